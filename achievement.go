@@ -0,0 +1,19 @@
+package main
+
+import (
+	"LearnShare/biz/job"
+	"context"
+	"fmt"
+	"os"
+)
+
+// backfillAchievements 按现有数据为全部用户检查并补发成就与勋章
+func backfillAchievements() {
+	initStorage()
+	awarded, err := job.EvaluateAllAchievements(context.Background())
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	fmt.Printf("成就补发完成，新达成 %d 项\n", awarded)
+}
@@ -0,0 +1,145 @@
+package db
+
+import (
+	"LearnShare/pkg/constants"
+	"LearnShare/pkg/errno"
+	"LearnShare/pkg/logger"
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// achievementMetricValue 某个用户在统计指标上的数值
+type achievementMetricValue struct {
+	UserID int64 `gorm:"column:user_id"`
+	Value  int64 `gorm:"column:value"`
+}
+
+// achievementMetrics 成就统计指标，每项按用户分组统计 userIDs 在现有业务表中的数据
+// 已删除、隐藏或未通过审核的内容不计入
+var achievementMetrics = map[string]func(tx *gorm.DB, userIDs []int64) *gorm.DB{
+	// uploads 已发布的资源数
+	"uploads": func(tx *gorm.DB, userIDs []int64) *gorm.DB {
+		return tx.Table(constants.ResourceTableName).Select("uploader_id AS user_id, COUNT(*) AS value").
+			Where("uploader_id IN ? AND status = ? AND deleted_at IS NULL", userIDs, "normal").Group("uploader_id")
+	},
+	// downloads_received 上传的资源累计被下载次数
+	"downloads_received": func(tx *gorm.DB, userIDs []int64) *gorm.DB {
+		return tx.Table(constants.ResourceTableName).Select("uploader_id AS user_id, COALESCE(SUM(download_count), 0) AS value").
+			Where("uploader_id IN ? AND deleted_at IS NULL", userIDs).Group("uploader_id")
+	},
+	// helpful_comments 至少获得一个点赞的资源评论与课程评论数
+	"helpful_comments": func(tx *gorm.DB, userIDs []int64) *gorm.DB {
+		comments := func(table string) *gorm.DB {
+			return tx.Session(&gorm.Session{NewDB: true}).Table(table).Select("user_id").
+				Where("user_id IN ? AND likes > 0 AND status = ? AND is_visible = ?", userIDs, "normal", true)
+		}
+		return tx.Raw("SELECT user_id, COUNT(*) AS value FROM (? UNION ALL ?) AS c GROUP BY user_id",
+			comments(constants.ResourceCommentTableName), comments(constants.CourseCommentTableName))
+	},
+	// course_ratings 评价过的课程数
+	"course_ratings": func(tx *gorm.DB, userIDs []int64) *gorm.DB {
+		return tx.Table(constants.CourseRatingTableName).Select("user_id, COUNT(*) AS value").
+			Where("user_id IN ? AND is_visible = ?", userIDs, true).Group("user_id")
+	},
+}
+
+// ListAchievements 查询全部启用的成就及其勋章物品，按展示顺序排列
+func ListAchievements(ctx context.Context) ([]*Achievement, error) {
+	var achievements []*Achievement
+	err := DB.WithContext(ctx).Table(constants.AchievementTableName).Where("enabled = ?", true).
+		Order("sort_order ASC, code ASC").Preload("Medal").Find(&achievements).Error
+	if err != nil {
+		return nil, errno.NewErrNo(errno.InternalDatabaseErrorCode, "查询成就失败: "+err.Error())
+	}
+	return achievements, nil
+}
+
+// GetUserAchievements 查询用户已达成的成就
+func GetUserAchievements(ctx context.Context, userID int64) ([]*UserAchievement, error) {
+	var earned []*UserAchievement
+	err := DB.WithContext(ctx).Table(constants.UserAchievementTableName).Where("user_id = ?", userID).Find(&earned).Error
+	if err != nil {
+		return nil, errno.NewErrNo(errno.InternalDatabaseErrorCode, "查询用户成就失败: "+err.Error())
+	}
+	return earned, nil
+}
+
+// EvaluateAchievements 统计 userIDs 在各成就指标上的数值，为达到阈值的用户发放成就与勋章
+// 已达成的成就与已拥有的勋章不会重复发放，可重复执行；返回 指标 -> 用户 -> 数值 以及本次新达成的成就数量
+func EvaluateAchievements(ctx context.Context, achievements []*Achievement, userIDs []int64) (map[string]map[int64]int64, int64, error) {
+	values := make(map[string]map[int64]int64)
+	if len(userIDs) == 0 {
+		return values, 0, nil
+	}
+	for _, a := range achievements {
+		if _, ok := values[a.Metric]; ok {
+			continue
+		}
+		metric, ok := achievementMetrics[a.Metric]
+		if !ok {
+			logger.Warnf("成就统计指标无效: code=%s metric=%s", a.Code, a.Metric)
+			continue
+		}
+		var rows []*achievementMetricValue
+		if err := metric(DB.WithContext(ctx), userIDs).Scan(&rows).Error; err != nil {
+			return nil, 0, errno.NewErrNo(errno.InternalDatabaseErrorCode, "统计成就指标失败: "+err.Error())
+		}
+		values[a.Metric] = make(map[int64]int64, len(rows))
+		for _, row := range rows {
+			values[a.Metric][row.UserID] = row.Value
+		}
+	}
+
+	var (
+		earned []*UserAchievement
+		medals []*UserItem
+	)
+	for _, a := range achievements {
+		for userID, value := range values[a.Metric] {
+			if value < a.Threshold {
+				continue
+			}
+			earned = append(earned, &UserAchievement{UserID: userID, AchievementCode: a.Code})
+			if a.MedalItemID != nil {
+				medals = append(medals, &UserItem{UserID: userID, ItemID: *a.MedalItemID})
+			}
+		}
+	}
+	if len(earned) == 0 {
+		return values, 0, nil
+	}
+
+	var awarded int64
+	err := DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Table(constants.UserAchievementTableName).Clauses(clause.OnConflict{DoNothing: true}).Create(&earned)
+		if result.Error != nil {
+			return errno.NewErrNo(errno.InternalDatabaseErrorCode, "发放成就失败: "+result.Error.Error())
+		}
+		awarded = result.RowsAffected
+		if len(medals) == 0 {
+			return nil
+		}
+		if err := tx.Table(constants.UserItemTableName).Omit("Item").Clauses(clause.OnConflict{DoNothing: true}).
+			Create(&medals).Error; err != nil {
+			return errno.NewErrNo(errno.InternalDatabaseErrorCode, "发放成就勋章失败: "+err.Error())
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	return values, awarded, nil
+}
+
+// ListUserIDsAfter 按ID顺序分批查询用户，afterID 为上一批最后一个用户ID
+func ListUserIDsAfter(ctx context.Context, afterID int64, limit int) ([]int64, error) {
+	var ids []int64
+	err := DB.WithContext(ctx).Table(constants.UserTableName).Where("user_id > ?", afterID).
+		Order("user_id ASC").Limit(limit).Pluck("user_id", &ids).Error
+	if err != nil {
+		return nil, errno.NewErrNo(errno.InternalDatabaseErrorCode, "查询用户失败: "+err.Error())
+	}
+	return ids, nil
+}
@@ -0,0 +1,130 @@
+package db
+
+import (
+	"LearnShare/pkg/constants"
+	"LearnShare/pkg/errno"
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// AdminActionFilter 管理操作日志查询条件，字段为空表示不限
+type AdminActionFilter struct {
+	ActorID    *int64
+	Action     *string
+	TargetType *string
+	TargetID   *int64
+	StartTime  *time.Time
+	EndTime    *time.Time
+}
+
+func (f AdminActionFilter) apply(query *gorm.DB) *gorm.DB {
+	if f.ActorID != nil {
+		query = query.Where("actor_id = ?", *f.ActorID)
+	}
+	if f.Action != nil {
+		query = query.Where("action = ?", *f.Action)
+	}
+	if f.TargetType != nil {
+		query = query.Where("target_type = ?", *f.TargetType)
+	}
+	if f.TargetID != nil {
+		query = query.Where("target_id = ?", *f.TargetID)
+	}
+	if f.StartTime != nil {
+		query = query.Where("created_at >= ?", *f.StartTime)
+	}
+	if f.EndTime != nil {
+		query = query.Where("created_at < ?", *f.EndTime)
+	}
+	return query
+}
+
+// CreateAdminAction 追加一条管理操作日志
+func CreateAdminAction(ctx context.Context, action *AdminAction) error {
+	err := DB.WithContext(ctx).Table(constants.AdminActionTableName).Create(action).Error
+	if err != nil {
+		return errno.NewErrNo(errno.InternalDatabaseErrorCode, "记录管理操作日志失败: "+err.Error())
+	}
+	return nil
+}
+
+// ListAdminActions 按条件分页查询管理操作日志，按时间倒序
+func ListAdminActions(ctx context.Context, filter AdminActionFilter, pageNum, pageSize int) ([]*AdminAction, int64, error) {
+	var (
+		actions []*AdminAction
+		total   int64
+	)
+	query := filter.apply(DB.WithContext(ctx).Table(constants.AdminActionTableName))
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, errno.NewErrNo(errno.InternalDatabaseErrorCode, "统计管理操作日志失败: "+err.Error())
+	}
+
+	err := query.Order("action_id DESC").Limit(pageSize).Offset(pageSize * (pageNum - 1)).Find(&actions).Error
+	if err != nil {
+		return nil, 0, errno.NewErrNo(errno.InternalDatabaseErrorCode, "查询管理操作日志失败: "+err.Error())
+	}
+	return actions, total, nil
+}
+
+// ExportAdminActions 按条件分批读取管理操作日志（时间倒序，最多 limit 条），每批交给 fn 处理
+func ExportAdminActions(ctx context.Context, filter AdminActionFilter, limit int, fn func([]*AdminAction) error) error {
+	const batchSize = 500
+
+	lastID := int64(0)
+	for read := 0; read < limit; {
+		size := batchSize
+		if limit-read < size {
+			size = limit - read
+		}
+
+		var batch []*AdminAction
+		query := filter.apply(DB.WithContext(ctx).Table(constants.AdminActionTableName))
+		if lastID > 0 {
+			query = query.Where("action_id < ?", lastID)
+		}
+		if err := query.Order("action_id DESC").Limit(size).Find(&batch).Error; err != nil {
+			return errno.NewErrNo(errno.InternalDatabaseErrorCode, "导出管理操作日志失败: "+err.Error())
+		}
+		if len(batch) == 0 {
+			return nil
+		}
+		if err := fn(batch); err != nil {
+			return err
+		}
+
+		read += len(batch)
+		lastID = batch[len(batch)-1].ActionID
+		if len(batch) < size {
+			return nil
+		}
+	}
+	return nil
+}
+
+// snapshotSensitiveColumns 不写入操作快照的敏感字段
+var snapshotSensitiveColumns = []string{"password_hash", "token_hash"}
+
+// GetRecordSnapshot 读取 table 中 keyColumn = id 的整行记录作为操作快照，记录不存在时返回 nil
+func GetRecordSnapshot(ctx context.Context, table, keyColumn string, id int64) (map[string]any, error) {
+	record := map[string]any{}
+	err := DB.WithContext(ctx).Table(table).Where(keyColumn+" = ?", id).Take(&record).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, errno.NewErrNo(errno.InternalDatabaseErrorCode, "读取操作快照失败: "+err.Error())
+	}
+
+	for _, column := range snapshotSensitiveColumns {
+		delete(record, column)
+	}
+	for key, value := range record {
+		if b, ok := value.([]byte); ok {
+			record[key] = string(b)
+		}
+	}
+	return record, nil
+}
@@ -0,0 +1,90 @@
+package db
+
+import (
+	"LearnShare/pkg/errno"
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ContentRef 内容引用，TargetType 取值与举报对象类型相同
+type ContentRef struct {
+	TargetType string
+	TargetID   int64
+}
+
+// hiddenContentCondition 各类内容被管理员隐藏时的状态，用户自行删除的评论不在其中
+var hiddenContentCondition = map[string]string{
+	"resource":        "status = 'banned'",
+	"comment":         "status = 'deleted_by_admin' OR (status = 'normal' AND is_visible = FALSE)",
+	"course_comment":  "status = 'deleted_by_admin' OR (status = 'normal' AND is_visible = FALSE)",
+	"course_rating":   "is_visible = FALSE",
+	"resource_rating": "is_visible = FALSE",
+}
+
+// ListUserVisibleComments 查询用户仍可见的资源评论与课程评论
+func ListUserVisibleComments(ctx context.Context, userID int64) ([]ContentRef, error) {
+	var refs []ContentRef
+	for _, targetType := range []string{"comment", "course_comment"} {
+		content := reviewContents[targetType]
+		var ids []int64
+		err := DB.WithContext(ctx).Table(content.table).
+			Where("user_id = ? AND status = ? AND is_visible = ?", userID, "normal", true).
+			Order(content.key+" ASC").Pluck(content.key, &ids).Error
+		if err != nil {
+			return nil, errno.NewErrNo(errno.InternalDatabaseErrorCode, "查询用户评论失败: "+err.Error())
+		}
+		for _, id := range ids {
+			refs = append(refs, ContentRef{TargetType: targetType, TargetID: id})
+		}
+	}
+	return refs, nil
+}
+
+// HideContent 隐藏一条内容，处理方式与举报审核确认违规时相同
+func HideContent(ctx context.Context, targetType string, targetID int64) error {
+	return DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if _, err := lockContent(tx, targetType, targetID); err != nil {
+			return err
+		}
+		return setContentVisible(tx, targetType, targetID, false)
+	})
+}
+
+// RestoreContent 恢复被管理员隐藏的内容，资源评分恢复后重新计算资源平均分
+func RestoreContent(ctx context.Context, targetType string, targetID int64) error {
+	return DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		content, err := lockContent(tx, targetType, targetID)
+		if err != nil {
+			return err
+		}
+		var hidden int64
+		if err := tx.Table(content.table).Where(content.key+" = ?", targetID).
+			Where(hiddenContentCondition[targetType]).Count(&hidden).Error; err != nil {
+			return errno.NewErrNo(errno.InternalDatabaseErrorCode, "查询内容状态失败: "+err.Error())
+		}
+		if hidden == 0 {
+			return errno.NewErrNo(errno.ServiceInvalidParameter, "该内容未被管理员隐藏")
+		}
+		return setContentVisible(tx, targetType, targetID, true)
+	})
+}
+
+// lockContent 在事务中锁定内容行，校验对象类型有效且内容存在
+func lockContent(tx *gorm.DB, targetType string, targetID int64) (reviewContent, error) {
+	content, ok := reviewContents[targetType]
+	if !ok {
+		return content, errno.NewErrNo(errno.ServiceInvalidParameter, "内容类型无效")
+	}
+	var ids []int64
+	err := tx.Table(content.table).Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where(content.key+" = ?", targetID).Limit(1).Pluck(content.key, &ids).Error
+	if err != nil {
+		return content, errno.NewErrNo(errno.InternalDatabaseErrorCode, "查询内容失败: "+err.Error())
+	}
+	if len(ids) == 0 {
+		return content, errno.NewErrNo(errno.ErrRecordNotFound, "内容不存在")
+	}
+	return content, nil
+}
@@ -0,0 +1,123 @@
+package db
+
+import (
+	"LearnShare/pkg/constants"
+	"LearnShare/pkg/errno"
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// ListContentFilterRules 分页查询过滤规则，enabled 为空时不限状态，keyword 匹配规则内容与说明
+func ListContentFilterRules(ctx context.Context, enabled *bool, keyword string, pageNum, pageSize int) ([]*ContentFilterRule, int64, error) {
+	query := DB.WithContext(ctx).Table(constants.ContentFilterRuleTableName)
+	if enabled != nil {
+		query = query.Where("enabled = ?", *enabled)
+	}
+	if keyword != "" {
+		query = query.Where("pattern LIKE ? OR description LIKE ?", "%"+keyword+"%", "%"+keyword+"%")
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, errno.NewErrNo(errno.InternalDatabaseErrorCode, "统计过滤规则失败: "+err.Error())
+	}
+	var rules []*ContentFilterRule
+	err := query.Order("rule_id DESC").Limit(pageSize).Offset(pageSize * (pageNum - 1)).Find(&rules).Error
+	if err != nil {
+		return nil, 0, errno.NewErrNo(errno.InternalDatabaseErrorCode, "查询过滤规则失败: "+err.Error())
+	}
+	return rules, total, nil
+}
+
+// GetEnabledContentFilterRules 查询全部启用的过滤规则
+func GetEnabledContentFilterRules(ctx context.Context) ([]*ContentFilterRule, error) {
+	var rules []*ContentFilterRule
+	err := DB.WithContext(ctx).Table(constants.ContentFilterRuleTableName).
+		Where("enabled = ?", true).Order("rule_id ASC").Find(&rules).Error
+	if err != nil {
+		return nil, errno.NewErrNo(errno.InternalDatabaseErrorCode, "查询过滤规则失败: "+err.Error())
+	}
+	return rules, nil
+}
+
+// GetContentFilterRule 根据ID查询过滤规则
+func GetContentFilterRule(ctx context.Context, ruleID int64) (*ContentFilterRule, error) {
+	var rule ContentFilterRule
+	err := DB.WithContext(ctx).Table(constants.ContentFilterRuleTableName).Where("rule_id = ?", ruleID).Take(&rule).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errno.ContentFilterRuleNotFoundError
+		}
+		return nil, errno.NewErrNo(errno.InternalDatabaseErrorCode, "查询过滤规则失败: "+err.Error())
+	}
+	return &rule, nil
+}
+
+// CreateContentFilterRule 创建过滤规则，同一匹配方式下规则内容不能重复
+func CreateContentFilterRule(ctx context.Context, rule *ContentFilterRule) error {
+	err := DB.WithContext(ctx).Table(constants.ContentFilterRuleTableName).Create(rule).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return errno.ContentFilterRuleExistError
+		}
+		return errno.NewErrNo(errno.InternalDatabaseErrorCode, "创建过滤规则失败: "+err.Error())
+	}
+	return nil
+}
+
+// UpdateContentFilterRule 更新过滤规则
+func UpdateContentFilterRule(ctx context.Context, ruleID int64, updates map[string]interface{}) error {
+	err := DB.WithContext(ctx).Table(constants.ContentFilterRuleTableName).Where("rule_id = ?", ruleID).Updates(updates).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return errno.ContentFilterRuleExistError
+		}
+		return errno.NewErrNo(errno.InternalDatabaseErrorCode, "更新过滤规则失败: "+err.Error())
+	}
+	return nil
+}
+
+// DeleteContentFilterRule 删除过滤规则
+func DeleteContentFilterRule(ctx context.Context, ruleID int64) error {
+	result := DB.WithContext(ctx).Table(constants.ContentFilterRuleTableName).Where("rule_id = ?", ruleID).Delete(&ContentFilterRule{})
+	if result.Error != nil {
+		return errno.NewErrNo(errno.InternalDatabaseErrorCode, "删除过滤规则失败: "+result.Error.Error())
+	}
+	if result.RowsAffected == 0 {
+		return errno.ContentFilterRuleNotFoundError
+	}
+	return nil
+}
+
+// CreateFilterReview 内容过滤命中后为新发布的内容提交审核，没有举报人
+// hide 为 true 时先隐藏内容等待审核，审核驳回（误判）后按自动隐藏的规则恢复
+func CreateFilterReview(ctx context.Context, targetType string, targetID int64, reason string, priority int, dueAt time.Time, hide bool) (*Review, error) {
+	review := &Review{
+		TargetID:   targetID,
+		TargetType: targetType,
+		Reason:     reason,
+		Priority:   priority,
+		DueAt:      &dueAt,
+		Level:      1,
+	}
+	err := DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if hide {
+			if err := setReportedContentHidden(tx, targetType, targetID, true); err != nil {
+				return err
+			}
+			now := time.Now()
+			review.AutoHiddenAt = &now
+		}
+		if err := tx.Table(constants.ReviewTableName).Create(review).Error; err != nil {
+			return errno.NewErrNo(errno.InternalDatabaseErrorCode, "创建过滤审核失败: "+err.Error())
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return review, nil
+}
@@ -5,7 +5,6 @@ import (
 	"LearnShare/pkg/errno"
 	"context"
 	"errors"
-	"fmt"
 	"gorm.io/gorm"
 	"time"
 )
@@ -212,7 +211,8 @@ func UpdateCourseCommentAsync(ctx context.Context, commentID int64, updates map[
 }
 
 func DeleteCourseComment(ctx context.Context, commentID int64) error {
-	err := DB.WithContext(ctx).Table(constants.CourseCommentTableName).Where("comment_id = ?", commentID).Delete(&CourseComment{}).Error
+	err := DB.WithContext(ctx).Table(constants.CourseCommentTableName).Where("comment_id = ? AND status = ?", commentID, "normal").
+		Updates(commentDeletedUpdates("deleted_by_user")).Error
 	if err != nil {
 		return errno.NewErrNo(errno.InternalDatabaseErrorCode, "删除课程评论失败: "+err.Error())
 	}
@@ -393,7 +393,7 @@ func DeleteResourceAsync(ctx context.Context, resourceID int64) chan error {
 	})
 }
 
-// AdminDeleteCourse 管理员硬删除课程，并清理所有关联数据
+// AdminDeleteCourse 管理员删除课程（软删除），课程下的资源一并标记删除，评论、评分与收藏保留到清理任务彻底删除时
 func AdminDeleteCourse(ctx context.Context, courseID int64) error {
 	ctxWithTimeout, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
@@ -416,32 +416,15 @@ func AdminDeleteCourse(ctx context.Context, courseID int64) error {
 		return errno.NewErrNo(errno.InternalDatabaseErrorCode, "查询课程失败: "+err.Error())
 	}
 
-	// 2. 删除课程下的所有资源（假设资源表有 course_id 外键）
-	if err := tx.Table(constants.ResourceTableName).Where("course_id = ?", courseID).Delete(&Resource{}).Error; err != nil {
+	// 2. 课程与资源使用同一删除时间，恢复课程时据此恢复随课程删除的资源
+	now := time.Now().Truncate(time.Second)
+	if err := tx.Table(constants.ResourceTableName).Where("course_id = ? AND deleted_at IS NULL", courseID).Update("deleted_at", now).Error; err != nil {
 		tx.Rollback()
 		return errno.NewErrNo(errno.InternalDatabaseErrorCode, "删除课程资源失败: "+err.Error())
 	}
 
-	// 3. 删除课程的所有评分
-	if err := tx.Table(constants.CourseRatingTableName).Where("course_id = ?", courseID).Delete(&CourseRating{}).Error; err != nil {
-		tx.Rollback()
-		return errno.NewErrNo(errno.InternalDatabaseErrorCode, "删除课程评分失败: "+err.Error())
-	}
-
-	// 4. 删除课程的所有评论
-	if err := tx.Table(constants.CourseCommentTableName).Where("course_id = ?", courseID).Delete(&CourseComment{}).Error; err != nil {
-		tx.Rollback()
-		return errno.NewErrNo(errno.InternalDatabaseErrorCode, "删除课程评论失败: "+err.Error())
-	}
-
-	// 5. 删除课程收藏记录（favorites 表，target_type = 'course'）
-	if err := tx.Table(constants.FavoriteTableName).Where("target_type = ? AND target_id = ?", "course", courseID).Delete(nil).Error; err != nil {
-		tx.Rollback()
-		return errno.NewErrNo(errno.InternalDatabaseErrorCode, fmt.Sprintf("清理课程收藏引用失败: %v", err))
-	}
-
-	// 6. 最终删除课程本身
-	if err := tx.Table(constants.CourseTableName).Where("course_id = ?", courseID).Delete(&Course{}).Error; err != nil {
+	// 3. 删除课程本身
+	if err := tx.Table(constants.CourseTableName).Where("course_id = ?", courseID).Update("deleted_at", now).Error; err != nil {
 		tx.Rollback()
 		return errno.NewErrNo(errno.InternalDatabaseErrorCode, "删除课程失败: "+err.Error())
 	}
@@ -477,7 +460,7 @@ func AdminDeleteCourseComment(ctx context.Context, commentID int64) error {
 		return errno.NewErrNo(errno.InternalDatabaseErrorCode, "查询课程评论失败: "+err.Error())
 	}
 
-	if err := tx.Table(constants.CourseCommentTableName).Where("comment_id = ?", commentID).Delete(&CourseComment{}).Error; err != nil {
+	if err := tx.Table(constants.CourseCommentTableName).Where("comment_id = ?", commentID).Updates(commentDeletedUpdates("deleted_by_admin")).Error; err != nil {
 		tx.Rollback()
 		return errno.NewErrNo(errno.InternalDatabaseErrorCode, "删除课程评论失败: "+err.Error())
 	}
@@ -0,0 +1,83 @@
+package db
+
+import (
+	"LearnShare/pkg/constants"
+	"LearnShare/pkg/errno"
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// LeaderboardUserScore 用户在周榜、月榜与总榜上的分数，以及用于按学院、专业筛选的归属
+type LeaderboardUserScore struct {
+	UserID    int64  `gorm:"column:user_id"`
+	CollegeID *int64 `gorm:"column:college_id"`
+	MajorID   *int64 `gorm:"column:major_id"`
+	Week      int64  `gorm:"column:week"`
+	Month     int64  `gorm:"column:month"`
+	Total     int64  `gorm:"column:total"`
+}
+
+// leaderboardQueries 各排行榜的统计方式，只统计状态正常的用户
+var leaderboardQueries = map[string]func(tx *gorm.DB, weekStart, monthStart time.Time) *gorm.DB{
+	// uploads 已发布的资源数，按上传时间归入周期
+	"uploads": func(tx *gorm.DB, weekStart, monthStart time.Time) *gorm.DB {
+		return tx.Table(constants.ResourceTableName+" AS r").
+			Select("u.user_id, u.college_id, u.major_id, "+
+				"SUM(CASE WHEN r.created_at >= ? THEN 1 ELSE 0 END) AS week, "+
+				"SUM(CASE WHEN r.created_at >= ? THEN 1 ELSE 0 END) AS month, COUNT(*) AS total", weekStart, monthStart).
+			Joins("JOIN "+constants.UserTableName+" AS u ON u.user_id = r.uploader_id").
+			Where("r.status = ? AND r.deleted_at IS NULL AND u.status = ?", "normal", "active").
+			Group("u.user_id, u.college_id, u.major_id")
+	},
+	// reputation 周期内信誉分记录的变化之和，总榜为当前信誉分
+	"reputation": func(tx *gorm.DB, weekStart, monthStart time.Time) *gorm.DB {
+		since := weekStart
+		if monthStart.Before(since) {
+			since = monthStart
+		}
+		return tx.Table(constants.UserTableName+" AS u").
+			Select("u.user_id, u.college_id, u.major_id, "+
+				"COALESCE(SUM(CASE WHEN rr.created_at >= ? THEN rr.change_score ELSE 0 END), 0) AS week, "+
+				"COALESCE(SUM(CASE WHEN rr.created_at >= ? THEN rr.change_score ELSE 0 END), 0) AS month, "+
+				"u.reputation_score AS total", weekStart, monthStart).
+			Joins("LEFT JOIN "+constants.ReputationRecordTableName+" AS rr ON rr.user_id = u.user_id AND rr.created_at >= ?", since).
+			Where("u.status = ?", "active").
+			Group("u.user_id, u.college_id, u.major_id, u.reputation_score")
+	},
+}
+
+// ListLeaderboardScores 从业务表统计排行榜分数，userIDs 为空时统计全部用户（用于重建），
+// 用户不存在、不是正常状态或没有数据时不返回
+func ListLeaderboardScores(ctx context.Context, board string, weekStart, monthStart time.Time, userIDs ...int64) ([]*LeaderboardUserScore, error) {
+	query, ok := leaderboardQueries[board]
+	if !ok {
+		return nil, errno.ParamVerifyError.WithMessage("排行榜类型无效")
+	}
+	tx := query(DB.WithContext(ctx), weekStart, monthStart)
+	if len(userIDs) > 0 {
+		tx = tx.Where("u.user_id IN ?", userIDs)
+	}
+	var scores []*LeaderboardUserScore
+	if err := tx.Scan(&scores).Error; err != nil {
+		return nil, errno.NewErrNo(errno.InternalDatabaseErrorCode, "统计排行榜失败: "+err.Error())
+	}
+	return scores, nil
+}
+
+// GetUsersByIDs 批量查询用户，返回 用户ID -> 用户，不存在的用户不返回
+func GetUsersByIDs(ctx context.Context, userIDs []int64) (map[int64]*User, error) {
+	users := make(map[int64]*User, len(userIDs))
+	if len(userIDs) == 0 {
+		return users, nil
+	}
+	var list []*User
+	if err := DB.WithContext(ctx).Table(constants.UserTableName).Where("user_id IN ?", userIDs).Find(&list).Error; err != nil {
+		return nil, errno.NewErrNo(errno.InternalDatabaseErrorCode, "查询用户失败: "+err.Error())
+	}
+	for _, u := range list {
+		users[u.UserID] = u
+	}
+	return users, nil
+}
@@ -2,22 +2,28 @@ package db
 
 import (
 	"LearnShare/biz/model/module"
+	"strings"
 	"time"
+
+	"gorm.io/gorm"
 )
 
 type User struct {
-	UserID          int64     `json:"user_id" db:"user_id"`
-	Username        string    `json:"username" db:"username"`
-	PasswordHash    string    `json:"-" db:"password_hash"`
-	Email           string    `json:"email" db:"email"`
-	CollegeID       *int64    `json:"college_id,omitempty" db:"college_id"`
-	MajorID         *int64    `json:"major_id,omitempty" db:"major_id"`
-	AvatarURL       *string   `json:"avatar_url,omitempty" db:"avatar_url"`
-	ReputationScore int64     `json:"reputation_score" db:"reputation_score"`
-	RoleID          int64     `json:"role_id" db:"role_id"`
-	Status          string    `json:"status" db:"status"`
-	CreatedAt       time.Time `json:"created_at" db:"created_at"`
-	UpdatedAt       time.Time `json:"updated_at" db:"updated_at"`
+	UserID          int64      `json:"user_id" db:"user_id" gorm:"primaryKey;autoIncrement"`
+	Username        string     `json:"username" db:"username"`
+	PasswordHash    string     `json:"-" db:"password_hash"`
+	Email           string     `json:"email" db:"email"`
+	CollegeID       *int64     `json:"college_id,omitempty" db:"college_id"`
+	MajorID         *int64     `json:"major_id,omitempty" db:"major_id"`
+	AvatarURL       *string    `json:"avatar_url,omitempty" db:"avatar_url"`
+	ReputationScore int64      `json:"reputation_score" db:"reputation_score"`
+	RoleID          int64      `json:"role_id" db:"role_id"`
+	Status          string     `json:"status" db:"status"`
+	MutedUntil      *time.Time `json:"muted_until,omitempty" db:"muted_until"`
+	CreatedAt       time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at" db:"updated_at"`
+	// 注册流程创建账户的时间，激活后清空；只有带此标记的未激活账户会被清理
+	ActivationPendingAt *time.Time `json:"-" db:"activation_pending_at"`
 }
 
 func (u User) ToUserModule() *module.User {
@@ -44,6 +50,149 @@ func (u User) ToUserModule() *module.User {
 	return user
 }
 
+// UserIdentity 用户与外部身份提供方账号的绑定关系
+type UserIdentity struct {
+	IdentityID int64     `json:"identity_id" db:"identity_id" gorm:"primaryKey;autoIncrement"`
+	UserID     int64     `json:"user_id" db:"user_id"`
+	Issuer     string    `json:"issuer" db:"issuer"`
+	Subject    string    `json:"subject" db:"subject"`
+	Email      *string   `json:"email,omitempty" db:"email"`
+	CreatedAt  time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// PasswordHistory 用户曾使用过的密码哈希
+type PasswordHistory struct {
+	HistoryID    int64     `json:"history_id" db:"history_id" gorm:"primaryKey;autoIncrement"`
+	UserID       int64     `json:"user_id" db:"user_id"`
+	PasswordHash string    `json:"-" db:"password_hash"`
+	CreatedAt    time.Time `json:"created_at" db:"created_at"`
+}
+
+// EmailChangeHistory 邮箱变更记录
+type EmailChangeHistory struct {
+	HistoryID int64     `json:"history_id" db:"history_id" gorm:"primaryKey;autoIncrement"`
+	UserID    int64     `json:"user_id" db:"user_id"`
+	OldEmail  string    `json:"old_email" db:"old_email"`
+	NewEmail  string    `json:"new_email" db:"new_email"`
+	Action    string    `json:"action" db:"action"`
+	IP        *string   `json:"ip,omitempty" db:"ip"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+}
+
+// PersonalAccessToken 个人访问令牌，仅保存哈希
+type PersonalAccessToken struct {
+	TokenID     int64      `json:"token_id" db:"token_id" gorm:"primaryKey;autoIncrement"`
+	UserID      int64      `json:"user_id" db:"user_id"`
+	Name        string     `json:"name" db:"name"`
+	TokenPrefix string     `json:"token_prefix" db:"token_prefix"`
+	TokenHash   string     `json:"-" db:"token_hash"`
+	Scopes      string     `json:"scopes" db:"scopes"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty" db:"expires_at"`
+	LastUsedAt  *time.Time `json:"last_used_at,omitempty" db:"last_used_at"`
+	LastUsedIP  *string    `json:"last_used_ip,omitempty" db:"last_used_ip"`
+	RevokedAt   *time.Time `json:"revoked_at,omitempty" db:"revoked_at"`
+	CreatedAt   time.Time  `json:"created_at" db:"created_at"`
+}
+
+// ScopeList 返回令牌的授权范围列表
+func (t PersonalAccessToken) ScopeList() []string {
+	if t.Scopes == "" {
+		return []string{}
+	}
+	return strings.Split(t.Scopes, ",")
+}
+
+func (t PersonalAccessToken) ToPersonalTokenModule() *module.PersonalToken {
+	token := &module.PersonalToken{
+		TokenID:     t.TokenID,
+		Name:        t.Name,
+		TokenPrefix: t.TokenPrefix,
+		Scopes:      t.ScopeList(),
+		LastUsedIP:  t.LastUsedIP,
+		CreatedAt:   t.CreatedAt.Unix(),
+	}
+	if t.ExpiresAt != nil {
+		expiresAt := t.ExpiresAt.Unix()
+		token.ExpiresAt = &expiresAt
+	}
+	if t.LastUsedAt != nil {
+		lastUsedAt := t.LastUsedAt.Unix()
+		token.LastUsedAt = &lastUsedAt
+	}
+	return token
+}
+
+// ScopedPermissionGrant 作用域授权：仅在 ScopeType/ScopeID 指定的课程或资源范围内生效的权限
+type ScopedPermissionGrant struct {
+	GrantID      int64     `json:"grant_id" db:"grant_id" gorm:"primaryKey;autoIncrement"`
+	UserID       int64     `json:"user_id" db:"user_id"`
+	PermissionID int64     `json:"permission_id" db:"permission_id"`
+	ScopeType    string    `json:"scope_type" db:"scope_type"`
+	ScopeID      int64     `json:"scope_id" db:"scope_id"`
+	GrantedBy    *int64    `json:"granted_by,omitempty" db:"granted_by"`
+	CreatedAt    time.Time `json:"created_at" db:"created_at"`
+}
+
+// ScopedPermissionGrantWithName 带权限标识的作用域授权
+type ScopedPermissionGrantWithName struct {
+	ScopedPermissionGrant
+	PermissionName string `json:"permission_name" db:"permission_name"`
+}
+
+func (g ScopedPermissionGrantWithName) ToScopedPermissionModule() *module.ScopedPermission {
+	return &module.ScopedPermission{
+		GrantID:        g.GrantID,
+		UserID:         g.UserID,
+		PermissionName: g.PermissionName,
+		ScopeType:      g.ScopeType,
+		ScopeID:        g.ScopeID,
+		GrantedBy:      g.GrantedBy,
+		CreatedAt:      g.CreatedAt.Unix(),
+	}
+}
+
+// Scope 权限作用域
+type Scope struct {
+	Type string
+	ID   int64
+}
+
+// AdminAction 管理操作日志，只追加不修改
+type AdminAction struct {
+	ActionID       int64     `json:"action_id" db:"action_id" gorm:"primaryKey;autoIncrement"`
+	ActorID        int64     `json:"actor_id" db:"actor_id"`
+	Action         string    `json:"action" db:"action"`
+	Method         string    `json:"method" db:"method"`
+	Path           string    `json:"path" db:"path"`
+	TargetType     *string   `json:"target_type,omitempty" db:"target_type"`
+	TargetID       *int64    `json:"target_id,omitempty" db:"target_id"`
+	BeforeSnapshot *string   `json:"before_snapshot,omitempty" db:"before_snapshot"`
+	AfterSnapshot  *string   `json:"after_snapshot,omitempty" db:"after_snapshot"`
+	StatusCode     int32     `json:"status_code" db:"status_code"`
+	IP             *string   `json:"ip,omitempty" db:"ip"`
+	RequestID      *string   `json:"request_id,omitempty" db:"request_id"`
+	CreatedAt      time.Time `json:"created_at" db:"created_at"`
+}
+
+func (a AdminAction) ToAdminActionModule() *module.AdminAction {
+	return &module.AdminAction{
+		ActionID:       a.ActionID,
+		ActorID:        a.ActorID,
+		Action:         a.Action,
+		Method:         a.Method,
+		Path:           a.Path,
+		TargetType:     a.TargetType,
+		TargetID:       a.TargetID,
+		BeforeSnapshot: a.BeforeSnapshot,
+		AfterSnapshot:  a.AfterSnapshot,
+		StatusCode:     a.StatusCode,
+		IP:             a.IP,
+		RequestID:      a.RequestID,
+		CreatedAt:      a.CreatedAt.Unix(),
+	}
+}
+
 // Course 相关结构体
 type Course struct {
 	CourseID    int64     `json:"course_id" db:"course_id"`
@@ -55,6 +204,8 @@ type Course struct {
 	Description *string   `json:"description,omitempty" db:"description"`
 	CreatedAt   time.Time `json:"created_at" db:"created_at"`
 	UpdatedAt   time.Time `json:"updated_at" db:"updated_at"`
+	// 软删除：查询自动排除已删除课程，保留期过后由清理任务彻底删除
+	DeletedAt gorm.DeletedAt `json:"-" db:"deleted_at"`
 }
 
 func (c Course) ToCourseModule() *module.Course {
@@ -95,6 +246,8 @@ type Resource struct {
 	Status        string        `gorm:"type:enum('normal','low_quality','pending_review');default:'pending_review'"`
 	CreatedAt     time.Time     `gorm:"autoCreateTime"`
 	Tags          []ResourceTag `gorm:"many2many:resource_tags;joinForeignKey:resource_id;joinReferences:tag_id"`
+	// 软删除：查询自动排除已删除资源，保留期过后由清理任务删除文件与记录
+	DeletedAt gorm.DeletedAt `gorm:"index"`
 }
 
 // ToResourceModule 将db.Resource转换为model.Resource
@@ -194,7 +347,7 @@ func (r CourseRating) ToCourseRatingModule() *module.CourseRating {
 }
 
 type CourseComment struct {
-	CommentID int64     `json:"comment_id" db:"comment_id"`
+	CommentID int64     `json:"comment_id" db:"comment_id" gorm:"primaryKey;autoIncrement"`
 	CourseID  int64     `json:"course_id" db:"course_id"`
 	UserID    int64     `json:"user_id" db:"user_id"`
 	Content   string    `json:"content" db:"content"`
@@ -252,7 +405,7 @@ type CommentUserRow struct {
 }
 
 type CourseCommentWithuser struct {
-	CommentID int64     `json:"comment_id" db:"comment_id"`
+	CommentID int64     `json:"comment_id" db:"comment_id" gorm:"primaryKey;autoIncrement"`
 	CourseID  int64     `json:"course_id" db:"course_id"`
 	User      User      `json:"user" db:"-"`
 	Likes     int64     `json:"likes" db:"likes"`
@@ -402,10 +555,19 @@ type Review struct {
 	Reason     string     `gorm:"type:text;not null;column:reason"`
 	Status     string     `gorm:"type:enum('pending','approved','rejected');default:'pending';column:status"`
 	Priority   int        `gorm:"default:3;column:priority"`
-	ReporterID int64      `gorm:"column:reporter_id"`
+	ReporterID *int64     `gorm:"column:reporter_id"` // 内容过滤自动提交的审核没有举报人
 	ReviewerID *int64     `gorm:"column:reviewer_id"`
 	ReviewedAt *time.Time `gorm:"column:reviewed_at"`
-	CreatedAt  time.Time  `gorm:"autoCreateTime;column:created_at"`
+	// 审核队列：认领人与租约、SLA 截止时间及超时升级时间
+	ClaimedBy      *int64     `gorm:"column:claimed_by"`
+	ClaimExpiresAt *time.Time `gorm:"column:claim_expires_at"`
+	DueAt          *time.Time `gorm:"column:due_at"`
+	EscalatedAt    *time.Time `gorm:"column:escalated_at"`
+	Level          int        `gorm:"default:1;column:level"` // 1 举报审核，2 申诉复核
+	// 举报合并：同一对象的举报次数，以及达到阈值后自动隐藏内容的时间
+	ReportCount  int64      `gorm:"default:1;column:report_count"`
+	AutoHiddenAt *time.Time `gorm:"column:auto_hidden_at"`
+	CreatedAt    time.Time  `gorm:"autoCreateTime;column:created_at"`
 }
 
 // ToReviewModule 将db.Review转换为model.Review
@@ -417,21 +579,253 @@ func (r Review) ToReviewModule() *module.Review {
 		reviewerId = 0
 	}
 
-	reporterId := r.ReporterID
+	var reporterId int64
+	if r.ReporterID != nil {
+		reporterId = *r.ReporterID
+	}
 
 	return &module.Review{
-		ReviewId:   r.ReviewID,
-		ReviewerId: reviewerId,
-		ReporterId: reporterId,
-		TargetId:   r.TargetID,
-		TargetType: r.TargetType,
+		ReviewId:       r.ReviewID,
+		ReviewerId:     reviewerId,
+		ReporterId:     reporterId,
+		TargetId:       r.TargetID,
+		TargetType:     r.TargetType,
+		Reason:         r.Reason,
+		Status:         r.Status,
+		Priority:       int64(r.Priority),
+		CreatedAt:      r.CreatedAt.Unix(),
+		ClaimedBy:      r.ClaimedBy,
+		ClaimExpiresAt: unixPtr(r.ClaimExpiresAt),
+		DueAt:          unixPtr(r.DueAt),
+		EscalatedAt:    unixPtr(r.EscalatedAt),
+		Level:          int64Ptr(int64(r.Level)),
+		ReportCount:    int64Ptr(r.ReportCount),
+		AutoHiddenAt:   unixPtr(r.AutoHiddenAt),
+	}
+}
+
+// ReviewReporter 合并到审核记录的举报人
+type ReviewReporter struct {
+	ReviewID   int64     `gorm:"primaryKey;column:review_id"`
+	ReporterID int64     `gorm:"primaryKey;column:reporter_id"`
+	Reason     string    `gorm:"column:reason"`
+	CreatedAt  time.Time `gorm:"autoCreateTime;column:created_at"`
+}
+
+func (r ReviewReporter) ToReviewReporterModule() *module.ReviewReporter {
+	return &module.ReviewReporter{
+		ReporterId: r.ReporterID,
 		Reason:     r.Reason,
-		Status:     r.Status,
-		Priority:   int64(r.Priority),
 		CreatedAt:  r.CreatedAt.Unix(),
 	}
 }
 
+// ModerationAction 处罚记录
+type ModerationAction struct {
+	ActionID        int64      `gorm:"primaryKey;autoIncrement;column:action_id"`
+	ReviewID        int64      `gorm:"column:review_id"`
+	UserID          int64      `gorm:"column:user_id"`
+	ModeratorID     *int64     `gorm:"column:moderator_id"`
+	Action          string     `gorm:"column:action"`
+	Reason          string     `gorm:"column:reason"`
+	TargetType      string     `gorm:"column:target_type"`
+	TargetID        int64      `gorm:"column:target_id"`
+	ReputationDelta int64      `gorm:"column:reputation_delta"`
+	MutedUntil      *time.Time `gorm:"column:muted_until"`
+	Status          string     `gorm:"default:active;column:status"`
+	AppealReviewID  *int64     `gorm:"column:appeal_review_id"`
+	CreatedAt       time.Time  `gorm:"autoCreateTime;column:created_at"`
+	RevokedAt       *time.Time `gorm:"column:revoked_at"`
+}
+
+func (m ModerationAction) ToModerationActionModule() *module.ModerationAction {
+	return &module.ModerationAction{
+		ActionID:        m.ActionID,
+		ReviewID:        m.ReviewID,
+		UserID:          m.UserID,
+		ModeratorID:     m.ModeratorID,
+		Action:          m.Action,
+		Reason:          m.Reason,
+		TargetType:      m.TargetType,
+		TargetID:        m.TargetID,
+		ReputationDelta: m.ReputationDelta,
+		MutedUntil:      unixPtr(m.MutedUntil),
+		Status:          m.Status,
+		AppealReviewID:  m.AppealReviewID,
+		CreatedAt:       m.CreatedAt.Unix(),
+		RevokedAt:       unixPtr(m.RevokedAt),
+	}
+}
+
+// Notification 站内通知
+type Notification struct {
+	NotificationID int64     `gorm:"primaryKey;autoIncrement;column:notification_id"`
+	UserID         int64     `gorm:"column:user_id"`
+	Type           string    `gorm:"column:type"`
+	Title          string    `gorm:"column:title"`
+	Content        string    `gorm:"column:content"`
+	RelatedType    *string   `gorm:"column:related_type"`
+	RelatedID      *int64    `gorm:"column:related_id"`
+	IsRead         bool      `gorm:"column:is_read"`
+	CreatedAt      time.Time `gorm:"autoCreateTime;column:created_at"`
+}
+
+func (n Notification) ToNotificationModule() *module.Notification {
+	return &module.Notification{
+		NotificationID: n.NotificationID,
+		Type:           n.Type,
+		Title:          n.Title,
+		Content:        n.Content,
+		RelatedType:    n.RelatedType,
+		RelatedID:      n.RelatedID,
+		IsRead:         n.IsRead,
+		CreatedAt:      n.CreatedAt.Unix(),
+	}
+}
+
+// ContentFilterRule 内容过滤规则
+type ContentFilterRule struct {
+	RuleID      int64     `gorm:"primaryKey;autoIncrement;column:rule_id"`
+	Pattern     string    `gorm:"column:pattern"`
+	MatchType   string    `gorm:"default:keyword;column:match_type"` // keyword 或 regex
+	Action      string    `gorm:"column:action"`                     // reject、mask 或 review
+	Description *string   `gorm:"column:description"`
+	Enabled     bool      `gorm:"column:enabled"`
+	CreatedBy   *int64    `gorm:"column:created_by"`
+	CreatedAt   time.Time `gorm:"autoCreateTime;column:created_at"`
+	UpdatedAt   time.Time `gorm:"autoUpdateTime;column:updated_at"`
+}
+
+func (r ContentFilterRule) ToContentFilterRuleModule() *module.ContentFilterRule {
+	return &module.ContentFilterRule{
+		RuleID:      r.RuleID,
+		Pattern:     r.Pattern,
+		MatchType:   r.MatchType,
+		Action:      r.Action,
+		Description: r.Description,
+		Enabled:     r.Enabled,
+		CreatedBy:   r.CreatedBy,
+		CreatedAt:   r.CreatedAt.Unix(),
+		UpdatedAt:   r.UpdatedAt.Unix(),
+	}
+}
+
+// ReputationRecord 信誉分变动记录
+type ReputationRecord struct {
+	RecordID    int64     `gorm:"primaryKey;autoIncrement;column:record_id"`
+	UserID      int64     `gorm:"column:user_id"`
+	ChangeScore int64     `gorm:"column:change_score"`
+	EventType   string    `gorm:"column:event_type"`
+	Reason      string    `gorm:"column:reason"`
+	RelatedID   *int64    `gorm:"column:related_id"`
+	RelatedType *string   `gorm:"column:related_type"`
+	OperatorID  *int64    `gorm:"column:operator_id"`
+	CreatedAt   time.Time `gorm:"autoCreateTime;column:created_at"`
+}
+
+func (r ReputationRecord) ToReputationRecordModule() *module.ReputationRecord {
+	return &module.ReputationRecord{
+		RecordID:    r.RecordID,
+		UserID:      r.UserID,
+		ChangeScore: r.ChangeScore,
+		EventType:   r.EventType,
+		Reason:      r.Reason,
+		RelatedType: r.RelatedType,
+		RelatedID:   r.RelatedID,
+		OperatorID:  r.OperatorID,
+		CreatedAt:   r.CreatedAt.Unix(),
+	}
+}
+
+// ReputationRule 信誉分规则，定义自动发放事件的分值与限制
+type ReputationRule struct {
+	EventType     string    `gorm:"primaryKey;column:event_type"`
+	Points        int64     `gorm:"column:points"`
+	DailyLimit    int64     `gorm:"column:daily_limit"`
+	OncePerTarget bool      `gorm:"column:once_per_target"`
+	Description   string    `gorm:"column:description"`
+	Enabled       bool      `gorm:"column:enabled"`
+	UpdatedAt     time.Time `gorm:"autoUpdateTime;column:updated_at"`
+}
+
+// Item 积分商城物品
+type Item struct {
+	ItemID      int64   `gorm:"primaryKey;autoIncrement;column:item_id"`
+	Name        string  `gorm:"column:name"`
+	Type        string  `gorm:"column:type"`
+	Price       int64   `gorm:"column:price"`
+	Description *string `gorm:"column:description"`
+	ImageURL    *string `gorm:"column:image_url"`
+}
+
+func (i Item) ToItemModule() *module.Item {
+	item := &module.Item{
+		ItemID: i.ItemID,
+		Name:   i.Name,
+		Type:   i.Type,
+		Price:  i.Price,
+	}
+	if i.Description != nil {
+		item.Description = *i.Description
+	}
+	if i.ImageURL != nil {
+		item.ImageURL = *i.ImageURL
+	}
+	return item
+}
+
+// UserItem 用户拥有的物品，Item 为关联查询得到的物品信息
+type UserItem struct {
+	UserItemID int64     `gorm:"primaryKey;autoIncrement;column:user_item_id"`
+	UserID     int64     `gorm:"column:user_id"`
+	ItemID     int64     `gorm:"column:item_id"`
+	IsUsed     bool      `gorm:"column:is_used"`
+	ObtainedAt time.Time `gorm:"autoCreateTime;column:obtained_at"`
+	Item       Item      `gorm:"foreignKey:ItemID;references:ItemID"`
+}
+
+func (u UserItem) ToUserItemModule() *module.UserItem {
+	return &module.UserItem{
+		UserItemID: u.UserItemID,
+		Item:       u.Item.ToItemModule(),
+		IsUsed:     u.IsUsed,
+		ObtainedAt: u.ObtainedAt.Unix(),
+	}
+}
+
+// Achievement 成就定义，Medal 为关联查询得到的勋章物品
+type Achievement struct {
+	Code        string `gorm:"primaryKey;column:code"`
+	Name        string `gorm:"column:name"`
+	Description string `gorm:"column:description"`
+	Metric      string `gorm:"column:metric"`
+	Threshold   int64  `gorm:"column:threshold"`
+	MedalItemID *int64 `gorm:"column:medal_item_id"`
+	SortOrder   int64  `gorm:"column:sort_order"`
+	Enabled     bool   `gorm:"column:enabled"`
+	Medal       *Item  `gorm:"foreignKey:MedalItemID;references:ItemID"`
+}
+
+// UserAchievement 用户已达成的成就
+type UserAchievement struct {
+	UserID          int64     `gorm:"primaryKey;column:user_id"`
+	AchievementCode string    `gorm:"primaryKey;column:achievement_code"`
+	AchievedAt      time.Time `gorm:"autoCreateTime;column:achieved_at"`
+}
+
+// unixPtr 将可空时间转换为可空的秒级时间戳
+func unixPtr(t *time.Time) *int64 {
+	if t == nil {
+		return nil
+	}
+	ts := t.Unix()
+	return &ts
+}
+
+func int64Ptr(v int64) *int64 {
+	return &v
+}
+
 // Permission 权限表结构
 type Permission struct {
 	PermissionID   int64  `json:"permission_id" db:"permission_id"`
@@ -0,0 +1,385 @@
+package db
+
+import (
+	"LearnShare/pkg/constants"
+	"LearnShare/pkg/errno"
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// reviewContent 举报对象所在的表、主键与作者字段，popularity 为衡量对象热度的表达式
+type reviewContent struct {
+	table, key, owner, popularity string
+}
+
+var reviewContents = map[string]reviewContent{
+	"resource":        {constants.ResourceTableName, "resource_id", "uploader_id", "COALESCE(download_count, 0) + COALESCE(rating_count, 0)"},
+	"comment":         {constants.ResourceCommentTableName, "comment_id", "user_id", "COALESCE(likes, 0)"},
+	"course_comment":  {constants.CourseCommentTableName, "comment_id", "user_id", "COALESCE(likes, 0)"},
+	"course_rating":   {constants.CourseRatingTableName, "rating_id", "user_id", "0"},
+	"resource_rating": {constants.ResourceRatingTableName, "rating_id", "user_id", "0"},
+}
+
+// appealReviewerExcluded 申诉复核不能由原处罚人或原举报审核员处理，用于认领时过滤
+const appealReviewerExcluded = `level = 1 OR NOT EXISTS (
+	SELECT 1 FROM moderation_actions m JOIN reviews p ON p.review_id = m.review_id
+	WHERE m.action_id = reviews.target_id AND (m.moderator_id = ? OR p.reviewer_id = ?))`
+
+// GetReviewContentOwner 查询举报对象的作者
+func GetReviewContentOwner(ctx context.Context, targetType string, targetID int64) (int64, error) {
+	content, ok := reviewContents[targetType]
+	if !ok {
+		return 0, errno.NewErrNo(errno.ServiceInvalidParameter, "举报对象类型无效")
+	}
+
+	var owners []int64
+	err := DB.WithContext(ctx).Table(content.table).Where(content.key+" = ?", targetID).Limit(1).Pluck(content.owner, &owners).Error
+	if err != nil {
+		return 0, errno.NewErrNo(errno.InternalDatabaseErrorCode, "查询违规内容作者失败: "+err.Error())
+	}
+	if len(owners) == 0 {
+		return 0, errno.NewErrNo(errno.ErrRecordNotFound, "违规内容不存在")
+	}
+	return owners[0], nil
+}
+
+// setContentVisible 隐藏或恢复举报对象
+func setContentVisible(tx *gorm.DB, targetType string, targetID int64, visible bool) error {
+	content, ok := reviewContents[targetType]
+	if !ok {
+		return errno.NewErrNo(errno.ServiceInvalidParameter, "举报对象类型无效")
+	}
+
+	var updates map[string]interface{}
+	switch targetType {
+	case "resource":
+		updates = map[string]interface{}{"status": "banned"}
+		if visible {
+			updates["status"] = "normal"
+		}
+	case "comment", "course_comment":
+		updates = map[string]interface{}{"status": "deleted_by_admin", "is_visible": false}
+		if visible {
+			updates = map[string]interface{}{"status": "normal", "is_visible": true, "deleted_at": nil}
+		}
+	default:
+		updates = map[string]interface{}{"is_visible": visible}
+	}
+
+	if err := tx.Table(content.table).Where(content.key+" = ?", targetID).Updates(updates).Error; err != nil {
+		return errno.NewErrNo(errno.InternalDatabaseErrorCode, "更新违规内容状态失败: "+err.Error())
+	}
+	return refreshRatingAggregate(tx, targetType, targetID)
+}
+
+// refreshRatingAggregate 资源评分显示状态变化后重新计算资源的平均分与评分人数
+// 课程评分在读取时按可见评分汇总，无需处理
+func refreshRatingAggregate(tx *gorm.DB, targetType string, ratingID int64) error {
+	if targetType != "resource_rating" {
+		return nil
+	}
+
+	var resourceIDs []int64
+	if err := tx.Table(constants.ResourceRatingTableName).Where("rating_id = ?", ratingID).
+		Limit(1).Pluck("resource_id", &resourceIDs).Error; err != nil {
+		return errno.NewErrNo(errno.InternalDatabaseErrorCode, "查询资源评分失败: "+err.Error())
+	}
+	if len(resourceIDs) == 0 {
+		return nil
+	}
+
+	var avgResult struct {
+		AverageRating float64 `gorm:"column:average_rating"`
+		RatingCount   int64   `gorm:"column:rating_count"`
+	}
+	if err := tx.Table(constants.ResourceRatingTableName).
+		Select("COALESCE(AVG(recommendation), 0) as average_rating, COUNT(*) as rating_count").
+		Where("resource_id = ? AND is_visible = ?", resourceIDs[0], true).
+		Scan(&avgResult).Error; err != nil {
+		return errno.NewErrNo(errno.InternalDatabaseErrorCode, "计算资源平均评分失败: "+err.Error())
+	}
+	if err := tx.Table(constants.ResourceTableName).Where("resource_id = ?", resourceIDs[0]).
+		Updates(map[string]interface{}{
+			"average_rating": avgResult.AverageRating,
+			"rating_count":   avgResult.RatingCount,
+		}).Error; err != nil {
+		return errno.NewErrNo(errno.InternalDatabaseErrorCode, "更新资源评分信息失败: "+err.Error())
+	}
+	return nil
+}
+
+// moderationReputationChange 处罚及申诉撤销产生的信誉分变动，关联到处罚记录
+func moderationReputationChange(action *ModerationAction, delta int64, eventType, reason string) ReputationChange {
+	relatedType, actionID := "moderation", action.ActionID
+	return ReputationChange{
+		UserID:      action.UserID,
+		Delta:       delta,
+		EventType:   eventType,
+		Reason:      reason,
+		RelatedType: &relatedType,
+		RelatedID:   &actionID,
+	}
+}
+
+// ApplyModerationAction 对已确认违规的举报执行处罚，处罚效果、信誉分记录与通知在同一事务中写入
+// 同一举报可执行多种处罚，但同一类型的处罚在未撤销前只能执行一次
+// action 需填好用户、处罚类型与参数，notification 只需填写标题与内容
+func ApplyModerationAction(ctx context.Context, action *ModerationAction, notification *Notification) error {
+	return DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		review, err := getReview(tx.Clauses(clause.Locking{Strength: "UPDATE"}), action.ReviewID)
+		if err != nil {
+			return err
+		}
+		if review.Level != 1 || review.Status != "approved" {
+			return errno.ReviewNotApprovedError
+		}
+		action.TargetType, action.TargetID = review.TargetType, review.TargetID
+
+		// 举报行已加锁，重复提交的同类处罚在此串行，未撤销的处罚不能重复执行
+		var existing int64
+		if err := tx.Table(constants.ModerationActionTableName).
+			Where("review_id = ? AND action = ? AND status = ?", action.ReviewID, action.Action, "active").
+			Count(&existing).Error; err != nil {
+			return errno.NewErrNo(errno.InternalDatabaseErrorCode, "查询处罚记录失败: "+err.Error())
+		}
+		if existing > 0 {
+			return errno.ModerationActionExistError
+		}
+
+		if err := tx.Table(constants.ModerationActionTableName).Create(action).Error; err != nil {
+			return errno.NewErrNo(errno.InternalDatabaseErrorCode, "创建处罚记录失败: "+err.Error())
+		}
+
+		if err := applyModerationEffect(tx, action); err != nil {
+			return err
+		}
+
+		notification.UserID = action.UserID
+		notification.RelatedType, notification.RelatedID = moderationRelated(action.ActionID)
+		return createNotification(tx, notification)
+	})
+}
+
+// applyModerationEffect 执行处罚效果：隐藏内容、扣除信誉分、禁言或封禁，警告没有额外效果
+func applyModerationEffect(tx *gorm.DB, action *ModerationAction) error {
+	var err error
+	switch action.Action {
+	case "hide_content":
+		return setContentVisible(tx, action.TargetType, action.TargetID, false)
+	case "deduct_reputation":
+		_, err = changeReputation(tx, moderationReputationChange(action, action.ReputationDelta, ReputationPenalty, "违规处罚: "+action.Reason))
+		return err
+	case "mute":
+		err = tx.Table(constants.UserTableName).
+			Where("user_id = ? AND (muted_until IS NULL OR muted_until < ?)", action.UserID, action.MutedUntil).
+			Update("muted_until", action.MutedUntil).Error
+	case "ban":
+		err = tx.Table(constants.UserTableName).Where("user_id = ?", action.UserID).Update("status", "banned").Error
+	}
+	if err != nil {
+		return errno.NewErrNo(errno.InternalDatabaseErrorCode, "执行处罚失败: "+err.Error())
+	}
+	return nil
+}
+
+// revertModerationAction 撤销处罚并恢复其效果
+func revertModerationAction(tx *gorm.DB, action *ModerationAction, now time.Time) error {
+	if err := tx.Table(constants.ModerationActionTableName).Where("action_id = ?", action.ActionID).Updates(map[string]interface{}{
+		"status":     "revoked",
+		"revoked_at": now,
+	}).Error; err != nil {
+		return errno.NewErrNo(errno.InternalDatabaseErrorCode, "撤销处罚失败: "+err.Error())
+	}
+
+	var err error
+	switch action.Action {
+	case "hide_content":
+		return setContentVisible(tx, action.TargetType, action.TargetID, true)
+	case "deduct_reputation":
+		_, err = changeReputation(tx, moderationReputationChange(action, -action.ReputationDelta, ReputationAppealRestored, "申诉成功，恢复信誉分"))
+		return err
+	case "mute":
+		// 之后又被延长的禁言不受影响
+		err = tx.Table(constants.UserTableName).
+			Where("user_id = ? AND muted_until <= ?", action.UserID, action.MutedUntil).
+			Update("muted_until", nil).Error
+	case "ban":
+		err = tx.Table(constants.UserTableName).
+			Where("user_id = ? AND status = ?", action.UserID, "banned").
+			Update("status", "active").Error
+	}
+	if err != nil {
+		return errno.NewErrNo(errno.InternalDatabaseErrorCode, "恢复处罚效果失败: "+err.Error())
+	}
+	return nil
+}
+
+// GetModerationAction 查询处罚记录，不存在时返回 ModerationActionNotFoundError
+func GetModerationAction(ctx context.Context, actionID int64) (*ModerationAction, error) {
+	return getModerationAction(DB.WithContext(ctx), actionID)
+}
+
+func getModerationAction(tx *gorm.DB, actionID int64) (*ModerationAction, error) {
+	var action ModerationAction
+	if err := tx.Table(constants.ModerationActionTableName).Where("action_id = ?", actionID).First(&action).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errno.ModerationActionNotFoundError
+		}
+		return nil, errno.NewErrNo(errno.InternalDatabaseErrorCode, "查询处罚记录失败: "+err.Error())
+	}
+	return &action, nil
+}
+
+// ListUserModerationActions 分页查询用户收到的处罚，按时间倒序
+func ListUserModerationActions(ctx context.Context, userID int64, pageNum, pageSize int) ([]*ModerationAction, int64, error) {
+	var (
+		actions []*ModerationAction
+		total   int64
+	)
+	query := DB.WithContext(ctx).Table(constants.ModerationActionTableName).Where("user_id = ?", userID)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, errno.NewErrNo(errno.InternalDatabaseErrorCode, "统计处罚记录失败: "+err.Error())
+	}
+	err := query.Order("action_id DESC").Limit(pageSize).Offset(pageSize * (pageNum - 1)).Find(&actions).Error
+	if err != nil {
+		return nil, 0, errno.NewErrNo(errno.InternalDatabaseErrorCode, "查询处罚记录失败: "+err.Error())
+	}
+	return actions, total, nil
+}
+
+// CreateAppeal 对处罚提起申诉，创建二级复核记录，每条处罚只能申诉一次
+func CreateAppeal(ctx context.Context, actionID, userID int64, reason string, priority int, dueAt time.Time) (int64, error) {
+	var reviewID int64
+	err := DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		action, err := getModerationAction(tx.Clauses(clause.Locking{Strength: "UPDATE"}), actionID)
+		if err != nil {
+			return err
+		}
+		if action.UserID != userID {
+			return errno.ModerationActionNotFoundError
+		}
+		if action.AppealReviewID != nil {
+			return errno.AppealExistError
+		}
+		if action.Status != "active" {
+			return errno.AppealNotAllowedError
+		}
+
+		review := &Review{
+			TargetID:   actionID,
+			TargetType: "moderation_action",
+			Reason:     reason,
+			Priority:   priority,
+			ReporterID: &userID,
+			DueAt:      &dueAt,
+			Level:      2,
+		}
+		if err := tx.Table(constants.ReviewTableName).Create(review).Error; err != nil {
+			return errno.NewErrNo(errno.InternalDatabaseErrorCode, "创建申诉失败: "+err.Error())
+		}
+		if err := tx.Table(constants.ModerationActionTableName).Where("action_id = ?", actionID).
+			Update("appeal_review_id", review.ReviewID).Error; err != nil {
+			return errno.NewErrNo(errno.InternalDatabaseErrorCode, "关联申诉失败: "+err.Error())
+		}
+		reviewID = review.ReviewID
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return reviewID, nil
+}
+
+// checkAppealReviewer 申诉复核人不能是原处罚人或原举报的审核员
+func checkAppealReviewer(tx *gorm.DB, actionID, reviewerID int64) error {
+	action, err := getModerationAction(tx, actionID)
+	if err != nil {
+		return err
+	}
+	if action.ModeratorID != nil && *action.ModeratorID == reviewerID {
+		return errno.AppealReviewerConflictError
+	}
+	original, err := getReview(tx, action.ReviewID)
+	if err != nil {
+		return err
+	}
+	if original.ReviewerID != nil && *original.ReviewerID == reviewerID {
+		return errno.AppealReviewerConflictError
+	}
+	return nil
+}
+
+// GetPendingAppeals 分页查询待处理的处罚申诉，按审核队列顺序
+func GetPendingAppeals(ctx context.Context, pageNum, pageSize int) ([]*Review, int64, error) {
+	var (
+		reviews []*Review
+		total   int64
+	)
+	query := DB.WithContext(ctx).Table(constants.ReviewTableName).Where("level = ? AND status = ?", 2, "pending")
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, errno.NewErrNo(errno.InternalDatabaseErrorCode, "统计处罚申诉失败: "+err.Error())
+	}
+	err := query.Order(reviewQueueOrder).Limit(pageSize).Offset(pageSize * (pageNum - 1)).Find(&reviews).Error
+	if err != nil {
+		return nil, 0, errno.NewErrNo(errno.InternalDatabaseErrorCode, "查询处罚申诉失败: "+err.Error())
+	}
+	return reviews, total, nil
+}
+
+// ResolveAppeal 处理处罚申诉，overturn 为 true 时撤销处罚并恢复其效果，结果通知申诉人
+func ResolveAppeal(ctx context.Context, reviewID, reviewerID int64, overturn bool, notification *Notification) (*ModerationAction, error) {
+	var action *ModerationAction
+	err := DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		review, err := lockReviewForAudit(tx, reviewID, reviewerID, now)
+		if err != nil {
+			return err
+		}
+		if review.Level != 2 || review.TargetType != "moderation_action" {
+			return errno.NewErrNo(errno.ServiceInvalidParameter, "该审核记录不是处罚申诉")
+		}
+		if err := checkAppealReviewer(tx, review.TargetID, reviewerID); err != nil {
+			return err
+		}
+		action, err = getModerationAction(tx.Clauses(clause.Locking{Strength: "UPDATE"}), review.TargetID)
+		if err != nil {
+			return err
+		}
+
+		status := "rejected"
+		if overturn {
+			status = "approved"
+		}
+		if err := tx.Table(constants.ReviewTableName).Where("review_id = ?", reviewID).Updates(map[string]interface{}{
+			"status":           status,
+			"reviewer_id":      reviewerID,
+			"reviewed_at":      now,
+			"claimed_by":       nil,
+			"claim_expires_at": nil,
+		}).Error; err != nil {
+			return errno.NewErrNo(errno.InternalDatabaseErrorCode, "更新申诉状态失败: "+err.Error())
+		}
+		if overturn && action.Status == "active" {
+			if err := revertModerationAction(tx, action, now); err != nil {
+				return err
+			}
+		}
+
+		notification.UserID = action.UserID
+		notification.RelatedType, notification.RelatedID = moderationRelated(action.ActionID)
+		return createNotification(tx, notification)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return action, nil
+}
+
+func moderationRelated(actionID int64) (*string, *int64) {
+	relatedType := "moderation_action"
+	return &relatedType, &actionID
+}
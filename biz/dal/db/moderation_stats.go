@@ -0,0 +1,191 @@
+package db
+
+import (
+	"LearnShare/pkg/constants"
+	"LearnShare/pkg/errno"
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// BacklogDelta 按对象类型与时间桶统计的审核数量，Bucket 为 -1 表示统计区间开始之前
+type BacklogDelta struct {
+	TargetType string `gorm:"column:target_type"`
+	Bucket     int64  `gorm:"column:bucket"`
+	Count      int64  `gorm:"column:cnt"`
+}
+
+// ReviewerDecisionStats 审核员在统计区间内的处理情况
+type ReviewerDecisionStats struct {
+	ReviewerID         int64   `gorm:"column:reviewer_id"`
+	Username           string  `gorm:"column:username"`
+	Decided            int64   `gorm:"column:decided"`
+	Approved           int64   `gorm:"column:approved"`
+	Rejected           int64   `gorm:"column:rejected"`
+	AvgDecisionSeconds float64 `gorm:"column:avg_decision_seconds"`
+}
+
+// ReportedUserStats 被举报用户
+type ReportedUserStats struct {
+	UserID      int64  `gorm:"column:user_id"`
+	Username    string `gorm:"column:username"`
+	ReportCount int64  `gorm:"column:report_count"`
+	ReviewCount int64  `gorm:"column:review_count"`
+}
+
+// ReportedResourceStats 被举报资源
+type ReportedResourceStats struct {
+	ResourceID   int64  `gorm:"column:resource_id"`
+	ResourceName string `gorm:"column:resource_name"`
+	ReportCount  int64  `gorm:"column:report_count"`
+	ReviewCount  int64  `gorm:"column:review_count"`
+}
+
+// secondsBetween 两个时间相差的秒数，SQLite 没有 TIMESTAMPDIFF，用儒略日换算
+func secondsBetween(from, to string) string {
+	if DB.Dialector.Name() == "sqlite" {
+		return "CAST(ROUND((julianday(" + to + ") - julianday(" + from + ")) * 86400) AS INTEGER)"
+	}
+	return "TIMESTAMPDIFF(SECOND, " + from + ", " + to + ")"
+}
+
+// intDivide 整数除法，除数以参数传入，SQLite 的整数相除即为整除
+func intDivide(dividend string) string {
+	if DB.Dialector.Name() == "sqlite" {
+		return "(" + dividend + ") / ?"
+	}
+	return "(" + dividend + ") DIV ?"
+}
+
+// CountReviewBacklogDeltas 统计 [start, end) 内各时间桶新增与处理的审核数量，以及 start 之前的累计数量（Bucket 为 -1）
+// 某一时刻的积压等于此前提交的数量减去此前处理的数量，由调用方按桶累加
+func CountReviewBacklogDeltas(ctx context.Context, start, end time.Time, step time.Duration) (created, decided []*BacklogDelta, err error) {
+	count := func(column string) ([]*BacklogDelta, error) {
+		var before, within []*BacklogDelta
+		if err := DB.WithContext(ctx).Table(constants.ReviewTableName).
+			Select("target_type, -1 AS bucket, COUNT(*) AS cnt").
+			Where(column+" < ?", start).Group("target_type").Scan(&before).Error; err != nil {
+			return nil, err
+		}
+		if err := DB.WithContext(ctx).Table(constants.ReviewTableName).
+			Select("target_type, "+intDivide(secondsBetween("?", column))+" AS bucket, COUNT(*) AS cnt", start, int64(step/time.Second)).
+			Where(column+" >= ? AND "+column+" < ?", start, end).Group("target_type, bucket").Scan(&within).Error; err != nil {
+			return nil, err
+		}
+		return append(before, within...), nil
+	}
+
+	if created, err = count("created_at"); err != nil {
+		return nil, nil, errno.NewErrNo(errno.InternalDatabaseErrorCode, "统计审核提交数量失败: "+err.Error())
+	}
+	if decided, err = count("reviewed_at"); err != nil {
+		return nil, nil, errno.NewErrNo(errno.InternalDatabaseErrorCode, "统计审核处理数量失败: "+err.Error())
+	}
+	return created, decided, nil
+}
+
+// MedianDecisionSeconds 统计 [start, end) 内处理的举报数量，以及从提交到处理用时的中位数（秒）
+func MedianDecisionSeconds(ctx context.Context, start, end time.Time) (int64, int64, error) {
+	decided := func() *gorm.DB {
+		return DB.WithContext(ctx).Table(constants.ReviewTableName).
+			Where("level = ? AND reviewed_at >= ? AND reviewed_at < ?", 1, start, end)
+	}
+
+	var total int64
+	if err := decided().Count(&total).Error; err != nil {
+		return 0, 0, errno.NewErrNo(errno.InternalDatabaseErrorCode, "统计已处理举报失败: "+err.Error())
+	}
+	if total == 0 {
+		return 0, 0, nil
+	}
+
+	// 奇数取中间一条，偶数取中间两条的平均值
+	var middle []int64
+	limit := 2 - int(total%2)
+	if err := decided().Select(secondsBetween("created_at", "reviewed_at")+" AS seconds").
+		Order("seconds ASC").Offset(int((total-1)/2)).Limit(limit).Pluck("seconds", &middle).Error; err != nil {
+		return 0, 0, errno.NewErrNo(errno.InternalDatabaseErrorCode, "统计审核用时失败: "+err.Error())
+	}
+	if len(middle) == 0 {
+		return total, 0, nil
+	}
+	var sum int64
+	for _, v := range middle {
+		sum += v
+	}
+	return total, sum / int64(len(middle)), nil
+}
+
+// GetReviewerDecisionStats 统计 [start, end) 内各审核员处理的举报，按处理数量降序
+func GetReviewerDecisionStats(ctx context.Context, start, end time.Time) ([]*ReviewerDecisionStats, error) {
+	var stats []*ReviewerDecisionStats
+	err := DB.WithContext(ctx).Table(constants.ReviewTableName+" AS r").
+		Select(`r.reviewer_id, COALESCE(u.username, '') AS username, COUNT(*) AS decided,
+			COALESCE(SUM(r.status = 'approved'), 0) AS approved,
+			COALESCE(SUM(r.status = 'rejected'), 0) AS rejected,
+			COALESCE(AVG(`+secondsBetween("r.created_at", "r.reviewed_at")+`), 0) AS avg_decision_seconds`).
+		Joins("LEFT JOIN "+constants.UserTableName+" AS u ON u.user_id = r.reviewer_id").
+		Where("r.level = ? AND r.reviewer_id IS NOT NULL AND r.reviewed_at >= ? AND r.reviewed_at < ?", 1, start, end).
+		Group("r.reviewer_id, u.username").
+		Order("decided DESC, r.reviewer_id ASC").
+		Scan(&stats).Error
+	if err != nil {
+		return nil, errno.NewErrNo(errno.InternalDatabaseErrorCode, "统计审核员处理情况失败: "+err.Error())
+	}
+	return stats, nil
+}
+
+// GetTopReportedUsers 统计 [start, end) 内被举报次数最多的内容作者，按举报人提交举报的时间计算
+func GetTopReportedUsers(ctx context.Context, start, end time.Time, limit int) ([]*ReportedUserStats, error) {
+	types := make([]string, 0, len(reviewContents))
+	for t := range reviewContents {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+
+	parts := make([]string, 0, len(types))
+	args := make([]interface{}, 0, len(types)*3+1)
+	for _, t := range types {
+		content := reviewContents[t]
+		parts = append(parts, "SELECT c."+content.owner+" AS user_id, r.review_id"+
+			" FROM "+constants.ReviewReporterTableName+" AS rr"+
+			" JOIN "+constants.ReviewTableName+" AS r ON r.review_id = rr.review_id"+
+			" JOIN "+content.table+" AS c ON c."+content.key+" = r.target_id"+
+			" WHERE r.target_type = ? AND rr.created_at >= ? AND rr.created_at < ?")
+		args = append(args, t, start, end)
+	}
+	args = append(args, limit)
+
+	var stats []*ReportedUserStats
+	err := DB.WithContext(ctx).Raw(`SELECT o.user_id, u.username, COUNT(*) AS report_count, COUNT(DISTINCT o.review_id) AS review_count
+		FROM (`+strings.Join(parts, " UNION ALL ")+`) AS o
+		JOIN `+constants.UserTableName+` AS u ON u.user_id = o.user_id
+		GROUP BY o.user_id, u.username
+		ORDER BY report_count DESC, o.user_id ASC
+		LIMIT ?`, args...).Scan(&stats).Error
+	if err != nil {
+		return nil, errno.NewErrNo(errno.InternalDatabaseErrorCode, "统计被举报用户失败: "+err.Error())
+	}
+	return stats, nil
+}
+
+// GetTopReportedResources 统计 [start, end) 内被举报次数最多的资源
+func GetTopReportedResources(ctx context.Context, start, end time.Time, limit int) ([]*ReportedResourceStats, error) {
+	var stats []*ReportedResourceStats
+	err := DB.WithContext(ctx).Table(constants.ReviewReporterTableName+" AS rr").
+		Select("r.target_id AS resource_id, c.resource_name, COUNT(*) AS report_count, COUNT(DISTINCT r.review_id) AS review_count").
+		Joins("JOIN "+constants.ReviewTableName+" AS r ON r.review_id = rr.review_id").
+		Joins("JOIN "+constants.ResourceTableName+" AS c ON c.resource_id = r.target_id").
+		Where("r.target_type = ? AND rr.created_at >= ? AND rr.created_at < ?", "resource", start, end).
+		Group("r.target_id, c.resource_name").
+		Order("report_count DESC, r.target_id ASC").
+		Limit(limit).
+		Scan(&stats).Error
+	if err != nil {
+		return nil, errno.NewErrNo(errno.InternalDatabaseErrorCode, "统计被举报资源失败: "+err.Error())
+	}
+	return stats, nil
+}
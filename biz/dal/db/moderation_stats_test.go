@@ -0,0 +1,122 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"LearnShare/pkg/constants"
+)
+
+// insertDecidedReview 插入一条指定提交与处理时间的审核记录，reviewedAt 为零值时表示尚未处理
+func insertDecidedReview(t *testing.T, targetType string, level int, status string, reviewerID int64, createdAt, reviewedAt time.Time) {
+	t.Helper()
+	review := Review{TargetID: 1, TargetType: targetType, Reason: "违规", Status: status, Level: level, CreatedAt: createdAt}
+	if !reviewedAt.IsZero() {
+		review.ReviewerID, review.ReviewedAt = &reviewerID, &reviewedAt
+	}
+	if err := DB.Table(constants.ReviewTableName).Create(&review).Error; err != nil {
+		t.Fatalf("插入审核记录失败: %v", err)
+	}
+}
+
+// deltaCounts 将统计结果整理为 对象类型 -> 时间桶 -> 数量
+func deltaCounts(deltas []*BacklogDelta) map[string]map[int64]int64 {
+	counts := make(map[string]map[int64]int64)
+	for _, d := range deltas {
+		if counts[d.TargetType] == nil {
+			counts[d.TargetType] = make(map[int64]int64)
+		}
+		counts[d.TargetType][d.Bucket] += d.Count
+	}
+	return counts
+}
+
+func assertDeltas(t *testing.T, name string, got []*BacklogDelta, expected map[string]map[int64]int64) {
+	t.Helper()
+	counts := deltaCounts(got)
+	if len(counts) != len(expected) {
+		t.Fatalf("%s 的对象类型不正确: %v, 期望 %v", name, counts, expected)
+	}
+	for targetType, buckets := range expected {
+		if len(counts[targetType]) != len(buckets) {
+			t.Fatalf("%s 中 %s 的时间桶不正确: %v, 期望 %v", name, targetType, counts[targetType], buckets)
+		}
+		for bucket, n := range buckets {
+			if counts[targetType][bucket] != n {
+				t.Fatalf("%s 中 %s 第 %d 桶数量不正确: %d, 期望 %d", name, targetType, bucket, counts[targetType][bucket], n)
+			}
+		}
+	}
+}
+
+func TestModerationStats(t *testing.T) {
+	cleanup := setupModerationTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	first := insertModerationUser(t, "reviewer1", 100)
+	second := insertModerationUser(t, "reviewer2", 100)
+	start := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(3 * time.Hour)
+	at := func(minutes int) time.Time { return start.Add(time.Duration(minutes) * time.Minute) }
+
+	// 统计区间之前提交与处理
+	insertDecidedReview(t, "resource", 1, "approved", first.UserID, at(-120), at(-60))
+	insertDecidedReview(t, "resource", 1, "approved", first.UserID, at(-30), at(30))
+	// 区间内提交，用时依次为 3600、600、900、600 秒
+	insertDecidedReview(t, "comment", 1, "rejected", second.UserID, at(10), at(70))
+	insertDecidedReview(t, "comment", 1, "approved", second.UserID, at(90), at(100))
+	insertDecidedReview(t, "course_comment", 1, "approved", first.UserID, at(120), at(135))
+	insertDecidedReview(t, "comment", 1, "rejected", second.UserID, at(120), at(130))
+	// 仍待处理，以及恰好在区间结束时处理的举报
+	insertDecidedReview(t, "comment", 1, "pending", 0, at(20), time.Time{})
+	insertDecidedReview(t, "comment", 1, "approved", second.UserID, at(150), at(180))
+	// 申诉复核计入积压，但不计入举报处理用时与审核员统计
+	insertDecidedReview(t, "moderation_action", 2, "approved", first.UserID, at(60), at(65))
+
+	created, decided, err := CountReviewBacklogDeltas(ctx, start, end, time.Hour)
+	if err != nil {
+		t.Fatalf("统计审核积压失败: %v", err)
+	}
+	assertDeltas(t, "提交数量", created, map[string]map[int64]int64{
+		"resource":          {-1: 2},
+		"comment":           {0: 2, 1: 1, 2: 2},
+		"course_comment":    {2: 1},
+		"moderation_action": {1: 1},
+	})
+	assertDeltas(t, "处理数量", decided, map[string]map[int64]int64{
+		"resource":          {-1: 1, 0: 1},
+		"comment":           {1: 2, 2: 1},
+		"course_comment":    {2: 1},
+		"moderation_action": {1: 1},
+	})
+
+	// 用时 [600, 600, 900, 3600, 3600]，取中间一条
+	total, median, err := MedianDecisionSeconds(ctx, start, end)
+	if err != nil || total != 5 || median != 900 {
+		t.Fatalf("处理用时中位数不正确: total=%d median=%d err=%v", total, median, err)
+	}
+	// 用时 [600, 600, 900, 3600]，取中间两条的平均值
+	total, median, err = MedianDecisionSeconds(ctx, at(60), end)
+	if err != nil || total != 4 || median != 750 {
+		t.Fatalf("偶数条时中位数不正确: total=%d median=%d err=%v", total, median, err)
+	}
+	if total, median, err = MedianDecisionSeconds(ctx, at(-600), at(-300)); err != nil || total != 0 || median != 0 {
+		t.Fatalf("区间内无处理记录时应返回0: total=%d median=%d err=%v", total, median, err)
+	}
+
+	reviewers, err := GetReviewerDecisionStats(ctx, start, end)
+	if err != nil || len(reviewers) != 2 {
+		t.Fatalf("统计审核员处理情况失败: %+v err=%v", reviewers, err)
+	}
+	expected := []ReviewerDecisionStats{
+		{ReviewerID: second.UserID, Username: "reviewer2", Decided: 3, Approved: 1, Rejected: 2, AvgDecisionSeconds: 1600},
+		{ReviewerID: first.UserID, Username: "reviewer1", Decided: 2, Approved: 2, Rejected: 0, AvgDecisionSeconds: 2250},
+	}
+	for i, e := range expected {
+		if *reviewers[i] != e {
+			t.Fatalf("第 %d 位审核员统计不正确: %+v, 期望 %+v", i, *reviewers[i], e)
+		}
+	}
+}
@@ -0,0 +1,314 @@
+package db
+
+import (
+	"LearnShare/pkg/constants"
+	"LearnShare/pkg/errno"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// setupModerationTestDB 初始化处罚与申诉测试数据库
+func setupModerationTestDB(t *testing.T) func() {
+	t.Helper()
+	sqliteDB, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("初始化SQLite失败: %v", err)
+	}
+
+	createTableSQL := []string{`
+CREATE TABLE IF NOT EXISTS users (
+    user_id INTEGER PRIMARY KEY AUTOINCREMENT,
+    username TEXT,
+    password_hash TEXT,
+    email TEXT UNIQUE,
+    college_id INTEGER,
+    major_id INTEGER,
+    avatar_url TEXT,
+    reputation_score INTEGER DEFAULT 0,
+    role_id INTEGER,
+    status TEXT,
+    muted_until DATETIME,
+    activation_pending_at DATETIME,
+    created_at DATETIME,
+    updated_at DATETIME
+);`, `
+CREATE TABLE IF NOT EXISTS reviews (
+    review_id INTEGER PRIMARY KEY AUTOINCREMENT,
+    target_id INTEGER NOT NULL,
+    target_type TEXT NOT NULL,
+    reason TEXT NOT NULL,
+    status TEXT DEFAULT 'pending',
+    priority INTEGER DEFAULT 3,
+    reporter_id INTEGER,
+    reviewer_id INTEGER,
+    reviewed_at DATETIME,
+    claimed_by INTEGER,
+    claim_expires_at DATETIME,
+    due_at DATETIME,
+    escalated_at DATETIME,
+    level INTEGER DEFAULT 1,
+    report_count INTEGER DEFAULT 1,
+    auto_hidden_at DATETIME,
+    created_at DATETIME
+);`, `
+CREATE TABLE IF NOT EXISTS moderation_actions (
+    action_id INTEGER PRIMARY KEY AUTOINCREMENT,
+    review_id INTEGER,
+    user_id INTEGER,
+    moderator_id INTEGER,
+    action TEXT,
+    reason TEXT,
+    target_type TEXT,
+    target_id INTEGER,
+    reputation_delta INTEGER DEFAULT 0,
+    muted_until DATETIME,
+    status TEXT DEFAULT 'active',
+    appeal_review_id INTEGER,
+    created_at DATETIME,
+    revoked_at DATETIME
+);`, `
+CREATE TABLE IF NOT EXISTS notifications (
+    notification_id INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id INTEGER,
+    type TEXT,
+    title TEXT,
+    content TEXT,
+    related_type TEXT,
+    related_id INTEGER,
+    is_read BOOLEAN DEFAULT 0,
+    created_at DATETIME
+);`, `
+CREATE TABLE IF NOT EXISTS reputation_records (
+    record_id INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id INTEGER,
+    change_score INTEGER,
+    event_type TEXT,
+    reason TEXT,
+    related_id INTEGER,
+    related_type TEXT,
+    operator_id INTEGER,
+    created_at DATETIME
+);`}
+	for _, sql := range createTableSQL {
+		if err := sqliteDB.Exec(sql).Error; err != nil {
+			t.Fatalf("创建测试数据表失败: %v", err)
+		}
+	}
+
+	DB = sqliteDB
+
+	return func() {
+		sqlDB, err := DB.DB()
+		if err == nil {
+			_ = sqlDB.Close()
+		}
+	}
+}
+
+// insertModerationUser 插入状态正常、带初始信誉分的用户
+func insertModerationUser(t *testing.T, username string, reputation int64) User {
+	t.Helper()
+	user := insertUser(t, username, username+"@example.com", "hash")
+	if err := DB.Table(constants.UserTableName).Where("user_id = ?", user.UserID).
+		Updates(map[string]interface{}{"status": "active", "reputation_score": reputation}).Error; err != nil {
+		t.Fatalf("更新测试用户失败: %v", err)
+	}
+	return user
+}
+
+// insertReport 插入一条针对评论的举报审核记录
+func insertReport(t *testing.T, status string, level int, reviewerID *int64) Review {
+	t.Helper()
+	review := Review{TargetID: 1, TargetType: "comment", Reason: "违规", Status: status, Level: level, ReviewerID: reviewerID}
+	if err := DB.Table(constants.ReviewTableName).Create(&review).Error; err != nil {
+		t.Fatalf("插入审核记录失败: %v", err)
+	}
+	return review
+}
+
+func applyAction(t *testing.T, reviewID, userID, moderatorID int64, action string) (*ModerationAction, error) {
+	t.Helper()
+	m := &ModerationAction{ReviewID: reviewID, UserID: userID, ModeratorID: &moderatorID, Action: action, Reason: "违规"}
+	switch action {
+	case "deduct_reputation":
+		m.ReputationDelta = -10
+	case "mute":
+		until := time.Now().Add(24 * time.Hour)
+		m.MutedUntil = &until
+	}
+	err := ApplyModerationAction(context.Background(), m, &Notification{Type: "moderation", Title: "处罚通知"})
+	return m, err
+}
+
+func assertErrNo(t *testing.T, err error, code int64) {
+	t.Helper()
+	var e errno.ErrNo
+	if !errors.As(err, &e) || e.ErrorCode != code {
+		t.Fatalf("期望错误码 %d，实际: %v", code, err)
+	}
+}
+
+func TestApplyModerationActionRequiresApprovedReport(t *testing.T) {
+	cleanup := setupModerationTestDB(t)
+	defer cleanup()
+
+	user := insertModerationUser(t, "offender", 50)
+	moderator := insertModerationUser(t, "moderator", 0)
+
+	for _, c := range []struct {
+		status string
+		level  int
+	}{{"pending", 1}, {"rejected", 1}, {"approved", 2}} {
+		review := insertReport(t, c.status, c.level, &moderator.UserID)
+		_, err := applyAction(t, review.ReviewID, user.UserID, moderator.UserID, "warn")
+		assertErrNo(t, err, errno.ReviewNotApproved)
+	}
+
+	review := insertReport(t, "approved", 1, &moderator.UserID)
+	if _, err := applyAction(t, review.ReviewID, user.UserID, moderator.UserID, "deduct_reputation"); err != nil {
+		t.Fatalf("对已确认的举报执行处罚失败: %v", err)
+	}
+	_, err := applyAction(t, review.ReviewID, user.UserID, moderator.UserID, "deduct_reputation")
+	assertErrNo(t, err, errno.ModerationActionExist)
+	if _, err := applyAction(t, review.ReviewID, user.UserID, moderator.UserID, "mute"); err != nil {
+		t.Fatalf("同一举报执行其他类型的处罚失败: %v", err)
+	}
+
+	var got User
+	DB.Table(constants.UserTableName).Where("user_id = ?", user.UserID).First(&got)
+	if got.ReputationScore != 40 || got.MutedUntil == nil {
+		t.Fatalf("处罚效果不正确: reputation=%d muted_until=%v", got.ReputationScore, got.MutedUntil)
+	}
+}
+
+func TestAppealReviewerExcluded(t *testing.T) {
+	cleanup := setupModerationTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	user := insertModerationUser(t, "offender", 50)
+	moderator := insertModerationUser(t, "moderator", 0)
+	reviewer := insertModerationUser(t, "reviewer", 0)
+	other := insertModerationUser(t, "other", 0)
+
+	review := insertReport(t, "approved", 1, &reviewer.UserID)
+	action, err := applyAction(t, review.ReviewID, user.UserID, moderator.UserID, "warn")
+	if err != nil {
+		t.Fatalf("执行处罚失败: %v", err)
+	}
+	appealID, err := CreateAppeal(ctx, action.ActionID, user.UserID, "误判", 2, time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatalf("提起申诉失败: %v", err)
+	}
+
+	now := time.Now()
+	expiresAt := now.Add(30 * time.Minute)
+	for _, excluded := range []int64{moderator.UserID, reviewer.UserID} {
+		_, err := ClaimReview(ctx, appealID, excluded, expiresAt, now)
+		assertErrNo(t, err, errno.AppealReviewerConflict)
+
+		reviews, err := ClaimNextReviews(ctx, excluded, "", 10, 10, expiresAt, now)
+		if err != nil || len(reviews) != 0 {
+			t.Fatalf("原处罚人或原审核员不应认领到申诉: reviews=%d err=%v", len(reviews), err)
+		}
+
+		_, err = ResolveAppeal(ctx, appealID, excluded, true, &Notification{Type: "appeal", Title: "申诉结果"})
+		assertErrNo(t, err, errno.AppealReviewerConflict)
+	}
+
+	reviews, err := ClaimNextReviews(ctx, other.UserID, "", 10, 10, expiresAt, now)
+	if err != nil || len(reviews) != 1 || reviews[0].ReviewID != appealID {
+		t.Fatalf("其他审核员应当认领到申诉: reviews=%v err=%v", reviews, err)
+	}
+}
+
+func TestResolveAppealRevertsPenalty(t *testing.T) {
+	cleanup := setupModerationTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	user := insertModerationUser(t, "offender", 50)
+	moderator := insertModerationUser(t, "moderator", 0)
+	other := insertModerationUser(t, "other", 0)
+
+	review := insertReport(t, "approved", 1, &moderator.UserID)
+	var actions []*ModerationAction
+	for _, name := range []string{"deduct_reputation", "mute", "ban"} {
+		action, err := applyAction(t, review.ReviewID, user.UserID, moderator.UserID, name)
+		if err != nil {
+			t.Fatalf("执行处罚 %s 失败: %v", name, err)
+		}
+		actions = append(actions, action)
+	}
+
+	var got User
+	DB.Table(constants.UserTableName).Where("user_id = ?", user.UserID).First(&got)
+	if got.ReputationScore != 40 || got.MutedUntil == nil || got.Status != "banned" {
+		t.Fatalf("处罚效果不正确: %+v", got)
+	}
+
+	for _, action := range actions {
+		appealID, err := CreateAppeal(ctx, action.ActionID, user.UserID, "误判", 2, time.Now().Add(time.Hour))
+		if err != nil {
+			t.Fatalf("提起申诉失败: %v", err)
+		}
+		if _, err := ResolveAppeal(ctx, appealID, other.UserID, true, &Notification{Type: "appeal", Title: "申诉结果"}); err != nil {
+			t.Fatalf("处理申诉失败: %v", err)
+		}
+		reverted, err := GetModerationAction(ctx, action.ActionID)
+		if err != nil || reverted.Status != "revoked" || reverted.RevokedAt == nil {
+			t.Fatalf("申诉成功后处罚应当撤销: %+v err=%v", reverted, err)
+		}
+	}
+
+	got = User{}
+	DB.Table(constants.UserTableName).Where("user_id = ?", user.UserID).First(&got)
+	if got.ReputationScore != 50 || got.MutedUntil != nil || got.Status != "active" {
+		t.Fatalf("申诉成功后处罚效果应当恢复: reputation=%d muted_until=%v status=%s",
+			got.ReputationScore, got.MutedUntil, got.Status)
+	}
+
+	var records []ReputationRecord
+	DB.Table(constants.ReputationRecordTableName).Where("user_id = ?", user.UserID).Order("record_id").Find(&records)
+	if len(records) != 2 || records[0].EventType != ReputationPenalty || records[1].EventType != ReputationAppealRestored ||
+		records[1].ChangeScore != 10 {
+		t.Fatalf("信誉分记录不正确: %+v", records)
+	}
+}
+
+func TestClaimNextReviewsRespectsClaimLimit(t *testing.T) {
+	cleanup := setupModerationTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	reviewer := insertModerationUser(t, "reviewer", 0)
+	for i := 0; i < 4; i++ {
+		insertReport(t, "pending", 1, nil)
+	}
+
+	now := time.Now()
+	expiresAt := now.Add(30 * time.Minute)
+	reviews, err := ClaimNextReviews(ctx, reviewer.UserID, "", 2, 3, expiresAt, now)
+	if err != nil || len(reviews) != 2 {
+		t.Fatalf("首次认领应得到 2 条: reviews=%d err=%v", len(reviews), err)
+	}
+	// 已持有 2 条，上限 3 时只能再认领 1 条
+	reviews, err = ClaimNextReviews(ctx, reviewer.UserID, "", 2, 3, expiresAt, now)
+	if err != nil || len(reviews) != 1 {
+		t.Fatalf("认领数量应受上限约束: reviews=%d err=%v", len(reviews), err)
+	}
+	_, err = ClaimNextReviews(ctx, reviewer.UserID, "", 1, 3, expiresAt, now)
+	assertErrNo(t, err, errno.ReviewClaimLimitExceeded)
+
+	// 认领过期后不再计入持有数
+	later := expiresAt.Add(time.Minute)
+	reviews, err = ClaimNextReviews(ctx, reviewer.UserID, "", 10, 3, later.Add(30*time.Minute), later)
+	if err != nil || len(reviews) != 3 {
+		t.Fatalf("过期认领不应占用上限: reviews=%d err=%v", len(reviews), err)
+	}
+}
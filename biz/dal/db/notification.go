@@ -0,0 +1,61 @@
+package db
+
+import (
+	"LearnShare/pkg/constants"
+	"LearnShare/pkg/errno"
+	"context"
+
+	"gorm.io/gorm"
+)
+
+// createNotification 在给定事务中写入一条站内通知
+func createNotification(tx *gorm.DB, notification *Notification) error {
+	if err := tx.Table(constants.NotificationTableName).Create(notification).Error; err != nil {
+		return errno.NewErrNo(errno.InternalDatabaseErrorCode, "创建通知失败: "+err.Error())
+	}
+	return nil
+}
+
+// CreateNotification 写入一条站内通知
+func CreateNotification(ctx context.Context, notification *Notification) error {
+	return createNotification(DB.WithContext(ctx), notification)
+}
+
+// ListNotifications 分页查询用户的通知（按时间倒序），同时返回未读数量
+func ListNotifications(ctx context.Context, userID int64, unreadOnly bool, pageNum, pageSize int) ([]*Notification, int64, int64, error) {
+	var (
+		notifications []*Notification
+		total, unread int64
+	)
+	userNotifications := func() *gorm.DB {
+		return DB.WithContext(ctx).Table(constants.NotificationTableName).Where("user_id = ?", userID)
+	}
+	if err := userNotifications().Where("is_read = ?", false).Count(&unread).Error; err != nil {
+		return nil, 0, 0, errno.NewErrNo(errno.InternalDatabaseErrorCode, "统计未读通知失败: "+err.Error())
+	}
+
+	query := userNotifications()
+	if unreadOnly {
+		query = query.Where("is_read = ?", false)
+	}
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, 0, errno.NewErrNo(errno.InternalDatabaseErrorCode, "统计通知失败: "+err.Error())
+	}
+	err := query.Order("notification_id DESC").Limit(pageSize).Offset(pageSize * (pageNum - 1)).Find(&notifications).Error
+	if err != nil {
+		return nil, 0, 0, errno.NewErrNo(errno.InternalDatabaseErrorCode, "查询通知失败: "+err.Error())
+	}
+	return notifications, total, unread, nil
+}
+
+// MarkNotificationsRead 将用户的通知标记为已读，ids 为空时标记全部
+func MarkNotificationsRead(ctx context.Context, userID int64, ids []int64) error {
+	query := DB.WithContext(ctx).Table(constants.NotificationTableName).Where("user_id = ? AND is_read = ?", userID, false)
+	if len(ids) > 0 {
+		query = query.Where("notification_id IN ?", ids)
+	}
+	if err := query.Update("is_read", true).Error; err != nil {
+		return errno.NewErrNo(errno.InternalDatabaseErrorCode, "标记通知已读失败: "+err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package db
+
+import (
+	"LearnShare/pkg/constants"
+	"LearnShare/pkg/errno"
+	"context"
+)
+
+// GetPasswordHistory 获取用户最近 limit 次被替换掉的密码哈希，按时间倒序
+func GetPasswordHistory(ctx context.Context, userID int64, limit int) ([]string, error) {
+	var hashes []string
+	err := DB.WithContext(ctx).Table(constants.PasswordHistoryTableName).
+		Where("user_id = ?", userID).
+		Order("created_at DESC, history_id DESC").
+		Limit(limit).
+		Pluck("password_hash", &hashes).Error
+	if err != nil {
+		return nil, errno.NewErrNo(errno.InternalDatabaseErrorCode, "查询历史密码失败: "+err.Error())
+	}
+	return hashes, nil
+}
+
+// UpdateUserPasswordWithHistory 更新密码并将旧密码写入历史，历史只保留最近 keep 条
+func UpdateUserPasswordWithHistory(ctx context.Context, userID int64, oldHash, newHash string, keep int) error {
+	tx := DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := tx.Table(constants.UserTableName).Where("user_id = ?", userID).Update("password_hash", newHash).Error; err != nil {
+		tx.Rollback()
+		return errno.NewErrNo(errno.InternalDatabaseErrorCode, "更新用户密码失败: "+err.Error())
+	}
+
+	if keep > 0 && oldHash != "" {
+		history := &PasswordHistory{UserID: userID, PasswordHash: oldHash}
+		if err := tx.Table(constants.PasswordHistoryTableName).Create(history).Error; err != nil {
+			tx.Rollback()
+			return errno.NewErrNo(errno.InternalDatabaseErrorCode, "写入历史密码失败: "+err.Error())
+		}
+
+		// 删除超出保留条数的旧记录
+		var keepIDs []int64
+		err := tx.Table(constants.PasswordHistoryTableName).
+			Where("user_id = ?", userID).
+			Order("created_at DESC, history_id DESC").
+			Limit(keep).
+			Pluck("history_id", &keepIDs).Error
+		if err != nil {
+			tx.Rollback()
+			return errno.NewErrNo(errno.InternalDatabaseErrorCode, "查询历史密码失败: "+err.Error())
+		}
+		err = tx.Table(constants.PasswordHistoryTableName).
+			Where("user_id = ? AND history_id NOT IN ?", userID, keepIDs).
+			Delete(&PasswordHistory{}).Error
+		if err != nil {
+			tx.Rollback()
+			return errno.NewErrNo(errno.InternalDatabaseErrorCode, "清理历史密码失败: "+err.Error())
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return errno.NewErrNo(errno.InternalDatabaseErrorCode, "提交事务失败: "+err.Error())
+	}
+	return nil
+}
@@ -4,6 +4,10 @@ import (
 	"LearnShare/pkg/constants"
 	"LearnShare/pkg/errno"
 	"context"
+	"errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // GetRolePermissions 从数据库查询指定角色拥有的全部权限标识
@@ -141,3 +145,142 @@ func CreateRole(ctx context.Context, roleName string, permissionIds []int64) (in
 
 	return role.RoleID, nil
 }
+
+// GetRoleByID 根据角色ID查询角色，不存在时返回 nil
+func GetRoleByID(ctx context.Context, roleID int64) (*Role, error) {
+	var role Role
+	err := DB.WithContext(ctx).Table(constants.RoleTableName).Where("role_id = ?", roleID).First(&role).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, errno.NewErrNo(errno.InternalDatabaseErrorCode, "查询角色失败: "+err.Error())
+	}
+	return &role, nil
+}
+
+// UpdateRole 更新角色名称与描述，参数为 nil 时不修改对应字段
+func UpdateRole(ctx context.Context, roleID int64, roleName, description *string) error {
+	updates := make(map[string]interface{})
+	if roleName != nil {
+		updates["role_name"] = *roleName
+	}
+	if description != nil {
+		updates["description"] = *description
+	}
+	if len(updates) == 0 {
+		return nil
+	}
+
+	err := DB.WithContext(ctx).Table(constants.RoleTableName).Where("role_id = ?", roleID).Updates(updates).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return errno.RoleNameExistError
+		}
+		return errno.NewErrNo(errno.InternalDatabaseErrorCode, "更新角色失败: "+err.Error())
+	}
+	return nil
+}
+
+// GrantRolePermissions 为角色添加权限，已拥有的权限会被忽略
+func GrantRolePermissions(ctx context.Context, roleID int64, permissionIDs []int64) error {
+	if len(permissionIDs) == 0 {
+		return nil
+	}
+	rolePermissions := make([]*RolePermission, 0, len(permissionIDs))
+	for _, permissionID := range permissionIDs {
+		rolePermissions = append(rolePermissions, &RolePermission{RoleID: roleID, PermissionID: permissionID})
+	}
+
+	err := DB.WithContext(ctx).Table(constants.RolePermissionTableName).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&rolePermissions).Error
+	if err != nil {
+		return errno.NewErrNo(errno.InternalDatabaseErrorCode, "授予角色权限失败: "+err.Error())
+	}
+	return nil
+}
+
+// RevokeRolePermissions 移除角色的指定权限
+func RevokeRolePermissions(ctx context.Context, roleID int64, permissionIDs []int64) error {
+	if len(permissionIDs) == 0 {
+		return nil
+	}
+	err := DB.WithContext(ctx).Table(constants.RolePermissionTableName).
+		Where("role_id = ? AND permission_id IN ?", roleID, permissionIDs).
+		Delete(&RolePermission{}).Error
+	if err != nil {
+		return errno.NewErrNo(errno.InternalDatabaseErrorCode, "移除角色权限失败: "+err.Error())
+	}
+	return nil
+}
+
+// CountExistingPermissions 统计 permissionIDs 中实际存在的权限数量
+func CountExistingPermissions(ctx context.Context, permissionIDs []int64) (int64, error) {
+	var count int64
+	err := DB.WithContext(ctx).Table(constants.PermissionTableName).
+		Where("permission_id IN ?", permissionIDs).
+		Count(&count).Error
+	if err != nil {
+		return 0, errno.NewErrNo(errno.InternalDatabaseErrorCode, "查询权限失败: "+err.Error())
+	}
+	return count, nil
+}
+
+// DeleteRole 删除角色，并将该角色下的用户转移到 reassignRoleID，返回被转移的用户ID
+func DeleteRole(ctx context.Context, roleID, reassignRoleID int64) ([]int64, error) {
+	// 开启事务
+	tx := DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	var userIDs []int64
+	err := tx.Table(constants.UserTableName).Where("role_id = ?", roleID).Pluck("user_id", &userIDs).Error
+	if err != nil {
+		tx.Rollback()
+		return nil, errno.NewErrNo(errno.InternalDatabaseErrorCode, "查询角色用户失败: "+err.Error())
+	}
+
+	if len(userIDs) > 0 {
+		err = tx.Table(constants.UserTableName).Where("role_id = ?", roleID).Update("role_id", reassignRoleID).Error
+		if err != nil {
+			tx.Rollback()
+			return nil, errno.NewErrNo(errno.InternalDatabaseErrorCode, "转移角色用户失败: "+err.Error())
+		}
+	}
+
+	// 角色权限关联随外键级联删除
+	err = tx.Table(constants.RoleTableName).Where("role_id = ?", roleID).Delete(&Role{}).Error
+	if err != nil {
+		tx.Rollback()
+		return nil, errno.NewErrNo(errno.InternalDatabaseErrorCode, "删除角色失败: "+err.Error())
+	}
+
+	// 提交事务
+	if err := tx.Commit().Error; err != nil {
+		return nil, errno.NewErrNo(errno.InternalDatabaseErrorCode, "提交事务失败: "+err.Error())
+	}
+
+	return userIDs, nil
+}
+
+// GetUsersByRole 分页查询指定角色下的用户
+func GetUsersByRole(ctx context.Context, roleID int64, pageNum, pageSize int) ([]*User, int64, error) {
+	var (
+		users []*User
+		total int64
+	)
+	query := DB.WithContext(ctx).Table(constants.UserTableName).Where("role_id = ?", roleID)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, errno.NewErrNo(errno.InternalDatabaseErrorCode, "统计角色用户失败: "+err.Error())
+	}
+
+	err := query.Order("user_id ASC").Limit(pageSize).Offset(pageSize * (pageNum - 1)).Find(&users).Error
+	if err != nil {
+		return nil, 0, errno.NewErrNo(errno.InternalDatabaseErrorCode, "查询角色用户失败: "+err.Error())
+	}
+	return users, total, nil
+}
@@ -0,0 +1,102 @@
+package db
+
+import (
+	"LearnShare/pkg/constants"
+	"LearnShare/pkg/errno"
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// CreatePersonalAccessToken 创建个人访问令牌
+func CreatePersonalAccessToken(ctx context.Context, token *PersonalAccessToken) error {
+	err := DB.WithContext(ctx).Table(constants.PersonalAccessTokenTableName).Create(token).Error
+	if err != nil {
+		return errno.NewErrNo(errno.InternalDatabaseErrorCode, "创建个人访问令牌失败: "+err.Error())
+	}
+	return nil
+}
+
+// GetPersonalAccessTokenByHash 根据令牌哈希查询，不存在时返回 nil
+func GetPersonalAccessTokenByHash(ctx context.Context, hash string) (*PersonalAccessToken, error) {
+	var token PersonalAccessToken
+	err := DB.WithContext(ctx).Table(constants.PersonalAccessTokenTableName).
+		Where("token_hash = ?", hash).
+		First(&token).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, errno.NewErrNo(errno.InternalDatabaseErrorCode, "查询个人访问令牌失败: "+err.Error())
+	}
+	return &token, nil
+}
+
+// ListPersonalAccessTokens 查询用户未吊销的个人访问令牌
+func ListPersonalAccessTokens(ctx context.Context, userID int64) ([]*PersonalAccessToken, error) {
+	var tokens []*PersonalAccessToken
+	err := DB.WithContext(ctx).Table(constants.PersonalAccessTokenTableName).
+		Where("user_id = ? AND revoked_at IS NULL", userID).
+		Order("created_at DESC").
+		Find(&tokens).Error
+	if err != nil {
+		return nil, errno.NewErrNo(errno.InternalDatabaseErrorCode, "查询个人访问令牌列表失败: "+err.Error())
+	}
+	return tokens, nil
+}
+
+// CountPersonalAccessTokens 统计用户未吊销的个人访问令牌数量
+func CountPersonalAccessTokens(ctx context.Context, userID int64) (int64, error) {
+	var count int64
+	err := DB.WithContext(ctx).Table(constants.PersonalAccessTokenTableName).
+		Where("user_id = ? AND revoked_at IS NULL", userID).
+		Count(&count).Error
+	if err != nil {
+		return 0, errno.NewErrNo(errno.InternalDatabaseErrorCode, "统计个人访问令牌失败: "+err.Error())
+	}
+	return count, nil
+}
+
+// RevokePersonalAccessToken 吊销用户的个人访问令牌，返回是否存在可吊销的令牌
+func RevokePersonalAccessToken(ctx context.Context, userID, tokenID int64) (bool, error) {
+	result := DB.WithContext(ctx).Table(constants.PersonalAccessTokenTableName).
+		Where("token_id = ? AND user_id = ? AND revoked_at IS NULL", tokenID, userID).
+		Update("revoked_at", time.Now())
+	if result.Error != nil {
+		return false, errno.NewErrNo(errno.InternalDatabaseErrorCode, "吊销个人访问令牌失败: "+result.Error.Error())
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// RevokeUserPersonalAccessTokens 吊销用户全部未吊销的个人访问令牌，返回吊销的数量
+func RevokeUserPersonalAccessTokens(ctx context.Context, userID int64, at time.Time) (int64, error) {
+	result := DB.WithContext(ctx).Table(constants.PersonalAccessTokenTableName).
+		Where("user_id = ? AND revoked_at IS NULL", userID).
+		Update("revoked_at", at)
+	if result.Error != nil {
+		return 0, errno.NewErrNo(errno.InternalDatabaseErrorCode, "吊销用户个人访问令牌失败: "+result.Error.Error())
+	}
+	return result.RowsAffected, nil
+}
+
+// TouchPersonalAccessToken 记录令牌的最近使用时间与IP
+func TouchPersonalAccessToken(ctx context.Context, tokenID int64, ip string) error {
+	err := DB.WithContext(ctx).Table(constants.PersonalAccessTokenTableName).
+		Where("token_id = ?", tokenID).
+		Updates(map[string]interface{}{"last_used_at": time.Now(), "last_used_ip": ip}).Error
+	if err != nil {
+		return errno.NewErrNo(errno.InternalDatabaseErrorCode, "更新令牌使用记录失败: "+err.Error())
+	}
+	return nil
+}
+
+// TouchPersonalAccessTokenAsync 异步记录令牌使用情况(不等待结果)
+// 请求结束后 ctx 可能已被取消，因此使用独立的 context
+func TouchPersonalAccessTokenAsync(tokenID int64, ip string) {
+	pool := GetAsyncPool()
+	pool.SubmitNoWait(func() error {
+		return TouchPersonalAccessToken(context.Background(), tokenID, ip)
+	})
+}
@@ -0,0 +1,187 @@
+package db
+
+import (
+	"LearnShare/pkg/constants"
+	"LearnShare/pkg/errno"
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ReportStats 同一对象的举报汇总，用于计算审核优先级
+type ReportStats struct {
+	ReportCount        int64
+	ReporterReputation float64 // 举报人平均信誉分
+	Popularity         int64   // 对象热度：资源为下载与评分次数之和，评论为点赞数
+	CreatedAt          time.Time
+}
+
+// ReportOptions 举报合并策略
+// Prioritize 按汇总信息计算优先级与 SLA 截止时间；AutoHideThreshold 为自动隐藏内容的举报次数，0 表示不隐藏
+type ReportOptions struct {
+	Prioritize        func(stats *ReportStats) (int, time.Time)
+	AutoHideThreshold int64
+}
+
+// errReportRace 并发的首次举报同时创建审核记录，唯一键冲突
+var errReportRace = errors.New("report race")
+
+// ReportContent 举报内容：同一对象待处理的举报合并到一条审核记录，记录每位举报人并累计举报次数
+// 优先级只升不降，举报次数达到阈值时先隐藏内容等待审核
+func ReportContent(ctx context.Context, reporterID int64, targetType string, targetID int64, reason string, opts ReportOptions) (*Review, error) {
+	review, err := reportContent(ctx, reporterID, targetType, targetID, reason, opts)
+	if errors.Is(err, errReportRace) {
+		// 另一请求已创建待处理的审核记录，重试一次合并进去
+		review, err = reportContent(ctx, reporterID, targetType, targetID, reason, opts)
+	}
+	if errors.Is(err, errReportRace) {
+		return nil, errno.NewErrNo(errno.InternalDatabaseErrorCode, "创建举报失败: 并发冲突")
+	}
+	return review, err
+}
+
+func reportContent(ctx context.Context, reporterID int64, targetType string, targetID int64, reason string, opts ReportOptions) (*Review, error) {
+	content, ok := reviewContents[targetType]
+	if !ok {
+		return nil, errno.NewErrNo(errno.ServiceInvalidParameter, "举报对象类型无效")
+	}
+
+	var review Review
+	err := DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var target struct {
+			Popularity int64 `gorm:"column:popularity"`
+		}
+		if err := tx.Table(content.table).Select(content.popularity+" AS popularity").
+			Where(content.key+" = ?", targetID).Take(&target).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return errno.NewErrNo(errno.ErrRecordNotFound, "举报对象不存在")
+			}
+			return errno.NewErrNo(errno.InternalDatabaseErrorCode, "查询举报对象失败: "+err.Error())
+		}
+
+		created := false
+		err := tx.Table(constants.ReviewTableName).Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("target_type = ? AND target_id = ? AND level = ? AND status = ?", targetType, targetID, 1, "pending").
+			Take(&review).Error
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			review = Review{
+				TargetID:    targetID,
+				TargetType:  targetType,
+				Reason:      reason,
+				Priority:    constants.DefaultReviewPriority,
+				ReporterID:  &reporterID,
+				Level:       1,
+				ReportCount: 1,
+			}
+			if err := tx.Table(constants.ReviewTableName).Create(&review).Error; err != nil {
+				if errors.Is(err, gorm.ErrDuplicatedKey) {
+					return errReportRace
+				}
+				return errno.NewErrNo(errno.InternalDatabaseErrorCode, "创建举报失败: "+err.Error())
+			}
+			created = true
+		case err != nil:
+			return errno.NewErrNo(errno.InternalDatabaseErrorCode, "查询待处理举报失败: "+err.Error())
+		}
+
+		reporter := &ReviewReporter{ReviewID: review.ReviewID, ReporterID: reporterID, Reason: reason}
+		if err := tx.Table(constants.ReviewReporterTableName).Create(reporter).Error; err != nil {
+			if errors.Is(err, gorm.ErrDuplicatedKey) {
+				return errno.ReviewAlreadyReportedError
+			}
+			return errno.NewErrNo(errno.InternalDatabaseErrorCode, "记录举报人失败: "+err.Error())
+		}
+
+		updates := map[string]interface{}{}
+		if !created {
+			review.ReportCount++
+			updates["report_count"] = gorm.Expr("report_count + 1")
+		}
+
+		var reputation float64
+		if err := tx.Table(constants.ReviewReporterTableName+" AS r").
+			Joins("JOIN "+constants.UserTableName+" AS u ON u.user_id = r.reporter_id").
+			Where("r.review_id = ?", review.ReviewID).
+			Select("COALESCE(AVG(u.reputation_score), 0)").Scan(&reputation).Error; err != nil {
+			return errno.NewErrNo(errno.InternalDatabaseErrorCode, "统计举报人信誉分失败: "+err.Error())
+		}
+		priority, dueAt := opts.Prioritize(&ReportStats{
+			ReportCount:        review.ReportCount,
+			ReporterReputation: reputation,
+			Popularity:         target.Popularity,
+			CreatedAt:          review.CreatedAt,
+		})
+		if created || priority < review.Priority {
+			review.Priority, review.DueAt = priority, &dueAt
+			updates["priority"], updates["due_at"] = priority, dueAt
+		}
+
+		if opts.AutoHideThreshold > 0 && review.ReportCount >= opts.AutoHideThreshold && review.AutoHiddenAt == nil {
+			if err := setReportedContentHidden(tx, targetType, targetID, true); err != nil {
+				return err
+			}
+			now := time.Now()
+			review.AutoHiddenAt = &now
+			updates["auto_hidden_at"] = now
+		}
+
+		if len(updates) == 0 {
+			return nil
+		}
+		if err := tx.Table(constants.ReviewTableName).Where("review_id = ?", review.ReviewID).Updates(updates).Error; err != nil {
+			return errno.NewErrNo(errno.InternalDatabaseErrorCode, "更新举报记录失败: "+err.Error())
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &review, nil
+}
+
+// setReportedContentHidden 举报数达到阈值时暂时隐藏内容等待审核，举报被驳回后恢复
+// 只处理正常状态的内容，不影响已被删除或封禁的内容
+func setReportedContentHidden(tx *gorm.DB, targetType string, targetID int64, hidden bool) error {
+	content, ok := reviewContents[targetType]
+	if !ok {
+		return errno.NewErrNo(errno.ServiceInvalidParameter, "举报对象类型无效")
+	}
+
+	query := tx.Table(content.table).Where(content.key+" = ?", targetID)
+	var err error
+	switch targetType {
+	case "resource":
+		if hidden {
+			err = query.Where("status = ?", "normal").Update("status", "pending_review").Error
+		} else {
+			err = query.Where("status = ?", "pending_review").Update("status", "normal").Error
+		}
+	case "comment", "course_comment":
+		err = query.Where("status = ?", "normal").Update("is_visible", !hidden).Error
+	default:
+		err = query.Update("is_visible", !hidden).Error
+	}
+	if err != nil {
+		return errno.NewErrNo(errno.InternalDatabaseErrorCode, "更新被举报内容状态失败: "+err.Error())
+	}
+	return refreshRatingAggregate(tx, targetType, targetID)
+}
+
+// GetReviewReporters 查询合并到审核记录的全部举报人，按举报时间排列
+func GetReviewReporters(ctx context.Context, reviewID int64) ([]*ReviewReporter, error) {
+	if _, err := getReview(DB.WithContext(ctx), reviewID); err != nil {
+		return nil, err
+	}
+
+	var reporters []*ReviewReporter
+	err := DB.WithContext(ctx).Table(constants.ReviewReporterTableName).
+		Where("review_id = ?", reviewID).Order("created_at ASC, reporter_id ASC").Find(&reporters).Error
+	if err != nil {
+		return nil, errno.NewErrNo(errno.InternalDatabaseErrorCode, "查询举报人失败: "+err.Error())
+	}
+	return reporters, nil
+}
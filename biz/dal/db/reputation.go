@@ -0,0 +1,184 @@
+package db
+
+import (
+	"LearnShare/pkg/constants"
+	"LearnShare/pkg/errno"
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 信誉分事件类型，前三种按 reputation_rules 中的规则发放，其余由调用方指定分值
+const (
+	ReputationUploadApproved     = "upload_approved"
+	ReputationResourceDownloaded = "resource_downloaded"
+	ReputationRatingReceived     = "rating_received"
+	ReputationPenalty            = "penalty"
+	ReputationAppealRestored     = "appeal_restored"
+	ReputationManualAdjust       = "manual_adjust"
+	ReputationShopPurchase       = "shop_purchase"
+)
+
+// reputationEventCap 规则事件加分后信誉分不超过该值，处罚与手动调整不受限制
+const reputationEventCap = 100
+
+// ReputationChange 一次信誉分变动
+type ReputationChange struct {
+	UserID      int64
+	Delta       int64
+	EventType   string
+	Reason      string
+	RelatedType *string
+	RelatedID   *int64
+	OperatorID  *int64
+}
+
+// changeReputation 在事务中调整用户信誉分并写入信誉分记录，所有信誉分变动都经过这里
+func changeReputation(tx *gorm.DB, change ReputationChange) (*ReputationRecord, error) {
+	result := tx.Table(constants.UserTableName).Where("user_id = ?", change.UserID).
+		Update("reputation_score", gorm.Expr("reputation_score + ?", change.Delta))
+	if result.Error != nil {
+		return nil, errno.NewErrNo(errno.InternalDatabaseErrorCode, "更新用户信誉分失败: "+result.Error.Error())
+	}
+	if result.RowsAffected == 0 {
+		return nil, errno.NewErrNo(errno.ErrRecordNotFound, "用户不存在")
+	}
+
+	record := &ReputationRecord{
+		UserID:      change.UserID,
+		ChangeScore: change.Delta,
+		EventType:   change.EventType,
+		Reason:      change.Reason,
+		RelatedID:   change.RelatedID,
+		RelatedType: change.RelatedType,
+		OperatorID:  change.OperatorID,
+	}
+	if err := tx.Table(constants.ReputationRecordTableName).Create(record).Error; err != nil {
+		return nil, errno.NewErrNo(errno.InternalDatabaseErrorCode, "记录信誉分变动失败: "+err.Error())
+	}
+	return record, nil
+}
+
+// awardReputation 在事务中按规则为用户发放事件信誉分，返回实际变动的分数
+// 规则不存在或未启用、超过每日上限、同一对象已计分或信誉分已达上限时不发放，也不写记录
+func awardReputation(tx *gorm.DB, userID int64, eventType, relatedType string, relatedID int64) (int64, error) {
+	var rule ReputationRule
+	err := tx.Table(constants.ReputationRuleTableName).Where("event_type = ? AND enabled = ?", eventType, true).Take(&rule).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, nil
+		}
+		return 0, errno.NewErrNo(errno.InternalDatabaseErrorCode, "查询信誉分规则失败: "+err.Error())
+	}
+
+	// 锁定用户行，同一用户的并发事件依次校验上限
+	var scores []int64
+	if err := tx.Table(constants.UserTableName).Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("user_id = ?", userID).Pluck("reputation_score", &scores).Error; err != nil {
+		return 0, errno.NewErrNo(errno.InternalDatabaseErrorCode, "查询用户信誉分失败: "+err.Error())
+	}
+	if len(scores) == 0 {
+		return 0, nil
+	}
+
+	records := func() *gorm.DB {
+		return tx.Table(constants.ReputationRecordTableName).Where("user_id = ? AND event_type = ?", userID, eventType)
+	}
+	if rule.OncePerTarget {
+		var awarded int64
+		if err := records().Where("related_type = ? AND related_id = ?", relatedType, relatedID).Count(&awarded).Error; err != nil {
+			return 0, errno.NewErrNo(errno.InternalDatabaseErrorCode, "查询信誉分记录失败: "+err.Error())
+		}
+		if awarded > 0 {
+			return 0, nil
+		}
+	}
+	if rule.DailyLimit > 0 {
+		now := time.Now()
+		today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+		var count int64
+		if err := records().Where("created_at >= ?", today).Count(&count).Error; err != nil {
+			return 0, errno.NewErrNo(errno.InternalDatabaseErrorCode, "查询信誉分记录失败: "+err.Error())
+		}
+		if count >= rule.DailyLimit {
+			return 0, nil
+		}
+	}
+
+	delta := rule.Points
+	if delta > 0 && scores[0]+delta > reputationEventCap {
+		delta = reputationEventCap - scores[0]
+		if delta < 0 {
+			delta = 0
+		}
+	}
+	if delta == 0 {
+		return 0, nil
+	}
+
+	if _, err := changeReputation(tx, ReputationChange{
+		UserID:      userID,
+		Delta:       delta,
+		EventType:   eventType,
+		Reason:      rule.Description,
+		RelatedType: &relatedType,
+		RelatedID:   &relatedID,
+	}); err != nil {
+		return 0, err
+	}
+	return delta, nil
+}
+
+// AwardReputation 按规则为用户发放事件信誉分，返回实际变动的分数
+func AwardReputation(ctx context.Context, userID int64, eventType, relatedType string, relatedID int64) (int64, error) {
+	var delta int64
+	err := DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		delta, err = awardReputation(tx, userID, eventType, relatedType, relatedID)
+		return err
+	})
+	return delta, err
+}
+
+// AdjustUserReputation 手动调整用户信誉分，返回变动记录与调整后的信誉分
+func AdjustUserReputation(ctx context.Context, change ReputationChange) (*ReputationRecord, int64, error) {
+	var (
+		record *ReputationRecord
+		scores []int64
+	)
+	err := DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		if record, err = changeReputation(tx, change); err != nil {
+			return err
+		}
+		if err := tx.Table(constants.UserTableName).Where("user_id = ?", change.UserID).
+			Pluck("reputation_score", &scores).Error; err != nil {
+			return errno.NewErrNo(errno.InternalDatabaseErrorCode, "查询用户信誉分失败: "+err.Error())
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	return record, scores[0], nil
+}
+
+// ListReputationRecords 分页查询用户的信誉分变动记录，按时间倒序
+func ListReputationRecords(ctx context.Context, userID int64, pageNum, pageSize int) ([]*ReputationRecord, int64, error) {
+	var (
+		records []*ReputationRecord
+		total   int64
+	)
+	query := DB.WithContext(ctx).Table(constants.ReputationRecordTableName).Where("user_id = ?", userID)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, errno.NewErrNo(errno.InternalDatabaseErrorCode, "统计信誉分记录失败: "+err.Error())
+	}
+	err := query.Order("record_id DESC").Limit(pageSize).Offset(pageSize * (pageNum - 1)).Find(&records).Error
+	if err != nil {
+		return nil, 0, errno.NewErrNo(errno.InternalDatabaseErrorCode, "查询信誉分记录失败: "+err.Error())
+	}
+	return records, total, nil
+}
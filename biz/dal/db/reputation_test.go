@@ -0,0 +1,137 @@
+package db
+
+import (
+	"LearnShare/pkg/constants"
+	"LearnShare/pkg/errno"
+	"context"
+	"errors"
+	"testing"
+
+	"gorm.io/gorm"
+)
+
+// setupReputationTestDB 在资源审核测试数据库上补充下载（每日上限 2 次）与已停用的被评分规则
+func setupReputationTestDB(t *testing.T) func() {
+	t.Helper()
+	cleanup := setupResourceReviewTestDB(t)
+	for _, rule := range []ReputationRule{
+		{EventType: ReputationResourceDownloaded, Points: 1, DailyLimit: 2, Description: "资源被下载", Enabled: true},
+		{EventType: ReputationRatingReceived, Points: 2, Description: "资源被评分", Enabled: false},
+	} {
+		if err := DB.Table(constants.ReputationRuleTableName).Create(&rule).Error; err != nil {
+			t.Fatalf("插入信誉分规则失败: %v", err)
+		}
+	}
+	return cleanup
+}
+
+// assertLedger 校验用户信誉分与变动记录条数，且信誉分等于初始分加全部记录之和
+func assertLedger(t *testing.T, userID, initial, score, records int64) {
+	t.Helper()
+	var u User
+	if err := DB.Table(constants.UserTableName).Where("user_id = ?", userID).First(&u).Error; err != nil {
+		t.Fatalf("查询用户失败: %v", err)
+	}
+	var ledger struct {
+		Count int64
+		Sum   int64
+	}
+	DB.Table(constants.ReputationRecordTableName).Select("COUNT(*) AS count, COALESCE(SUM(change_score), 0) AS sum").
+		Where("user_id = ?", userID).Scan(&ledger)
+	if u.ReputationScore != score || ledger.Count != records || initial+ledger.Sum != u.ReputationScore {
+		t.Fatalf("信誉分与变动记录不一致: score=%d records=%d sum=%d, 期望 score=%d records=%d",
+			u.ReputationScore, ledger.Count, ledger.Sum, score, records)
+	}
+}
+
+func TestAwardReputationWritesLedgerOnce(t *testing.T) {
+	cleanup := setupReputationTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	uploader := insertModerationUser(t, "uploader", 10)
+	award := func(eventType string, relatedID int64) int64 {
+		t.Helper()
+		delta, err := AwardReputation(ctx, uploader.UserID, eventType, "resource", relatedID)
+		if err != nil {
+			t.Fatalf("发放信誉分失败: %v", err)
+		}
+		return delta
+	}
+
+	if delta := award(ReputationUploadApproved, 1); delta != 5 {
+		t.Fatalf("上传审核通过应发放 5 分: %d", delta)
+	}
+	assertLedger(t, uploader.UserID, 10, 15, 1)
+	var record ReputationRecord
+	DB.Table(constants.ReputationRecordTableName).Where("user_id = ?", uploader.UserID).First(&record)
+	if record.ChangeScore != 5 || record.EventType != ReputationUploadApproved || record.RelatedType == nil ||
+		*record.RelatedType != "resource" || record.RelatedID == nil || *record.RelatedID != 1 {
+		t.Fatalf("信誉分记录内容不正确: %+v", record)
+	}
+
+	// 同一对象的重复事件不再计分，也不写记录
+	if delta := award(ReputationUploadApproved, 1); delta != 0 {
+		t.Fatalf("同一资源不应重复计分: %d", delta)
+	}
+	assertLedger(t, uploader.UserID, 10, 15, 1)
+	award(ReputationUploadApproved, 2)
+	assertLedger(t, uploader.UserID, 10, 20, 2)
+
+	// 超过每日上限与规则停用时不计分
+	for id := int64(1); id <= 3; id++ {
+		award(ReputationResourceDownloaded, id)
+	}
+	assertLedger(t, uploader.UserID, 10, 22, 4)
+	if delta := award(ReputationRatingReceived, 1); delta != 0 {
+		t.Fatalf("停用的规则不应计分: %d", delta)
+	}
+	assertLedger(t, uploader.UserID, 10, 22, 4)
+
+	// 接近上限时只发放到上限，记录实际变动的分数；直接改分后初始分视为 98 减去已有记录之和
+	DB.Table(constants.UserTableName).Where("user_id = ?", uploader.UserID).Update("reputation_score", 98)
+	const initial = 98 - 12
+	if delta := award(ReputationUploadApproved, 3); delta != 2 {
+		t.Fatalf("信誉分应发放至上限: %d", delta)
+	}
+	assertLedger(t, uploader.UserID, initial, reputationEventCap, 5)
+	if delta := award(ReputationUploadApproved, 4); delta != 0 {
+		t.Fatalf("已达上限时不应计分: %d", delta)
+	}
+	assertLedger(t, uploader.UserID, initial, reputationEventCap, 5)
+}
+
+func TestReputationChangeSharesTransaction(t *testing.T) {
+	cleanup := setupReputationTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	user := insertModerationUser(t, "member", 10)
+
+	// 调用方事务回滚时，信誉分与变动记录一起回滚
+	err := DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if _, err := awardReputation(tx, user.UserID, ReputationUploadApproved, "resource", 1); err != nil {
+			return err
+		}
+		return errors.New("rollback")
+	})
+	if err == nil {
+		t.Fatal("事务应当返回错误")
+	}
+	assertLedger(t, user.UserID, 10, 10, 0)
+	// 回滚后同一对象仍可正常计分
+	if delta, err := AwardReputation(ctx, user.UserID, ReputationUploadApproved, "resource", 1); err != nil || delta != 5 {
+		t.Fatalf("回滚后应当可以重新计分: delta=%d err=%v", delta, err)
+	}
+	assertLedger(t, user.UserID, 10, 15, 1)
+
+	// 变动记录写入失败时信誉分也不变
+	if err := DB.Exec(`CREATE TRIGGER trg_reputation_records_fail BEFORE INSERT ON reputation_records
+BEGIN SELECT RAISE(ABORT, 'insert failed'); END;`).Error; err != nil {
+		t.Fatalf("创建触发器失败: %v", err)
+	}
+	defer DB.Exec("DROP TRIGGER trg_reputation_records_fail")
+	_, _, err = AdjustUserReputation(ctx, ReputationChange{UserID: user.UserID, Delta: -3, EventType: ReputationManualAdjust, Reason: "调整"})
+	assertErrNo(t, err, errno.InternalDatabaseErrorCode)
+	assertLedger(t, user.UserID, 10, 15, 1)
+}
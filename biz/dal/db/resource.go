@@ -5,7 +5,6 @@ import (
 	"LearnShare/pkg/errno"
 	"context"
 	"errors"
-	"fmt"
 	"time"
 
 	"gorm.io/gorm"
@@ -19,7 +18,7 @@ func SearchResources(ctx context.Context, keyword *string, tagID, courseID *int6
 	var resources []*Resource
 	var total int64
 
-	db := DB.WithContext(ctxWithTimeout).Table(constants.ResourceTableName)
+	db := DB.WithContext(ctxWithTimeout).Table(constants.ResourceTableName).Where(constants.ResourceTableName + ".deleted_at IS NULL")
 
 	if keyword != nil && *keyword != "" {
 		db = db.Where("resource_name LIKE ? OR description LIKE ?", "%"+*keyword+"%", "%"+*keyword+"%")
@@ -234,6 +233,19 @@ func SubmitResourceRating(ctx context.Context, userID, resourceID int64, recomme
 		return nil, errno.NewErrNo(errno.InternalDatabaseErrorCode, "更新资源评分信息失败: "+err.Error())
 	}
 
+	// 资源上传者获得信誉分，给自己的资源评分不计
+	var uploaderIDs []int64
+	if err = tx.Table(constants.ResourceTableName).Where("resource_id = ?", resourceID).Limit(1).Pluck("uploader_id", &uploaderIDs).Error; err != nil {
+		tx.Rollback()
+		return nil, errno.NewErrNo(errno.InternalDatabaseErrorCode, "查询资源上传者失败: "+err.Error())
+	}
+	if len(uploaderIDs) > 0 && uploaderIDs[0] != userID {
+		if _, err = awardReputation(tx, uploaderIDs[0], ReputationRatingReceived, "rating", rating.RatingID); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+
 	// 提交事务
 	if err := tx.Commit().Error; err != nil {
 		tx.Rollback()
@@ -392,8 +404,9 @@ func DeleteResourceComment(ctx context.Context, commentID, userID int64) error {
 		}
 	}()
 
-	// 直接删除评论，确保用户只能删除自己的评论
-	result := tx.Table(constants.ResourceCommentTableName).Where("comment_id = ? AND user_id = ?", commentID, userID).Delete(&ResourceComment{})
+	// 标记为用户删除，确保用户只能删除自己的评论；保留期内管理员可恢复
+	result := tx.Table(constants.ResourceCommentTableName).Where("comment_id = ? AND user_id = ? AND status = ?", commentID, userID, "normal").
+		Updates(commentDeletedUpdates("deleted_by_user"))
 	if result.Error != nil {
 		tx.Rollback()
 		return errno.NewErrNo(errno.InternalDatabaseErrorCode, "删除评论失败: "+result.Error.Error())
@@ -443,7 +456,7 @@ func AdminDeleteResourceComment(ctx context.Context, commentID int64) error {
 		return errno.NewErrNo(errno.InternalDatabaseErrorCode, "查询评论失败: "+err.Error())
 	}
 
-	if err := tx.Table(constants.ResourceCommentTableName).Where("comment_id = ?", commentID).Delete(&ResourceComment{}).Error; err != nil {
+	if err := tx.Table(constants.ResourceCommentTableName).Where("comment_id = ?", commentID).Updates(commentDeletedUpdates("deleted_by_admin")).Error; err != nil {
 		tx.Rollback()
 		return errno.NewErrNo(errno.InternalDatabaseErrorCode, "删除评论失败: "+err.Error())
 	}
@@ -511,7 +524,7 @@ func AdminDeleteResourceRating(ctx context.Context, ratingID int64) error {
 	return nil
 }
 
-// AdminDeleteResource 管理员硬删除资源，并清理关联引用
+// AdminDeleteResource 管理员删除资源（软删除），文件与收藏等关联数据保留到清理任务彻底删除时
 func AdminDeleteResource(ctx context.Context, resourceID int64) error {
 	ctxWithTimeout, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
@@ -538,12 +551,6 @@ func AdminDeleteResource(ctx context.Context, resourceID int64) error {
 		return errno.NewErrNo(errno.InternalDatabaseErrorCode, "删除资源失败: "+err.Error())
 	}
 
-	// 清理 favorites 的孤儿记录（非外键约束，需要手动清理）
-	if err := tx.Table(constants.FavoriteTableName).Where("target_type = ? AND target_id = ?", "resource", resourceID).Delete(nil).Error; err != nil {
-		tx.Rollback()
-		return errno.NewErrNo(errno.InternalDatabaseErrorCode, fmt.Sprintf("清理收藏引用失败: %v", err))
-	}
-
 	if err := tx.Commit().Error; err != nil {
 		tx.Rollback()
 		return errno.NewErrNo(errno.InternalDatabaseErrorCode, "提交删除事务失败: "+err.Error())
@@ -552,31 +559,6 @@ func AdminDeleteResource(ctx context.Context, resourceID int64) error {
 	return nil
 }
 
-// CreateReview 创建一个新的举报（审核）
-func CreateReview(ctx context.Context, creatorID int64, targetID int64, targetType, reason string) error {
-	review := &Review{
-		TargetID:   targetID,
-		TargetType: targetType,
-		Reason:     reason,
-		ReviewerID: &creatorID, // 使用 creatorID
-	}
-
-	result := DB.WithContext(ctx).Table(constants.ReviewTableName).Create(review)
-	if result.Error != nil {
-		return errno.NewErrNo(errno.InternalDatabaseErrorCode, "创建举报失败: "+result.Error.Error())
-	}
-
-	return nil
-}
-
-// CreateReviewAsync 异步创建举报
-func CreateReviewAsync(ctx context.Context, creatorID int64, targetID int64, targetType, reason string) chan error {
-	pool := GetAsyncPool()
-	return pool.Submit(func() error {
-		return CreateReview(ctx, creatorID, targetID, targetType, reason)
-	})
-}
-
 // GetOrCreateTag 获取或创建单个标签
 func GetOrCreateTag(ctx context.Context, tagName string) (*ResourceTag, error) {
 	var tag ResourceTag
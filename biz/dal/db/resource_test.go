@@ -33,7 +33,8 @@ CREATE TABLE IF NOT EXISTS resources (
     average_rating REAL DEFAULT 0.0,
     rating_count INTEGER DEFAULT 0,
     status TEXT DEFAULT 'pending_review',
-    created_at DATETIME
+    created_at DATETIME,
+    deleted_at DATETIME
 );
 `
 
@@ -67,7 +68,8 @@ CREATE TABLE IF NOT EXISTS resource_comments (
     likes INTEGER DEFAULT 0,
     is_visible INTEGER DEFAULT 1,
     status TEXT DEFAULT 'normal',
-    created_at DATETIME
+    created_at DATETIME,
+    deleted_at DATETIME
 );
 `
 
@@ -96,6 +98,8 @@ CREATE TABLE IF NOT EXISTS users (
     reputation_score INTEGER DEFAULT 0,
     role_id INTEGER,
     status TEXT,
+    muted_until DATETIME,
+    activation_pending_at DATETIME,
     created_at DATETIME,
     updated_at DATETIME
 );
@@ -110,10 +114,25 @@ CREATE TABLE IF NOT EXISTS reviews (
     reason TEXT NOT NULL,
     status TEXT DEFAULT 'pending',
     priority INTEGER DEFAULT 3,
+    level INTEGER DEFAULT 1,
+    reporter_id INTEGER,
     reviewer_id INTEGER,
     reviewed_at DATETIME,
+    claimed_by INTEGER,
+    claim_expires_at DATETIME,
+    due_at DATETIME,
+    escalated_at DATETIME,
+    report_count INTEGER DEFAULT 1,
+    auto_hidden_at DATETIME,
     created_at DATETIME
 );
+CREATE TABLE IF NOT EXISTS review_reporters (
+    review_id INTEGER NOT NULL,
+    reporter_id INTEGER NOT NULL,
+    reason TEXT NOT NULL,
+    created_at DATETIME,
+    PRIMARY KEY (review_id, reporter_id)
+);
 `
 
 	tables := []string{
@@ -545,26 +564,42 @@ func TestDeleteResourceComment(t *testing.T) {
 	})
 }
 
-func TestCreateReview(t *testing.T) {
+func TestReportContent(t *testing.T) {
 	cleanup := setupResourceTestDB(t)
 	defer cleanup()
 
 	ctx := context.Background()
+	opts := ReportOptions{
+		Prioritize: func(stats *ReportStats) (int, time.Time) {
+			return 3, stats.CreatedAt.Add(48 * time.Hour)
+		},
+		AutoHideThreshold: 2,
+	}
+	resource := seedResource(t, "线性代数笔记", "期末复习", 1)
 
-	t.Run("创建举报成功", func(t *testing.T) {
-		err := CreateReview(ctx, 1, 100, "resource", "违规内容")
+	t.Run("同一资源的举报合并到一条审核", func(t *testing.T) {
+		review, err := ReportContent(ctx, 1, "resource", resource.ResourceID, "违规内容", opts)
 		if err != nil {
 			t.Fatalf("创建举报失败: %v", err)
 		}
-
-		// 验证举报记录已创建
-		var review Review
-		err = DB.WithContext(ctx).Table(constants.ReviewTableName).Where("target_id = ? AND target_type = ?", 100, "resource").First(&review).Error
+		merged, err := ReportContent(ctx, 2, "resource", resource.ResourceID, "内容抄袭", opts)
 		if err != nil {
-			t.Fatalf("查询举报记录失败: %v", err)
+			t.Fatalf("合并举报失败: %v", err)
+		}
+		if merged.ReviewID != review.ReviewID {
+			t.Fatalf("预期合并到审核 %d，实际为 %d", review.ReviewID, merged.ReviewID)
+		}
+		if merged.ReportCount != 2 {
+			t.Fatalf("预期举报次数为2，实际为 %d", merged.ReportCount)
 		}
-		if review.Reason != "违规内容" {
-			t.Fatalf("预期举报原因为'违规内容'，实际为 %s", review.Reason)
+		if merged.AutoHiddenAt == nil {
+			t.Fatalf("预期举报次数达到阈值后自动隐藏内容")
+		}
+	})
+
+	t.Run("重复举报", func(t *testing.T) {
+		if _, err := ReportContent(ctx, 1, "resource", resource.ResourceID, "违规内容", opts); err == nil {
+			t.Fatalf("预期重复举报返回错误")
 		}
 	})
 }
@@ -4,13 +4,12 @@ import (
 	"LearnShare/pkg/constants"
 	"LearnShare/pkg/errno"
 	"context"
-	"errors"
 	"time"
 
 	"gorm.io/gorm"
 )
 
-// GetPendingResourceReviews 获取待审核的资源举报列表
+// GetPendingResourceReviews 获取待审核的资源举报列表，按审核队列顺序排列
 func GetPendingResourceReviews(ctx context.Context, pageNum, pageSize int) ([]*Review, error) {
 	// 添加超时控制
 	ctxWithTimeout, cancel := context.WithTimeout(ctx, 5*time.Second)
@@ -19,7 +18,7 @@ func GetPendingResourceReviews(ctx context.Context, pageNum, pageSize int) ([]*R
 	var reviews []*Review
 	err := DB.WithContext(ctxWithTimeout).Table(constants.ReviewTableName).
 		Where("target_type = ? AND status = ?", "resource", "pending").
-		Order("created_at desc").
+		Order(reviewQueueOrder).
 		Offset((pageNum - 1) * pageSize).
 		Limit(pageSize).
 		Find(&reviews).Error
@@ -43,34 +42,54 @@ func CountPendingResourceReviews(ctx context.Context) (int64, error) {
 	return total, nil
 }
 
-// AuditResourceReview 审核资源举报记录
+// AuditResourceReview 审核资源举报记录：approve 封禁资源，reject 恢复资源为正常状态
 func AuditResourceReview(ctx context.Context, reviewID, reviewerID int64, action string) error {
-	// 开始事务
-	tx := DB.WithContext(ctx).Begin()
-	defer func() {
-		if r := recover(); r != nil {
-			tx.Rollback()
-		}
-	}()
-
-	// 查询举报记录
-	var review Review
-	if err := tx.Table(constants.ReviewTableName).Where("review_id = ?", reviewID).First(&review).Error; err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			tx.Rollback()
-			return errno.NewErrNo(errno.InternalDatabaseErrorCode, "记录未找到")
-		}
-		tx.Rollback()
-		return errno.NewErrNo(errno.InternalDatabaseErrorCode, "查询举报记录失败: "+err.Error())
+	return AuditContentReview(ctx, reviewID, reviewerID, "resource", action)
+}
+
+// AuditResourceCommentReview 审核资源评论举报：approve 删除评论，reject 恢复因举报数达到阈值而自动隐藏的评论
+func AuditResourceCommentReview(ctx context.Context, reviewID, reviewerID int64, action string) error {
+	return AuditContentReview(ctx, reviewID, reviewerID, "comment", action)
+}
+
+func GetPendingCommentReviews(ctx context.Context, pageNum, pageSize int) ([]*Review, error) {
+	ctxWithTimeout, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	var reviews []*Review
+	err := DB.WithContext(ctxWithTimeout).Table(constants.ReviewTableName).
+		Where("target_type = ? AND status = ?", "comment", "pending").
+		Order(reviewQueueOrder).
+		Offset((pageNum - 1) * pageSize).
+		Limit(pageSize).
+		Find(&reviews).Error
+	if err != nil {
+		return nil, errno.NewErrNo(errno.InternalDatabaseErrorCode, "查询待审核资源评论的审核记录失败: "+err.Error())
 	}
+	return reviews, nil
+}
 
-	// 校验举报目标类型
-	if review.TargetType != "resource" {
-		tx.Rollback()
-		return errno.NewErrNo(errno.InternalDatabaseErrorCode, "举报类型不匹配")
+// GetPendingReviewsByType 获取指定对象类型的待审核举报，按审核队列顺序排列
+func GetPendingReviewsByType(ctx context.Context, targetType string, pageNum, pageSize int) ([]*Review, error) {
+	ctxWithTimeout, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	var reviews []*Review
+	err := DB.WithContext(ctxWithTimeout).Table(constants.ReviewTableName).
+		Where("target_type = ? AND level = ? AND status = ?", targetType, 1, "pending").
+		Order(reviewQueueOrder).
+		Offset((pageNum - 1) * pageSize).
+		Limit(pageSize).
+		Find(&reviews).Error
+	if err != nil {
+		return nil, errno.NewErrNo(errno.InternalDatabaseErrorCode, "查询待审核举报列表失败: "+err.Error())
 	}
+	return reviews, nil
+}
 
-	// 计算更新后的状态
+// AuditContentReview 审核一条举报，单条审核与批量审核都经过这里
+// targetType 为空时不限对象类型，否则须与举报记录一致
+func AuditContentReview(ctx context.Context, reviewID, reviewerID int64, targetType, action string) error {
 	var newStatus string
 	switch action {
 	case "approve":
@@ -78,119 +97,69 @@ func AuditResourceReview(ctx context.Context, reviewID, reviewerID int64, action
 	case "reject":
 		newStatus = "rejected"
 	default:
-		tx.Rollback()
-		return errno.NewErrNo(errno.InternalDatabaseErrorCode, "操作类型无效")
-	}
-
-	// 更新举报状态
-	now := time.Now()
-	if err := tx.Table(constants.ReviewTableName).Where("review_id = ?", reviewID).Updates(map[string]interface{}{
-		"status":      newStatus,
-		"reviewer_id": reviewerID,
-		"reviewed_at": now,
-	}).Error; err != nil {
-		tx.Rollback()
-		return errno.NewErrNo(errno.InternalDatabaseErrorCode, "更新举报状态失败: "+err.Error())
+		return errno.NewErrNo(errno.ServiceInvalidParameter, "操作类型无效")
 	}
 
-	// 根据审核结果更新资源状态
-	if newStatus == "approved" {
-		if err := tx.Table(constants.ResourceTableName).Where("resource_id = ?", review.TargetID).
-			Update("status", "banned").Error; err != nil {
-			tx.Rollback()
-			return errno.NewErrNo(errno.InternalDatabaseErrorCode, "更新资源状态失败: "+err.Error())
+	return DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 锁定举报记录，已处理或被其他审核员认领时不允许审核
+		now := time.Now()
+		review, err := lockReviewForAudit(tx, reviewID, reviewerID, now)
+		if err != nil {
+			return err
 		}
-	} else if newStatus == "rejected" {
-		if err := tx.Table(constants.ResourceTableName).Where("resource_id = ?", review.TargetID).
-			Update("status", "normal").Error; err != nil {
-			tx.Rollback()
-			return errno.NewErrNo(errno.InternalDatabaseErrorCode, "更新资源状态失败: "+err.Error())
+		if review.Level != 1 || (targetType != "" && review.TargetType != targetType) {
+			return errno.NewErrNo(errno.InternalDatabaseErrorCode, "举报类型不匹配")
 		}
-	}
 
-	// 提交事务
-	if err := tx.Commit().Error; err != nil {
-		tx.Rollback()
-		return errno.NewErrNo(errno.InternalDatabaseErrorCode, "提交审核事务失败: "+err.Error())
-	}
-	return nil
+		// 更新举报状态，同时释放认领
+		if err := tx.Table(constants.ReviewTableName).Where("review_id = ?", reviewID).Updates(map[string]interface{}{
+			"status":           newStatus,
+			"reviewer_id":      reviewerID,
+			"reviewed_at":      now,
+			"claimed_by":       nil,
+			"claim_expires_at": nil,
+		}).Error; err != nil {
+			return errno.NewErrNo(errno.InternalDatabaseErrorCode, "更新举报状态失败: "+err.Error())
+		}
+		return applyReviewDecision(tx, review, newStatus == "approved")
+	})
 }
 
-func AuditResourceCommentReview(ctx context.Context, reviewID, reviewerID int64, action string) error {
-	tx := DB.WithContext(ctx).Begin()
-	defer func() {
-		if r := recover(); r != nil {
-			tx.Rollback()
+// applyReviewDecision 按审核结果处理被举报内容
+// 资源：确认违规时封禁，驳回时恢复正常，上传时被内容过滤拦下的资源此时才算审核通过并为上传者发放信誉分；
+// 评分与评论：确认违规时隐藏（资源评分同时重新计算平均分），驳回时恢复因举报数达到阈值而自动隐藏的内容
+func applyReviewDecision(tx *gorm.DB, review *Review, approved bool) error {
+	if review.TargetType == "resource" {
+		status := "normal"
+		if approved {
+			status = "banned"
 		}
-	}()
-
-	var review Review
-	if err := tx.Table(constants.ReviewTableName).Where("review_id = ?", reviewID).First(&review).Error; err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			tx.Rollback()
-			return errno.NewErrNo(errno.InternalDatabaseErrorCode, "记录未找到")
+		if err := tx.Table(constants.ResourceTableName).Where("resource_id = ?", review.TargetID).
+			Update("status", status).Error; err != nil {
+			return errno.NewErrNo(errno.InternalDatabaseErrorCode, "更新资源状态失败: "+err.Error())
 		}
-		tx.Rollback()
-		return errno.NewErrNo(errno.InternalDatabaseErrorCode, "查询举报记录失败: "+err.Error())
-	}
-
-	if review.TargetType != "comment" {
-		tx.Rollback()
-		return errno.NewErrNo(errno.InternalDatabaseErrorCode, "举报类型不匹配")
-	}
-
-	var newStatus string
-	switch action {
-	case "approve":
-		newStatus = "approved"
-	case "reject":
-		newStatus = "rejected"
-	default:
-		tx.Rollback()
-		return errno.NewErrNo(errno.InternalDatabaseErrorCode, "操作类型无效")
-	}
-
-	now := time.Now()
-	if err := tx.Table(constants.ReviewTableName).Where("review_id = ?", reviewID).Updates(map[string]interface{}{
-		"status":      newStatus,
-		"reviewer_id": reviewerID,
-		"reviewed_at": now,
-	}).Error; err != nil {
-		tx.Rollback()
-		return errno.NewErrNo(errno.InternalDatabaseErrorCode, "更新举报状态失败: "+err.Error())
-	}
-
-	if newStatus == "approved" {
-		if err := tx.Table(constants.ResourceCommentTableName).Where("comment_id = ?", review.TargetID).
-			Updates(map[string]interface{}{
-				"status":     "deleted_by_admin",
-				"is_visible": false,
-			}).Error; err != nil {
-			tx.Rollback()
-			return errno.NewErrNo(errno.InternalDatabaseErrorCode, "更新资源评论状态失败: "+err.Error())
+		// 只有内容过滤提交（没有举报人）且上传时已隐藏的审核才是待发布的上传，
+		// 驳回用户举报不发放信誉分，未隐藏的资源已在上传时发放过
+		if approved || review.ReporterID != nil || review.AutoHiddenAt == nil {
+			return nil
 		}
+		// 资源审核通过后上传者获得信誉分，同一资源只计一次
+		var uploaderIDs []int64
+		if err := tx.Table(constants.ResourceTableName).Where("resource_id = ?", review.TargetID).
+			Limit(1).Pluck("uploader_id", &uploaderIDs).Error; err != nil {
+			return errno.NewErrNo(errno.InternalDatabaseErrorCode, "查询资源上传者失败: "+err.Error())
+		}
+		if len(uploaderIDs) == 0 {
+			return nil
+		}
+		_, err := awardReputation(tx, uploaderIDs[0], ReputationUploadApproved, "resource", review.TargetID)
+		return err
 	}
-
-	if err := tx.Commit().Error; err != nil {
-		tx.Rollback()
-		return errno.NewErrNo(errno.InternalDatabaseErrorCode, "提交审核事务失败: "+err.Error())
+	if approved {
+		return setContentVisible(tx, review.TargetType, review.TargetID, false)
 	}
-	return nil
-}
-
-func GetPendingCommentReviews(ctx context.Context, pageNum, pageSize int) ([]*Review, error) {
-	ctxWithTimeout, cancel := context.WithTimeout(ctx, 5*time.Second)
-	defer cancel()
-
-	var reviews []*Review
-	err := DB.WithContext(ctxWithTimeout).Table(constants.ReviewTableName).
-		Where("target_type = ? AND status = ?", "comment", "pending").
-		Order("created_at desc").
-		Offset((pageNum - 1) * pageSize).
-		Limit(pageSize).
-		Find(&reviews).Error
-	if err != nil {
-		return nil, errno.NewErrNo(errno.InternalDatabaseErrorCode, "查询待审核资源评论的审核记录失败: "+err.Error())
+	if review.AutoHiddenAt != nil {
+		return setReportedContentHidden(tx, review.TargetType, review.TargetID, false)
 	}
-	return reviews, nil
+	return nil
 }
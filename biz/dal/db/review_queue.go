@@ -0,0 +1,244 @@
+package db
+
+import (
+	"LearnShare/pkg/constants"
+	"LearnShare/pkg/errno"
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// reviewQueueOrder 审核队列顺序：已升级的优先，其次按优先级（1 最高）与 SLA 截止时间
+const reviewQueueOrder = "escalated_at IS NULL, priority ASC, due_at ASC, review_id ASC"
+
+// claimableReview 待审核且未被认领（或认领已过期）的审核记录
+func claimableReview(query *gorm.DB, now time.Time) *gorm.DB {
+	return query.Where("status = ? AND (claimed_by IS NULL OR claim_expires_at <= ?)", "pending", now)
+}
+
+// ReviewQueueStats 审核队列概况
+type ReviewQueueStats struct {
+	Pending         int64      `gorm:"column:pending"`
+	Unclaimed       int64      `gorm:"column:unclaimed"`
+	Claimed         int64      `gorm:"column:claimed"`
+	Overdue         int64      `gorm:"column:overdue"`
+	Escalated       int64      `gorm:"column:escalated"`
+	OldestPendingAt *time.Time `gorm:"column:oldest_pending_at"`
+}
+
+// ClaimNextReviews 按队列顺序认领最多 count 条可认领的审核记录，认领后持有数不超过 maxClaims，targetType 为空时不限类型
+// 先锁定审核员的用户行及其持有的认领再计数，同一审核员的并发认领串行执行；
+// 候选记录通过 FOR UPDATE SKIP LOCKED 锁定，并发认领的审核员不会拿到同一条记录
+func ClaimNextReviews(ctx context.Context, reviewerID int64, targetType string, count int, maxClaims int64, expiresAt, now time.Time) ([]*Review, error) {
+	var reviews []*Review
+	err := DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 审核员未持有认领时没有可锁定的审核记录，以用户行作为串行点
+		var locked []int64
+		if err := tx.Table(constants.UserTableName).Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("user_id = ?", reviewerID).Pluck("user_id", &locked).Error; err != nil {
+			return errno.NewErrNo(errno.InternalDatabaseErrorCode, "锁定审核员失败: "+err.Error())
+		}
+		var held []int64
+		if err := tx.Table(constants.ReviewTableName).Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("claimed_by = ? AND status = ? AND claim_expires_at > ?", reviewerID, "pending", now).
+			Pluck("review_id", &held).Error; err != nil {
+			return errno.NewErrNo(errno.InternalDatabaseErrorCode, "统计审核认领数量失败: "+err.Error())
+		}
+		limit := min(int64(count), maxClaims-int64(len(held)))
+		if limit <= 0 {
+			return errno.ReviewClaimLimitExceededError
+		}
+
+		var ids []int64
+		query := claimableReview(tx.Table(constants.ReviewTableName), now).Where(appealReviewerExcluded, reviewerID, reviewerID)
+		if targetType != "" {
+			query = query.Where("target_type = ?", targetType)
+		}
+		err := query.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Order(reviewQueueOrder).Limit(int(limit)).Pluck("review_id", &ids).Error
+		if err != nil {
+			return errno.NewErrNo(errno.InternalDatabaseErrorCode, "查询待认领审核记录失败: "+err.Error())
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+
+		if err := tx.Table(constants.ReviewTableName).Where("review_id IN ?", ids).Updates(map[string]interface{}{
+			"claimed_by":       reviewerID,
+			"claim_expires_at": expiresAt,
+		}).Error; err != nil {
+			return errno.NewErrNo(errno.InternalDatabaseErrorCode, "认领审核记录失败: "+err.Error())
+		}
+		if err := tx.Table(constants.ReviewTableName).Where("review_id IN ?", ids).
+			Order(reviewQueueOrder).Find(&reviews).Error; err != nil {
+			return errno.NewErrNo(errno.InternalDatabaseErrorCode, "查询审核记录失败: "+err.Error())
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return reviews, nil
+}
+
+// ClaimReview 认领指定审核记录，已由本人认领时刷新租约
+// 以带条件的单条 UPDATE 完成抢占，未更新到记录时再区分不存在、已处理与被他人认领
+func ClaimReview(ctx context.Context, reviewID, reviewerID int64, expiresAt, now time.Time) (*Review, error) {
+	review, err := getReview(DB.WithContext(ctx), reviewID)
+	if err != nil {
+		return nil, err
+	}
+	if review.Level == 2 {
+		if err := checkAppealReviewer(DB.WithContext(ctx), review.TargetID, reviewerID); err != nil {
+			return nil, err
+		}
+	}
+
+	result := DB.WithContext(ctx).Table(constants.ReviewTableName).
+		Where("review_id = ? AND status = ?", reviewID, "pending").
+		Where("claimed_by IS NULL OR claimed_by = ? OR claim_expires_at <= ?", reviewerID, now).
+		Updates(map[string]interface{}{
+			"claimed_by":       reviewerID,
+			"claim_expires_at": expiresAt,
+		})
+	if result.Error != nil {
+		return nil, errno.NewErrNo(errno.InternalDatabaseErrorCode, "认领审核记录失败: "+result.Error.Error())
+	}
+
+	review, err = getReview(DB.WithContext(ctx), reviewID)
+	if err != nil {
+		return nil, err
+	}
+	if result.RowsAffected == 0 {
+		if review.Status != "pending" {
+			return nil, errno.ReviewAlreadyHandledError
+		}
+		return nil, errno.ReviewClaimedByOtherError
+	}
+	return review, nil
+}
+
+// ReleaseReviewClaim 释放本人持有的认领
+func ReleaseReviewClaim(ctx context.Context, reviewID, reviewerID int64) error {
+	result := DB.WithContext(ctx).Table(constants.ReviewTableName).
+		Where("review_id = ? AND status = ? AND claimed_by = ?", reviewID, "pending", reviewerID).
+		Updates(map[string]interface{}{
+			"claimed_by":       nil,
+			"claim_expires_at": nil,
+		})
+	if result.Error != nil {
+		return errno.NewErrNo(errno.InternalDatabaseErrorCode, "释放审核认领失败: "+result.Error.Error())
+	}
+	if result.RowsAffected == 0 {
+		if _, err := getReview(DB.WithContext(ctx), reviewID); err != nil {
+			return err
+		}
+		return errno.ReviewClaimNotHeldError
+	}
+	return nil
+}
+
+// CountActiveReviewClaims 统计审核员当前持有且未过期的认领数量
+func CountActiveReviewClaims(ctx context.Context, reviewerID int64, now time.Time) (int64, error) {
+	var count int64
+	err := DB.WithContext(ctx).Table(constants.ReviewTableName).
+		Where("claimed_by = ? AND status = ? AND claim_expires_at > ?", reviewerID, "pending", now).
+		Count(&count).Error
+	if err != nil {
+		return 0, errno.NewErrNo(errno.InternalDatabaseErrorCode, "统计审核认领数量失败: "+err.Error())
+	}
+	return count, nil
+}
+
+// ListReviewerInbox 查询审核员当前持有且未过期的认领，按队列顺序
+func ListReviewerInbox(ctx context.Context, reviewerID int64, now time.Time) ([]*Review, error) {
+	var reviews []*Review
+	err := DB.WithContext(ctx).Table(constants.ReviewTableName).
+		Where("claimed_by = ? AND status = ? AND claim_expires_at > ?", reviewerID, "pending", now).
+		Order(reviewQueueOrder).Find(&reviews).Error
+	if err != nil {
+		return nil, errno.NewErrNo(errno.InternalDatabaseErrorCode, "查询审核收件箱失败: "+err.Error())
+	}
+	return reviews, nil
+}
+
+// ReleaseExpiredReviewClaims 清除已过期的认领，返回释放的数量
+func ReleaseExpiredReviewClaims(ctx context.Context, now time.Time) (int64, error) {
+	result := DB.WithContext(ctx).Table(constants.ReviewTableName).
+		Where("status = ? AND claimed_by IS NOT NULL AND claim_expires_at <= ?", "pending", now).
+		Updates(map[string]interface{}{
+			"claimed_by":       nil,
+			"claim_expires_at": nil,
+		})
+	if result.Error != nil {
+		return 0, errno.NewErrNo(errno.InternalDatabaseErrorCode, "释放过期审核认领失败: "+result.Error.Error())
+	}
+	return result.RowsAffected, nil
+}
+
+// EscalateOverdueReviews 将超过 SLA 截止时间仍未处理的审核记录标记为已升级，返回本次升级的数量
+func EscalateOverdueReviews(ctx context.Context, now time.Time) (int64, error) {
+	result := DB.WithContext(ctx).Table(constants.ReviewTableName).
+		Where("status = ? AND escalated_at IS NULL AND due_at <= ?", "pending", now).
+		Update("escalated_at", now)
+	if result.Error != nil {
+		return 0, errno.NewErrNo(errno.InternalDatabaseErrorCode, "升级超时审核记录失败: "+result.Error.Error())
+	}
+	return result.RowsAffected, nil
+}
+
+// GetReviewQueueStats 统计审核队列概况，targetType 为空时不限类型
+func GetReviewQueueStats(ctx context.Context, targetType string, now time.Time) (*ReviewQueueStats, error) {
+	var stats ReviewQueueStats
+	query := DB.WithContext(ctx).Table(constants.ReviewTableName).
+		Select(`COUNT(*) AS pending,
+			COALESCE(SUM(claimed_by IS NULL OR claim_expires_at <= ?), 0) AS unclaimed,
+			COALESCE(SUM(claimed_by IS NOT NULL AND claim_expires_at > ?), 0) AS claimed,
+			COALESCE(SUM(due_at <= ?), 0) AS overdue,
+			COALESCE(SUM(escalated_at IS NOT NULL), 0) AS escalated,
+			MIN(created_at) AS oldest_pending_at`, now, now, now).
+		Where("status = ?", "pending")
+	if targetType != "" {
+		query = query.Where("target_type = ?", targetType)
+	}
+	if err := query.Scan(&stats).Error; err != nil {
+		return nil, errno.NewErrNo(errno.InternalDatabaseErrorCode, "统计审核队列失败: "+err.Error())
+	}
+	return &stats, nil
+}
+
+// GetReviewByID 查询审核记录，不存在时返回 ReviewNotFoundError
+func GetReviewByID(ctx context.Context, reviewID int64) (*Review, error) {
+	return getReview(DB.WithContext(ctx), reviewID)
+}
+
+// getReview 查询审核记录，不存在时返回 ReviewNotFoundError
+func getReview(tx *gorm.DB, reviewID int64) (*Review, error) {
+	var review Review
+	if err := tx.Table(constants.ReviewTableName).Where("review_id = ?", reviewID).First(&review).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errno.ReviewNotFoundError
+		}
+		return nil, errno.NewErrNo(errno.InternalDatabaseErrorCode, "查询审核记录失败: "+err.Error())
+	}
+	return &review, nil
+}
+
+// lockReviewForAudit 在审核事务中锁定审核记录，校验其仍待处理且未被他人持有有效认领
+func lockReviewForAudit(tx *gorm.DB, reviewID, reviewerID int64, now time.Time) (*Review, error) {
+	review, err := getReview(tx.Clauses(clause.Locking{Strength: "UPDATE"}), reviewID)
+	if err != nil {
+		return nil, err
+	}
+	if review.Status != "pending" {
+		return nil, errno.ReviewAlreadyHandledError
+	}
+	if review.ClaimedBy != nil && *review.ClaimedBy != reviewerID &&
+		review.ClaimExpiresAt != nil && review.ClaimExpiresAt.After(now) {
+		return nil, errno.ReviewClaimedByOtherError
+	}
+	return review, nil
+}
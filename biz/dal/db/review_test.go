@@ -0,0 +1,101 @@
+package db
+
+import (
+	"LearnShare/pkg/constants"
+	"context"
+	"testing"
+	"time"
+)
+
+// setupResourceReviewTestDB 在处罚测试数据库上补充资源与信誉分规则表
+func setupResourceReviewTestDB(t *testing.T) func() {
+	t.Helper()
+	cleanup := setupModerationTestDB(t)
+	for _, sql := range []string{`
+CREATE TABLE IF NOT EXISTS resources (
+    resource_id INTEGER PRIMARY KEY AUTOINCREMENT,
+    uploader_id INTEGER NOT NULL,
+    status TEXT DEFAULT 'normal',
+    deleted_at DATETIME
+);`, `
+CREATE TABLE IF NOT EXISTS reputation_rules (
+    event_type TEXT PRIMARY KEY,
+    points INTEGER,
+    daily_limit INTEGER DEFAULT 0,
+    once_per_target BOOLEAN DEFAULT 0,
+    description TEXT,
+    enabled BOOLEAN DEFAULT 1,
+    updated_at DATETIME
+);`} {
+		if err := DB.Exec(sql).Error; err != nil {
+			t.Fatalf("创建测试数据表失败: %v", err)
+		}
+	}
+	if err := DB.Table(constants.ReputationRuleTableName).Create(&ReputationRule{
+		EventType: ReputationUploadApproved, Points: 5, OncePerTarget: true, Description: "资源审核通过", Enabled: true,
+	}).Error; err != nil {
+		t.Fatalf("插入信誉分规则失败: %v", err)
+	}
+	return cleanup
+}
+
+func TestAuditResourceReviewAwardsOnlyPendingUpload(t *testing.T) {
+	cleanup := setupResourceReviewTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	uploader := insertModerationUser(t, "uploader", 10)
+	reporter := insertModerationUser(t, "reporter", 0)
+	reviewer := insertModerationUser(t, "reviewer", 0)
+
+	insertResource := func(status string) int64 {
+		t.Helper()
+		if err := DB.Exec("INSERT INTO resources (uploader_id, status) VALUES (?, ?)", uploader.UserID, status).Error; err != nil {
+			t.Fatalf("插入测试资源失败: %v", err)
+		}
+		var id int64
+		DB.Raw("SELECT MAX(resource_id) FROM resources").Scan(&id)
+		return id
+	}
+	reputation := func() int64 {
+		t.Helper()
+		var u User
+		DB.Table(constants.UserTableName).Where("user_id = ?", uploader.UserID).First(&u)
+		return u.ReputationScore
+	}
+
+	// 驳回对资源的举报（包括举报数达到阈值而自动隐藏的）：恢复正常，不发放信誉分
+	for _, autoHidden := range []bool{false, true} {
+		resourceID := insertResource("normal")
+		review := Review{TargetID: resourceID, TargetType: "resource", Reason: "违规", ReporterID: &reporter.UserID, Level: 1}
+		if autoHidden {
+			now := time.Now()
+			review.AutoHiddenAt = &now
+			DB.Table(constants.ResourceTableName).Where("resource_id = ?", resourceID).Update("status", "pending_review")
+		}
+		if err := DB.Table(constants.ReviewTableName).Create(&review).Error; err != nil {
+			t.Fatalf("插入举报记录失败: %v", err)
+		}
+		if err := AuditContentReview(ctx, review.ReviewID, reviewer.UserID, "resource", "reject"); err != nil {
+			t.Fatalf("驳回举报失败: %v", err)
+		}
+		if got := reputation(); got != 10 {
+			t.Fatalf("驳回举报不应发放上传信誉分: auto_hidden=%v reputation=%d", autoHidden, got)
+		}
+	}
+
+	// 上传时被内容过滤拦下的资源审核通过：发布并发放信誉分
+	pending := insertResource("normal")
+	review, err := CreateFilterReview(ctx, "resource", pending, "命中过滤规则", 2, time.Now().Add(time.Hour), true)
+	if err != nil {
+		t.Fatalf("提交过滤审核失败: %v", err)
+	}
+	if err := AuditContentReview(ctx, review.ReviewID, reviewer.UserID, "resource", "reject"); err != nil {
+		t.Fatalf("审核待发布资源失败: %v", err)
+	}
+	var status string
+	DB.Table(constants.ResourceTableName).Where("resource_id = ?", pending).Pluck("status", &status)
+	if status != "normal" || reputation() != 15 {
+		t.Fatalf("待发布资源审核通过后应当发布并发放信誉分: status=%s reputation=%d", status, reputation())
+	}
+}
@@ -4,6 +4,9 @@ import (
 	"LearnShare/pkg/constants"
 	"LearnShare/pkg/errno"
 	"context"
+	"errors"
+
+	"gorm.io/gorm"
 )
 
 // GetCollegeList 获取学院列表
@@ -46,6 +49,38 @@ func GetMajorListByCollege(ctx context.Context, collegeID int64, pageNum, pageSi
 	return majors, total, nil
 }
 
+// GetCollegeByName 根据学院名称查询学院，不存在时返回 nil
+func GetCollegeByName(ctx context.Context, collegeName string) (*College, error) {
+	var college College
+	err := DB.WithContext(ctx).Table(constants.CollegeTableName).
+		Where("college_name = ?", collegeName).
+		First(&college).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, errno.NewErrNo(errno.InternalDatabaseErrorCode, "查询学院失败: "+err.Error())
+	}
+	return &college, nil
+}
+
+// GetMajorByName 根据专业名称查询专业，collegeID 为 0 时不限定学院，不存在时返回 nil
+func GetMajorByName(ctx context.Context, majorName string, collegeID int64) (*Major, error) {
+	var major Major
+	query := DB.WithContext(ctx).Table(constants.MajorTableName).Where("major_name = ?", majorName)
+	if collegeID != 0 {
+		query = query.Where("college_id = ?", collegeID)
+	}
+	err := query.First(&major).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, errno.NewErrNo(errno.InternalDatabaseErrorCode, "查询专业失败: "+err.Error())
+	}
+	return &major, nil
+}
+
 // GetTeacherListByCollegeId 根据专业ID获取教师列表
 func GetTeacherListByCollegeId(ctx context.Context, collegeId int64, pageNum, pageSize int) ([]*Teacher, int64, error) {
 	var teachers []*Teacher
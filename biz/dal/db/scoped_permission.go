@@ -0,0 +1,117 @@
+package db
+
+import (
+	"LearnShare/pkg/constants"
+	"LearnShare/pkg/errno"
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+)
+
+// HasScopedPermission 判断用户是否在任一作用域内拥有指定权限
+func HasScopedPermission(ctx context.Context, userID int64, permissionName string, scopes []Scope) (bool, error) {
+	if len(scopes) == 0 {
+		return false, nil
+	}
+
+	scopeCond := DB.Where("g.scope_type = ? AND g.scope_id = ?", scopes[0].Type, scopes[0].ID)
+	for _, scope := range scopes[1:] {
+		scopeCond = scopeCond.Or("g.scope_type = ? AND g.scope_id = ?", scope.Type, scope.ID)
+	}
+
+	var count int64
+	err := DB.WithContext(ctx).
+		Table(constants.ScopedPermissionGrantTableName+" AS g").
+		Joins("JOIN "+constants.PermissionTableName+" AS p ON g.permission_id = p.permission_id").
+		Where("g.user_id = ? AND p.permission_name = ?", userID, permissionName).
+		Where(scopeCond).
+		Count(&count).Error
+	if err != nil {
+		return false, errno.NewErrNo(errno.InternalDatabaseErrorCode, "查询作用域授权失败: "+err.Error())
+	}
+	return count > 0, nil
+}
+
+// CreateScopedPermissionGrant 创建作用域授权
+func CreateScopedPermissionGrant(ctx context.Context, grant *ScopedPermissionGrant) error {
+	err := DB.WithContext(ctx).Table(constants.ScopedPermissionGrantTableName).Create(grant).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return errno.ScopedGrantExistError
+		}
+		return errno.NewErrNo(errno.InternalDatabaseErrorCode, "创建作用域授权失败: "+err.Error())
+	}
+	return nil
+}
+
+// GetScopedPermissionGrant 查询作用域授权，不存在时返回 nil
+func GetScopedPermissionGrant(ctx context.Context, grantID int64) (*ScopedPermissionGrantWithName, error) {
+	var grant ScopedPermissionGrantWithName
+	err := scopedGrantQuery(ctx).Where("g.grant_id = ?", grantID).Take(&grant).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, errno.NewErrNo(errno.InternalDatabaseErrorCode, "查询作用域授权失败: "+err.Error())
+	}
+	return &grant, nil
+}
+
+// ListScopedPermissionGrants 查询指定作用域下的全部授权
+func ListScopedPermissionGrants(ctx context.Context, scopeType string, scopeID int64) ([]*ScopedPermissionGrantWithName, error) {
+	var grants []*ScopedPermissionGrantWithName
+	err := scopedGrantQuery(ctx).
+		Where("g.scope_type = ? AND g.scope_id = ?", scopeType, scopeID).
+		Order("g.grant_id ASC").
+		Find(&grants).Error
+	if err != nil {
+		return nil, errno.NewErrNo(errno.InternalDatabaseErrorCode, "查询作用域授权列表失败: "+err.Error())
+	}
+	return grants, nil
+}
+
+// DeleteScopedPermissionGrant 删除作用域授权
+func DeleteScopedPermissionGrant(ctx context.Context, grantID int64) error {
+	err := DB.WithContext(ctx).Table(constants.ScopedPermissionGrantTableName).
+		Where("grant_id = ?", grantID).
+		Delete(&ScopedPermissionGrant{}).Error
+	if err != nil {
+		return errno.NewErrNo(errno.InternalDatabaseErrorCode, "删除作用域授权失败: "+err.Error())
+	}
+	return nil
+}
+
+// GetPermissionByName 根据权限标识查询权限，不存在时返回 nil
+func GetPermissionByName(ctx context.Context, name string) (*Permission, error) {
+	var permission Permission
+	err := DB.WithContext(ctx).Table(constants.PermissionTableName).Where("permission_name = ?", name).Take(&permission).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, errno.NewErrNo(errno.InternalDatabaseErrorCode, "查询权限失败: "+err.Error())
+	}
+	return &permission, nil
+}
+
+// GetScopeParentID 查询作用域对象所属的上级对象ID，例如评论所属的课程、资源所属的课程
+// table/keyColumn 定位对象，parentColumn 为上级对象ID列；对象不存在或上级为空时 ok 为 false
+func GetScopeParentID(ctx context.Context, table, keyColumn, parentColumn string, key int64) (int64, bool, error) {
+	var parentIDs []*int64
+	err := DB.WithContext(ctx).Table(table).Where(keyColumn+" = ?", key).Limit(1).Pluck(parentColumn, &parentIDs).Error
+	if err != nil {
+		return 0, false, errno.NewErrNo(errno.InternalDatabaseErrorCode, "查询作用域失败: "+err.Error())
+	}
+	if len(parentIDs) == 0 || parentIDs[0] == nil {
+		return 0, false, nil
+	}
+	return *parentIDs[0], true, nil
+}
+
+func scopedGrantQuery(ctx context.Context) *gorm.DB {
+	return DB.WithContext(ctx).
+		Table(constants.ScopedPermissionGrantTableName + " AS g").
+		Select("g.*, p.permission_name").
+		Joins("JOIN " + constants.PermissionTableName + " AS p ON g.permission_id = p.permission_id")
+}
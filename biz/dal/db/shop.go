@@ -0,0 +1,255 @@
+package db
+
+import (
+	"LearnShare/pkg/constants"
+	"LearnShare/pkg/errno"
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// achievementMedals 作为成就奖励的勋章，只能通过达成成就获得
+func achievementMedals(tx *gorm.DB) *gorm.DB {
+	return tx.Session(&gorm.Session{NewDB: true}).Table(constants.AchievementTableName).
+		Select("medal_item_id").Where("medal_item_id IS NOT NULL")
+}
+
+// ListItems 分页查询商城可兑换的物品，itemType 为空时不限类型
+func ListItems(ctx context.Context, itemType string, pageNum, pageSize int) ([]*Item, int64, error) {
+	query := DB.WithContext(ctx).Table(constants.ItemTableName).Where("item_id NOT IN (?)", achievementMedals(DB.WithContext(ctx)))
+	if itemType != "" {
+		query = query.Where("type = ?", itemType)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, errno.NewErrNo(errno.InternalDatabaseErrorCode, "统计物品失败: "+err.Error())
+	}
+	var items []*Item
+	err := query.Order("type ASC, price ASC, item_id ASC").Limit(pageSize).Offset(pageSize * (pageNum - 1)).Find(&items).Error
+	if err != nil {
+		return nil, 0, errno.NewErrNo(errno.InternalDatabaseErrorCode, "查询物品失败: "+err.Error())
+	}
+	return items, total, nil
+}
+
+// GetItemByID 根据ID查询物品
+func GetItemByID(ctx context.Context, itemID int64) (*Item, error) {
+	var item Item
+	err := DB.WithContext(ctx).Table(constants.ItemTableName).Where("item_id = ?", itemID).Take(&item).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errno.ItemNotFoundError
+		}
+		return nil, errno.NewErrNo(errno.InternalDatabaseErrorCode, "查询物品失败: "+err.Error())
+	}
+	return &item, nil
+}
+
+// CreateItem 创建物品，物品名称不能重复
+func CreateItem(ctx context.Context, item *Item) error {
+	err := DB.WithContext(ctx).Table(constants.ItemTableName).Create(item).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return errno.ItemNameExistError
+		}
+		return errno.NewErrNo(errno.InternalDatabaseErrorCode, "创建物品失败: "+err.Error())
+	}
+	return nil
+}
+
+// UpdateItem 更新物品，修改类型时取消所有用户对该物品的使用，保证每种类型最多使用一件
+func UpdateItem(ctx context.Context, itemID int64, updates map[string]interface{}) error {
+	return DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Table(constants.ItemTableName).Where("item_id = ?", itemID).Updates(updates).Error; err != nil {
+			if errors.Is(err, gorm.ErrDuplicatedKey) {
+				return errno.ItemNameExistError
+			}
+			return errno.NewErrNo(errno.InternalDatabaseErrorCode, "更新物品失败: "+err.Error())
+		}
+		if _, ok := updates["type"]; ok {
+			if err := tx.Table(constants.UserItemTableName).Where("item_id = ? AND is_used = ?", itemID, true).
+				Update("is_used", false).Error; err != nil {
+				return errno.NewErrNo(errno.InternalDatabaseErrorCode, "取消物品使用失败: "+err.Error())
+			}
+		}
+		return nil
+	})
+}
+
+// DeleteItem 删除物品，已有用户拥有的物品不能删除
+func DeleteItem(ctx context.Context, itemID int64) error {
+	return DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var owned int64
+		if err := tx.Table(constants.UserItemTableName).Where("item_id = ?", itemID).Count(&owned).Error; err != nil {
+			return errno.NewErrNo(errno.InternalDatabaseErrorCode, "查询物品持有情况失败: "+err.Error())
+		}
+		if owned > 0 {
+			return errno.ItemInUseError
+		}
+		result := tx.Table(constants.ItemTableName).Where("item_id = ?", itemID).Delete(&Item{})
+		if result.Error != nil {
+			return errno.NewErrNo(errno.InternalDatabaseErrorCode, "删除物品失败: "+result.Error.Error())
+		}
+		if result.RowsAffected == 0 {
+			return errno.ItemNotFoundError
+		}
+		return nil
+	})
+}
+
+// PurchaseItem 用信誉分兑换物品，扣分写入信誉分记录，返回获得的物品与兑换后的信誉分
+func PurchaseItem(ctx context.Context, userID, itemID int64) (*UserItem, int64, error) {
+	var (
+		userItem *UserItem
+		score    int64
+	)
+	err := DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var item Item
+		if err := tx.Table(constants.ItemTableName).Where("item_id = ?", itemID).Take(&item).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return errno.ItemNotFoundError
+			}
+			return errno.NewErrNo(errno.InternalDatabaseErrorCode, "查询物品失败: "+err.Error())
+		}
+		var medals int64
+		if err := achievementMedals(tx).Where("medal_item_id = ?", itemID).Count(&medals).Error; err != nil {
+			return errno.NewErrNo(errno.InternalDatabaseErrorCode, "查询成就勋章失败: "+err.Error())
+		}
+		if medals > 0 {
+			return errno.ItemNotForSaleError
+		}
+
+		// 锁定用户行，并发兑换依次校验余额
+		var scores []int64
+		if err := tx.Table(constants.UserTableName).Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("user_id = ?", userID).Pluck("reputation_score", &scores).Error; err != nil {
+			return errno.NewErrNo(errno.InternalDatabaseErrorCode, "查询用户信誉分失败: "+err.Error())
+		}
+		if len(scores) == 0 {
+			return errno.NewErrNo(errno.ErrRecordNotFound, "用户不存在")
+		}
+		if scores[0] < item.Price {
+			return errno.ReputationInsufficientError
+		}
+
+		userItem = &UserItem{UserID: userID, ItemID: itemID}
+		if err := tx.Table(constants.UserItemTableName).Omit("Item").Create(userItem).Error; err != nil {
+			if errors.Is(err, gorm.ErrDuplicatedKey) {
+				return errno.ItemAlreadyOwnedError
+			}
+			return errno.NewErrNo(errno.InternalDatabaseErrorCode, "发放物品失败: "+err.Error())
+		}
+		userItem.Item = item
+
+		score = scores[0]
+		if item.Price == 0 {
+			return nil
+		}
+		relatedType := "item"
+		if _, err := changeReputation(tx, ReputationChange{
+			UserID:      userID,
+			Delta:       -item.Price,
+			EventType:   ReputationShopPurchase,
+			Reason:      "兑换物品：" + item.Name,
+			RelatedType: &relatedType,
+			RelatedID:   &itemID,
+		}); err != nil {
+			return err
+		}
+		score -= item.Price
+		return nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	return userItem, score, nil
+}
+
+// ListUserItems 查询用户拥有的物品，itemType 为空时不限类型
+func ListUserItems(ctx context.Context, userID int64, itemType string) ([]*UserItem, error) {
+	query := DB.WithContext(ctx).Table(constants.UserItemTableName).Where("user_items.user_id = ?", userID)
+	if itemType != "" {
+		query = query.Joins("JOIN "+constants.ItemTableName+" ON "+constants.ItemTableName+".item_id = user_items.item_id").
+			Where(constants.ItemTableName+".type = ?", itemType)
+	}
+	var userItems []*UserItem
+	err := query.Select("user_items.*").Order("user_items.obtained_at DESC, user_items.user_item_id DESC").
+		Preload("Item").Find(&userItems).Error
+	if err != nil {
+		return nil, errno.NewErrNo(errno.InternalDatabaseErrorCode, "查询用户物品失败: "+err.Error())
+	}
+	return userItems, nil
+}
+
+// GetEquippedItems 查询用户正在使用的物品
+func GetEquippedItems(ctx context.Context, userID int64) ([]*Item, error) {
+	var items []*Item
+	err := DB.WithContext(ctx).Table(constants.ItemTableName+" AS i").Select("i.*").
+		Joins("JOIN "+constants.UserItemTableName+" AS ui ON ui.item_id = i.item_id").
+		Where("ui.user_id = ? AND ui.is_used = ?", userID, true).
+		Order("i.type ASC").Find(&items).Error
+	if err != nil {
+		return nil, errno.NewErrNo(errno.InternalDatabaseErrorCode, "查询使用中的物品失败: "+err.Error())
+	}
+	return items, nil
+}
+
+// EquipUserItem 使用已拥有的物品，同类型的其他物品自动取消使用
+func EquipUserItem(ctx context.Context, userID, itemID int64) error {
+	return DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 锁定用户全部物品，避免并发使用同类型物品后出现多件使用中
+		var owned []*UserItem
+		if err := tx.Table(constants.UserItemTableName).Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("user_id = ?", userID).Preload("Item").Find(&owned).Error; err != nil {
+			return errno.NewErrNo(errno.InternalDatabaseErrorCode, "查询用户物品失败: "+err.Error())
+		}
+		var target *UserItem
+		for _, ui := range owned {
+			if ui.ItemID == itemID {
+				target = ui
+				break
+			}
+		}
+		if target == nil {
+			return errno.ItemNotOwnedError
+		}
+
+		var others []int64
+		for _, ui := range owned {
+			if ui.IsUsed && ui.ItemID != itemID && ui.Item.Type == target.Item.Type {
+				others = append(others, ui.UserItemID)
+			}
+		}
+		if len(others) > 0 {
+			if err := tx.Table(constants.UserItemTableName).Where("user_item_id IN ?", others).
+				Update("is_used", false).Error; err != nil {
+				return errno.NewErrNo(errno.InternalDatabaseErrorCode, "取消同类物品使用失败: "+err.Error())
+			}
+		}
+		if err := tx.Table(constants.UserItemTableName).Where("user_item_id = ?", target.UserItemID).
+			Update("is_used", true).Error; err != nil {
+			return errno.NewErrNo(errno.InternalDatabaseErrorCode, "使用物品失败: "+err.Error())
+		}
+		return nil
+	})
+}
+
+// UnequipUserItem 取消使用已拥有的物品
+func UnequipUserItem(ctx context.Context, userID, itemID int64) error {
+	var owned int64
+	if err := DB.WithContext(ctx).Table(constants.UserItemTableName).Where("user_id = ? AND item_id = ?", userID, itemID).
+		Count(&owned).Error; err != nil {
+		return errno.NewErrNo(errno.InternalDatabaseErrorCode, "查询用户物品失败: "+err.Error())
+	}
+	if owned == 0 {
+		return errno.ItemNotOwnedError
+	}
+	if err := DB.WithContext(ctx).Table(constants.UserItemTableName).Where("user_id = ? AND item_id = ?", userID, itemID).
+		Update("is_used", false).Error; err != nil {
+		return errno.NewErrNo(errno.InternalDatabaseErrorCode, "取消使用物品失败: "+err.Error())
+	}
+	return nil
+}
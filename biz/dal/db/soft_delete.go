@@ -0,0 +1,171 @@
+package db
+
+import (
+	"LearnShare/pkg/constants"
+	"LearnShare/pkg/errno"
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// commentDeletedUpdates 评论删除时的字段更新，status 为 deleted_by_user 或 deleted_by_admin
+// 记录删除时间的评论在保留期过后由清理任务彻底删除；举报审核隐藏的评论不记录删除时间，不会被清理
+func commentDeletedUpdates(status string) map[string]interface{} {
+	return map[string]interface{}{"status": status, "is_visible": false, "deleted_at": time.Now()}
+}
+
+// RestoreCourse 恢复已删除的课程，以及随课程一起删除的资源
+func RestoreCourse(ctx context.Context, courseID int64) error {
+	return DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var course Course
+		err := tx.Unscoped().Table(constants.CourseTableName).Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("course_id = ?", courseID).Take(&course).Error
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return errno.CourseNotFoundError
+			}
+			return errno.NewErrNo(errno.InternalDatabaseErrorCode, "查询课程失败: "+err.Error())
+		}
+		if !course.DeletedAt.Valid {
+			return errno.NewErrNo(errno.ServiceInvalidParameter, "课程未被删除")
+		}
+
+		if err := tx.Table(constants.ResourceTableName).Where("course_id = ? AND deleted_at = ?", courseID, course.DeletedAt.Time).
+			Update("deleted_at", nil).Error; err != nil {
+			return errno.NewErrNo(errno.InternalDatabaseErrorCode, "恢复课程资源失败: "+err.Error())
+		}
+		if err := tx.Table(constants.CourseTableName).Where("course_id = ?", courseID).Update("deleted_at", nil).Error; err != nil {
+			return errno.NewErrNo(errno.InternalDatabaseErrorCode, "恢复课程失败: "+err.Error())
+		}
+		return nil
+	})
+}
+
+// RestoreResource 恢复已删除的资源，所属课程已删除时需先恢复课程
+func RestoreResource(ctx context.Context, resourceID int64) error {
+	return DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var resource Resource
+		err := tx.Unscoped().Table(constants.ResourceTableName).Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("resource_id = ?", resourceID).Take(&resource).Error
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return errno.ResourceNotFoundError
+			}
+			return errno.NewErrNo(errno.InternalDatabaseErrorCode, "查询资源失败: "+err.Error())
+		}
+		if !resource.DeletedAt.Valid {
+			return errno.NewErrNo(errno.ServiceInvalidParameter, "资源未被删除")
+		}
+
+		var deletedCourses int64
+		if err := tx.Table(constants.CourseTableName).Where("course_id = ? AND deleted_at IS NOT NULL", resource.CourseID).
+			Count(&deletedCourses).Error; err != nil {
+			return errno.NewErrNo(errno.InternalDatabaseErrorCode, "查询所属课程失败: "+err.Error())
+		}
+		if deletedCourses > 0 {
+			return errno.NewErrNo(errno.ServiceInvalidParameter, "资源所属课程已删除，请先恢复课程")
+		}
+
+		if err := tx.Table(constants.ResourceTableName).Where("resource_id = ?", resourceID).Update("deleted_at", nil).Error; err != nil {
+			return errno.NewErrNo(errno.InternalDatabaseErrorCode, "恢复资源失败: "+err.Error())
+		}
+		return nil
+	})
+}
+
+// RestoreDeletedComment 恢复被用户或管理员删除的评论，targetType 为 comment（资源评论）或 course_comment
+func RestoreDeletedComment(ctx context.Context, targetType string, commentID int64) error {
+	return DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		content, err := lockContent(tx, targetType, commentID)
+		if err != nil {
+			return err
+		}
+		result := tx.Table(content.table).Where(content.key+" = ? AND deleted_at IS NOT NULL", commentID).
+			Updates(map[string]interface{}{"status": "normal", "is_visible": true, "deleted_at": nil})
+		if result.Error != nil {
+			return errno.NewErrNo(errno.InternalDatabaseErrorCode, "恢复评论失败: "+result.Error.Error())
+		}
+		if result.RowsAffected == 0 {
+			return errno.NewErrNo(errno.ServiceInvalidParameter, "该评论未被删除")
+		}
+		return nil
+	})
+}
+
+// ListExpiredResources 查询删除时间早于 before 的资源，清理任务删除对象存储中的文件后再彻底删除记录
+func ListExpiredResources(ctx context.Context, before time.Time, limit int) ([]*Resource, error) {
+	var resources []*Resource
+	err := DB.WithContext(ctx).Unscoped().Table(constants.ResourceTableName).
+		Where("deleted_at < ?", before).Order("deleted_at ASC").Limit(limit).Find(&resources).Error
+	if err != nil {
+		return nil, errno.NewErrNo(errno.InternalDatabaseErrorCode, "查询待清理资源失败: "+err.Error())
+	}
+	return resources, nil
+}
+
+// PurgeResource 彻底删除已删除的资源及其收藏引用，评论、评分与标签关联由外键级联删除
+func PurgeResource(ctx context.Context, resourceID int64) error {
+	return DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Table(constants.FavoriteTableName).Where("target_type = ? AND target_id = ?", "resource", resourceID).Delete(nil).Error; err != nil {
+			return errno.NewErrNo(errno.InternalDatabaseErrorCode, "清理资源收藏引用失败: "+err.Error())
+		}
+		if err := tx.Unscoped().Table(constants.ResourceTableName).Where("resource_id = ? AND deleted_at IS NOT NULL", resourceID).
+			Delete(&Resource{}).Error; err != nil {
+			return errno.NewErrNo(errno.InternalDatabaseErrorCode, "清理资源失败: "+err.Error())
+		}
+		return nil
+	})
+}
+
+// PurgeExpiredCourses 彻底删除删除时间早于 before 的课程，返回删除数量
+// 仍有待清理资源的课程留到资源文件删除后再处理；评论与评分由外键级联删除
+func PurgeExpiredCourses(ctx context.Context, before time.Time, limit int) (int64, error) {
+	var courseIDs []int64
+	err := DB.WithContext(ctx).Table(constants.CourseTableName).
+		Where("deleted_at < ?", before).
+		Where("NOT EXISTS (SELECT 1 FROM "+constants.ResourceTableName+" r WHERE r.course_id = courses.course_id AND r.deleted_at IS NOT NULL)").
+		Order("deleted_at ASC").Limit(limit).Pluck("course_id", &courseIDs).Error
+	if err != nil {
+		return 0, errno.NewErrNo(errno.InternalDatabaseErrorCode, "查询待清理课程失败: "+err.Error())
+	}
+
+	var purged int64
+	for _, courseID := range courseIDs {
+		err := DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			if err := tx.Table(constants.FavoriteTableName).Where("target_type = ? AND target_id = ?", "course", courseID).Delete(nil).Error; err != nil {
+				return err
+			}
+			return tx.Unscoped().Table(constants.CourseTableName).Where("course_id = ? AND deleted_at IS NOT NULL", courseID).Delete(&Course{}).Error
+		})
+		if err != nil {
+			return purged, errno.NewErrNo(errno.InternalDatabaseErrorCode, "清理课程失败: "+err.Error())
+		}
+		purged++
+	}
+	return purged, nil
+}
+
+// PurgeExpiredComments 彻底删除删除时间早于 before 的资源评论与课程评论，返回删除数量
+func PurgeExpiredComments(ctx context.Context, before time.Time, limit int) (int64, error) {
+	var purged int64
+	for _, targetType := range []string{"comment", "course_comment"} {
+		content := reviewContents[targetType]
+		var ids []int64
+		if err := DB.WithContext(ctx).Table(content.table).Where("deleted_at < ?", before).
+			Order("deleted_at ASC").Limit(limit).Pluck(content.key, &ids).Error; err != nil {
+			return purged, errno.NewErrNo(errno.InternalDatabaseErrorCode, "查询待清理评论失败: "+err.Error())
+		}
+		if len(ids) == 0 {
+			continue
+		}
+		result := DB.WithContext(ctx).Table(content.table).Where(content.key+" IN ?", ids).Delete(nil)
+		if result.Error != nil {
+			return purged, errno.NewErrNo(errno.InternalDatabaseErrorCode, "清理评论失败: "+result.Error.Error())
+		}
+		purged += result.RowsAffected
+	}
+	return purged, nil
+}
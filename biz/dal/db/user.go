@@ -5,18 +5,22 @@ import (
 	"LearnShare/pkg/errno"
 	"context"
 	"errors"
+	"strings"
+	"time"
 
 	"gorm.io/gorm"
 )
 
-// CreateUser 创建新用户
+// CreateUser 创建新用户，注册流程创建的账户带待激活标记，长期未激活时由清理任务处理
 func CreateUser(ctx context.Context, username, passwordHash, email string) error {
+	now := time.Now()
 	user := &User{
-		Username:     username,
-		PasswordHash: passwordHash,
-		Email:        email,
-		RoleID:       2, // 默认普通用户角色ID
-		Status:       "inactive",
+		Username:            username,
+		PasswordHash:        passwordHash,
+		Email:               email,
+		RoleID:              2, // 默认普通用户角色ID
+		Status:              "inactive",
+		ActivationPendingAt: &now,
 	}
 
 	err := DB.WithContext(ctx).Table(constants.UserTableName).Create(user).Error
@@ -88,6 +92,16 @@ func UpdateUserStatues(ctx context.Context, userID int64, newStatus string) erro
 	return nil
 }
 
+// ActivateUser 激活账户并清除待激活标记，只处理仍未激活的账户
+func ActivateUser(ctx context.Context, userID int64) error {
+	err := DB.WithContext(ctx).Table(constants.UserTableName).Where("user_id = ? AND status = ?", userID, "inactive").
+		Updates(map[string]interface{}{"status": "active", "activation_pending_at": nil}).Error
+	if err != nil {
+		return errno.NewErrNo(errno.InternalDatabaseErrorCode, "激活账户失败: "+err.Error())
+	}
+	return nil
+}
+
 // UpdateUserStatuesAsync 异步更新用户状态
 func UpdateUserStatuesAsync(ctx context.Context, userID int64, newStatus string) chan error {
 	pool := GetAsyncPool()
@@ -123,6 +137,29 @@ func GetUserByEmail(ctx context.Context, email string) (*User, error) {
 	return &user, nil
 }
 
+// FindUserByEmail 根据邮箱查询用户，不存在时返回 nil
+func FindUserByEmail(ctx context.Context, email string) (*User, error) {
+	var user User
+	err := DB.WithContext(ctx).Table(constants.UserTableName).Where("email = ?", email).First(&user).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, errno.NewErrNo(errno.InternalDatabaseErrorCode, "查询用户失败: "+err.Error())
+	}
+	return &user, nil
+}
+
+// IsUsernameExist 检查用户名是否已被占用
+func IsUsernameExist(ctx context.Context, username string) (bool, error) {
+	var count int64
+	err := DB.WithContext(ctx).Table(constants.UserTableName).Where("username = ?", username).Count(&count).Error
+	if err != nil {
+		return false, errno.NewErrNo(errno.InternalDatabaseErrorCode, "检查用户名失败: "+err.Error())
+	}
+	return count > 0, nil
+}
+
 // GetUserByID 根据用户ID查询用户
 func GetUserByID(ctx context.Context, id int64) (*User, error) {
 	var user User
@@ -133,6 +170,53 @@ func GetUserByID(ctx context.Context, id int64) (*User, error) {
 	return &user, nil
 }
 
+// inactiveUserContent 未激活账户删除时会被级联删除（或受外键保护）的内容，有任一内容的账户不删除
+var inactiveUserContent = []struct{ table, owner string }{
+	{constants.ResourceTableName, "uploader_id"},
+	{constants.ResourceCommentTableName, "user_id"},
+	{constants.ResourceRatingTableName, "user_id"},
+	{constants.CourseCommentTableName, "user_id"},
+	{constants.CourseRatingTableName, "user_id"},
+	{constants.ReviewTableName, "reporter_id"},
+	{constants.ReviewReporterTableName, "reporter_id"},
+}
+
+// PurgeInactiveUsers 清理注册流程创建、在 before 之前注册且仍未激活的账户，返回删除与锁定的数量
+// 只处理带待激活标记的账户，管理员创建或早先存在的未激活账户不受影响；
+// 已有上传、评论、评分或举报的账户删除时会连带删除内容，改为锁定并清除标记
+func PurgeInactiveUsers(ctx context.Context, before time.Time) (int64, int64, error) {
+	hasContent := make([]string, 0, len(inactiveUserContent))
+	for _, c := range inactiveUserContent {
+		hasContent = append(hasContent, "EXISTS (SELECT 1 FROM "+c.table+" WHERE "+c.table+"."+c.owner+" = users.user_id)")
+	}
+	contentCond := strings.Join(hasContent, " OR ")
+	stale := func(tx *gorm.DB) *gorm.DB {
+		return tx.Table(constants.UserTableName).
+			Where("status = ? AND activation_pending_at IS NOT NULL AND activation_pending_at < ?", "inactive", before)
+	}
+
+	var deleted, locked int64
+	err := DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := stale(tx).Where(contentCond).
+			Updates(map[string]interface{}{"status": "locked", "activation_pending_at": nil})
+		if result.Error != nil {
+			return errno.NewErrNo(errno.InternalDatabaseErrorCode, "锁定未激活账户失败: "+result.Error.Error())
+		}
+		locked = result.RowsAffected
+
+		result = stale(tx).Where("NOT (" + contentCond + ")").Delete(&User{})
+		if result.Error != nil {
+			return errno.NewErrNo(errno.InternalDatabaseErrorCode, "清理未激活账户失败: "+result.Error.Error())
+		}
+		deleted = result.RowsAffected
+		return nil
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+	return deleted, locked, nil
+}
+
 // AdminCreateUser 管理员创建新用户
 func AdminCreateUser(ctx context.Context, username, passwordHash, email string, roleID int64, status string) (int64, error) {
 
@@ -191,19 +275,37 @@ func AdminUpdateUser(ctx context.Context, userID int64, username, passwordHash,
 	return nil
 }
 
-// IncrementUserReputation 增加用户信誉分
-func IncrementUserReputation(ctx context.Context, userID int64, delta int64) error {
-	err := DB.WithContext(ctx).Table(constants.UserTableName).Where("user_id = ? AND reputation_score < 100", userID).Update("reputation_score", gorm.Expr("reputation_score + ?", delta)).Error
-	if err != nil {
-		return errno.NewErrNo(errno.InternalDatabaseErrorCode, "更新用户信誉分失败: "+err.Error())
+// ChangeUserEmail 修改用户邮箱并记录变更，action 为 change 或 revert
+func ChangeUserEmail(ctx context.Context, userID int64, oldEmail, newEmail, action string, ip *string) error {
+	tx := DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := tx.Table(constants.UserTableName).Where("user_id = ?", userID).Update("email", newEmail).Error; err != nil {
+		tx.Rollback()
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return errno.UserEmailInUseError
+		}
+		return errno.NewErrNo(errno.InternalDatabaseErrorCode, "更新用户邮箱失败: "+err.Error())
 	}
-	return nil
-}
 
-// IncrementUserReputationAsync 异步增加用户信誉分
-func IncrementUserReputationAsync(ctx context.Context, userID int64, delta int64) chan error {
-	pool := GetAsyncPool()
-	return pool.Submit(func() error {
-		return IncrementUserReputation(ctx, userID, delta)
-	})
+	history := &EmailChangeHistory{
+		UserID:   userID,
+		OldEmail: oldEmail,
+		NewEmail: newEmail,
+		Action:   action,
+		IP:       ip,
+	}
+	if err := tx.Table(constants.EmailChangeHistoryTableName).Create(history).Error; err != nil {
+		tx.Rollback()
+		return errno.NewErrNo(errno.InternalDatabaseErrorCode, "记录邮箱变更失败: "+err.Error())
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return errno.NewErrNo(errno.InternalDatabaseErrorCode, "提交事务失败: "+err.Error())
+	}
+	return nil
 }
@@ -0,0 +1,79 @@
+package db
+
+import (
+	"LearnShare/pkg/constants"
+	"LearnShare/pkg/errno"
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+)
+
+// GetUserIdentity 根据 issuer 与 subject 查询外部身份绑定，未绑定时返回 nil
+func GetUserIdentity(ctx context.Context, issuer, subject string) (*UserIdentity, error) {
+	var identity UserIdentity
+	err := DB.WithContext(ctx).Table(constants.UserIdentityTableName).
+		Where("issuer = ? AND subject = ?", issuer, subject).
+		First(&identity).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, errno.NewErrNo(errno.InternalDatabaseErrorCode, "查询身份绑定失败: "+err.Error())
+	}
+	return &identity, nil
+}
+
+// CreateUserIdentity 为已有用户绑定外部身份
+func CreateUserIdentity(ctx context.Context, userID int64, issuer, subject string, email *string) error {
+	identity := &UserIdentity{
+		UserID:  userID,
+		Issuer:  issuer,
+		Subject: subject,
+		Email:   email,
+	}
+
+	err := DB.WithContext(ctx).Table(constants.UserIdentityTableName).Create(identity).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return errno.NewErrNo(errno.ServiceUserExist, "该外部身份已绑定其他账户")
+		}
+		return errno.NewErrNo(errno.InternalDatabaseErrorCode, "创建身份绑定失败: "+err.Error())
+	}
+	return nil
+}
+
+// CreateUserWithIdentity 创建新用户并同时绑定外部身份
+func CreateUserWithIdentity(ctx context.Context, user *User, issuer, subject string) (int64, error) {
+	tx := DB.WithContext(ctx).Begin()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err := tx.Table(constants.UserTableName).Create(user).Error; err != nil {
+		tx.Rollback()
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return 0, errno.NewErrNo(errno.ServiceUserExist, "用户已存在")
+		}
+		return 0, errno.NewErrNo(errno.InternalDatabaseErrorCode, "创建用户失败: "+err.Error())
+	}
+
+	identity := &UserIdentity{
+		UserID:  user.UserID,
+		Issuer:  issuer,
+		Subject: subject,
+		Email:   &user.Email,
+	}
+	if err := tx.Table(constants.UserIdentityTableName).Create(identity).Error; err != nil {
+		tx.Rollback()
+		return 0, errno.NewErrNo(errno.InternalDatabaseErrorCode, "创建身份绑定失败: "+err.Error())
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return 0, errno.NewErrNo(errno.InternalDatabaseErrorCode, "提交事务失败: "+err.Error())
+	}
+
+	return user.UserID, nil
+}
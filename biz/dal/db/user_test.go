@@ -31,6 +31,8 @@ CREATE TABLE IF NOT EXISTS users (
     reputation_score INTEGER DEFAULT 0,
     role_id INTEGER,
     status TEXT,
+    muted_until DATETIME,
+    activation_pending_at DATETIME,
     created_at DATETIME,
     updated_at DATETIME
 );
@@ -183,3 +185,71 @@ func TestUpdateUserAvatarAndMajor(t *testing.T) {
 		t.Fatalf("专业ID未正确更新")
 	}
 }
+
+func TestPurgeInactiveUsers(t *testing.T) {
+	cleanup := setupResourceTestDB(t)
+	defer cleanup()
+	for _, sql := range []string{
+		"CREATE TABLE IF NOT EXISTS course_comments (comment_id INTEGER PRIMARY KEY AUTOINCREMENT, user_id INTEGER)",
+		"CREATE TABLE IF NOT EXISTS course_ratings (rating_id INTEGER PRIMARY KEY AUTOINCREMENT, user_id INTEGER)",
+	} {
+		if err := DB.Exec(sql).Error; err != nil {
+			t.Fatalf("创建测试数据表失败: %v", err)
+		}
+	}
+
+	ctx := context.Background()
+	stale := time.Now().Add(-72 * time.Hour)
+	insert := func(username string, pendingAt *time.Time) int64 {
+		t.Helper()
+		u := insertUser(t, username, username+"@example.com", "hash")
+		if err := DB.Table(constants.UserTableName).Where("user_id = ?", u.UserID).
+			Updates(map[string]interface{}{"created_at": stale, "activation_pending_at": pendingAt}).Error; err != nil {
+			t.Fatalf("更新测试用户失败: %v", err)
+		}
+		return u.UserID
+	}
+	recent := time.Now()
+	abandoned := insert("abandoned", &stale)
+	uploader := insert("uploader", &stale)
+	fresh := insert("fresh", &recent)
+	adminCreated := insert("admincreated", nil)
+	if err := DB.Exec("INSERT INTO resources (resource_name, resource_url, type, size, uploader_id, course_id) VALUES ('r', '/r', 'pdf', 1, ?, 1)", uploader).Error; err != nil {
+		t.Fatalf("插入测试资源失败: %v", err)
+	}
+
+	deleted, locked, err := PurgeInactiveUsers(ctx, time.Now().Add(-24*time.Hour))
+	if err != nil {
+		t.Fatalf("清理未激活账户失败: %v", err)
+	}
+	if deleted != 1 || locked != 1 {
+		t.Fatalf("预期删除1个、锁定1个账户，实际删除 %d、锁定 %d", deleted, locked)
+	}
+
+	status := func(userID int64) string {
+		var statuses []string
+		DB.Table(constants.UserTableName).Where("user_id = ?", userID).Pluck("status", &statuses)
+		if len(statuses) == 0 {
+			return ""
+		}
+		return statuses[0]
+	}
+	if s := status(abandoned); s != "" {
+		t.Fatalf("长期未激活且没有内容的注册账户应当删除，实际状态 %s", s)
+	}
+	if s := status(uploader); s != "locked" {
+		t.Fatalf("已有内容的账户应当锁定而不是删除，实际状态 %s", s)
+	}
+	if s := status(fresh); s != "inactive" {
+		t.Fatalf("未超过期限的账户不应处理，实际状态 %s", s)
+	}
+	if s := status(adminCreated); s != "inactive" {
+		t.Fatalf("没有待激活标记的账户不应处理，实际状态 %s", s)
+	}
+
+	// 锁定后清除了标记，再次执行不会重复处理
+	deleted, locked, err = PurgeInactiveUsers(ctx, time.Now().Add(-24*time.Hour))
+	if err != nil || deleted != 0 || locked != 0 {
+		t.Fatalf("重复清理不应再处理账户: deleted=%d locked=%d err=%v", deleted, locked, err)
+	}
+}
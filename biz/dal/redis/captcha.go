@@ -0,0 +1,35 @@
+package redis
+
+import (
+	"LearnShare/pkg/errno"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+func captchaKey(id string) string {
+	return fmt.Sprintf("captcha:%s", id)
+}
+
+// SetCaptchaAnswer 保存本地图形验证码答案
+func SetCaptchaAnswer(ctx context.Context, id, answer string, ttl time.Duration) error {
+	if err := RDB.Set(ctx, captchaKey(id), answer, ttl).Err(); err != nil {
+		return errno.NewErrNo(errno.InternalRedisErrorCode, "保存验证码失败: "+err.Error())
+	}
+	return nil
+}
+
+// TakeCaptchaAnswer 取出并删除验证码答案，不存在或已使用时返回空串
+func TakeCaptchaAnswer(ctx context.Context, id string) (string, error) {
+	answer, err := RDB.GetDel(ctx, captchaKey(id)).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return "", nil
+		}
+		return "", errno.NewErrNo(errno.InternalRedisErrorCode, "获取验证码失败: "+err.Error())
+	}
+	return answer, nil
+}
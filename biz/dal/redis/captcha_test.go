@@ -0,0 +1,33 @@
+package redis
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestCaptchaAnswer(t *testing.T) {
+	server, cleanup := initTestRedis(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	if err := SetCaptchaAnswer(ctx, "abc", "12", time.Minute); err != nil {
+		t.Fatalf("保存验证码失败: %v", err)
+	}
+	answer, err := TakeCaptchaAnswer(ctx, "abc")
+	if err != nil || answer != "12" {
+		t.Fatalf("期望取出答案 12, 实际为 %q, err=%v", answer, err)
+	}
+	answer, err = TakeCaptchaAnswer(ctx, "abc")
+	if err != nil || answer != "" {
+		t.Fatalf("验证码只能取出一次, 实际为 %q, err=%v", answer, err)
+	}
+
+	if err := SetCaptchaAnswer(ctx, "expired", "3", time.Minute); err != nil {
+		t.Fatalf("保存验证码失败: %v", err)
+	}
+	server.FastForward(2 * time.Minute)
+	if answer, _ := TakeCaptchaAnswer(ctx, "expired"); answer != "" {
+		t.Fatalf("过期验证码不应被取出, 实际为 %q", answer)
+	}
+}
@@ -0,0 +1,80 @@
+package redis
+
+import (
+	"LearnShare/pkg/errno"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// EmailRevert 撤销邮箱修改所需的信息
+type EmailRevert struct {
+	UserID   int64  `json:"user_id"`
+	OldEmail string `json:"old_email"`
+	NewEmail string `json:"new_email"`
+}
+
+func emailRevertKey(token string) string {
+	return fmt.Sprintf("email_revert:%s", token)
+}
+
+func sessionRevokedKey(userID int64) string {
+	return fmt.Sprintf("session_revoked:%d", userID)
+}
+
+// SetEmailRevert 保存邮箱修改的撤销令牌
+func SetEmailRevert(ctx context.Context, token string, data *EmailRevert, ttl time.Duration) error {
+	value, err := json.Marshal(data)
+	if err != nil {
+		return errno.NewErrNo(errno.InternalServiceErrorCode, "序列化撤销信息失败: "+err.Error())
+	}
+	if err := RDB.Set(ctx, emailRevertKey(token), value, ttl).Err(); err != nil {
+		return errno.NewErrNo(errno.InternalRedisErrorCode, "保存邮箱撤销令牌失败: "+err.Error())
+	}
+	return nil
+}
+
+// TakeEmailRevert 取出并删除撤销令牌，不存在或已使用时返回 nil
+func TakeEmailRevert(ctx context.Context, token string) (*EmailRevert, error) {
+	value, err := RDB.GetDel(ctx, emailRevertKey(token)).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, nil
+		}
+		return nil, errno.NewErrNo(errno.InternalRedisErrorCode, "获取邮箱撤销令牌失败: "+err.Error())
+	}
+	var data EmailRevert
+	if err := json.Unmarshal(value, &data); err != nil {
+		return nil, errno.NewErrNo(errno.InternalServiceErrorCode, "解析撤销信息失败: "+err.Error())
+	}
+	return &data, nil
+}
+
+// RevokeUserSessions 使用户在 at 之前签发的所有令牌失效，ttl 应不短于令牌的最长有效期
+func RevokeUserSessions(ctx context.Context, userID int64, at time.Time, ttl time.Duration) error {
+	if err := RDB.Set(ctx, sessionRevokedKey(userID), at.Unix(), ttl).Err(); err != nil {
+		return errno.NewErrNo(errno.InternalRedisErrorCode, "吊销用户会话失败: "+err.Error())
+	}
+	return nil
+}
+
+// GetUserSessionsRevokedAt 获取用户会话的吊销时间 (unix)，未吊销时返回 0
+func GetUserSessionsRevokedAt(ctx context.Context, userID int64) (int64, error) {
+	value, err := RDB.Get(ctx, sessionRevokedKey(userID)).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return 0, nil
+		}
+		return 0, errno.NewErrNo(errno.InternalRedisErrorCode, "获取用户会话吊销时间失败: "+err.Error())
+	}
+	at, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, errno.NewErrNo(errno.InternalRedisErrorCode, "用户会话吊销时间格式错误")
+	}
+	return at, nil
+}
@@ -0,0 +1,47 @@
+package redis
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestEmailRevert(t *testing.T) {
+	_, cleanup := initTestRedis(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	data := &EmailRevert{UserID: 7, OldEmail: "old@example.com", NewEmail: "new@example.com"}
+	if err := SetEmailRevert(ctx, "token", data, time.Hour); err != nil {
+		t.Fatalf("保存撤销令牌失败: %v", err)
+	}
+
+	got, err := TakeEmailRevert(ctx, "token")
+	if err != nil || got == nil || *got != *data {
+		t.Fatalf("取出的撤销信息不正确: %+v, err=%v", got, err)
+	}
+	got, err = TakeEmailRevert(ctx, "token")
+	if err != nil || got != nil {
+		t.Fatalf("撤销令牌只能使用一次: %+v, err=%v", got, err)
+	}
+}
+
+func TestRevokeUserSessions(t *testing.T) {
+	_, cleanup := initTestRedis(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	at, err := GetUserSessionsRevokedAt(ctx, 7)
+	if err != nil || at != 0 {
+		t.Fatalf("未吊销时应返回 0: at=%d err=%v", at, err)
+	}
+
+	now := time.Now()
+	if err := RevokeUserSessions(ctx, 7, now, time.Hour); err != nil {
+		t.Fatalf("吊销会话失败: %v", err)
+	}
+	at, err = GetUserSessionsRevokedAt(ctx, 7)
+	if err != nil || at != now.Unix() {
+		t.Fatalf("吊销时间不正确: at=%d err=%v", at, err)
+	}
+}
@@ -0,0 +1,21 @@
+package redis
+
+import (
+	"LearnShare/pkg/errno"
+	"context"
+	"fmt"
+	"time"
+)
+
+func jobLockKey(name string) string {
+	return fmt.Sprintf("job_lock:%s", name)
+}
+
+// TryJobLock 抢占定时任务本轮的执行权，多实例部署时保证同一任务同一时间只执行一次
+func TryJobLock(ctx context.Context, name string, ttl time.Duration) (bool, error) {
+	ok, err := RDB.SetNX(ctx, jobLockKey(name), time.Now().Unix(), ttl).Result()
+	if err != nil {
+		return false, errno.NewErrNo(errno.InternalRedisErrorCode, "获取定时任务锁失败: "+err.Error())
+	}
+	return ok, nil
+}
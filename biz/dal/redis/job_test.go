@@ -0,0 +1,28 @@
+package redis
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestTryJobLock(t *testing.T) {
+	server, cleanup := initTestRedis(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	ok, err := TryJobLock(ctx, "purge_inactive_users", time.Minute)
+	if err != nil || !ok {
+		t.Fatalf("首次获取任务锁应当成功: ok=%v err=%v", ok, err)
+	}
+	ok, err = TryJobLock(ctx, "purge_inactive_users", time.Minute)
+	if err != nil || ok {
+		t.Fatalf("任务锁未过期时不应重复获取: ok=%v err=%v", ok, err)
+	}
+
+	server.FastForward(time.Minute)
+	ok, err = TryJobLock(ctx, "purge_inactive_users", time.Minute)
+	if err != nil || !ok {
+		t.Fatalf("任务锁过期后应当可以重新获取: ok=%v err=%v", ok, err)
+	}
+}
@@ -0,0 +1,185 @@
+package redis
+
+import (
+	"LearnShare/pkg/errno"
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// leaderboardKeyPattern 匹配全部排行榜键，重建时用于清理不再需要的排行榜
+const leaderboardKeyPattern = "leaderboard:*"
+
+// LeaderboardKey 排行榜有序集合的键，period 如 w20261019、m202610、all，scope 如 all、college:1、major:2
+func LeaderboardKey(board, period, scope string) string {
+	return fmt.Sprintf("leaderboard:%s:%s:%s", board, period, scope)
+}
+
+// LeaderboardScore 用户在某个排行榜上的分数，Score 不大于 0 时移出该排行榜；TTL 为 0 表示不过期
+type LeaderboardScore struct {
+	Key   string
+	Score int64
+	TTL   time.Duration
+}
+
+// LeaderboardEntry 排行榜上的一名用户，Rank 从 1 开始
+type LeaderboardEntry struct {
+	UserID int64
+	Score  int64
+	Rank   int64
+}
+
+// LeaderboardSnapshot 重建时写入的完整排行榜
+type LeaderboardSnapshot struct {
+	Key    string
+	TTL    time.Duration
+	Scores map[int64]int64
+}
+
+// SetLeaderboardScores 写入用户在多个排行榜上的最新分数
+func SetLeaderboardScores(ctx context.Context, userID int64, scores []LeaderboardScore) error {
+	if len(scores) == 0 {
+		return nil
+	}
+	member := strconv.FormatInt(userID, 10)
+	_, err := RDB.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, s := range scores {
+			if s.Score <= 0 {
+				pipe.ZRem(ctx, s.Key, member)
+				continue
+			}
+			pipe.ZAdd(ctx, s.Key, redis.Z{Score: float64(s.Score), Member: member})
+			if s.TTL > 0 {
+				pipe.Expire(ctx, s.Key, s.TTL)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return errno.NewErrNo(errno.InternalRedisErrorCode, "更新排行榜失败: "+err.Error())
+	}
+	return nil
+}
+
+// RemoveFromLeaderboards 将用户移出指定排行榜
+func RemoveFromLeaderboards(ctx context.Context, userID int64, keys []string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	member := strconv.FormatInt(userID, 10)
+	_, err := RDB.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, key := range keys {
+			pipe.ZRem(ctx, key, member)
+		}
+		return nil
+	})
+	if err != nil {
+		return errno.NewErrNo(errno.InternalRedisErrorCode, "移出排行榜失败: "+err.Error())
+	}
+	return nil
+}
+
+// GetLeaderboardPage 按分数从高到低分页查询排行榜，返回本页用户与上榜总人数
+func GetLeaderboardPage(ctx context.Context, key string, offset, limit int64) ([]*LeaderboardEntry, int64, error) {
+	total, err := RDB.ZCard(ctx, key).Result()
+	if err != nil {
+		return nil, 0, errno.NewErrNo(errno.InternalRedisErrorCode, "查询排行榜人数失败: "+err.Error())
+	}
+	if total == 0 || offset >= total {
+		return []*LeaderboardEntry{}, total, nil
+	}
+	members, err := RDB.ZRevRangeWithScores(ctx, key, offset, offset+limit-1).Result()
+	if err != nil {
+		return nil, 0, errno.NewErrNo(errno.InternalRedisErrorCode, "查询排行榜失败: "+err.Error())
+	}
+	entries := make([]*LeaderboardEntry, 0, len(members))
+	for i, m := range members {
+		userID, err := strconv.ParseInt(fmt.Sprint(m.Member), 10, 64)
+		if err != nil {
+			continue
+		}
+		entries = append(entries, &LeaderboardEntry{UserID: userID, Score: int64(m.Score), Rank: offset + int64(i) + 1})
+	}
+	return entries, total, nil
+}
+
+// GetLeaderboardRank 查询用户在排行榜上的名次，未上榜时返回 nil
+func GetLeaderboardRank(ctx context.Context, key string, userID int64) (*LeaderboardEntry, error) {
+	member := strconv.FormatInt(userID, 10)
+	rank, err := RDB.ZRevRank(ctx, key, member).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, nil
+		}
+		return nil, errno.NewErrNo(errno.InternalRedisErrorCode, "查询排行榜名次失败: "+err.Error())
+	}
+	score, err := RDB.ZScore(ctx, key, member).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, nil
+		}
+		return nil, errno.NewErrNo(errno.InternalRedisErrorCode, "查询排行榜分数失败: "+err.Error())
+	}
+	return &LeaderboardEntry{UserID: userID, Score: int64(score), Rank: rank + 1}, nil
+}
+
+// ReplaceLeaderboards 用重建结果整体替换排行榜：先写入临时键再原子改名，重建期间查询不受影响；
+// 不在本次结果中的旧排行榜（如已过期的周期、已没有上榜用户的学院）一并删除
+func ReplaceLeaderboards(ctx context.Context, snapshots []*LeaderboardSnapshot) error {
+	keep := make(map[string]bool, len(snapshots))
+	for _, s := range snapshots {
+		if len(s.Scores) == 0 {
+			continue
+		}
+		tmp := s.Key + ":rebuild"
+		members := make([]redis.Z, 0, len(s.Scores))
+		for userID, score := range s.Scores {
+			if score > 0 {
+				members = append(members, redis.Z{Score: float64(score), Member: strconv.FormatInt(userID, 10)})
+			}
+		}
+		if len(members) == 0 {
+			continue
+		}
+		_, err := RDB.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			pipe.Del(ctx, tmp)
+			pipe.ZAdd(ctx, tmp, members...)
+			pipe.Rename(ctx, tmp, s.Key)
+			if s.TTL > 0 {
+				pipe.Expire(ctx, s.Key, s.TTL)
+			}
+			return nil
+		})
+		if err != nil {
+			return errno.NewErrNo(errno.InternalRedisErrorCode, "写入排行榜失败: "+err.Error())
+		}
+		keep[s.Key] = true
+	}
+
+	var cursor uint64
+	for {
+		keys, next, err := RDB.Scan(ctx, cursor, leaderboardKeyPattern, 500).Result()
+		if err != nil {
+			return errno.NewErrNo(errno.InternalRedisErrorCode, "扫描排行榜失败: "+err.Error())
+		}
+		var stale []string
+		for _, key := range keys {
+			if !keep[key] {
+				stale = append(stale, key)
+			}
+		}
+		if len(stale) > 0 {
+			if err := RDB.Del(ctx, stale...).Err(); err != nil {
+				return errno.NewErrNo(errno.InternalRedisErrorCode, "清理排行榜失败: "+err.Error())
+			}
+		}
+		if next == 0 {
+			return nil
+		}
+		cursor = next
+	}
+}
@@ -0,0 +1,88 @@
+package redis
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestLeaderboardScores(t *testing.T) {
+	server, cleanup := initTestRedis(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	weekKey := LeaderboardKey("uploads", "w20261019", "all")
+	allKey := LeaderboardKey("uploads", "all", "all")
+	for userID, score := range map[int64]int64{1: 5, 2: 9, 3: 7} {
+		if err := SetLeaderboardScores(ctx, userID, []LeaderboardScore{
+			{Key: weekKey, Score: score, TTL: time.Hour},
+			{Key: allKey, Score: score},
+		}); err != nil {
+			t.Fatalf("写入排行榜失败: %v", err)
+		}
+	}
+	if ttl := server.TTL(weekKey); ttl != time.Hour {
+		t.Fatalf("周榜应当设置过期时间: %v", ttl)
+	}
+	if ttl := server.TTL(allKey); ttl != 0 {
+		t.Fatalf("总榜不应过期: %v", ttl)
+	}
+
+	entries, total, err := GetLeaderboardPage(ctx, weekKey, 1, 2)
+	if err != nil {
+		t.Fatalf("查询排行榜失败: %v", err)
+	}
+	if total != 3 || len(entries) != 2 {
+		t.Fatalf("排行榜分页结果不正确: total=%d len=%d", total, len(entries))
+	}
+	if entries[0].UserID != 3 || entries[0].Rank != 2 || entries[1].UserID != 1 || entries[1].Rank != 3 {
+		t.Fatalf("排行榜排序不正确: %+v %+v", entries[0], entries[1])
+	}
+
+	rank, err := GetLeaderboardRank(ctx, weekKey, 2)
+	if err != nil || rank == nil || rank.Rank != 1 || rank.Score != 9 {
+		t.Fatalf("查询用户名次不正确: rank=%+v err=%v", rank, err)
+	}
+
+	if err := SetLeaderboardScores(ctx, 2, []LeaderboardScore{{Key: weekKey, Score: 0}}); err != nil {
+		t.Fatalf("更新排行榜失败: %v", err)
+	}
+	rank, err = GetLeaderboardRank(ctx, weekKey, 2)
+	if err != nil || rank != nil {
+		t.Fatalf("分数归零后应当移出排行榜: rank=%+v err=%v", rank, err)
+	}
+
+	if err := RemoveFromLeaderboards(ctx, 1, []string{weekKey, allKey}); err != nil {
+		t.Fatalf("移出排行榜失败: %v", err)
+	}
+	if rank, _ := GetLeaderboardRank(ctx, allKey, 1); rank != nil {
+		t.Fatalf("用户应当已移出总榜: %+v", rank)
+	}
+}
+
+func TestReplaceLeaderboards(t *testing.T) {
+	server, cleanup := initTestRedis(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	staleKey := LeaderboardKey("uploads", "w20261012", "all")
+	keptKey := LeaderboardKey("reputation", "all", "college:1")
+	_ = SetLeaderboardScores(ctx, 1, []LeaderboardScore{{Key: staleKey, Score: 3}, {Key: keptKey, Score: 100}})
+	_ = SetLeaderboardScores(ctx, 9, []LeaderboardScore{{Key: keptKey, Score: 50}})
+
+	if err := ReplaceLeaderboards(ctx, []*LeaderboardSnapshot{
+		{Key: keptKey, Scores: map[int64]int64{1: 120, 2: 80, 3: 0}},
+	}); err != nil {
+		t.Fatalf("重建排行榜失败: %v", err)
+	}
+	if server.Exists(staleKey) {
+		t.Fatal("重建后不在结果中的旧排行榜应当删除")
+	}
+	entries, total, err := GetLeaderboardPage(ctx, keptKey, 0, 10)
+	if err != nil {
+		t.Fatalf("查询排行榜失败: %v", err)
+	}
+	if total != 2 || entries[0].UserID != 1 || entries[0].Score != 120 || entries[1].UserID != 2 {
+		t.Fatalf("重建后的排行榜不正确: total=%d entries=%+v", total, entries)
+	}
+}
@@ -0,0 +1,138 @@
+package redis
+
+import (
+	"LearnShare/pkg/errno"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// LoginLock 账户或IP的临时锁定记录
+type LoginLock struct {
+	PrevStatus string `json:"prev_status"` // 锁定前的账户状态，解锁时恢复；IP锁定时为空
+	Until      int64  `json:"until"`       // 锁定截止时间 (unix)
+	Level      int    `json:"level"`       // 连续锁定次数，用于计算下次锁定时长
+}
+
+func loginFailAccountKey(email string) string {
+	return fmt.Sprintf("login_fail:account:%s", strings.ToLower(email))
+}
+
+func loginFailIPKey(ip string) string {
+	return fmt.Sprintf("login_fail:ip:%s", ip)
+}
+
+func loginLockKey(userID int64) string {
+	return fmt.Sprintf("login_lock:%d", userID)
+}
+
+func loginIPLockKey(ip string) string {
+	return fmt.Sprintf("login_lock:ip:%s", ip)
+}
+
+// IncrLoginFail 累加账户与IP的登录失败次数，返回累加后的次数
+// ip 为空时（无法获取请求方地址）不累加IP计数，避免所有此类请求共用同一计数而被一并限制
+func IncrLoginFail(ctx context.Context, email, ip string, window time.Duration) (accountCount, ipCount int64, err error) {
+	pipe := RDB.TxPipeline()
+	accountIncr := pipe.Incr(ctx, loginFailAccountKey(email))
+	pipe.ExpireNX(ctx, loginFailAccountKey(email), window)
+	var ipIncr *redis.IntCmd
+	if ip != "" {
+		ipIncr = pipe.Incr(ctx, loginFailIPKey(ip))
+		pipe.ExpireNX(ctx, loginFailIPKey(ip), window)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, 0, errno.NewErrNo(errno.InternalRedisErrorCode, "记录登录失败次数失败: "+err.Error())
+	}
+	if ipIncr != nil {
+		ipCount = ipIncr.Val()
+	}
+	return accountIncr.Val(), ipCount, nil
+}
+
+// GetLoginFailIPCount 获取IP在窗口内的登录失败次数
+func GetLoginFailIPCount(ctx context.Context, ip string) (int64, error) {
+	count, err := RDB.Get(ctx, loginFailIPKey(ip)).Int64()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return 0, nil
+		}
+		return 0, errno.NewErrNo(errno.InternalRedisErrorCode, "获取登录失败次数失败: "+err.Error())
+	}
+	return count, nil
+}
+
+// ClearLoginFailAccount 清除账户的登录失败次数
+func ClearLoginFailAccount(ctx context.Context, email string) error {
+	if err := RDB.Del(ctx, loginFailAccountKey(email)).Err(); err != nil {
+		return errno.NewErrNo(errno.InternalRedisErrorCode, "清除登录失败次数失败: "+err.Error())
+	}
+	return nil
+}
+
+// ClearLoginFailIP 清除IP的登录失败次数
+func ClearLoginFailIP(ctx context.Context, ip string) error {
+	if err := RDB.Del(ctx, loginFailIPKey(ip)).Err(); err != nil {
+		return errno.NewErrNo(errno.InternalRedisErrorCode, "清除登录失败次数失败: "+err.Error())
+	}
+	return nil
+}
+
+// SetLoginLock 写入账户锁定记录，expiration 需长于锁定时长以保留锁定级别
+func SetLoginLock(ctx context.Context, userID int64, lock *LoginLock, expiration time.Duration) error {
+	return setLoginLock(ctx, loginLockKey(userID), lock, expiration)
+}
+
+// GetLoginLock 获取账户锁定记录，不存在时返回 nil
+func GetLoginLock(ctx context.Context, userID int64) (*LoginLock, error) {
+	return getLoginLock(ctx, loginLockKey(userID))
+}
+
+// SetLoginIPLock 写入IP锁定记录，expiration 需长于锁定时长以保留锁定级别
+func SetLoginIPLock(ctx context.Context, ip string, lock *LoginLock, expiration time.Duration) error {
+	return setLoginLock(ctx, loginIPLockKey(ip), lock, expiration)
+}
+
+// GetLoginIPLock 获取IP锁定记录，不存在时返回 nil
+func GetLoginIPLock(ctx context.Context, ip string) (*LoginLock, error) {
+	return getLoginLock(ctx, loginIPLockKey(ip))
+}
+
+func setLoginLock(ctx context.Context, key string, lock *LoginLock, expiration time.Duration) error {
+	value, err := json.Marshal(lock)
+	if err != nil {
+		return errno.NewErrNo(errno.InternalServiceErrorCode, "序列化锁定记录失败: "+err.Error())
+	}
+	if err := RDB.Set(ctx, key, value, expiration).Err(); err != nil {
+		return errno.NewErrNo(errno.InternalRedisErrorCode, "写入锁定记录失败: "+err.Error())
+	}
+	return nil
+}
+
+func getLoginLock(ctx context.Context, key string) (*LoginLock, error) {
+	value, err := RDB.Get(ctx, key).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, nil
+		}
+		return nil, errno.NewErrNo(errno.InternalRedisErrorCode, "获取锁定记录失败: "+err.Error())
+	}
+	var lock LoginLock
+	if err := json.Unmarshal(value, &lock); err != nil {
+		return nil, errno.NewErrNo(errno.InternalRedisErrorCode, "锁定记录格式错误")
+	}
+	return &lock, nil
+}
+
+// DeleteLoginLock 删除账户锁定记录
+func DeleteLoginLock(ctx context.Context, userID int64) error {
+	if err := RDB.Del(ctx, loginLockKey(userID)).Err(); err != nil {
+		return errno.NewErrNo(errno.InternalRedisErrorCode, "删除锁定记录失败: "+err.Error())
+	}
+	return nil
+}
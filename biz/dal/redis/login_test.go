@@ -0,0 +1,111 @@
+package redis
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestLoginFailCounters(t *testing.T) {
+	server, cleanup := initTestRedis(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	for i := 1; i <= 3; i++ {
+		accountCount, ipCount, err := IncrLoginFail(ctx, "User@Example.com", "1.2.3.4", time.Minute)
+		if err != nil {
+			t.Fatalf("记录登录失败次数失败: %v", err)
+		}
+		if accountCount != int64(i) || ipCount != int64(i) {
+			t.Fatalf("第 %d 次失败计数不正确: account=%d ip=%d", i, accountCount, ipCount)
+		}
+	}
+
+	// 账户计数不区分邮箱大小写
+	accountCount, ipCount, err := IncrLoginFail(ctx, "user@example.com", "5.6.7.8", time.Minute)
+	if err != nil {
+		t.Fatalf("记录登录失败次数失败: %v", err)
+	}
+	if accountCount != 4 {
+		t.Fatalf("期望账户失败次数为 4, 实际为 %d", accountCount)
+	}
+
+	if err := ClearLoginFailAccount(ctx, "user@example.com"); err != nil {
+		t.Fatalf("清除登录失败次数失败: %v", err)
+	}
+	ipCount, err = GetLoginFailIPCount(ctx, "1.2.3.4")
+	if err != nil {
+		t.Fatalf("获取IP失败次数失败: %v", err)
+	}
+	if ipCount != 3 {
+		t.Fatalf("清除账户计数不应影响IP计数, 实际为 %d", ipCount)
+	}
+
+	// 没有IP时只累加账户计数
+	accountCount, ipCount, err = IncrLoginFail(ctx, "other@example.com", "", time.Minute)
+	if err != nil || accountCount != 1 || ipCount != 0 {
+		t.Fatalf("没有IP时计数不正确: account=%d ip=%d err=%v", accountCount, ipCount, err)
+	}
+	if server.Exists(loginFailIPKey("")) {
+		t.Fatalf("没有IP时不应写入IP计数")
+	}
+
+	if err := ClearLoginFailIP(ctx, "5.6.7.8"); err != nil {
+		t.Fatalf("清除IP失败次数失败: %v", err)
+	}
+	if count, _ := GetLoginFailIPCount(ctx, "5.6.7.8"); count != 0 {
+		t.Fatalf("清除后IP失败次数应为 0, 实际为 %d", count)
+	}
+
+	server.FastForward(2 * time.Minute)
+	ipCount, err = GetLoginFailIPCount(ctx, "1.2.3.4")
+	if err != nil {
+		t.Fatalf("获取IP失败次数失败: %v", err)
+	}
+	if ipCount != 0 {
+		t.Fatalf("窗口过期后IP失败次数应当清零, 实际为 %d", ipCount)
+	}
+}
+
+func TestLoginLock(t *testing.T) {
+	_, cleanup := initTestRedis(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	lock, err := GetLoginLock(ctx, 1)
+	if err != nil || lock != nil {
+		t.Fatalf("不存在的锁定记录应返回 nil, lock=%v err=%v", lock, err)
+	}
+
+	want := &LoginLock{PrevStatus: "active", Until: time.Now().Add(time.Minute).Unix(), Level: 2}
+	if err := SetLoginLock(ctx, 1, want, time.Hour); err != nil {
+		t.Fatalf("写入锁定记录失败: %v", err)
+	}
+	got, err := GetLoginLock(ctx, 1)
+	if err != nil {
+		t.Fatalf("获取锁定记录失败: %v", err)
+	}
+	if *got != *want {
+		t.Fatalf("锁定记录不一致: got=%+v want=%+v", got, want)
+	}
+
+	if err := DeleteLoginLock(ctx, 1); err != nil {
+		t.Fatalf("删除锁定记录失败: %v", err)
+	}
+	if got, _ := GetLoginLock(ctx, 1); got != nil {
+		t.Fatalf("删除后锁定记录仍然存在")
+	}
+
+	// IP锁定记录与账户锁定记录互不影响
+	ipLock := &LoginLock{Until: time.Now().Add(time.Minute).Unix(), Level: 3}
+	if err := SetLoginIPLock(ctx, "1.2.3.4", ipLock, time.Hour); err != nil {
+		t.Fatalf("写入IP锁定记录失败: %v", err)
+	}
+	got, err = GetLoginIPLock(ctx, "1.2.3.4")
+	if err != nil || got == nil || *got != *ipLock {
+		t.Fatalf("IP锁定记录不一致: got=%+v err=%v", got, err)
+	}
+	if got, _ := GetLoginIPLock(ctx, "5.6.7.8"); got != nil {
+		t.Fatalf("其他IP不应存在锁定记录")
+	}
+}
@@ -0,0 +1,48 @@
+package redis
+
+import (
+	"LearnShare/pkg/errno"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// OIDCState 授权请求发起时保存的一次性参数
+type OIDCState struct {
+	CodeVerifier string `json:"code_verifier"`
+	Nonce        string `json:"nonce"`
+}
+
+// SetOIDCState 保存授权请求的 state，10 分钟内有效
+func SetOIDCState(ctx context.Context, state string, data *OIDCState) error {
+	key := fmt.Sprintf("oidc_state:%s", state)
+	value, err := json.Marshal(data)
+	if err != nil {
+		return errno.NewErrNo(errno.InternalServiceErrorCode, "序列化 OIDC state 失败: "+err.Error())
+	}
+	if err := RDB.Set(ctx, key, value, 10*time.Minute).Err(); err != nil {
+		return errno.NewErrNo(errno.InternalRedisErrorCode, "保存 OIDC state 失败: "+err.Error())
+	}
+	return nil
+}
+
+// TakeOIDCState 取出并删除 state，不存在或已使用时返回 nil
+func TakeOIDCState(ctx context.Context, state string) (*OIDCState, error) {
+	key := fmt.Sprintf("oidc_state:%s", state)
+	value, err := RDB.GetDel(ctx, key).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, nil
+		}
+		return nil, errno.NewErrNo(errno.InternalRedisErrorCode, "获取 OIDC state 失败: "+err.Error())
+	}
+	var data OIDCState
+	if err := json.Unmarshal(value, &data); err != nil {
+		return nil, errno.NewErrNo(errno.InternalRedisErrorCode, "OIDC state 格式错误")
+	}
+	return &data, nil
+}
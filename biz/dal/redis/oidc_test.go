@@ -0,0 +1,32 @@
+package redis
+
+import (
+	"context"
+	"testing"
+)
+
+func TestOIDCStateIsOneTime(t *testing.T) {
+	_, cleanup := initTestRedis(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	if err := SetOIDCState(ctx, "state123", &OIDCState{CodeVerifier: "verifier", Nonce: "nonce"}); err != nil {
+		t.Fatalf("保存 OIDC state 失败: %v", err)
+	}
+
+	state, err := TakeOIDCState(ctx, "state123")
+	if err != nil {
+		t.Fatalf("获取 OIDC state 失败: %v", err)
+	}
+	if state == nil || state.CodeVerifier != "verifier" || state.Nonce != "nonce" {
+		t.Fatalf("OIDC state 内容不正确: %+v", state)
+	}
+
+	again, err := TakeOIDCState(ctx, "state123")
+	if err != nil {
+		t.Fatalf("再次获取 OIDC state 失败: %v", err)
+	}
+	if again != nil {
+		t.Fatalf("state 被使用后应当失效")
+	}
+}
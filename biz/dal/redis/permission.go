@@ -3,20 +3,105 @@ package redis
 import (
 	"LearnShare/pkg/errno"
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// permissionVersionKey 权限快照的全局版本号，任何角色或权限写入都会使其递增
+	permissionVersionKey = "permission_version"
+	// PermissionInvalidateChannel 权限失效广播频道
+	PermissionInvalidateChannel = "permission_invalidate"
 )
 
-func SetPermissionCache(ctx context.Context, key string, value string) error {
-	err := RDB.Set(ctx, key, value, 0).Err()
+// PermissionInvalidation 权限失效广播消息
+type PermissionInvalidation struct {
+	Version int64 `json:"version"`
+	RoleID  int64 `json:"role_id"` // 0 表示全部角色
+}
+
+func rolePermissionsKey(roleID, version int64) string {
+	return fmt.Sprintf("role_permissions:%d:v%d", roleID, version)
+}
+
+// GetPermissionVersion 获取当前权限快照版本号，未写入过时为 0
+func GetPermissionVersion(ctx context.Context) (int64, error) {
+	version, err := RDB.Get(ctx, permissionVersionKey).Int64()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return 0, nil
+		}
+		return 0, errno.NewErrNo(errno.InternalRedisErrorCode, "获取权限版本失败: "+err.Error())
+	}
+	return version, nil
+}
+
+// SetRolePermissionSnapshot 保存角色在指定版本下的权限快照
+func SetRolePermissionSnapshot(ctx context.Context, roleID, version int64, value string, ttl time.Duration) error {
+	err := RDB.Set(ctx, rolePermissionsKey(roleID, version), value, ttl).Err()
 	if err != nil {
 		return errno.NewErrNo(errno.InternalRedisErrorCode, "设置权限缓存失败: "+err.Error())
 	}
 	return nil
 }
 
-func GetPermissionCache(ctx context.Context, key string) (string, error) {
-	value, err := RDB.Get(ctx, key).Result()
+// GetRolePermissionSnapshot 获取角色在指定版本下的权限快照，不存在时 ok 为 false
+func GetRolePermissionSnapshot(ctx context.Context, roleID, version int64) (string, bool, error) {
+	value, err := RDB.Get(ctx, rolePermissionsKey(roleID, version)).Result()
 	if err != nil {
-		return "", errno.NewErrNo(errno.InternalRedisErrorCode, "获取权限缓存失败: "+err.Error())
+		if errors.Is(err, redis.Nil) {
+			return "", false, nil
+		}
+		return "", false, errno.NewErrNo(errno.InternalRedisErrorCode, "获取权限缓存失败: "+err.Error())
+	}
+	return value, true, nil
+}
+
+// InvalidatePermissions 递增权限版本号使所有旧快照失效，并广播给其他实例
+func InvalidatePermissions(ctx context.Context, roleID int64) (int64, error) {
+	version, err := RDB.Incr(ctx, permissionVersionKey).Result()
+	if err != nil {
+		return 0, errno.NewErrNo(errno.InternalRedisErrorCode, "更新权限版本失败: "+err.Error())
+	}
+
+	payload, err := json.Marshal(&PermissionInvalidation{Version: version, RoleID: roleID})
+	if err != nil {
+		return 0, errno.NewErrNo(errno.InternalServiceErrorCode, "序列化权限失效消息失败: "+err.Error())
+	}
+	if err := RDB.Publish(ctx, PermissionInvalidateChannel, payload).Err(); err != nil {
+		return 0, errno.NewErrNo(errno.InternalRedisErrorCode, "广播权限失效消息失败: "+err.Error())
+	}
+	return version, nil
+}
+
+// SubscribePermissionInvalidation 订阅权限失效广播，ctx 结束后退出；断线重连由客户端自动完成
+func SubscribePermissionInvalidation(ctx context.Context, handler func(*PermissionInvalidation)) error {
+	pubsub := RDB.Subscribe(ctx, PermissionInvalidateChannel)
+	defer pubsub.Close()
+
+	// 等待订阅确认，确保返回前已开始接收消息
+	if _, err := pubsub.Receive(ctx); err != nil {
+		return errno.NewErrNo(errno.InternalRedisErrorCode, "订阅权限失效消息失败: "+err.Error())
+	}
+
+	ch := pubsub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case msg, ok := <-ch:
+			if !ok {
+				return nil
+			}
+			var data PermissionInvalidation
+			if err := json.Unmarshal([]byte(msg.Payload), &data); err != nil {
+				continue
+			}
+			handler(&data)
+		}
 	}
-	return value, nil
 }
@@ -0,0 +1,85 @@
+package redis
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestRolePermissionSnapshot(t *testing.T) {
+	_, cleanup := initTestRedis(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	version, err := GetPermissionVersion(ctx)
+	if err != nil || version != 0 {
+		t.Fatalf("初始版本应为 0, 实际 %d, err=%v", version, err)
+	}
+
+	if err := SetRolePermissionSnapshot(ctx, 3, version, "review.handle,audit.log.view", time.Hour); err != nil {
+		t.Fatalf("设置权限快照失败: %v", err)
+	}
+	value, ok, err := GetRolePermissionSnapshot(ctx, 3, version)
+	if err != nil || !ok || value != "review.handle,audit.log.view" {
+		t.Fatalf("权限快照不正确: %q %v, err=%v", value, ok, err)
+	}
+
+	newVersion, err := InvalidatePermissions(ctx, 3)
+	if err != nil || newVersion != 1 {
+		t.Fatalf("失效后版本应为 1, 实际 %d, err=%v", newVersion, err)
+	}
+	if _, ok, _ := GetRolePermissionSnapshot(ctx, 3, newVersion); ok {
+		t.Fatalf("新版本下不应存在旧快照")
+	}
+}
+
+func TestPermissionInvalidationBroadcast(t *testing.T) {
+	_, cleanup := initTestRedis(t)
+	defer cleanup()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	received := make(chan *PermissionInvalidation, 1)
+	done := make(chan error, 1)
+	go func() {
+		done <- SubscribePermissionInvalidation(ctx, func(msg *PermissionInvalidation) {
+			received <- msg
+		})
+	}()
+
+	// 等待订阅建立后再广播
+	deadline := time.Now().Add(time.Second)
+	for {
+		n, err := RDB.PubSubNumSub(ctx, PermissionInvalidateChannel).Result()
+		if err == nil && n[PermissionInvalidateChannel] > 0 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("订阅未建立")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	if _, err := InvalidatePermissions(ctx, 5); err != nil {
+		t.Fatalf("广播权限失效失败: %v", err)
+	}
+	select {
+	case msg := <-received:
+		if msg.Version != 1 || msg.RoleID != 5 {
+			t.Fatalf("广播消息不正确: %+v", msg)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("未收到权限失效广播")
+	}
+
+	cancel()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("取消订阅返回错误: %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("取消后订阅未退出")
+	}
+}
@@ -0,0 +1,75 @@
+package redis
+
+import (
+	"LearnShare/pkg/errno"
+	"context"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// slidingWindowScript 滑动窗口限流：清理窗口外记录后计数，未超限时记录本次请求
+// 返回 {是否放行, 窗口内请求数, 最早一条记录的时间戳(毫秒)}
+var slidingWindowScript = redis.NewScript(`
+local key = KEYS[1]
+local now = tonumber(ARGV[1])
+local window = tonumber(ARGV[2])
+local limit = tonumber(ARGV[3])
+local member = ARGV[4]
+
+redis.call('ZREMRANGEBYSCORE', key, '-inf', now - window)
+local count = redis.call('ZCARD', key)
+local allowed = 0
+if count < limit then
+	redis.call('ZADD', key, now, member)
+	count = count + 1
+	allowed = 1
+end
+redis.call('PEXPIRE', key, window)
+
+local oldest = redis.call('ZRANGE', key, 0, 0, 'WITHSCORES')
+local oldestScore = now
+if oldest[2] then
+	oldestScore = tonumber(oldest[2])
+end
+return {allowed, count, oldestScore}
+`)
+
+// RateLimitResult 限流检查结果
+type RateLimitResult struct {
+	Allowed   bool
+	Remaining int64         // 窗口内剩余可用次数
+	Reset     time.Duration // 距离窗口内最早一次请求过期（即释放一个名额）的时长
+}
+
+func rateLimitKey(policy, subject string) string {
+	return fmt.Sprintf("rate_limit:%s:%s", policy, subject)
+}
+
+// SlidingWindowAllow 按滑动窗口检查 subject 在 policy 下是否还可以请求
+func SlidingWindowAllow(ctx context.Context, policy, subject string, limit int64, window time.Duration) (*RateLimitResult, error) {
+	now := time.Now().UnixMilli()
+	member := fmt.Sprintf("%d-%d", now, rand.Int63())
+
+	vals, err := slidingWindowScript.Run(ctx, RDB, []string{rateLimitKey(policy, subject)},
+		now, window.Milliseconds(), limit, member).Int64Slice()
+	if err != nil {
+		return nil, errno.NewErrNo(errno.InternalRedisErrorCode, "限流检查失败: "+err.Error())
+	}
+
+	reset := time.Duration(vals[2]+window.Milliseconds()-now) * time.Millisecond
+	if reset < 0 {
+		reset = 0
+	}
+	remaining := limit - vals[1]
+	if remaining < 0 {
+		remaining = 0
+	}
+	return &RateLimitResult{
+		Allowed:   vals[0] == 1,
+		Remaining: remaining,
+		Reset:     reset,
+	}, nil
+}
@@ -0,0 +1,70 @@
+package redis
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestSlidingWindowAllow(t *testing.T) {
+	_, cleanup := initTestRedis(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	for i := 1; i <= 3; i++ {
+		res, err := SlidingWindowAllow(ctx, "comment", "user:1", 3, time.Minute)
+		if err != nil {
+			t.Fatalf("限流检查失败: %v", err)
+		}
+		if !res.Allowed || res.Remaining != int64(3-i) {
+			t.Fatalf("第 %d 次请求结果不正确: %+v", i, res)
+		}
+	}
+
+	res, err := SlidingWindowAllow(ctx, "comment", "user:1", 3, time.Minute)
+	if err != nil {
+		t.Fatalf("限流检查失败: %v", err)
+	}
+	if res.Allowed || res.Remaining != 0 {
+		t.Fatalf("超出限制的请求应当被拒绝: %+v", res)
+	}
+	if res.Reset <= 0 || res.Reset > time.Minute {
+		t.Fatalf("重置时间不正确: %v", res.Reset)
+	}
+
+	// 不同主体、不同策略互不影响
+	res, err = SlidingWindowAllow(ctx, "comment", "user:2", 3, time.Minute)
+	if err != nil || !res.Allowed {
+		t.Fatalf("其他用户不应受影响: %+v, %v", res, err)
+	}
+	res, err = SlidingWindowAllow(ctx, "report", "user:1", 3, time.Minute)
+	if err != nil || !res.Allowed {
+		t.Fatalf("其他策略不应受影响: %+v, %v", res, err)
+	}
+}
+
+func TestSlidingWindowAllowExpires(t *testing.T) {
+	_, cleanup := initTestRedis(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	window := 50 * time.Millisecond
+	for i := 0; i < 2; i++ {
+		if _, err := SlidingWindowAllow(ctx, "search", "ip:1.2.3.4", 2, window); err != nil {
+			t.Fatalf("限流检查失败: %v", err)
+		}
+	}
+	res, _ := SlidingWindowAllow(ctx, "search", "ip:1.2.3.4", 2, window)
+	if res.Allowed {
+		t.Fatalf("窗口内超出限制的请求应当被拒绝")
+	}
+
+	time.Sleep(2 * window)
+	res, err := SlidingWindowAllow(ctx, "search", "ip:1.2.3.4", 2, window)
+	if err != nil {
+		t.Fatalf("限流检查失败: %v", err)
+	}
+	if !res.Allowed {
+		t.Fatalf("窗口滑过后应当重新放行")
+	}
+}
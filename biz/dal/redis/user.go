@@ -4,6 +4,7 @@ import (
 	"LearnShare/biz/dal/db"
 	"LearnShare/pkg/errno"
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -69,23 +70,40 @@ func IsBlacklistToken(ctx context.Context, token string) (bool, error) {
 	return false, nil
 }
 
+// SetUserInfoCache 以 JSON 写入用户信息缓存，不含密码哈希
 func SetUserInfoCache(ctx context.Context, userId string, data *db.User, expiration time.Duration) error {
-	err := RDB.Set(ctx, userId, data, expiration).Err()
+	value, err := json.Marshal(data)
+	if err != nil {
+		return errno.NewErrNo(errno.InternalServiceErrorCode, "序列化用户信息失败: "+err.Error())
+	}
+	err = RDB.Set(ctx, userId, value, expiration).Err()
 	if err != nil {
 		return errno.NewErrNo(errno.InternalRedisErrorCode, "设置用户信息缓存失败: "+err.Error())
 	}
 	return nil
 }
 
+// GetUserInfoCache 读取用户信息缓存
 func GetUserInfoCache(ctx context.Context, userId string) (*db.User, error) {
-	var user db.User
-	err := RDB.Get(ctx, userId).Scan(&user)
+	value, err := RDB.Get(ctx, userId).Bytes()
 	if err != nil {
 		return nil, errno.NewErrNo(errno.InternalRedisErrorCode, "获取用户信息缓存失败: "+err.Error())
 	}
+	var user db.User
+	if err := json.Unmarshal(value, &user); err != nil {
+		return nil, errno.NewErrNo(errno.InternalServiceErrorCode, "解析用户信息缓存失败: "+err.Error())
+	}
 	return &user, nil
 }
 
+// DeleteUserInfoCache 删除用户信息缓存
+func DeleteUserInfoCache(ctx context.Context, userId string) error {
+	if err := RDB.Del(ctx, userId).Err(); err != nil {
+		return errno.NewErrNo(errno.InternalRedisErrorCode, "删除用户信息缓存失败: "+err.Error())
+	}
+	return nil
+}
+
 // SetEmailRateLimit 设置邮件发送频率限制（同一IP 1分钟间隔）
 func SetEmailRateLimit(ctx context.Context, ip string) error {
 	key := fmt.Sprintf("email_rate_limit:%s", ip)
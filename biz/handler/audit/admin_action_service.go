@@ -0,0 +1,64 @@
+// Code generated by hertz generator.
+
+package audit
+
+import (
+	"LearnShare/biz/pack"
+	"LearnShare/biz/service"
+	"LearnShare/pkg/errno"
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"LearnShare/biz/model/audit"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+)
+
+// ListAdminActions .
+// @router /api/admin/actions [GET]
+func ListAdminActions(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req audit.ListAdminActionsReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp := new(audit.ListAdminActionsResp)
+	actions, total, err := service.NewAdminActionService(ctx, c).ListAdminActions(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp.BaseResp = pack.BuildBaseResp(errno.Success)
+	resp.ActionList = actions
+	resp.Total = total
+	pack.SendResponse(c, resp)
+}
+
+// ExportAdminActions .
+// @router /api/admin/actions/export [GET]
+func ExportAdminActions(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req audit.ExportAdminActionsReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	// 导出成功时直接返回 CSV 文件
+	var buf bytes.Buffer
+	if err = service.NewAdminActionService(ctx, c).ExportAdminActions(&req, &buf); err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="admin_actions_%s.csv"`, time.Now().Format("20060102150405")))
+	c.Data(consts.StatusOK, "text/csv; charset=utf-8", buf.Bytes())
+}
@@ -166,7 +166,14 @@ func GetCourseCommentAuditList(ctx context.Context, c *app.RequestContext) {
 	}
 
 	resp := new(audit.GetCourseCommentAuditListResp)
+	list, err := service.NewAuditService(ctx, c).GetCourseCommentAuditList(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
 	resp.BaseResp = pack.BuildBaseResp(errno.Success)
+	resp.CommentAuditList = list
 	pack.SendResponse(c, resp)
 }
 
@@ -191,3 +198,93 @@ func GetResourceCommentAuditList(ctx context.Context, c *app.RequestContext) {
 	resp.CommentAuditList = list
 	pack.SendResponse(c, resp)
 }
+
+// GetCourseRatingAuditList .
+// @router /api/admin/audit/course_ratings [GET]
+func GetCourseRatingAuditList(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req audit.GetCourseRatingAuditListReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp := new(audit.GetCourseRatingAuditListResp)
+	list, err := service.NewAuditService(ctx, c).GetCourseRatingAuditList(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp.BaseResp = pack.BuildBaseResp(errno.Success)
+	resp.RatingAuditList = list
+	pack.SendResponse(c, resp)
+}
+
+// AuditCourseRating .
+// @router /api/admin/audit/course_ratings/:review_id [POST]
+func AuditCourseRating(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req audit.AuditCourseRatingReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp := new(audit.AuditCourseRatingResp)
+	err = service.NewAuditService(ctx, c).AuditCourseRating(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp.BaseResp = pack.BuildBaseResp(errno.Success)
+	pack.SendResponse(c, resp)
+}
+
+// GetResourceRatingAuditList .
+// @router /api/admin/audit/resource_ratings [GET]
+func GetResourceRatingAuditList(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req audit.GetResourceRatingAuditListReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp := new(audit.GetResourceRatingAuditListResp)
+	list, err := service.NewAuditService(ctx, c).GetResourceRatingAuditList(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp.BaseResp = pack.BuildBaseResp(errno.Success)
+	resp.RatingAuditList = list
+	pack.SendResponse(c, resp)
+}
+
+// AuditResourceRating .
+// @router /api/admin/audit/resource_ratings/:review_id [POST]
+func AuditResourceRating(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req audit.AuditResourceRatingReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp := new(audit.AuditResourceRatingResp)
+	err = service.NewAuditService(ctx, c).AuditResourceRating(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp.BaseResp = pack.BuildBaseResp(errno.Success)
+	pack.SendResponse(c, resp)
+}
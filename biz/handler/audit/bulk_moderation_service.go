@@ -0,0 +1,86 @@
+// Code generated by hertz generator.
+
+package audit
+
+import (
+	"LearnShare/biz/pack"
+	"LearnShare/biz/service"
+	"LearnShare/pkg/errno"
+	"context"
+
+	"LearnShare/biz/model/audit"
+
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// BatchAuditReviews .
+// @router /api/admin/audit/reviews/batch [POST]
+func BatchAuditReviews(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req audit.BatchAuditReviewsReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp := new(audit.BatchAuditReviewsResp)
+	results, succeeded, err := service.NewBulkModerationService(ctx, c).BatchAuditReviews(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp.BaseResp = pack.BuildBaseResp(errno.Success)
+	resp.ResultList = results
+	resp.SuccessCount = succeeded
+	pack.SendResponse(c, resp)
+}
+
+// HideUserComments .
+// @router /api/admin/audit/users/:user_id/hide_comments [POST]
+func HideUserComments(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req audit.HideUserCommentsReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp := new(audit.HideUserCommentsResp)
+	results, succeeded, err := service.NewBulkModerationService(ctx, c).HideUserComments(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp.BaseResp = pack.BuildBaseResp(errno.Success)
+	resp.ResultList = results
+	resp.SuccessCount = succeeded
+	pack.SendResponse(c, resp)
+}
+
+// RestoreContent .
+// @router /api/admin/audit/restore [POST]
+func RestoreContent(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req audit.RestoreContentReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp := new(audit.RestoreContentResp)
+	results, succeeded, err := service.NewBulkModerationService(ctx, c).RestoreContent(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp.BaseResp = pack.BuildBaseResp(errno.Success)
+	resp.ResultList = results
+	resp.SuccessCount = succeeded
+	pack.SendResponse(c, resp)
+}
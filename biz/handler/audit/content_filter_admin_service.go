@@ -0,0 +1,133 @@
+// Code generated by hertz generator.
+
+package audit
+
+import (
+	"LearnShare/biz/pack"
+	"LearnShare/biz/service"
+	"LearnShare/pkg/errno"
+	"context"
+
+	"LearnShare/biz/model/audit"
+
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// ListContentFilterRules .
+// @router /api/admin/content_filters [GET]
+func ListContentFilterRules(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req audit.ListContentFilterRulesReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp := new(audit.ListContentFilterRulesResp)
+	rules, total, err := service.NewContentFilterAdminService(ctx, c).ListContentFilterRules(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp.BaseResp = pack.BuildBaseResp(errno.Success)
+	resp.RuleList = rules
+	resp.Total = total
+	pack.SendResponse(c, resp)
+}
+
+// CreateContentFilterRule .
+// @router /api/admin/content_filters [POST]
+func CreateContentFilterRule(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req audit.CreateContentFilterRuleReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp := new(audit.CreateContentFilterRuleResp)
+	rule, err := service.NewContentFilterAdminService(ctx, c).CreateContentFilterRule(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp.BaseResp = pack.BuildBaseResp(errno.Success)
+	resp.Rule = rule
+	pack.SendResponse(c, resp)
+}
+
+// UpdateContentFilterRule .
+// @router /api/admin/content_filters/:rule_id [PUT]
+func UpdateContentFilterRule(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req audit.UpdateContentFilterRuleReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp := new(audit.UpdateContentFilterRuleResp)
+	rule, err := service.NewContentFilterAdminService(ctx, c).UpdateContentFilterRule(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp.BaseResp = pack.BuildBaseResp(errno.Success)
+	resp.Rule = rule
+	pack.SendResponse(c, resp)
+}
+
+// DeleteContentFilterRule .
+// @router /api/admin/content_filters/:rule_id [DELETE]
+func DeleteContentFilterRule(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req audit.DeleteContentFilterRuleReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp := new(audit.DeleteContentFilterRuleResp)
+	err = service.NewContentFilterAdminService(ctx, c).DeleteContentFilterRule(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp.BaseResp = pack.BuildBaseResp(errno.Success)
+	pack.SendResponse(c, resp)
+}
+
+// TestContentFilter .
+// @router /api/admin/content_filters/test [POST]
+func TestContentFilter(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req audit.TestContentFilterReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp := new(audit.TestContentFilterResp)
+	action, matches, masked, err := service.NewContentFilterAdminService(ctx, c).TestContentFilter(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp.BaseResp = pack.BuildBaseResp(errno.Success)
+	if action != "" {
+		resp.Action = &action
+	}
+	resp.MatchList = matches
+	resp.MaskedText = masked
+	pack.SendResponse(c, resp)
+}
@@ -0,0 +1,83 @@
+// Code generated by hertz generator.
+
+package audit
+
+import (
+	"LearnShare/biz/pack"
+	"LearnShare/biz/service"
+	"LearnShare/pkg/errno"
+	"context"
+
+	"LearnShare/biz/model/audit"
+
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// ApplyModerationAction .
+// @router /api/admin/audit/reviews/:review_id/actions [POST]
+func ApplyModerationAction(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req audit.ApplyModerationActionReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp := new(audit.ApplyModerationActionResp)
+	action, err := service.NewModerationAdminService(ctx, c).ApplyModerationAction(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp.BaseResp = pack.BuildBaseResp(errno.Success)
+	resp.ModerationAction = action
+	pack.SendResponse(c, resp)
+}
+
+// GetAppealList .
+// @router /api/admin/audit/appeals [GET]
+func GetAppealList(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req audit.GetAppealListReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp := new(audit.GetAppealListResp)
+	appeals, total, err := service.NewModerationAdminService(ctx, c).GetAppealList(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp.BaseResp = pack.BuildBaseResp(errno.Success)
+	resp.AppealList = appeals
+	resp.Total = total
+	pack.SendResponse(c, resp)
+}
+
+// ResolveAppeal .
+// @router /api/admin/audit/appeals/:review_id [POST]
+func ResolveAppeal(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req audit.ResolveAppealReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp := new(audit.ResolveAppealResp)
+	err = service.NewModerationAdminService(ctx, c).ResolveAppeal(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp.BaseResp = pack.BuildBaseResp(errno.Success)
+	pack.SendResponse(c, resp)
+}
@@ -0,0 +1,37 @@
+// Code generated by hertz generator.
+
+package audit
+
+import (
+	"LearnShare/biz/pack"
+	"LearnShare/biz/service"
+	"LearnShare/pkg/errno"
+	"context"
+
+	"LearnShare/biz/model/audit"
+
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// GetModerationStats .
+// @router /api/admin/reports/moderation [GET]
+func GetModerationStats(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req audit.GetModerationStatsReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp := new(audit.GetModerationStatsResp)
+	stats, err := service.NewModerationStatsService(ctx, c).GetModerationStats(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp.BaseResp = pack.BuildBaseResp(errno.Success)
+	resp.Stats = stats
+	pack.SendResponse(c, resp)
+}
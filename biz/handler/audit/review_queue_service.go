@@ -0,0 +1,150 @@
+// Code generated by hertz generator.
+
+package audit
+
+import (
+	"LearnShare/biz/pack"
+	"LearnShare/biz/service"
+	"LearnShare/pkg/errno"
+	"context"
+
+	"LearnShare/biz/model/audit"
+
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// ClaimNextReviews .
+// @router /api/admin/audit/queue/claim [POST]
+func ClaimNextReviews(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req audit.ClaimNextReviewsReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp := new(audit.ClaimNextReviewsResp)
+	reviews, err := service.NewReviewQueueService(ctx, c).ClaimNextReviews(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp.BaseResp = pack.BuildBaseResp(errno.Success)
+	resp.ReviewList = reviews
+	pack.SendResponse(c, resp)
+}
+
+// GetReviewQueueStats .
+// @router /api/admin/audit/queue/stats [GET]
+func GetReviewQueueStats(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req audit.GetReviewQueueStatsReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp := new(audit.GetReviewQueueStatsResp)
+	stats, err := service.NewReviewQueueService(ctx, c).GetReviewQueueStats(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp.BaseResp = pack.BuildBaseResp(errno.Success)
+	resp.Stats = stats
+	pack.SendResponse(c, resp)
+}
+
+// GetReviewInbox .
+// @router /api/admin/audit/inbox [GET]
+func GetReviewInbox(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req audit.GetReviewInboxReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp := new(audit.GetReviewInboxResp)
+	reviews, err := service.NewReviewQueueService(ctx, c).GetReviewInbox()
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp.BaseResp = pack.BuildBaseResp(errno.Success)
+	resp.ReviewList = reviews
+	pack.SendResponse(c, resp)
+}
+
+// ClaimReview .
+// @router /api/admin/audit/reviews/:review_id/claim [POST]
+func ClaimReview(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req audit.ClaimReviewReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp := new(audit.ClaimReviewResp)
+	review, err := service.NewReviewQueueService(ctx, c).ClaimReview(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp.BaseResp = pack.BuildBaseResp(errno.Success)
+	resp.Review = review
+	pack.SendResponse(c, resp)
+}
+
+// ReleaseReview .
+// @router /api/admin/audit/reviews/:review_id/claim [DELETE]
+func ReleaseReview(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req audit.ReleaseReviewReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp := new(audit.ReleaseReviewResp)
+	if err = service.NewReviewQueueService(ctx, c).ReleaseReview(&req); err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp.BaseResp = pack.BuildBaseResp(errno.Success)
+	pack.SendResponse(c, resp)
+}
+
+// GetReviewReporters .
+// @router /api/admin/audit/reviews/:review_id/reporters [GET]
+func GetReviewReporters(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req audit.GetReviewReportersReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp := new(audit.GetReviewReportersResp)
+	reporters, err := service.NewReviewQueueService(ctx, c).GetReviewReporters(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp.BaseResp = pack.BuildBaseResp(errno.Success)
+	resp.ReporterList = reporters
+	pack.SendResponse(c, resp)
+}
@@ -81,3 +81,47 @@ func AdminDeleteCourse(ctx context.Context, c *app.RequestContext) {
 	resp.BaseResp = pack.BuildBaseResp(errno.Success)
 	pack.SendResponse(c, resp)
 }
+
+// AdminRestoreCourse .
+// @router /api/admin/courses/:course_id/restore [POST]
+func AdminRestoreCourse(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req course.AdminRestoreCourseReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp := new(course.AdminRestoreCourseResp)
+
+	if err = service.NewCourseService(ctx, c).AdminRestoreCourse(&req); err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp.BaseResp = pack.BuildBaseResp(errno.Success)
+	pack.SendResponse(c, resp)
+}
+
+// AdminRestoreCourseComment .
+// @router /api/admin/course_comments/:comment_id/restore [POST]
+func AdminRestoreCourseComment(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req course.AdminRestoreCourseCommentReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp := new(course.AdminRestoreCourseCommentResp)
+
+	if err = service.NewCourseService(ctx, c).AdminRestoreCourseComment(&req); err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp.BaseResp = pack.BuildBaseResp(errno.Success)
+	pack.SendResponse(c, resp)
+}
@@ -247,3 +247,47 @@ func ReactCourseComment(ctx context.Context, c *app.RequestContext) {
 	pack.SendResponse(c, resp)
 
 }
+
+// ReportCourseComment .
+// @router /api/course_comments/:comment_id/report [POST]
+func ReportCourseComment(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req course.ReportCourseCommentReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp := new(course.ReportCourseCommentResp)
+	err = service.NewCourseService(ctx, c).ReportCourseComment(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp.BaseResp = pack.BuildBaseResp(errno.Success)
+	pack.SendResponse(c, resp)
+}
+
+// ReportCourseRating .
+// @router /api/course_ratings/:rating_id/report [POST]
+func ReportCourseRating(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req course.ReportCourseRatingReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp := new(course.ReportCourseRatingResp)
+	err = service.NewCourseService(ctx, c).ReportCourseRating(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp.BaseResp = pack.BuildBaseResp(errno.Success)
+	pack.SendResponse(c, resp)
+}
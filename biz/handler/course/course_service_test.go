@@ -38,7 +38,8 @@ CREATE TABLE IF NOT EXISTS courses (
     grade TEXT NOT NULL,
     description TEXT,
     created_at DATETIME,
-    updated_at DATETIME
+    updated_at DATETIME,
+    deleted_at DATETIME
 );
 `
 	if err := sqliteDB.Exec(createCourseTableSQL).Error; err != nil {
@@ -74,7 +75,8 @@ CREATE TABLE IF NOT EXISTS course_comments (
     parent_id INTEGER DEFAULT 0,
     is_visible BOOLEAN DEFAULT 1,
     created_at DATETIME,
-    updated_at DATETIME
+    updated_at DATETIME,
+    deleted_at DATETIME
 );
 `
 	if err := sqliteDB.Exec(createCourseCommentTableSQL).Error; err != nil {
@@ -96,7 +98,8 @@ CREATE TABLE IF NOT EXISTS resources (
     average_rating REAL DEFAULT 0.0,
     rating_count INTEGER DEFAULT 0,
     status TEXT DEFAULT 'pending_review',
-    created_at DATETIME
+    created_at DATETIME,
+    deleted_at DATETIME
 );
 `
 	if err := sqliteDB.Exec(createResourceTableSQL).Error; err != nil {
@@ -0,0 +1,39 @@
+// Code generated by hertz generator.
+
+package leaderboard
+
+import (
+	"LearnShare/biz/pack"
+	"LearnShare/biz/service"
+	"LearnShare/pkg/errno"
+	"context"
+
+	"LearnShare/biz/model/leaderboard"
+
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// GetLeaderboard .
+// @router /api/leaderboards/:board [GET]
+func GetLeaderboard(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req leaderboard.GetLeaderboardReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp := new(leaderboard.GetLeaderboardResp)
+	entries, total, mine, err := service.NewLeaderboardService(ctx, c).GetLeaderboard(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp.BaseResp = pack.BuildBaseResp(errno.Success)
+	resp.EntryList = entries
+	resp.Total = total
+	resp.MyEntry = mine
+	pack.SendResponse(c, resp)
+}
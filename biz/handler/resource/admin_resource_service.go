@@ -78,3 +78,47 @@ func AdminDeleteResource(ctx context.Context, c *app.RequestContext) {
 	resp.BaseResp = pack.BuildBaseResp(errno.Success)
 	pack.SendResponse(c, resp)
 }
+
+// AdminRestoreResource .
+// @router /api/admin/resources/:resource_id/restore [POST]
+func AdminRestoreResource(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req resource.AdminRestoreResourceReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp := new(resource.AdminRestoreResourceResp)
+
+	if err = service.NewResourceService(ctx, c).AdminRestoreResource(&req); err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp.BaseResp = pack.BuildBaseResp(errno.Success)
+	pack.SendResponse(c, resp)
+}
+
+// AdminRestoreResourceComment .
+// @router /api/admin/resource_comments/:comment_id/restore [POST]
+func AdminRestoreResourceComment(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req resource.AdminRestoreResourceCommentReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp := new(resource.AdminRestoreResourceCommentResp)
+
+	if err = service.NewResourceService(ctx, c).AdminRestoreResourceComment(&req); err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp.BaseResp = pack.BuildBaseResp(errno.Success)
+	pack.SendResponse(c, resp)
+}
@@ -317,3 +317,47 @@ func ReactResourceComment(ctx context.Context, c *app.RequestContext) {
 
 	pack.SendResponse(c, resp)
 }
+
+// ReportResourceComment .
+// @router /api/resource_comments/:comment_id/report [POST]
+func ReportResourceComment(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req resource.ReportResourceCommentReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp := new(resource.ReportResourceCommentResp)
+	err = service.NewResourceService(ctx, c).ReportResourceComment(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp.BaseResp = pack.BuildBaseResp(errno.Success)
+	pack.SendResponse(c, resp)
+}
+
+// ReportResourceRating .
+// @router /api/resource_ratings/:rating_id/report [POST]
+func ReportResourceRating(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req resource.ReportResourceRatingReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp := new(resource.ReportResourceRatingResp)
+	err = service.NewResourceService(ctx, c).ReportResourceRating(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp.BaseResp = pack.BuildBaseResp(errno.Success)
+	pack.SendResponse(c, resp)
+}
@@ -0,0 +1,91 @@
+// Code generated by hertz generator.
+
+package shop
+
+import (
+	"LearnShare/biz/pack"
+	"LearnShare/biz/service"
+	"LearnShare/pkg/errno"
+	"context"
+
+	"LearnShare/biz/model/shop"
+
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// AdminCreateItem .
+// @router /api/admin/items [POST]
+func AdminCreateItem(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req shop.AdminCreateItemReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	image, err := c.FormFile("image")
+	if err != nil {
+		pack.BuildFailResponse(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	resp := new(shop.AdminCreateItemResp)
+	item, err := service.NewShopAdminService(ctx, c).AdminCreateItem(&req, image)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp.BaseResp = pack.BuildBaseResp(errno.Success)
+	resp.Item = item
+	pack.SendResponse(c, resp)
+}
+
+// AdminUpdateItem .
+// @router /api/admin/items/:item_id [PUT]
+func AdminUpdateItem(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req shop.AdminUpdateItemReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	// 预览图可选，未以 multipart 表单上传 image 时保留原图
+	image, _ := c.FormFile("image")
+
+	resp := new(shop.AdminUpdateItemResp)
+	item, err := service.NewShopAdminService(ctx, c).AdminUpdateItem(&req, image)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp.BaseResp = pack.BuildBaseResp(errno.Success)
+	resp.Item = item
+	pack.SendResponse(c, resp)
+}
+
+// AdminDeleteItem .
+// @router /api/admin/items/:item_id [DELETE]
+func AdminDeleteItem(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req shop.AdminDeleteItemReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp := new(shop.AdminDeleteItemResp)
+	err = service.NewShopAdminService(ctx, c).AdminDeleteItem(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp.BaseResp = pack.BuildBaseResp(errno.Success)
+	pack.SendResponse(c, resp)
+}
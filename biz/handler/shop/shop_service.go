@@ -0,0 +1,129 @@
+// Code generated by hertz generator.
+
+package shop
+
+import (
+	"LearnShare/biz/pack"
+	"LearnShare/biz/service"
+	"LearnShare/pkg/errno"
+	"context"
+
+	"LearnShare/biz/model/shop"
+
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// ListItems .
+// @router /api/shop/items [GET]
+func ListItems(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req shop.ListItemsReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp := new(shop.ListItemsResp)
+	items, total, err := service.NewShopService(ctx, c).ListItems(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp.BaseResp = pack.BuildBaseResp(errno.Success)
+	resp.ItemList = items
+	resp.Total = total
+	pack.SendResponse(c, resp)
+}
+
+// PurchaseItem .
+// @router /api/shop/items/:item_id/purchase [POST]
+func PurchaseItem(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req shop.PurchaseItemReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp := new(shop.PurchaseItemResp)
+	userItem, score, err := service.NewShopService(ctx, c).PurchaseItem(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp.BaseResp = pack.BuildBaseResp(errno.Success)
+	resp.UserItem = userItem
+	resp.ReputationScore = score
+	pack.SendResponse(c, resp)
+}
+
+// ListMyItems .
+// @router /api/users/me/items [GET]
+func ListMyItems(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req shop.ListMyItemsReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp := new(shop.ListMyItemsResp)
+	items, err := service.NewShopService(ctx, c).ListMyItems(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp.BaseResp = pack.BuildBaseResp(errno.Success)
+	resp.ItemList = items
+	pack.SendResponse(c, resp)
+}
+
+// EquipItem .
+// @router /api/users/me/items/:item_id/equip [POST]
+func EquipItem(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req shop.EquipItemReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp := new(shop.EquipItemResp)
+	err = service.NewShopService(ctx, c).EquipItem(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp.BaseResp = pack.BuildBaseResp(errno.Success)
+	pack.SendResponse(c, resp)
+}
+
+// UnequipItem .
+// @router /api/users/me/items/:item_id/unequip [POST]
+func UnequipItem(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req shop.UnequipItemReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp := new(shop.UnequipItemResp)
+	err = service.NewShopService(ctx, c).UnequipItem(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp.BaseResp = pack.BuildBaseResp(errno.Success)
+	pack.SendResponse(c, resp)
+}
@@ -0,0 +1,37 @@
+// Code generated by hertz generator.
+
+package user
+
+import (
+	"LearnShare/biz/pack"
+	"LearnShare/biz/service"
+	"LearnShare/pkg/errno"
+	"context"
+
+	"LearnShare/biz/model/user"
+
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// ListMyAchievements .
+// @router /api/users/me/achievements [GET]
+func ListMyAchievements(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.ListMyAchievementsReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp := new(user.ListMyAchievementsResp)
+	achievements, err := service.NewAchievementService(ctx, c).ListMyAchievements(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp.BaseResp = pack.BuildBaseResp(errno.Success)
+	resp.AchievementList = achievements
+	pack.SendResponse(c, resp)
+}
@@ -0,0 +1,61 @@
+// Code generated by hertz generator.
+
+package user
+
+import (
+	"LearnShare/biz/pack"
+	"LearnShare/biz/service"
+	"LearnShare/pkg/errno"
+	"context"
+
+	"LearnShare/biz/model/user"
+
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// ListMyModerationActions .
+// @router /api/users/me/moderation_actions [GET]
+func ListMyModerationActions(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.ListMyModerationActionsReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp := new(user.ListMyModerationActionsResp)
+	actions, total, err := service.NewModerationService(ctx, c).ListMyModerationActions(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp.BaseResp = pack.BuildBaseResp(errno.Success)
+	resp.ActionList = actions
+	resp.Total = total
+	pack.SendResponse(c, resp)
+}
+
+// AppealModerationAction .
+// @router /api/users/me/moderation_actions/:action_id/appeal [POST]
+func AppealModerationAction(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.AppealModerationActionReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp := new(user.AppealModerationActionResp)
+	reviewID, err := service.NewModerationService(ctx, c).AppealModerationAction(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp.BaseResp = pack.BuildBaseResp(errno.Success)
+	resp.AppealReviewID = reviewID
+	pack.SendResponse(c, resp)
+}
@@ -0,0 +1,61 @@
+// Code generated by hertz generator.
+
+package user
+
+import (
+	"LearnShare/biz/pack"
+	"LearnShare/biz/service"
+	"LearnShare/pkg/errno"
+	"context"
+
+	"LearnShare/biz/model/user"
+
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// ListNotifications .
+// @router /api/users/me/notifications [GET]
+func ListNotifications(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.ListNotificationsReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp := new(user.ListNotificationsResp)
+	notifications, total, unread, err := service.NewNotificationService(ctx, c).ListNotifications(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp.BaseResp = pack.BuildBaseResp(errno.Success)
+	resp.NotificationList = notifications
+	resp.Total = total
+	resp.Unread = unread
+	pack.SendResponse(c, resp)
+}
+
+// MarkNotificationsRead .
+// @router /api/users/me/notifications/read [POST]
+func MarkNotificationsRead(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.MarkNotificationsReadReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp := new(user.MarkNotificationsReadResp)
+	err = service.NewNotificationService(ctx, c).MarkNotificationsRead(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp.BaseResp = pack.BuildBaseResp(errno.Success)
+	pack.SendResponse(c, resp)
+}
@@ -0,0 +1,63 @@
+// Code generated by hertz generator.
+
+package user
+
+import (
+	"LearnShare/biz/pack"
+	"LearnShare/biz/service"
+	"LearnShare/pkg/errno"
+	"context"
+
+	"LearnShare/biz/model/user"
+
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// ListMyReputationRecords .
+// @router /api/users/me/reputation [GET]
+func ListMyReputationRecords(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.ListMyReputationRecordsReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp := new(user.ListMyReputationRecordsResp)
+	score, records, total, err := service.NewReputationService(ctx, c).ListMyReputationRecords(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp.BaseResp = pack.BuildBaseResp(errno.Success)
+	resp.ReputationScore = score
+	resp.RecordList = records
+	resp.Total = total
+	pack.SendResponse(c, resp)
+}
+
+// AdjustUserReputation .
+// @router /api/admin/users/:user_id/reputation [POST]
+func AdjustUserReputation(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.AdjustUserReputationReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp := new(user.AdjustUserReputationResp)
+	score, record, err := service.NewReputationService(ctx, c).AdjustUserReputation(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp.BaseResp = pack.BuildBaseResp(errno.Success)
+	resp.ReputationScore = score
+	resp.Record = record
+	pack.SendResponse(c, resp)
+}
@@ -87,3 +87,126 @@ func AddRole(ctx context.Context, c *app.RequestContext) {
 
 	pack.SendResponse(c, resp)
 }
+
+// UpdateRole .
+// @router /api/admin/roles/:role_id [PUT]
+func UpdateRole(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.UpdateRoleReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp := new(user.UpdateRoleResp)
+
+	err = service.NewRoleAdminService(ctx, c).UpdateRole(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp.BaseResp = pack.BuildBaseResp(errno.Success)
+
+	pack.SendResponse(c, resp)
+}
+
+// DeleteRole .
+// @router /api/admin/roles/:role_id [DELETE]
+func DeleteRole(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.DeleteRoleReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp := new(user.DeleteRoleResp)
+
+	reassigned, err := service.NewRoleAdminService(ctx, c).DeleteRole(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp.BaseResp = pack.BuildBaseResp(errno.Success)
+	resp.ReassignedUsers = reassigned
+
+	pack.SendResponse(c, resp)
+}
+
+// GrantRolePermissions .
+// @router /api/admin/roles/:role_id/permissions [POST]
+func GrantRolePermissions(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.GrantRolePermissionsReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp := new(user.GrantRolePermissionsResp)
+
+	err = service.NewRoleAdminService(ctx, c).GrantRolePermissions(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp.BaseResp = pack.BuildBaseResp(errno.Success)
+
+	pack.SendResponse(c, resp)
+}
+
+// RevokeRolePermissions .
+// @router /api/admin/roles/:role_id/permissions [DELETE]
+func RevokeRolePermissions(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.RevokeRolePermissionsReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp := new(user.RevokeRolePermissionsResp)
+
+	err = service.NewRoleAdminService(ctx, c).RevokeRolePermissions(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp.BaseResp = pack.BuildBaseResp(errno.Success)
+
+	pack.SendResponse(c, resp)
+}
+
+// GetRoleUsers .
+// @router /api/admin/roles/:role_id/users [GET]
+func GetRoleUsers(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.GetRoleUsersReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp := new(user.GetRoleUsersResp)
+
+	users, total, err := service.NewRoleAdminService(ctx, c).GetRoleUsers(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp.BaseResp = pack.BuildBaseResp(errno.Success)
+	resp.UserList = users
+	resp.Total = total
+
+	pack.SendResponse(c, resp)
+}
@@ -0,0 +1,88 @@
+// Code generated by hertz generator.
+
+package user
+
+import (
+	"LearnShare/biz/pack"
+	"LearnShare/biz/service"
+	"LearnShare/pkg/errno"
+	"context"
+
+	"LearnShare/biz/model/user"
+
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// GrantScopedPermission .
+// @router /api/scoped_permissions [POST]
+func GrantScopedPermission(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.GrantScopedPermissionReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp := new(user.GrantScopedPermissionResp)
+
+	grantID, err := service.NewScopedPermissionService(ctx, c).GrantScopedPermission(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp.BaseResp = pack.BuildBaseResp(errno.Success)
+	resp.GrantID = grantID
+
+	pack.SendResponse(c, resp)
+}
+
+// RevokeScopedPermission .
+// @router /api/scoped_permissions/:grant_id [DELETE]
+func RevokeScopedPermission(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.RevokeScopedPermissionReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp := new(user.RevokeScopedPermissionResp)
+
+	err = service.NewScopedPermissionService(ctx, c).RevokeScopedPermission(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp.BaseResp = pack.BuildBaseResp(errno.Success)
+
+	pack.SendResponse(c, resp)
+}
+
+// ListScopedPermissions .
+// @router /api/scoped_permissions [GET]
+func ListScopedPermissions(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.ListScopedPermissionsReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp := new(user.ListScopedPermissionsResp)
+
+	grants, err := service.NewScopedPermissionService(ctx, c).ListScopedPermissions(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp.BaseResp = pack.BuildBaseResp(errno.Success)
+	resp.GrantList = grants
+
+	pack.SendResponse(c, resp)
+}
@@ -298,3 +298,244 @@ func GetUserInfo(ctx context.Context, c *app.RequestContext) {
 
 	pack.SendResponse(c, resp)
 }
+
+// OidcAuthorize .
+// @router /api/auth/oidc/authorize [GET]
+func OidcAuthorize(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.OIDCAuthorizeReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp := new(user.OIDCAuthorizeResp)
+	authorizeURL, err := service.NewOIDCService(ctx, c).Authorize()
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp.BaseResponse = pack.BuildBaseResp(errno.Success)
+	resp.AuthorizeURL = authorizeURL
+	pack.SendResponse(c, resp)
+}
+
+// OidcCallback .
+// @router /api/auth/oidc/callback [POST]
+func OidcCallback(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.OIDCCallbackReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	userInfo, err := service.NewOIDCService(ctx, c).Callback(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	key := uuid.NewV1()
+	c.Set(constants.UUID, key.String())
+	c.Set(constants.ContextUid, userInfo.UserId)
+	c.Set(constants.RoleID, userInfo.RoleId)
+
+	middleware.AccessTokenJwtMiddleware.LoginHandler(ctx, c)
+	middleware.RefreshTokenJwtMiddleware.LoginHandler(ctx, c)
+
+	resp := &user.OIDCCallbackResp{
+		BaseResponse: pack.BuildBaseResp(errno.Success),
+		User:         userInfo,
+	}
+
+	c.Header("Access-Token", c.GetString("Access-Token"))
+	c.Header("Refresh-Token", c.GetString("Refresh-Token"))
+
+	pack.SendResponse(c, resp)
+}
+
+// UnlockAccount .
+// @router /api/auth/unlock [POST]
+func UnlockAccount(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.UnlockAccountReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp := new(user.UnlockAccountResp)
+	err = service.NewUserService(ctx, c).UnlockAccount(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp.BaseResponse = pack.BuildBaseResp(errno.Success)
+	pack.SendResponse(c, resp)
+}
+
+// ActivateAccount .
+// @router /api/auth/activate [POST]
+func ActivateAccount(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.ActivateAccountReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp := new(user.ActivateAccountResp)
+	err = service.NewUserService(ctx, c).ActivateAccount(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp.BaseResponse = pack.BuildBaseResp(errno.Success)
+	pack.SendResponse(c, resp)
+}
+
+// ResendActivation .
+// @router /api/auth/activate/resend [POST]
+func ResendActivation(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.ResendActivationReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp := new(user.ResendActivationResp)
+	err = service.NewUserService(ctx, c).ResendActivation(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp.BaseResponse = pack.BuildBaseResp(errno.Success)
+	pack.SendResponse(c, resp)
+}
+
+// GetCaptcha .
+// @router /api/auth/captcha [GET]
+func GetCaptcha(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.GetCaptchaReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp := new(user.GetCaptchaResp)
+	challenge, expire, err := service.NewCaptchaService(ctx, c).Generate()
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp.BaseResponse = pack.BuildBaseResp(errno.Success)
+	resp.CaptchaID = challenge.ID
+	resp.Image = challenge.Image
+	resp.ExpireIn = int64(expire.Seconds())
+	pack.SendResponse(c, resp)
+}
+
+// RevertEmailChange .
+// @router /api/auth/email/revert [POST]
+func RevertEmailChange(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.RevertEmailChangeReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp := new(user.RevertEmailChangeResp)
+	err = service.NewUserService(ctx, c).RevertEmailChange(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp.BaseResponse = pack.BuildBaseResp(errno.Success)
+	pack.SendResponse(c, resp)
+}
+
+// CreatePersonalToken .
+// @router /api/users/me/tokens [POST]
+func CreatePersonalToken(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.CreatePersonalTokenReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp := new(user.CreatePersonalTokenResp)
+	token, info, err := service.NewUserService(ctx, c).CreatePersonalToken(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp.BaseResponse = pack.BuildBaseResp(errno.Success)
+	resp.Token = token
+	resp.TokenInfo = info
+	pack.SendResponse(c, resp)
+}
+
+// ListPersonalTokens .
+// @router /api/users/me/tokens [GET]
+func ListPersonalTokens(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.ListPersonalTokensReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp := new(user.ListPersonalTokensResp)
+	tokens, err := service.NewUserService(ctx, c).ListPersonalTokens()
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp.BaseResponse = pack.BuildBaseResp(errno.Success)
+	resp.Tokens = tokens
+	pack.SendResponse(c, resp)
+}
+
+// RevokePersonalToken .
+// @router /api/users/me/tokens/:token_id [DELETE]
+func RevokePersonalToken(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req user.RevokePersonalTokenReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp := new(user.RevokePersonalTokenResp)
+	err = service.NewUserService(ctx, c).RevokePersonalToken(&req)
+	if err != nil {
+		pack.BuildFailResponse(c, err)
+		return
+	}
+
+	resp.BaseResponse = pack.BuildBaseResp(errno.Success)
+	pack.SendResponse(c, resp)
+}
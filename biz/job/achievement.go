@@ -0,0 +1,61 @@
+package job
+
+import (
+	"LearnShare/biz/dal/db"
+	"LearnShare/config"
+	"LearnShare/pkg/logger"
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// achievementBatchSize 每批检查的用户数量
+const achievementBatchSize = 500
+
+func evaluateAchievementsInterval() time.Duration {
+	cfg := config.Achievement
+	if cfg == nil || cfg.EvaluateIntervalMinutes <= 0 {
+		return 0
+	}
+	return time.Duration(cfg.EvaluateIntervalMinutes) * time.Minute
+}
+
+func evaluateAchievements(ctx context.Context) error {
+	_, err := EvaluateAllAchievements(ctx)
+	return err
+}
+
+// EvaluateAllAchievements 分批为全部用户检查并发放成就，返回新达成的成就数量
+// 定时任务与 `achievements backfill` 补发命令共用，重复执行不会重复发放
+func EvaluateAllAchievements(ctx context.Context) (int64, error) {
+	achievements, err := db.ListAchievements(ctx)
+	if err != nil || len(achievements) == 0 {
+		return 0, err
+	}
+
+	var (
+		afterID int64
+		awarded int64
+	)
+	for {
+		userIDs, err := db.ListUserIDsAfter(ctx, afterID, achievementBatchSize)
+		if err != nil {
+			return awarded, err
+		}
+		if len(userIDs) == 0 {
+			break
+		}
+		_, n, err := db.EvaluateAchievements(ctx, achievements, userIDs)
+		if err != nil {
+			return awarded, err
+		}
+		awarded += n
+		afterID = userIDs[len(userIDs)-1]
+	}
+
+	if awarded > 0 {
+		logger.WithFields(zap.Int64("awarded", awarded)).Info("已发放新达成的成就")
+	}
+	return awarded, nil
+}
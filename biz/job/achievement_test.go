@@ -0,0 +1,163 @@
+package job
+
+import (
+	"context"
+	"testing"
+
+	"LearnShare/biz/dal/db"
+	"LearnShare/pkg/constants"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// setupAchievementTestDB 初始化成就统计所需的表，并写入成就与勋章
+// first_upload 发放勋章 1，downloads_10 不带勋章，stopped 已停用
+func setupAchievementTestDB(t *testing.T) func() {
+	t.Helper()
+	sqliteDB, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("初始化SQLite失败: %v", err)
+	}
+
+	createTableSQL := []string{`
+CREATE TABLE IF NOT EXISTS users (
+    user_id INTEGER PRIMARY KEY AUTOINCREMENT,
+    username TEXT
+);`, `
+CREATE TABLE IF NOT EXISTS resources (
+    resource_id INTEGER PRIMARY KEY AUTOINCREMENT,
+    uploader_id INTEGER NOT NULL,
+    download_count INTEGER DEFAULT 0,
+    status TEXT DEFAULT 'normal',
+    deleted_at DATETIME
+);`, `
+CREATE TABLE IF NOT EXISTS items (
+    item_id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name TEXT,
+    type TEXT,
+    description TEXT,
+    price INTEGER,
+    image_url TEXT
+);`, `
+CREATE TABLE IF NOT EXISTS user_items (
+    user_item_id INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id INTEGER NOT NULL,
+    item_id INTEGER NOT NULL,
+    is_used BOOLEAN DEFAULT 0,
+    obtained_at DATETIME,
+    UNIQUE (user_id, item_id)
+);`, `
+CREATE TABLE IF NOT EXISTS achievements (
+    code TEXT PRIMARY KEY,
+    name TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    metric TEXT NOT NULL,
+    threshold INTEGER NOT NULL,
+    medal_item_id INTEGER,
+    sort_order INTEGER NOT NULL DEFAULT 0,
+    enabled BOOLEAN NOT NULL DEFAULT 1
+);`, `
+CREATE TABLE IF NOT EXISTS user_achievements (
+    user_id INTEGER NOT NULL,
+    achievement_code TEXT NOT NULL,
+    achieved_at DATETIME,
+    PRIMARY KEY (user_id, achievement_code)
+);`, `
+INSERT INTO items (item_id, name, type, price) VALUES (1, '初次分享勋章', 'medal', 0);`, `
+INSERT INTO achievements (code, name, metric, threshold, medal_item_id, sort_order, enabled) VALUES
+    ('first_upload', '初次分享', 'uploads', 1, 1, 10, 1),
+    ('downloads_10', '广受欢迎', 'downloads_received', 10, NULL, 20, 1),
+    ('stopped', '已停用', 'uploads', 1, NULL, 30, 0);`}
+	for _, sql := range createTableSQL {
+		if err := sqliteDB.Exec(sql).Error; err != nil {
+			t.Fatalf("创建测试数据表失败: %v", err)
+		}
+	}
+
+	db.DB = sqliteDB
+	return func() {
+		if sqlDB, err := db.DB.DB(); err == nil {
+			_ = sqlDB.Close()
+		}
+	}
+}
+
+// seedAchievementUsers 写入三个用户：
+// 第一个发布两份资源、累计下载 11 次；第二个只有被封禁的资源；第三个发布一份资源且已拥有勋章
+func seedAchievementUsers(t *testing.T) []int64 {
+	t.Helper()
+	for _, sql := range []string{
+		`INSERT INTO users (user_id, username) VALUES (1, 'active'), (2, 'banned'), (3, 'holder')`,
+		`INSERT INTO resources (uploader_id, download_count, status) VALUES (1, 6, 'normal'), (1, 5, 'normal'), (2, 3, 'banned'), (3, 0, 'normal')`,
+		`INSERT INTO user_items (user_id, item_id) VALUES (3, 1)`,
+	} {
+		if err := db.DB.Exec(sql).Error; err != nil {
+			t.Fatalf("写入测试数据失败: %v", err)
+		}
+	}
+	return []int64{1, 2, 3}
+}
+
+// assertEarned 校验已达成的成就数量与勋章持有数量
+func assertEarned(t *testing.T, achievements, medals int64) {
+	t.Helper()
+	var earned, owned int64
+	db.DB.Table(constants.UserAchievementTableName).Count(&earned)
+	db.DB.Table(constants.UserItemTableName).Where("item_id = ?", 1).Count(&owned)
+	if earned != achievements || owned != medals {
+		t.Fatalf("成就或勋章数量不正确: achievements=%d medals=%d, 期望 %d %d", earned, owned, achievements, medals)
+	}
+}
+
+func TestEvaluateAchievementsGrantsOnce(t *testing.T) {
+	cleanup := setupAchievementTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	userIDs := seedAchievementUsers(t)
+	achievements, err := db.ListAchievements(ctx)
+	if err != nil || len(achievements) != 2 {
+		t.Fatalf("只应返回启用的成就: %v err=%v", achievements, err)
+	}
+
+	values, awarded, err := db.EvaluateAchievements(ctx, achievements, userIDs)
+	if err != nil || awarded != 3 {
+		t.Fatalf("首次检查应达成 3 项成就: awarded=%d err=%v", awarded, err)
+	}
+	if values["uploads"][1] != 2 || values["uploads"][2] != 0 || values["downloads_received"][1] != 11 {
+		t.Fatalf("成就指标统计不正确: %v", values)
+	}
+	// 已拥有勋章的用户不会重复获得
+	assertEarned(t, 3, 2)
+
+	if _, awarded, err = db.EvaluateAchievements(ctx, achievements, userIDs); err != nil || awarded != 0 {
+		t.Fatalf("重复检查不应再次发放: awarded=%d err=%v", awarded, err)
+	}
+	assertEarned(t, 3, 2)
+}
+
+func TestEvaluateAllAchievementsBackfillIdempotent(t *testing.T) {
+	cleanup := setupAchievementTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	seedAchievementUsers(t)
+
+	if awarded, err := EvaluateAllAchievements(ctx); err != nil || awarded != 3 {
+		t.Fatalf("补发应达成 3 项成就: awarded=%d err=%v", awarded, err)
+	}
+	if awarded, err := EvaluateAllAchievements(ctx); err != nil || awarded != 0 {
+		t.Fatalf("重复补发不应再次发放: awarded=%d err=%v", awarded, err)
+	}
+	assertEarned(t, 3, 2)
+
+	// 新数据达到阈值后，再次补发只发放新达成的成就
+	if err := db.DB.Exec(`INSERT INTO resources (uploader_id, status) VALUES (2, 'normal')`).Error; err != nil {
+		t.Fatalf("写入资源失败: %v", err)
+	}
+	if awarded, err := EvaluateAllAchievements(ctx); err != nil || awarded != 1 {
+		t.Fatalf("补发应只发放新达成的成就: awarded=%d err=%v", awarded, err)
+	}
+	assertEarned(t, 4, 3)
+}
@@ -0,0 +1,36 @@
+package job
+
+import (
+	"LearnShare/biz/dal/db"
+	"LearnShare/config"
+	"LearnShare/pkg/logger"
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+func purgeInactiveUsersInterval() time.Duration {
+	cfg := config.Activation
+	if cfg == nil || cfg.PurgeAfterHours <= 0 || cfg.PurgeIntervalMinutes <= 0 {
+		return 0
+	}
+	return time.Duration(cfg.PurgeIntervalMinutes) * time.Minute
+}
+
+// purgeInactiveUsers 清理注册后超过配置时长仍未激活的账户，已有内容的账户改为锁定
+func purgeInactiveUsers(ctx context.Context) error {
+	before := time.Now().Add(-time.Duration(config.Activation.PurgeAfterHours) * time.Hour)
+	deleted, locked, err := db.PurgeInactiveUsers(ctx, before)
+	if err != nil {
+		return err
+	}
+	if deleted > 0 || locked > 0 {
+		logger.WithFields(
+			zap.Int64("deleted", deleted),
+			zap.Int64("locked", locked),
+			zap.Time("registered_before", before),
+		).Info("已清理长期未激活的账户")
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package job
+
+import (
+	"LearnShare/biz/dal/redis"
+	"LearnShare/pkg/logger"
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Init 启动所有后台定时任务
+func Init() {
+	go schedule("purge_inactive_users", purgeInactiveUsersInterval, purgeInactiveUsers)
+	go schedule("maintain_review_queue", reviewQueueInterval, maintainReviewQueue)
+	go schedule("purge_deleted_content", purgeDeletedContentInterval, purgeDeletedContent)
+	go schedule("evaluate_achievements", evaluateAchievementsInterval, evaluateAchievements)
+	go schedule("rebuild_leaderboards", rebuildLeaderboardsInterval, rebuildLeaderboards)
+}
+
+// schedule 按 interval 周期执行任务，interval 每轮重新读取以支持配置热更新，返回 <=0 时本轮跳过
+// 每轮执行前先抢占分布式锁，多实例部署时同一任务只会在一个实例上执行
+func schedule(name string, interval func() time.Duration, run func(ctx context.Context) error) {
+	for {
+		d := interval()
+		if d <= 0 {
+			time.Sleep(time.Minute)
+			continue
+		}
+		time.Sleep(d)
+
+		ctx := context.Background()
+		ok, err := redis.TryJobLock(ctx, name, d/2)
+		if err != nil {
+			logger.WithFields(zap.String("job", name), zap.Error(err)).Error("定时任务获取执行锁失败")
+			continue
+		}
+		if !ok {
+			continue
+		}
+
+		start := time.Now()
+		if err := run(ctx); err != nil {
+			logger.WithFields(zap.String("job", name), zap.Error(err)).Error("定时任务执行失败")
+			continue
+		}
+		logger.WithFields(zap.String("job", name), zap.Duration("cost", time.Since(start))).Debug("定时任务执行完成")
+	}
+}
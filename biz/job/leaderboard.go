@@ -0,0 +1,22 @@
+package job
+
+import (
+	"LearnShare/biz/service"
+	"LearnShare/config"
+	"context"
+	"time"
+)
+
+func rebuildLeaderboardsInterval() time.Duration {
+	cfg := config.Leaderboard
+	if cfg == nil || cfg.RebuildIntervalMinutes <= 0 {
+		return 0
+	}
+	return time.Duration(cfg.RebuildIntervalMinutes) * time.Minute
+}
+
+// rebuildLeaderboards 定期从数据库重建排行榜，修正增量更新遗漏的变化（如课程删除连带的资源）
+func rebuildLeaderboards(ctx context.Context) error {
+	_, err := service.RebuildLeaderboards(ctx)
+	return err
+}
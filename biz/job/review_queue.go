@@ -0,0 +1,40 @@
+package job
+
+import (
+	"LearnShare/biz/dal/db"
+	"LearnShare/config"
+	"LearnShare/pkg/logger"
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+func reviewQueueInterval() time.Duration {
+	cfg := config.ReviewQueue
+	if cfg == nil || cfg.EscalateIntervalMinutes <= 0 {
+		return 0
+	}
+	return time.Duration(cfg.EscalateIntervalMinutes) * time.Minute
+}
+
+// maintainReviewQueue 释放过期的审核认领，并升级超过 SLA 截止时间仍未处理的审核记录
+func maintainReviewQueue(ctx context.Context) error {
+	now := time.Now()
+	released, err := db.ReleaseExpiredReviewClaims(ctx, now)
+	if err != nil {
+		return err
+	}
+	if released > 0 {
+		logger.WithFields(zap.Int64("count", released)).Info("已释放过期的审核认领")
+	}
+
+	escalated, err := db.EscalateOverdueReviews(ctx, now)
+	if err != nil {
+		return err
+	}
+	if escalated > 0 {
+		logger.WithFields(zap.Int64("count", escalated)).Warn("审核记录超过 SLA 截止时间，已升级")
+	}
+	return nil
+}
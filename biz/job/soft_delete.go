@@ -0,0 +1,64 @@
+package job
+
+import (
+	"LearnShare/biz/dal/db"
+	"LearnShare/config"
+	"LearnShare/pkg/logger"
+	"LearnShare/pkg/oss"
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// purgeDeletedBatchSize 每轮清理的最大条目数，积压的数据在后续轮次继续处理
+const purgeDeletedBatchSize = 200
+
+func purgeDeletedContentInterval() time.Duration {
+	cfg := config.SoftDelete
+	if cfg == nil || cfg.RetentionDays <= 0 || cfg.PurgeIntervalMinutes <= 0 {
+		return 0
+	}
+	return time.Duration(cfg.PurgeIntervalMinutes) * time.Minute
+}
+
+// purgeDeletedContent 彻底删除超过保留期的资源、课程与评论
+// 资源先删除对象存储中的文件再删除记录，文件删除失败的资源留到下一轮重试
+func purgeDeletedContent(ctx context.Context) error {
+	before := time.Now().AddDate(0, 0, -config.SoftDelete.RetentionDays)
+
+	resources, err := db.ListExpiredResources(ctx, before, purgeDeletedBatchSize)
+	if err != nil {
+		return err
+	}
+	var purgedResources int64
+	for _, r := range resources {
+		if err := oss.DeleteByURL(r.FilePath); err != nil {
+			logger.WithFields(zap.Int64("resource_id", r.ResourceID), zap.Error(err)).Warn("删除资源文件失败，下次清理时重试")
+			continue
+		}
+		if err := db.PurgeResource(ctx, r.ResourceID); err != nil {
+			return err
+		}
+		purgedResources++
+	}
+
+	purgedCourses, err := db.PurgeExpiredCourses(ctx, before, purgeDeletedBatchSize)
+	if err != nil {
+		return err
+	}
+	purgedComments, err := db.PurgeExpiredComments(ctx, before, purgeDeletedBatchSize)
+	if err != nil {
+		return err
+	}
+
+	if purgedResources+purgedCourses+purgedComments > 0 {
+		logger.WithFields(
+			zap.Int64("resources", purgedResources),
+			zap.Int64("courses", purgedCourses),
+			zap.Int64("comments", purgedComments),
+			zap.Time("deleted_before", before),
+		).Info("已彻底删除超过保留期的内容")
+	}
+	return nil
+}
@@ -0,0 +1,30 @@
+package middleware
+
+import (
+	"LearnShare/biz/service"
+	"context"
+	"strings"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+)
+
+// adminPathPrefix 管理后台路由前缀
+const adminPathPrefix = "/api/admin/"
+
+// AdminActionLogger 管理操作日志中间件，记录管理后台全部写操作（含失败的请求）
+// 需注册在路由访问策略之后，未通过认证与权限校验的请求不会进入本中间件
+func AdminActionLogger() app.HandlerFunc {
+	return func(ctx context.Context, c *app.RequestContext) {
+		c.Next(ctx)
+
+		if !strings.HasPrefix(c.FullPath(), adminPathPrefix) {
+			return
+		}
+		switch string(c.Method()) {
+		case consts.MethodGet, consts.MethodHead, consts.MethodOptions:
+			return
+		}
+		service.NewAdminActionService(ctx, c).RecordRequest()
+	}
+}
@@ -0,0 +1,152 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"LearnShare/biz/dal/db"
+	"LearnShare/biz/service"
+	"LearnShare/pkg/constants"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/config"
+	"github.com/cloudwego/hertz/pkg/common/ut"
+	"github.com/cloudwego/hertz/pkg/route"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// setupAdminActionDB 初始化只包含用户与管理操作日志表的内存数据库
+func setupAdminActionDB(t *testing.T) func() {
+	t.Helper()
+	sqliteDB, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("初始化SQLite失败: %v", err)
+	}
+	createTableSQL := `
+CREATE TABLE IF NOT EXISTS users (
+    user_id INTEGER PRIMARY KEY AUTOINCREMENT,
+    username TEXT,
+    password_hash TEXT,
+    email TEXT UNIQUE,
+    college_id INTEGER,
+    major_id INTEGER,
+    avatar_url TEXT,
+    reputation_score INTEGER DEFAULT 0,
+    role_id INTEGER,
+    status TEXT,
+    muted_until DATETIME,
+    activation_pending_at DATETIME,
+    created_at DATETIME,
+    updated_at DATETIME
+);
+CREATE TABLE IF NOT EXISTS admin_actions (
+    action_id INTEGER PRIMARY KEY AUTOINCREMENT,
+    actor_id INTEGER NOT NULL,
+    action TEXT NOT NULL,
+    method TEXT NOT NULL,
+    path TEXT NOT NULL,
+    target_type TEXT,
+    target_id INTEGER,
+    before_snapshot TEXT,
+    after_snapshot TEXT,
+    status_code INTEGER NOT NULL,
+    ip TEXT,
+    request_id TEXT,
+    created_at DATETIME
+);
+`
+	if err := sqliteDB.Exec(createTableSQL).Error; err != nil {
+		t.Fatalf("创建测试数据表失败: %v", err)
+	}
+	db.DB = sqliteDB
+	return func() {
+		if sqlDB, err := db.DB.DB(); err == nil {
+			_ = sqlDB.Close()
+		}
+	}
+}
+
+// adminLockUser 模拟管理接口：记录变更前后的用户快照
+func adminLockUser(ctx context.Context, c *app.RequestContext) {
+	userID, _ := strconv.ParseInt(c.Param("user_id"), 10, 64)
+	before, _ := db.GetRecordSnapshot(ctx, constants.UserTableName, "user_id", userID)
+	db.DB.Table(constants.UserTableName).Where("user_id = ?", userID).Update("status", "locked")
+	after, _ := db.GetRecordSnapshot(ctx, constants.UserTableName, "user_id", userID)
+	service.RecordAdminChange(c, "user", userID, before, after)
+	c.Status(http.StatusOK)
+}
+
+func adminForbidden(ctx context.Context, c *app.RequestContext) {
+	c.Status(http.StatusForbidden)
+}
+
+func newAdminActionEngine() *route.Engine {
+	engine := route.NewEngine(config.NewOptions(nil))
+	engine.Use(func(ctx context.Context, c *app.RequestContext) {
+		c.Set(constants.ContextUid, int64(1))
+		c.Next(ctx)
+	}, AdminActionLogger())
+	engine.POST("/api/admin/users/:user_id/lock", adminLockUser)
+	engine.GET("/api/admin/users/:user_id", adminLockUser)
+	engine.DELETE("/api/admin/courses/:course_id", adminForbidden)
+	engine.POST("/api/users/:user_id/lock", adminLockUser)
+	return engine
+}
+
+func TestAdminActionLogger(t *testing.T) {
+	cleanup := setupAdminActionDB(t)
+	defer cleanup()
+
+	now := time.Now()
+	u := db.User{Username: "target", PasswordHash: "secret-hash", Email: "target@example.com", Status: "active", CreatedAt: now, UpdatedAt: now}
+	if err := db.DB.Table(constants.UserTableName).Create(&u).Error; err != nil {
+		t.Fatalf("插入用户失败: %v", err)
+	}
+
+	engine := newAdminActionEngine()
+	id := strconv.FormatInt(u.UserID, 10)
+	ut.PerformRequest(engine, "POST", "/api/admin/users/"+id+"/lock", nil)
+	ut.PerformRequest(engine, "GET", "/api/admin/users/"+id, nil)
+	ut.PerformRequest(engine, "POST", "/api/users/"+id+"/lock", nil)
+	ut.PerformRequest(engine, "DELETE", "/api/admin/courses/7", nil)
+
+	var actions []db.AdminAction
+	db.DB.Table(constants.AdminActionTableName).Order("action_id").Find(&actions)
+	if len(actions) != 2 {
+		t.Fatalf("只应记录管理后台的写操作: %+v", actions)
+	}
+
+	locked := actions[0]
+	if locked.Action != "adminLockUser" || locked.ActorID != 1 || locked.StatusCode != http.StatusOK ||
+		locked.TargetType == nil || *locked.TargetType != "user" || locked.TargetID == nil || *locked.TargetID != u.UserID {
+		t.Fatalf("操作日志内容不正确: %+v", locked)
+	}
+	if locked.BeforeSnapshot == nil || locked.AfterSnapshot == nil ||
+		!strings.Contains(*locked.BeforeSnapshot, `"status":"active"`) || !strings.Contains(*locked.AfterSnapshot, `"status":"locked"`) {
+		t.Fatalf("变更前后快照不正确: before=%v after=%v", derefSnapshot(locked.BeforeSnapshot), derefSnapshot(locked.AfterSnapshot))
+	}
+	for _, snapshot := range []string{*locked.BeforeSnapshot, *locked.AfterSnapshot} {
+		if strings.Contains(snapshot, "password_hash") || strings.Contains(snapshot, "secret-hash") {
+			t.Fatalf("快照不应包含密码哈希: %s", snapshot)
+		}
+	}
+
+	// 失败的请求同样记录，目标取自路径参数
+	denied := actions[1]
+	if denied.StatusCode != http.StatusForbidden || denied.TargetType == nil || *denied.TargetType != "course" ||
+		denied.TargetID == nil || *denied.TargetID != 7 || denied.BeforeSnapshot != nil {
+		t.Fatalf("失败请求的日志不正确: %+v", denied)
+	}
+}
+
+func derefSnapshot(s *string) string {
+	if s == nil {
+		return "<nil>"
+	}
+	return *s
+}
@@ -1,6 +1,7 @@
 package middleware
 
 import (
+	"LearnShare/biz/dal/redis"
 	"LearnShare/biz/model/user"
 	"LearnShare/biz/pack"
 	"LearnShare/biz/service"
@@ -71,6 +72,14 @@ func AccessTokenJwt() {
 		},
 
 		Authenticator: func(ctx context.Context, c *app.RequestContext) (interface{}, error) {
+			// 已通过统一身份认证完成身份校验时，直接使用上下文中的用户信息签发令牌
+			if _, ok := c.Get(constants.ContextUid); ok {
+				return &JwtCustomClaims{
+					UserId: service.GetUidFormContext(c),
+					UUID:   service.GetUuidFormContext(c),
+					RoleId: service.GetRoleIdFormContext(c),
+				}, nil
+			}
 			var loginStruct user.LoginInReq
 			if err := c.BindAndValidate(&loginStruct); err != nil {
 				return nil, err
@@ -211,6 +220,15 @@ func IsAccessTokenAvailable(ctx context.Context, c *app.RequestContext) error {
 	if !AccessTokenJwtMiddleware.Authorizator(identity, ctx, c) {
 		return errno.AuthInvalid
 	}
+	if identity != nil {
+		revoked, err := isSessionRevoked(ctx, claims, identity.(*JwtCustomClaims).UserId)
+		if err != nil {
+			return err
+		}
+		if revoked {
+			return errno.NewErrNo(errno.AuthInvalidCode, "登录状态已失效，请重新登录")
+		}
+	}
 
 	return nil
 
@@ -256,10 +274,32 @@ func IsRefreshTokenAvailable(ctx context.Context, c *app.RequestContext) bool {
 	if !RefreshTokenJwtMiddleware.Authorizator(identity, ctx, c) {
 		return false
 	}
+	if identity != nil {
+		if revoked, err := isSessionRevoked(ctx, claims, identity.(*JwtCustomClaims).UserId); err != nil || revoked {
+			return false
+		}
+	}
 
 	return true
 }
 
+// isSessionRevoked 令牌签发时间早于用户会话的吊销时间时视为已失效
+func isSessionRevoked(ctx context.Context, claims jwt.MapClaims, userID int64) (bool, error) {
+	revokedAt, err := redis.GetUserSessionsRevokedAt(ctx, userID)
+	if err != nil || revokedAt == 0 {
+		return false, err
+	}
+
+	var issuedAt int64
+	switch v := claims["orig_iat"].(type) {
+	case float64:
+		issuedAt = int64(v)
+	case json.Number:
+		issuedAt, _ = v.Int64()
+	}
+	return issuedAt < revokedAt, nil
+}
+
 func InitJWT() {
 	AccessTokenJwt()
 	RefreshTokenJwt()
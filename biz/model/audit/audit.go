@@ -2206,8 +2206,8 @@ func (p *GetCourseCommentAuditListReq) String() string {
 }
 
 type GetCourseCommentAuditListResp struct {
-	BaseResp         *module.BaseResp        `thrift:"base_resp,1,required" form:"base_resp,required" json:"base_resp,required" query:"base_resp,required"`
-	CommentAuditList []*module.CourseComment `thrift:"comment_audit_list,2,required,list<module.CourseComment>" form:"comment_audit_list,required" json:"comment_audit_list,required" query:"comment_audit_list,required"`
+	BaseResp         *module.BaseResp `thrift:"base_resp,1,required" form:"base_resp,required" json:"base_resp,required" query:"base_resp,required"`
+	CommentAuditList []*module.Review `thrift:"comment_audit_list,2,required,list<module.Review>" form:"comment_audit_list,required" json:"comment_audit_list,required" query:"comment_audit_list,required"`
 }
 
 func NewGetCourseCommentAuditListResp() *GetCourseCommentAuditListResp {
@@ -2226,7 +2226,7 @@ func (p *GetCourseCommentAuditListResp) GetBaseResp() (v *module.BaseResp) {
 	return p.BaseResp
 }
 
-func (p *GetCourseCommentAuditListResp) GetCommentAuditList() (v []*module.CourseComment) {
+func (p *GetCourseCommentAuditListResp) GetCommentAuditList() (v []*module.Review) {
 	return p.CommentAuditList
 }
 
@@ -2331,8 +2331,8 @@ func (p *GetCourseCommentAuditListResp) ReadField2(iprot thrift.TProtocol) error
 	if err != nil {
 		return err
 	}
-	_field := make([]*module.CourseComment, 0, size)
-	values := make([]module.CourseComment, size)
+	_field := make([]*module.Review, 0, size)
+	values := make([]module.Review, size)
 	for i := 0; i < size; i++ {
 		_elem := &values[i]
 		_elem.InitDefault()
@@ -3571,385 +3571,20043 @@ func (p *AuditResourceCommentResp) String() string {
 
 }
 
-type AdminAuditService interface {
-	GetResourceAuditList(ctx context.Context, req *GetResourceAuditListReq) (r *GetResourceAuditListResp, err error)
+// 获取待审核课程评分举报列表
+type GetCourseRatingAuditListReq struct {
+	PageNum  int32 `thrift:"page_num,1,required" form:"page_num,required" json:"page_num,required" query:"page_num,required"`
+	PageSize int32 `thrift:"page_size,2,required" form:"page_size,required" json:"page_size,required" query:"page_size,required"`
+}
 
-	AuditResource(ctx context.Context, req *AuditResourceReq) (r *AuditResourceResp, err error)
+func NewGetCourseRatingAuditListReq() *GetCourseRatingAuditListReq {
+	return &GetCourseRatingAuditListReq{}
+}
 
-	GetCourseAuditList(ctx context.Context, req *GetCourseAuditListReq) (r *GetCourseAuditListResp, err error)
+func (p *GetCourseRatingAuditListReq) InitDefault() {
+}
 
-	AuditCourse(ctx context.Context, req *AuditCourseReq) (r *AuditCourseResp, err error)
+func (p *GetCourseRatingAuditListReq) GetPageNum() (v int32) {
+	return p.PageNum
+}
 
-	GetCommentAuditList(ctx context.Context, req *GetCommentAuditListReq) (r *GetCommentAuditListResp, err error)
+func (p *GetCourseRatingAuditListReq) GetPageSize() (v int32) {
+	return p.PageSize
+}
 
-	GetCourseCommentAuditList(ctx context.Context, req *GetCourseCommentAuditListReq) (r *GetCourseCommentAuditListResp, err error)
+var fieldIDToName_GetCourseRatingAuditListReq = map[int16]string{
+	1: "page_num",
+	2: "page_size",
+}
 
-	GetResourceCommentAuditList(ctx context.Context, req *GetResourceCommentAuditListReq) (r *GetResourceCommentAuditListResp, err error)
+func (p *GetCourseRatingAuditListReq) Read(iprot thrift.TProtocol) (err error) {
 
-	AuditCourseComment(ctx context.Context, req *AuditCourseCommentReq) (r *AuditCourseCommentResp, err error)
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetPageNum bool = false
+	var issetPageSize bool = false
 
-	AuditResourceComment(ctx context.Context, req *AuditResourceCommentReq) (r *AuditResourceCommentResp, err error)
-}
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
 
-type AdminAuditServiceClient struct {
-	c thrift.TClient
-}
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
 
-func NewAdminAuditServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *AdminAuditServiceClient {
-	return &AdminAuditServiceClient{
-		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetPageNum = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetPageSize = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-}
 
-func NewAdminAuditServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *AdminAuditServiceClient {
-	return &AdminAuditServiceClient{
-		c: thrift.NewTStandardClient(iprot, oprot),
+	if !issetPageNum {
+		fieldId = 1
+		goto RequiredFieldNotSetError
 	}
-}
 
-func NewAdminAuditServiceClient(c thrift.TClient) *AdminAuditServiceClient {
-	return &AdminAuditServiceClient{
-		c: c,
+	if !issetPageSize {
+		fieldId = 2
+		goto RequiredFieldNotSetError
 	}
-}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetCourseRatingAuditListReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
-func (p *AdminAuditServiceClient) Client_() thrift.TClient {
-	return p.c
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_GetCourseRatingAuditListReq[fieldId]))
 }
 
-func (p *AdminAuditServiceClient) GetResourceAuditList(ctx context.Context, req *GetResourceAuditListReq) (r *GetResourceAuditListResp, err error) {
-	var _args AdminAuditServiceGetResourceAuditListArgs
-	_args.Req = req
-	var _result AdminAuditServiceGetResourceAuditListResult
-	if err = p.Client_().Call(ctx, "GetResourceAuditList", &_args, &_result); err != nil {
-		return
+func (p *GetCourseRatingAuditListReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
 	}
-	return _result.GetSuccess(), nil
+	p.PageNum = _field
+	return nil
 }
-func (p *AdminAuditServiceClient) AuditResource(ctx context.Context, req *AuditResourceReq) (r *AuditResourceResp, err error) {
-	var _args AdminAuditServiceAuditResourceArgs
-	_args.Req = req
-	var _result AdminAuditServiceAuditResourceResult
-	if err = p.Client_().Call(ctx, "AuditResource", &_args, &_result); err != nil {
-		return
+func (p *GetCourseRatingAuditListReq) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
 	}
-	return _result.GetSuccess(), nil
+	p.PageSize = _field
+	return nil
 }
-func (p *AdminAuditServiceClient) GetCourseAuditList(ctx context.Context, req *GetCourseAuditListReq) (r *GetCourseAuditListResp, err error) {
-	var _args AdminAuditServiceGetCourseAuditListArgs
-	_args.Req = req
-	var _result AdminAuditServiceGetCourseAuditListResult
-	if err = p.Client_().Call(ctx, "GetCourseAuditList", &_args, &_result); err != nil {
-		return
+
+func (p *GetCourseRatingAuditListReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetCourseRatingAuditListReq"); err != nil {
+		goto WriteStructBeginError
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *AdminAuditServiceClient) AuditCourse(ctx context.Context, req *AuditCourseReq) (r *AuditCourseResp, err error) {
-	var _args AdminAuditServiceAuditCourseArgs
-	_args.Req = req
-	var _result AdminAuditServiceAuditCourseResult
-	if err = p.Client_().Call(ctx, "AuditCourse", &_args, &_result); err != nil {
-		return
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *AdminAuditServiceClient) GetCommentAuditList(ctx context.Context, req *GetCommentAuditListReq) (r *GetCommentAuditListResp, err error) {
-	var _args AdminAuditServiceGetCommentAuditListArgs
-	_args.Req = req
-	var _result AdminAuditServiceGetCommentAuditListResult
-	if err = p.Client_().Call(ctx, "GetCommentAuditList", &_args, &_result); err != nil {
-		return
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *AdminAuditServiceClient) GetCourseCommentAuditList(ctx context.Context, req *GetCourseCommentAuditListReq) (r *GetCourseCommentAuditListResp, err error) {
-	var _args AdminAuditServiceGetCourseCommentAuditListArgs
-	_args.Req = req
-	var _result AdminAuditServiceGetCourseCommentAuditListResult
-	if err = p.Client_().Call(ctx, "GetCourseCommentAuditList", &_args, &_result); err != nil {
-		return
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	return _result.GetSuccess(), nil
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
-func (p *AdminAuditServiceClient) GetResourceCommentAuditList(ctx context.Context, req *GetResourceCommentAuditListReq) (r *GetResourceCommentAuditListResp, err error) {
-	var _args AdminAuditServiceGetResourceCommentAuditListArgs
-	_args.Req = req
-	var _result AdminAuditServiceGetResourceCommentAuditListResult
-	if err = p.Client_().Call(ctx, "GetResourceCommentAuditList", &_args, &_result); err != nil {
-		return
+
+func (p *GetCourseRatingAuditListReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("page_num", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *AdminAuditServiceClient) AuditCourseComment(ctx context.Context, req *AuditCourseCommentReq) (r *AuditCourseCommentResp, err error) {
-	var _args AdminAuditServiceAuditCourseCommentArgs
-	_args.Req = req
-	var _result AdminAuditServiceAuditCourseCommentResult
-	if err = p.Client_().Call(ctx, "AuditCourseComment", &_args, &_result); err != nil {
-		return
+	if err := oprot.WriteI32(p.PageNum); err != nil {
+		return err
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *AdminAuditServiceClient) AuditResourceComment(ctx context.Context, req *AuditResourceCommentReq) (r *AuditResourceCommentResp, err error) {
-	var _args AdminAuditServiceAuditResourceCommentArgs
-	_args.Req = req
-	var _result AdminAuditServiceAuditResourceCommentResult
-	if err = p.Client_().Call(ctx, "AuditResourceComment", &_args, &_result); err != nil {
-		return
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
-	return _result.GetSuccess(), nil
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-type AdminAuditServiceProcessor struct {
-	processorMap map[string]thrift.TProcessorFunction
-	handler      AdminAuditService
+func (p *GetCourseRatingAuditListReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("page_size", thrift.I32, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.PageSize); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *AdminAuditServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
-	p.processorMap[key] = processor
+func (p *GetCourseRatingAuditListReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetCourseRatingAuditListReq(%+v)", *p)
+
 }
 
-func (p *AdminAuditServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
-	processor, ok = p.processorMap[key]
-	return processor, ok
+type GetCourseRatingAuditListResp struct {
+	BaseResp        *module.BaseResp `thrift:"base_resp,1,required" form:"base_resp,required" json:"base_resp,required" query:"base_resp,required"`
+	RatingAuditList []*module.Review `thrift:"rating_audit_list,2,required,list<module.Review>" form:"rating_audit_list,required" json:"rating_audit_list,required" query:"rating_audit_list,required"`
 }
 
-func (p *AdminAuditServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
-	return p.processorMap
+func NewGetCourseRatingAuditListResp() *GetCourseRatingAuditListResp {
+	return &GetCourseRatingAuditListResp{}
 }
 
-func NewAdminAuditServiceProcessor(handler AdminAuditService) *AdminAuditServiceProcessor {
-	self := &AdminAuditServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
-	self.AddToProcessorMap("GetResourceAuditList", &adminAuditServiceProcessorGetResourceAuditList{handler: handler})
-	self.AddToProcessorMap("AuditResource", &adminAuditServiceProcessorAuditResource{handler: handler})
-	self.AddToProcessorMap("GetCourseAuditList", &adminAuditServiceProcessorGetCourseAuditList{handler: handler})
-	self.AddToProcessorMap("AuditCourse", &adminAuditServiceProcessorAuditCourse{handler: handler})
-	self.AddToProcessorMap("GetCommentAuditList", &adminAuditServiceProcessorGetCommentAuditList{handler: handler})
-	self.AddToProcessorMap("GetCourseCommentAuditList", &adminAuditServiceProcessorGetCourseCommentAuditList{handler: handler})
-	self.AddToProcessorMap("GetResourceCommentAuditList", &adminAuditServiceProcessorGetResourceCommentAuditList{handler: handler})
-	self.AddToProcessorMap("AuditCourseComment", &adminAuditServiceProcessorAuditCourseComment{handler: handler})
-	self.AddToProcessorMap("AuditResourceComment", &adminAuditServiceProcessorAuditResourceComment{handler: handler})
-	return self
+func (p *GetCourseRatingAuditListResp) InitDefault() {
 }
-func (p *AdminAuditServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	name, _, seqId, err := iprot.ReadMessageBegin()
-	if err != nil {
-		return false, err
-	}
-	if processor, ok := p.GetProcessorFunction(name); ok {
-		return processor.Process(ctx, seqId, iprot, oprot)
+
+var GetCourseRatingAuditListResp_BaseResp_DEFAULT *module.BaseResp
+
+func (p *GetCourseRatingAuditListResp) GetBaseResp() (v *module.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return GetCourseRatingAuditListResp_BaseResp_DEFAULT
 	}
-	iprot.Skip(thrift.STRUCT)
-	iprot.ReadMessageEnd()
-	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
-	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
-	x.Write(oprot)
-	oprot.WriteMessageEnd()
-	oprot.Flush(ctx)
-	return false, x
+	return p.BaseResp
 }
 
-type adminAuditServiceProcessorGetResourceAuditList struct {
-	handler AdminAuditService
+func (p *GetCourseRatingAuditListResp) GetRatingAuditList() (v []*module.Review) {
+	return p.RatingAuditList
 }
 
-func (p *adminAuditServiceProcessorGetResourceAuditList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := AdminAuditServiceGetResourceAuditListArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetResourceAuditList", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+var fieldIDToName_GetCourseRatingAuditListResp = map[int16]string{
+	1: "base_resp",
+	2: "rating_audit_list",
+}
+
+func (p *GetCourseRatingAuditListResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *GetCourseRatingAuditListResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetBaseResp bool = false
+	var issetRatingAuditList bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := AdminAuditServiceGetResourceAuditListResult{}
-	var retval *GetResourceAuditListResp
-	if retval, err2 = p.handler.GetResourceAuditList(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetResourceAuditList: "+err2.Error())
-		oprot.WriteMessageBegin("GetResourceAuditList", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetBaseResp = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetRatingAuditList = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err2 = oprot.WriteMessageBegin("GetResourceAuditList", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+
+	if !issetBaseResp {
+		fieldId = 1
+		goto RequiredFieldNotSetError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+
+	if !issetRatingAuditList {
+		fieldId = 2
+		goto RequiredFieldNotSetError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetCourseRatingAuditListResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_GetCourseRatingAuditListResp[fieldId]))
+}
+
+func (p *GetCourseRatingAuditListResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := module.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.BaseResp = _field
+	return nil
+}
+func (p *GetCourseRatingAuditListResp) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
 	if err != nil {
-		return
+		return err
 	}
-	return true, err
-}
+	_field := make([]*module.Review, 0, size)
+	values := make([]module.Review, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
 
-type adminAuditServiceProcessorAuditResource struct {
-	handler AdminAuditService
-}
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
 
-func (p *adminAuditServiceProcessorAuditResource) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := AdminAuditServiceAuditResourceArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("AuditResource", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+		_field = append(_field, _elem)
 	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.RatingAuditList = _field
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := AdminAuditServiceAuditResourceResult{}
-	var retval *AuditResourceResp
-	if retval, err2 = p.handler.AuditResource(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing AuditResource: "+err2.Error())
-		oprot.WriteMessageBegin("AuditResource", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+func (p *GetCourseRatingAuditListResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetCourseRatingAuditListResp"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageBegin("AuditResource", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *GetCourseRatingAuditListResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
 	}
-	if err != nil {
-		return
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
 	}
-	return true, err
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-type adminAuditServiceProcessorGetCourseAuditList struct {
-	handler AdminAuditService
+func (p *GetCourseRatingAuditListResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("rating_audit_list", thrift.LIST, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.RatingAuditList)); err != nil {
+		return err
+	}
+	for _, v := range p.RatingAuditList {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *GetCourseRatingAuditListResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetCourseRatingAuditListResp(%+v)", *p)
+
+}
+
+// 审核课程评分举报，approve 时隐藏该评分
+type AuditCourseRatingReq struct {
+	ReviewID int64  `thrift:"review_id,1,required" json:"review_id,required" path:"review_id,required"`
+	Action   string `thrift:"action,2,required" form:"action,required" json:"action,required" query:"action,required"`
+}
+
+func NewAuditCourseRatingReq() *AuditCourseRatingReq {
+	return &AuditCourseRatingReq{}
+}
+
+func (p *AuditCourseRatingReq) InitDefault() {
+}
+
+func (p *AuditCourseRatingReq) GetReviewID() (v int64) {
+	return p.ReviewID
+}
+
+func (p *AuditCourseRatingReq) GetAction() (v string) {
+	return p.Action
+}
+
+var fieldIDToName_AuditCourseRatingReq = map[int16]string{
+	1: "review_id",
+	2: "action",
+}
+
+func (p *AuditCourseRatingReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetReviewID bool = false
+	var issetAction bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetReviewID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetAction = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetReviewID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetAction {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AuditCourseRatingReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_AuditCourseRatingReq[fieldId]))
+}
+
+func (p *AuditCourseRatingReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ReviewID = _field
+	return nil
+}
+func (p *AuditCourseRatingReq) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Action = _field
+	return nil
+}
+
+func (p *AuditCourseRatingReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AuditCourseRatingReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AuditCourseRatingReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("review_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ReviewID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *AuditCourseRatingReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("action", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Action); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *AuditCourseRatingReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AuditCourseRatingReq(%+v)", *p)
+
+}
+
+type AuditCourseRatingResp struct {
+	BaseResp *module.BaseResp `thrift:"base_resp,1,required" form:"base_resp,required" json:"base_resp,required" query:"base_resp,required"`
+}
+
+func NewAuditCourseRatingResp() *AuditCourseRatingResp {
+	return &AuditCourseRatingResp{}
+}
+
+func (p *AuditCourseRatingResp) InitDefault() {
+}
+
+var AuditCourseRatingResp_BaseResp_DEFAULT *module.BaseResp
+
+func (p *AuditCourseRatingResp) GetBaseResp() (v *module.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return AuditCourseRatingResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+var fieldIDToName_AuditCourseRatingResp = map[int16]string{
+	1: "base_resp",
+}
+
+func (p *AuditCourseRatingResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *AuditCourseRatingResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetBaseResp bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetBaseResp = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetBaseResp {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AuditCourseRatingResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_AuditCourseRatingResp[fieldId]))
+}
+
+func (p *AuditCourseRatingResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := module.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+
+func (p *AuditCourseRatingResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AuditCourseRatingResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AuditCourseRatingResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *AuditCourseRatingResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AuditCourseRatingResp(%+v)", *p)
+
+}
+
+// 获取待审核资源评分举报列表
+type GetResourceRatingAuditListReq struct {
+	PageNum  int32 `thrift:"page_num,1,required" form:"page_num,required" json:"page_num,required" query:"page_num,required"`
+	PageSize int32 `thrift:"page_size,2,required" form:"page_size,required" json:"page_size,required" query:"page_size,required"`
+}
+
+func NewGetResourceRatingAuditListReq() *GetResourceRatingAuditListReq {
+	return &GetResourceRatingAuditListReq{}
+}
+
+func (p *GetResourceRatingAuditListReq) InitDefault() {
+}
+
+func (p *GetResourceRatingAuditListReq) GetPageNum() (v int32) {
+	return p.PageNum
+}
+
+func (p *GetResourceRatingAuditListReq) GetPageSize() (v int32) {
+	return p.PageSize
+}
+
+var fieldIDToName_GetResourceRatingAuditListReq = map[int16]string{
+	1: "page_num",
+	2: "page_size",
+}
+
+func (p *GetResourceRatingAuditListReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetPageNum bool = false
+	var issetPageSize bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetPageNum = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetPageSize = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetPageNum {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetPageSize {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetResourceRatingAuditListReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_GetResourceRatingAuditListReq[fieldId]))
+}
+
+func (p *GetResourceRatingAuditListReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.PageNum = _field
+	return nil
+}
+func (p *GetResourceRatingAuditListReq) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.PageSize = _field
+	return nil
+}
+
+func (p *GetResourceRatingAuditListReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetResourceRatingAuditListReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *GetResourceRatingAuditListReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("page_num", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.PageNum); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *GetResourceRatingAuditListReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("page_size", thrift.I32, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.PageSize); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *GetResourceRatingAuditListReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetResourceRatingAuditListReq(%+v)", *p)
+
+}
+
+type GetResourceRatingAuditListResp struct {
+	BaseResp        *module.BaseResp `thrift:"base_resp,1,required" form:"base_resp,required" json:"base_resp,required" query:"base_resp,required"`
+	RatingAuditList []*module.Review `thrift:"rating_audit_list,2,required,list<module.Review>" form:"rating_audit_list,required" json:"rating_audit_list,required" query:"rating_audit_list,required"`
+}
+
+func NewGetResourceRatingAuditListResp() *GetResourceRatingAuditListResp {
+	return &GetResourceRatingAuditListResp{}
+}
+
+func (p *GetResourceRatingAuditListResp) InitDefault() {
+}
+
+var GetResourceRatingAuditListResp_BaseResp_DEFAULT *module.BaseResp
+
+func (p *GetResourceRatingAuditListResp) GetBaseResp() (v *module.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return GetResourceRatingAuditListResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *GetResourceRatingAuditListResp) GetRatingAuditList() (v []*module.Review) {
+	return p.RatingAuditList
+}
+
+var fieldIDToName_GetResourceRatingAuditListResp = map[int16]string{
+	1: "base_resp",
+	2: "rating_audit_list",
+}
+
+func (p *GetResourceRatingAuditListResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *GetResourceRatingAuditListResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetBaseResp bool = false
+	var issetRatingAuditList bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetBaseResp = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetRatingAuditList = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetBaseResp {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetRatingAuditList {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetResourceRatingAuditListResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_GetResourceRatingAuditListResp[fieldId]))
+}
+
+func (p *GetResourceRatingAuditListResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := module.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+func (p *GetResourceRatingAuditListResp) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*module.Review, 0, size)
+	values := make([]module.Review, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.RatingAuditList = _field
+	return nil
+}
+
+func (p *GetResourceRatingAuditListResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetResourceRatingAuditListResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *GetResourceRatingAuditListResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *GetResourceRatingAuditListResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("rating_audit_list", thrift.LIST, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.RatingAuditList)); err != nil {
+		return err
+	}
+	for _, v := range p.RatingAuditList {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *GetResourceRatingAuditListResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetResourceRatingAuditListResp(%+v)", *p)
+
+}
+
+// 审核资源评分举报，approve 时隐藏该评分并重新计算资源平均分
+type AuditResourceRatingReq struct {
+	ReviewID int64  `thrift:"review_id,1,required" json:"review_id,required" path:"review_id,required"`
+	Action   string `thrift:"action,2,required" form:"action,required" json:"action,required" query:"action,required"`
+}
+
+func NewAuditResourceRatingReq() *AuditResourceRatingReq {
+	return &AuditResourceRatingReq{}
+}
+
+func (p *AuditResourceRatingReq) InitDefault() {
+}
+
+func (p *AuditResourceRatingReq) GetReviewID() (v int64) {
+	return p.ReviewID
+}
+
+func (p *AuditResourceRatingReq) GetAction() (v string) {
+	return p.Action
+}
+
+var fieldIDToName_AuditResourceRatingReq = map[int16]string{
+	1: "review_id",
+	2: "action",
+}
+
+func (p *AuditResourceRatingReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetReviewID bool = false
+	var issetAction bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetReviewID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetAction = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetReviewID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetAction {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AuditResourceRatingReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_AuditResourceRatingReq[fieldId]))
+}
+
+func (p *AuditResourceRatingReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ReviewID = _field
+	return nil
+}
+func (p *AuditResourceRatingReq) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Action = _field
+	return nil
+}
+
+func (p *AuditResourceRatingReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AuditResourceRatingReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AuditResourceRatingReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("review_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ReviewID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *AuditResourceRatingReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("action", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Action); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *AuditResourceRatingReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AuditResourceRatingReq(%+v)", *p)
+
+}
+
+type AuditResourceRatingResp struct {
+	BaseResp *module.BaseResp `thrift:"base_resp,1,required" form:"base_resp,required" json:"base_resp,required" query:"base_resp,required"`
+}
+
+func NewAuditResourceRatingResp() *AuditResourceRatingResp {
+	return &AuditResourceRatingResp{}
+}
+
+func (p *AuditResourceRatingResp) InitDefault() {
+}
+
+var AuditResourceRatingResp_BaseResp_DEFAULT *module.BaseResp
+
+func (p *AuditResourceRatingResp) GetBaseResp() (v *module.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return AuditResourceRatingResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+var fieldIDToName_AuditResourceRatingResp = map[int16]string{
+	1: "base_resp",
+}
+
+func (p *AuditResourceRatingResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *AuditResourceRatingResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetBaseResp bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetBaseResp = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetBaseResp {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AuditResourceRatingResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_AuditResourceRatingResp[fieldId]))
+}
+
+func (p *AuditResourceRatingResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := module.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+
+func (p *AuditResourceRatingResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AuditResourceRatingResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AuditResourceRatingResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *AuditResourceRatingResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AuditResourceRatingResp(%+v)", *p)
+
+}
+
+// 查询管理操作日志，时间为秒级时间戳
+type ListAdminActionsReq struct {
+	ActorID    *int64  `thrift:"actor_id,1,optional" json:"actor_id,omitempty" query:"actor_id"`
+	Action     *string `thrift:"action,2,optional" json:"action,omitempty" query:"action"`
+	TargetType *string `thrift:"target_type,3,optional" json:"target_type,omitempty" query:"target_type"`
+	TargetID   *int64  `thrift:"target_id,4,optional" json:"target_id,omitempty" query:"target_id"`
+	StartTime  *int64  `thrift:"start_time,5,optional" json:"start_time,omitempty" query:"start_time"`
+	EndTime    *int64  `thrift:"end_time,6,optional" json:"end_time,omitempty" query:"end_time"`
+	PageNum    int32   `thrift:"page_num,7,required" json:"page_num,required" query:"page_num,required"`
+	PageSize   int32   `thrift:"page_size,8,required" json:"page_size,required" query:"page_size,required"`
+}
+
+func NewListAdminActionsReq() *ListAdminActionsReq {
+	return &ListAdminActionsReq{}
+}
+
+func (p *ListAdminActionsReq) InitDefault() {
+}
+
+var ListAdminActionsReq_ActorID_DEFAULT int64
+
+func (p *ListAdminActionsReq) GetActorID() (v int64) {
+	if !p.IsSetActorID() {
+		return ListAdminActionsReq_ActorID_DEFAULT
+	}
+	return *p.ActorID
+}
+
+var ListAdminActionsReq_Action_DEFAULT string
+
+func (p *ListAdminActionsReq) GetAction() (v string) {
+	if !p.IsSetAction() {
+		return ListAdminActionsReq_Action_DEFAULT
+	}
+	return *p.Action
+}
+
+var ListAdminActionsReq_TargetType_DEFAULT string
+
+func (p *ListAdminActionsReq) GetTargetType() (v string) {
+	if !p.IsSetTargetType() {
+		return ListAdminActionsReq_TargetType_DEFAULT
+	}
+	return *p.TargetType
+}
+
+var ListAdminActionsReq_TargetID_DEFAULT int64
+
+func (p *ListAdminActionsReq) GetTargetID() (v int64) {
+	if !p.IsSetTargetID() {
+		return ListAdminActionsReq_TargetID_DEFAULT
+	}
+	return *p.TargetID
+}
+
+var ListAdminActionsReq_StartTime_DEFAULT int64
+
+func (p *ListAdminActionsReq) GetStartTime() (v int64) {
+	if !p.IsSetStartTime() {
+		return ListAdminActionsReq_StartTime_DEFAULT
+	}
+	return *p.StartTime
+}
+
+var ListAdminActionsReq_EndTime_DEFAULT int64
+
+func (p *ListAdminActionsReq) GetEndTime() (v int64) {
+	if !p.IsSetEndTime() {
+		return ListAdminActionsReq_EndTime_DEFAULT
+	}
+	return *p.EndTime
+}
+
+func (p *ListAdminActionsReq) GetPageNum() (v int32) {
+	return p.PageNum
+}
+
+func (p *ListAdminActionsReq) GetPageSize() (v int32) {
+	return p.PageSize
+}
+
+var fieldIDToName_ListAdminActionsReq = map[int16]string{
+	1: "actor_id",
+	2: "action",
+	3: "target_type",
+	4: "target_id",
+	5: "start_time",
+	6: "end_time",
+	7: "page_num",
+	8: "page_size",
+}
+
+func (p *ListAdminActionsReq) IsSetActorID() bool {
+	return p.ActorID != nil
+}
+
+func (p *ListAdminActionsReq) IsSetAction() bool {
+	return p.Action != nil
+}
+
+func (p *ListAdminActionsReq) IsSetTargetType() bool {
+	return p.TargetType != nil
+}
+
+func (p *ListAdminActionsReq) IsSetTargetID() bool {
+	return p.TargetID != nil
+}
+
+func (p *ListAdminActionsReq) IsSetStartTime() bool {
+	return p.StartTime != nil
+}
+
+func (p *ListAdminActionsReq) IsSetEndTime() bool {
+	return p.EndTime != nil
+}
+
+func (p *ListAdminActionsReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetPageNum bool = false
+	var issetPageSize bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetPageNum = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetPageSize = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetPageNum {
+		fieldId = 7
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetPageSize {
+		fieldId = 8
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListAdminActionsReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ListAdminActionsReq[fieldId]))
+}
+
+func (p *ListAdminActionsReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ActorID = _field
+	return nil
+}
+func (p *ListAdminActionsReq) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Action = _field
+	return nil
+}
+func (p *ListAdminActionsReq) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.TargetType = _field
+	return nil
+}
+func (p *ListAdminActionsReq) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.TargetID = _field
+	return nil
+}
+func (p *ListAdminActionsReq) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.StartTime = _field
+	return nil
+}
+func (p *ListAdminActionsReq) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.EndTime = _field
+	return nil
+}
+func (p *ListAdminActionsReq) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.PageNum = _field
+	return nil
+}
+func (p *ListAdminActionsReq) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.PageSize = _field
+	return nil
+}
+
+func (p *ListAdminActionsReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ListAdminActionsReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ListAdminActionsReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetActorID() {
+		if err = oprot.WriteFieldBegin("actor_id", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.ActorID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ListAdminActionsReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAction() {
+		if err = oprot.WriteFieldBegin("action", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Action); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *ListAdminActionsReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTargetType() {
+		if err = oprot.WriteFieldBegin("target_type", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.TargetType); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *ListAdminActionsReq) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTargetID() {
+		if err = oprot.WriteFieldBegin("target_id", thrift.I64, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.TargetID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *ListAdminActionsReq) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetStartTime() {
+		if err = oprot.WriteFieldBegin("start_time", thrift.I64, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.StartTime); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *ListAdminActionsReq) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEndTime() {
+		if err = oprot.WriteFieldBegin("end_time", thrift.I64, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.EndTime); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *ListAdminActionsReq) writeField7(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("page_num", thrift.I32, 7); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.PageNum); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+
+func (p *ListAdminActionsReq) writeField8(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("page_size", thrift.I32, 8); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.PageSize); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+
+func (p *ListAdminActionsReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListAdminActionsReq(%+v)", *p)
+
+}
+
+type ListAdminActionsResp struct {
+	BaseResp   *module.BaseResp      `thrift:"base_resp,1,required" form:"base_resp,required" json:"base_resp,required" query:"base_resp,required"`
+	ActionList []*module.AdminAction `thrift:"action_list,2,required,list<module.AdminAction>" form:"action_list,required" json:"action_list,required" query:"action_list,required"`
+	Total      int64                 `thrift:"total,3,required" form:"total,required" json:"total,required" query:"total,required"`
+}
+
+func NewListAdminActionsResp() *ListAdminActionsResp {
+	return &ListAdminActionsResp{}
+}
+
+func (p *ListAdminActionsResp) InitDefault() {
+}
+
+var ListAdminActionsResp_BaseResp_DEFAULT *module.BaseResp
+
+func (p *ListAdminActionsResp) GetBaseResp() (v *module.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return ListAdminActionsResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *ListAdminActionsResp) GetActionList() (v []*module.AdminAction) {
+	return p.ActionList
+}
+
+func (p *ListAdminActionsResp) GetTotal() (v int64) {
+	return p.Total
+}
+
+var fieldIDToName_ListAdminActionsResp = map[int16]string{
+	1: "base_resp",
+	2: "action_list",
+	3: "total",
+}
+
+func (p *ListAdminActionsResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *ListAdminActionsResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetBaseResp bool = false
+	var issetActionList bool = false
+	var issetTotal bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetBaseResp = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetActionList = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetTotal = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetBaseResp {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetActionList {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetTotal {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListAdminActionsResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ListAdminActionsResp[fieldId]))
+}
+
+func (p *ListAdminActionsResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := module.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+func (p *ListAdminActionsResp) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*module.AdminAction, 0, size)
+	values := make([]module.AdminAction, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.ActionList = _field
+	return nil
+}
+func (p *ListAdminActionsResp) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Total = _field
+	return nil
+}
+
+func (p *ListAdminActionsResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ListAdminActionsResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ListAdminActionsResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ListAdminActionsResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("action_list", thrift.LIST, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.ActionList)); err != nil {
+		return err
+	}
+	for _, v := range p.ActionList {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *ListAdminActionsResp) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("total", thrift.I64, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.Total); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *ListAdminActionsResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListAdminActionsResp(%+v)", *p)
+
+}
+
+// 导出管理操作日志为 CSV，响应体为文件内容
+type ExportAdminActionsReq struct {
+	ActorID    *int64  `thrift:"actor_id,1,optional" json:"actor_id,omitempty" query:"actor_id"`
+	Action     *string `thrift:"action,2,optional" json:"action,omitempty" query:"action"`
+	TargetType *string `thrift:"target_type,3,optional" json:"target_type,omitempty" query:"target_type"`
+	TargetID   *int64  `thrift:"target_id,4,optional" json:"target_id,omitempty" query:"target_id"`
+	StartTime  *int64  `thrift:"start_time,5,optional" json:"start_time,omitempty" query:"start_time"`
+	EndTime    *int64  `thrift:"end_time,6,optional" json:"end_time,omitempty" query:"end_time"`
+}
+
+func NewExportAdminActionsReq() *ExportAdminActionsReq {
+	return &ExportAdminActionsReq{}
+}
+
+func (p *ExportAdminActionsReq) InitDefault() {
+}
+
+var ExportAdminActionsReq_ActorID_DEFAULT int64
+
+func (p *ExportAdminActionsReq) GetActorID() (v int64) {
+	if !p.IsSetActorID() {
+		return ExportAdminActionsReq_ActorID_DEFAULT
+	}
+	return *p.ActorID
+}
+
+var ExportAdminActionsReq_Action_DEFAULT string
+
+func (p *ExportAdminActionsReq) GetAction() (v string) {
+	if !p.IsSetAction() {
+		return ExportAdminActionsReq_Action_DEFAULT
+	}
+	return *p.Action
+}
+
+var ExportAdminActionsReq_TargetType_DEFAULT string
+
+func (p *ExportAdminActionsReq) GetTargetType() (v string) {
+	if !p.IsSetTargetType() {
+		return ExportAdminActionsReq_TargetType_DEFAULT
+	}
+	return *p.TargetType
+}
+
+var ExportAdminActionsReq_TargetID_DEFAULT int64
+
+func (p *ExportAdminActionsReq) GetTargetID() (v int64) {
+	if !p.IsSetTargetID() {
+		return ExportAdminActionsReq_TargetID_DEFAULT
+	}
+	return *p.TargetID
+}
+
+var ExportAdminActionsReq_StartTime_DEFAULT int64
+
+func (p *ExportAdminActionsReq) GetStartTime() (v int64) {
+	if !p.IsSetStartTime() {
+		return ExportAdminActionsReq_StartTime_DEFAULT
+	}
+	return *p.StartTime
+}
+
+var ExportAdminActionsReq_EndTime_DEFAULT int64
+
+func (p *ExportAdminActionsReq) GetEndTime() (v int64) {
+	if !p.IsSetEndTime() {
+		return ExportAdminActionsReq_EndTime_DEFAULT
+	}
+	return *p.EndTime
+}
+
+var fieldIDToName_ExportAdminActionsReq = map[int16]string{
+	1: "actor_id",
+	2: "action",
+	3: "target_type",
+	4: "target_id",
+	5: "start_time",
+	6: "end_time",
+}
+
+func (p *ExportAdminActionsReq) IsSetActorID() bool {
+	return p.ActorID != nil
+}
+
+func (p *ExportAdminActionsReq) IsSetAction() bool {
+	return p.Action != nil
+}
+
+func (p *ExportAdminActionsReq) IsSetTargetType() bool {
+	return p.TargetType != nil
+}
+
+func (p *ExportAdminActionsReq) IsSetTargetID() bool {
+	return p.TargetID != nil
+}
+
+func (p *ExportAdminActionsReq) IsSetStartTime() bool {
+	return p.StartTime != nil
+}
+
+func (p *ExportAdminActionsReq) IsSetEndTime() bool {
+	return p.EndTime != nil
+}
+
+func (p *ExportAdminActionsReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExportAdminActionsReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ExportAdminActionsReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ActorID = _field
+	return nil
+}
+func (p *ExportAdminActionsReq) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Action = _field
+	return nil
+}
+func (p *ExportAdminActionsReq) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.TargetType = _field
+	return nil
+}
+func (p *ExportAdminActionsReq) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.TargetID = _field
+	return nil
+}
+func (p *ExportAdminActionsReq) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.StartTime = _field
+	return nil
+}
+func (p *ExportAdminActionsReq) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.EndTime = _field
+	return nil
+}
+
+func (p *ExportAdminActionsReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ExportAdminActionsReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ExportAdminActionsReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetActorID() {
+		if err = oprot.WriteFieldBegin("actor_id", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.ActorID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ExportAdminActionsReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAction() {
+		if err = oprot.WriteFieldBegin("action", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Action); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *ExportAdminActionsReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTargetType() {
+		if err = oprot.WriteFieldBegin("target_type", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.TargetType); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *ExportAdminActionsReq) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTargetID() {
+		if err = oprot.WriteFieldBegin("target_id", thrift.I64, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.TargetID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *ExportAdminActionsReq) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetStartTime() {
+		if err = oprot.WriteFieldBegin("start_time", thrift.I64, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.StartTime); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *ExportAdminActionsReq) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEndTime() {
+		if err = oprot.WriteFieldBegin("end_time", thrift.I64, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.EndTime); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *ExportAdminActionsReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ExportAdminActionsReq(%+v)", *p)
+
+}
+
+type ExportAdminActionsResp struct {
+	BaseResp *module.BaseResp `thrift:"base_resp,1,required" form:"base_resp,required" json:"base_resp,required" query:"base_resp,required"`
+}
+
+func NewExportAdminActionsResp() *ExportAdminActionsResp {
+	return &ExportAdminActionsResp{}
+}
+
+func (p *ExportAdminActionsResp) InitDefault() {
+}
+
+var ExportAdminActionsResp_BaseResp_DEFAULT *module.BaseResp
+
+func (p *ExportAdminActionsResp) GetBaseResp() (v *module.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return ExportAdminActionsResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+var fieldIDToName_ExportAdminActionsResp = map[int16]string{
+	1: "base_resp",
+}
+
+func (p *ExportAdminActionsResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *ExportAdminActionsResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetBaseResp bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetBaseResp = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetBaseResp {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ExportAdminActionsResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ExportAdminActionsResp[fieldId]))
+}
+
+func (p *ExportAdminActionsResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := module.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+
+func (p *ExportAdminActionsResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ExportAdminActionsResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ExportAdminActionsResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ExportAdminActionsResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ExportAdminActionsResp(%+v)", *p)
+
+}
+
+// 按队列顺序认领若干条待审核记录，target_type 为空时不限类型
+type ClaimNextReviewsReq struct {
+	TargetType *string `thrift:"target_type,1,optional" form:"target_type" json:"target_type,omitempty" query:"target_type"`
+	Count      int32   `thrift:"count,2,required" form:"count,required" json:"count,required" query:"count,required"`
+}
+
+func NewClaimNextReviewsReq() *ClaimNextReviewsReq {
+	return &ClaimNextReviewsReq{}
+}
+
+func (p *ClaimNextReviewsReq) InitDefault() {
+}
+
+var ClaimNextReviewsReq_TargetType_DEFAULT string
+
+func (p *ClaimNextReviewsReq) GetTargetType() (v string) {
+	if !p.IsSetTargetType() {
+		return ClaimNextReviewsReq_TargetType_DEFAULT
+	}
+	return *p.TargetType
+}
+
+func (p *ClaimNextReviewsReq) GetCount() (v int32) {
+	return p.Count
+}
+
+var fieldIDToName_ClaimNextReviewsReq = map[int16]string{
+	1: "target_type",
+	2: "count",
+}
+
+func (p *ClaimNextReviewsReq) IsSetTargetType() bool {
+	return p.TargetType != nil
+}
+
+func (p *ClaimNextReviewsReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetCount bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetCount = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetCount {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ClaimNextReviewsReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ClaimNextReviewsReq[fieldId]))
+}
+
+func (p *ClaimNextReviewsReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.TargetType = _field
+	return nil
+}
+func (p *ClaimNextReviewsReq) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Count = _field
+	return nil
+}
+
+func (p *ClaimNextReviewsReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ClaimNextReviewsReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ClaimNextReviewsReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTargetType() {
+		if err = oprot.WriteFieldBegin("target_type", thrift.STRING, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.TargetType); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ClaimNextReviewsReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("count", thrift.I32, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.Count); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *ClaimNextReviewsReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ClaimNextReviewsReq(%+v)", *p)
+
+}
+
+type ClaimNextReviewsResp struct {
+	BaseResp   *module.BaseResp `thrift:"base_resp,1,required" form:"base_resp,required" json:"base_resp,required" query:"base_resp,required"`
+	ReviewList []*module.Review `thrift:"review_list,2,required,list<module.Review>" form:"review_list,required" json:"review_list,required" query:"review_list,required"`
+}
+
+func NewClaimNextReviewsResp() *ClaimNextReviewsResp {
+	return &ClaimNextReviewsResp{}
+}
+
+func (p *ClaimNextReviewsResp) InitDefault() {
+}
+
+var ClaimNextReviewsResp_BaseResp_DEFAULT *module.BaseResp
+
+func (p *ClaimNextReviewsResp) GetBaseResp() (v *module.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return ClaimNextReviewsResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *ClaimNextReviewsResp) GetReviewList() (v []*module.Review) {
+	return p.ReviewList
+}
+
+var fieldIDToName_ClaimNextReviewsResp = map[int16]string{
+	1: "base_resp",
+	2: "review_list",
+}
+
+func (p *ClaimNextReviewsResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *ClaimNextReviewsResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetBaseResp bool = false
+	var issetReviewList bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetBaseResp = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetReviewList = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetBaseResp {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetReviewList {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ClaimNextReviewsResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ClaimNextReviewsResp[fieldId]))
+}
+
+func (p *ClaimNextReviewsResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := module.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+func (p *ClaimNextReviewsResp) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*module.Review, 0, size)
+	values := make([]module.Review, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.ReviewList = _field
+	return nil
+}
+
+func (p *ClaimNextReviewsResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ClaimNextReviewsResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ClaimNextReviewsResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ClaimNextReviewsResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("review_list", thrift.LIST, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.ReviewList)); err != nil {
+		return err
+	}
+	for _, v := range p.ReviewList {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *ClaimNextReviewsResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ClaimNextReviewsResp(%+v)", *p)
+
+}
+
+// 认领指定审核记录，已持有认领时续期
+type ClaimReviewReq struct {
+	ReviewID int64 `thrift:"review_id,1,required" json:"review_id,required" path:"review_id,required"`
+}
+
+func NewClaimReviewReq() *ClaimReviewReq {
+	return &ClaimReviewReq{}
+}
+
+func (p *ClaimReviewReq) InitDefault() {
+}
+
+func (p *ClaimReviewReq) GetReviewID() (v int64) {
+	return p.ReviewID
+}
+
+var fieldIDToName_ClaimReviewReq = map[int16]string{
+	1: "review_id",
+}
+
+func (p *ClaimReviewReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetReviewID bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetReviewID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetReviewID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ClaimReviewReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ClaimReviewReq[fieldId]))
+}
+
+func (p *ClaimReviewReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ReviewID = _field
+	return nil
+}
+
+func (p *ClaimReviewReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ClaimReviewReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ClaimReviewReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("review_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ReviewID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ClaimReviewReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ClaimReviewReq(%+v)", *p)
+
+}
+
+type ClaimReviewResp struct {
+	BaseResp *module.BaseResp `thrift:"base_resp,1,required" form:"base_resp,required" json:"base_resp,required" query:"base_resp,required"`
+	Review   *module.Review   `thrift:"review,2,required" form:"review,required" json:"review,required" query:"review,required"`
+}
+
+func NewClaimReviewResp() *ClaimReviewResp {
+	return &ClaimReviewResp{}
+}
+
+func (p *ClaimReviewResp) InitDefault() {
+}
+
+var ClaimReviewResp_BaseResp_DEFAULT *module.BaseResp
+
+func (p *ClaimReviewResp) GetBaseResp() (v *module.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return ClaimReviewResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+var ClaimReviewResp_Review_DEFAULT *module.Review
+
+func (p *ClaimReviewResp) GetReview() (v *module.Review) {
+	if !p.IsSetReview() {
+		return ClaimReviewResp_Review_DEFAULT
+	}
+	return p.Review
+}
+
+var fieldIDToName_ClaimReviewResp = map[int16]string{
+	1: "base_resp",
+	2: "review",
+}
+
+func (p *ClaimReviewResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *ClaimReviewResp) IsSetReview() bool {
+	return p.Review != nil
+}
+
+func (p *ClaimReviewResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetBaseResp bool = false
+	var issetReview bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetBaseResp = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetReview = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetBaseResp {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetReview {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ClaimReviewResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ClaimReviewResp[fieldId]))
+}
+
+func (p *ClaimReviewResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := module.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+func (p *ClaimReviewResp) ReadField2(iprot thrift.TProtocol) error {
+	_field := module.NewReview()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Review = _field
+	return nil
+}
+
+func (p *ClaimReviewResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ClaimReviewResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ClaimReviewResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ClaimReviewResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("review", thrift.STRUCT, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Review.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *ClaimReviewResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ClaimReviewResp(%+v)", *p)
+
+}
+
+type ReleaseReviewReq struct {
+	ReviewID int64 `thrift:"review_id,1,required" json:"review_id,required" path:"review_id,required"`
+}
+
+func NewReleaseReviewReq() *ReleaseReviewReq {
+	return &ReleaseReviewReq{}
+}
+
+func (p *ReleaseReviewReq) InitDefault() {
+}
+
+func (p *ReleaseReviewReq) GetReviewID() (v int64) {
+	return p.ReviewID
+}
+
+var fieldIDToName_ReleaseReviewReq = map[int16]string{
+	1: "review_id",
+}
+
+func (p *ReleaseReviewReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetReviewID bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetReviewID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetReviewID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ReleaseReviewReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ReleaseReviewReq[fieldId]))
+}
+
+func (p *ReleaseReviewReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ReviewID = _field
+	return nil
+}
+
+func (p *ReleaseReviewReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ReleaseReviewReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ReleaseReviewReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("review_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ReviewID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ReleaseReviewReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReleaseReviewReq(%+v)", *p)
+
+}
+
+type ReleaseReviewResp struct {
+	BaseResp *module.BaseResp `thrift:"base_resp,1,required" form:"base_resp,required" json:"base_resp,required" query:"base_resp,required"`
+}
+
+func NewReleaseReviewResp() *ReleaseReviewResp {
+	return &ReleaseReviewResp{}
+}
+
+func (p *ReleaseReviewResp) InitDefault() {
+}
+
+var ReleaseReviewResp_BaseResp_DEFAULT *module.BaseResp
+
+func (p *ReleaseReviewResp) GetBaseResp() (v *module.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return ReleaseReviewResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+var fieldIDToName_ReleaseReviewResp = map[int16]string{
+	1: "base_resp",
+}
+
+func (p *ReleaseReviewResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *ReleaseReviewResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetBaseResp bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetBaseResp = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetBaseResp {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ReleaseReviewResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ReleaseReviewResp[fieldId]))
+}
+
+func (p *ReleaseReviewResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := module.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+
+func (p *ReleaseReviewResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ReleaseReviewResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ReleaseReviewResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ReleaseReviewResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReleaseReviewResp(%+v)", *p)
+
+}
+
+// 当前审核员已认领且未过期的审核记录
+type GetReviewInboxReq struct {
+}
+
+func NewGetReviewInboxReq() *GetReviewInboxReq {
+	return &GetReviewInboxReq{}
+}
+
+func (p *GetReviewInboxReq) InitDefault() {
+}
+
+var fieldIDToName_GetReviewInboxReq = map[int16]string{}
+
+func (p *GetReviewInboxReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		if err = iprot.Skip(fieldTypeId); err != nil {
+			goto SkipFieldTypeError
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+SkipFieldTypeError:
+	return thrift.PrependError(fmt.Sprintf("%T skip field type %d error", p, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *GetReviewInboxReq) Write(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteStructBegin("GetReviewInboxReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *GetReviewInboxReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetReviewInboxReq(%+v)", *p)
+
+}
+
+type GetReviewInboxResp struct {
+	BaseResp   *module.BaseResp `thrift:"base_resp,1,required" form:"base_resp,required" json:"base_resp,required" query:"base_resp,required"`
+	ReviewList []*module.Review `thrift:"review_list,2,required,list<module.Review>" form:"review_list,required" json:"review_list,required" query:"review_list,required"`
+}
+
+func NewGetReviewInboxResp() *GetReviewInboxResp {
+	return &GetReviewInboxResp{}
+}
+
+func (p *GetReviewInboxResp) InitDefault() {
+}
+
+var GetReviewInboxResp_BaseResp_DEFAULT *module.BaseResp
+
+func (p *GetReviewInboxResp) GetBaseResp() (v *module.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return GetReviewInboxResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *GetReviewInboxResp) GetReviewList() (v []*module.Review) {
+	return p.ReviewList
+}
+
+var fieldIDToName_GetReviewInboxResp = map[int16]string{
+	1: "base_resp",
+	2: "review_list",
+}
+
+func (p *GetReviewInboxResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *GetReviewInboxResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetBaseResp bool = false
+	var issetReviewList bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetBaseResp = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetReviewList = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetBaseResp {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetReviewList {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetReviewInboxResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_GetReviewInboxResp[fieldId]))
+}
+
+func (p *GetReviewInboxResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := module.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+func (p *GetReviewInboxResp) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*module.Review, 0, size)
+	values := make([]module.Review, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.ReviewList = _field
+	return nil
+}
+
+func (p *GetReviewInboxResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetReviewInboxResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *GetReviewInboxResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *GetReviewInboxResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("review_list", thrift.LIST, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.ReviewList)); err != nil {
+		return err
+	}
+	for _, v := range p.ReviewList {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *GetReviewInboxResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetReviewInboxResp(%+v)", *p)
+
+}
+
+type GetReviewQueueStatsReq struct {
+	TargetType *string `thrift:"target_type,1,optional" json:"target_type,omitempty" query:"target_type"`
+}
+
+func NewGetReviewQueueStatsReq() *GetReviewQueueStatsReq {
+	return &GetReviewQueueStatsReq{}
+}
+
+func (p *GetReviewQueueStatsReq) InitDefault() {
+}
+
+var GetReviewQueueStatsReq_TargetType_DEFAULT string
+
+func (p *GetReviewQueueStatsReq) GetTargetType() (v string) {
+	if !p.IsSetTargetType() {
+		return GetReviewQueueStatsReq_TargetType_DEFAULT
+	}
+	return *p.TargetType
+}
+
+var fieldIDToName_GetReviewQueueStatsReq = map[int16]string{
+	1: "target_type",
+}
+
+func (p *GetReviewQueueStatsReq) IsSetTargetType() bool {
+	return p.TargetType != nil
+}
+
+func (p *GetReviewQueueStatsReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetReviewQueueStatsReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *GetReviewQueueStatsReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.TargetType = _field
+	return nil
+}
+
+func (p *GetReviewQueueStatsReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetReviewQueueStatsReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *GetReviewQueueStatsReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTargetType() {
+		if err = oprot.WriteFieldBegin("target_type", thrift.STRING, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.TargetType); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *GetReviewQueueStatsReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetReviewQueueStatsReq(%+v)", *p)
+
+}
+
+type GetReviewQueueStatsResp struct {
+	BaseResp *module.BaseResp         `thrift:"base_resp,1,required" form:"base_resp,required" json:"base_resp,required" query:"base_resp,required"`
+	Stats    *module.ReviewQueueStats `thrift:"stats,2,required" form:"stats,required" json:"stats,required" query:"stats,required"`
+}
+
+func NewGetReviewQueueStatsResp() *GetReviewQueueStatsResp {
+	return &GetReviewQueueStatsResp{}
+}
+
+func (p *GetReviewQueueStatsResp) InitDefault() {
+}
+
+var GetReviewQueueStatsResp_BaseResp_DEFAULT *module.BaseResp
+
+func (p *GetReviewQueueStatsResp) GetBaseResp() (v *module.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return GetReviewQueueStatsResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+var GetReviewQueueStatsResp_Stats_DEFAULT *module.ReviewQueueStats
+
+func (p *GetReviewQueueStatsResp) GetStats() (v *module.ReviewQueueStats) {
+	if !p.IsSetStats() {
+		return GetReviewQueueStatsResp_Stats_DEFAULT
+	}
+	return p.Stats
+}
+
+var fieldIDToName_GetReviewQueueStatsResp = map[int16]string{
+	1: "base_resp",
+	2: "stats",
+}
+
+func (p *GetReviewQueueStatsResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *GetReviewQueueStatsResp) IsSetStats() bool {
+	return p.Stats != nil
+}
+
+func (p *GetReviewQueueStatsResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetBaseResp bool = false
+	var issetStats bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetBaseResp = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetStats = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetBaseResp {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetStats {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetReviewQueueStatsResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_GetReviewQueueStatsResp[fieldId]))
+}
+
+func (p *GetReviewQueueStatsResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := module.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+func (p *GetReviewQueueStatsResp) ReadField2(iprot thrift.TProtocol) error {
+	_field := module.NewReviewQueueStats()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Stats = _field
+	return nil
+}
+
+func (p *GetReviewQueueStatsResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetReviewQueueStatsResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *GetReviewQueueStatsResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *GetReviewQueueStatsResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("stats", thrift.STRUCT, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Stats.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *GetReviewQueueStatsResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetReviewQueueStatsResp(%+v)", *p)
+
+}
+
+// 同一对象的多次举报合并为一条审核记录，这里列出全部举报人及其理由
+type GetReviewReportersReq struct {
+	ReviewID int64 `thrift:"review_id,1,required" json:"review_id,required" path:"review_id,required"`
+}
+
+func NewGetReviewReportersReq() *GetReviewReportersReq {
+	return &GetReviewReportersReq{}
+}
+
+func (p *GetReviewReportersReq) InitDefault() {
+}
+
+func (p *GetReviewReportersReq) GetReviewID() (v int64) {
+	return p.ReviewID
+}
+
+var fieldIDToName_GetReviewReportersReq = map[int16]string{
+	1: "review_id",
+}
+
+func (p *GetReviewReportersReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetReviewID bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetReviewID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetReviewID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetReviewReportersReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_GetReviewReportersReq[fieldId]))
+}
+
+func (p *GetReviewReportersReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ReviewID = _field
+	return nil
+}
+
+func (p *GetReviewReportersReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetReviewReportersReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *GetReviewReportersReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("review_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ReviewID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *GetReviewReportersReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetReviewReportersReq(%+v)", *p)
+
+}
+
+type GetReviewReportersResp struct {
+	BaseResp     *module.BaseResp         `thrift:"base_resp,1,required" form:"base_resp,required" json:"base_resp,required" query:"base_resp,required"`
+	ReporterList []*module.ReviewReporter `thrift:"reporter_list,2,required,list<module.ReviewReporter>" form:"reporter_list,required" json:"reporter_list,required" query:"reporter_list,required"`
+}
+
+func NewGetReviewReportersResp() *GetReviewReportersResp {
+	return &GetReviewReportersResp{}
+}
+
+func (p *GetReviewReportersResp) InitDefault() {
+}
+
+var GetReviewReportersResp_BaseResp_DEFAULT *module.BaseResp
+
+func (p *GetReviewReportersResp) GetBaseResp() (v *module.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return GetReviewReportersResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *GetReviewReportersResp) GetReporterList() (v []*module.ReviewReporter) {
+	return p.ReporterList
+}
+
+var fieldIDToName_GetReviewReportersResp = map[int16]string{
+	1: "base_resp",
+	2: "reporter_list",
+}
+
+func (p *GetReviewReportersResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *GetReviewReportersResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetBaseResp bool = false
+	var issetReporterList bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetBaseResp = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetReporterList = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetBaseResp {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetReporterList {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetReviewReportersResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_GetReviewReportersResp[fieldId]))
+}
+
+func (p *GetReviewReportersResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := module.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+func (p *GetReviewReportersResp) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*module.ReviewReporter, 0, size)
+	values := make([]module.ReviewReporter, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.ReporterList = _field
+	return nil
+}
+
+func (p *GetReviewReportersResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetReviewReportersResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *GetReviewReportersResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *GetReviewReportersResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("reporter_list", thrift.LIST, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.ReporterList)); err != nil {
+		return err
+	}
+	for _, v := range p.ReporterList {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *GetReviewReportersResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetReviewReportersResp(%+v)", *p)
+
+}
+
+// 对已确认违规的举报执行处罚，扣分时 reputation_delta 为扣除的分数，禁言时 mute_hours 为禁言时长
+type ApplyModerationActionReq struct {
+	ReviewID        int64  `thrift:"review_id,1,required" json:"review_id,required" path:"review_id,required"`
+	Action          string `thrift:"action,2,required" form:"action,required" json:"action,required" query:"action,required"`
+	Reason          string `thrift:"reason,3,required" form:"reason,required" json:"reason,required" query:"reason,required"`
+	ReputationDelta *int32 `thrift:"reputation_delta,4,optional" form:"reputation_delta" json:"reputation_delta,omitempty" query:"reputation_delta"`
+	MuteHours       *int32 `thrift:"mute_hours,5,optional" form:"mute_hours" json:"mute_hours,omitempty" query:"mute_hours"`
+}
+
+func NewApplyModerationActionReq() *ApplyModerationActionReq {
+	return &ApplyModerationActionReq{}
+}
+
+func (p *ApplyModerationActionReq) InitDefault() {
+}
+
+func (p *ApplyModerationActionReq) GetReviewID() (v int64) {
+	return p.ReviewID
+}
+
+func (p *ApplyModerationActionReq) GetAction() (v string) {
+	return p.Action
+}
+
+func (p *ApplyModerationActionReq) GetReason() (v string) {
+	return p.Reason
+}
+
+var ApplyModerationActionReq_ReputationDelta_DEFAULT int32
+
+func (p *ApplyModerationActionReq) GetReputationDelta() (v int32) {
+	if !p.IsSetReputationDelta() {
+		return ApplyModerationActionReq_ReputationDelta_DEFAULT
+	}
+	return *p.ReputationDelta
+}
+
+var ApplyModerationActionReq_MuteHours_DEFAULT int32
+
+func (p *ApplyModerationActionReq) GetMuteHours() (v int32) {
+	if !p.IsSetMuteHours() {
+		return ApplyModerationActionReq_MuteHours_DEFAULT
+	}
+	return *p.MuteHours
+}
+
+var fieldIDToName_ApplyModerationActionReq = map[int16]string{
+	1: "review_id",
+	2: "action",
+	3: "reason",
+	4: "reputation_delta",
+	5: "mute_hours",
+}
+
+func (p *ApplyModerationActionReq) IsSetReputationDelta() bool {
+	return p.ReputationDelta != nil
+}
+
+func (p *ApplyModerationActionReq) IsSetMuteHours() bool {
+	return p.MuteHours != nil
+}
+
+func (p *ApplyModerationActionReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetReviewID bool = false
+	var issetAction bool = false
+	var issetReason bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetReviewID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetAction = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetReason = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetReviewID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetAction {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetReason {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApplyModerationActionReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ApplyModerationActionReq[fieldId]))
+}
+
+func (p *ApplyModerationActionReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ReviewID = _field
+	return nil
+}
+func (p *ApplyModerationActionReq) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Action = _field
+	return nil
+}
+func (p *ApplyModerationActionReq) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Reason = _field
+	return nil
+}
+func (p *ApplyModerationActionReq) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ReputationDelta = _field
+	return nil
+}
+func (p *ApplyModerationActionReq) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.MuteHours = _field
+	return nil
+}
+
+func (p *ApplyModerationActionReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ApplyModerationActionReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ApplyModerationActionReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("review_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ReviewID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ApplyModerationActionReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("action", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Action); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *ApplyModerationActionReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("reason", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Reason); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *ApplyModerationActionReq) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetReputationDelta() {
+		if err = oprot.WriteFieldBegin("reputation_delta", thrift.I32, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.ReputationDelta); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *ApplyModerationActionReq) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMuteHours() {
+		if err = oprot.WriteFieldBegin("mute_hours", thrift.I32, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.MuteHours); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *ApplyModerationActionReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ApplyModerationActionReq(%+v)", *p)
+
+}
+
+type ApplyModerationActionResp struct {
+	BaseResp         *module.BaseResp         `thrift:"base_resp,1,required" form:"base_resp,required" json:"base_resp,required" query:"base_resp,required"`
+	ModerationAction *module.ModerationAction `thrift:"moderation_action,2,required" form:"moderation_action,required" json:"moderation_action,required" query:"moderation_action,required"`
+}
+
+func NewApplyModerationActionResp() *ApplyModerationActionResp {
+	return &ApplyModerationActionResp{}
+}
+
+func (p *ApplyModerationActionResp) InitDefault() {
+}
+
+var ApplyModerationActionResp_BaseResp_DEFAULT *module.BaseResp
+
+func (p *ApplyModerationActionResp) GetBaseResp() (v *module.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return ApplyModerationActionResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+var ApplyModerationActionResp_ModerationAction_DEFAULT *module.ModerationAction
+
+func (p *ApplyModerationActionResp) GetModerationAction() (v *module.ModerationAction) {
+	if !p.IsSetModerationAction() {
+		return ApplyModerationActionResp_ModerationAction_DEFAULT
+	}
+	return p.ModerationAction
+}
+
+var fieldIDToName_ApplyModerationActionResp = map[int16]string{
+	1: "base_resp",
+	2: "moderation_action",
+}
+
+func (p *ApplyModerationActionResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *ApplyModerationActionResp) IsSetModerationAction() bool {
+	return p.ModerationAction != nil
+}
+
+func (p *ApplyModerationActionResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetBaseResp bool = false
+	var issetModerationAction bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetBaseResp = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetModerationAction = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetBaseResp {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetModerationAction {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ApplyModerationActionResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ApplyModerationActionResp[fieldId]))
+}
+
+func (p *ApplyModerationActionResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := module.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+func (p *ApplyModerationActionResp) ReadField2(iprot thrift.TProtocol) error {
+	_field := module.NewModerationAction()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.ModerationAction = _field
+	return nil
+}
+
+func (p *ApplyModerationActionResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ApplyModerationActionResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ApplyModerationActionResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ApplyModerationActionResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("moderation_action", thrift.STRUCT, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.ModerationAction.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *ApplyModerationActionResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ApplyModerationActionResp(%+v)", *p)
+
+}
+
+// 获取待处理的处罚申诉（二级复核）列表
+type GetAppealListReq struct {
+	PageNum  int32 `thrift:"page_num,1,required" form:"page_num,required" json:"page_num,required" query:"page_num,required"`
+	PageSize int32 `thrift:"page_size,2,required" form:"page_size,required" json:"page_size,required" query:"page_size,required"`
+}
+
+func NewGetAppealListReq() *GetAppealListReq {
+	return &GetAppealListReq{}
+}
+
+func (p *GetAppealListReq) InitDefault() {
+}
+
+func (p *GetAppealListReq) GetPageNum() (v int32) {
+	return p.PageNum
+}
+
+func (p *GetAppealListReq) GetPageSize() (v int32) {
+	return p.PageSize
+}
+
+var fieldIDToName_GetAppealListReq = map[int16]string{
+	1: "page_num",
+	2: "page_size",
+}
+
+func (p *GetAppealListReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetPageNum bool = false
+	var issetPageSize bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetPageNum = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetPageSize = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetPageNum {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetPageSize {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetAppealListReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_GetAppealListReq[fieldId]))
+}
+
+func (p *GetAppealListReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.PageNum = _field
+	return nil
+}
+func (p *GetAppealListReq) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.PageSize = _field
+	return nil
+}
+
+func (p *GetAppealListReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetAppealListReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *GetAppealListReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("page_num", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.PageNum); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *GetAppealListReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("page_size", thrift.I32, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.PageSize); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *GetAppealListReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetAppealListReq(%+v)", *p)
+
+}
+
+type GetAppealListResp struct {
+	BaseResp   *module.BaseResp `thrift:"base_resp,1,required" form:"base_resp,required" json:"base_resp,required" query:"base_resp,required"`
+	AppealList []*module.Review `thrift:"appeal_list,2,required,list<module.Review>" form:"appeal_list,required" json:"appeal_list,required" query:"appeal_list,required"`
+	Total      int64            `thrift:"total,3,required" form:"total,required" json:"total,required" query:"total,required"`
+}
+
+func NewGetAppealListResp() *GetAppealListResp {
+	return &GetAppealListResp{}
+}
+
+func (p *GetAppealListResp) InitDefault() {
+}
+
+var GetAppealListResp_BaseResp_DEFAULT *module.BaseResp
+
+func (p *GetAppealListResp) GetBaseResp() (v *module.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return GetAppealListResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *GetAppealListResp) GetAppealList() (v []*module.Review) {
+	return p.AppealList
+}
+
+func (p *GetAppealListResp) GetTotal() (v int64) {
+	return p.Total
+}
+
+var fieldIDToName_GetAppealListResp = map[int16]string{
+	1: "base_resp",
+	2: "appeal_list",
+	3: "total",
+}
+
+func (p *GetAppealListResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *GetAppealListResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetBaseResp bool = false
+	var issetAppealList bool = false
+	var issetTotal bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetBaseResp = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetAppealList = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetTotal = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetBaseResp {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetAppealList {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetTotal {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetAppealListResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_GetAppealListResp[fieldId]))
+}
+
+func (p *GetAppealListResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := module.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+func (p *GetAppealListResp) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*module.Review, 0, size)
+	values := make([]module.Review, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.AppealList = _field
+	return nil
+}
+func (p *GetAppealListResp) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Total = _field
+	return nil
+}
+
+func (p *GetAppealListResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetAppealListResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *GetAppealListResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *GetAppealListResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("appeal_list", thrift.LIST, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.AppealList)); err != nil {
+		return err
+	}
+	for _, v := range p.AppealList {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *GetAppealListResp) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("total", thrift.I64, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.Total); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *GetAppealListResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetAppealListResp(%+v)", *p)
+
+}
+
+// 处理处罚申诉：approve 撤销处罚，reject 维持处罚
+type ResolveAppealReq struct {
+	ReviewID int64  `thrift:"review_id,1,required" json:"review_id,required" path:"review_id,required"`
+	Action   string `thrift:"action,2,required" form:"action,required" json:"action,required" query:"action,required"`
+}
+
+func NewResolveAppealReq() *ResolveAppealReq {
+	return &ResolveAppealReq{}
+}
+
+func (p *ResolveAppealReq) InitDefault() {
+}
+
+func (p *ResolveAppealReq) GetReviewID() (v int64) {
+	return p.ReviewID
+}
+
+func (p *ResolveAppealReq) GetAction() (v string) {
+	return p.Action
+}
+
+var fieldIDToName_ResolveAppealReq = map[int16]string{
+	1: "review_id",
+	2: "action",
+}
+
+func (p *ResolveAppealReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetReviewID bool = false
+	var issetAction bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetReviewID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetAction = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetReviewID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetAction {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ResolveAppealReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ResolveAppealReq[fieldId]))
+}
+
+func (p *ResolveAppealReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ReviewID = _field
+	return nil
+}
+func (p *ResolveAppealReq) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Action = _field
+	return nil
+}
+
+func (p *ResolveAppealReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ResolveAppealReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ResolveAppealReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("review_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ReviewID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ResolveAppealReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("action", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Action); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *ResolveAppealReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ResolveAppealReq(%+v)", *p)
+
+}
+
+type ResolveAppealResp struct {
+	BaseResp *module.BaseResp `thrift:"base_resp,1,required" form:"base_resp,required" json:"base_resp,required" query:"base_resp,required"`
+}
+
+func NewResolveAppealResp() *ResolveAppealResp {
+	return &ResolveAppealResp{}
+}
+
+func (p *ResolveAppealResp) InitDefault() {
+}
+
+var ResolveAppealResp_BaseResp_DEFAULT *module.BaseResp
+
+func (p *ResolveAppealResp) GetBaseResp() (v *module.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return ResolveAppealResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+var fieldIDToName_ResolveAppealResp = map[int16]string{
+	1: "base_resp",
+}
+
+func (p *ResolveAppealResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *ResolveAppealResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetBaseResp bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetBaseResp = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetBaseResp {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ResolveAppealResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ResolveAppealResp[fieldId]))
+}
+
+func (p *ResolveAppealResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := module.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+
+func (p *ResolveAppealResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ResolveAppealResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ResolveAppealResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ResolveAppealResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ResolveAppealResp(%+v)", *p)
+
+}
+
+// 内容过滤规则：match_type 为 keyword（忽略大小写）或 regex，action 为 reject、mask 或 review
+type ListContentFilterRulesReq struct {
+	Enabled  *bool   `thrift:"enabled,1,optional" json:"enabled,omitempty" query:"enabled"`
+	Keyword  *string `thrift:"keyword,2,optional" json:"keyword,omitempty" query:"keyword"`
+	PageNum  int32   `thrift:"page_num,3,required" json:"page_num,required" query:"page_num,required"`
+	PageSize int32   `thrift:"page_size,4,required" json:"page_size,required" query:"page_size,required"`
+}
+
+func NewListContentFilterRulesReq() *ListContentFilterRulesReq {
+	return &ListContentFilterRulesReq{}
+}
+
+func (p *ListContentFilterRulesReq) InitDefault() {
+}
+
+var ListContentFilterRulesReq_Enabled_DEFAULT bool
+
+func (p *ListContentFilterRulesReq) GetEnabled() (v bool) {
+	if !p.IsSetEnabled() {
+		return ListContentFilterRulesReq_Enabled_DEFAULT
+	}
+	return *p.Enabled
+}
+
+var ListContentFilterRulesReq_Keyword_DEFAULT string
+
+func (p *ListContentFilterRulesReq) GetKeyword() (v string) {
+	if !p.IsSetKeyword() {
+		return ListContentFilterRulesReq_Keyword_DEFAULT
+	}
+	return *p.Keyword
+}
+
+func (p *ListContentFilterRulesReq) GetPageNum() (v int32) {
+	return p.PageNum
+}
+
+func (p *ListContentFilterRulesReq) GetPageSize() (v int32) {
+	return p.PageSize
+}
+
+var fieldIDToName_ListContentFilterRulesReq = map[int16]string{
+	1: "enabled",
+	2: "keyword",
+	3: "page_num",
+	4: "page_size",
+}
+
+func (p *ListContentFilterRulesReq) IsSetEnabled() bool {
+	return p.Enabled != nil
+}
+
+func (p *ListContentFilterRulesReq) IsSetKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListContentFilterRulesReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetPageNum bool = false
+	var issetPageSize bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetPageNum = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetPageSize = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetPageNum {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetPageSize {
+		fieldId = 4
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListContentFilterRulesReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ListContentFilterRulesReq[fieldId]))
+}
+
+func (p *ListContentFilterRulesReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Enabled = _field
+	return nil
+}
+func (p *ListContentFilterRulesReq) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Keyword = _field
+	return nil
+}
+func (p *ListContentFilterRulesReq) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.PageNum = _field
+	return nil
+}
+func (p *ListContentFilterRulesReq) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.PageSize = _field
+	return nil
+}
+
+func (p *ListContentFilterRulesReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ListContentFilterRulesReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ListContentFilterRulesReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEnabled() {
+		if err = oprot.WriteFieldBegin("enabled", thrift.BOOL, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.Enabled); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ListContentFilterRulesReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetKeyword() {
+		if err = oprot.WriteFieldBegin("keyword", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Keyword); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *ListContentFilterRulesReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("page_num", thrift.I32, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.PageNum); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *ListContentFilterRulesReq) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("page_size", thrift.I32, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.PageSize); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *ListContentFilterRulesReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListContentFilterRulesReq(%+v)", *p)
+
+}
+
+type ListContentFilterRulesResp struct {
+	BaseResp *module.BaseResp            `thrift:"base_resp,1,required" form:"base_resp,required" json:"base_resp,required" query:"base_resp,required"`
+	RuleList []*module.ContentFilterRule `thrift:"rule_list,2,required,list<module.ContentFilterRule>" form:"rule_list,required" json:"rule_list,required" query:"rule_list,required"`
+	Total    int64                       `thrift:"total,3,required" form:"total,required" json:"total,required" query:"total,required"`
+}
+
+func NewListContentFilterRulesResp() *ListContentFilterRulesResp {
+	return &ListContentFilterRulesResp{}
+}
+
+func (p *ListContentFilterRulesResp) InitDefault() {
+}
+
+var ListContentFilterRulesResp_BaseResp_DEFAULT *module.BaseResp
+
+func (p *ListContentFilterRulesResp) GetBaseResp() (v *module.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return ListContentFilterRulesResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *ListContentFilterRulesResp) GetRuleList() (v []*module.ContentFilterRule) {
+	return p.RuleList
+}
+
+func (p *ListContentFilterRulesResp) GetTotal() (v int64) {
+	return p.Total
+}
+
+var fieldIDToName_ListContentFilterRulesResp = map[int16]string{
+	1: "base_resp",
+	2: "rule_list",
+	3: "total",
+}
+
+func (p *ListContentFilterRulesResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *ListContentFilterRulesResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetBaseResp bool = false
+	var issetRuleList bool = false
+	var issetTotal bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetBaseResp = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetRuleList = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetTotal = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetBaseResp {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetRuleList {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetTotal {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListContentFilterRulesResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ListContentFilterRulesResp[fieldId]))
+}
+
+func (p *ListContentFilterRulesResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := module.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+func (p *ListContentFilterRulesResp) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*module.ContentFilterRule, 0, size)
+	values := make([]module.ContentFilterRule, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.RuleList = _field
+	return nil
+}
+func (p *ListContentFilterRulesResp) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Total = _field
+	return nil
+}
+
+func (p *ListContentFilterRulesResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ListContentFilterRulesResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ListContentFilterRulesResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ListContentFilterRulesResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("rule_list", thrift.LIST, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.RuleList)); err != nil {
+		return err
+	}
+	for _, v := range p.RuleList {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *ListContentFilterRulesResp) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("total", thrift.I64, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.Total); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *ListContentFilterRulesResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListContentFilterRulesResp(%+v)", *p)
+
+}
+
+type CreateContentFilterRuleReq struct {
+	Pattern     string  `thrift:"pattern,1,required" form:"pattern,required" json:"pattern,required" query:"pattern,required"`
+	MatchType   string  `thrift:"match_type,2,required" form:"match_type,required" json:"match_type,required" query:"match_type,required"`
+	Action      string  `thrift:"action,3,required" form:"action,required" json:"action,required" query:"action,required"`
+	Description *string `thrift:"description,4,optional" form:"description" json:"description,omitempty" query:"description"`
+}
+
+func NewCreateContentFilterRuleReq() *CreateContentFilterRuleReq {
+	return &CreateContentFilterRuleReq{}
+}
+
+func (p *CreateContentFilterRuleReq) InitDefault() {
+}
+
+func (p *CreateContentFilterRuleReq) GetPattern() (v string) {
+	return p.Pattern
+}
+
+func (p *CreateContentFilterRuleReq) GetMatchType() (v string) {
+	return p.MatchType
+}
+
+func (p *CreateContentFilterRuleReq) GetAction() (v string) {
+	return p.Action
+}
+
+var CreateContentFilterRuleReq_Description_DEFAULT string
+
+func (p *CreateContentFilterRuleReq) GetDescription() (v string) {
+	if !p.IsSetDescription() {
+		return CreateContentFilterRuleReq_Description_DEFAULT
+	}
+	return *p.Description
+}
+
+var fieldIDToName_CreateContentFilterRuleReq = map[int16]string{
+	1: "pattern",
+	2: "match_type",
+	3: "action",
+	4: "description",
+}
+
+func (p *CreateContentFilterRuleReq) IsSetDescription() bool {
+	return p.Description != nil
+}
+
+func (p *CreateContentFilterRuleReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetPattern bool = false
+	var issetMatchType bool = false
+	var issetAction bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetPattern = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetMatchType = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetAction = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetPattern {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetMatchType {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetAction {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CreateContentFilterRuleReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_CreateContentFilterRuleReq[fieldId]))
+}
+
+func (p *CreateContentFilterRuleReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Pattern = _field
+	return nil
+}
+func (p *CreateContentFilterRuleReq) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.MatchType = _field
+	return nil
+}
+func (p *CreateContentFilterRuleReq) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Action = _field
+	return nil
+}
+func (p *CreateContentFilterRuleReq) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Description = _field
+	return nil
+}
+
+func (p *CreateContentFilterRuleReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CreateContentFilterRuleReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *CreateContentFilterRuleReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("pattern", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Pattern); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *CreateContentFilterRuleReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("match_type", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.MatchType); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *CreateContentFilterRuleReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("action", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Action); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *CreateContentFilterRuleReq) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDescription() {
+		if err = oprot.WriteFieldBegin("description", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Description); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *CreateContentFilterRuleReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CreateContentFilterRuleReq(%+v)", *p)
+
+}
+
+type CreateContentFilterRuleResp struct {
+	BaseResp *module.BaseResp          `thrift:"base_resp,1,required" form:"base_resp,required" json:"base_resp,required" query:"base_resp,required"`
+	Rule     *module.ContentFilterRule `thrift:"rule,2,required" form:"rule,required" json:"rule,required" query:"rule,required"`
+}
+
+func NewCreateContentFilterRuleResp() *CreateContentFilterRuleResp {
+	return &CreateContentFilterRuleResp{}
+}
+
+func (p *CreateContentFilterRuleResp) InitDefault() {
+}
+
+var CreateContentFilterRuleResp_BaseResp_DEFAULT *module.BaseResp
+
+func (p *CreateContentFilterRuleResp) GetBaseResp() (v *module.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return CreateContentFilterRuleResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+var CreateContentFilterRuleResp_Rule_DEFAULT *module.ContentFilterRule
+
+func (p *CreateContentFilterRuleResp) GetRule() (v *module.ContentFilterRule) {
+	if !p.IsSetRule() {
+		return CreateContentFilterRuleResp_Rule_DEFAULT
+	}
+	return p.Rule
+}
+
+var fieldIDToName_CreateContentFilterRuleResp = map[int16]string{
+	1: "base_resp",
+	2: "rule",
+}
+
+func (p *CreateContentFilterRuleResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *CreateContentFilterRuleResp) IsSetRule() bool {
+	return p.Rule != nil
+}
+
+func (p *CreateContentFilterRuleResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetBaseResp bool = false
+	var issetRule bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetBaseResp = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetRule = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetBaseResp {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetRule {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CreateContentFilterRuleResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_CreateContentFilterRuleResp[fieldId]))
+}
+
+func (p *CreateContentFilterRuleResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := module.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+func (p *CreateContentFilterRuleResp) ReadField2(iprot thrift.TProtocol) error {
+	_field := module.NewContentFilterRule()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Rule = _field
+	return nil
+}
+
+func (p *CreateContentFilterRuleResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CreateContentFilterRuleResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *CreateContentFilterRuleResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *CreateContentFilterRuleResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("rule", thrift.STRUCT, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Rule.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *CreateContentFilterRuleResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CreateContentFilterRuleResp(%+v)", *p)
+
+}
+
+// 只更新传入的字段
+type UpdateContentFilterRuleReq struct {
+	RuleID      int64   `thrift:"rule_id,1,required" json:"rule_id,required" path:"rule_id,required"`
+	Pattern     *string `thrift:"pattern,2,optional" form:"pattern" json:"pattern,omitempty" query:"pattern"`
+	MatchType   *string `thrift:"match_type,3,optional" form:"match_type" json:"match_type,omitempty" query:"match_type"`
+	Action      *string `thrift:"action,4,optional" form:"action" json:"action,omitempty" query:"action"`
+	Description *string `thrift:"description,5,optional" form:"description" json:"description,omitempty" query:"description"`
+	Enabled     *bool   `thrift:"enabled,6,optional" form:"enabled" json:"enabled,omitempty" query:"enabled"`
+}
+
+func NewUpdateContentFilterRuleReq() *UpdateContentFilterRuleReq {
+	return &UpdateContentFilterRuleReq{}
+}
+
+func (p *UpdateContentFilterRuleReq) InitDefault() {
+}
+
+func (p *UpdateContentFilterRuleReq) GetRuleID() (v int64) {
+	return p.RuleID
+}
+
+var UpdateContentFilterRuleReq_Pattern_DEFAULT string
+
+func (p *UpdateContentFilterRuleReq) GetPattern() (v string) {
+	if !p.IsSetPattern() {
+		return UpdateContentFilterRuleReq_Pattern_DEFAULT
+	}
+	return *p.Pattern
+}
+
+var UpdateContentFilterRuleReq_MatchType_DEFAULT string
+
+func (p *UpdateContentFilterRuleReq) GetMatchType() (v string) {
+	if !p.IsSetMatchType() {
+		return UpdateContentFilterRuleReq_MatchType_DEFAULT
+	}
+	return *p.MatchType
+}
+
+var UpdateContentFilterRuleReq_Action_DEFAULT string
+
+func (p *UpdateContentFilterRuleReq) GetAction() (v string) {
+	if !p.IsSetAction() {
+		return UpdateContentFilterRuleReq_Action_DEFAULT
+	}
+	return *p.Action
+}
+
+var UpdateContentFilterRuleReq_Description_DEFAULT string
+
+func (p *UpdateContentFilterRuleReq) GetDescription() (v string) {
+	if !p.IsSetDescription() {
+		return UpdateContentFilterRuleReq_Description_DEFAULT
+	}
+	return *p.Description
+}
+
+var UpdateContentFilterRuleReq_Enabled_DEFAULT bool
+
+func (p *UpdateContentFilterRuleReq) GetEnabled() (v bool) {
+	if !p.IsSetEnabled() {
+		return UpdateContentFilterRuleReq_Enabled_DEFAULT
+	}
+	return *p.Enabled
+}
+
+var fieldIDToName_UpdateContentFilterRuleReq = map[int16]string{
+	1: "rule_id",
+	2: "pattern",
+	3: "match_type",
+	4: "action",
+	5: "description",
+	6: "enabled",
+}
+
+func (p *UpdateContentFilterRuleReq) IsSetPattern() bool {
+	return p.Pattern != nil
+}
+
+func (p *UpdateContentFilterRuleReq) IsSetMatchType() bool {
+	return p.MatchType != nil
+}
+
+func (p *UpdateContentFilterRuleReq) IsSetAction() bool {
+	return p.Action != nil
+}
+
+func (p *UpdateContentFilterRuleReq) IsSetDescription() bool {
+	return p.Description != nil
+}
+
+func (p *UpdateContentFilterRuleReq) IsSetEnabled() bool {
+	return p.Enabled != nil
+}
+
+func (p *UpdateContentFilterRuleReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetRuleID bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetRuleID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetRuleID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateContentFilterRuleReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_UpdateContentFilterRuleReq[fieldId]))
+}
+
+func (p *UpdateContentFilterRuleReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.RuleID = _field
+	return nil
+}
+func (p *UpdateContentFilterRuleReq) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Pattern = _field
+	return nil
+}
+func (p *UpdateContentFilterRuleReq) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.MatchType = _field
+	return nil
+}
+func (p *UpdateContentFilterRuleReq) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Action = _field
+	return nil
+}
+func (p *UpdateContentFilterRuleReq) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Description = _field
+	return nil
+}
+func (p *UpdateContentFilterRuleReq) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Enabled = _field
+	return nil
+}
+
+func (p *UpdateContentFilterRuleReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UpdateContentFilterRuleReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UpdateContentFilterRuleReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("rule_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.RuleID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *UpdateContentFilterRuleReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPattern() {
+		if err = oprot.WriteFieldBegin("pattern", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Pattern); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *UpdateContentFilterRuleReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMatchType() {
+		if err = oprot.WriteFieldBegin("match_type", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.MatchType); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *UpdateContentFilterRuleReq) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAction() {
+		if err = oprot.WriteFieldBegin("action", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Action); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *UpdateContentFilterRuleReq) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDescription() {
+		if err = oprot.WriteFieldBegin("description", thrift.STRING, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Description); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *UpdateContentFilterRuleReq) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEnabled() {
+		if err = oprot.WriteFieldBegin("enabled", thrift.BOOL, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.Enabled); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *UpdateContentFilterRuleReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateContentFilterRuleReq(%+v)", *p)
+
+}
+
+type UpdateContentFilterRuleResp struct {
+	BaseResp *module.BaseResp          `thrift:"base_resp,1,required" form:"base_resp,required" json:"base_resp,required" query:"base_resp,required"`
+	Rule     *module.ContentFilterRule `thrift:"rule,2,required" form:"rule,required" json:"rule,required" query:"rule,required"`
+}
+
+func NewUpdateContentFilterRuleResp() *UpdateContentFilterRuleResp {
+	return &UpdateContentFilterRuleResp{}
+}
+
+func (p *UpdateContentFilterRuleResp) InitDefault() {
+}
+
+var UpdateContentFilterRuleResp_BaseResp_DEFAULT *module.BaseResp
+
+func (p *UpdateContentFilterRuleResp) GetBaseResp() (v *module.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return UpdateContentFilterRuleResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+var UpdateContentFilterRuleResp_Rule_DEFAULT *module.ContentFilterRule
+
+func (p *UpdateContentFilterRuleResp) GetRule() (v *module.ContentFilterRule) {
+	if !p.IsSetRule() {
+		return UpdateContentFilterRuleResp_Rule_DEFAULT
+	}
+	return p.Rule
+}
+
+var fieldIDToName_UpdateContentFilterRuleResp = map[int16]string{
+	1: "base_resp",
+	2: "rule",
+}
+
+func (p *UpdateContentFilterRuleResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *UpdateContentFilterRuleResp) IsSetRule() bool {
+	return p.Rule != nil
+}
+
+func (p *UpdateContentFilterRuleResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetBaseResp bool = false
+	var issetRule bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetBaseResp = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetRule = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetBaseResp {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetRule {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateContentFilterRuleResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_UpdateContentFilterRuleResp[fieldId]))
+}
+
+func (p *UpdateContentFilterRuleResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := module.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+func (p *UpdateContentFilterRuleResp) ReadField2(iprot thrift.TProtocol) error {
+	_field := module.NewContentFilterRule()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Rule = _field
+	return nil
+}
+
+func (p *UpdateContentFilterRuleResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UpdateContentFilterRuleResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UpdateContentFilterRuleResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *UpdateContentFilterRuleResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("rule", thrift.STRUCT, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Rule.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *UpdateContentFilterRuleResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateContentFilterRuleResp(%+v)", *p)
+
+}
+
+type DeleteContentFilterRuleReq struct {
+	RuleID int64 `thrift:"rule_id,1,required" json:"rule_id,required" path:"rule_id,required"`
+}
+
+func NewDeleteContentFilterRuleReq() *DeleteContentFilterRuleReq {
+	return &DeleteContentFilterRuleReq{}
+}
+
+func (p *DeleteContentFilterRuleReq) InitDefault() {
+}
+
+func (p *DeleteContentFilterRuleReq) GetRuleID() (v int64) {
+	return p.RuleID
+}
+
+var fieldIDToName_DeleteContentFilterRuleReq = map[int16]string{
+	1: "rule_id",
+}
+
+func (p *DeleteContentFilterRuleReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetRuleID bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetRuleID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetRuleID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeleteContentFilterRuleReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_DeleteContentFilterRuleReq[fieldId]))
+}
+
+func (p *DeleteContentFilterRuleReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.RuleID = _field
+	return nil
+}
+
+func (p *DeleteContentFilterRuleReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("DeleteContentFilterRuleReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *DeleteContentFilterRuleReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("rule_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.RuleID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *DeleteContentFilterRuleReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DeleteContentFilterRuleReq(%+v)", *p)
+
+}
+
+type DeleteContentFilterRuleResp struct {
+	BaseResp *module.BaseResp `thrift:"base_resp,1,required" form:"base_resp,required" json:"base_resp,required" query:"base_resp,required"`
+}
+
+func NewDeleteContentFilterRuleResp() *DeleteContentFilterRuleResp {
+	return &DeleteContentFilterRuleResp{}
+}
+
+func (p *DeleteContentFilterRuleResp) InitDefault() {
+}
+
+var DeleteContentFilterRuleResp_BaseResp_DEFAULT *module.BaseResp
+
+func (p *DeleteContentFilterRuleResp) GetBaseResp() (v *module.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return DeleteContentFilterRuleResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+var fieldIDToName_DeleteContentFilterRuleResp = map[int16]string{
+	1: "base_resp",
+}
+
+func (p *DeleteContentFilterRuleResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *DeleteContentFilterRuleResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetBaseResp bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetBaseResp = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetBaseResp {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeleteContentFilterRuleResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_DeleteContentFilterRuleResp[fieldId]))
+}
+
+func (p *DeleteContentFilterRuleResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := module.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+
+func (p *DeleteContentFilterRuleResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("DeleteContentFilterRuleResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *DeleteContentFilterRuleResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *DeleteContentFilterRuleResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DeleteContentFilterRuleResp(%+v)", *p)
+
+}
+
+// 用当前启用的规则检查一段文本，不会产生审核记录
+type TestContentFilterReq struct {
+	Text string `thrift:"text,1,required" form:"text,required" json:"text,required" query:"text,required"`
+}
+
+func NewTestContentFilterReq() *TestContentFilterReq {
+	return &TestContentFilterReq{}
+}
+
+func (p *TestContentFilterReq) InitDefault() {
+}
+
+func (p *TestContentFilterReq) GetText() (v string) {
+	return p.Text
+}
+
+var fieldIDToName_TestContentFilterReq = map[int16]string{
+	1: "text",
+}
+
+func (p *TestContentFilterReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetText bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetText = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetText {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TestContentFilterReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_TestContentFilterReq[fieldId]))
+}
+
+func (p *TestContentFilterReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Text = _field
+	return nil
+}
+
+func (p *TestContentFilterReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TestContentFilterReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TestContentFilterReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("text", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Text); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TestContentFilterReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TestContentFilterReq(%+v)", *p)
+
+}
+
+type TestContentFilterResp struct {
+	BaseResp   *module.BaseResp             `thrift:"base_resp,1,required" form:"base_resp,required" json:"base_resp,required" query:"base_resp,required"`
+	Action     *string                      `thrift:"action,2,optional" form:"action" json:"action,omitempty" query:"action"`
+	MatchList  []*module.ContentFilterMatch `thrift:"match_list,3,required,list<module.ContentFilterMatch>" form:"match_list,required" json:"match_list,required" query:"match_list,required"`
+	MaskedText string                       `thrift:"masked_text,4,required" form:"masked_text,required" json:"masked_text,required" query:"masked_text,required"`
+}
+
+func NewTestContentFilterResp() *TestContentFilterResp {
+	return &TestContentFilterResp{}
+}
+
+func (p *TestContentFilterResp) InitDefault() {
+}
+
+var TestContentFilterResp_BaseResp_DEFAULT *module.BaseResp
+
+func (p *TestContentFilterResp) GetBaseResp() (v *module.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return TestContentFilterResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+var TestContentFilterResp_Action_DEFAULT string
+
+func (p *TestContentFilterResp) GetAction() (v string) {
+	if !p.IsSetAction() {
+		return TestContentFilterResp_Action_DEFAULT
+	}
+	return *p.Action
+}
+
+func (p *TestContentFilterResp) GetMatchList() (v []*module.ContentFilterMatch) {
+	return p.MatchList
+}
+
+func (p *TestContentFilterResp) GetMaskedText() (v string) {
+	return p.MaskedText
+}
+
+var fieldIDToName_TestContentFilterResp = map[int16]string{
+	1: "base_resp",
+	2: "action",
+	3: "match_list",
+	4: "masked_text",
+}
+
+func (p *TestContentFilterResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *TestContentFilterResp) IsSetAction() bool {
+	return p.Action != nil
+}
+
+func (p *TestContentFilterResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetBaseResp bool = false
+	var issetMatchList bool = false
+	var issetMaskedText bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetBaseResp = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetMatchList = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetMaskedText = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetBaseResp {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetMatchList {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetMaskedText {
+		fieldId = 4
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TestContentFilterResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_TestContentFilterResp[fieldId]))
+}
+
+func (p *TestContentFilterResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := module.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+func (p *TestContentFilterResp) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Action = _field
+	return nil
+}
+func (p *TestContentFilterResp) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*module.ContentFilterMatch, 0, size)
+	values := make([]module.ContentFilterMatch, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.MatchList = _field
+	return nil
+}
+func (p *TestContentFilterResp) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.MaskedText = _field
+	return nil
+}
+
+func (p *TestContentFilterResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("TestContentFilterResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *TestContentFilterResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *TestContentFilterResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAction() {
+		if err = oprot.WriteFieldBegin("action", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Action); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *TestContentFilterResp) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("match_list", thrift.LIST, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.MatchList)); err != nil {
+		return err
+	}
+	for _, v := range p.MatchList {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *TestContentFilterResp) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("masked_text", thrift.STRING, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.MaskedText); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *TestContentFilterResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TestContentFilterResp(%+v)", *p)
+
+}
+
+// 批量审核举报，每条举报单独提交事务，与单条审核的处理相同
+type BatchAuditReviewsReq struct {
+	ReviewIds []int64 `thrift:"review_ids,1,required,list<i64>" form:"review_ids,required" json:"review_ids,required" query:"review_ids,required"`
+	Action    string  `thrift:"action,2,required" form:"action,required" json:"action,required" query:"action,required"`
+}
+
+func NewBatchAuditReviewsReq() *BatchAuditReviewsReq {
+	return &BatchAuditReviewsReq{}
+}
+
+func (p *BatchAuditReviewsReq) InitDefault() {
+}
+
+func (p *BatchAuditReviewsReq) GetReviewIds() (v []int64) {
+	return p.ReviewIds
+}
+
+func (p *BatchAuditReviewsReq) GetAction() (v string) {
+	return p.Action
+}
+
+var fieldIDToName_BatchAuditReviewsReq = map[int16]string{
+	1: "review_ids",
+	2: "action",
+}
+
+func (p *BatchAuditReviewsReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetReviewIds bool = false
+	var issetAction bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetReviewIds = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetAction = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetReviewIds {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetAction {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BatchAuditReviewsReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_BatchAuditReviewsReq[fieldId]))
+}
+
+func (p *BatchAuditReviewsReq) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]int64, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem int64
+		if v, err := iprot.ReadI64(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.ReviewIds = _field
+	return nil
+}
+func (p *BatchAuditReviewsReq) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Action = _field
+	return nil
+}
+
+func (p *BatchAuditReviewsReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("BatchAuditReviewsReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *BatchAuditReviewsReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("review_ids", thrift.LIST, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.I64, len(p.ReviewIds)); err != nil {
+		return err
+	}
+	for _, v := range p.ReviewIds {
+		if err := oprot.WriteI64(v); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *BatchAuditReviewsReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("action", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Action); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *BatchAuditReviewsReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("BatchAuditReviewsReq(%+v)", *p)
+
+}
+
+type BatchAuditReviewsResp struct {
+	BaseResp     *module.BaseResp          `thrift:"base_resp,1,required" form:"base_resp,required" json:"base_resp,required" query:"base_resp,required"`
+	ResultList   []*module.BatchItemResult `thrift:"result_list,2,required,list<module.BatchItemResult>" form:"result_list,required" json:"result_list,required" query:"result_list,required"`
+	SuccessCount int64                     `thrift:"success_count,3,required" form:"success_count,required" json:"success_count,required" query:"success_count,required"`
+}
+
+func NewBatchAuditReviewsResp() *BatchAuditReviewsResp {
+	return &BatchAuditReviewsResp{}
+}
+
+func (p *BatchAuditReviewsResp) InitDefault() {
+}
+
+var BatchAuditReviewsResp_BaseResp_DEFAULT *module.BaseResp
+
+func (p *BatchAuditReviewsResp) GetBaseResp() (v *module.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return BatchAuditReviewsResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *BatchAuditReviewsResp) GetResultList() (v []*module.BatchItemResult) {
+	return p.ResultList
+}
+
+func (p *BatchAuditReviewsResp) GetSuccessCount() (v int64) {
+	return p.SuccessCount
+}
+
+var fieldIDToName_BatchAuditReviewsResp = map[int16]string{
+	1: "base_resp",
+	2: "result_list",
+	3: "success_count",
+}
+
+func (p *BatchAuditReviewsResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *BatchAuditReviewsResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetBaseResp bool = false
+	var issetResultList bool = false
+	var issetSuccessCount bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetBaseResp = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetResultList = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetSuccessCount = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetBaseResp {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetResultList {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetSuccessCount {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BatchAuditReviewsResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_BatchAuditReviewsResp[fieldId]))
+}
+
+func (p *BatchAuditReviewsResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := module.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+func (p *BatchAuditReviewsResp) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*module.BatchItemResult, 0, size)
+	values := make([]module.BatchItemResult, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.ResultList = _field
+	return nil
+}
+func (p *BatchAuditReviewsResp) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.SuccessCount = _field
+	return nil
+}
+
+func (p *BatchAuditReviewsResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("BatchAuditReviewsResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *BatchAuditReviewsResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *BatchAuditReviewsResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("result_list", thrift.LIST, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.ResultList)); err != nil {
+		return err
+	}
+	for _, v := range p.ResultList {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *BatchAuditReviewsResp) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("success_count", thrift.I64, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.SuccessCount); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *BatchAuditReviewsResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("BatchAuditReviewsResp(%+v)", *p)
+
+}
+
+// 隐藏用户全部可见的资源评论与课程评论
+type HideUserCommentsReq struct {
+	UserID int64 `thrift:"user_id,1,required" json:"user_id,required" path:"user_id,required"`
+}
+
+func NewHideUserCommentsReq() *HideUserCommentsReq {
+	return &HideUserCommentsReq{}
+}
+
+func (p *HideUserCommentsReq) InitDefault() {
+}
+
+func (p *HideUserCommentsReq) GetUserID() (v int64) {
+	return p.UserID
+}
+
+var fieldIDToName_HideUserCommentsReq = map[int16]string{
+	1: "user_id",
+}
+
+func (p *HideUserCommentsReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetUserID bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetUserID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetUserID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_HideUserCommentsReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_HideUserCommentsReq[fieldId]))
+}
+
+func (p *HideUserCommentsReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.UserID = _field
+	return nil
+}
+
+func (p *HideUserCommentsReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("HideUserCommentsReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *HideUserCommentsReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.UserID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *HideUserCommentsReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("HideUserCommentsReq(%+v)", *p)
+
+}
+
+type HideUserCommentsResp struct {
+	BaseResp     *module.BaseResp          `thrift:"base_resp,1,required" form:"base_resp,required" json:"base_resp,required" query:"base_resp,required"`
+	ResultList   []*module.BatchItemResult `thrift:"result_list,2,required,list<module.BatchItemResult>" form:"result_list,required" json:"result_list,required" query:"result_list,required"`
+	SuccessCount int64                     `thrift:"success_count,3,required" form:"success_count,required" json:"success_count,required" query:"success_count,required"`
+}
+
+func NewHideUserCommentsResp() *HideUserCommentsResp {
+	return &HideUserCommentsResp{}
+}
+
+func (p *HideUserCommentsResp) InitDefault() {
+}
+
+var HideUserCommentsResp_BaseResp_DEFAULT *module.BaseResp
+
+func (p *HideUserCommentsResp) GetBaseResp() (v *module.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return HideUserCommentsResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *HideUserCommentsResp) GetResultList() (v []*module.BatchItemResult) {
+	return p.ResultList
+}
+
+func (p *HideUserCommentsResp) GetSuccessCount() (v int64) {
+	return p.SuccessCount
+}
+
+var fieldIDToName_HideUserCommentsResp = map[int16]string{
+	1: "base_resp",
+	2: "result_list",
+	3: "success_count",
+}
+
+func (p *HideUserCommentsResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *HideUserCommentsResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetBaseResp bool = false
+	var issetResultList bool = false
+	var issetSuccessCount bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetBaseResp = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetResultList = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetSuccessCount = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetBaseResp {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetResultList {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetSuccessCount {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_HideUserCommentsResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_HideUserCommentsResp[fieldId]))
+}
+
+func (p *HideUserCommentsResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := module.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+func (p *HideUserCommentsResp) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*module.BatchItemResult, 0, size)
+	values := make([]module.BatchItemResult, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.ResultList = _field
+	return nil
+}
+func (p *HideUserCommentsResp) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.SuccessCount = _field
+	return nil
+}
+
+func (p *HideUserCommentsResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("HideUserCommentsResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *HideUserCommentsResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *HideUserCommentsResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("result_list", thrift.LIST, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.ResultList)); err != nil {
+		return err
+	}
+	for _, v := range p.ResultList {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *HideUserCommentsResp) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("success_count", thrift.I64, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.SuccessCount); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *HideUserCommentsResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("HideUserCommentsResp(%+v)", *p)
+
+}
+
+// 批量恢复被管理员隐藏的内容
+type RestoreContentReq struct {
+	Items []*module.ContentRef `thrift:"items,1,required,list<module.ContentRef>" form:"items,required" json:"items,required" query:"items,required"`
+}
+
+func NewRestoreContentReq() *RestoreContentReq {
+	return &RestoreContentReq{}
+}
+
+func (p *RestoreContentReq) InitDefault() {
+}
+
+func (p *RestoreContentReq) GetItems() (v []*module.ContentRef) {
+	return p.Items
+}
+
+var fieldIDToName_RestoreContentReq = map[int16]string{
+	1: "items",
+}
+
+func (p *RestoreContentReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetItems bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetItems = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetItems {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RestoreContentReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_RestoreContentReq[fieldId]))
+}
+
+func (p *RestoreContentReq) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*module.ContentRef, 0, size)
+	values := make([]module.ContentRef, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Items = _field
+	return nil
+}
+
+func (p *RestoreContentReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RestoreContentReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RestoreContentReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("items", thrift.LIST, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Items)); err != nil {
+		return err
+	}
+	for _, v := range p.Items {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *RestoreContentReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RestoreContentReq(%+v)", *p)
+
+}
+
+type RestoreContentResp struct {
+	BaseResp     *module.BaseResp          `thrift:"base_resp,1,required" form:"base_resp,required" json:"base_resp,required" query:"base_resp,required"`
+	ResultList   []*module.BatchItemResult `thrift:"result_list,2,required,list<module.BatchItemResult>" form:"result_list,required" json:"result_list,required" query:"result_list,required"`
+	SuccessCount int64                     `thrift:"success_count,3,required" form:"success_count,required" json:"success_count,required" query:"success_count,required"`
+}
+
+func NewRestoreContentResp() *RestoreContentResp {
+	return &RestoreContentResp{}
+}
+
+func (p *RestoreContentResp) InitDefault() {
+}
+
+var RestoreContentResp_BaseResp_DEFAULT *module.BaseResp
+
+func (p *RestoreContentResp) GetBaseResp() (v *module.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return RestoreContentResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *RestoreContentResp) GetResultList() (v []*module.BatchItemResult) {
+	return p.ResultList
+}
+
+func (p *RestoreContentResp) GetSuccessCount() (v int64) {
+	return p.SuccessCount
+}
+
+var fieldIDToName_RestoreContentResp = map[int16]string{
+	1: "base_resp",
+	2: "result_list",
+	3: "success_count",
+}
+
+func (p *RestoreContentResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *RestoreContentResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetBaseResp bool = false
+	var issetResultList bool = false
+	var issetSuccessCount bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetBaseResp = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetResultList = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetSuccessCount = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetBaseResp {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetResultList {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetSuccessCount {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RestoreContentResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_RestoreContentResp[fieldId]))
+}
+
+func (p *RestoreContentResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := module.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+func (p *RestoreContentResp) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*module.BatchItemResult, 0, size)
+	values := make([]module.BatchItemResult, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.ResultList = _field
+	return nil
+}
+func (p *RestoreContentResp) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.SuccessCount = _field
+	return nil
+}
+
+func (p *RestoreContentResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RestoreContentResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RestoreContentResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *RestoreContentResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("result_list", thrift.LIST, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.ResultList)); err != nil {
+		return err
+	}
+	for _, v := range p.ResultList {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *RestoreContentResp) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("success_count", thrift.I64, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.SuccessCount); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *RestoreContentResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RestoreContentResp(%+v)", *p)
+
+}
+
+// 审核统计：时间为秒级时间戳，默认最近 30 天；interval 为 day 或 week，决定积压曲线的采样间隔
+type GetModerationStatsReq struct {
+	StartTime *int64  `thrift:"start_time,1,optional" json:"start_time,omitempty" query:"start_time"`
+	EndTime   *int64  `thrift:"end_time,2,optional" json:"end_time,omitempty" query:"end_time"`
+	Interval  *string `thrift:"interval,3,optional" json:"interval,omitempty" query:"interval"`
+	TopN      *int32  `thrift:"top_n,4,optional" json:"top_n,omitempty" query:"top_n"`
+}
+
+func NewGetModerationStatsReq() *GetModerationStatsReq {
+	return &GetModerationStatsReq{}
+}
+
+func (p *GetModerationStatsReq) InitDefault() {
+}
+
+var GetModerationStatsReq_StartTime_DEFAULT int64
+
+func (p *GetModerationStatsReq) GetStartTime() (v int64) {
+	if !p.IsSetStartTime() {
+		return GetModerationStatsReq_StartTime_DEFAULT
+	}
+	return *p.StartTime
+}
+
+var GetModerationStatsReq_EndTime_DEFAULT int64
+
+func (p *GetModerationStatsReq) GetEndTime() (v int64) {
+	if !p.IsSetEndTime() {
+		return GetModerationStatsReq_EndTime_DEFAULT
+	}
+	return *p.EndTime
+}
+
+var GetModerationStatsReq_Interval_DEFAULT string
+
+func (p *GetModerationStatsReq) GetInterval() (v string) {
+	if !p.IsSetInterval() {
+		return GetModerationStatsReq_Interval_DEFAULT
+	}
+	return *p.Interval
+}
+
+var GetModerationStatsReq_TopN_DEFAULT int32
+
+func (p *GetModerationStatsReq) GetTopN() (v int32) {
+	if !p.IsSetTopN() {
+		return GetModerationStatsReq_TopN_DEFAULT
+	}
+	return *p.TopN
+}
+
+var fieldIDToName_GetModerationStatsReq = map[int16]string{
+	1: "start_time",
+	2: "end_time",
+	3: "interval",
+	4: "top_n",
+}
+
+func (p *GetModerationStatsReq) IsSetStartTime() bool {
+	return p.StartTime != nil
+}
+
+func (p *GetModerationStatsReq) IsSetEndTime() bool {
+	return p.EndTime != nil
+}
+
+func (p *GetModerationStatsReq) IsSetInterval() bool {
+	return p.Interval != nil
+}
+
+func (p *GetModerationStatsReq) IsSetTopN() bool {
+	return p.TopN != nil
+}
+
+func (p *GetModerationStatsReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetModerationStatsReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *GetModerationStatsReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.StartTime = _field
+	return nil
+}
+func (p *GetModerationStatsReq) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.EndTime = _field
+	return nil
+}
+func (p *GetModerationStatsReq) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Interval = _field
+	return nil
+}
+func (p *GetModerationStatsReq) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.TopN = _field
+	return nil
+}
+
+func (p *GetModerationStatsReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetModerationStatsReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *GetModerationStatsReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetStartTime() {
+		if err = oprot.WriteFieldBegin("start_time", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.StartTime); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *GetModerationStatsReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEndTime() {
+		if err = oprot.WriteFieldBegin("end_time", thrift.I64, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.EndTime); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *GetModerationStatsReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetInterval() {
+		if err = oprot.WriteFieldBegin("interval", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Interval); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *GetModerationStatsReq) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTopN() {
+		if err = oprot.WriteFieldBegin("top_n", thrift.I32, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.TopN); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *GetModerationStatsReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetModerationStatsReq(%+v)", *p)
+
+}
+
+type GetModerationStatsResp struct {
+	BaseResp *module.BaseResp        `thrift:"base_resp,1,required" form:"base_resp,required" json:"base_resp,required" query:"base_resp,required"`
+	Stats    *module.ModerationStats `thrift:"stats,2,required" form:"stats,required" json:"stats,required" query:"stats,required"`
+}
+
+func NewGetModerationStatsResp() *GetModerationStatsResp {
+	return &GetModerationStatsResp{}
+}
+
+func (p *GetModerationStatsResp) InitDefault() {
+}
+
+var GetModerationStatsResp_BaseResp_DEFAULT *module.BaseResp
+
+func (p *GetModerationStatsResp) GetBaseResp() (v *module.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return GetModerationStatsResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+var GetModerationStatsResp_Stats_DEFAULT *module.ModerationStats
+
+func (p *GetModerationStatsResp) GetStats() (v *module.ModerationStats) {
+	if !p.IsSetStats() {
+		return GetModerationStatsResp_Stats_DEFAULT
+	}
+	return p.Stats
+}
+
+var fieldIDToName_GetModerationStatsResp = map[int16]string{
+	1: "base_resp",
+	2: "stats",
+}
+
+func (p *GetModerationStatsResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *GetModerationStatsResp) IsSetStats() bool {
+	return p.Stats != nil
+}
+
+func (p *GetModerationStatsResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetBaseResp bool = false
+	var issetStats bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetBaseResp = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetStats = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetBaseResp {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetStats {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetModerationStatsResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_GetModerationStatsResp[fieldId]))
+}
+
+func (p *GetModerationStatsResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := module.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+func (p *GetModerationStatsResp) ReadField2(iprot thrift.TProtocol) error {
+	_field := module.NewModerationStats()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Stats = _field
+	return nil
+}
+
+func (p *GetModerationStatsResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetModerationStatsResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *GetModerationStatsResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *GetModerationStatsResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("stats", thrift.STRUCT, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Stats.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *GetModerationStatsResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetModerationStatsResp(%+v)", *p)
+
+}
+
+type AdminAuditService interface {
+	GetResourceAuditList(ctx context.Context, req *GetResourceAuditListReq) (r *GetResourceAuditListResp, err error)
+
+	AuditResource(ctx context.Context, req *AuditResourceReq) (r *AuditResourceResp, err error)
+
+	GetCourseAuditList(ctx context.Context, req *GetCourseAuditListReq) (r *GetCourseAuditListResp, err error)
+
+	AuditCourse(ctx context.Context, req *AuditCourseReq) (r *AuditCourseResp, err error)
+
+	GetCommentAuditList(ctx context.Context, req *GetCommentAuditListReq) (r *GetCommentAuditListResp, err error)
+
+	GetCourseCommentAuditList(ctx context.Context, req *GetCourseCommentAuditListReq) (r *GetCourseCommentAuditListResp, err error)
+
+	GetResourceCommentAuditList(ctx context.Context, req *GetResourceCommentAuditListReq) (r *GetResourceCommentAuditListResp, err error)
+
+	AuditCourseComment(ctx context.Context, req *AuditCourseCommentReq) (r *AuditCourseCommentResp, err error)
+
+	AuditResourceComment(ctx context.Context, req *AuditResourceCommentReq) (r *AuditResourceCommentResp, err error)
+
+	GetCourseRatingAuditList(ctx context.Context, req *GetCourseRatingAuditListReq) (r *GetCourseRatingAuditListResp, err error)
+
+	AuditCourseRating(ctx context.Context, req *AuditCourseRatingReq) (r *AuditCourseRatingResp, err error)
+
+	GetResourceRatingAuditList(ctx context.Context, req *GetResourceRatingAuditListReq) (r *GetResourceRatingAuditListResp, err error)
+
+	AuditResourceRating(ctx context.Context, req *AuditResourceRatingReq) (r *AuditResourceRatingResp, err error)
+}
+
+type AdminAuditServiceClient struct {
+	c thrift.TClient
+}
+
+func NewAdminAuditServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *AdminAuditServiceClient {
+	return &AdminAuditServiceClient{
+		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+	}
+}
+
+func NewAdminAuditServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *AdminAuditServiceClient {
+	return &AdminAuditServiceClient{
+		c: thrift.NewTStandardClient(iprot, oprot),
+	}
+}
+
+func NewAdminAuditServiceClient(c thrift.TClient) *AdminAuditServiceClient {
+	return &AdminAuditServiceClient{
+		c: c,
+	}
+}
+
+func (p *AdminAuditServiceClient) Client_() thrift.TClient {
+	return p.c
+}
+
+func (p *AdminAuditServiceClient) GetResourceAuditList(ctx context.Context, req *GetResourceAuditListReq) (r *GetResourceAuditListResp, err error) {
+	var _args AdminAuditServiceGetResourceAuditListArgs
+	_args.Req = req
+	var _result AdminAuditServiceGetResourceAuditListResult
+	if err = p.Client_().Call(ctx, "GetResourceAuditList", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *AdminAuditServiceClient) AuditResource(ctx context.Context, req *AuditResourceReq) (r *AuditResourceResp, err error) {
+	var _args AdminAuditServiceAuditResourceArgs
+	_args.Req = req
+	var _result AdminAuditServiceAuditResourceResult
+	if err = p.Client_().Call(ctx, "AuditResource", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *AdminAuditServiceClient) GetCourseAuditList(ctx context.Context, req *GetCourseAuditListReq) (r *GetCourseAuditListResp, err error) {
+	var _args AdminAuditServiceGetCourseAuditListArgs
+	_args.Req = req
+	var _result AdminAuditServiceGetCourseAuditListResult
+	if err = p.Client_().Call(ctx, "GetCourseAuditList", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *AdminAuditServiceClient) AuditCourse(ctx context.Context, req *AuditCourseReq) (r *AuditCourseResp, err error) {
+	var _args AdminAuditServiceAuditCourseArgs
+	_args.Req = req
+	var _result AdminAuditServiceAuditCourseResult
+	if err = p.Client_().Call(ctx, "AuditCourse", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *AdminAuditServiceClient) GetCommentAuditList(ctx context.Context, req *GetCommentAuditListReq) (r *GetCommentAuditListResp, err error) {
+	var _args AdminAuditServiceGetCommentAuditListArgs
+	_args.Req = req
+	var _result AdminAuditServiceGetCommentAuditListResult
+	if err = p.Client_().Call(ctx, "GetCommentAuditList", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *AdminAuditServiceClient) GetCourseCommentAuditList(ctx context.Context, req *GetCourseCommentAuditListReq) (r *GetCourseCommentAuditListResp, err error) {
+	var _args AdminAuditServiceGetCourseCommentAuditListArgs
+	_args.Req = req
+	var _result AdminAuditServiceGetCourseCommentAuditListResult
+	if err = p.Client_().Call(ctx, "GetCourseCommentAuditList", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *AdminAuditServiceClient) GetResourceCommentAuditList(ctx context.Context, req *GetResourceCommentAuditListReq) (r *GetResourceCommentAuditListResp, err error) {
+	var _args AdminAuditServiceGetResourceCommentAuditListArgs
+	_args.Req = req
+	var _result AdminAuditServiceGetResourceCommentAuditListResult
+	if err = p.Client_().Call(ctx, "GetResourceCommentAuditList", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *AdminAuditServiceClient) AuditCourseComment(ctx context.Context, req *AuditCourseCommentReq) (r *AuditCourseCommentResp, err error) {
+	var _args AdminAuditServiceAuditCourseCommentArgs
+	_args.Req = req
+	var _result AdminAuditServiceAuditCourseCommentResult
+	if err = p.Client_().Call(ctx, "AuditCourseComment", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *AdminAuditServiceClient) AuditResourceComment(ctx context.Context, req *AuditResourceCommentReq) (r *AuditResourceCommentResp, err error) {
+	var _args AdminAuditServiceAuditResourceCommentArgs
+	_args.Req = req
+	var _result AdminAuditServiceAuditResourceCommentResult
+	if err = p.Client_().Call(ctx, "AuditResourceComment", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *AdminAuditServiceClient) GetCourseRatingAuditList(ctx context.Context, req *GetCourseRatingAuditListReq) (r *GetCourseRatingAuditListResp, err error) {
+	var _args AdminAuditServiceGetCourseRatingAuditListArgs
+	_args.Req = req
+	var _result AdminAuditServiceGetCourseRatingAuditListResult
+	if err = p.Client_().Call(ctx, "GetCourseRatingAuditList", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *AdminAuditServiceClient) AuditCourseRating(ctx context.Context, req *AuditCourseRatingReq) (r *AuditCourseRatingResp, err error) {
+	var _args AdminAuditServiceAuditCourseRatingArgs
+	_args.Req = req
+	var _result AdminAuditServiceAuditCourseRatingResult
+	if err = p.Client_().Call(ctx, "AuditCourseRating", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *AdminAuditServiceClient) GetResourceRatingAuditList(ctx context.Context, req *GetResourceRatingAuditListReq) (r *GetResourceRatingAuditListResp, err error) {
+	var _args AdminAuditServiceGetResourceRatingAuditListArgs
+	_args.Req = req
+	var _result AdminAuditServiceGetResourceRatingAuditListResult
+	if err = p.Client_().Call(ctx, "GetResourceRatingAuditList", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *AdminAuditServiceClient) AuditResourceRating(ctx context.Context, req *AuditResourceRatingReq) (r *AuditResourceRatingResp, err error) {
+	var _args AdminAuditServiceAuditResourceRatingArgs
+	_args.Req = req
+	var _result AdminAuditServiceAuditResourceRatingResult
+	if err = p.Client_().Call(ctx, "AuditResourceRating", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+type AdminActionService interface {
+	ListAdminActions(ctx context.Context, req *ListAdminActionsReq) (r *ListAdminActionsResp, err error)
+
+	ExportAdminActions(ctx context.Context, req *ExportAdminActionsReq) (r *ExportAdminActionsResp, err error)
+}
+
+type AdminActionServiceClient struct {
+	c thrift.TClient
+}
+
+func NewAdminActionServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *AdminActionServiceClient {
+	return &AdminActionServiceClient{
+		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+	}
+}
+
+func NewAdminActionServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *AdminActionServiceClient {
+	return &AdminActionServiceClient{
+		c: thrift.NewTStandardClient(iprot, oprot),
+	}
+}
+
+func NewAdminActionServiceClient(c thrift.TClient) *AdminActionServiceClient {
+	return &AdminActionServiceClient{
+		c: c,
+	}
+}
+
+func (p *AdminActionServiceClient) Client_() thrift.TClient {
+	return p.c
+}
+
+func (p *AdminActionServiceClient) ListAdminActions(ctx context.Context, req *ListAdminActionsReq) (r *ListAdminActionsResp, err error) {
+	var _args AdminActionServiceListAdminActionsArgs
+	_args.Req = req
+	var _result AdminActionServiceListAdminActionsResult
+	if err = p.Client_().Call(ctx, "ListAdminActions", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *AdminActionServiceClient) ExportAdminActions(ctx context.Context, req *ExportAdminActionsReq) (r *ExportAdminActionsResp, err error) {
+	var _args AdminActionServiceExportAdminActionsArgs
+	_args.Req = req
+	var _result AdminActionServiceExportAdminActionsResult
+	if err = p.Client_().Call(ctx, "ExportAdminActions", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+type ReviewQueueService interface {
+	ClaimNextReviews(ctx context.Context, req *ClaimNextReviewsReq) (r *ClaimNextReviewsResp, err error)
+
+	GetReviewQueueStats(ctx context.Context, req *GetReviewQueueStatsReq) (r *GetReviewQueueStatsResp, err error)
+
+	GetReviewInbox(ctx context.Context, req *GetReviewInboxReq) (r *GetReviewInboxResp, err error)
+
+	ClaimReview(ctx context.Context, req *ClaimReviewReq) (r *ClaimReviewResp, err error)
+
+	ReleaseReview(ctx context.Context, req *ReleaseReviewReq) (r *ReleaseReviewResp, err error)
+
+	GetReviewReporters(ctx context.Context, req *GetReviewReportersReq) (r *GetReviewReportersResp, err error)
+}
+
+type ReviewQueueServiceClient struct {
+	c thrift.TClient
+}
+
+func NewReviewQueueServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *ReviewQueueServiceClient {
+	return &ReviewQueueServiceClient{
+		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+	}
+}
+
+func NewReviewQueueServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *ReviewQueueServiceClient {
+	return &ReviewQueueServiceClient{
+		c: thrift.NewTStandardClient(iprot, oprot),
+	}
+}
+
+func NewReviewQueueServiceClient(c thrift.TClient) *ReviewQueueServiceClient {
+	return &ReviewQueueServiceClient{
+		c: c,
+	}
+}
+
+func (p *ReviewQueueServiceClient) Client_() thrift.TClient {
+	return p.c
+}
+
+func (p *ReviewQueueServiceClient) ClaimNextReviews(ctx context.Context, req *ClaimNextReviewsReq) (r *ClaimNextReviewsResp, err error) {
+	var _args ReviewQueueServiceClaimNextReviewsArgs
+	_args.Req = req
+	var _result ReviewQueueServiceClaimNextReviewsResult
+	if err = p.Client_().Call(ctx, "ClaimNextReviews", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ReviewQueueServiceClient) GetReviewQueueStats(ctx context.Context, req *GetReviewQueueStatsReq) (r *GetReviewQueueStatsResp, err error) {
+	var _args ReviewQueueServiceGetReviewQueueStatsArgs
+	_args.Req = req
+	var _result ReviewQueueServiceGetReviewQueueStatsResult
+	if err = p.Client_().Call(ctx, "GetReviewQueueStats", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ReviewQueueServiceClient) GetReviewInbox(ctx context.Context, req *GetReviewInboxReq) (r *GetReviewInboxResp, err error) {
+	var _args ReviewQueueServiceGetReviewInboxArgs
+	_args.Req = req
+	var _result ReviewQueueServiceGetReviewInboxResult
+	if err = p.Client_().Call(ctx, "GetReviewInbox", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ReviewQueueServiceClient) ClaimReview(ctx context.Context, req *ClaimReviewReq) (r *ClaimReviewResp, err error) {
+	var _args ReviewQueueServiceClaimReviewArgs
+	_args.Req = req
+	var _result ReviewQueueServiceClaimReviewResult
+	if err = p.Client_().Call(ctx, "ClaimReview", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ReviewQueueServiceClient) ReleaseReview(ctx context.Context, req *ReleaseReviewReq) (r *ReleaseReviewResp, err error) {
+	var _args ReviewQueueServiceReleaseReviewArgs
+	_args.Req = req
+	var _result ReviewQueueServiceReleaseReviewResult
+	if err = p.Client_().Call(ctx, "ReleaseReview", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ReviewQueueServiceClient) GetReviewReporters(ctx context.Context, req *GetReviewReportersReq) (r *GetReviewReportersResp, err error) {
+	var _args ReviewQueueServiceGetReviewReportersArgs
+	_args.Req = req
+	var _result ReviewQueueServiceGetReviewReportersResult
+	if err = p.Client_().Call(ctx, "GetReviewReporters", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+type ModerationAdminService interface {
+	ApplyModerationAction(ctx context.Context, req *ApplyModerationActionReq) (r *ApplyModerationActionResp, err error)
+
+	GetAppealList(ctx context.Context, req *GetAppealListReq) (r *GetAppealListResp, err error)
+
+	ResolveAppeal(ctx context.Context, req *ResolveAppealReq) (r *ResolveAppealResp, err error)
+}
+
+type ModerationAdminServiceClient struct {
+	c thrift.TClient
+}
+
+func NewModerationAdminServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *ModerationAdminServiceClient {
+	return &ModerationAdminServiceClient{
+		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+	}
+}
+
+func NewModerationAdminServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *ModerationAdminServiceClient {
+	return &ModerationAdminServiceClient{
+		c: thrift.NewTStandardClient(iprot, oprot),
+	}
+}
+
+func NewModerationAdminServiceClient(c thrift.TClient) *ModerationAdminServiceClient {
+	return &ModerationAdminServiceClient{
+		c: c,
+	}
+}
+
+func (p *ModerationAdminServiceClient) Client_() thrift.TClient {
+	return p.c
+}
+
+func (p *ModerationAdminServiceClient) ApplyModerationAction(ctx context.Context, req *ApplyModerationActionReq) (r *ApplyModerationActionResp, err error) {
+	var _args ModerationAdminServiceApplyModerationActionArgs
+	_args.Req = req
+	var _result ModerationAdminServiceApplyModerationActionResult
+	if err = p.Client_().Call(ctx, "ApplyModerationAction", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ModerationAdminServiceClient) GetAppealList(ctx context.Context, req *GetAppealListReq) (r *GetAppealListResp, err error) {
+	var _args ModerationAdminServiceGetAppealListArgs
+	_args.Req = req
+	var _result ModerationAdminServiceGetAppealListResult
+	if err = p.Client_().Call(ctx, "GetAppealList", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ModerationAdminServiceClient) ResolveAppeal(ctx context.Context, req *ResolveAppealReq) (r *ResolveAppealResp, err error) {
+	var _args ModerationAdminServiceResolveAppealArgs
+	_args.Req = req
+	var _result ModerationAdminServiceResolveAppealResult
+	if err = p.Client_().Call(ctx, "ResolveAppeal", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+type ContentFilterAdminService interface {
+	ListContentFilterRules(ctx context.Context, req *ListContentFilterRulesReq) (r *ListContentFilterRulesResp, err error)
+
+	CreateContentFilterRule(ctx context.Context, req *CreateContentFilterRuleReq) (r *CreateContentFilterRuleResp, err error)
+
+	UpdateContentFilterRule(ctx context.Context, req *UpdateContentFilterRuleReq) (r *UpdateContentFilterRuleResp, err error)
+
+	DeleteContentFilterRule(ctx context.Context, req *DeleteContentFilterRuleReq) (r *DeleteContentFilterRuleResp, err error)
+
+	TestContentFilter(ctx context.Context, req *TestContentFilterReq) (r *TestContentFilterResp, err error)
+}
+
+type ContentFilterAdminServiceClient struct {
+	c thrift.TClient
+}
+
+func NewContentFilterAdminServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *ContentFilterAdminServiceClient {
+	return &ContentFilterAdminServiceClient{
+		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+	}
+}
+
+func NewContentFilterAdminServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *ContentFilterAdminServiceClient {
+	return &ContentFilterAdminServiceClient{
+		c: thrift.NewTStandardClient(iprot, oprot),
+	}
+}
+
+func NewContentFilterAdminServiceClient(c thrift.TClient) *ContentFilterAdminServiceClient {
+	return &ContentFilterAdminServiceClient{
+		c: c,
+	}
+}
+
+func (p *ContentFilterAdminServiceClient) Client_() thrift.TClient {
+	return p.c
+}
+
+func (p *ContentFilterAdminServiceClient) ListContentFilterRules(ctx context.Context, req *ListContentFilterRulesReq) (r *ListContentFilterRulesResp, err error) {
+	var _args ContentFilterAdminServiceListContentFilterRulesArgs
+	_args.Req = req
+	var _result ContentFilterAdminServiceListContentFilterRulesResult
+	if err = p.Client_().Call(ctx, "ListContentFilterRules", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ContentFilterAdminServiceClient) CreateContentFilterRule(ctx context.Context, req *CreateContentFilterRuleReq) (r *CreateContentFilterRuleResp, err error) {
+	var _args ContentFilterAdminServiceCreateContentFilterRuleArgs
+	_args.Req = req
+	var _result ContentFilterAdminServiceCreateContentFilterRuleResult
+	if err = p.Client_().Call(ctx, "CreateContentFilterRule", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ContentFilterAdminServiceClient) UpdateContentFilterRule(ctx context.Context, req *UpdateContentFilterRuleReq) (r *UpdateContentFilterRuleResp, err error) {
+	var _args ContentFilterAdminServiceUpdateContentFilterRuleArgs
+	_args.Req = req
+	var _result ContentFilterAdminServiceUpdateContentFilterRuleResult
+	if err = p.Client_().Call(ctx, "UpdateContentFilterRule", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ContentFilterAdminServiceClient) DeleteContentFilterRule(ctx context.Context, req *DeleteContentFilterRuleReq) (r *DeleteContentFilterRuleResp, err error) {
+	var _args ContentFilterAdminServiceDeleteContentFilterRuleArgs
+	_args.Req = req
+	var _result ContentFilterAdminServiceDeleteContentFilterRuleResult
+	if err = p.Client_().Call(ctx, "DeleteContentFilterRule", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ContentFilterAdminServiceClient) TestContentFilter(ctx context.Context, req *TestContentFilterReq) (r *TestContentFilterResp, err error) {
+	var _args ContentFilterAdminServiceTestContentFilterArgs
+	_args.Req = req
+	var _result ContentFilterAdminServiceTestContentFilterResult
+	if err = p.Client_().Call(ctx, "TestContentFilter", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+type BulkModerationService interface {
+	BatchAuditReviews(ctx context.Context, req *BatchAuditReviewsReq) (r *BatchAuditReviewsResp, err error)
+
+	HideUserComments(ctx context.Context, req *HideUserCommentsReq) (r *HideUserCommentsResp, err error)
+
+	RestoreContent(ctx context.Context, req *RestoreContentReq) (r *RestoreContentResp, err error)
+}
+
+type BulkModerationServiceClient struct {
+	c thrift.TClient
+}
+
+func NewBulkModerationServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *BulkModerationServiceClient {
+	return &BulkModerationServiceClient{
+		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+	}
+}
+
+func NewBulkModerationServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *BulkModerationServiceClient {
+	return &BulkModerationServiceClient{
+		c: thrift.NewTStandardClient(iprot, oprot),
+	}
+}
+
+func NewBulkModerationServiceClient(c thrift.TClient) *BulkModerationServiceClient {
+	return &BulkModerationServiceClient{
+		c: c,
+	}
+}
+
+func (p *BulkModerationServiceClient) Client_() thrift.TClient {
+	return p.c
+}
+
+func (p *BulkModerationServiceClient) BatchAuditReviews(ctx context.Context, req *BatchAuditReviewsReq) (r *BatchAuditReviewsResp, err error) {
+	var _args BulkModerationServiceBatchAuditReviewsArgs
+	_args.Req = req
+	var _result BulkModerationServiceBatchAuditReviewsResult
+	if err = p.Client_().Call(ctx, "BatchAuditReviews", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *BulkModerationServiceClient) HideUserComments(ctx context.Context, req *HideUserCommentsReq) (r *HideUserCommentsResp, err error) {
+	var _args BulkModerationServiceHideUserCommentsArgs
+	_args.Req = req
+	var _result BulkModerationServiceHideUserCommentsResult
+	if err = p.Client_().Call(ctx, "HideUserComments", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *BulkModerationServiceClient) RestoreContent(ctx context.Context, req *RestoreContentReq) (r *RestoreContentResp, err error) {
+	var _args BulkModerationServiceRestoreContentArgs
+	_args.Req = req
+	var _result BulkModerationServiceRestoreContentResult
+	if err = p.Client_().Call(ctx, "RestoreContent", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+type ModerationStatsService interface {
+	GetModerationStats(ctx context.Context, req *GetModerationStatsReq) (r *GetModerationStatsResp, err error)
+}
+
+type ModerationStatsServiceClient struct {
+	c thrift.TClient
+}
+
+func NewModerationStatsServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *ModerationStatsServiceClient {
+	return &ModerationStatsServiceClient{
+		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+	}
+}
+
+func NewModerationStatsServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *ModerationStatsServiceClient {
+	return &ModerationStatsServiceClient{
+		c: thrift.NewTStandardClient(iprot, oprot),
+	}
+}
+
+func NewModerationStatsServiceClient(c thrift.TClient) *ModerationStatsServiceClient {
+	return &ModerationStatsServiceClient{
+		c: c,
+	}
+}
+
+func (p *ModerationStatsServiceClient) Client_() thrift.TClient {
+	return p.c
+}
+
+func (p *ModerationStatsServiceClient) GetModerationStats(ctx context.Context, req *GetModerationStatsReq) (r *GetModerationStatsResp, err error) {
+	var _args ModerationStatsServiceGetModerationStatsArgs
+	_args.Req = req
+	var _result ModerationStatsServiceGetModerationStatsResult
+	if err = p.Client_().Call(ctx, "GetModerationStats", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+type AdminAuditServiceProcessor struct {
+	processorMap map[string]thrift.TProcessorFunction
+	handler      AdminAuditService
+}
+
+func (p *AdminAuditServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
+	p.processorMap[key] = processor
+}
+
+func (p *AdminAuditServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
+	processor, ok = p.processorMap[key]
+	return processor, ok
+}
+
+func (p *AdminAuditServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
+	return p.processorMap
+}
+
+func NewAdminAuditServiceProcessor(handler AdminAuditService) *AdminAuditServiceProcessor {
+	self := &AdminAuditServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
+	self.AddToProcessorMap("GetResourceAuditList", &adminAuditServiceProcessorGetResourceAuditList{handler: handler})
+	self.AddToProcessorMap("AuditResource", &adminAuditServiceProcessorAuditResource{handler: handler})
+	self.AddToProcessorMap("GetCourseAuditList", &adminAuditServiceProcessorGetCourseAuditList{handler: handler})
+	self.AddToProcessorMap("AuditCourse", &adminAuditServiceProcessorAuditCourse{handler: handler})
+	self.AddToProcessorMap("GetCommentAuditList", &adminAuditServiceProcessorGetCommentAuditList{handler: handler})
+	self.AddToProcessorMap("GetCourseCommentAuditList", &adminAuditServiceProcessorGetCourseCommentAuditList{handler: handler})
+	self.AddToProcessorMap("GetResourceCommentAuditList", &adminAuditServiceProcessorGetResourceCommentAuditList{handler: handler})
+	self.AddToProcessorMap("AuditCourseComment", &adminAuditServiceProcessorAuditCourseComment{handler: handler})
+	self.AddToProcessorMap("AuditResourceComment", &adminAuditServiceProcessorAuditResourceComment{handler: handler})
+	self.AddToProcessorMap("GetCourseRatingAuditList", &adminAuditServiceProcessorGetCourseRatingAuditList{handler: handler})
+	self.AddToProcessorMap("AuditCourseRating", &adminAuditServiceProcessorAuditCourseRating{handler: handler})
+	self.AddToProcessorMap("GetResourceRatingAuditList", &adminAuditServiceProcessorGetResourceRatingAuditList{handler: handler})
+	self.AddToProcessorMap("AuditResourceRating", &adminAuditServiceProcessorAuditResourceRating{handler: handler})
+	return self
+}
+func (p *AdminAuditServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	name, _, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return false, err
+	}
+	if processor, ok := p.GetProcessorFunction(name); ok {
+		return processor.Process(ctx, seqId, iprot, oprot)
+	}
+	iprot.Skip(thrift.STRUCT)
+	iprot.ReadMessageEnd()
+	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
+	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
+	x.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Flush(ctx)
+	return false, x
+}
+
+type adminAuditServiceProcessorGetResourceAuditList struct {
+	handler AdminAuditService
+}
+
+func (p *adminAuditServiceProcessorGetResourceAuditList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := AdminAuditServiceGetResourceAuditListArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetResourceAuditList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := AdminAuditServiceGetResourceAuditListResult{}
+	var retval *GetResourceAuditListResp
+	if retval, err2 = p.handler.GetResourceAuditList(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetResourceAuditList: "+err2.Error())
+		oprot.WriteMessageBegin("GetResourceAuditList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetResourceAuditList", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type adminAuditServiceProcessorAuditResource struct {
+	handler AdminAuditService
+}
+
+func (p *adminAuditServiceProcessorAuditResource) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := AdminAuditServiceAuditResourceArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("AuditResource", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := AdminAuditServiceAuditResourceResult{}
+	var retval *AuditResourceResp
+	if retval, err2 = p.handler.AuditResource(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing AuditResource: "+err2.Error())
+		oprot.WriteMessageBegin("AuditResource", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("AuditResource", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type adminAuditServiceProcessorGetCourseAuditList struct {
+	handler AdminAuditService
+}
+
+func (p *adminAuditServiceProcessorGetCourseAuditList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := AdminAuditServiceGetCourseAuditListArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetCourseAuditList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := AdminAuditServiceGetCourseAuditListResult{}
+	var retval *GetCourseAuditListResp
+	if retval, err2 = p.handler.GetCourseAuditList(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetCourseAuditList: "+err2.Error())
+		oprot.WriteMessageBegin("GetCourseAuditList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetCourseAuditList", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type adminAuditServiceProcessorAuditCourse struct {
+	handler AdminAuditService
+}
+
+func (p *adminAuditServiceProcessorAuditCourse) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := AdminAuditServiceAuditCourseArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("AuditCourse", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := AdminAuditServiceAuditCourseResult{}
+	var retval *AuditCourseResp
+	if retval, err2 = p.handler.AuditCourse(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing AuditCourse: "+err2.Error())
+		oprot.WriteMessageBegin("AuditCourse", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("AuditCourse", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type adminAuditServiceProcessorGetCommentAuditList struct {
+	handler AdminAuditService
+}
+
+func (p *adminAuditServiceProcessorGetCommentAuditList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := AdminAuditServiceGetCommentAuditListArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetCommentAuditList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := AdminAuditServiceGetCommentAuditListResult{}
+	var retval *GetCommentAuditListResp
+	if retval, err2 = p.handler.GetCommentAuditList(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetCommentAuditList: "+err2.Error())
+		oprot.WriteMessageBegin("GetCommentAuditList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetCommentAuditList", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type adminAuditServiceProcessorGetCourseCommentAuditList struct {
+	handler AdminAuditService
+}
+
+func (p *adminAuditServiceProcessorGetCourseCommentAuditList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := AdminAuditServiceGetCourseCommentAuditListArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetCourseCommentAuditList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := AdminAuditServiceGetCourseCommentAuditListResult{}
+	var retval *GetCourseCommentAuditListResp
+	if retval, err2 = p.handler.GetCourseCommentAuditList(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetCourseCommentAuditList: "+err2.Error())
+		oprot.WriteMessageBegin("GetCourseCommentAuditList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetCourseCommentAuditList", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type adminAuditServiceProcessorGetResourceCommentAuditList struct {
+	handler AdminAuditService
+}
+
+func (p *adminAuditServiceProcessorGetResourceCommentAuditList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := AdminAuditServiceGetResourceCommentAuditListArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetResourceCommentAuditList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := AdminAuditServiceGetResourceCommentAuditListResult{}
+	var retval *GetResourceCommentAuditListResp
+	if retval, err2 = p.handler.GetResourceCommentAuditList(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetResourceCommentAuditList: "+err2.Error())
+		oprot.WriteMessageBegin("GetResourceCommentAuditList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetResourceCommentAuditList", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type adminAuditServiceProcessorAuditCourseComment struct {
+	handler AdminAuditService
+}
+
+func (p *adminAuditServiceProcessorAuditCourseComment) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := AdminAuditServiceAuditCourseCommentArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("AuditCourseComment", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := AdminAuditServiceAuditCourseCommentResult{}
+	var retval *AuditCourseCommentResp
+	if retval, err2 = p.handler.AuditCourseComment(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing AuditCourseComment: "+err2.Error())
+		oprot.WriteMessageBegin("AuditCourseComment", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("AuditCourseComment", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type adminAuditServiceProcessorAuditResourceComment struct {
+	handler AdminAuditService
+}
+
+func (p *adminAuditServiceProcessorAuditResourceComment) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := AdminAuditServiceAuditResourceCommentArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("AuditResourceComment", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := AdminAuditServiceAuditResourceCommentResult{}
+	var retval *AuditResourceCommentResp
+	if retval, err2 = p.handler.AuditResourceComment(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing AuditResourceComment: "+err2.Error())
+		oprot.WriteMessageBegin("AuditResourceComment", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("AuditResourceComment", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type adminAuditServiceProcessorGetCourseRatingAuditList struct {
+	handler AdminAuditService
+}
+
+func (p *adminAuditServiceProcessorGetCourseRatingAuditList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := AdminAuditServiceGetCourseRatingAuditListArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetCourseRatingAuditList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := AdminAuditServiceGetCourseRatingAuditListResult{}
+	var retval *GetCourseRatingAuditListResp
+	if retval, err2 = p.handler.GetCourseRatingAuditList(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetCourseRatingAuditList: "+err2.Error())
+		oprot.WriteMessageBegin("GetCourseRatingAuditList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetCourseRatingAuditList", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type adminAuditServiceProcessorAuditCourseRating struct {
+	handler AdminAuditService
+}
+
+func (p *adminAuditServiceProcessorAuditCourseRating) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := AdminAuditServiceAuditCourseRatingArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("AuditCourseRating", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := AdminAuditServiceAuditCourseRatingResult{}
+	var retval *AuditCourseRatingResp
+	if retval, err2 = p.handler.AuditCourseRating(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing AuditCourseRating: "+err2.Error())
+		oprot.WriteMessageBegin("AuditCourseRating", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("AuditCourseRating", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type adminAuditServiceProcessorGetResourceRatingAuditList struct {
+	handler AdminAuditService
+}
+
+func (p *adminAuditServiceProcessorGetResourceRatingAuditList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := AdminAuditServiceGetResourceRatingAuditListArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetResourceRatingAuditList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := AdminAuditServiceGetResourceRatingAuditListResult{}
+	var retval *GetResourceRatingAuditListResp
+	if retval, err2 = p.handler.GetResourceRatingAuditList(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetResourceRatingAuditList: "+err2.Error())
+		oprot.WriteMessageBegin("GetResourceRatingAuditList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetResourceRatingAuditList", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type adminAuditServiceProcessorAuditResourceRating struct {
+	handler AdminAuditService
+}
+
+func (p *adminAuditServiceProcessorAuditResourceRating) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := AdminAuditServiceAuditResourceRatingArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("AuditResourceRating", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := AdminAuditServiceAuditResourceRatingResult{}
+	var retval *AuditResourceRatingResp
+	if retval, err2 = p.handler.AuditResourceRating(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing AuditResourceRating: "+err2.Error())
+		oprot.WriteMessageBegin("AuditResourceRating", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("AuditResourceRating", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type AdminAuditServiceGetResourceAuditListArgs struct {
+	Req *GetResourceAuditListReq `thrift:"req,1"`
+}
+
+func NewAdminAuditServiceGetResourceAuditListArgs() *AdminAuditServiceGetResourceAuditListArgs {
+	return &AdminAuditServiceGetResourceAuditListArgs{}
+}
+
+func (p *AdminAuditServiceGetResourceAuditListArgs) InitDefault() {
+}
+
+var AdminAuditServiceGetResourceAuditListArgs_Req_DEFAULT *GetResourceAuditListReq
+
+func (p *AdminAuditServiceGetResourceAuditListArgs) GetReq() (v *GetResourceAuditListReq) {
+	if !p.IsSetReq() {
+		return AdminAuditServiceGetResourceAuditListArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_AdminAuditServiceGetResourceAuditListArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *AdminAuditServiceGetResourceAuditListArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *AdminAuditServiceGetResourceAuditListArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminAuditServiceGetResourceAuditListArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *AdminAuditServiceGetResourceAuditListArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetResourceAuditListReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *AdminAuditServiceGetResourceAuditListArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetResourceAuditList_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AdminAuditServiceGetResourceAuditListArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *AdminAuditServiceGetResourceAuditListArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AdminAuditServiceGetResourceAuditListArgs(%+v)", *p)
+
+}
+
+type AdminAuditServiceGetResourceAuditListResult struct {
+	Success *GetResourceAuditListResp `thrift:"success,0,optional"`
+}
+
+func NewAdminAuditServiceGetResourceAuditListResult() *AdminAuditServiceGetResourceAuditListResult {
+	return &AdminAuditServiceGetResourceAuditListResult{}
+}
+
+func (p *AdminAuditServiceGetResourceAuditListResult) InitDefault() {
+}
+
+var AdminAuditServiceGetResourceAuditListResult_Success_DEFAULT *GetResourceAuditListResp
+
+func (p *AdminAuditServiceGetResourceAuditListResult) GetSuccess() (v *GetResourceAuditListResp) {
+	if !p.IsSetSuccess() {
+		return AdminAuditServiceGetResourceAuditListResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_AdminAuditServiceGetResourceAuditListResult = map[int16]string{
+	0: "success",
+}
+
+func (p *AdminAuditServiceGetResourceAuditListResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *AdminAuditServiceGetResourceAuditListResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminAuditServiceGetResourceAuditListResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *AdminAuditServiceGetResourceAuditListResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetResourceAuditListResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *AdminAuditServiceGetResourceAuditListResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetResourceAuditList_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AdminAuditServiceGetResourceAuditListResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *AdminAuditServiceGetResourceAuditListResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AdminAuditServiceGetResourceAuditListResult(%+v)", *p)
+
+}
+
+type AdminAuditServiceAuditResourceArgs struct {
+	Req *AuditResourceReq `thrift:"req,1"`
+}
+
+func NewAdminAuditServiceAuditResourceArgs() *AdminAuditServiceAuditResourceArgs {
+	return &AdminAuditServiceAuditResourceArgs{}
+}
+
+func (p *AdminAuditServiceAuditResourceArgs) InitDefault() {
+}
+
+var AdminAuditServiceAuditResourceArgs_Req_DEFAULT *AuditResourceReq
+
+func (p *AdminAuditServiceAuditResourceArgs) GetReq() (v *AuditResourceReq) {
+	if !p.IsSetReq() {
+		return AdminAuditServiceAuditResourceArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_AdminAuditServiceAuditResourceArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *AdminAuditServiceAuditResourceArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *AdminAuditServiceAuditResourceArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminAuditServiceAuditResourceArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *AdminAuditServiceAuditResourceArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewAuditResourceReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *AdminAuditServiceAuditResourceArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AuditResource_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AdminAuditServiceAuditResourceArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *AdminAuditServiceAuditResourceArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AdminAuditServiceAuditResourceArgs(%+v)", *p)
+
+}
+
+type AdminAuditServiceAuditResourceResult struct {
+	Success *AuditResourceResp `thrift:"success,0,optional"`
+}
+
+func NewAdminAuditServiceAuditResourceResult() *AdminAuditServiceAuditResourceResult {
+	return &AdminAuditServiceAuditResourceResult{}
+}
+
+func (p *AdminAuditServiceAuditResourceResult) InitDefault() {
+}
+
+var AdminAuditServiceAuditResourceResult_Success_DEFAULT *AuditResourceResp
+
+func (p *AdminAuditServiceAuditResourceResult) GetSuccess() (v *AuditResourceResp) {
+	if !p.IsSetSuccess() {
+		return AdminAuditServiceAuditResourceResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_AdminAuditServiceAuditResourceResult = map[int16]string{
+	0: "success",
+}
+
+func (p *AdminAuditServiceAuditResourceResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *AdminAuditServiceAuditResourceResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminAuditServiceAuditResourceResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *AdminAuditServiceAuditResourceResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewAuditResourceResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *AdminAuditServiceAuditResourceResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AuditResource_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AdminAuditServiceAuditResourceResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *AdminAuditServiceAuditResourceResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AdminAuditServiceAuditResourceResult(%+v)", *p)
+
+}
+
+type AdminAuditServiceGetCourseAuditListArgs struct {
+	Req *GetCourseAuditListReq `thrift:"req,1"`
+}
+
+func NewAdminAuditServiceGetCourseAuditListArgs() *AdminAuditServiceGetCourseAuditListArgs {
+	return &AdminAuditServiceGetCourseAuditListArgs{}
+}
+
+func (p *AdminAuditServiceGetCourseAuditListArgs) InitDefault() {
+}
+
+var AdminAuditServiceGetCourseAuditListArgs_Req_DEFAULT *GetCourseAuditListReq
+
+func (p *AdminAuditServiceGetCourseAuditListArgs) GetReq() (v *GetCourseAuditListReq) {
+	if !p.IsSetReq() {
+		return AdminAuditServiceGetCourseAuditListArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_AdminAuditServiceGetCourseAuditListArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *AdminAuditServiceGetCourseAuditListArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *AdminAuditServiceGetCourseAuditListArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminAuditServiceGetCourseAuditListArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *AdminAuditServiceGetCourseAuditListArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetCourseAuditListReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *AdminAuditServiceGetCourseAuditListArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetCourseAuditList_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AdminAuditServiceGetCourseAuditListArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *AdminAuditServiceGetCourseAuditListArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AdminAuditServiceGetCourseAuditListArgs(%+v)", *p)
+
+}
+
+type AdminAuditServiceGetCourseAuditListResult struct {
+	Success *GetCourseAuditListResp `thrift:"success,0,optional"`
+}
+
+func NewAdminAuditServiceGetCourseAuditListResult() *AdminAuditServiceGetCourseAuditListResult {
+	return &AdminAuditServiceGetCourseAuditListResult{}
+}
+
+func (p *AdminAuditServiceGetCourseAuditListResult) InitDefault() {
+}
+
+var AdminAuditServiceGetCourseAuditListResult_Success_DEFAULT *GetCourseAuditListResp
+
+func (p *AdminAuditServiceGetCourseAuditListResult) GetSuccess() (v *GetCourseAuditListResp) {
+	if !p.IsSetSuccess() {
+		return AdminAuditServiceGetCourseAuditListResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_AdminAuditServiceGetCourseAuditListResult = map[int16]string{
+	0: "success",
+}
+
+func (p *AdminAuditServiceGetCourseAuditListResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *AdminAuditServiceGetCourseAuditListResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminAuditServiceGetCourseAuditListResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *AdminAuditServiceGetCourseAuditListResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetCourseAuditListResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *AdminAuditServiceGetCourseAuditListResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetCourseAuditList_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AdminAuditServiceGetCourseAuditListResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *AdminAuditServiceGetCourseAuditListResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AdminAuditServiceGetCourseAuditListResult(%+v)", *p)
+
+}
+
+type AdminAuditServiceAuditCourseArgs struct {
+	Req *AuditCourseReq `thrift:"req,1"`
+}
+
+func NewAdminAuditServiceAuditCourseArgs() *AdminAuditServiceAuditCourseArgs {
+	return &AdminAuditServiceAuditCourseArgs{}
+}
+
+func (p *AdminAuditServiceAuditCourseArgs) InitDefault() {
+}
+
+var AdminAuditServiceAuditCourseArgs_Req_DEFAULT *AuditCourseReq
+
+func (p *AdminAuditServiceAuditCourseArgs) GetReq() (v *AuditCourseReq) {
+	if !p.IsSetReq() {
+		return AdminAuditServiceAuditCourseArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_AdminAuditServiceAuditCourseArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *AdminAuditServiceAuditCourseArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *AdminAuditServiceAuditCourseArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminAuditServiceAuditCourseArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *AdminAuditServiceAuditCourseArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewAuditCourseReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *AdminAuditServiceAuditCourseArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AuditCourse_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AdminAuditServiceAuditCourseArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *AdminAuditServiceAuditCourseArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AdminAuditServiceAuditCourseArgs(%+v)", *p)
+
+}
+
+type AdminAuditServiceAuditCourseResult struct {
+	Success *AuditCourseResp `thrift:"success,0,optional"`
+}
+
+func NewAdminAuditServiceAuditCourseResult() *AdminAuditServiceAuditCourseResult {
+	return &AdminAuditServiceAuditCourseResult{}
+}
+
+func (p *AdminAuditServiceAuditCourseResult) InitDefault() {
+}
+
+var AdminAuditServiceAuditCourseResult_Success_DEFAULT *AuditCourseResp
+
+func (p *AdminAuditServiceAuditCourseResult) GetSuccess() (v *AuditCourseResp) {
+	if !p.IsSetSuccess() {
+		return AdminAuditServiceAuditCourseResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_AdminAuditServiceAuditCourseResult = map[int16]string{
+	0: "success",
+}
+
+func (p *AdminAuditServiceAuditCourseResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *AdminAuditServiceAuditCourseResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminAuditServiceAuditCourseResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *AdminAuditServiceAuditCourseResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewAuditCourseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *AdminAuditServiceAuditCourseResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AuditCourse_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AdminAuditServiceAuditCourseResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *AdminAuditServiceAuditCourseResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AdminAuditServiceAuditCourseResult(%+v)", *p)
+
+}
+
+type AdminAuditServiceGetCommentAuditListArgs struct {
+	Req *GetCommentAuditListReq `thrift:"req,1"`
+}
+
+func NewAdminAuditServiceGetCommentAuditListArgs() *AdminAuditServiceGetCommentAuditListArgs {
+	return &AdminAuditServiceGetCommentAuditListArgs{}
+}
+
+func (p *AdminAuditServiceGetCommentAuditListArgs) InitDefault() {
+}
+
+var AdminAuditServiceGetCommentAuditListArgs_Req_DEFAULT *GetCommentAuditListReq
+
+func (p *AdminAuditServiceGetCommentAuditListArgs) GetReq() (v *GetCommentAuditListReq) {
+	if !p.IsSetReq() {
+		return AdminAuditServiceGetCommentAuditListArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_AdminAuditServiceGetCommentAuditListArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *AdminAuditServiceGetCommentAuditListArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *AdminAuditServiceGetCommentAuditListArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminAuditServiceGetCommentAuditListArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *AdminAuditServiceGetCommentAuditListArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetCommentAuditListReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *AdminAuditServiceGetCommentAuditListArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetCommentAuditList_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AdminAuditServiceGetCommentAuditListArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *AdminAuditServiceGetCommentAuditListArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AdminAuditServiceGetCommentAuditListArgs(%+v)", *p)
+
+}
+
+type AdminAuditServiceGetCommentAuditListResult struct {
+	Success *GetCommentAuditListResp `thrift:"success,0,optional"`
+}
+
+func NewAdminAuditServiceGetCommentAuditListResult() *AdminAuditServiceGetCommentAuditListResult {
+	return &AdminAuditServiceGetCommentAuditListResult{}
+}
+
+func (p *AdminAuditServiceGetCommentAuditListResult) InitDefault() {
+}
+
+var AdminAuditServiceGetCommentAuditListResult_Success_DEFAULT *GetCommentAuditListResp
+
+func (p *AdminAuditServiceGetCommentAuditListResult) GetSuccess() (v *GetCommentAuditListResp) {
+	if !p.IsSetSuccess() {
+		return AdminAuditServiceGetCommentAuditListResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_AdminAuditServiceGetCommentAuditListResult = map[int16]string{
+	0: "success",
+}
+
+func (p *AdminAuditServiceGetCommentAuditListResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *AdminAuditServiceGetCommentAuditListResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminAuditServiceGetCommentAuditListResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *AdminAuditServiceGetCommentAuditListResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetCommentAuditListResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *AdminAuditServiceGetCommentAuditListResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetCommentAuditList_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AdminAuditServiceGetCommentAuditListResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *AdminAuditServiceGetCommentAuditListResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AdminAuditServiceGetCommentAuditListResult(%+v)", *p)
+
+}
+
+type AdminAuditServiceGetCourseCommentAuditListArgs struct {
+	Req *GetCourseCommentAuditListReq `thrift:"req,1"`
+}
+
+func NewAdminAuditServiceGetCourseCommentAuditListArgs() *AdminAuditServiceGetCourseCommentAuditListArgs {
+	return &AdminAuditServiceGetCourseCommentAuditListArgs{}
+}
+
+func (p *AdminAuditServiceGetCourseCommentAuditListArgs) InitDefault() {
+}
+
+var AdminAuditServiceGetCourseCommentAuditListArgs_Req_DEFAULT *GetCourseCommentAuditListReq
+
+func (p *AdminAuditServiceGetCourseCommentAuditListArgs) GetReq() (v *GetCourseCommentAuditListReq) {
+	if !p.IsSetReq() {
+		return AdminAuditServiceGetCourseCommentAuditListArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_AdminAuditServiceGetCourseCommentAuditListArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *AdminAuditServiceGetCourseCommentAuditListArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *AdminAuditServiceGetCourseCommentAuditListArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminAuditServiceGetCourseCommentAuditListArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *AdminAuditServiceGetCourseCommentAuditListArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetCourseCommentAuditListReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *AdminAuditServiceGetCourseCommentAuditListArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetCourseCommentAuditList_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AdminAuditServiceGetCourseCommentAuditListArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *AdminAuditServiceGetCourseCommentAuditListArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AdminAuditServiceGetCourseCommentAuditListArgs(%+v)", *p)
+
+}
+
+type AdminAuditServiceGetCourseCommentAuditListResult struct {
+	Success *GetCourseCommentAuditListResp `thrift:"success,0,optional"`
+}
+
+func NewAdminAuditServiceGetCourseCommentAuditListResult() *AdminAuditServiceGetCourseCommentAuditListResult {
+	return &AdminAuditServiceGetCourseCommentAuditListResult{}
+}
+
+func (p *AdminAuditServiceGetCourseCommentAuditListResult) InitDefault() {
+}
+
+var AdminAuditServiceGetCourseCommentAuditListResult_Success_DEFAULT *GetCourseCommentAuditListResp
+
+func (p *AdminAuditServiceGetCourseCommentAuditListResult) GetSuccess() (v *GetCourseCommentAuditListResp) {
+	if !p.IsSetSuccess() {
+		return AdminAuditServiceGetCourseCommentAuditListResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_AdminAuditServiceGetCourseCommentAuditListResult = map[int16]string{
+	0: "success",
+}
+
+func (p *AdminAuditServiceGetCourseCommentAuditListResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *AdminAuditServiceGetCourseCommentAuditListResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminAuditServiceGetCourseCommentAuditListResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *AdminAuditServiceGetCourseCommentAuditListResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetCourseCommentAuditListResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *AdminAuditServiceGetCourseCommentAuditListResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetCourseCommentAuditList_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AdminAuditServiceGetCourseCommentAuditListResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *AdminAuditServiceGetCourseCommentAuditListResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AdminAuditServiceGetCourseCommentAuditListResult(%+v)", *p)
+
+}
+
+type AdminAuditServiceGetResourceCommentAuditListArgs struct {
+	Req *GetResourceCommentAuditListReq `thrift:"req,1"`
+}
+
+func NewAdminAuditServiceGetResourceCommentAuditListArgs() *AdminAuditServiceGetResourceCommentAuditListArgs {
+	return &AdminAuditServiceGetResourceCommentAuditListArgs{}
+}
+
+func (p *AdminAuditServiceGetResourceCommentAuditListArgs) InitDefault() {
+}
+
+var AdminAuditServiceGetResourceCommentAuditListArgs_Req_DEFAULT *GetResourceCommentAuditListReq
+
+func (p *AdminAuditServiceGetResourceCommentAuditListArgs) GetReq() (v *GetResourceCommentAuditListReq) {
+	if !p.IsSetReq() {
+		return AdminAuditServiceGetResourceCommentAuditListArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_AdminAuditServiceGetResourceCommentAuditListArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *AdminAuditServiceGetResourceCommentAuditListArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *AdminAuditServiceGetResourceCommentAuditListArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminAuditServiceGetResourceCommentAuditListArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *AdminAuditServiceGetResourceCommentAuditListArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetResourceCommentAuditListReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *AdminAuditServiceGetResourceCommentAuditListArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetResourceCommentAuditList_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AdminAuditServiceGetResourceCommentAuditListArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *AdminAuditServiceGetResourceCommentAuditListArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AdminAuditServiceGetResourceCommentAuditListArgs(%+v)", *p)
+
+}
+
+type AdminAuditServiceGetResourceCommentAuditListResult struct {
+	Success *GetResourceCommentAuditListResp `thrift:"success,0,optional"`
+}
+
+func NewAdminAuditServiceGetResourceCommentAuditListResult() *AdminAuditServiceGetResourceCommentAuditListResult {
+	return &AdminAuditServiceGetResourceCommentAuditListResult{}
+}
+
+func (p *AdminAuditServiceGetResourceCommentAuditListResult) InitDefault() {
+}
+
+var AdminAuditServiceGetResourceCommentAuditListResult_Success_DEFAULT *GetResourceCommentAuditListResp
+
+func (p *AdminAuditServiceGetResourceCommentAuditListResult) GetSuccess() (v *GetResourceCommentAuditListResp) {
+	if !p.IsSetSuccess() {
+		return AdminAuditServiceGetResourceCommentAuditListResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_AdminAuditServiceGetResourceCommentAuditListResult = map[int16]string{
+	0: "success",
+}
+
+func (p *AdminAuditServiceGetResourceCommentAuditListResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *AdminAuditServiceGetResourceCommentAuditListResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminAuditServiceGetResourceCommentAuditListResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *AdminAuditServiceGetResourceCommentAuditListResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetResourceCommentAuditListResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *AdminAuditServiceGetResourceCommentAuditListResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetResourceCommentAuditList_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AdminAuditServiceGetResourceCommentAuditListResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *AdminAuditServiceGetResourceCommentAuditListResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AdminAuditServiceGetResourceCommentAuditListResult(%+v)", *p)
+
+}
+
+type AdminAuditServiceAuditCourseCommentArgs struct {
+	Req *AuditCourseCommentReq `thrift:"req,1"`
+}
+
+func NewAdminAuditServiceAuditCourseCommentArgs() *AdminAuditServiceAuditCourseCommentArgs {
+	return &AdminAuditServiceAuditCourseCommentArgs{}
+}
+
+func (p *AdminAuditServiceAuditCourseCommentArgs) InitDefault() {
+}
+
+var AdminAuditServiceAuditCourseCommentArgs_Req_DEFAULT *AuditCourseCommentReq
+
+func (p *AdminAuditServiceAuditCourseCommentArgs) GetReq() (v *AuditCourseCommentReq) {
+	if !p.IsSetReq() {
+		return AdminAuditServiceAuditCourseCommentArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_AdminAuditServiceAuditCourseCommentArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *AdminAuditServiceAuditCourseCommentArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *AdminAuditServiceAuditCourseCommentArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminAuditServiceAuditCourseCommentArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *AdminAuditServiceAuditCourseCommentArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewAuditCourseCommentReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *AdminAuditServiceAuditCourseCommentArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AuditCourseComment_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AdminAuditServiceAuditCourseCommentArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *AdminAuditServiceAuditCourseCommentArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AdminAuditServiceAuditCourseCommentArgs(%+v)", *p)
+
+}
+
+type AdminAuditServiceAuditCourseCommentResult struct {
+	Success *AuditCourseCommentResp `thrift:"success,0,optional"`
+}
+
+func NewAdminAuditServiceAuditCourseCommentResult() *AdminAuditServiceAuditCourseCommentResult {
+	return &AdminAuditServiceAuditCourseCommentResult{}
+}
+
+func (p *AdminAuditServiceAuditCourseCommentResult) InitDefault() {
+}
+
+var AdminAuditServiceAuditCourseCommentResult_Success_DEFAULT *AuditCourseCommentResp
+
+func (p *AdminAuditServiceAuditCourseCommentResult) GetSuccess() (v *AuditCourseCommentResp) {
+	if !p.IsSetSuccess() {
+		return AdminAuditServiceAuditCourseCommentResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_AdminAuditServiceAuditCourseCommentResult = map[int16]string{
+	0: "success",
+}
+
+func (p *AdminAuditServiceAuditCourseCommentResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *AdminAuditServiceAuditCourseCommentResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminAuditServiceAuditCourseCommentResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *AdminAuditServiceAuditCourseCommentResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewAuditCourseCommentResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *AdminAuditServiceAuditCourseCommentResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AuditCourseComment_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AdminAuditServiceAuditCourseCommentResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *AdminAuditServiceAuditCourseCommentResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AdminAuditServiceAuditCourseCommentResult(%+v)", *p)
+
+}
+
+type AdminAuditServiceAuditResourceCommentArgs struct {
+	Req *AuditResourceCommentReq `thrift:"req,1"`
+}
+
+func NewAdminAuditServiceAuditResourceCommentArgs() *AdminAuditServiceAuditResourceCommentArgs {
+	return &AdminAuditServiceAuditResourceCommentArgs{}
+}
+
+func (p *AdminAuditServiceAuditResourceCommentArgs) InitDefault() {
+}
+
+var AdminAuditServiceAuditResourceCommentArgs_Req_DEFAULT *AuditResourceCommentReq
+
+func (p *AdminAuditServiceAuditResourceCommentArgs) GetReq() (v *AuditResourceCommentReq) {
+	if !p.IsSetReq() {
+		return AdminAuditServiceAuditResourceCommentArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_AdminAuditServiceAuditResourceCommentArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *AdminAuditServiceAuditResourceCommentArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *AdminAuditServiceAuditResourceCommentArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminAuditServiceAuditResourceCommentArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *AdminAuditServiceAuditResourceCommentArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewAuditResourceCommentReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *AdminAuditServiceAuditResourceCommentArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AuditResourceComment_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AdminAuditServiceAuditResourceCommentArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *AdminAuditServiceAuditResourceCommentArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AdminAuditServiceAuditResourceCommentArgs(%+v)", *p)
+
+}
+
+type AdminAuditServiceAuditResourceCommentResult struct {
+	Success *AuditResourceCommentResp `thrift:"success,0,optional"`
+}
+
+func NewAdminAuditServiceAuditResourceCommentResult() *AdminAuditServiceAuditResourceCommentResult {
+	return &AdminAuditServiceAuditResourceCommentResult{}
+}
+
+func (p *AdminAuditServiceAuditResourceCommentResult) InitDefault() {
+}
+
+var AdminAuditServiceAuditResourceCommentResult_Success_DEFAULT *AuditResourceCommentResp
+
+func (p *AdminAuditServiceAuditResourceCommentResult) GetSuccess() (v *AuditResourceCommentResp) {
+	if !p.IsSetSuccess() {
+		return AdminAuditServiceAuditResourceCommentResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_AdminAuditServiceAuditResourceCommentResult = map[int16]string{
+	0: "success",
+}
+
+func (p *AdminAuditServiceAuditResourceCommentResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *AdminAuditServiceAuditResourceCommentResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminAuditServiceAuditResourceCommentResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *AdminAuditServiceAuditResourceCommentResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewAuditResourceCommentResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *AdminAuditServiceAuditResourceCommentResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AuditResourceComment_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AdminAuditServiceAuditResourceCommentResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *AdminAuditServiceAuditResourceCommentResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AdminAuditServiceAuditResourceCommentResult(%+v)", *p)
+
+}
+
+type AdminAuditServiceGetCourseRatingAuditListArgs struct {
+	Req *GetCourseRatingAuditListReq `thrift:"req,1"`
+}
+
+func NewAdminAuditServiceGetCourseRatingAuditListArgs() *AdminAuditServiceGetCourseRatingAuditListArgs {
+	return &AdminAuditServiceGetCourseRatingAuditListArgs{}
+}
+
+func (p *AdminAuditServiceGetCourseRatingAuditListArgs) InitDefault() {
+}
+
+var AdminAuditServiceGetCourseRatingAuditListArgs_Req_DEFAULT *GetCourseRatingAuditListReq
+
+func (p *AdminAuditServiceGetCourseRatingAuditListArgs) GetReq() (v *GetCourseRatingAuditListReq) {
+	if !p.IsSetReq() {
+		return AdminAuditServiceGetCourseRatingAuditListArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_AdminAuditServiceGetCourseRatingAuditListArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *AdminAuditServiceGetCourseRatingAuditListArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *AdminAuditServiceGetCourseRatingAuditListArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminAuditServiceGetCourseRatingAuditListArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *AdminAuditServiceGetCourseRatingAuditListArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetCourseRatingAuditListReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *AdminAuditServiceGetCourseRatingAuditListArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetCourseRatingAuditList_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AdminAuditServiceGetCourseRatingAuditListArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *AdminAuditServiceGetCourseRatingAuditListArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AdminAuditServiceGetCourseRatingAuditListArgs(%+v)", *p)
+
+}
+
+type AdminAuditServiceGetCourseRatingAuditListResult struct {
+	Success *GetCourseRatingAuditListResp `thrift:"success,0,optional"`
+}
+
+func NewAdminAuditServiceGetCourseRatingAuditListResult() *AdminAuditServiceGetCourseRatingAuditListResult {
+	return &AdminAuditServiceGetCourseRatingAuditListResult{}
+}
+
+func (p *AdminAuditServiceGetCourseRatingAuditListResult) InitDefault() {
+}
+
+var AdminAuditServiceGetCourseRatingAuditListResult_Success_DEFAULT *GetCourseRatingAuditListResp
+
+func (p *AdminAuditServiceGetCourseRatingAuditListResult) GetSuccess() (v *GetCourseRatingAuditListResp) {
+	if !p.IsSetSuccess() {
+		return AdminAuditServiceGetCourseRatingAuditListResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_AdminAuditServiceGetCourseRatingAuditListResult = map[int16]string{
+	0: "success",
+}
+
+func (p *AdminAuditServiceGetCourseRatingAuditListResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *AdminAuditServiceGetCourseRatingAuditListResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminAuditServiceGetCourseRatingAuditListResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *AdminAuditServiceGetCourseRatingAuditListResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetCourseRatingAuditListResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *AdminAuditServiceGetCourseRatingAuditListResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetCourseRatingAuditList_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AdminAuditServiceGetCourseRatingAuditListResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *AdminAuditServiceGetCourseRatingAuditListResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AdminAuditServiceGetCourseRatingAuditListResult(%+v)", *p)
+
+}
+
+type AdminAuditServiceAuditCourseRatingArgs struct {
+	Req *AuditCourseRatingReq `thrift:"req,1"`
+}
+
+func NewAdminAuditServiceAuditCourseRatingArgs() *AdminAuditServiceAuditCourseRatingArgs {
+	return &AdminAuditServiceAuditCourseRatingArgs{}
+}
+
+func (p *AdminAuditServiceAuditCourseRatingArgs) InitDefault() {
+}
+
+var AdminAuditServiceAuditCourseRatingArgs_Req_DEFAULT *AuditCourseRatingReq
+
+func (p *AdminAuditServiceAuditCourseRatingArgs) GetReq() (v *AuditCourseRatingReq) {
+	if !p.IsSetReq() {
+		return AdminAuditServiceAuditCourseRatingArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_AdminAuditServiceAuditCourseRatingArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *AdminAuditServiceAuditCourseRatingArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *AdminAuditServiceAuditCourseRatingArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminAuditServiceAuditCourseRatingArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *AdminAuditServiceAuditCourseRatingArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewAuditCourseRatingReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *AdminAuditServiceAuditCourseRatingArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AuditCourseRating_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AdminAuditServiceAuditCourseRatingArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *AdminAuditServiceAuditCourseRatingArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AdminAuditServiceAuditCourseRatingArgs(%+v)", *p)
+
+}
+
+type AdminAuditServiceAuditCourseRatingResult struct {
+	Success *AuditCourseRatingResp `thrift:"success,0,optional"`
+}
+
+func NewAdminAuditServiceAuditCourseRatingResult() *AdminAuditServiceAuditCourseRatingResult {
+	return &AdminAuditServiceAuditCourseRatingResult{}
+}
+
+func (p *AdminAuditServiceAuditCourseRatingResult) InitDefault() {
+}
+
+var AdminAuditServiceAuditCourseRatingResult_Success_DEFAULT *AuditCourseRatingResp
+
+func (p *AdminAuditServiceAuditCourseRatingResult) GetSuccess() (v *AuditCourseRatingResp) {
+	if !p.IsSetSuccess() {
+		return AdminAuditServiceAuditCourseRatingResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_AdminAuditServiceAuditCourseRatingResult = map[int16]string{
+	0: "success",
+}
+
+func (p *AdminAuditServiceAuditCourseRatingResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *AdminAuditServiceAuditCourseRatingResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminAuditServiceAuditCourseRatingResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *AdminAuditServiceAuditCourseRatingResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewAuditCourseRatingResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *AdminAuditServiceAuditCourseRatingResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AuditCourseRating_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AdminAuditServiceAuditCourseRatingResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *AdminAuditServiceAuditCourseRatingResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AdminAuditServiceAuditCourseRatingResult(%+v)", *p)
+
+}
+
+type AdminAuditServiceGetResourceRatingAuditListArgs struct {
+	Req *GetResourceRatingAuditListReq `thrift:"req,1"`
+}
+
+func NewAdminAuditServiceGetResourceRatingAuditListArgs() *AdminAuditServiceGetResourceRatingAuditListArgs {
+	return &AdminAuditServiceGetResourceRatingAuditListArgs{}
+}
+
+func (p *AdminAuditServiceGetResourceRatingAuditListArgs) InitDefault() {
+}
+
+var AdminAuditServiceGetResourceRatingAuditListArgs_Req_DEFAULT *GetResourceRatingAuditListReq
+
+func (p *AdminAuditServiceGetResourceRatingAuditListArgs) GetReq() (v *GetResourceRatingAuditListReq) {
+	if !p.IsSetReq() {
+		return AdminAuditServiceGetResourceRatingAuditListArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_AdminAuditServiceGetResourceRatingAuditListArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *AdminAuditServiceGetResourceRatingAuditListArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *AdminAuditServiceGetResourceRatingAuditListArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminAuditServiceGetResourceRatingAuditListArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *AdminAuditServiceGetResourceRatingAuditListArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetResourceRatingAuditListReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *AdminAuditServiceGetResourceRatingAuditListArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetResourceRatingAuditList_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AdminAuditServiceGetResourceRatingAuditListArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *AdminAuditServiceGetResourceRatingAuditListArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AdminAuditServiceGetResourceRatingAuditListArgs(%+v)", *p)
+
+}
+
+type AdminAuditServiceGetResourceRatingAuditListResult struct {
+	Success *GetResourceRatingAuditListResp `thrift:"success,0,optional"`
+}
+
+func NewAdminAuditServiceGetResourceRatingAuditListResult() *AdminAuditServiceGetResourceRatingAuditListResult {
+	return &AdminAuditServiceGetResourceRatingAuditListResult{}
+}
+
+func (p *AdminAuditServiceGetResourceRatingAuditListResult) InitDefault() {
+}
+
+var AdminAuditServiceGetResourceRatingAuditListResult_Success_DEFAULT *GetResourceRatingAuditListResp
+
+func (p *AdminAuditServiceGetResourceRatingAuditListResult) GetSuccess() (v *GetResourceRatingAuditListResp) {
+	if !p.IsSetSuccess() {
+		return AdminAuditServiceGetResourceRatingAuditListResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_AdminAuditServiceGetResourceRatingAuditListResult = map[int16]string{
+	0: "success",
+}
+
+func (p *AdminAuditServiceGetResourceRatingAuditListResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *AdminAuditServiceGetResourceRatingAuditListResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminAuditServiceGetResourceRatingAuditListResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *AdminAuditServiceGetResourceRatingAuditListResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetResourceRatingAuditListResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *AdminAuditServiceGetResourceRatingAuditListResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetResourceRatingAuditList_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AdminAuditServiceGetResourceRatingAuditListResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *AdminAuditServiceGetResourceRatingAuditListResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AdminAuditServiceGetResourceRatingAuditListResult(%+v)", *p)
+
+}
+
+type AdminAuditServiceAuditResourceRatingArgs struct {
+	Req *AuditResourceRatingReq `thrift:"req,1"`
+}
+
+func NewAdminAuditServiceAuditResourceRatingArgs() *AdminAuditServiceAuditResourceRatingArgs {
+	return &AdminAuditServiceAuditResourceRatingArgs{}
+}
+
+func (p *AdminAuditServiceAuditResourceRatingArgs) InitDefault() {
+}
+
+var AdminAuditServiceAuditResourceRatingArgs_Req_DEFAULT *AuditResourceRatingReq
+
+func (p *AdminAuditServiceAuditResourceRatingArgs) GetReq() (v *AuditResourceRatingReq) {
+	if !p.IsSetReq() {
+		return AdminAuditServiceAuditResourceRatingArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_AdminAuditServiceAuditResourceRatingArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *AdminAuditServiceAuditResourceRatingArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *AdminAuditServiceAuditResourceRatingArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminAuditServiceAuditResourceRatingArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *AdminAuditServiceAuditResourceRatingArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewAuditResourceRatingReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *AdminAuditServiceAuditResourceRatingArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AuditResourceRating_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AdminAuditServiceAuditResourceRatingArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *AdminAuditServiceAuditResourceRatingArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AdminAuditServiceAuditResourceRatingArgs(%+v)", *p)
+
+}
+
+type AdminAuditServiceAuditResourceRatingResult struct {
+	Success *AuditResourceRatingResp `thrift:"success,0,optional"`
+}
+
+func NewAdminAuditServiceAuditResourceRatingResult() *AdminAuditServiceAuditResourceRatingResult {
+	return &AdminAuditServiceAuditResourceRatingResult{}
+}
+
+func (p *AdminAuditServiceAuditResourceRatingResult) InitDefault() {
+}
+
+var AdminAuditServiceAuditResourceRatingResult_Success_DEFAULT *AuditResourceRatingResp
+
+func (p *AdminAuditServiceAuditResourceRatingResult) GetSuccess() (v *AuditResourceRatingResp) {
+	if !p.IsSetSuccess() {
+		return AdminAuditServiceAuditResourceRatingResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_AdminAuditServiceAuditResourceRatingResult = map[int16]string{
+	0: "success",
+}
+
+func (p *AdminAuditServiceAuditResourceRatingResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *AdminAuditServiceAuditResourceRatingResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminAuditServiceAuditResourceRatingResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *AdminAuditServiceAuditResourceRatingResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewAuditResourceRatingResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *AdminAuditServiceAuditResourceRatingResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AuditResourceRating_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AdminAuditServiceAuditResourceRatingResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *AdminAuditServiceAuditResourceRatingResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AdminAuditServiceAuditResourceRatingResult(%+v)", *p)
+
+}
+
+type AdminActionServiceProcessor struct {
+	processorMap map[string]thrift.TProcessorFunction
+	handler      AdminActionService
+}
+
+func (p *AdminActionServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
+	p.processorMap[key] = processor
+}
+
+func (p *AdminActionServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
+	processor, ok = p.processorMap[key]
+	return processor, ok
+}
+
+func (p *AdminActionServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
+	return p.processorMap
+}
+
+func NewAdminActionServiceProcessor(handler AdminActionService) *AdminActionServiceProcessor {
+	self := &AdminActionServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
+	self.AddToProcessorMap("ListAdminActions", &adminActionServiceProcessorListAdminActions{handler: handler})
+	self.AddToProcessorMap("ExportAdminActions", &adminActionServiceProcessorExportAdminActions{handler: handler})
+	return self
+}
+func (p *AdminActionServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	name, _, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return false, err
+	}
+	if processor, ok := p.GetProcessorFunction(name); ok {
+		return processor.Process(ctx, seqId, iprot, oprot)
+	}
+	iprot.Skip(thrift.STRUCT)
+	iprot.ReadMessageEnd()
+	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
+	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
+	x.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Flush(ctx)
+	return false, x
+}
+
+type adminActionServiceProcessorListAdminActions struct {
+	handler AdminActionService
+}
+
+func (p *adminActionServiceProcessorListAdminActions) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := AdminActionServiceListAdminActionsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ListAdminActions", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := AdminActionServiceListAdminActionsResult{}
+	var retval *ListAdminActionsResp
+	if retval, err2 = p.handler.ListAdminActions(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListAdminActions: "+err2.Error())
+		oprot.WriteMessageBegin("ListAdminActions", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ListAdminActions", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type adminActionServiceProcessorExportAdminActions struct {
+	handler AdminActionService
+}
+
+func (p *adminActionServiceProcessorExportAdminActions) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := AdminActionServiceExportAdminActionsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ExportAdminActions", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := AdminActionServiceExportAdminActionsResult{}
+	var retval *ExportAdminActionsResp
+	if retval, err2 = p.handler.ExportAdminActions(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ExportAdminActions: "+err2.Error())
+		oprot.WriteMessageBegin("ExportAdminActions", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ExportAdminActions", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type AdminActionServiceListAdminActionsArgs struct {
+	Req *ListAdminActionsReq `thrift:"req,1"`
+}
+
+func NewAdminActionServiceListAdminActionsArgs() *AdminActionServiceListAdminActionsArgs {
+	return &AdminActionServiceListAdminActionsArgs{}
+}
+
+func (p *AdminActionServiceListAdminActionsArgs) InitDefault() {
+}
+
+var AdminActionServiceListAdminActionsArgs_Req_DEFAULT *ListAdminActionsReq
+
+func (p *AdminActionServiceListAdminActionsArgs) GetReq() (v *ListAdminActionsReq) {
+	if !p.IsSetReq() {
+		return AdminActionServiceListAdminActionsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_AdminActionServiceListAdminActionsArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *AdminActionServiceListAdminActionsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *AdminActionServiceListAdminActionsArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminActionServiceListAdminActionsArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *AdminActionServiceListAdminActionsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewListAdminActionsReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *AdminActionServiceListAdminActionsArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ListAdminActions_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AdminActionServiceListAdminActionsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *AdminActionServiceListAdminActionsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AdminActionServiceListAdminActionsArgs(%+v)", *p)
+
+}
+
+type AdminActionServiceListAdminActionsResult struct {
+	Success *ListAdminActionsResp `thrift:"success,0,optional"`
+}
+
+func NewAdminActionServiceListAdminActionsResult() *AdminActionServiceListAdminActionsResult {
+	return &AdminActionServiceListAdminActionsResult{}
+}
+
+func (p *AdminActionServiceListAdminActionsResult) InitDefault() {
+}
+
+var AdminActionServiceListAdminActionsResult_Success_DEFAULT *ListAdminActionsResp
+
+func (p *AdminActionServiceListAdminActionsResult) GetSuccess() (v *ListAdminActionsResp) {
+	if !p.IsSetSuccess() {
+		return AdminActionServiceListAdminActionsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_AdminActionServiceListAdminActionsResult = map[int16]string{
+	0: "success",
+}
+
+func (p *AdminActionServiceListAdminActionsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *AdminActionServiceListAdminActionsResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminActionServiceListAdminActionsResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *AdminActionServiceListAdminActionsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewListAdminActionsResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *AdminActionServiceListAdminActionsResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ListAdminActions_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AdminActionServiceListAdminActionsResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *AdminActionServiceListAdminActionsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AdminActionServiceListAdminActionsResult(%+v)", *p)
+
+}
+
+type AdminActionServiceExportAdminActionsArgs struct {
+	Req *ExportAdminActionsReq `thrift:"req,1"`
+}
+
+func NewAdminActionServiceExportAdminActionsArgs() *AdminActionServiceExportAdminActionsArgs {
+	return &AdminActionServiceExportAdminActionsArgs{}
+}
+
+func (p *AdminActionServiceExportAdminActionsArgs) InitDefault() {
+}
+
+var AdminActionServiceExportAdminActionsArgs_Req_DEFAULT *ExportAdminActionsReq
+
+func (p *AdminActionServiceExportAdminActionsArgs) GetReq() (v *ExportAdminActionsReq) {
+	if !p.IsSetReq() {
+		return AdminActionServiceExportAdminActionsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_AdminActionServiceExportAdminActionsArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *AdminActionServiceExportAdminActionsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *AdminActionServiceExportAdminActionsArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminActionServiceExportAdminActionsArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *AdminActionServiceExportAdminActionsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewExportAdminActionsReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *AdminActionServiceExportAdminActionsArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ExportAdminActions_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AdminActionServiceExportAdminActionsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *AdminActionServiceExportAdminActionsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AdminActionServiceExportAdminActionsArgs(%+v)", *p)
+
+}
+
+type AdminActionServiceExportAdminActionsResult struct {
+	Success *ExportAdminActionsResp `thrift:"success,0,optional"`
+}
+
+func NewAdminActionServiceExportAdminActionsResult() *AdminActionServiceExportAdminActionsResult {
+	return &AdminActionServiceExportAdminActionsResult{}
+}
+
+func (p *AdminActionServiceExportAdminActionsResult) InitDefault() {
+}
+
+var AdminActionServiceExportAdminActionsResult_Success_DEFAULT *ExportAdminActionsResp
+
+func (p *AdminActionServiceExportAdminActionsResult) GetSuccess() (v *ExportAdminActionsResp) {
+	if !p.IsSetSuccess() {
+		return AdminActionServiceExportAdminActionsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_AdminActionServiceExportAdminActionsResult = map[int16]string{
+	0: "success",
+}
+
+func (p *AdminActionServiceExportAdminActionsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *AdminActionServiceExportAdminActionsResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminActionServiceExportAdminActionsResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *AdminActionServiceExportAdminActionsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewExportAdminActionsResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *AdminActionServiceExportAdminActionsResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ExportAdminActions_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AdminActionServiceExportAdminActionsResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *AdminActionServiceExportAdminActionsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AdminActionServiceExportAdminActionsResult(%+v)", *p)
+
+}
+
+type ReviewQueueServiceProcessor struct {
+	processorMap map[string]thrift.TProcessorFunction
+	handler      ReviewQueueService
+}
+
+func (p *ReviewQueueServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
+	p.processorMap[key] = processor
+}
+
+func (p *ReviewQueueServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
+	processor, ok = p.processorMap[key]
+	return processor, ok
+}
+
+func (p *ReviewQueueServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
+	return p.processorMap
+}
+
+func NewReviewQueueServiceProcessor(handler ReviewQueueService) *ReviewQueueServiceProcessor {
+	self := &ReviewQueueServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
+	self.AddToProcessorMap("ClaimNextReviews", &reviewQueueServiceProcessorClaimNextReviews{handler: handler})
+	self.AddToProcessorMap("GetReviewQueueStats", &reviewQueueServiceProcessorGetReviewQueueStats{handler: handler})
+	self.AddToProcessorMap("GetReviewInbox", &reviewQueueServiceProcessorGetReviewInbox{handler: handler})
+	self.AddToProcessorMap("ClaimReview", &reviewQueueServiceProcessorClaimReview{handler: handler})
+	self.AddToProcessorMap("ReleaseReview", &reviewQueueServiceProcessorReleaseReview{handler: handler})
+	self.AddToProcessorMap("GetReviewReporters", &reviewQueueServiceProcessorGetReviewReporters{handler: handler})
+	return self
+}
+func (p *ReviewQueueServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	name, _, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return false, err
+	}
+	if processor, ok := p.GetProcessorFunction(name); ok {
+		return processor.Process(ctx, seqId, iprot, oprot)
+	}
+	iprot.Skip(thrift.STRUCT)
+	iprot.ReadMessageEnd()
+	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
+	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
+	x.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Flush(ctx)
+	return false, x
+}
+
+type reviewQueueServiceProcessorClaimNextReviews struct {
+	handler ReviewQueueService
+}
+
+func (p *reviewQueueServiceProcessorClaimNextReviews) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ReviewQueueServiceClaimNextReviewsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ClaimNextReviews", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ReviewQueueServiceClaimNextReviewsResult{}
+	var retval *ClaimNextReviewsResp
+	if retval, err2 = p.handler.ClaimNextReviews(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ClaimNextReviews: "+err2.Error())
+		oprot.WriteMessageBegin("ClaimNextReviews", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ClaimNextReviews", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type reviewQueueServiceProcessorGetReviewQueueStats struct {
+	handler ReviewQueueService
+}
+
+func (p *reviewQueueServiceProcessorGetReviewQueueStats) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ReviewQueueServiceGetReviewQueueStatsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetReviewQueueStats", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ReviewQueueServiceGetReviewQueueStatsResult{}
+	var retval *GetReviewQueueStatsResp
+	if retval, err2 = p.handler.GetReviewQueueStats(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetReviewQueueStats: "+err2.Error())
+		oprot.WriteMessageBegin("GetReviewQueueStats", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetReviewQueueStats", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type reviewQueueServiceProcessorGetReviewInbox struct {
+	handler ReviewQueueService
+}
+
+func (p *reviewQueueServiceProcessorGetReviewInbox) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ReviewQueueServiceGetReviewInboxArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetReviewInbox", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ReviewQueueServiceGetReviewInboxResult{}
+	var retval *GetReviewInboxResp
+	if retval, err2 = p.handler.GetReviewInbox(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetReviewInbox: "+err2.Error())
+		oprot.WriteMessageBegin("GetReviewInbox", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetReviewInbox", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type reviewQueueServiceProcessorClaimReview struct {
+	handler ReviewQueueService
+}
+
+func (p *reviewQueueServiceProcessorClaimReview) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ReviewQueueServiceClaimReviewArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ClaimReview", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ReviewQueueServiceClaimReviewResult{}
+	var retval *ClaimReviewResp
+	if retval, err2 = p.handler.ClaimReview(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ClaimReview: "+err2.Error())
+		oprot.WriteMessageBegin("ClaimReview", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ClaimReview", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type reviewQueueServiceProcessorReleaseReview struct {
+	handler ReviewQueueService
+}
+
+func (p *reviewQueueServiceProcessorReleaseReview) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ReviewQueueServiceReleaseReviewArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ReleaseReview", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ReviewQueueServiceReleaseReviewResult{}
+	var retval *ReleaseReviewResp
+	if retval, err2 = p.handler.ReleaseReview(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ReleaseReview: "+err2.Error())
+		oprot.WriteMessageBegin("ReleaseReview", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ReleaseReview", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type reviewQueueServiceProcessorGetReviewReporters struct {
+	handler ReviewQueueService
+}
+
+func (p *reviewQueueServiceProcessorGetReviewReporters) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ReviewQueueServiceGetReviewReportersArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetReviewReporters", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ReviewQueueServiceGetReviewReportersResult{}
+	var retval *GetReviewReportersResp
+	if retval, err2 = p.handler.GetReviewReporters(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetReviewReporters: "+err2.Error())
+		oprot.WriteMessageBegin("GetReviewReporters", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetReviewReporters", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type ReviewQueueServiceClaimNextReviewsArgs struct {
+	Req *ClaimNextReviewsReq `thrift:"req,1"`
+}
+
+func NewReviewQueueServiceClaimNextReviewsArgs() *ReviewQueueServiceClaimNextReviewsArgs {
+	return &ReviewQueueServiceClaimNextReviewsArgs{}
+}
+
+func (p *ReviewQueueServiceClaimNextReviewsArgs) InitDefault() {
+}
+
+var ReviewQueueServiceClaimNextReviewsArgs_Req_DEFAULT *ClaimNextReviewsReq
+
+func (p *ReviewQueueServiceClaimNextReviewsArgs) GetReq() (v *ClaimNextReviewsReq) {
+	if !p.IsSetReq() {
+		return ReviewQueueServiceClaimNextReviewsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_ReviewQueueServiceClaimNextReviewsArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *ReviewQueueServiceClaimNextReviewsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ReviewQueueServiceClaimNextReviewsArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ReviewQueueServiceClaimNextReviewsArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ReviewQueueServiceClaimNextReviewsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewClaimNextReviewsReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *ReviewQueueServiceClaimNextReviewsArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ClaimNextReviews_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ReviewQueueServiceClaimNextReviewsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ReviewQueueServiceClaimNextReviewsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReviewQueueServiceClaimNextReviewsArgs(%+v)", *p)
+
+}
+
+type ReviewQueueServiceClaimNextReviewsResult struct {
+	Success *ClaimNextReviewsResp `thrift:"success,0,optional"`
+}
+
+func NewReviewQueueServiceClaimNextReviewsResult() *ReviewQueueServiceClaimNextReviewsResult {
+	return &ReviewQueueServiceClaimNextReviewsResult{}
+}
+
+func (p *ReviewQueueServiceClaimNextReviewsResult) InitDefault() {
+}
+
+var ReviewQueueServiceClaimNextReviewsResult_Success_DEFAULT *ClaimNextReviewsResp
+
+func (p *ReviewQueueServiceClaimNextReviewsResult) GetSuccess() (v *ClaimNextReviewsResp) {
+	if !p.IsSetSuccess() {
+		return ReviewQueueServiceClaimNextReviewsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_ReviewQueueServiceClaimNextReviewsResult = map[int16]string{
+	0: "success",
+}
+
+func (p *ReviewQueueServiceClaimNextReviewsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ReviewQueueServiceClaimNextReviewsResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ReviewQueueServiceClaimNextReviewsResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ReviewQueueServiceClaimNextReviewsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewClaimNextReviewsResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *ReviewQueueServiceClaimNextReviewsResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ClaimNextReviews_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ReviewQueueServiceClaimNextReviewsResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *ReviewQueueServiceClaimNextReviewsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReviewQueueServiceClaimNextReviewsResult(%+v)", *p)
+
+}
+
+type ReviewQueueServiceGetReviewQueueStatsArgs struct {
+	Req *GetReviewQueueStatsReq `thrift:"req,1"`
+}
+
+func NewReviewQueueServiceGetReviewQueueStatsArgs() *ReviewQueueServiceGetReviewQueueStatsArgs {
+	return &ReviewQueueServiceGetReviewQueueStatsArgs{}
+}
+
+func (p *ReviewQueueServiceGetReviewQueueStatsArgs) InitDefault() {
+}
+
+var ReviewQueueServiceGetReviewQueueStatsArgs_Req_DEFAULT *GetReviewQueueStatsReq
+
+func (p *ReviewQueueServiceGetReviewQueueStatsArgs) GetReq() (v *GetReviewQueueStatsReq) {
+	if !p.IsSetReq() {
+		return ReviewQueueServiceGetReviewQueueStatsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_ReviewQueueServiceGetReviewQueueStatsArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *ReviewQueueServiceGetReviewQueueStatsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ReviewQueueServiceGetReviewQueueStatsArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ReviewQueueServiceGetReviewQueueStatsArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ReviewQueueServiceGetReviewQueueStatsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetReviewQueueStatsReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *ReviewQueueServiceGetReviewQueueStatsArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetReviewQueueStats_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ReviewQueueServiceGetReviewQueueStatsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ReviewQueueServiceGetReviewQueueStatsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReviewQueueServiceGetReviewQueueStatsArgs(%+v)", *p)
+
+}
+
+type ReviewQueueServiceGetReviewQueueStatsResult struct {
+	Success *GetReviewQueueStatsResp `thrift:"success,0,optional"`
+}
+
+func NewReviewQueueServiceGetReviewQueueStatsResult() *ReviewQueueServiceGetReviewQueueStatsResult {
+	return &ReviewQueueServiceGetReviewQueueStatsResult{}
+}
+
+func (p *ReviewQueueServiceGetReviewQueueStatsResult) InitDefault() {
+}
+
+var ReviewQueueServiceGetReviewQueueStatsResult_Success_DEFAULT *GetReviewQueueStatsResp
+
+func (p *ReviewQueueServiceGetReviewQueueStatsResult) GetSuccess() (v *GetReviewQueueStatsResp) {
+	if !p.IsSetSuccess() {
+		return ReviewQueueServiceGetReviewQueueStatsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_ReviewQueueServiceGetReviewQueueStatsResult = map[int16]string{
+	0: "success",
+}
+
+func (p *ReviewQueueServiceGetReviewQueueStatsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ReviewQueueServiceGetReviewQueueStatsResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ReviewQueueServiceGetReviewQueueStatsResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ReviewQueueServiceGetReviewQueueStatsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetReviewQueueStatsResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *ReviewQueueServiceGetReviewQueueStatsResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetReviewQueueStats_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ReviewQueueServiceGetReviewQueueStatsResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *ReviewQueueServiceGetReviewQueueStatsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReviewQueueServiceGetReviewQueueStatsResult(%+v)", *p)
+
+}
+
+type ReviewQueueServiceGetReviewInboxArgs struct {
+	Req *GetReviewInboxReq `thrift:"req,1"`
+}
+
+func NewReviewQueueServiceGetReviewInboxArgs() *ReviewQueueServiceGetReviewInboxArgs {
+	return &ReviewQueueServiceGetReviewInboxArgs{}
+}
+
+func (p *ReviewQueueServiceGetReviewInboxArgs) InitDefault() {
+}
+
+var ReviewQueueServiceGetReviewInboxArgs_Req_DEFAULT *GetReviewInboxReq
+
+func (p *ReviewQueueServiceGetReviewInboxArgs) GetReq() (v *GetReviewInboxReq) {
+	if !p.IsSetReq() {
+		return ReviewQueueServiceGetReviewInboxArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_ReviewQueueServiceGetReviewInboxArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *ReviewQueueServiceGetReviewInboxArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ReviewQueueServiceGetReviewInboxArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ReviewQueueServiceGetReviewInboxArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ReviewQueueServiceGetReviewInboxArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetReviewInboxReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *ReviewQueueServiceGetReviewInboxArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetReviewInbox_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ReviewQueueServiceGetReviewInboxArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ReviewQueueServiceGetReviewInboxArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReviewQueueServiceGetReviewInboxArgs(%+v)", *p)
+
+}
+
+type ReviewQueueServiceGetReviewInboxResult struct {
+	Success *GetReviewInboxResp `thrift:"success,0,optional"`
+}
+
+func NewReviewQueueServiceGetReviewInboxResult() *ReviewQueueServiceGetReviewInboxResult {
+	return &ReviewQueueServiceGetReviewInboxResult{}
+}
+
+func (p *ReviewQueueServiceGetReviewInboxResult) InitDefault() {
+}
+
+var ReviewQueueServiceGetReviewInboxResult_Success_DEFAULT *GetReviewInboxResp
+
+func (p *ReviewQueueServiceGetReviewInboxResult) GetSuccess() (v *GetReviewInboxResp) {
+	if !p.IsSetSuccess() {
+		return ReviewQueueServiceGetReviewInboxResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_ReviewQueueServiceGetReviewInboxResult = map[int16]string{
+	0: "success",
+}
+
+func (p *ReviewQueueServiceGetReviewInboxResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ReviewQueueServiceGetReviewInboxResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ReviewQueueServiceGetReviewInboxResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ReviewQueueServiceGetReviewInboxResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetReviewInboxResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *ReviewQueueServiceGetReviewInboxResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetReviewInbox_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ReviewQueueServiceGetReviewInboxResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *ReviewQueueServiceGetReviewInboxResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReviewQueueServiceGetReviewInboxResult(%+v)", *p)
+
+}
+
+type ReviewQueueServiceClaimReviewArgs struct {
+	Req *ClaimReviewReq `thrift:"req,1"`
+}
+
+func NewReviewQueueServiceClaimReviewArgs() *ReviewQueueServiceClaimReviewArgs {
+	return &ReviewQueueServiceClaimReviewArgs{}
+}
+
+func (p *ReviewQueueServiceClaimReviewArgs) InitDefault() {
+}
+
+var ReviewQueueServiceClaimReviewArgs_Req_DEFAULT *ClaimReviewReq
+
+func (p *ReviewQueueServiceClaimReviewArgs) GetReq() (v *ClaimReviewReq) {
+	if !p.IsSetReq() {
+		return ReviewQueueServiceClaimReviewArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_ReviewQueueServiceClaimReviewArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *ReviewQueueServiceClaimReviewArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ReviewQueueServiceClaimReviewArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ReviewQueueServiceClaimReviewArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ReviewQueueServiceClaimReviewArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewClaimReviewReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *ReviewQueueServiceClaimReviewArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ClaimReview_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ReviewQueueServiceClaimReviewArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ReviewQueueServiceClaimReviewArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReviewQueueServiceClaimReviewArgs(%+v)", *p)
+
+}
+
+type ReviewQueueServiceClaimReviewResult struct {
+	Success *ClaimReviewResp `thrift:"success,0,optional"`
+}
+
+func NewReviewQueueServiceClaimReviewResult() *ReviewQueueServiceClaimReviewResult {
+	return &ReviewQueueServiceClaimReviewResult{}
+}
+
+func (p *ReviewQueueServiceClaimReviewResult) InitDefault() {
+}
+
+var ReviewQueueServiceClaimReviewResult_Success_DEFAULT *ClaimReviewResp
+
+func (p *ReviewQueueServiceClaimReviewResult) GetSuccess() (v *ClaimReviewResp) {
+	if !p.IsSetSuccess() {
+		return ReviewQueueServiceClaimReviewResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_ReviewQueueServiceClaimReviewResult = map[int16]string{
+	0: "success",
+}
+
+func (p *ReviewQueueServiceClaimReviewResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ReviewQueueServiceClaimReviewResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ReviewQueueServiceClaimReviewResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ReviewQueueServiceClaimReviewResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewClaimReviewResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *ReviewQueueServiceClaimReviewResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ClaimReview_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ReviewQueueServiceClaimReviewResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *ReviewQueueServiceClaimReviewResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReviewQueueServiceClaimReviewResult(%+v)", *p)
+
+}
+
+type ReviewQueueServiceReleaseReviewArgs struct {
+	Req *ReleaseReviewReq `thrift:"req,1"`
+}
+
+func NewReviewQueueServiceReleaseReviewArgs() *ReviewQueueServiceReleaseReviewArgs {
+	return &ReviewQueueServiceReleaseReviewArgs{}
+}
+
+func (p *ReviewQueueServiceReleaseReviewArgs) InitDefault() {
+}
+
+var ReviewQueueServiceReleaseReviewArgs_Req_DEFAULT *ReleaseReviewReq
+
+func (p *ReviewQueueServiceReleaseReviewArgs) GetReq() (v *ReleaseReviewReq) {
+	if !p.IsSetReq() {
+		return ReviewQueueServiceReleaseReviewArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_ReviewQueueServiceReleaseReviewArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *ReviewQueueServiceReleaseReviewArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ReviewQueueServiceReleaseReviewArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ReviewQueueServiceReleaseReviewArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ReviewQueueServiceReleaseReviewArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewReleaseReviewReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *ReviewQueueServiceReleaseReviewArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ReleaseReview_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ReviewQueueServiceReleaseReviewArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ReviewQueueServiceReleaseReviewArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReviewQueueServiceReleaseReviewArgs(%+v)", *p)
+
+}
+
+type ReviewQueueServiceReleaseReviewResult struct {
+	Success *ReleaseReviewResp `thrift:"success,0,optional"`
+}
+
+func NewReviewQueueServiceReleaseReviewResult() *ReviewQueueServiceReleaseReviewResult {
+	return &ReviewQueueServiceReleaseReviewResult{}
+}
+
+func (p *ReviewQueueServiceReleaseReviewResult) InitDefault() {
+}
+
+var ReviewQueueServiceReleaseReviewResult_Success_DEFAULT *ReleaseReviewResp
+
+func (p *ReviewQueueServiceReleaseReviewResult) GetSuccess() (v *ReleaseReviewResp) {
+	if !p.IsSetSuccess() {
+		return ReviewQueueServiceReleaseReviewResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_ReviewQueueServiceReleaseReviewResult = map[int16]string{
+	0: "success",
+}
+
+func (p *ReviewQueueServiceReleaseReviewResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ReviewQueueServiceReleaseReviewResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ReviewQueueServiceReleaseReviewResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ReviewQueueServiceReleaseReviewResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewReleaseReviewResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *ReviewQueueServiceReleaseReviewResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ReleaseReview_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ReviewQueueServiceReleaseReviewResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *ReviewQueueServiceReleaseReviewResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReviewQueueServiceReleaseReviewResult(%+v)", *p)
+
+}
+
+type ReviewQueueServiceGetReviewReportersArgs struct {
+	Req *GetReviewReportersReq `thrift:"req,1"`
+}
+
+func NewReviewQueueServiceGetReviewReportersArgs() *ReviewQueueServiceGetReviewReportersArgs {
+	return &ReviewQueueServiceGetReviewReportersArgs{}
+}
+
+func (p *ReviewQueueServiceGetReviewReportersArgs) InitDefault() {
+}
+
+var ReviewQueueServiceGetReviewReportersArgs_Req_DEFAULT *GetReviewReportersReq
+
+func (p *ReviewQueueServiceGetReviewReportersArgs) GetReq() (v *GetReviewReportersReq) {
+	if !p.IsSetReq() {
+		return ReviewQueueServiceGetReviewReportersArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_ReviewQueueServiceGetReviewReportersArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *ReviewQueueServiceGetReviewReportersArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ReviewQueueServiceGetReviewReportersArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ReviewQueueServiceGetReviewReportersArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ReviewQueueServiceGetReviewReportersArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetReviewReportersReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *ReviewQueueServiceGetReviewReportersArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetReviewReporters_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ReviewQueueServiceGetReviewReportersArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ReviewQueueServiceGetReviewReportersArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReviewQueueServiceGetReviewReportersArgs(%+v)", *p)
+
+}
+
+type ReviewQueueServiceGetReviewReportersResult struct {
+	Success *GetReviewReportersResp `thrift:"success,0,optional"`
+}
+
+func NewReviewQueueServiceGetReviewReportersResult() *ReviewQueueServiceGetReviewReportersResult {
+	return &ReviewQueueServiceGetReviewReportersResult{}
+}
+
+func (p *ReviewQueueServiceGetReviewReportersResult) InitDefault() {
+}
+
+var ReviewQueueServiceGetReviewReportersResult_Success_DEFAULT *GetReviewReportersResp
+
+func (p *ReviewQueueServiceGetReviewReportersResult) GetSuccess() (v *GetReviewReportersResp) {
+	if !p.IsSetSuccess() {
+		return ReviewQueueServiceGetReviewReportersResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_ReviewQueueServiceGetReviewReportersResult = map[int16]string{
+	0: "success",
+}
+
+func (p *ReviewQueueServiceGetReviewReportersResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ReviewQueueServiceGetReviewReportersResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ReviewQueueServiceGetReviewReportersResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ReviewQueueServiceGetReviewReportersResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetReviewReportersResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *ReviewQueueServiceGetReviewReportersResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetReviewReporters_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ReviewQueueServiceGetReviewReportersResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *ReviewQueueServiceGetReviewReportersResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReviewQueueServiceGetReviewReportersResult(%+v)", *p)
+
+}
+
+type ModerationAdminServiceProcessor struct {
+	processorMap map[string]thrift.TProcessorFunction
+	handler      ModerationAdminService
+}
+
+func (p *ModerationAdminServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
+	p.processorMap[key] = processor
+}
+
+func (p *ModerationAdminServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
+	processor, ok = p.processorMap[key]
+	return processor, ok
+}
+
+func (p *ModerationAdminServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
+	return p.processorMap
+}
+
+func NewModerationAdminServiceProcessor(handler ModerationAdminService) *ModerationAdminServiceProcessor {
+	self := &ModerationAdminServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
+	self.AddToProcessorMap("ApplyModerationAction", &moderationAdminServiceProcessorApplyModerationAction{handler: handler})
+	self.AddToProcessorMap("GetAppealList", &moderationAdminServiceProcessorGetAppealList{handler: handler})
+	self.AddToProcessorMap("ResolveAppeal", &moderationAdminServiceProcessorResolveAppeal{handler: handler})
+	return self
+}
+func (p *ModerationAdminServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	name, _, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return false, err
+	}
+	if processor, ok := p.GetProcessorFunction(name); ok {
+		return processor.Process(ctx, seqId, iprot, oprot)
+	}
+	iprot.Skip(thrift.STRUCT)
+	iprot.ReadMessageEnd()
+	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
+	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
+	x.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Flush(ctx)
+	return false, x
+}
+
+type moderationAdminServiceProcessorApplyModerationAction struct {
+	handler ModerationAdminService
+}
+
+func (p *moderationAdminServiceProcessorApplyModerationAction) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ModerationAdminServiceApplyModerationActionArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ApplyModerationAction", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ModerationAdminServiceApplyModerationActionResult{}
+	var retval *ApplyModerationActionResp
+	if retval, err2 = p.handler.ApplyModerationAction(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ApplyModerationAction: "+err2.Error())
+		oprot.WriteMessageBegin("ApplyModerationAction", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ApplyModerationAction", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type moderationAdminServiceProcessorGetAppealList struct {
+	handler ModerationAdminService
+}
+
+func (p *moderationAdminServiceProcessorGetAppealList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ModerationAdminServiceGetAppealListArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetAppealList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ModerationAdminServiceGetAppealListResult{}
+	var retval *GetAppealListResp
+	if retval, err2 = p.handler.GetAppealList(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetAppealList: "+err2.Error())
+		oprot.WriteMessageBegin("GetAppealList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetAppealList", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type moderationAdminServiceProcessorResolveAppeal struct {
+	handler ModerationAdminService
+}
+
+func (p *moderationAdminServiceProcessorResolveAppeal) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ModerationAdminServiceResolveAppealArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ResolveAppeal", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ModerationAdminServiceResolveAppealResult{}
+	var retval *ResolveAppealResp
+	if retval, err2 = p.handler.ResolveAppeal(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ResolveAppeal: "+err2.Error())
+		oprot.WriteMessageBegin("ResolveAppeal", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ResolveAppeal", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type ModerationAdminServiceApplyModerationActionArgs struct {
+	Req *ApplyModerationActionReq `thrift:"req,1"`
+}
+
+func NewModerationAdminServiceApplyModerationActionArgs() *ModerationAdminServiceApplyModerationActionArgs {
+	return &ModerationAdminServiceApplyModerationActionArgs{}
+}
+
+func (p *ModerationAdminServiceApplyModerationActionArgs) InitDefault() {
+}
+
+var ModerationAdminServiceApplyModerationActionArgs_Req_DEFAULT *ApplyModerationActionReq
+
+func (p *ModerationAdminServiceApplyModerationActionArgs) GetReq() (v *ApplyModerationActionReq) {
+	if !p.IsSetReq() {
+		return ModerationAdminServiceApplyModerationActionArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_ModerationAdminServiceApplyModerationActionArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *ModerationAdminServiceApplyModerationActionArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ModerationAdminServiceApplyModerationActionArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ModerationAdminServiceApplyModerationActionArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ModerationAdminServiceApplyModerationActionArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewApplyModerationActionReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *ModerationAdminServiceApplyModerationActionArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ApplyModerationAction_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ModerationAdminServiceApplyModerationActionArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ModerationAdminServiceApplyModerationActionArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ModerationAdminServiceApplyModerationActionArgs(%+v)", *p)
+
+}
+
+type ModerationAdminServiceApplyModerationActionResult struct {
+	Success *ApplyModerationActionResp `thrift:"success,0,optional"`
+}
+
+func NewModerationAdminServiceApplyModerationActionResult() *ModerationAdminServiceApplyModerationActionResult {
+	return &ModerationAdminServiceApplyModerationActionResult{}
+}
+
+func (p *ModerationAdminServiceApplyModerationActionResult) InitDefault() {
+}
+
+var ModerationAdminServiceApplyModerationActionResult_Success_DEFAULT *ApplyModerationActionResp
+
+func (p *ModerationAdminServiceApplyModerationActionResult) GetSuccess() (v *ApplyModerationActionResp) {
+	if !p.IsSetSuccess() {
+		return ModerationAdminServiceApplyModerationActionResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_ModerationAdminServiceApplyModerationActionResult = map[int16]string{
+	0: "success",
+}
+
+func (p *ModerationAdminServiceApplyModerationActionResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ModerationAdminServiceApplyModerationActionResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ModerationAdminServiceApplyModerationActionResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ModerationAdminServiceApplyModerationActionResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewApplyModerationActionResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *ModerationAdminServiceApplyModerationActionResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ApplyModerationAction_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ModerationAdminServiceApplyModerationActionResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *ModerationAdminServiceApplyModerationActionResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ModerationAdminServiceApplyModerationActionResult(%+v)", *p)
+
+}
+
+type ModerationAdminServiceGetAppealListArgs struct {
+	Req *GetAppealListReq `thrift:"req,1"`
+}
+
+func NewModerationAdminServiceGetAppealListArgs() *ModerationAdminServiceGetAppealListArgs {
+	return &ModerationAdminServiceGetAppealListArgs{}
+}
+
+func (p *ModerationAdminServiceGetAppealListArgs) InitDefault() {
+}
+
+var ModerationAdminServiceGetAppealListArgs_Req_DEFAULT *GetAppealListReq
+
+func (p *ModerationAdminServiceGetAppealListArgs) GetReq() (v *GetAppealListReq) {
+	if !p.IsSetReq() {
+		return ModerationAdminServiceGetAppealListArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_ModerationAdminServiceGetAppealListArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *ModerationAdminServiceGetAppealListArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ModerationAdminServiceGetAppealListArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ModerationAdminServiceGetAppealListArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ModerationAdminServiceGetAppealListArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetAppealListReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *ModerationAdminServiceGetAppealListArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetAppealList_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ModerationAdminServiceGetAppealListArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ModerationAdminServiceGetAppealListArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ModerationAdminServiceGetAppealListArgs(%+v)", *p)
+
+}
+
+type ModerationAdminServiceGetAppealListResult struct {
+	Success *GetAppealListResp `thrift:"success,0,optional"`
+}
+
+func NewModerationAdminServiceGetAppealListResult() *ModerationAdminServiceGetAppealListResult {
+	return &ModerationAdminServiceGetAppealListResult{}
+}
+
+func (p *ModerationAdminServiceGetAppealListResult) InitDefault() {
+}
+
+var ModerationAdminServiceGetAppealListResult_Success_DEFAULT *GetAppealListResp
+
+func (p *ModerationAdminServiceGetAppealListResult) GetSuccess() (v *GetAppealListResp) {
+	if !p.IsSetSuccess() {
+		return ModerationAdminServiceGetAppealListResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_ModerationAdminServiceGetAppealListResult = map[int16]string{
+	0: "success",
+}
+
+func (p *ModerationAdminServiceGetAppealListResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ModerationAdminServiceGetAppealListResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ModerationAdminServiceGetAppealListResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ModerationAdminServiceGetAppealListResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetAppealListResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *ModerationAdminServiceGetAppealListResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetAppealList_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ModerationAdminServiceGetAppealListResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *ModerationAdminServiceGetAppealListResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ModerationAdminServiceGetAppealListResult(%+v)", *p)
+
+}
+
+type ModerationAdminServiceResolveAppealArgs struct {
+	Req *ResolveAppealReq `thrift:"req,1"`
+}
+
+func NewModerationAdminServiceResolveAppealArgs() *ModerationAdminServiceResolveAppealArgs {
+	return &ModerationAdminServiceResolveAppealArgs{}
+}
+
+func (p *ModerationAdminServiceResolveAppealArgs) InitDefault() {
+}
+
+var ModerationAdminServiceResolveAppealArgs_Req_DEFAULT *ResolveAppealReq
+
+func (p *ModerationAdminServiceResolveAppealArgs) GetReq() (v *ResolveAppealReq) {
+	if !p.IsSetReq() {
+		return ModerationAdminServiceResolveAppealArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_ModerationAdminServiceResolveAppealArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *ModerationAdminServiceResolveAppealArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ModerationAdminServiceResolveAppealArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ModerationAdminServiceResolveAppealArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ModerationAdminServiceResolveAppealArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewResolveAppealReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *ModerationAdminServiceResolveAppealArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ResolveAppeal_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ModerationAdminServiceResolveAppealArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ModerationAdminServiceResolveAppealArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ModerationAdminServiceResolveAppealArgs(%+v)", *p)
+
+}
+
+type ModerationAdminServiceResolveAppealResult struct {
+	Success *ResolveAppealResp `thrift:"success,0,optional"`
+}
+
+func NewModerationAdminServiceResolveAppealResult() *ModerationAdminServiceResolveAppealResult {
+	return &ModerationAdminServiceResolveAppealResult{}
+}
+
+func (p *ModerationAdminServiceResolveAppealResult) InitDefault() {
+}
+
+var ModerationAdminServiceResolveAppealResult_Success_DEFAULT *ResolveAppealResp
+
+func (p *ModerationAdminServiceResolveAppealResult) GetSuccess() (v *ResolveAppealResp) {
+	if !p.IsSetSuccess() {
+		return ModerationAdminServiceResolveAppealResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_ModerationAdminServiceResolveAppealResult = map[int16]string{
+	0: "success",
+}
+
+func (p *ModerationAdminServiceResolveAppealResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ModerationAdminServiceResolveAppealResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ModerationAdminServiceResolveAppealResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *adminAuditServiceProcessorGetCourseAuditList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := AdminAuditServiceGetCourseAuditListArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetCourseAuditList", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *ModerationAdminServiceResolveAppealResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewResolveAppealResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.Success = _field
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := AdminAuditServiceGetCourseAuditListResult{}
-	var retval *GetCourseAuditListResp
-	if retval, err2 = p.handler.GetCourseAuditList(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetCourseAuditList: "+err2.Error())
-		oprot.WriteMessageBegin("GetCourseAuditList", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+func (p *ModerationAdminServiceResolveAppealResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ResolveAppeal_result"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageBegin("GetCourseAuditList", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ModerationAdminServiceResolveAppealResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *ModerationAdminServiceResolveAppealResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return true, err
+	return fmt.Sprintf("ModerationAdminServiceResolveAppealResult(%+v)", *p)
+
 }
 
-type adminAuditServiceProcessorAuditCourse struct {
-	handler AdminAuditService
+type ContentFilterAdminServiceProcessor struct {
+	processorMap map[string]thrift.TProcessorFunction
+	handler      ContentFilterAdminService
 }
 
-func (p *adminAuditServiceProcessorAuditCourse) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := AdminAuditServiceAuditCourseArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("AuditCourse", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func (p *ContentFilterAdminServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
+	p.processorMap[key] = processor
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := AdminAuditServiceAuditCourseResult{}
-	var retval *AuditCourseResp
-	if retval, err2 = p.handler.AuditCourse(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing AuditCourse: "+err2.Error())
-		oprot.WriteMessageBegin("AuditCourse", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("AuditCourse", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
+func (p *ContentFilterAdminServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
+	processor, ok = p.processorMap[key]
+	return processor, ok
+}
+
+func (p *ContentFilterAdminServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
+	return p.processorMap
+}
+
+func NewContentFilterAdminServiceProcessor(handler ContentFilterAdminService) *ContentFilterAdminServiceProcessor {
+	self := &ContentFilterAdminServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
+	self.AddToProcessorMap("ListContentFilterRules", &contentFilterAdminServiceProcessorListContentFilterRules{handler: handler})
+	self.AddToProcessorMap("CreateContentFilterRule", &contentFilterAdminServiceProcessorCreateContentFilterRule{handler: handler})
+	self.AddToProcessorMap("UpdateContentFilterRule", &contentFilterAdminServiceProcessorUpdateContentFilterRule{handler: handler})
+	self.AddToProcessorMap("DeleteContentFilterRule", &contentFilterAdminServiceProcessorDeleteContentFilterRule{handler: handler})
+	self.AddToProcessorMap("TestContentFilter", &contentFilterAdminServiceProcessorTestContentFilter{handler: handler})
+	return self
+}
+func (p *ContentFilterAdminServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	name, _, seqId, err := iprot.ReadMessageBegin()
 	if err != nil {
-		return
+		return false, err
 	}
-	return true, err
+	if processor, ok := p.GetProcessorFunction(name); ok {
+		return processor.Process(ctx, seqId, iprot, oprot)
+	}
+	iprot.Skip(thrift.STRUCT)
+	iprot.ReadMessageEnd()
+	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
+	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
+	x.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Flush(ctx)
+	return false, x
 }
 
-type adminAuditServiceProcessorGetCommentAuditList struct {
-	handler AdminAuditService
+type contentFilterAdminServiceProcessorListContentFilterRules struct {
+	handler ContentFilterAdminService
 }
 
-func (p *adminAuditServiceProcessorGetCommentAuditList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := AdminAuditServiceGetCommentAuditListArgs{}
+func (p *contentFilterAdminServiceProcessorListContentFilterRules) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ContentFilterAdminServiceListContentFilterRulesArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetCommentAuditList", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("ListContentFilterRules", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -3958,11 +23616,11 @@ func (p *adminAuditServiceProcessorGetCommentAuditList) Process(ctx context.Cont
 
 	iprot.ReadMessageEnd()
 	var err2 error
-	result := AdminAuditServiceGetCommentAuditListResult{}
-	var retval *GetCommentAuditListResp
-	if retval, err2 = p.handler.GetCommentAuditList(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetCommentAuditList: "+err2.Error())
-		oprot.WriteMessageBegin("GetCommentAuditList", thrift.EXCEPTION, seqId)
+	result := ContentFilterAdminServiceListContentFilterRulesResult{}
+	var retval *ListContentFilterRulesResp
+	if retval, err2 = p.handler.ListContentFilterRules(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListContentFilterRules: "+err2.Error())
+		oprot.WriteMessageBegin("ListContentFilterRules", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -3970,7 +23628,7 @@ func (p *adminAuditServiceProcessorGetCommentAuditList) Process(ctx context.Cont
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("GetCommentAuditList", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("ListContentFilterRules", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -3988,16 +23646,16 @@ func (p *adminAuditServiceProcessorGetCommentAuditList) Process(ctx context.Cont
 	return true, err
 }
 
-type adminAuditServiceProcessorGetCourseCommentAuditList struct {
-	handler AdminAuditService
+type contentFilterAdminServiceProcessorCreateContentFilterRule struct {
+	handler ContentFilterAdminService
 }
 
-func (p *adminAuditServiceProcessorGetCourseCommentAuditList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := AdminAuditServiceGetCourseCommentAuditListArgs{}
+func (p *contentFilterAdminServiceProcessorCreateContentFilterRule) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ContentFilterAdminServiceCreateContentFilterRuleArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetCourseCommentAuditList", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("CreateContentFilterRule", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -4006,11 +23664,11 @@ func (p *adminAuditServiceProcessorGetCourseCommentAuditList) Process(ctx contex
 
 	iprot.ReadMessageEnd()
 	var err2 error
-	result := AdminAuditServiceGetCourseCommentAuditListResult{}
-	var retval *GetCourseCommentAuditListResp
-	if retval, err2 = p.handler.GetCourseCommentAuditList(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetCourseCommentAuditList: "+err2.Error())
-		oprot.WriteMessageBegin("GetCourseCommentAuditList", thrift.EXCEPTION, seqId)
+	result := ContentFilterAdminServiceCreateContentFilterRuleResult{}
+	var retval *CreateContentFilterRuleResp
+	if retval, err2 = p.handler.CreateContentFilterRule(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing CreateContentFilterRule: "+err2.Error())
+		oprot.WriteMessageBegin("CreateContentFilterRule", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -4018,7 +23676,7 @@ func (p *adminAuditServiceProcessorGetCourseCommentAuditList) Process(ctx contex
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("GetCourseCommentAuditList", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("CreateContentFilterRule", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -4036,16 +23694,16 @@ func (p *adminAuditServiceProcessorGetCourseCommentAuditList) Process(ctx contex
 	return true, err
 }
 
-type adminAuditServiceProcessorGetResourceCommentAuditList struct {
-	handler AdminAuditService
+type contentFilterAdminServiceProcessorUpdateContentFilterRule struct {
+	handler ContentFilterAdminService
 }
 
-func (p *adminAuditServiceProcessorGetResourceCommentAuditList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := AdminAuditServiceGetResourceCommentAuditListArgs{}
+func (p *contentFilterAdminServiceProcessorUpdateContentFilterRule) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ContentFilterAdminServiceUpdateContentFilterRuleArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetResourceCommentAuditList", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("UpdateContentFilterRule", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -4054,11 +23712,11 @@ func (p *adminAuditServiceProcessorGetResourceCommentAuditList) Process(ctx cont
 
 	iprot.ReadMessageEnd()
 	var err2 error
-	result := AdminAuditServiceGetResourceCommentAuditListResult{}
-	var retval *GetResourceCommentAuditListResp
-	if retval, err2 = p.handler.GetResourceCommentAuditList(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetResourceCommentAuditList: "+err2.Error())
-		oprot.WriteMessageBegin("GetResourceCommentAuditList", thrift.EXCEPTION, seqId)
+	result := ContentFilterAdminServiceUpdateContentFilterRuleResult{}
+	var retval *UpdateContentFilterRuleResp
+	if retval, err2 = p.handler.UpdateContentFilterRule(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UpdateContentFilterRule: "+err2.Error())
+		oprot.WriteMessageBegin("UpdateContentFilterRule", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -4066,7 +23724,7 @@ func (p *adminAuditServiceProcessorGetResourceCommentAuditList) Process(ctx cont
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("GetResourceCommentAuditList", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("UpdateContentFilterRule", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -4084,16 +23742,16 @@ func (p *adminAuditServiceProcessorGetResourceCommentAuditList) Process(ctx cont
 	return true, err
 }
 
-type adminAuditServiceProcessorAuditCourseComment struct {
-	handler AdminAuditService
+type contentFilterAdminServiceProcessorDeleteContentFilterRule struct {
+	handler ContentFilterAdminService
 }
 
-func (p *adminAuditServiceProcessorAuditCourseComment) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := AdminAuditServiceAuditCourseCommentArgs{}
+func (p *contentFilterAdminServiceProcessorDeleteContentFilterRule) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ContentFilterAdminServiceDeleteContentFilterRuleArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("AuditCourseComment", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("DeleteContentFilterRule", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -4102,11 +23760,11 @@ func (p *adminAuditServiceProcessorAuditCourseComment) Process(ctx context.Conte
 
 	iprot.ReadMessageEnd()
 	var err2 error
-	result := AdminAuditServiceAuditCourseCommentResult{}
-	var retval *AuditCourseCommentResp
-	if retval, err2 = p.handler.AuditCourseComment(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing AuditCourseComment: "+err2.Error())
-		oprot.WriteMessageBegin("AuditCourseComment", thrift.EXCEPTION, seqId)
+	result := ContentFilterAdminServiceDeleteContentFilterRuleResult{}
+	var retval *DeleteContentFilterRuleResp
+	if retval, err2 = p.handler.DeleteContentFilterRule(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DeleteContentFilterRule: "+err2.Error())
+		oprot.WriteMessageBegin("DeleteContentFilterRule", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -4114,7 +23772,7 @@ func (p *adminAuditServiceProcessorAuditCourseComment) Process(ctx context.Conte
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("AuditCourseComment", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("DeleteContentFilterRule", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -4132,16 +23790,16 @@ func (p *adminAuditServiceProcessorAuditCourseComment) Process(ctx context.Conte
 	return true, err
 }
 
-type adminAuditServiceProcessorAuditResourceComment struct {
-	handler AdminAuditService
+type contentFilterAdminServiceProcessorTestContentFilter struct {
+	handler ContentFilterAdminService
 }
 
-func (p *adminAuditServiceProcessorAuditResourceComment) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := AdminAuditServiceAuditResourceCommentArgs{}
+func (p *contentFilterAdminServiceProcessorTestContentFilter) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ContentFilterAdminServiceTestContentFilterArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("AuditResourceComment", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("TestContentFilter", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -4150,11 +23808,11 @@ func (p *adminAuditServiceProcessorAuditResourceComment) Process(ctx context.Con
 
 	iprot.ReadMessageEnd()
 	var err2 error
-	result := AdminAuditServiceAuditResourceCommentResult{}
-	var retval *AuditResourceCommentResp
-	if retval, err2 = p.handler.AuditResourceComment(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing AuditResourceComment: "+err2.Error())
-		oprot.WriteMessageBegin("AuditResourceComment", thrift.EXCEPTION, seqId)
+	result := ContentFilterAdminServiceTestContentFilterResult{}
+	var retval *TestContentFilterResp
+	if retval, err2 = p.handler.TestContentFilter(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing TestContentFilter: "+err2.Error())
+		oprot.WriteMessageBegin("TestContentFilter", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -4162,7 +23820,7 @@ func (p *adminAuditServiceProcessorAuditResourceComment) Process(ctx context.Con
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("AuditResourceComment", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("TestContentFilter", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -4180,35 +23838,35 @@ func (p *adminAuditServiceProcessorAuditResourceComment) Process(ctx context.Con
 	return true, err
 }
 
-type AdminAuditServiceGetResourceAuditListArgs struct {
-	Req *GetResourceAuditListReq `thrift:"req,1"`
+type ContentFilterAdminServiceListContentFilterRulesArgs struct {
+	Req *ListContentFilterRulesReq `thrift:"req,1"`
 }
 
-func NewAdminAuditServiceGetResourceAuditListArgs() *AdminAuditServiceGetResourceAuditListArgs {
-	return &AdminAuditServiceGetResourceAuditListArgs{}
+func NewContentFilterAdminServiceListContentFilterRulesArgs() *ContentFilterAdminServiceListContentFilterRulesArgs {
+	return &ContentFilterAdminServiceListContentFilterRulesArgs{}
 }
 
-func (p *AdminAuditServiceGetResourceAuditListArgs) InitDefault() {
+func (p *ContentFilterAdminServiceListContentFilterRulesArgs) InitDefault() {
 }
 
-var AdminAuditServiceGetResourceAuditListArgs_Req_DEFAULT *GetResourceAuditListReq
+var ContentFilterAdminServiceListContentFilterRulesArgs_Req_DEFAULT *ListContentFilterRulesReq
 
-func (p *AdminAuditServiceGetResourceAuditListArgs) GetReq() (v *GetResourceAuditListReq) {
+func (p *ContentFilterAdminServiceListContentFilterRulesArgs) GetReq() (v *ListContentFilterRulesReq) {
 	if !p.IsSetReq() {
-		return AdminAuditServiceGetResourceAuditListArgs_Req_DEFAULT
+		return ContentFilterAdminServiceListContentFilterRulesArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_AdminAuditServiceGetResourceAuditListArgs = map[int16]string{
+var fieldIDToName_ContentFilterAdminServiceListContentFilterRulesArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *AdminAuditServiceGetResourceAuditListArgs) IsSetReq() bool {
+func (p *ContentFilterAdminServiceListContentFilterRulesArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *AdminAuditServiceGetResourceAuditListArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ContentFilterAdminServiceListContentFilterRulesArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4254,7 +23912,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminAuditServiceGetResourceAuditListArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ContentFilterAdminServiceListContentFilterRulesArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4264,8 +23922,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *AdminAuditServiceGetResourceAuditListArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewGetResourceAuditListReq()
+func (p *ContentFilterAdminServiceListContentFilterRulesArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewListContentFilterRulesReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -4273,9 +23931,9 @@ func (p *AdminAuditServiceGetResourceAuditListArgs) ReadField1(iprot thrift.TPro
 	return nil
 }
 
-func (p *AdminAuditServiceGetResourceAuditListArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ContentFilterAdminServiceListContentFilterRulesArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetResourceAuditList_args"); err != nil {
+	if err = oprot.WriteStructBegin("ListContentFilterRules_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4301,7 +23959,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AdminAuditServiceGetResourceAuditListArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ContentFilterAdminServiceListContentFilterRulesArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -4318,43 +23976,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *AdminAuditServiceGetResourceAuditListArgs) String() string {
+func (p *ContentFilterAdminServiceListContentFilterRulesArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AdminAuditServiceGetResourceAuditListArgs(%+v)", *p)
+	return fmt.Sprintf("ContentFilterAdminServiceListContentFilterRulesArgs(%+v)", *p)
 
 }
 
-type AdminAuditServiceGetResourceAuditListResult struct {
-	Success *GetResourceAuditListResp `thrift:"success,0,optional"`
+type ContentFilterAdminServiceListContentFilterRulesResult struct {
+	Success *ListContentFilterRulesResp `thrift:"success,0,optional"`
 }
 
-func NewAdminAuditServiceGetResourceAuditListResult() *AdminAuditServiceGetResourceAuditListResult {
-	return &AdminAuditServiceGetResourceAuditListResult{}
+func NewContentFilterAdminServiceListContentFilterRulesResult() *ContentFilterAdminServiceListContentFilterRulesResult {
+	return &ContentFilterAdminServiceListContentFilterRulesResult{}
 }
 
-func (p *AdminAuditServiceGetResourceAuditListResult) InitDefault() {
+func (p *ContentFilterAdminServiceListContentFilterRulesResult) InitDefault() {
 }
 
-var AdminAuditServiceGetResourceAuditListResult_Success_DEFAULT *GetResourceAuditListResp
+var ContentFilterAdminServiceListContentFilterRulesResult_Success_DEFAULT *ListContentFilterRulesResp
 
-func (p *AdminAuditServiceGetResourceAuditListResult) GetSuccess() (v *GetResourceAuditListResp) {
+func (p *ContentFilterAdminServiceListContentFilterRulesResult) GetSuccess() (v *ListContentFilterRulesResp) {
 	if !p.IsSetSuccess() {
-		return AdminAuditServiceGetResourceAuditListResult_Success_DEFAULT
+		return ContentFilterAdminServiceListContentFilterRulesResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_AdminAuditServiceGetResourceAuditListResult = map[int16]string{
+var fieldIDToName_ContentFilterAdminServiceListContentFilterRulesResult = map[int16]string{
 	0: "success",
 }
 
-func (p *AdminAuditServiceGetResourceAuditListResult) IsSetSuccess() bool {
+func (p *ContentFilterAdminServiceListContentFilterRulesResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *AdminAuditServiceGetResourceAuditListResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ContentFilterAdminServiceListContentFilterRulesResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4400,7 +24058,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminAuditServiceGetResourceAuditListResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ContentFilterAdminServiceListContentFilterRulesResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4410,8 +24068,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *AdminAuditServiceGetResourceAuditListResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewGetResourceAuditListResp()
+func (p *ContentFilterAdminServiceListContentFilterRulesResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewListContentFilterRulesResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -4419,9 +24077,9 @@ func (p *AdminAuditServiceGetResourceAuditListResult) ReadField0(iprot thrift.TP
 	return nil
 }
 
-func (p *AdminAuditServiceGetResourceAuditListResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ContentFilterAdminServiceListContentFilterRulesResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetResourceAuditList_result"); err != nil {
+	if err = oprot.WriteStructBegin("ListContentFilterRules_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4447,7 +24105,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AdminAuditServiceGetResourceAuditListResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ContentFilterAdminServiceListContentFilterRulesResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -4466,43 +24124,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *AdminAuditServiceGetResourceAuditListResult) String() string {
+func (p *ContentFilterAdminServiceListContentFilterRulesResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AdminAuditServiceGetResourceAuditListResult(%+v)", *p)
+	return fmt.Sprintf("ContentFilterAdminServiceListContentFilterRulesResult(%+v)", *p)
 
 }
 
-type AdminAuditServiceAuditResourceArgs struct {
-	Req *AuditResourceReq `thrift:"req,1"`
+type ContentFilterAdminServiceCreateContentFilterRuleArgs struct {
+	Req *CreateContentFilterRuleReq `thrift:"req,1"`
 }
 
-func NewAdminAuditServiceAuditResourceArgs() *AdminAuditServiceAuditResourceArgs {
-	return &AdminAuditServiceAuditResourceArgs{}
+func NewContentFilterAdminServiceCreateContentFilterRuleArgs() *ContentFilterAdminServiceCreateContentFilterRuleArgs {
+	return &ContentFilterAdminServiceCreateContentFilterRuleArgs{}
 }
 
-func (p *AdminAuditServiceAuditResourceArgs) InitDefault() {
+func (p *ContentFilterAdminServiceCreateContentFilterRuleArgs) InitDefault() {
 }
 
-var AdminAuditServiceAuditResourceArgs_Req_DEFAULT *AuditResourceReq
+var ContentFilterAdminServiceCreateContentFilterRuleArgs_Req_DEFAULT *CreateContentFilterRuleReq
 
-func (p *AdminAuditServiceAuditResourceArgs) GetReq() (v *AuditResourceReq) {
+func (p *ContentFilterAdminServiceCreateContentFilterRuleArgs) GetReq() (v *CreateContentFilterRuleReq) {
 	if !p.IsSetReq() {
-		return AdminAuditServiceAuditResourceArgs_Req_DEFAULT
+		return ContentFilterAdminServiceCreateContentFilterRuleArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_AdminAuditServiceAuditResourceArgs = map[int16]string{
+var fieldIDToName_ContentFilterAdminServiceCreateContentFilterRuleArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *AdminAuditServiceAuditResourceArgs) IsSetReq() bool {
+func (p *ContentFilterAdminServiceCreateContentFilterRuleArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *AdminAuditServiceAuditResourceArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ContentFilterAdminServiceCreateContentFilterRuleArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4548,7 +24206,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminAuditServiceAuditResourceArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ContentFilterAdminServiceCreateContentFilterRuleArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4558,8 +24216,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *AdminAuditServiceAuditResourceArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewAuditResourceReq()
+func (p *ContentFilterAdminServiceCreateContentFilterRuleArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewCreateContentFilterRuleReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -4567,9 +24225,9 @@ func (p *AdminAuditServiceAuditResourceArgs) ReadField1(iprot thrift.TProtocol)
 	return nil
 }
 
-func (p *AdminAuditServiceAuditResourceArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ContentFilterAdminServiceCreateContentFilterRuleArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("AuditResource_args"); err != nil {
+	if err = oprot.WriteStructBegin("CreateContentFilterRule_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4595,7 +24253,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AdminAuditServiceAuditResourceArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ContentFilterAdminServiceCreateContentFilterRuleArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -4612,43 +24270,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *AdminAuditServiceAuditResourceArgs) String() string {
+func (p *ContentFilterAdminServiceCreateContentFilterRuleArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AdminAuditServiceAuditResourceArgs(%+v)", *p)
+	return fmt.Sprintf("ContentFilterAdminServiceCreateContentFilterRuleArgs(%+v)", *p)
 
 }
 
-type AdminAuditServiceAuditResourceResult struct {
-	Success *AuditResourceResp `thrift:"success,0,optional"`
+type ContentFilterAdminServiceCreateContentFilterRuleResult struct {
+	Success *CreateContentFilterRuleResp `thrift:"success,0,optional"`
 }
 
-func NewAdminAuditServiceAuditResourceResult() *AdminAuditServiceAuditResourceResult {
-	return &AdminAuditServiceAuditResourceResult{}
+func NewContentFilterAdminServiceCreateContentFilterRuleResult() *ContentFilterAdminServiceCreateContentFilterRuleResult {
+	return &ContentFilterAdminServiceCreateContentFilterRuleResult{}
 }
 
-func (p *AdminAuditServiceAuditResourceResult) InitDefault() {
+func (p *ContentFilterAdminServiceCreateContentFilterRuleResult) InitDefault() {
 }
 
-var AdminAuditServiceAuditResourceResult_Success_DEFAULT *AuditResourceResp
+var ContentFilterAdminServiceCreateContentFilterRuleResult_Success_DEFAULT *CreateContentFilterRuleResp
 
-func (p *AdminAuditServiceAuditResourceResult) GetSuccess() (v *AuditResourceResp) {
+func (p *ContentFilterAdminServiceCreateContentFilterRuleResult) GetSuccess() (v *CreateContentFilterRuleResp) {
 	if !p.IsSetSuccess() {
-		return AdminAuditServiceAuditResourceResult_Success_DEFAULT
+		return ContentFilterAdminServiceCreateContentFilterRuleResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_AdminAuditServiceAuditResourceResult = map[int16]string{
+var fieldIDToName_ContentFilterAdminServiceCreateContentFilterRuleResult = map[int16]string{
 	0: "success",
 }
 
-func (p *AdminAuditServiceAuditResourceResult) IsSetSuccess() bool {
+func (p *ContentFilterAdminServiceCreateContentFilterRuleResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *AdminAuditServiceAuditResourceResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ContentFilterAdminServiceCreateContentFilterRuleResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4694,7 +24352,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminAuditServiceAuditResourceResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ContentFilterAdminServiceCreateContentFilterRuleResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4704,8 +24362,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *AdminAuditServiceAuditResourceResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewAuditResourceResp()
+func (p *ContentFilterAdminServiceCreateContentFilterRuleResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewCreateContentFilterRuleResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -4713,9 +24371,9 @@ func (p *AdminAuditServiceAuditResourceResult) ReadField0(iprot thrift.TProtocol
 	return nil
 }
 
-func (p *AdminAuditServiceAuditResourceResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ContentFilterAdminServiceCreateContentFilterRuleResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("AuditResource_result"); err != nil {
+	if err = oprot.WriteStructBegin("CreateContentFilterRule_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4741,7 +24399,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AdminAuditServiceAuditResourceResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ContentFilterAdminServiceCreateContentFilterRuleResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -4760,43 +24418,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *AdminAuditServiceAuditResourceResult) String() string {
+func (p *ContentFilterAdminServiceCreateContentFilterRuleResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AdminAuditServiceAuditResourceResult(%+v)", *p)
+	return fmt.Sprintf("ContentFilterAdminServiceCreateContentFilterRuleResult(%+v)", *p)
 
 }
 
-type AdminAuditServiceGetCourseAuditListArgs struct {
-	Req *GetCourseAuditListReq `thrift:"req,1"`
+type ContentFilterAdminServiceUpdateContentFilterRuleArgs struct {
+	Req *UpdateContentFilterRuleReq `thrift:"req,1"`
 }
 
-func NewAdminAuditServiceGetCourseAuditListArgs() *AdminAuditServiceGetCourseAuditListArgs {
-	return &AdminAuditServiceGetCourseAuditListArgs{}
+func NewContentFilterAdminServiceUpdateContentFilterRuleArgs() *ContentFilterAdminServiceUpdateContentFilterRuleArgs {
+	return &ContentFilterAdminServiceUpdateContentFilterRuleArgs{}
 }
 
-func (p *AdminAuditServiceGetCourseAuditListArgs) InitDefault() {
+func (p *ContentFilterAdminServiceUpdateContentFilterRuleArgs) InitDefault() {
 }
 
-var AdminAuditServiceGetCourseAuditListArgs_Req_DEFAULT *GetCourseAuditListReq
+var ContentFilterAdminServiceUpdateContentFilterRuleArgs_Req_DEFAULT *UpdateContentFilterRuleReq
 
-func (p *AdminAuditServiceGetCourseAuditListArgs) GetReq() (v *GetCourseAuditListReq) {
+func (p *ContentFilterAdminServiceUpdateContentFilterRuleArgs) GetReq() (v *UpdateContentFilterRuleReq) {
 	if !p.IsSetReq() {
-		return AdminAuditServiceGetCourseAuditListArgs_Req_DEFAULT
+		return ContentFilterAdminServiceUpdateContentFilterRuleArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_AdminAuditServiceGetCourseAuditListArgs = map[int16]string{
+var fieldIDToName_ContentFilterAdminServiceUpdateContentFilterRuleArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *AdminAuditServiceGetCourseAuditListArgs) IsSetReq() bool {
+func (p *ContentFilterAdminServiceUpdateContentFilterRuleArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *AdminAuditServiceGetCourseAuditListArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ContentFilterAdminServiceUpdateContentFilterRuleArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4842,7 +24500,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminAuditServiceGetCourseAuditListArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ContentFilterAdminServiceUpdateContentFilterRuleArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4852,8 +24510,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *AdminAuditServiceGetCourseAuditListArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewGetCourseAuditListReq()
+func (p *ContentFilterAdminServiceUpdateContentFilterRuleArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewUpdateContentFilterRuleReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -4861,9 +24519,9 @@ func (p *AdminAuditServiceGetCourseAuditListArgs) ReadField1(iprot thrift.TProto
 	return nil
 }
 
-func (p *AdminAuditServiceGetCourseAuditListArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ContentFilterAdminServiceUpdateContentFilterRuleArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetCourseAuditList_args"); err != nil {
+	if err = oprot.WriteStructBegin("UpdateContentFilterRule_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4889,7 +24547,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AdminAuditServiceGetCourseAuditListArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ContentFilterAdminServiceUpdateContentFilterRuleArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -4906,43 +24564,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *AdminAuditServiceGetCourseAuditListArgs) String() string {
+func (p *ContentFilterAdminServiceUpdateContentFilterRuleArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AdminAuditServiceGetCourseAuditListArgs(%+v)", *p)
+	return fmt.Sprintf("ContentFilterAdminServiceUpdateContentFilterRuleArgs(%+v)", *p)
 
 }
 
-type AdminAuditServiceGetCourseAuditListResult struct {
-	Success *GetCourseAuditListResp `thrift:"success,0,optional"`
+type ContentFilterAdminServiceUpdateContentFilterRuleResult struct {
+	Success *UpdateContentFilterRuleResp `thrift:"success,0,optional"`
 }
 
-func NewAdminAuditServiceGetCourseAuditListResult() *AdminAuditServiceGetCourseAuditListResult {
-	return &AdminAuditServiceGetCourseAuditListResult{}
+func NewContentFilterAdminServiceUpdateContentFilterRuleResult() *ContentFilterAdminServiceUpdateContentFilterRuleResult {
+	return &ContentFilterAdminServiceUpdateContentFilterRuleResult{}
 }
 
-func (p *AdminAuditServiceGetCourseAuditListResult) InitDefault() {
+func (p *ContentFilterAdminServiceUpdateContentFilterRuleResult) InitDefault() {
 }
 
-var AdminAuditServiceGetCourseAuditListResult_Success_DEFAULT *GetCourseAuditListResp
+var ContentFilterAdminServiceUpdateContentFilterRuleResult_Success_DEFAULT *UpdateContentFilterRuleResp
 
-func (p *AdminAuditServiceGetCourseAuditListResult) GetSuccess() (v *GetCourseAuditListResp) {
+func (p *ContentFilterAdminServiceUpdateContentFilterRuleResult) GetSuccess() (v *UpdateContentFilterRuleResp) {
 	if !p.IsSetSuccess() {
-		return AdminAuditServiceGetCourseAuditListResult_Success_DEFAULT
+		return ContentFilterAdminServiceUpdateContentFilterRuleResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_AdminAuditServiceGetCourseAuditListResult = map[int16]string{
+var fieldIDToName_ContentFilterAdminServiceUpdateContentFilterRuleResult = map[int16]string{
 	0: "success",
 }
 
-func (p *AdminAuditServiceGetCourseAuditListResult) IsSetSuccess() bool {
+func (p *ContentFilterAdminServiceUpdateContentFilterRuleResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *AdminAuditServiceGetCourseAuditListResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ContentFilterAdminServiceUpdateContentFilterRuleResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4988,7 +24646,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminAuditServiceGetCourseAuditListResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ContentFilterAdminServiceUpdateContentFilterRuleResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4998,8 +24656,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *AdminAuditServiceGetCourseAuditListResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewGetCourseAuditListResp()
+func (p *ContentFilterAdminServiceUpdateContentFilterRuleResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewUpdateContentFilterRuleResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -5007,9 +24665,9 @@ func (p *AdminAuditServiceGetCourseAuditListResult) ReadField0(iprot thrift.TPro
 	return nil
 }
 
-func (p *AdminAuditServiceGetCourseAuditListResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ContentFilterAdminServiceUpdateContentFilterRuleResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetCourseAuditList_result"); err != nil {
+	if err = oprot.WriteStructBegin("UpdateContentFilterRule_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5035,7 +24693,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AdminAuditServiceGetCourseAuditListResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ContentFilterAdminServiceUpdateContentFilterRuleResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -5054,43 +24712,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *AdminAuditServiceGetCourseAuditListResult) String() string {
+func (p *ContentFilterAdminServiceUpdateContentFilterRuleResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AdminAuditServiceGetCourseAuditListResult(%+v)", *p)
+	return fmt.Sprintf("ContentFilterAdminServiceUpdateContentFilterRuleResult(%+v)", *p)
 
 }
 
-type AdminAuditServiceAuditCourseArgs struct {
-	Req *AuditCourseReq `thrift:"req,1"`
+type ContentFilterAdminServiceDeleteContentFilterRuleArgs struct {
+	Req *DeleteContentFilterRuleReq `thrift:"req,1"`
 }
 
-func NewAdminAuditServiceAuditCourseArgs() *AdminAuditServiceAuditCourseArgs {
-	return &AdminAuditServiceAuditCourseArgs{}
+func NewContentFilterAdminServiceDeleteContentFilterRuleArgs() *ContentFilterAdminServiceDeleteContentFilterRuleArgs {
+	return &ContentFilterAdminServiceDeleteContentFilterRuleArgs{}
 }
 
-func (p *AdminAuditServiceAuditCourseArgs) InitDefault() {
+func (p *ContentFilterAdminServiceDeleteContentFilterRuleArgs) InitDefault() {
 }
 
-var AdminAuditServiceAuditCourseArgs_Req_DEFAULT *AuditCourseReq
+var ContentFilterAdminServiceDeleteContentFilterRuleArgs_Req_DEFAULT *DeleteContentFilterRuleReq
 
-func (p *AdminAuditServiceAuditCourseArgs) GetReq() (v *AuditCourseReq) {
+func (p *ContentFilterAdminServiceDeleteContentFilterRuleArgs) GetReq() (v *DeleteContentFilterRuleReq) {
 	if !p.IsSetReq() {
-		return AdminAuditServiceAuditCourseArgs_Req_DEFAULT
+		return ContentFilterAdminServiceDeleteContentFilterRuleArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_AdminAuditServiceAuditCourseArgs = map[int16]string{
+var fieldIDToName_ContentFilterAdminServiceDeleteContentFilterRuleArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *AdminAuditServiceAuditCourseArgs) IsSetReq() bool {
+func (p *ContentFilterAdminServiceDeleteContentFilterRuleArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *AdminAuditServiceAuditCourseArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ContentFilterAdminServiceDeleteContentFilterRuleArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5136,7 +24794,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminAuditServiceAuditCourseArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ContentFilterAdminServiceDeleteContentFilterRuleArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5146,8 +24804,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *AdminAuditServiceAuditCourseArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewAuditCourseReq()
+func (p *ContentFilterAdminServiceDeleteContentFilterRuleArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewDeleteContentFilterRuleReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -5155,9 +24813,9 @@ func (p *AdminAuditServiceAuditCourseArgs) ReadField1(iprot thrift.TProtocol) er
 	return nil
 }
 
-func (p *AdminAuditServiceAuditCourseArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ContentFilterAdminServiceDeleteContentFilterRuleArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("AuditCourse_args"); err != nil {
+	if err = oprot.WriteStructBegin("DeleteContentFilterRule_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5183,7 +24841,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AdminAuditServiceAuditCourseArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ContentFilterAdminServiceDeleteContentFilterRuleArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -5200,43 +24858,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *AdminAuditServiceAuditCourseArgs) String() string {
+func (p *ContentFilterAdminServiceDeleteContentFilterRuleArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AdminAuditServiceAuditCourseArgs(%+v)", *p)
+	return fmt.Sprintf("ContentFilterAdminServiceDeleteContentFilterRuleArgs(%+v)", *p)
 
 }
 
-type AdminAuditServiceAuditCourseResult struct {
-	Success *AuditCourseResp `thrift:"success,0,optional"`
+type ContentFilterAdminServiceDeleteContentFilterRuleResult struct {
+	Success *DeleteContentFilterRuleResp `thrift:"success,0,optional"`
 }
 
-func NewAdminAuditServiceAuditCourseResult() *AdminAuditServiceAuditCourseResult {
-	return &AdminAuditServiceAuditCourseResult{}
+func NewContentFilterAdminServiceDeleteContentFilterRuleResult() *ContentFilterAdminServiceDeleteContentFilterRuleResult {
+	return &ContentFilterAdminServiceDeleteContentFilterRuleResult{}
 }
 
-func (p *AdminAuditServiceAuditCourseResult) InitDefault() {
+func (p *ContentFilterAdminServiceDeleteContentFilterRuleResult) InitDefault() {
 }
 
-var AdminAuditServiceAuditCourseResult_Success_DEFAULT *AuditCourseResp
+var ContentFilterAdminServiceDeleteContentFilterRuleResult_Success_DEFAULT *DeleteContentFilterRuleResp
 
-func (p *AdminAuditServiceAuditCourseResult) GetSuccess() (v *AuditCourseResp) {
+func (p *ContentFilterAdminServiceDeleteContentFilterRuleResult) GetSuccess() (v *DeleteContentFilterRuleResp) {
 	if !p.IsSetSuccess() {
-		return AdminAuditServiceAuditCourseResult_Success_DEFAULT
+		return ContentFilterAdminServiceDeleteContentFilterRuleResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_AdminAuditServiceAuditCourseResult = map[int16]string{
+var fieldIDToName_ContentFilterAdminServiceDeleteContentFilterRuleResult = map[int16]string{
 	0: "success",
 }
 
-func (p *AdminAuditServiceAuditCourseResult) IsSetSuccess() bool {
+func (p *ContentFilterAdminServiceDeleteContentFilterRuleResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *AdminAuditServiceAuditCourseResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ContentFilterAdminServiceDeleteContentFilterRuleResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5282,7 +24940,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminAuditServiceAuditCourseResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ContentFilterAdminServiceDeleteContentFilterRuleResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5292,8 +24950,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *AdminAuditServiceAuditCourseResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewAuditCourseResp()
+func (p *ContentFilterAdminServiceDeleteContentFilterRuleResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewDeleteContentFilterRuleResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -5301,9 +24959,9 @@ func (p *AdminAuditServiceAuditCourseResult) ReadField0(iprot thrift.TProtocol)
 	return nil
 }
 
-func (p *AdminAuditServiceAuditCourseResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ContentFilterAdminServiceDeleteContentFilterRuleResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("AuditCourse_result"); err != nil {
+	if err = oprot.WriteStructBegin("DeleteContentFilterRule_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5329,7 +24987,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AdminAuditServiceAuditCourseResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ContentFilterAdminServiceDeleteContentFilterRuleResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -5348,43 +25006,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *AdminAuditServiceAuditCourseResult) String() string {
+func (p *ContentFilterAdminServiceDeleteContentFilterRuleResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AdminAuditServiceAuditCourseResult(%+v)", *p)
+	return fmt.Sprintf("ContentFilterAdminServiceDeleteContentFilterRuleResult(%+v)", *p)
 
 }
 
-type AdminAuditServiceGetCommentAuditListArgs struct {
-	Req *GetCommentAuditListReq `thrift:"req,1"`
+type ContentFilterAdminServiceTestContentFilterArgs struct {
+	Req *TestContentFilterReq `thrift:"req,1"`
 }
 
-func NewAdminAuditServiceGetCommentAuditListArgs() *AdminAuditServiceGetCommentAuditListArgs {
-	return &AdminAuditServiceGetCommentAuditListArgs{}
+func NewContentFilterAdminServiceTestContentFilterArgs() *ContentFilterAdminServiceTestContentFilterArgs {
+	return &ContentFilterAdminServiceTestContentFilterArgs{}
 }
 
-func (p *AdminAuditServiceGetCommentAuditListArgs) InitDefault() {
+func (p *ContentFilterAdminServiceTestContentFilterArgs) InitDefault() {
 }
 
-var AdminAuditServiceGetCommentAuditListArgs_Req_DEFAULT *GetCommentAuditListReq
+var ContentFilterAdminServiceTestContentFilterArgs_Req_DEFAULT *TestContentFilterReq
 
-func (p *AdminAuditServiceGetCommentAuditListArgs) GetReq() (v *GetCommentAuditListReq) {
+func (p *ContentFilterAdminServiceTestContentFilterArgs) GetReq() (v *TestContentFilterReq) {
 	if !p.IsSetReq() {
-		return AdminAuditServiceGetCommentAuditListArgs_Req_DEFAULT
+		return ContentFilterAdminServiceTestContentFilterArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_AdminAuditServiceGetCommentAuditListArgs = map[int16]string{
+var fieldIDToName_ContentFilterAdminServiceTestContentFilterArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *AdminAuditServiceGetCommentAuditListArgs) IsSetReq() bool {
+func (p *ContentFilterAdminServiceTestContentFilterArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *AdminAuditServiceGetCommentAuditListArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ContentFilterAdminServiceTestContentFilterArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5430,7 +25088,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminAuditServiceGetCommentAuditListArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ContentFilterAdminServiceTestContentFilterArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5440,8 +25098,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *AdminAuditServiceGetCommentAuditListArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewGetCommentAuditListReq()
+func (p *ContentFilterAdminServiceTestContentFilterArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewTestContentFilterReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -5449,9 +25107,9 @@ func (p *AdminAuditServiceGetCommentAuditListArgs) ReadField1(iprot thrift.TProt
 	return nil
 }
 
-func (p *AdminAuditServiceGetCommentAuditListArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ContentFilterAdminServiceTestContentFilterArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetCommentAuditList_args"); err != nil {
+	if err = oprot.WriteStructBegin("TestContentFilter_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5477,7 +25135,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AdminAuditServiceGetCommentAuditListArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ContentFilterAdminServiceTestContentFilterArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -5494,43 +25152,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *AdminAuditServiceGetCommentAuditListArgs) String() string {
+func (p *ContentFilterAdminServiceTestContentFilterArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AdminAuditServiceGetCommentAuditListArgs(%+v)", *p)
+	return fmt.Sprintf("ContentFilterAdminServiceTestContentFilterArgs(%+v)", *p)
 
 }
 
-type AdminAuditServiceGetCommentAuditListResult struct {
-	Success *GetCommentAuditListResp `thrift:"success,0,optional"`
+type ContentFilterAdminServiceTestContentFilterResult struct {
+	Success *TestContentFilterResp `thrift:"success,0,optional"`
 }
 
-func NewAdminAuditServiceGetCommentAuditListResult() *AdminAuditServiceGetCommentAuditListResult {
-	return &AdminAuditServiceGetCommentAuditListResult{}
+func NewContentFilterAdminServiceTestContentFilterResult() *ContentFilterAdminServiceTestContentFilterResult {
+	return &ContentFilterAdminServiceTestContentFilterResult{}
 }
 
-func (p *AdminAuditServiceGetCommentAuditListResult) InitDefault() {
+func (p *ContentFilterAdminServiceTestContentFilterResult) InitDefault() {
 }
 
-var AdminAuditServiceGetCommentAuditListResult_Success_DEFAULT *GetCommentAuditListResp
+var ContentFilterAdminServiceTestContentFilterResult_Success_DEFAULT *TestContentFilterResp
 
-func (p *AdminAuditServiceGetCommentAuditListResult) GetSuccess() (v *GetCommentAuditListResp) {
+func (p *ContentFilterAdminServiceTestContentFilterResult) GetSuccess() (v *TestContentFilterResp) {
 	if !p.IsSetSuccess() {
-		return AdminAuditServiceGetCommentAuditListResult_Success_DEFAULT
+		return ContentFilterAdminServiceTestContentFilterResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_AdminAuditServiceGetCommentAuditListResult = map[int16]string{
+var fieldIDToName_ContentFilterAdminServiceTestContentFilterResult = map[int16]string{
 	0: "success",
 }
 
-func (p *AdminAuditServiceGetCommentAuditListResult) IsSetSuccess() bool {
+func (p *ContentFilterAdminServiceTestContentFilterResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *AdminAuditServiceGetCommentAuditListResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ContentFilterAdminServiceTestContentFilterResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5576,7 +25234,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminAuditServiceGetCommentAuditListResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ContentFilterAdminServiceTestContentFilterResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5586,8 +25244,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *AdminAuditServiceGetCommentAuditListResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewGetCommentAuditListResp()
+func (p *ContentFilterAdminServiceTestContentFilterResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewTestContentFilterResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -5595,9 +25253,9 @@ func (p *AdminAuditServiceGetCommentAuditListResult) ReadField0(iprot thrift.TPr
 	return nil
 }
 
-func (p *AdminAuditServiceGetCommentAuditListResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ContentFilterAdminServiceTestContentFilterResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetCommentAuditList_result"); err != nil {
+	if err = oprot.WriteStructBegin("TestContentFilter_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5623,7 +25281,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AdminAuditServiceGetCommentAuditListResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ContentFilterAdminServiceTestContentFilterResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -5642,43 +25300,230 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *AdminAuditServiceGetCommentAuditListResult) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *ContentFilterAdminServiceTestContentFilterResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ContentFilterAdminServiceTestContentFilterResult(%+v)", *p)
+
+}
+
+type BulkModerationServiceProcessor struct {
+	processorMap map[string]thrift.TProcessorFunction
+	handler      BulkModerationService
+}
+
+func (p *BulkModerationServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
+	p.processorMap[key] = processor
+}
+
+func (p *BulkModerationServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
+	processor, ok = p.processorMap[key]
+	return processor, ok
+}
+
+func (p *BulkModerationServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
+	return p.processorMap
+}
+
+func NewBulkModerationServiceProcessor(handler BulkModerationService) *BulkModerationServiceProcessor {
+	self := &BulkModerationServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
+	self.AddToProcessorMap("BatchAuditReviews", &bulkModerationServiceProcessorBatchAuditReviews{handler: handler})
+	self.AddToProcessorMap("HideUserComments", &bulkModerationServiceProcessorHideUserComments{handler: handler})
+	self.AddToProcessorMap("RestoreContent", &bulkModerationServiceProcessorRestoreContent{handler: handler})
+	return self
+}
+func (p *BulkModerationServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	name, _, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return false, err
+	}
+	if processor, ok := p.GetProcessorFunction(name); ok {
+		return processor.Process(ctx, seqId, iprot, oprot)
+	}
+	iprot.Skip(thrift.STRUCT)
+	iprot.ReadMessageEnd()
+	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
+	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
+	x.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Flush(ctx)
+	return false, x
+}
+
+type bulkModerationServiceProcessorBatchAuditReviews struct {
+	handler BulkModerationService
+}
+
+func (p *bulkModerationServiceProcessorBatchAuditReviews) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := BulkModerationServiceBatchAuditReviewsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("BatchAuditReviews", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := BulkModerationServiceBatchAuditReviewsResult{}
+	var retval *BatchAuditReviewsResp
+	if retval, err2 = p.handler.BatchAuditReviews(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing BatchAuditReviews: "+err2.Error())
+		oprot.WriteMessageBegin("BatchAuditReviews", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("BatchAuditReviews", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type bulkModerationServiceProcessorHideUserComments struct {
+	handler BulkModerationService
+}
+
+func (p *bulkModerationServiceProcessorHideUserComments) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := BulkModerationServiceHideUserCommentsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("HideUserComments", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := BulkModerationServiceHideUserCommentsResult{}
+	var retval *HideUserCommentsResp
+	if retval, err2 = p.handler.HideUserComments(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing HideUserComments: "+err2.Error())
+		oprot.WriteMessageBegin("HideUserComments", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("HideUserComments", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type bulkModerationServiceProcessorRestoreContent struct {
+	handler BulkModerationService
+}
+
+func (p *bulkModerationServiceProcessorRestoreContent) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := BulkModerationServiceRestoreContentArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("RestoreContent", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
 	}
-	return fmt.Sprintf("AdminAuditServiceGetCommentAuditListResult(%+v)", *p)
 
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := BulkModerationServiceRestoreContentResult{}
+	var retval *RestoreContentResp
+	if retval, err2 = p.handler.RestoreContent(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RestoreContent: "+err2.Error())
+		oprot.WriteMessageBegin("RestoreContent", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("RestoreContent", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
 }
 
-type AdminAuditServiceGetCourseCommentAuditListArgs struct {
-	Req *GetCourseCommentAuditListReq `thrift:"req,1"`
+type BulkModerationServiceBatchAuditReviewsArgs struct {
+	Req *BatchAuditReviewsReq `thrift:"req,1"`
 }
 
-func NewAdminAuditServiceGetCourseCommentAuditListArgs() *AdminAuditServiceGetCourseCommentAuditListArgs {
-	return &AdminAuditServiceGetCourseCommentAuditListArgs{}
+func NewBulkModerationServiceBatchAuditReviewsArgs() *BulkModerationServiceBatchAuditReviewsArgs {
+	return &BulkModerationServiceBatchAuditReviewsArgs{}
 }
 
-func (p *AdminAuditServiceGetCourseCommentAuditListArgs) InitDefault() {
+func (p *BulkModerationServiceBatchAuditReviewsArgs) InitDefault() {
 }
 
-var AdminAuditServiceGetCourseCommentAuditListArgs_Req_DEFAULT *GetCourseCommentAuditListReq
+var BulkModerationServiceBatchAuditReviewsArgs_Req_DEFAULT *BatchAuditReviewsReq
 
-func (p *AdminAuditServiceGetCourseCommentAuditListArgs) GetReq() (v *GetCourseCommentAuditListReq) {
+func (p *BulkModerationServiceBatchAuditReviewsArgs) GetReq() (v *BatchAuditReviewsReq) {
 	if !p.IsSetReq() {
-		return AdminAuditServiceGetCourseCommentAuditListArgs_Req_DEFAULT
+		return BulkModerationServiceBatchAuditReviewsArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_AdminAuditServiceGetCourseCommentAuditListArgs = map[int16]string{
+var fieldIDToName_BulkModerationServiceBatchAuditReviewsArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *AdminAuditServiceGetCourseCommentAuditListArgs) IsSetReq() bool {
+func (p *BulkModerationServiceBatchAuditReviewsArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *AdminAuditServiceGetCourseCommentAuditListArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *BulkModerationServiceBatchAuditReviewsArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5724,7 +25569,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminAuditServiceGetCourseCommentAuditListArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BulkModerationServiceBatchAuditReviewsArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5734,8 +25579,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *AdminAuditServiceGetCourseCommentAuditListArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewGetCourseCommentAuditListReq()
+func (p *BulkModerationServiceBatchAuditReviewsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewBatchAuditReviewsReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -5743,9 +25588,9 @@ func (p *AdminAuditServiceGetCourseCommentAuditListArgs) ReadField1(iprot thrift
 	return nil
 }
 
-func (p *AdminAuditServiceGetCourseCommentAuditListArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *BulkModerationServiceBatchAuditReviewsArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetCourseCommentAuditList_args"); err != nil {
+	if err = oprot.WriteStructBegin("BatchAuditReviews_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5771,7 +25616,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AdminAuditServiceGetCourseCommentAuditListArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *BulkModerationServiceBatchAuditReviewsArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -5788,43 +25633,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *AdminAuditServiceGetCourseCommentAuditListArgs) String() string {
+func (p *BulkModerationServiceBatchAuditReviewsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AdminAuditServiceGetCourseCommentAuditListArgs(%+v)", *p)
+	return fmt.Sprintf("BulkModerationServiceBatchAuditReviewsArgs(%+v)", *p)
 
 }
 
-type AdminAuditServiceGetCourseCommentAuditListResult struct {
-	Success *GetCourseCommentAuditListResp `thrift:"success,0,optional"`
+type BulkModerationServiceBatchAuditReviewsResult struct {
+	Success *BatchAuditReviewsResp `thrift:"success,0,optional"`
 }
 
-func NewAdminAuditServiceGetCourseCommentAuditListResult() *AdminAuditServiceGetCourseCommentAuditListResult {
-	return &AdminAuditServiceGetCourseCommentAuditListResult{}
+func NewBulkModerationServiceBatchAuditReviewsResult() *BulkModerationServiceBatchAuditReviewsResult {
+	return &BulkModerationServiceBatchAuditReviewsResult{}
 }
 
-func (p *AdminAuditServiceGetCourseCommentAuditListResult) InitDefault() {
+func (p *BulkModerationServiceBatchAuditReviewsResult) InitDefault() {
 }
 
-var AdminAuditServiceGetCourseCommentAuditListResult_Success_DEFAULT *GetCourseCommentAuditListResp
+var BulkModerationServiceBatchAuditReviewsResult_Success_DEFAULT *BatchAuditReviewsResp
 
-func (p *AdminAuditServiceGetCourseCommentAuditListResult) GetSuccess() (v *GetCourseCommentAuditListResp) {
+func (p *BulkModerationServiceBatchAuditReviewsResult) GetSuccess() (v *BatchAuditReviewsResp) {
 	if !p.IsSetSuccess() {
-		return AdminAuditServiceGetCourseCommentAuditListResult_Success_DEFAULT
+		return BulkModerationServiceBatchAuditReviewsResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_AdminAuditServiceGetCourseCommentAuditListResult = map[int16]string{
+var fieldIDToName_BulkModerationServiceBatchAuditReviewsResult = map[int16]string{
 	0: "success",
 }
 
-func (p *AdminAuditServiceGetCourseCommentAuditListResult) IsSetSuccess() bool {
+func (p *BulkModerationServiceBatchAuditReviewsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *AdminAuditServiceGetCourseCommentAuditListResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *BulkModerationServiceBatchAuditReviewsResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5870,7 +25715,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminAuditServiceGetCourseCommentAuditListResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BulkModerationServiceBatchAuditReviewsResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5880,8 +25725,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *AdminAuditServiceGetCourseCommentAuditListResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewGetCourseCommentAuditListResp()
+func (p *BulkModerationServiceBatchAuditReviewsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewBatchAuditReviewsResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -5889,9 +25734,9 @@ func (p *AdminAuditServiceGetCourseCommentAuditListResult) ReadField0(iprot thri
 	return nil
 }
 
-func (p *AdminAuditServiceGetCourseCommentAuditListResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *BulkModerationServiceBatchAuditReviewsResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetCourseCommentAuditList_result"); err != nil {
+	if err = oprot.WriteStructBegin("BatchAuditReviews_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5917,7 +25762,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AdminAuditServiceGetCourseCommentAuditListResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *BulkModerationServiceBatchAuditReviewsResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -5936,43 +25781,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *AdminAuditServiceGetCourseCommentAuditListResult) String() string {
+func (p *BulkModerationServiceBatchAuditReviewsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AdminAuditServiceGetCourseCommentAuditListResult(%+v)", *p)
+	return fmt.Sprintf("BulkModerationServiceBatchAuditReviewsResult(%+v)", *p)
 
 }
 
-type AdminAuditServiceGetResourceCommentAuditListArgs struct {
-	Req *GetResourceCommentAuditListReq `thrift:"req,1"`
+type BulkModerationServiceHideUserCommentsArgs struct {
+	Req *HideUserCommentsReq `thrift:"req,1"`
 }
 
-func NewAdminAuditServiceGetResourceCommentAuditListArgs() *AdminAuditServiceGetResourceCommentAuditListArgs {
-	return &AdminAuditServiceGetResourceCommentAuditListArgs{}
+func NewBulkModerationServiceHideUserCommentsArgs() *BulkModerationServiceHideUserCommentsArgs {
+	return &BulkModerationServiceHideUserCommentsArgs{}
 }
 
-func (p *AdminAuditServiceGetResourceCommentAuditListArgs) InitDefault() {
+func (p *BulkModerationServiceHideUserCommentsArgs) InitDefault() {
 }
 
-var AdminAuditServiceGetResourceCommentAuditListArgs_Req_DEFAULT *GetResourceCommentAuditListReq
+var BulkModerationServiceHideUserCommentsArgs_Req_DEFAULT *HideUserCommentsReq
 
-func (p *AdminAuditServiceGetResourceCommentAuditListArgs) GetReq() (v *GetResourceCommentAuditListReq) {
+func (p *BulkModerationServiceHideUserCommentsArgs) GetReq() (v *HideUserCommentsReq) {
 	if !p.IsSetReq() {
-		return AdminAuditServiceGetResourceCommentAuditListArgs_Req_DEFAULT
+		return BulkModerationServiceHideUserCommentsArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_AdminAuditServiceGetResourceCommentAuditListArgs = map[int16]string{
+var fieldIDToName_BulkModerationServiceHideUserCommentsArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *AdminAuditServiceGetResourceCommentAuditListArgs) IsSetReq() bool {
+func (p *BulkModerationServiceHideUserCommentsArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *AdminAuditServiceGetResourceCommentAuditListArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *BulkModerationServiceHideUserCommentsArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6018,7 +25863,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminAuditServiceGetResourceCommentAuditListArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BulkModerationServiceHideUserCommentsArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6028,8 +25873,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *AdminAuditServiceGetResourceCommentAuditListArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewGetResourceCommentAuditListReq()
+func (p *BulkModerationServiceHideUserCommentsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewHideUserCommentsReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -6037,9 +25882,9 @@ func (p *AdminAuditServiceGetResourceCommentAuditListArgs) ReadField1(iprot thri
 	return nil
 }
 
-func (p *AdminAuditServiceGetResourceCommentAuditListArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *BulkModerationServiceHideUserCommentsArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetResourceCommentAuditList_args"); err != nil {
+	if err = oprot.WriteStructBegin("HideUserComments_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6065,7 +25910,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AdminAuditServiceGetResourceCommentAuditListArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *BulkModerationServiceHideUserCommentsArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -6082,43 +25927,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *AdminAuditServiceGetResourceCommentAuditListArgs) String() string {
+func (p *BulkModerationServiceHideUserCommentsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AdminAuditServiceGetResourceCommentAuditListArgs(%+v)", *p)
+	return fmt.Sprintf("BulkModerationServiceHideUserCommentsArgs(%+v)", *p)
 
 }
 
-type AdminAuditServiceGetResourceCommentAuditListResult struct {
-	Success *GetResourceCommentAuditListResp `thrift:"success,0,optional"`
+type BulkModerationServiceHideUserCommentsResult struct {
+	Success *HideUserCommentsResp `thrift:"success,0,optional"`
 }
 
-func NewAdminAuditServiceGetResourceCommentAuditListResult() *AdminAuditServiceGetResourceCommentAuditListResult {
-	return &AdminAuditServiceGetResourceCommentAuditListResult{}
+func NewBulkModerationServiceHideUserCommentsResult() *BulkModerationServiceHideUserCommentsResult {
+	return &BulkModerationServiceHideUserCommentsResult{}
 }
 
-func (p *AdminAuditServiceGetResourceCommentAuditListResult) InitDefault() {
+func (p *BulkModerationServiceHideUserCommentsResult) InitDefault() {
 }
 
-var AdminAuditServiceGetResourceCommentAuditListResult_Success_DEFAULT *GetResourceCommentAuditListResp
+var BulkModerationServiceHideUserCommentsResult_Success_DEFAULT *HideUserCommentsResp
 
-func (p *AdminAuditServiceGetResourceCommentAuditListResult) GetSuccess() (v *GetResourceCommentAuditListResp) {
+func (p *BulkModerationServiceHideUserCommentsResult) GetSuccess() (v *HideUserCommentsResp) {
 	if !p.IsSetSuccess() {
-		return AdminAuditServiceGetResourceCommentAuditListResult_Success_DEFAULT
+		return BulkModerationServiceHideUserCommentsResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_AdminAuditServiceGetResourceCommentAuditListResult = map[int16]string{
+var fieldIDToName_BulkModerationServiceHideUserCommentsResult = map[int16]string{
 	0: "success",
 }
 
-func (p *AdminAuditServiceGetResourceCommentAuditListResult) IsSetSuccess() bool {
+func (p *BulkModerationServiceHideUserCommentsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *AdminAuditServiceGetResourceCommentAuditListResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *BulkModerationServiceHideUserCommentsResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6164,7 +26009,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminAuditServiceGetResourceCommentAuditListResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BulkModerationServiceHideUserCommentsResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6174,8 +26019,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *AdminAuditServiceGetResourceCommentAuditListResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewGetResourceCommentAuditListResp()
+func (p *BulkModerationServiceHideUserCommentsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewHideUserCommentsResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -6183,9 +26028,9 @@ func (p *AdminAuditServiceGetResourceCommentAuditListResult) ReadField0(iprot th
 	return nil
 }
 
-func (p *AdminAuditServiceGetResourceCommentAuditListResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *BulkModerationServiceHideUserCommentsResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetResourceCommentAuditList_result"); err != nil {
+	if err = oprot.WriteStructBegin("HideUserComments_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6211,7 +26056,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AdminAuditServiceGetResourceCommentAuditListResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *BulkModerationServiceHideUserCommentsResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -6230,43 +26075,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *AdminAuditServiceGetResourceCommentAuditListResult) String() string {
+func (p *BulkModerationServiceHideUserCommentsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AdminAuditServiceGetResourceCommentAuditListResult(%+v)", *p)
+	return fmt.Sprintf("BulkModerationServiceHideUserCommentsResult(%+v)", *p)
 
 }
 
-type AdminAuditServiceAuditCourseCommentArgs struct {
-	Req *AuditCourseCommentReq `thrift:"req,1"`
+type BulkModerationServiceRestoreContentArgs struct {
+	Req *RestoreContentReq `thrift:"req,1"`
 }
 
-func NewAdminAuditServiceAuditCourseCommentArgs() *AdminAuditServiceAuditCourseCommentArgs {
-	return &AdminAuditServiceAuditCourseCommentArgs{}
+func NewBulkModerationServiceRestoreContentArgs() *BulkModerationServiceRestoreContentArgs {
+	return &BulkModerationServiceRestoreContentArgs{}
 }
 
-func (p *AdminAuditServiceAuditCourseCommentArgs) InitDefault() {
+func (p *BulkModerationServiceRestoreContentArgs) InitDefault() {
 }
 
-var AdminAuditServiceAuditCourseCommentArgs_Req_DEFAULT *AuditCourseCommentReq
+var BulkModerationServiceRestoreContentArgs_Req_DEFAULT *RestoreContentReq
 
-func (p *AdminAuditServiceAuditCourseCommentArgs) GetReq() (v *AuditCourseCommentReq) {
+func (p *BulkModerationServiceRestoreContentArgs) GetReq() (v *RestoreContentReq) {
 	if !p.IsSetReq() {
-		return AdminAuditServiceAuditCourseCommentArgs_Req_DEFAULT
+		return BulkModerationServiceRestoreContentArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_AdminAuditServiceAuditCourseCommentArgs = map[int16]string{
+var fieldIDToName_BulkModerationServiceRestoreContentArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *AdminAuditServiceAuditCourseCommentArgs) IsSetReq() bool {
+func (p *BulkModerationServiceRestoreContentArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *AdminAuditServiceAuditCourseCommentArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *BulkModerationServiceRestoreContentArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6312,7 +26157,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminAuditServiceAuditCourseCommentArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BulkModerationServiceRestoreContentArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6322,8 +26167,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *AdminAuditServiceAuditCourseCommentArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewAuditCourseCommentReq()
+func (p *BulkModerationServiceRestoreContentArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewRestoreContentReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -6331,9 +26176,9 @@ func (p *AdminAuditServiceAuditCourseCommentArgs) ReadField1(iprot thrift.TProto
 	return nil
 }
 
-func (p *AdminAuditServiceAuditCourseCommentArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *BulkModerationServiceRestoreContentArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("AuditCourseComment_args"); err != nil {
+	if err = oprot.WriteStructBegin("RestoreContent_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6359,7 +26204,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AdminAuditServiceAuditCourseCommentArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *BulkModerationServiceRestoreContentArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -6376,43 +26221,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *AdminAuditServiceAuditCourseCommentArgs) String() string {
+func (p *BulkModerationServiceRestoreContentArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AdminAuditServiceAuditCourseCommentArgs(%+v)", *p)
+	return fmt.Sprintf("BulkModerationServiceRestoreContentArgs(%+v)", *p)
 
 }
 
-type AdminAuditServiceAuditCourseCommentResult struct {
-	Success *AuditCourseCommentResp `thrift:"success,0,optional"`
+type BulkModerationServiceRestoreContentResult struct {
+	Success *RestoreContentResp `thrift:"success,0,optional"`
 }
 
-func NewAdminAuditServiceAuditCourseCommentResult() *AdminAuditServiceAuditCourseCommentResult {
-	return &AdminAuditServiceAuditCourseCommentResult{}
+func NewBulkModerationServiceRestoreContentResult() *BulkModerationServiceRestoreContentResult {
+	return &BulkModerationServiceRestoreContentResult{}
 }
 
-func (p *AdminAuditServiceAuditCourseCommentResult) InitDefault() {
+func (p *BulkModerationServiceRestoreContentResult) InitDefault() {
 }
 
-var AdminAuditServiceAuditCourseCommentResult_Success_DEFAULT *AuditCourseCommentResp
+var BulkModerationServiceRestoreContentResult_Success_DEFAULT *RestoreContentResp
 
-func (p *AdminAuditServiceAuditCourseCommentResult) GetSuccess() (v *AuditCourseCommentResp) {
+func (p *BulkModerationServiceRestoreContentResult) GetSuccess() (v *RestoreContentResp) {
 	if !p.IsSetSuccess() {
-		return AdminAuditServiceAuditCourseCommentResult_Success_DEFAULT
+		return BulkModerationServiceRestoreContentResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_AdminAuditServiceAuditCourseCommentResult = map[int16]string{
+var fieldIDToName_BulkModerationServiceRestoreContentResult = map[int16]string{
 	0: "success",
 }
 
-func (p *AdminAuditServiceAuditCourseCommentResult) IsSetSuccess() bool {
+func (p *BulkModerationServiceRestoreContentResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *AdminAuditServiceAuditCourseCommentResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *BulkModerationServiceRestoreContentResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6458,7 +26303,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminAuditServiceAuditCourseCommentResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BulkModerationServiceRestoreContentResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6468,8 +26313,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *AdminAuditServiceAuditCourseCommentResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewAuditCourseCommentResp()
+func (p *BulkModerationServiceRestoreContentResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewRestoreContentResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -6477,9 +26322,9 @@ func (p *AdminAuditServiceAuditCourseCommentResult) ReadField0(iprot thrift.TPro
 	return nil
 }
 
-func (p *AdminAuditServiceAuditCourseCommentResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *BulkModerationServiceRestoreContentResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("AuditCourseComment_result"); err != nil {
+	if err = oprot.WriteStructBegin("RestoreContent_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6505,7 +26350,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AdminAuditServiceAuditCourseCommentResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *BulkModerationServiceRestoreContentResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -6524,43 +26369,132 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *AdminAuditServiceAuditCourseCommentResult) String() string {
+func (p *BulkModerationServiceRestoreContentResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AdminAuditServiceAuditCourseCommentResult(%+v)", *p)
+	return fmt.Sprintf("BulkModerationServiceRestoreContentResult(%+v)", *p)
 
 }
 
-type AdminAuditServiceAuditResourceCommentArgs struct {
-	Req *AuditResourceCommentReq `thrift:"req,1"`
+type ModerationStatsServiceProcessor struct {
+	processorMap map[string]thrift.TProcessorFunction
+	handler      ModerationStatsService
 }
 
-func NewAdminAuditServiceAuditResourceCommentArgs() *AdminAuditServiceAuditResourceCommentArgs {
-	return &AdminAuditServiceAuditResourceCommentArgs{}
+func (p *ModerationStatsServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
+	p.processorMap[key] = processor
 }
 
-func (p *AdminAuditServiceAuditResourceCommentArgs) InitDefault() {
+func (p *ModerationStatsServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
+	processor, ok = p.processorMap[key]
+	return processor, ok
 }
 
-var AdminAuditServiceAuditResourceCommentArgs_Req_DEFAULT *AuditResourceCommentReq
+func (p *ModerationStatsServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
+	return p.processorMap
+}
 
-func (p *AdminAuditServiceAuditResourceCommentArgs) GetReq() (v *AuditResourceCommentReq) {
+func NewModerationStatsServiceProcessor(handler ModerationStatsService) *ModerationStatsServiceProcessor {
+	self := &ModerationStatsServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
+	self.AddToProcessorMap("GetModerationStats", &moderationStatsServiceProcessorGetModerationStats{handler: handler})
+	return self
+}
+func (p *ModerationStatsServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	name, _, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return false, err
+	}
+	if processor, ok := p.GetProcessorFunction(name); ok {
+		return processor.Process(ctx, seqId, iprot, oprot)
+	}
+	iprot.Skip(thrift.STRUCT)
+	iprot.ReadMessageEnd()
+	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
+	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
+	x.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Flush(ctx)
+	return false, x
+}
+
+type moderationStatsServiceProcessorGetModerationStats struct {
+	handler ModerationStatsService
+}
+
+func (p *moderationStatsServiceProcessorGetModerationStats) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ModerationStatsServiceGetModerationStatsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetModerationStats", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ModerationStatsServiceGetModerationStatsResult{}
+	var retval *GetModerationStatsResp
+	if retval, err2 = p.handler.GetModerationStats(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetModerationStats: "+err2.Error())
+		oprot.WriteMessageBegin("GetModerationStats", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetModerationStats", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type ModerationStatsServiceGetModerationStatsArgs struct {
+	Req *GetModerationStatsReq `thrift:"req,1"`
+}
+
+func NewModerationStatsServiceGetModerationStatsArgs() *ModerationStatsServiceGetModerationStatsArgs {
+	return &ModerationStatsServiceGetModerationStatsArgs{}
+}
+
+func (p *ModerationStatsServiceGetModerationStatsArgs) InitDefault() {
+}
+
+var ModerationStatsServiceGetModerationStatsArgs_Req_DEFAULT *GetModerationStatsReq
+
+func (p *ModerationStatsServiceGetModerationStatsArgs) GetReq() (v *GetModerationStatsReq) {
 	if !p.IsSetReq() {
-		return AdminAuditServiceAuditResourceCommentArgs_Req_DEFAULT
+		return ModerationStatsServiceGetModerationStatsArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_AdminAuditServiceAuditResourceCommentArgs = map[int16]string{
+var fieldIDToName_ModerationStatsServiceGetModerationStatsArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *AdminAuditServiceAuditResourceCommentArgs) IsSetReq() bool {
+func (p *ModerationStatsServiceGetModerationStatsArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *AdminAuditServiceAuditResourceCommentArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ModerationStatsServiceGetModerationStatsArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6606,7 +26540,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminAuditServiceAuditResourceCommentArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ModerationStatsServiceGetModerationStatsArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6616,8 +26550,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *AdminAuditServiceAuditResourceCommentArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewAuditResourceCommentReq()
+func (p *ModerationStatsServiceGetModerationStatsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetModerationStatsReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -6625,9 +26559,9 @@ func (p *AdminAuditServiceAuditResourceCommentArgs) ReadField1(iprot thrift.TPro
 	return nil
 }
 
-func (p *AdminAuditServiceAuditResourceCommentArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ModerationStatsServiceGetModerationStatsArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("AuditResourceComment_args"); err != nil {
+	if err = oprot.WriteStructBegin("GetModerationStats_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6653,7 +26587,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AdminAuditServiceAuditResourceCommentArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ModerationStatsServiceGetModerationStatsArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -6670,43 +26604,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *AdminAuditServiceAuditResourceCommentArgs) String() string {
+func (p *ModerationStatsServiceGetModerationStatsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AdminAuditServiceAuditResourceCommentArgs(%+v)", *p)
+	return fmt.Sprintf("ModerationStatsServiceGetModerationStatsArgs(%+v)", *p)
 
 }
 
-type AdminAuditServiceAuditResourceCommentResult struct {
-	Success *AuditResourceCommentResp `thrift:"success,0,optional"`
+type ModerationStatsServiceGetModerationStatsResult struct {
+	Success *GetModerationStatsResp `thrift:"success,0,optional"`
 }
 
-func NewAdminAuditServiceAuditResourceCommentResult() *AdminAuditServiceAuditResourceCommentResult {
-	return &AdminAuditServiceAuditResourceCommentResult{}
+func NewModerationStatsServiceGetModerationStatsResult() *ModerationStatsServiceGetModerationStatsResult {
+	return &ModerationStatsServiceGetModerationStatsResult{}
 }
 
-func (p *AdminAuditServiceAuditResourceCommentResult) InitDefault() {
+func (p *ModerationStatsServiceGetModerationStatsResult) InitDefault() {
 }
 
-var AdminAuditServiceAuditResourceCommentResult_Success_DEFAULT *AuditResourceCommentResp
+var ModerationStatsServiceGetModerationStatsResult_Success_DEFAULT *GetModerationStatsResp
 
-func (p *AdminAuditServiceAuditResourceCommentResult) GetSuccess() (v *AuditResourceCommentResp) {
+func (p *ModerationStatsServiceGetModerationStatsResult) GetSuccess() (v *GetModerationStatsResp) {
 	if !p.IsSetSuccess() {
-		return AdminAuditServiceAuditResourceCommentResult_Success_DEFAULT
+		return ModerationStatsServiceGetModerationStatsResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_AdminAuditServiceAuditResourceCommentResult = map[int16]string{
+var fieldIDToName_ModerationStatsServiceGetModerationStatsResult = map[int16]string{
 	0: "success",
 }
 
-func (p *AdminAuditServiceAuditResourceCommentResult) IsSetSuccess() bool {
+func (p *ModerationStatsServiceGetModerationStatsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *AdminAuditServiceAuditResourceCommentResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ModerationStatsServiceGetModerationStatsResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6752,7 +26686,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminAuditServiceAuditResourceCommentResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ModerationStatsServiceGetModerationStatsResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6762,8 +26696,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *AdminAuditServiceAuditResourceCommentResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewAuditResourceCommentResp()
+func (p *ModerationStatsServiceGetModerationStatsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetModerationStatsResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -6771,9 +26705,9 @@ func (p *AdminAuditServiceAuditResourceCommentResult) ReadField0(iprot thrift.TP
 	return nil
 }
 
-func (p *AdminAuditServiceAuditResourceCommentResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ModerationStatsServiceGetModerationStatsResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("AuditResourceComment_result"); err != nil {
+	if err = oprot.WriteStructBegin("GetModerationStats_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6799,7 +26733,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AdminAuditServiceAuditResourceCommentResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ModerationStatsServiceGetModerationStatsResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -6818,10 +26752,10 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *AdminAuditServiceAuditResourceCommentResult) String() string {
+func (p *ModerationStatsServiceGetModerationStatsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AdminAuditServiceAuditResourceCommentResult(%+v)", *p)
+	return fmt.Sprintf("ModerationStatsServiceGetModerationStatsResult(%+v)", *p)
 
 }
@@ -4074,30 +4074,38 @@ func (p *SubmitCourseCommentReactionResp) String() string {
 
 }
 
-type AdminDeleteCourseCommentReq struct {
-	CommentID int64 `thrift:"comment_id,1,required" json:"comment_id,required" path:"comment_id,required"`
+// 举报课程评分
+type ReportCourseRatingReq struct {
+	RatingID int64  `thrift:"rating_id,1,required" json:"rating_id,required" path:"rating_id,required"`
+	Reason   string `thrift:"reason,2,required" form:"reason,required" json:"reason,required"`
 }
 
-func NewAdminDeleteCourseCommentReq() *AdminDeleteCourseCommentReq {
-	return &AdminDeleteCourseCommentReq{}
+func NewReportCourseRatingReq() *ReportCourseRatingReq {
+	return &ReportCourseRatingReq{}
 }
 
-func (p *AdminDeleteCourseCommentReq) InitDefault() {
+func (p *ReportCourseRatingReq) InitDefault() {
 }
 
-func (p *AdminDeleteCourseCommentReq) GetCommentID() (v int64) {
-	return p.CommentID
+func (p *ReportCourseRatingReq) GetRatingID() (v int64) {
+	return p.RatingID
 }
 
-var fieldIDToName_AdminDeleteCourseCommentReq = map[int16]string{
-	1: "comment_id",
+func (p *ReportCourseRatingReq) GetReason() (v string) {
+	return p.Reason
 }
 
-func (p *AdminDeleteCourseCommentReq) Read(iprot thrift.TProtocol) (err error) {
+var fieldIDToName_ReportCourseRatingReq = map[int16]string{
+	1: "rating_id",
+	2: "reason",
+}
+
+func (p *ReportCourseRatingReq) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetCommentID bool = false
+	var issetRatingID bool = false
+	var issetReason bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -4118,7 +4126,16 @@ func (p *AdminDeleteCourseCommentReq) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetCommentID = true
+				issetRatingID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetReason = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -4135,17 +4152,22 @@ func (p *AdminDeleteCourseCommentReq) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
-	if !issetCommentID {
+	if !issetRatingID {
 		fieldId = 1
 		goto RequiredFieldNotSetError
 	}
+
+	if !issetReason {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminDeleteCourseCommentReq[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ReportCourseRatingReq[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4154,10 +4176,10 @@ ReadFieldEndError:
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_AdminDeleteCourseCommentReq[fieldId]))
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ReportCourseRatingReq[fieldId]))
 }
 
-func (p *AdminDeleteCourseCommentReq) ReadField1(iprot thrift.TProtocol) error {
+func (p *ReportCourseRatingReq) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -4165,13 +4187,24 @@ func (p *AdminDeleteCourseCommentReq) ReadField1(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.CommentID = _field
+	p.RatingID = _field
 	return nil
 }
+func (p *ReportCourseRatingReq) ReadField2(iprot thrift.TProtocol) error {
 
-func (p *AdminDeleteCourseCommentReq) Write(oprot thrift.TProtocol) (err error) {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Reason = _field
+	return nil
+}
+
+func (p *ReportCourseRatingReq) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("AdminDeleteCourseCommentReq"); err != nil {
+	if err = oprot.WriteStructBegin("ReportCourseRatingReq"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4179,6 +4212,10 @@ func (p *AdminDeleteCourseCommentReq) Write(oprot thrift.TProtocol) (err error)
 			fieldId = 1
 			goto WriteFieldError
 		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -4197,11 +4234,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AdminDeleteCourseCommentReq) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("comment_id", thrift.I64, 1); err != nil {
+func (p *ReportCourseRatingReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("rating_id", thrift.I64, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.CommentID); err != nil {
+	if err := oprot.WriteI64(p.RatingID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -4214,43 +4251,60 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *AdminDeleteCourseCommentReq) String() string {
+func (p *ReportCourseRatingReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("reason", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Reason); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *ReportCourseRatingReq) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AdminDeleteCourseCommentReq(%+v)", *p)
+	return fmt.Sprintf("ReportCourseRatingReq(%+v)", *p)
 
 }
 
-type AdminDeleteCourseCommentResp struct {
-	BaseResp *module.BaseResp `thrift:"base_resp,1,required" form:"base_resp,required" json:"base_resp,required" query:"base_resp,required"`
+type ReportCourseRatingResp struct {
+	BaseResp *module.BaseResp `thrift:"baseResp,1,required" form:"baseResp,required" json:"baseResp,required" query:"baseResp,required"`
 }
 
-func NewAdminDeleteCourseCommentResp() *AdminDeleteCourseCommentResp {
-	return &AdminDeleteCourseCommentResp{}
+func NewReportCourseRatingResp() *ReportCourseRatingResp {
+	return &ReportCourseRatingResp{}
 }
 
-func (p *AdminDeleteCourseCommentResp) InitDefault() {
+func (p *ReportCourseRatingResp) InitDefault() {
 }
 
-var AdminDeleteCourseCommentResp_BaseResp_DEFAULT *module.BaseResp
+var ReportCourseRatingResp_BaseResp_DEFAULT *module.BaseResp
 
-func (p *AdminDeleteCourseCommentResp) GetBaseResp() (v *module.BaseResp) {
+func (p *ReportCourseRatingResp) GetBaseResp() (v *module.BaseResp) {
 	if !p.IsSetBaseResp() {
-		return AdminDeleteCourseCommentResp_BaseResp_DEFAULT
+		return ReportCourseRatingResp_BaseResp_DEFAULT
 	}
 	return p.BaseResp
 }
 
-var fieldIDToName_AdminDeleteCourseCommentResp = map[int16]string{
-	1: "base_resp",
+var fieldIDToName_ReportCourseRatingResp = map[int16]string{
+	1: "baseResp",
 }
 
-func (p *AdminDeleteCourseCommentResp) IsSetBaseResp() bool {
+func (p *ReportCourseRatingResp) IsSetBaseResp() bool {
 	return p.BaseResp != nil
 }
 
-func (p *AdminDeleteCourseCommentResp) Read(iprot thrift.TProtocol) (err error) {
+func (p *ReportCourseRatingResp) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4302,7 +4356,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminDeleteCourseCommentResp[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ReportCourseRatingResp[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4311,10 +4365,10 @@ ReadFieldEndError:
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_AdminDeleteCourseCommentResp[fieldId]))
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ReportCourseRatingResp[fieldId]))
 }
 
-func (p *AdminDeleteCourseCommentResp) ReadField1(iprot thrift.TProtocol) error {
+func (p *ReportCourseRatingResp) ReadField1(iprot thrift.TProtocol) error {
 	_field := module.NewBaseResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -4323,9 +4377,9 @@ func (p *AdminDeleteCourseCommentResp) ReadField1(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *AdminDeleteCourseCommentResp) Write(oprot thrift.TProtocol) (err error) {
+func (p *ReportCourseRatingResp) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("AdminDeleteCourseCommentResp"); err != nil {
+	if err = oprot.WriteStructBegin("ReportCourseRatingResp"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4351,8 +4405,8 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AdminDeleteCourseCommentResp) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+func (p *ReportCourseRatingResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("baseResp", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
 	if err := p.BaseResp.Write(oprot); err != nil {
@@ -4368,38 +4422,46 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *AdminDeleteCourseCommentResp) String() string {
+func (p *ReportCourseRatingResp) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AdminDeleteCourseCommentResp(%+v)", *p)
+	return fmt.Sprintf("ReportCourseRatingResp(%+v)", *p)
 
 }
 
-type AdminDeleteCourseRatingReq struct {
-	RatingID int64 `thrift:"rating_id,1,required" json:"rating_id,required" path:"rating_id,required"`
+// 举报课程评论
+type ReportCourseCommentReq struct {
+	CommentID int64  `thrift:"comment_id,1,required" json:"comment_id,required" path:"comment_id,required"`
+	Reason    string `thrift:"reason,2,required" form:"reason,required" json:"reason,required"`
 }
 
-func NewAdminDeleteCourseRatingReq() *AdminDeleteCourseRatingReq {
-	return &AdminDeleteCourseRatingReq{}
+func NewReportCourseCommentReq() *ReportCourseCommentReq {
+	return &ReportCourseCommentReq{}
 }
 
-func (p *AdminDeleteCourseRatingReq) InitDefault() {
+func (p *ReportCourseCommentReq) InitDefault() {
 }
 
-func (p *AdminDeleteCourseRatingReq) GetRatingID() (v int64) {
-	return p.RatingID
+func (p *ReportCourseCommentReq) GetCommentID() (v int64) {
+	return p.CommentID
 }
 
-var fieldIDToName_AdminDeleteCourseRatingReq = map[int16]string{
-	1: "rating_id",
+func (p *ReportCourseCommentReq) GetReason() (v string) {
+	return p.Reason
 }
 
-func (p *AdminDeleteCourseRatingReq) Read(iprot thrift.TProtocol) (err error) {
+var fieldIDToName_ReportCourseCommentReq = map[int16]string{
+	1: "comment_id",
+	2: "reason",
+}
+
+func (p *ReportCourseCommentReq) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetRatingID bool = false
+	var issetCommentID bool = false
+	var issetReason bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -4420,7 +4482,16 @@ func (p *AdminDeleteCourseRatingReq) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetRatingID = true
+				issetCommentID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetReason = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -4437,17 +4508,22 @@ func (p *AdminDeleteCourseRatingReq) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
-	if !issetRatingID {
+	if !issetCommentID {
 		fieldId = 1
 		goto RequiredFieldNotSetError
 	}
+
+	if !issetReason {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminDeleteCourseRatingReq[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ReportCourseCommentReq[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4456,10 +4532,10 @@ ReadFieldEndError:
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_AdminDeleteCourseRatingReq[fieldId]))
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ReportCourseCommentReq[fieldId]))
 }
 
-func (p *AdminDeleteCourseRatingReq) ReadField1(iprot thrift.TProtocol) error {
+func (p *ReportCourseCommentReq) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -4467,13 +4543,24 @@ func (p *AdminDeleteCourseRatingReq) ReadField1(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.RatingID = _field
+	p.CommentID = _field
 	return nil
 }
+func (p *ReportCourseCommentReq) ReadField2(iprot thrift.TProtocol) error {
 
-func (p *AdminDeleteCourseRatingReq) Write(oprot thrift.TProtocol) (err error) {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Reason = _field
+	return nil
+}
+
+func (p *ReportCourseCommentReq) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("AdminDeleteCourseRatingReq"); err != nil {
+	if err = oprot.WriteStructBegin("ReportCourseCommentReq"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4481,6 +4568,10 @@ func (p *AdminDeleteCourseRatingReq) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -4499,11 +4590,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AdminDeleteCourseRatingReq) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("rating_id", thrift.I64, 1); err != nil {
+func (p *ReportCourseCommentReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("comment_id", thrift.I64, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.RatingID); err != nil {
+	if err := oprot.WriteI64(p.CommentID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -4516,43 +4607,60 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *AdminDeleteCourseRatingReq) String() string {
+func (p *ReportCourseCommentReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("reason", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Reason); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *ReportCourseCommentReq) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AdminDeleteCourseRatingReq(%+v)", *p)
+	return fmt.Sprintf("ReportCourseCommentReq(%+v)", *p)
 
 }
 
-type AdminDeleteCourseRatingResp struct {
-	BaseResp *module.BaseResp `thrift:"base_resp,1,required" form:"base_resp,required" json:"base_resp,required" query:"base_resp,required"`
+type ReportCourseCommentResp struct {
+	BaseResp *module.BaseResp `thrift:"baseResp,1,required" form:"baseResp,required" json:"baseResp,required" query:"baseResp,required"`
 }
 
-func NewAdminDeleteCourseRatingResp() *AdminDeleteCourseRatingResp {
-	return &AdminDeleteCourseRatingResp{}
+func NewReportCourseCommentResp() *ReportCourseCommentResp {
+	return &ReportCourseCommentResp{}
 }
 
-func (p *AdminDeleteCourseRatingResp) InitDefault() {
+func (p *ReportCourseCommentResp) InitDefault() {
 }
 
-var AdminDeleteCourseRatingResp_BaseResp_DEFAULT *module.BaseResp
+var ReportCourseCommentResp_BaseResp_DEFAULT *module.BaseResp
 
-func (p *AdminDeleteCourseRatingResp) GetBaseResp() (v *module.BaseResp) {
+func (p *ReportCourseCommentResp) GetBaseResp() (v *module.BaseResp) {
 	if !p.IsSetBaseResp() {
-		return AdminDeleteCourseRatingResp_BaseResp_DEFAULT
+		return ReportCourseCommentResp_BaseResp_DEFAULT
 	}
 	return p.BaseResp
 }
 
-var fieldIDToName_AdminDeleteCourseRatingResp = map[int16]string{
-	1: "base_resp",
+var fieldIDToName_ReportCourseCommentResp = map[int16]string{
+	1: "baseResp",
 }
 
-func (p *AdminDeleteCourseRatingResp) IsSetBaseResp() bool {
+func (p *ReportCourseCommentResp) IsSetBaseResp() bool {
 	return p.BaseResp != nil
 }
 
-func (p *AdminDeleteCourseRatingResp) Read(iprot thrift.TProtocol) (err error) {
+func (p *ReportCourseCommentResp) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4604,7 +4712,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminDeleteCourseRatingResp[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ReportCourseCommentResp[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4613,10 +4721,10 @@ ReadFieldEndError:
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_AdminDeleteCourseRatingResp[fieldId]))
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ReportCourseCommentResp[fieldId]))
 }
 
-func (p *AdminDeleteCourseRatingResp) ReadField1(iprot thrift.TProtocol) error {
+func (p *ReportCourseCommentResp) ReadField1(iprot thrift.TProtocol) error {
 	_field := module.NewBaseResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -4625,9 +4733,9 @@ func (p *AdminDeleteCourseRatingResp) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *AdminDeleteCourseRatingResp) Write(oprot thrift.TProtocol) (err error) {
+func (p *ReportCourseCommentResp) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("AdminDeleteCourseRatingResp"); err != nil {
+	if err = oprot.WriteStructBegin("ReportCourseCommentResp"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4653,8 +4761,8 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AdminDeleteCourseRatingResp) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+func (p *ReportCourseCommentResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("baseResp", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
 	if err := p.BaseResp.Write(oprot); err != nil {
@@ -4670,38 +4778,38 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *AdminDeleteCourseRatingResp) String() string {
+func (p *ReportCourseCommentResp) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AdminDeleteCourseRatingResp(%+v)", *p)
+	return fmt.Sprintf("ReportCourseCommentResp(%+v)", *p)
 
 }
 
-type AdminDeleteCourseReq struct {
-	CourseID int64 `thrift:"course_id,1,required" json:"course_id,required" path:"course_id,required"`
+type AdminDeleteCourseCommentReq struct {
+	CommentID int64 `thrift:"comment_id,1,required" json:"comment_id,required" path:"comment_id,required"`
 }
 
-func NewAdminDeleteCourseReq() *AdminDeleteCourseReq {
-	return &AdminDeleteCourseReq{}
+func NewAdminDeleteCourseCommentReq() *AdminDeleteCourseCommentReq {
+	return &AdminDeleteCourseCommentReq{}
 }
 
-func (p *AdminDeleteCourseReq) InitDefault() {
+func (p *AdminDeleteCourseCommentReq) InitDefault() {
 }
 
-func (p *AdminDeleteCourseReq) GetCourseID() (v int64) {
-	return p.CourseID
+func (p *AdminDeleteCourseCommentReq) GetCommentID() (v int64) {
+	return p.CommentID
 }
 
-var fieldIDToName_AdminDeleteCourseReq = map[int16]string{
-	1: "course_id",
+var fieldIDToName_AdminDeleteCourseCommentReq = map[int16]string{
+	1: "comment_id",
 }
 
-func (p *AdminDeleteCourseReq) Read(iprot thrift.TProtocol) (err error) {
+func (p *AdminDeleteCourseCommentReq) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetCourseID bool = false
+	var issetCommentID bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -4722,7 +4830,7 @@ func (p *AdminDeleteCourseReq) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetCourseID = true
+				issetCommentID = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -4739,7 +4847,7 @@ func (p *AdminDeleteCourseReq) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
-	if !issetCourseID {
+	if !issetCommentID {
 		fieldId = 1
 		goto RequiredFieldNotSetError
 	}
@@ -4749,7 +4857,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminDeleteCourseReq[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminDeleteCourseCommentReq[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4758,10 +4866,10 @@ ReadFieldEndError:
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_AdminDeleteCourseReq[fieldId]))
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_AdminDeleteCourseCommentReq[fieldId]))
 }
 
-func (p *AdminDeleteCourseReq) ReadField1(iprot thrift.TProtocol) error {
+func (p *AdminDeleteCourseCommentReq) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -4769,13 +4877,13 @@ func (p *AdminDeleteCourseReq) ReadField1(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.CourseID = _field
+	p.CommentID = _field
 	return nil
 }
 
-func (p *AdminDeleteCourseReq) Write(oprot thrift.TProtocol) (err error) {
+func (p *AdminDeleteCourseCommentReq) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("AdminDeleteCourseReq"); err != nil {
+	if err = oprot.WriteStructBegin("AdminDeleteCourseCommentReq"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4801,11 +4909,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AdminDeleteCourseReq) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("course_id", thrift.I64, 1); err != nil {
+func (p *AdminDeleteCourseCommentReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("comment_id", thrift.I64, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.CourseID); err != nil {
+	if err := oprot.WriteI64(p.CommentID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -4818,43 +4926,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *AdminDeleteCourseReq) String() string {
+func (p *AdminDeleteCourseCommentReq) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AdminDeleteCourseReq(%+v)", *p)
+	return fmt.Sprintf("AdminDeleteCourseCommentReq(%+v)", *p)
 
 }
 
-type AdminDeleteCourseResp struct {
+type AdminDeleteCourseCommentResp struct {
 	BaseResp *module.BaseResp `thrift:"base_resp,1,required" form:"base_resp,required" json:"base_resp,required" query:"base_resp,required"`
 }
 
-func NewAdminDeleteCourseResp() *AdminDeleteCourseResp {
-	return &AdminDeleteCourseResp{}
+func NewAdminDeleteCourseCommentResp() *AdminDeleteCourseCommentResp {
+	return &AdminDeleteCourseCommentResp{}
 }
 
-func (p *AdminDeleteCourseResp) InitDefault() {
+func (p *AdminDeleteCourseCommentResp) InitDefault() {
 }
 
-var AdminDeleteCourseResp_BaseResp_DEFAULT *module.BaseResp
+var AdminDeleteCourseCommentResp_BaseResp_DEFAULT *module.BaseResp
 
-func (p *AdminDeleteCourseResp) GetBaseResp() (v *module.BaseResp) {
+func (p *AdminDeleteCourseCommentResp) GetBaseResp() (v *module.BaseResp) {
 	if !p.IsSetBaseResp() {
-		return AdminDeleteCourseResp_BaseResp_DEFAULT
+		return AdminDeleteCourseCommentResp_BaseResp_DEFAULT
 	}
 	return p.BaseResp
 }
 
-var fieldIDToName_AdminDeleteCourseResp = map[int16]string{
+var fieldIDToName_AdminDeleteCourseCommentResp = map[int16]string{
 	1: "base_resp",
 }
 
-func (p *AdminDeleteCourseResp) IsSetBaseResp() bool {
+func (p *AdminDeleteCourseCommentResp) IsSetBaseResp() bool {
 	return p.BaseResp != nil
 }
 
-func (p *AdminDeleteCourseResp) Read(iprot thrift.TProtocol) (err error) {
+func (p *AdminDeleteCourseCommentResp) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4906,7 +5014,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminDeleteCourseResp[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminDeleteCourseCommentResp[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4915,10 +5023,10 @@ ReadFieldEndError:
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_AdminDeleteCourseResp[fieldId]))
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_AdminDeleteCourseCommentResp[fieldId]))
 }
 
-func (p *AdminDeleteCourseResp) ReadField1(iprot thrift.TProtocol) error {
+func (p *AdminDeleteCourseCommentResp) ReadField1(iprot thrift.TProtocol) error {
 	_field := module.NewBaseResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -4927,9 +5035,9 @@ func (p *AdminDeleteCourseResp) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *AdminDeleteCourseResp) Write(oprot thrift.TProtocol) (err error) {
+func (p *AdminDeleteCourseCommentResp) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("AdminDeleteCourseResp"); err != nil {
+	if err = oprot.WriteStructBegin("AdminDeleteCourseCommentResp"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4955,7 +5063,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AdminDeleteCourseResp) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *AdminDeleteCourseCommentResp) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -4972,714 +5080,3240 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *AdminDeleteCourseResp) String() string {
+func (p *AdminDeleteCourseCommentResp) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AdminDeleteCourseResp(%+v)", *p)
+	return fmt.Sprintf("AdminDeleteCourseCommentResp(%+v)", *p)
 
 }
 
-type CourseService interface {
-	Search(ctx context.Context, req *SearchReq) (r *SearchResp, err error)
-
-	GetCourseDetail(ctx context.Context, req *GetCourseDetailReq) (r *GetCourseDetailResp, err error)
+type AdminDeleteCourseRatingReq struct {
+	RatingID int64 `thrift:"rating_id,1,required" json:"rating_id,required" path:"rating_id,required"`
+}
 
-	GetCourseResourceList(ctx context.Context, req *GetCourseResourceListReq) (r *GetCourseResourceListResp, err error)
+func NewAdminDeleteCourseRatingReq() *AdminDeleteCourseRatingReq {
+	return &AdminDeleteCourseRatingReq{}
+}
 
-	GetCourseComments(ctx context.Context, req *GetCourseCommentsReq) (r *GetCourseCommentsResp, err error)
+func (p *AdminDeleteCourseRatingReq) InitDefault() {
+}
 
-	SubmitCourseRating(ctx context.Context, req *SubmitCourseRatingReq) (r *SubmitCourseRatingResp, err error)
+func (p *AdminDeleteCourseRatingReq) GetRatingID() (v int64) {
+	return p.RatingID
+}
 
-	SubmitCourseComment(ctx context.Context, req *SubmitCourseCommentReq) (r *SubmitCourseCommentResp, err error)
+var fieldIDToName_AdminDeleteCourseRatingReq = map[int16]string{
+	1: "rating_id",
+}
 
-	DeleteCourseComment(ctx context.Context, req *DeleteCourseCommentReq) (r *DeleteCourseCommentResp, err error)
+func (p *AdminDeleteCourseRatingReq) Read(iprot thrift.TProtocol) (err error) {
 
-	DeleteCourseRating(ctx context.Context, req *DeleteCourseRatingReq) (r *DeleteCourseRatingResp, err error)
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetRatingID bool = false
 
-	ReactCourseComment(ctx context.Context, req *SubmitCourseCommentReactionReq) (r *SubmitCourseCommentReactionResp, err error)
-}
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
 
-type CourseServiceClient struct {
-	c thrift.TClient
-}
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
 
-func NewCourseServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *CourseServiceClient {
-	return &CourseServiceClient{
-		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetRatingID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-}
-
-func NewCourseServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *CourseServiceClient {
-	return &CourseServiceClient{
-		c: thrift.NewTStandardClient(iprot, oprot),
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-}
 
-func NewCourseServiceClient(c thrift.TClient) *CourseServiceClient {
-	return &CourseServiceClient{
-		c: c,
+	if !issetRatingID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
 	}
-}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminDeleteCourseRatingReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
-func (p *CourseServiceClient) Client_() thrift.TClient {
-	return p.c
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_AdminDeleteCourseRatingReq[fieldId]))
 }
 
-func (p *CourseServiceClient) Search(ctx context.Context, req *SearchReq) (r *SearchResp, err error) {
-	var _args CourseServiceSearchArgs
-	_args.Req = req
-	var _result CourseServiceSearchResult
-	if err = p.Client_().Call(ctx, "search", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
-}
-func (p *CourseServiceClient) GetCourseDetail(ctx context.Context, req *GetCourseDetailReq) (r *GetCourseDetailResp, err error) {
-	var _args CourseServiceGetCourseDetailArgs
-	_args.Req = req
-	var _result CourseServiceGetCourseDetailResult
-	if err = p.Client_().Call(ctx, "getCourseDetail", &_args, &_result); err != nil {
-		return
+func (p *AdminDeleteCourseRatingReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
 	}
-	return _result.GetSuccess(), nil
+	p.RatingID = _field
+	return nil
 }
-func (p *CourseServiceClient) GetCourseResourceList(ctx context.Context, req *GetCourseResourceListReq) (r *GetCourseResourceListResp, err error) {
-	var _args CourseServiceGetCourseResourceListArgs
-	_args.Req = req
-	var _result CourseServiceGetCourseResourceListResult
-	if err = p.Client_().Call(ctx, "getCourseResourceList", &_args, &_result); err != nil {
-		return
+
+func (p *AdminDeleteCourseRatingReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AdminDeleteCourseRatingReq"); err != nil {
+		goto WriteStructBeginError
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *CourseServiceClient) GetCourseComments(ctx context.Context, req *GetCourseCommentsReq) (r *GetCourseCommentsResp, err error) {
-	var _args CourseServiceGetCourseCommentsArgs
-	_args.Req = req
-	var _result CourseServiceGetCourseCommentsResult
-	if err = p.Client_().Call(ctx, "getCourseComments", &_args, &_result); err != nil {
-		return
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *CourseServiceClient) SubmitCourseRating(ctx context.Context, req *SubmitCourseRatingReq) (r *SubmitCourseRatingResp, err error) {
-	var _args CourseServiceSubmitCourseRatingArgs
-	_args.Req = req
-	var _result CourseServiceSubmitCourseRatingResult
-	if err = p.Client_().Call(ctx, "submitCourseRating", &_args, &_result); err != nil {
-		return
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *CourseServiceClient) SubmitCourseComment(ctx context.Context, req *SubmitCourseCommentReq) (r *SubmitCourseCommentResp, err error) {
-	var _args CourseServiceSubmitCourseCommentArgs
-	_args.Req = req
-	var _result CourseServiceSubmitCourseCommentResult
-	if err = p.Client_().Call(ctx, "submitCourseComment", &_args, &_result); err != nil {
-		return
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	return _result.GetSuccess(), nil
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
-func (p *CourseServiceClient) DeleteCourseComment(ctx context.Context, req *DeleteCourseCommentReq) (r *DeleteCourseCommentResp, err error) {
-	var _args CourseServiceDeleteCourseCommentArgs
-	_args.Req = req
-	var _result CourseServiceDeleteCourseCommentResult
-	if err = p.Client_().Call(ctx, "deleteCourseComment", &_args, &_result); err != nil {
-		return
+
+func (p *AdminDeleteCourseRatingReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("rating_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *CourseServiceClient) DeleteCourseRating(ctx context.Context, req *DeleteCourseRatingReq) (r *DeleteCourseRatingResp, err error) {
-	var _args CourseServiceDeleteCourseRatingArgs
-	_args.Req = req
-	var _result CourseServiceDeleteCourseRatingResult
-	if err = p.Client_().Call(ctx, "deleteCourseRating", &_args, &_result); err != nil {
-		return
+	if err := oprot.WriteI64(p.RatingID); err != nil {
+		return err
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *CourseServiceClient) ReactCourseComment(ctx context.Context, req *SubmitCourseCommentReactionReq) (r *SubmitCourseCommentReactionResp, err error) {
-	var _args CourseServiceReactCourseCommentArgs
-	_args.Req = req
-	var _result CourseServiceReactCourseCommentResult
-	if err = p.Client_().Call(ctx, "reactCourseComment", &_args, &_result); err != nil {
-		return
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
-	return _result.GetSuccess(), nil
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-type AdminCourseService interface {
-	AdminDeleteCourseComment(ctx context.Context, req *AdminDeleteCourseCommentReq) (r *AdminDeleteCourseCommentResp, err error)
+func (p *AdminDeleteCourseRatingReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AdminDeleteCourseRatingReq(%+v)", *p)
 
-	AdminDeleteCourseRating(ctx context.Context, req *AdminDeleteCourseRatingReq) (r *AdminDeleteCourseRatingResp, err error)
+}
 
-	AdminDeleteCourse(ctx context.Context, req *AdminDeleteCourseReq) (r *AdminDeleteCourseResp, err error)
+type AdminDeleteCourseRatingResp struct {
+	BaseResp *module.BaseResp `thrift:"base_resp,1,required" form:"base_resp,required" json:"base_resp,required" query:"base_resp,required"`
 }
 
-type AdminCourseServiceClient struct {
-	c thrift.TClient
+func NewAdminDeleteCourseRatingResp() *AdminDeleteCourseRatingResp {
+	return &AdminDeleteCourseRatingResp{}
 }
 
-func NewAdminCourseServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *AdminCourseServiceClient {
-	return &AdminCourseServiceClient{
-		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
-	}
+func (p *AdminDeleteCourseRatingResp) InitDefault() {
 }
 
-func NewAdminCourseServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *AdminCourseServiceClient {
-	return &AdminCourseServiceClient{
-		c: thrift.NewTStandardClient(iprot, oprot),
-	}
-}
+var AdminDeleteCourseRatingResp_BaseResp_DEFAULT *module.BaseResp
 
-func NewAdminCourseServiceClient(c thrift.TClient) *AdminCourseServiceClient {
-	return &AdminCourseServiceClient{
-		c: c,
+func (p *AdminDeleteCourseRatingResp) GetBaseResp() (v *module.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return AdminDeleteCourseRatingResp_BaseResp_DEFAULT
 	}
+	return p.BaseResp
 }
 
-func (p *AdminCourseServiceClient) Client_() thrift.TClient {
-	return p.c
+var fieldIDToName_AdminDeleteCourseRatingResp = map[int16]string{
+	1: "base_resp",
 }
 
-func (p *AdminCourseServiceClient) AdminDeleteCourseComment(ctx context.Context, req *AdminDeleteCourseCommentReq) (r *AdminDeleteCourseCommentResp, err error) {
-	var _args AdminCourseServiceAdminDeleteCourseCommentArgs
-	_args.Req = req
-	var _result AdminCourseServiceAdminDeleteCourseCommentResult
-	if err = p.Client_().Call(ctx, "AdminDeleteCourseComment", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
-}
-func (p *AdminCourseServiceClient) AdminDeleteCourseRating(ctx context.Context, req *AdminDeleteCourseRatingReq) (r *AdminDeleteCourseRatingResp, err error) {
-	var _args AdminCourseServiceAdminDeleteCourseRatingArgs
-	_args.Req = req
-	var _result AdminCourseServiceAdminDeleteCourseRatingResult
-	if err = p.Client_().Call(ctx, "AdminDeleteCourseRating", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
-}
-func (p *AdminCourseServiceClient) AdminDeleteCourse(ctx context.Context, req *AdminDeleteCourseReq) (r *AdminDeleteCourseResp, err error) {
-	var _args AdminCourseServiceAdminDeleteCourseArgs
-	_args.Req = req
-	var _result AdminCourseServiceAdminDeleteCourseResult
-	if err = p.Client_().Call(ctx, "AdminDeleteCourse", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
+func (p *AdminDeleteCourseRatingResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
 }
 
-type CourseServiceProcessor struct {
-	processorMap map[string]thrift.TProcessorFunction
-	handler      CourseService
-}
+func (p *AdminDeleteCourseRatingResp) Read(iprot thrift.TProtocol) (err error) {
 
-func (p *CourseServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
-	p.processorMap[key] = processor
-}
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetBaseResp bool = false
 
-func (p *CourseServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
-	processor, ok = p.processorMap[key]
-	return processor, ok
-}
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
 
-func (p *CourseServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
-	return p.processorMap
-}
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
 
-func NewCourseServiceProcessor(handler CourseService) *CourseServiceProcessor {
-	self := &CourseServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
-	self.AddToProcessorMap("search", &courseServiceProcessorSearch{handler: handler})
-	self.AddToProcessorMap("getCourseDetail", &courseServiceProcessorGetCourseDetail{handler: handler})
-	self.AddToProcessorMap("getCourseResourceList", &courseServiceProcessorGetCourseResourceList{handler: handler})
-	self.AddToProcessorMap("getCourseComments", &courseServiceProcessorGetCourseComments{handler: handler})
-	self.AddToProcessorMap("submitCourseRating", &courseServiceProcessorSubmitCourseRating{handler: handler})
-	self.AddToProcessorMap("submitCourseComment", &courseServiceProcessorSubmitCourseComment{handler: handler})
-	self.AddToProcessorMap("deleteCourseComment", &courseServiceProcessorDeleteCourseComment{handler: handler})
-	self.AddToProcessorMap("deleteCourseRating", &courseServiceProcessorDeleteCourseRating{handler: handler})
-	self.AddToProcessorMap("reactCourseComment", &courseServiceProcessorReactCourseComment{handler: handler})
-	return self
-}
-func (p *CourseServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	name, _, seqId, err := iprot.ReadMessageBegin()
-	if err != nil {
-		return false, err
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetBaseResp = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if processor, ok := p.GetProcessorFunction(name); ok {
-		return processor.Process(ctx, seqId, iprot, oprot)
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	iprot.Skip(thrift.STRUCT)
-	iprot.ReadMessageEnd()
-	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
-	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
-	x.Write(oprot)
-	oprot.WriteMessageEnd()
-	oprot.Flush(ctx)
-	return false, x
-}
 
-type courseServiceProcessorSearch struct {
-	handler CourseService
+	if !issetBaseResp {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminDeleteCourseRatingResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_AdminDeleteCourseRatingResp[fieldId]))
 }
 
-func (p *courseServiceProcessorSearch) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := CourseServiceSearchArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("search", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *AdminDeleteCourseRatingResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := module.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.BaseResp = _field
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := CourseServiceSearchResult{}
-	var retval *SearchResp
-	if retval, err2 = p.handler.Search(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing search: "+err2.Error())
-		oprot.WriteMessageBegin("search", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+func (p *AdminDeleteCourseRatingResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AdminDeleteCourseRatingResp"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageBegin("search", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AdminDeleteCourseRatingResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
 	}
-	if err != nil {
-		return
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
 	}
-	return true, err
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-type courseServiceProcessorGetCourseDetail struct {
-	handler CourseService
+func (p *AdminDeleteCourseRatingResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AdminDeleteCourseRatingResp(%+v)", *p)
+
 }
 
-func (p *courseServiceProcessorGetCourseDetail) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := CourseServiceGetCourseDetailArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("getCourseDetail", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+type AdminDeleteCourseReq struct {
+	CourseID int64 `thrift:"course_id,1,required" json:"course_id,required" path:"course_id,required"`
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := CourseServiceGetCourseDetailResult{}
-	var retval *GetCourseDetailResp
-	if retval, err2 = p.handler.GetCourseDetail(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing getCourseDetail: "+err2.Error())
-		oprot.WriteMessageBegin("getCourseDetail", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("getCourseDetail", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
-	}
-	return true, err
+func NewAdminDeleteCourseReq() *AdminDeleteCourseReq {
+	return &AdminDeleteCourseReq{}
 }
 
-type courseServiceProcessorGetCourseResourceList struct {
-	handler CourseService
+func (p *AdminDeleteCourseReq) InitDefault() {
 }
 
-func (p *courseServiceProcessorGetCourseResourceList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := CourseServiceGetCourseResourceListArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("getCourseResourceList", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
-
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := CourseServiceGetCourseResourceListResult{}
-	var retval *GetCourseResourceListResp
-	if retval, err2 = p.handler.GetCourseResourceList(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing getCourseResourceList: "+err2.Error())
-		oprot.WriteMessageBegin("getCourseResourceList", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("getCourseResourceList", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
-	}
-	return true, err
+func (p *AdminDeleteCourseReq) GetCourseID() (v int64) {
+	return p.CourseID
 }
 
-type courseServiceProcessorGetCourseComments struct {
-	handler CourseService
+var fieldIDToName_AdminDeleteCourseReq = map[int16]string{
+	1: "course_id",
 }
 
-func (p *courseServiceProcessorGetCourseComments) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := CourseServiceGetCourseCommentsArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("getCourseComments", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func (p *AdminDeleteCourseReq) Read(iprot thrift.TProtocol) (err error) {
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := CourseServiceGetCourseCommentsResult{}
-	var retval *GetCourseCommentsResp
-	if retval, err2 = p.handler.GetCourseComments(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing getCourseComments: "+err2.Error())
-		oprot.WriteMessageBegin("getCourseComments", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("getCourseComments", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetCourseID bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetCourseID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	if err != nil {
-		return
+
+	if !issetCourseID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
 	}
-	return true, err
-}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminDeleteCourseReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
-type courseServiceProcessorSubmitCourseRating struct {
-	handler CourseService
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_AdminDeleteCourseReq[fieldId]))
 }
 
-func (p *courseServiceProcessorSubmitCourseRating) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := CourseServiceSubmitCourseRatingArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("submitCourseRating", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func (p *AdminDeleteCourseReq) ReadField1(iprot thrift.TProtocol) error {
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := CourseServiceSubmitCourseRatingResult{}
-	var retval *SubmitCourseRatingResp
-	if retval, err2 = p.handler.SubmitCourseRating(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing submitCourseRating: "+err2.Error())
-		oprot.WriteMessageBegin("submitCourseRating", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
 	} else {
-		result.Success = retval
+		_field = v
 	}
-	if err2 = oprot.WriteMessageBegin("submitCourseRating", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	p.CourseID = _field
+	return nil
+}
+
+func (p *AdminDeleteCourseReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AdminDeleteCourseReq"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	if err != nil {
-		return
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AdminDeleteCourseReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("course_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
 	}
-	return true, err
+	if err := oprot.WriteI64(p.CourseID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-type courseServiceProcessorSubmitCourseComment struct {
-	handler CourseService
+func (p *AdminDeleteCourseReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AdminDeleteCourseReq(%+v)", *p)
+
 }
 
-func (p *courseServiceProcessorSubmitCourseComment) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := CourseServiceSubmitCourseCommentArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("submitCourseComment", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+type AdminDeleteCourseResp struct {
+	BaseResp *module.BaseResp `thrift:"base_resp,1,required" form:"base_resp,required" json:"base_resp,required" query:"base_resp,required"`
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := CourseServiceSubmitCourseCommentResult{}
-	var retval *SubmitCourseCommentResp
-	if retval, err2 = p.handler.SubmitCourseComment(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing submitCourseComment: "+err2.Error())
-		oprot.WriteMessageBegin("submitCourseComment", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("submitCourseComment", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
-	}
-	return true, err
+func NewAdminDeleteCourseResp() *AdminDeleteCourseResp {
+	return &AdminDeleteCourseResp{}
 }
 
-type courseServiceProcessorDeleteCourseComment struct {
-	handler CourseService
+func (p *AdminDeleteCourseResp) InitDefault() {
 }
 
-func (p *courseServiceProcessorDeleteCourseComment) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := CourseServiceDeleteCourseCommentArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("deleteCourseComment", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+var AdminDeleteCourseResp_BaseResp_DEFAULT *module.BaseResp
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := CourseServiceDeleteCourseCommentResult{}
-	var retval *DeleteCourseCommentResp
-	if retval, err2 = p.handler.DeleteCourseComment(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing deleteCourseComment: "+err2.Error())
-		oprot.WriteMessageBegin("deleteCourseComment", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("deleteCourseComment", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+func (p *AdminDeleteCourseResp) GetBaseResp() (v *module.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return AdminDeleteCourseResp_BaseResp_DEFAULT
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	return p.BaseResp
+}
+
+var fieldIDToName_AdminDeleteCourseResp = map[int16]string{
+	1: "base_resp",
+}
+
+func (p *AdminDeleteCourseResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *AdminDeleteCourseResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetBaseResp bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetBaseResp = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	if err != nil {
-		return
+
+	if !issetBaseResp {
+		fieldId = 1
+		goto RequiredFieldNotSetError
 	}
-	return true, err
-}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminDeleteCourseResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
-type courseServiceProcessorDeleteCourseRating struct {
-	handler CourseService
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_AdminDeleteCourseResp[fieldId]))
 }
 
-func (p *courseServiceProcessorDeleteCourseRating) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := CourseServiceDeleteCourseRatingArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("deleteCourseRating", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *AdminDeleteCourseResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := module.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.BaseResp = _field
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := CourseServiceDeleteCourseRatingResult{}
-	var retval *DeleteCourseRatingResp
-	if retval, err2 = p.handler.DeleteCourseRating(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing deleteCourseRating: "+err2.Error())
-		oprot.WriteMessageBegin("deleteCourseRating", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+func (p *AdminDeleteCourseResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AdminDeleteCourseResp"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageBegin("deleteCourseRating", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AdminDeleteCourseResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
 	}
-	if err != nil {
-		return
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
 	}
-	return true, err
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-type courseServiceProcessorReactCourseComment struct {
-	handler CourseService
+func (p *AdminDeleteCourseResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AdminDeleteCourseResp(%+v)", *p)
+
 }
 
-func (p *courseServiceProcessorReactCourseComment) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := CourseServiceReactCourseCommentArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("reactCourseComment", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+type AdminRestoreCourseReq struct {
+	CourseID int64 `thrift:"course_id,1,required" json:"course_id,required" path:"course_id,required"`
+}
+
+func NewAdminRestoreCourseReq() *AdminRestoreCourseReq {
+	return &AdminRestoreCourseReq{}
+}
+
+func (p *AdminRestoreCourseReq) InitDefault() {
+}
+
+func (p *AdminRestoreCourseReq) GetCourseID() (v int64) {
+	return p.CourseID
+}
+
+var fieldIDToName_AdminRestoreCourseReq = map[int16]string{
+	1: "course_id",
+}
+
+func (p *AdminRestoreCourseReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetCourseID bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetCourseID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetCourseID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminRestoreCourseReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_AdminRestoreCourseReq[fieldId]))
+}
+
+func (p *AdminRestoreCourseReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.CourseID = _field
+	return nil
+}
+
+func (p *AdminRestoreCourseReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AdminRestoreCourseReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AdminRestoreCourseReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("course_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.CourseID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *AdminRestoreCourseReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AdminRestoreCourseReq(%+v)", *p)
+
+}
+
+type AdminRestoreCourseResp struct {
+	BaseResp *module.BaseResp `thrift:"base_resp,1,required" form:"base_resp,required" json:"base_resp,required" query:"base_resp,required"`
+}
+
+func NewAdminRestoreCourseResp() *AdminRestoreCourseResp {
+	return &AdminRestoreCourseResp{}
+}
+
+func (p *AdminRestoreCourseResp) InitDefault() {
+}
+
+var AdminRestoreCourseResp_BaseResp_DEFAULT *module.BaseResp
+
+func (p *AdminRestoreCourseResp) GetBaseResp() (v *module.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return AdminRestoreCourseResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+var fieldIDToName_AdminRestoreCourseResp = map[int16]string{
+	1: "base_resp",
+}
+
+func (p *AdminRestoreCourseResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *AdminRestoreCourseResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetBaseResp bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetBaseResp = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetBaseResp {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminRestoreCourseResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_AdminRestoreCourseResp[fieldId]))
+}
+
+func (p *AdminRestoreCourseResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := module.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+
+func (p *AdminRestoreCourseResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AdminRestoreCourseResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AdminRestoreCourseResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *AdminRestoreCourseResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AdminRestoreCourseResp(%+v)", *p)
+
+}
+
+type AdminRestoreCourseCommentReq struct {
+	CommentID int64 `thrift:"comment_id,1,required" json:"comment_id,required" path:"comment_id,required"`
+}
+
+func NewAdminRestoreCourseCommentReq() *AdminRestoreCourseCommentReq {
+	return &AdminRestoreCourseCommentReq{}
+}
+
+func (p *AdminRestoreCourseCommentReq) InitDefault() {
+}
+
+func (p *AdminRestoreCourseCommentReq) GetCommentID() (v int64) {
+	return p.CommentID
+}
+
+var fieldIDToName_AdminRestoreCourseCommentReq = map[int16]string{
+	1: "comment_id",
+}
+
+func (p *AdminRestoreCourseCommentReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetCommentID bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetCommentID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetCommentID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminRestoreCourseCommentReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_AdminRestoreCourseCommentReq[fieldId]))
+}
+
+func (p *AdminRestoreCourseCommentReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.CommentID = _field
+	return nil
+}
+
+func (p *AdminRestoreCourseCommentReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AdminRestoreCourseCommentReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AdminRestoreCourseCommentReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("comment_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.CommentID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *AdminRestoreCourseCommentReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AdminRestoreCourseCommentReq(%+v)", *p)
+
+}
+
+type AdminRestoreCourseCommentResp struct {
+	BaseResp *module.BaseResp `thrift:"base_resp,1,required" form:"base_resp,required" json:"base_resp,required" query:"base_resp,required"`
+}
+
+func NewAdminRestoreCourseCommentResp() *AdminRestoreCourseCommentResp {
+	return &AdminRestoreCourseCommentResp{}
+}
+
+func (p *AdminRestoreCourseCommentResp) InitDefault() {
+}
+
+var AdminRestoreCourseCommentResp_BaseResp_DEFAULT *module.BaseResp
+
+func (p *AdminRestoreCourseCommentResp) GetBaseResp() (v *module.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return AdminRestoreCourseCommentResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+var fieldIDToName_AdminRestoreCourseCommentResp = map[int16]string{
+	1: "base_resp",
+}
+
+func (p *AdminRestoreCourseCommentResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *AdminRestoreCourseCommentResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetBaseResp bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetBaseResp = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetBaseResp {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminRestoreCourseCommentResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_AdminRestoreCourseCommentResp[fieldId]))
+}
+
+func (p *AdminRestoreCourseCommentResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := module.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+
+func (p *AdminRestoreCourseCommentResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AdminRestoreCourseCommentResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AdminRestoreCourseCommentResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *AdminRestoreCourseCommentResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AdminRestoreCourseCommentResp(%+v)", *p)
+
+}
+
+type CourseService interface {
+	Search(ctx context.Context, req *SearchReq) (r *SearchResp, err error)
+
+	GetCourseDetail(ctx context.Context, req *GetCourseDetailReq) (r *GetCourseDetailResp, err error)
+
+	GetCourseResourceList(ctx context.Context, req *GetCourseResourceListReq) (r *GetCourseResourceListResp, err error)
+
+	GetCourseComments(ctx context.Context, req *GetCourseCommentsReq) (r *GetCourseCommentsResp, err error)
+
+	SubmitCourseRating(ctx context.Context, req *SubmitCourseRatingReq) (r *SubmitCourseRatingResp, err error)
+
+	SubmitCourseComment(ctx context.Context, req *SubmitCourseCommentReq) (r *SubmitCourseCommentResp, err error)
+
+	DeleteCourseComment(ctx context.Context, req *DeleteCourseCommentReq) (r *DeleteCourseCommentResp, err error)
+
+	DeleteCourseRating(ctx context.Context, req *DeleteCourseRatingReq) (r *DeleteCourseRatingResp, err error)
+
+	ReactCourseComment(ctx context.Context, req *SubmitCourseCommentReactionReq) (r *SubmitCourseCommentReactionResp, err error)
+
+	ReportCourseComment(ctx context.Context, req *ReportCourseCommentReq) (r *ReportCourseCommentResp, err error)
+
+	ReportCourseRating(ctx context.Context, req *ReportCourseRatingReq) (r *ReportCourseRatingResp, err error)
+}
+
+type CourseServiceClient struct {
+	c thrift.TClient
+}
+
+func NewCourseServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *CourseServiceClient {
+	return &CourseServiceClient{
+		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+	}
+}
+
+func NewCourseServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *CourseServiceClient {
+	return &CourseServiceClient{
+		c: thrift.NewTStandardClient(iprot, oprot),
+	}
+}
+
+func NewCourseServiceClient(c thrift.TClient) *CourseServiceClient {
+	return &CourseServiceClient{
+		c: c,
+	}
+}
+
+func (p *CourseServiceClient) Client_() thrift.TClient {
+	return p.c
+}
+
+func (p *CourseServiceClient) Search(ctx context.Context, req *SearchReq) (r *SearchResp, err error) {
+	var _args CourseServiceSearchArgs
+	_args.Req = req
+	var _result CourseServiceSearchResult
+	if err = p.Client_().Call(ctx, "search", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *CourseServiceClient) GetCourseDetail(ctx context.Context, req *GetCourseDetailReq) (r *GetCourseDetailResp, err error) {
+	var _args CourseServiceGetCourseDetailArgs
+	_args.Req = req
+	var _result CourseServiceGetCourseDetailResult
+	if err = p.Client_().Call(ctx, "getCourseDetail", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *CourseServiceClient) GetCourseResourceList(ctx context.Context, req *GetCourseResourceListReq) (r *GetCourseResourceListResp, err error) {
+	var _args CourseServiceGetCourseResourceListArgs
+	_args.Req = req
+	var _result CourseServiceGetCourseResourceListResult
+	if err = p.Client_().Call(ctx, "getCourseResourceList", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *CourseServiceClient) GetCourseComments(ctx context.Context, req *GetCourseCommentsReq) (r *GetCourseCommentsResp, err error) {
+	var _args CourseServiceGetCourseCommentsArgs
+	_args.Req = req
+	var _result CourseServiceGetCourseCommentsResult
+	if err = p.Client_().Call(ctx, "getCourseComments", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *CourseServiceClient) SubmitCourseRating(ctx context.Context, req *SubmitCourseRatingReq) (r *SubmitCourseRatingResp, err error) {
+	var _args CourseServiceSubmitCourseRatingArgs
+	_args.Req = req
+	var _result CourseServiceSubmitCourseRatingResult
+	if err = p.Client_().Call(ctx, "submitCourseRating", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *CourseServiceClient) SubmitCourseComment(ctx context.Context, req *SubmitCourseCommentReq) (r *SubmitCourseCommentResp, err error) {
+	var _args CourseServiceSubmitCourseCommentArgs
+	_args.Req = req
+	var _result CourseServiceSubmitCourseCommentResult
+	if err = p.Client_().Call(ctx, "submitCourseComment", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *CourseServiceClient) DeleteCourseComment(ctx context.Context, req *DeleteCourseCommentReq) (r *DeleteCourseCommentResp, err error) {
+	var _args CourseServiceDeleteCourseCommentArgs
+	_args.Req = req
+	var _result CourseServiceDeleteCourseCommentResult
+	if err = p.Client_().Call(ctx, "deleteCourseComment", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *CourseServiceClient) DeleteCourseRating(ctx context.Context, req *DeleteCourseRatingReq) (r *DeleteCourseRatingResp, err error) {
+	var _args CourseServiceDeleteCourseRatingArgs
+	_args.Req = req
+	var _result CourseServiceDeleteCourseRatingResult
+	if err = p.Client_().Call(ctx, "deleteCourseRating", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *CourseServiceClient) ReactCourseComment(ctx context.Context, req *SubmitCourseCommentReactionReq) (r *SubmitCourseCommentReactionResp, err error) {
+	var _args CourseServiceReactCourseCommentArgs
+	_args.Req = req
+	var _result CourseServiceReactCourseCommentResult
+	if err = p.Client_().Call(ctx, "reactCourseComment", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *CourseServiceClient) ReportCourseComment(ctx context.Context, req *ReportCourseCommentReq) (r *ReportCourseCommentResp, err error) {
+	var _args CourseServiceReportCourseCommentArgs
+	_args.Req = req
+	var _result CourseServiceReportCourseCommentResult
+	if err = p.Client_().Call(ctx, "reportCourseComment", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *CourseServiceClient) ReportCourseRating(ctx context.Context, req *ReportCourseRatingReq) (r *ReportCourseRatingResp, err error) {
+	var _args CourseServiceReportCourseRatingArgs
+	_args.Req = req
+	var _result CourseServiceReportCourseRatingResult
+	if err = p.Client_().Call(ctx, "reportCourseRating", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+type AdminCourseService interface {
+	AdminDeleteCourseComment(ctx context.Context, req *AdminDeleteCourseCommentReq) (r *AdminDeleteCourseCommentResp, err error)
+
+	AdminDeleteCourseRating(ctx context.Context, req *AdminDeleteCourseRatingReq) (r *AdminDeleteCourseRatingResp, err error)
+
+	AdminDeleteCourse(ctx context.Context, req *AdminDeleteCourseReq) (r *AdminDeleteCourseResp, err error)
+
+	AdminRestoreCourse(ctx context.Context, req *AdminRestoreCourseReq) (r *AdminRestoreCourseResp, err error)
+
+	AdminRestoreCourseComment(ctx context.Context, req *AdminRestoreCourseCommentReq) (r *AdminRestoreCourseCommentResp, err error)
+}
+
+type AdminCourseServiceClient struct {
+	c thrift.TClient
+}
+
+func NewAdminCourseServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *AdminCourseServiceClient {
+	return &AdminCourseServiceClient{
+		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+	}
+}
+
+func NewAdminCourseServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *AdminCourseServiceClient {
+	return &AdminCourseServiceClient{
+		c: thrift.NewTStandardClient(iprot, oprot),
+	}
+}
+
+func NewAdminCourseServiceClient(c thrift.TClient) *AdminCourseServiceClient {
+	return &AdminCourseServiceClient{
+		c: c,
+	}
+}
+
+func (p *AdminCourseServiceClient) Client_() thrift.TClient {
+	return p.c
+}
+
+func (p *AdminCourseServiceClient) AdminDeleteCourseComment(ctx context.Context, req *AdminDeleteCourseCommentReq) (r *AdminDeleteCourseCommentResp, err error) {
+	var _args AdminCourseServiceAdminDeleteCourseCommentArgs
+	_args.Req = req
+	var _result AdminCourseServiceAdminDeleteCourseCommentResult
+	if err = p.Client_().Call(ctx, "AdminDeleteCourseComment", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *AdminCourseServiceClient) AdminDeleteCourseRating(ctx context.Context, req *AdminDeleteCourseRatingReq) (r *AdminDeleteCourseRatingResp, err error) {
+	var _args AdminCourseServiceAdminDeleteCourseRatingArgs
+	_args.Req = req
+	var _result AdminCourseServiceAdminDeleteCourseRatingResult
+	if err = p.Client_().Call(ctx, "AdminDeleteCourseRating", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *AdminCourseServiceClient) AdminDeleteCourse(ctx context.Context, req *AdminDeleteCourseReq) (r *AdminDeleteCourseResp, err error) {
+	var _args AdminCourseServiceAdminDeleteCourseArgs
+	_args.Req = req
+	var _result AdminCourseServiceAdminDeleteCourseResult
+	if err = p.Client_().Call(ctx, "AdminDeleteCourse", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *AdminCourseServiceClient) AdminRestoreCourse(ctx context.Context, req *AdminRestoreCourseReq) (r *AdminRestoreCourseResp, err error) {
+	var _args AdminCourseServiceAdminRestoreCourseArgs
+	_args.Req = req
+	var _result AdminCourseServiceAdminRestoreCourseResult
+	if err = p.Client_().Call(ctx, "AdminRestoreCourse", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *AdminCourseServiceClient) AdminRestoreCourseComment(ctx context.Context, req *AdminRestoreCourseCommentReq) (r *AdminRestoreCourseCommentResp, err error) {
+	var _args AdminCourseServiceAdminRestoreCourseCommentArgs
+	_args.Req = req
+	var _result AdminCourseServiceAdminRestoreCourseCommentResult
+	if err = p.Client_().Call(ctx, "AdminRestoreCourseComment", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+type CourseServiceProcessor struct {
+	processorMap map[string]thrift.TProcessorFunction
+	handler      CourseService
+}
+
+func (p *CourseServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
+	p.processorMap[key] = processor
+}
+
+func (p *CourseServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
+	processor, ok = p.processorMap[key]
+	return processor, ok
+}
+
+func (p *CourseServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
+	return p.processorMap
+}
+
+func NewCourseServiceProcessor(handler CourseService) *CourseServiceProcessor {
+	self := &CourseServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
+	self.AddToProcessorMap("search", &courseServiceProcessorSearch{handler: handler})
+	self.AddToProcessorMap("getCourseDetail", &courseServiceProcessorGetCourseDetail{handler: handler})
+	self.AddToProcessorMap("getCourseResourceList", &courseServiceProcessorGetCourseResourceList{handler: handler})
+	self.AddToProcessorMap("getCourseComments", &courseServiceProcessorGetCourseComments{handler: handler})
+	self.AddToProcessorMap("submitCourseRating", &courseServiceProcessorSubmitCourseRating{handler: handler})
+	self.AddToProcessorMap("submitCourseComment", &courseServiceProcessorSubmitCourseComment{handler: handler})
+	self.AddToProcessorMap("deleteCourseComment", &courseServiceProcessorDeleteCourseComment{handler: handler})
+	self.AddToProcessorMap("deleteCourseRating", &courseServiceProcessorDeleteCourseRating{handler: handler})
+	self.AddToProcessorMap("reactCourseComment", &courseServiceProcessorReactCourseComment{handler: handler})
+	self.AddToProcessorMap("reportCourseComment", &courseServiceProcessorReportCourseComment{handler: handler})
+	self.AddToProcessorMap("reportCourseRating", &courseServiceProcessorReportCourseRating{handler: handler})
+	return self
+}
+func (p *CourseServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	name, _, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return false, err
+	}
+	if processor, ok := p.GetProcessorFunction(name); ok {
+		return processor.Process(ctx, seqId, iprot, oprot)
+	}
+	iprot.Skip(thrift.STRUCT)
+	iprot.ReadMessageEnd()
+	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
+	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
+	x.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Flush(ctx)
+	return false, x
+}
+
+type courseServiceProcessorSearch struct {
+	handler CourseService
+}
+
+func (p *courseServiceProcessorSearch) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := CourseServiceSearchArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("search", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := CourseServiceSearchResult{}
+	var retval *SearchResp
+	if retval, err2 = p.handler.Search(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing search: "+err2.Error())
+		oprot.WriteMessageBegin("search", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("search", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type courseServiceProcessorGetCourseDetail struct {
+	handler CourseService
+}
+
+func (p *courseServiceProcessorGetCourseDetail) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := CourseServiceGetCourseDetailArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("getCourseDetail", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := CourseServiceGetCourseDetailResult{}
+	var retval *GetCourseDetailResp
+	if retval, err2 = p.handler.GetCourseDetail(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing getCourseDetail: "+err2.Error())
+		oprot.WriteMessageBegin("getCourseDetail", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("getCourseDetail", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type courseServiceProcessorGetCourseResourceList struct {
+	handler CourseService
+}
+
+func (p *courseServiceProcessorGetCourseResourceList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := CourseServiceGetCourseResourceListArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("getCourseResourceList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := CourseServiceGetCourseResourceListResult{}
+	var retval *GetCourseResourceListResp
+	if retval, err2 = p.handler.GetCourseResourceList(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing getCourseResourceList: "+err2.Error())
+		oprot.WriteMessageBegin("getCourseResourceList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("getCourseResourceList", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type courseServiceProcessorGetCourseComments struct {
+	handler CourseService
+}
+
+func (p *courseServiceProcessorGetCourseComments) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := CourseServiceGetCourseCommentsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("getCourseComments", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := CourseServiceGetCourseCommentsResult{}
+	var retval *GetCourseCommentsResp
+	if retval, err2 = p.handler.GetCourseComments(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing getCourseComments: "+err2.Error())
+		oprot.WriteMessageBegin("getCourseComments", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("getCourseComments", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type courseServiceProcessorSubmitCourseRating struct {
+	handler CourseService
+}
+
+func (p *courseServiceProcessorSubmitCourseRating) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := CourseServiceSubmitCourseRatingArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("submitCourseRating", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := CourseServiceSubmitCourseRatingResult{}
+	var retval *SubmitCourseRatingResp
+	if retval, err2 = p.handler.SubmitCourseRating(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing submitCourseRating: "+err2.Error())
+		oprot.WriteMessageBegin("submitCourseRating", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("submitCourseRating", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type courseServiceProcessorSubmitCourseComment struct {
+	handler CourseService
+}
+
+func (p *courseServiceProcessorSubmitCourseComment) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := CourseServiceSubmitCourseCommentArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("submitCourseComment", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := CourseServiceSubmitCourseCommentResult{}
+	var retval *SubmitCourseCommentResp
+	if retval, err2 = p.handler.SubmitCourseComment(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing submitCourseComment: "+err2.Error())
+		oprot.WriteMessageBegin("submitCourseComment", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("submitCourseComment", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type courseServiceProcessorDeleteCourseComment struct {
+	handler CourseService
+}
+
+func (p *courseServiceProcessorDeleteCourseComment) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := CourseServiceDeleteCourseCommentArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("deleteCourseComment", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := CourseServiceDeleteCourseCommentResult{}
+	var retval *DeleteCourseCommentResp
+	if retval, err2 = p.handler.DeleteCourseComment(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing deleteCourseComment: "+err2.Error())
+		oprot.WriteMessageBegin("deleteCourseComment", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("deleteCourseComment", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type courseServiceProcessorDeleteCourseRating struct {
+	handler CourseService
+}
+
+func (p *courseServiceProcessorDeleteCourseRating) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := CourseServiceDeleteCourseRatingArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("deleteCourseRating", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := CourseServiceDeleteCourseRatingResult{}
+	var retval *DeleteCourseRatingResp
+	if retval, err2 = p.handler.DeleteCourseRating(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing deleteCourseRating: "+err2.Error())
+		oprot.WriteMessageBegin("deleteCourseRating", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("deleteCourseRating", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type courseServiceProcessorReactCourseComment struct {
+	handler CourseService
+}
+
+func (p *courseServiceProcessorReactCourseComment) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := CourseServiceReactCourseCommentArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("reactCourseComment", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := CourseServiceReactCourseCommentResult{}
+	var retval *SubmitCourseCommentReactionResp
+	if retval, err2 = p.handler.ReactCourseComment(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing reactCourseComment: "+err2.Error())
+		oprot.WriteMessageBegin("reactCourseComment", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("reactCourseComment", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type courseServiceProcessorReportCourseComment struct {
+	handler CourseService
+}
+
+func (p *courseServiceProcessorReportCourseComment) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := CourseServiceReportCourseCommentArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("reportCourseComment", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := CourseServiceReportCourseCommentResult{}
+	var retval *ReportCourseCommentResp
+	if retval, err2 = p.handler.ReportCourseComment(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing reportCourseComment: "+err2.Error())
+		oprot.WriteMessageBegin("reportCourseComment", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("reportCourseComment", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type courseServiceProcessorReportCourseRating struct {
+	handler CourseService
+}
+
+func (p *courseServiceProcessorReportCourseRating) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := CourseServiceReportCourseRatingArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("reportCourseRating", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := CourseServiceReportCourseRatingResult{}
+	var retval *ReportCourseRatingResp
+	if retval, err2 = p.handler.ReportCourseRating(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing reportCourseRating: "+err2.Error())
+		oprot.WriteMessageBegin("reportCourseRating", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("reportCourseRating", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type CourseServiceSearchArgs struct {
+	Req *SearchReq `thrift:"req,1"`
+}
+
+func NewCourseServiceSearchArgs() *CourseServiceSearchArgs {
+	return &CourseServiceSearchArgs{}
+}
+
+func (p *CourseServiceSearchArgs) InitDefault() {
+}
+
+var CourseServiceSearchArgs_Req_DEFAULT *SearchReq
+
+func (p *CourseServiceSearchArgs) GetReq() (v *SearchReq) {
+	if !p.IsSetReq() {
+		return CourseServiceSearchArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_CourseServiceSearchArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *CourseServiceSearchArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CourseServiceSearchArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CourseServiceSearchArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *CourseServiceSearchArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewSearchReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *CourseServiceSearchArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("search_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *CourseServiceSearchArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *CourseServiceSearchArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CourseServiceSearchArgs(%+v)", *p)
+
+}
+
+type CourseServiceSearchResult struct {
+	Success *SearchResp `thrift:"success,0,optional"`
+}
+
+func NewCourseServiceSearchResult() *CourseServiceSearchResult {
+	return &CourseServiceSearchResult{}
+}
+
+func (p *CourseServiceSearchResult) InitDefault() {
+}
+
+var CourseServiceSearchResult_Success_DEFAULT *SearchResp
+
+func (p *CourseServiceSearchResult) GetSuccess() (v *SearchResp) {
+	if !p.IsSetSuccess() {
+		return CourseServiceSearchResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_CourseServiceSearchResult = map[int16]string{
+	0: "success",
+}
+
+func (p *CourseServiceSearchResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CourseServiceSearchResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CourseServiceSearchResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *CourseServiceSearchResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewSearchResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *CourseServiceSearchResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("search_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *CourseServiceSearchResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *CourseServiceSearchResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CourseServiceSearchResult(%+v)", *p)
+
+}
+
+type CourseServiceGetCourseDetailArgs struct {
+	Req *GetCourseDetailReq `thrift:"req,1"`
+}
+
+func NewCourseServiceGetCourseDetailArgs() *CourseServiceGetCourseDetailArgs {
+	return &CourseServiceGetCourseDetailArgs{}
+}
+
+func (p *CourseServiceGetCourseDetailArgs) InitDefault() {
+}
+
+var CourseServiceGetCourseDetailArgs_Req_DEFAULT *GetCourseDetailReq
+
+func (p *CourseServiceGetCourseDetailArgs) GetReq() (v *GetCourseDetailReq) {
+	if !p.IsSetReq() {
+		return CourseServiceGetCourseDetailArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_CourseServiceGetCourseDetailArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *CourseServiceGetCourseDetailArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CourseServiceGetCourseDetailArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CourseServiceGetCourseDetailArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *CourseServiceGetCourseDetailArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetCourseDetailReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *CourseServiceGetCourseDetailArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("getCourseDetail_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *CourseServiceGetCourseDetailArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *CourseServiceGetCourseDetailArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CourseServiceGetCourseDetailArgs(%+v)", *p)
+
+}
+
+type CourseServiceGetCourseDetailResult struct {
+	Success *GetCourseDetailResp `thrift:"success,0,optional"`
+}
+
+func NewCourseServiceGetCourseDetailResult() *CourseServiceGetCourseDetailResult {
+	return &CourseServiceGetCourseDetailResult{}
+}
+
+func (p *CourseServiceGetCourseDetailResult) InitDefault() {
+}
+
+var CourseServiceGetCourseDetailResult_Success_DEFAULT *GetCourseDetailResp
+
+func (p *CourseServiceGetCourseDetailResult) GetSuccess() (v *GetCourseDetailResp) {
+	if !p.IsSetSuccess() {
+		return CourseServiceGetCourseDetailResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_CourseServiceGetCourseDetailResult = map[int16]string{
+	0: "success",
+}
+
+func (p *CourseServiceGetCourseDetailResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CourseServiceGetCourseDetailResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CourseServiceGetCourseDetailResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *CourseServiceGetCourseDetailResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetCourseDetailResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *CourseServiceGetCourseDetailResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("getCourseDetail_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *CourseServiceGetCourseDetailResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *CourseServiceGetCourseDetailResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CourseServiceGetCourseDetailResult(%+v)", *p)
+
+}
+
+type CourseServiceGetCourseResourceListArgs struct {
+	Req *GetCourseResourceListReq `thrift:"req,1"`
+}
+
+func NewCourseServiceGetCourseResourceListArgs() *CourseServiceGetCourseResourceListArgs {
+	return &CourseServiceGetCourseResourceListArgs{}
+}
+
+func (p *CourseServiceGetCourseResourceListArgs) InitDefault() {
+}
+
+var CourseServiceGetCourseResourceListArgs_Req_DEFAULT *GetCourseResourceListReq
+
+func (p *CourseServiceGetCourseResourceListArgs) GetReq() (v *GetCourseResourceListReq) {
+	if !p.IsSetReq() {
+		return CourseServiceGetCourseResourceListArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_CourseServiceGetCourseResourceListArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *CourseServiceGetCourseResourceListArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CourseServiceGetCourseResourceListArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CourseServiceGetCourseResourceListArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *CourseServiceGetCourseResourceListArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetCourseResourceListReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *CourseServiceGetCourseResourceListArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("getCourseResourceList_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *CourseServiceGetCourseResourceListArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *CourseServiceGetCourseResourceListArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CourseServiceGetCourseResourceListArgs(%+v)", *p)
+
+}
+
+type CourseServiceGetCourseResourceListResult struct {
+	Success *GetCourseResourceListResp `thrift:"success,0,optional"`
+}
+
+func NewCourseServiceGetCourseResourceListResult() *CourseServiceGetCourseResourceListResult {
+	return &CourseServiceGetCourseResourceListResult{}
+}
+
+func (p *CourseServiceGetCourseResourceListResult) InitDefault() {
+}
+
+var CourseServiceGetCourseResourceListResult_Success_DEFAULT *GetCourseResourceListResp
+
+func (p *CourseServiceGetCourseResourceListResult) GetSuccess() (v *GetCourseResourceListResp) {
+	if !p.IsSetSuccess() {
+		return CourseServiceGetCourseResourceListResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_CourseServiceGetCourseResourceListResult = map[int16]string{
+	0: "success",
+}
+
+func (p *CourseServiceGetCourseResourceListResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CourseServiceGetCourseResourceListResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CourseServiceGetCourseResourceListResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *CourseServiceGetCourseResourceListResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetCourseResourceListResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *CourseServiceGetCourseResourceListResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("getCourseResourceList_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *CourseServiceGetCourseResourceListResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *CourseServiceGetCourseResourceListResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CourseServiceGetCourseResourceListResult(%+v)", *p)
+
+}
+
+type CourseServiceGetCourseCommentsArgs struct {
+	Req *GetCourseCommentsReq `thrift:"req,1"`
+}
+
+func NewCourseServiceGetCourseCommentsArgs() *CourseServiceGetCourseCommentsArgs {
+	return &CourseServiceGetCourseCommentsArgs{}
+}
+
+func (p *CourseServiceGetCourseCommentsArgs) InitDefault() {
+}
+
+var CourseServiceGetCourseCommentsArgs_Req_DEFAULT *GetCourseCommentsReq
+
+func (p *CourseServiceGetCourseCommentsArgs) GetReq() (v *GetCourseCommentsReq) {
+	if !p.IsSetReq() {
+		return CourseServiceGetCourseCommentsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_CourseServiceGetCourseCommentsArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *CourseServiceGetCourseCommentsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CourseServiceGetCourseCommentsArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CourseServiceGetCourseCommentsArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *CourseServiceGetCourseCommentsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetCourseCommentsReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *CourseServiceGetCourseCommentsArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("getCourseComments_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *CourseServiceGetCourseCommentsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *CourseServiceGetCourseCommentsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CourseServiceGetCourseCommentsArgs(%+v)", *p)
+
+}
+
+type CourseServiceGetCourseCommentsResult struct {
+	Success *GetCourseCommentsResp `thrift:"success,0,optional"`
+}
+
+func NewCourseServiceGetCourseCommentsResult() *CourseServiceGetCourseCommentsResult {
+	return &CourseServiceGetCourseCommentsResult{}
+}
+
+func (p *CourseServiceGetCourseCommentsResult) InitDefault() {
+}
+
+var CourseServiceGetCourseCommentsResult_Success_DEFAULT *GetCourseCommentsResp
+
+func (p *CourseServiceGetCourseCommentsResult) GetSuccess() (v *GetCourseCommentsResp) {
+	if !p.IsSetSuccess() {
+		return CourseServiceGetCourseCommentsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_CourseServiceGetCourseCommentsResult = map[int16]string{
+	0: "success",
+}
+
+func (p *CourseServiceGetCourseCommentsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CourseServiceGetCourseCommentsResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CourseServiceGetCourseCommentsResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *CourseServiceGetCourseCommentsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetCourseCommentsResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.Success = _field
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := CourseServiceReactCourseCommentResult{}
-	var retval *SubmitCourseCommentReactionResp
-	if retval, err2 = p.handler.ReactCourseComment(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing reactCourseComment: "+err2.Error())
-		oprot.WriteMessageBegin("reactCourseComment", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+func (p *CourseServiceGetCourseCommentsResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("getCourseComments_result"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageBegin("reactCourseComment", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *CourseServiceGetCourseCommentsResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *CourseServiceGetCourseCommentsResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return true, err
+	return fmt.Sprintf("CourseServiceGetCourseCommentsResult(%+v)", *p)
+
 }
 
-type CourseServiceSearchArgs struct {
-	Req *SearchReq `thrift:"req,1"`
+type CourseServiceSubmitCourseRatingArgs struct {
+	Req *SubmitCourseRatingReq `thrift:"req,1"`
 }
 
-func NewCourseServiceSearchArgs() *CourseServiceSearchArgs {
-	return &CourseServiceSearchArgs{}
+func NewCourseServiceSubmitCourseRatingArgs() *CourseServiceSubmitCourseRatingArgs {
+	return &CourseServiceSubmitCourseRatingArgs{}
 }
 
-func (p *CourseServiceSearchArgs) InitDefault() {
+func (p *CourseServiceSubmitCourseRatingArgs) InitDefault() {
 }
 
-var CourseServiceSearchArgs_Req_DEFAULT *SearchReq
+var CourseServiceSubmitCourseRatingArgs_Req_DEFAULT *SubmitCourseRatingReq
 
-func (p *CourseServiceSearchArgs) GetReq() (v *SearchReq) {
+func (p *CourseServiceSubmitCourseRatingArgs) GetReq() (v *SubmitCourseRatingReq) {
 	if !p.IsSetReq() {
-		return CourseServiceSearchArgs_Req_DEFAULT
+		return CourseServiceSubmitCourseRatingArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_CourseServiceSearchArgs = map[int16]string{
+var fieldIDToName_CourseServiceSubmitCourseRatingArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *CourseServiceSearchArgs) IsSetReq() bool {
+func (p *CourseServiceSubmitCourseRatingArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *CourseServiceSearchArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *CourseServiceSubmitCourseRatingArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5725,7 +8359,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CourseServiceSearchArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CourseServiceSubmitCourseRatingArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5735,8 +8369,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *CourseServiceSearchArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewSearchReq()
+func (p *CourseServiceSubmitCourseRatingArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewSubmitCourseRatingReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -5744,9 +8378,9 @@ func (p *CourseServiceSearchArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *CourseServiceSearchArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *CourseServiceSubmitCourseRatingArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("search_args"); err != nil {
+	if err = oprot.WriteStructBegin("submitCourseRating_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5772,7 +8406,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *CourseServiceSearchArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *CourseServiceSubmitCourseRatingArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -5789,43 +8423,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *CourseServiceSearchArgs) String() string {
+func (p *CourseServiceSubmitCourseRatingArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CourseServiceSearchArgs(%+v)", *p)
+	return fmt.Sprintf("CourseServiceSubmitCourseRatingArgs(%+v)", *p)
 
 }
 
-type CourseServiceSearchResult struct {
-	Success *SearchResp `thrift:"success,0,optional"`
+type CourseServiceSubmitCourseRatingResult struct {
+	Success *SubmitCourseRatingResp `thrift:"success,0,optional"`
 }
 
-func NewCourseServiceSearchResult() *CourseServiceSearchResult {
-	return &CourseServiceSearchResult{}
+func NewCourseServiceSubmitCourseRatingResult() *CourseServiceSubmitCourseRatingResult {
+	return &CourseServiceSubmitCourseRatingResult{}
 }
 
-func (p *CourseServiceSearchResult) InitDefault() {
+func (p *CourseServiceSubmitCourseRatingResult) InitDefault() {
 }
 
-var CourseServiceSearchResult_Success_DEFAULT *SearchResp
+var CourseServiceSubmitCourseRatingResult_Success_DEFAULT *SubmitCourseRatingResp
 
-func (p *CourseServiceSearchResult) GetSuccess() (v *SearchResp) {
+func (p *CourseServiceSubmitCourseRatingResult) GetSuccess() (v *SubmitCourseRatingResp) {
 	if !p.IsSetSuccess() {
-		return CourseServiceSearchResult_Success_DEFAULT
+		return CourseServiceSubmitCourseRatingResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_CourseServiceSearchResult = map[int16]string{
+var fieldIDToName_CourseServiceSubmitCourseRatingResult = map[int16]string{
 	0: "success",
 }
 
-func (p *CourseServiceSearchResult) IsSetSuccess() bool {
+func (p *CourseServiceSubmitCourseRatingResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *CourseServiceSearchResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *CourseServiceSubmitCourseRatingResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5871,7 +8505,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CourseServiceSearchResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CourseServiceSubmitCourseRatingResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5881,8 +8515,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *CourseServiceSearchResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewSearchResp()
+func (p *CourseServiceSubmitCourseRatingResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewSubmitCourseRatingResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -5890,9 +8524,9 @@ func (p *CourseServiceSearchResult) ReadField0(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *CourseServiceSearchResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *CourseServiceSubmitCourseRatingResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("search_result"); err != nil {
+	if err = oprot.WriteStructBegin("submitCourseRating_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5918,7 +8552,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *CourseServiceSearchResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *CourseServiceSubmitCourseRatingResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -5937,43 +8571,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *CourseServiceSearchResult) String() string {
+func (p *CourseServiceSubmitCourseRatingResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CourseServiceSearchResult(%+v)", *p)
+	return fmt.Sprintf("CourseServiceSubmitCourseRatingResult(%+v)", *p)
 
 }
 
-type CourseServiceGetCourseDetailArgs struct {
-	Req *GetCourseDetailReq `thrift:"req,1"`
+type CourseServiceSubmitCourseCommentArgs struct {
+	Req *SubmitCourseCommentReq `thrift:"req,1"`
 }
 
-func NewCourseServiceGetCourseDetailArgs() *CourseServiceGetCourseDetailArgs {
-	return &CourseServiceGetCourseDetailArgs{}
+func NewCourseServiceSubmitCourseCommentArgs() *CourseServiceSubmitCourseCommentArgs {
+	return &CourseServiceSubmitCourseCommentArgs{}
 }
 
-func (p *CourseServiceGetCourseDetailArgs) InitDefault() {
+func (p *CourseServiceSubmitCourseCommentArgs) InitDefault() {
 }
 
-var CourseServiceGetCourseDetailArgs_Req_DEFAULT *GetCourseDetailReq
+var CourseServiceSubmitCourseCommentArgs_Req_DEFAULT *SubmitCourseCommentReq
 
-func (p *CourseServiceGetCourseDetailArgs) GetReq() (v *GetCourseDetailReq) {
+func (p *CourseServiceSubmitCourseCommentArgs) GetReq() (v *SubmitCourseCommentReq) {
 	if !p.IsSetReq() {
-		return CourseServiceGetCourseDetailArgs_Req_DEFAULT
+		return CourseServiceSubmitCourseCommentArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_CourseServiceGetCourseDetailArgs = map[int16]string{
+var fieldIDToName_CourseServiceSubmitCourseCommentArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *CourseServiceGetCourseDetailArgs) IsSetReq() bool {
+func (p *CourseServiceSubmitCourseCommentArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *CourseServiceGetCourseDetailArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *CourseServiceSubmitCourseCommentArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6019,7 +8653,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CourseServiceGetCourseDetailArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CourseServiceSubmitCourseCommentArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6029,8 +8663,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *CourseServiceGetCourseDetailArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewGetCourseDetailReq()
+func (p *CourseServiceSubmitCourseCommentArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewSubmitCourseCommentReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -6038,9 +8672,9 @@ func (p *CourseServiceGetCourseDetailArgs) ReadField1(iprot thrift.TProtocol) er
 	return nil
 }
 
-func (p *CourseServiceGetCourseDetailArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *CourseServiceSubmitCourseCommentArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("getCourseDetail_args"); err != nil {
+	if err = oprot.WriteStructBegin("submitCourseComment_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6066,7 +8700,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *CourseServiceGetCourseDetailArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *CourseServiceSubmitCourseCommentArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -6083,43 +8717,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *CourseServiceGetCourseDetailArgs) String() string {
+func (p *CourseServiceSubmitCourseCommentArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CourseServiceGetCourseDetailArgs(%+v)", *p)
+	return fmt.Sprintf("CourseServiceSubmitCourseCommentArgs(%+v)", *p)
 
 }
 
-type CourseServiceGetCourseDetailResult struct {
-	Success *GetCourseDetailResp `thrift:"success,0,optional"`
+type CourseServiceSubmitCourseCommentResult struct {
+	Success *SubmitCourseCommentResp `thrift:"success,0,optional"`
 }
 
-func NewCourseServiceGetCourseDetailResult() *CourseServiceGetCourseDetailResult {
-	return &CourseServiceGetCourseDetailResult{}
+func NewCourseServiceSubmitCourseCommentResult() *CourseServiceSubmitCourseCommentResult {
+	return &CourseServiceSubmitCourseCommentResult{}
 }
 
-func (p *CourseServiceGetCourseDetailResult) InitDefault() {
+func (p *CourseServiceSubmitCourseCommentResult) InitDefault() {
 }
 
-var CourseServiceGetCourseDetailResult_Success_DEFAULT *GetCourseDetailResp
+var CourseServiceSubmitCourseCommentResult_Success_DEFAULT *SubmitCourseCommentResp
 
-func (p *CourseServiceGetCourseDetailResult) GetSuccess() (v *GetCourseDetailResp) {
+func (p *CourseServiceSubmitCourseCommentResult) GetSuccess() (v *SubmitCourseCommentResp) {
 	if !p.IsSetSuccess() {
-		return CourseServiceGetCourseDetailResult_Success_DEFAULT
+		return CourseServiceSubmitCourseCommentResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_CourseServiceGetCourseDetailResult = map[int16]string{
+var fieldIDToName_CourseServiceSubmitCourseCommentResult = map[int16]string{
 	0: "success",
 }
 
-func (p *CourseServiceGetCourseDetailResult) IsSetSuccess() bool {
+func (p *CourseServiceSubmitCourseCommentResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *CourseServiceGetCourseDetailResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *CourseServiceSubmitCourseCommentResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6165,7 +8799,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CourseServiceGetCourseDetailResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CourseServiceSubmitCourseCommentResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6175,8 +8809,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *CourseServiceGetCourseDetailResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewGetCourseDetailResp()
+func (p *CourseServiceSubmitCourseCommentResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewSubmitCourseCommentResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -6184,9 +8818,9 @@ func (p *CourseServiceGetCourseDetailResult) ReadField0(iprot thrift.TProtocol)
 	return nil
 }
 
-func (p *CourseServiceGetCourseDetailResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *CourseServiceSubmitCourseCommentResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("getCourseDetail_result"); err != nil {
+	if err = oprot.WriteStructBegin("submitCourseComment_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6212,7 +8846,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *CourseServiceGetCourseDetailResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *CourseServiceSubmitCourseCommentResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -6231,43 +8865,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *CourseServiceGetCourseDetailResult) String() string {
+func (p *CourseServiceSubmitCourseCommentResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CourseServiceGetCourseDetailResult(%+v)", *p)
+	return fmt.Sprintf("CourseServiceSubmitCourseCommentResult(%+v)", *p)
 
 }
 
-type CourseServiceGetCourseResourceListArgs struct {
-	Req *GetCourseResourceListReq `thrift:"req,1"`
+type CourseServiceDeleteCourseCommentArgs struct {
+	Req *DeleteCourseCommentReq `thrift:"req,1"`
 }
 
-func NewCourseServiceGetCourseResourceListArgs() *CourseServiceGetCourseResourceListArgs {
-	return &CourseServiceGetCourseResourceListArgs{}
+func NewCourseServiceDeleteCourseCommentArgs() *CourseServiceDeleteCourseCommentArgs {
+	return &CourseServiceDeleteCourseCommentArgs{}
 }
 
-func (p *CourseServiceGetCourseResourceListArgs) InitDefault() {
+func (p *CourseServiceDeleteCourseCommentArgs) InitDefault() {
 }
 
-var CourseServiceGetCourseResourceListArgs_Req_DEFAULT *GetCourseResourceListReq
+var CourseServiceDeleteCourseCommentArgs_Req_DEFAULT *DeleteCourseCommentReq
 
-func (p *CourseServiceGetCourseResourceListArgs) GetReq() (v *GetCourseResourceListReq) {
+func (p *CourseServiceDeleteCourseCommentArgs) GetReq() (v *DeleteCourseCommentReq) {
 	if !p.IsSetReq() {
-		return CourseServiceGetCourseResourceListArgs_Req_DEFAULT
+		return CourseServiceDeleteCourseCommentArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_CourseServiceGetCourseResourceListArgs = map[int16]string{
+var fieldIDToName_CourseServiceDeleteCourseCommentArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *CourseServiceGetCourseResourceListArgs) IsSetReq() bool {
+func (p *CourseServiceDeleteCourseCommentArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *CourseServiceGetCourseResourceListArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *CourseServiceDeleteCourseCommentArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6313,7 +8947,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CourseServiceGetCourseResourceListArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CourseServiceDeleteCourseCommentArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6323,8 +8957,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *CourseServiceGetCourseResourceListArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewGetCourseResourceListReq()
+func (p *CourseServiceDeleteCourseCommentArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewDeleteCourseCommentReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -6332,9 +8966,9 @@ func (p *CourseServiceGetCourseResourceListArgs) ReadField1(iprot thrift.TProtoc
 	return nil
 }
 
-func (p *CourseServiceGetCourseResourceListArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *CourseServiceDeleteCourseCommentArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("getCourseResourceList_args"); err != nil {
+	if err = oprot.WriteStructBegin("deleteCourseComment_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6360,7 +8994,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *CourseServiceGetCourseResourceListArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *CourseServiceDeleteCourseCommentArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -6377,43 +9011,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *CourseServiceGetCourseResourceListArgs) String() string {
+func (p *CourseServiceDeleteCourseCommentArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CourseServiceGetCourseResourceListArgs(%+v)", *p)
+	return fmt.Sprintf("CourseServiceDeleteCourseCommentArgs(%+v)", *p)
 
 }
 
-type CourseServiceGetCourseResourceListResult struct {
-	Success *GetCourseResourceListResp `thrift:"success,0,optional"`
+type CourseServiceDeleteCourseCommentResult struct {
+	Success *DeleteCourseCommentResp `thrift:"success,0,optional"`
 }
 
-func NewCourseServiceGetCourseResourceListResult() *CourseServiceGetCourseResourceListResult {
-	return &CourseServiceGetCourseResourceListResult{}
+func NewCourseServiceDeleteCourseCommentResult() *CourseServiceDeleteCourseCommentResult {
+	return &CourseServiceDeleteCourseCommentResult{}
 }
 
-func (p *CourseServiceGetCourseResourceListResult) InitDefault() {
+func (p *CourseServiceDeleteCourseCommentResult) InitDefault() {
 }
 
-var CourseServiceGetCourseResourceListResult_Success_DEFAULT *GetCourseResourceListResp
+var CourseServiceDeleteCourseCommentResult_Success_DEFAULT *DeleteCourseCommentResp
 
-func (p *CourseServiceGetCourseResourceListResult) GetSuccess() (v *GetCourseResourceListResp) {
+func (p *CourseServiceDeleteCourseCommentResult) GetSuccess() (v *DeleteCourseCommentResp) {
 	if !p.IsSetSuccess() {
-		return CourseServiceGetCourseResourceListResult_Success_DEFAULT
+		return CourseServiceDeleteCourseCommentResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_CourseServiceGetCourseResourceListResult = map[int16]string{
+var fieldIDToName_CourseServiceDeleteCourseCommentResult = map[int16]string{
 	0: "success",
 }
 
-func (p *CourseServiceGetCourseResourceListResult) IsSetSuccess() bool {
+func (p *CourseServiceDeleteCourseCommentResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *CourseServiceGetCourseResourceListResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *CourseServiceDeleteCourseCommentResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6459,7 +9093,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CourseServiceGetCourseResourceListResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CourseServiceDeleteCourseCommentResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6469,8 +9103,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *CourseServiceGetCourseResourceListResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewGetCourseResourceListResp()
+func (p *CourseServiceDeleteCourseCommentResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewDeleteCourseCommentResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -6478,9 +9112,9 @@ func (p *CourseServiceGetCourseResourceListResult) ReadField0(iprot thrift.TProt
 	return nil
 }
 
-func (p *CourseServiceGetCourseResourceListResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *CourseServiceDeleteCourseCommentResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("getCourseResourceList_result"); err != nil {
+	if err = oprot.WriteStructBegin("deleteCourseComment_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6506,7 +9140,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *CourseServiceGetCourseResourceListResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *CourseServiceDeleteCourseCommentResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -6525,43 +9159,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *CourseServiceGetCourseResourceListResult) String() string {
+func (p *CourseServiceDeleteCourseCommentResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CourseServiceGetCourseResourceListResult(%+v)", *p)
+	return fmt.Sprintf("CourseServiceDeleteCourseCommentResult(%+v)", *p)
 
 }
 
-type CourseServiceGetCourseCommentsArgs struct {
-	Req *GetCourseCommentsReq `thrift:"req,1"`
+type CourseServiceDeleteCourseRatingArgs struct {
+	Req *DeleteCourseRatingReq `thrift:"req,1"`
 }
 
-func NewCourseServiceGetCourseCommentsArgs() *CourseServiceGetCourseCommentsArgs {
-	return &CourseServiceGetCourseCommentsArgs{}
+func NewCourseServiceDeleteCourseRatingArgs() *CourseServiceDeleteCourseRatingArgs {
+	return &CourseServiceDeleteCourseRatingArgs{}
 }
 
-func (p *CourseServiceGetCourseCommentsArgs) InitDefault() {
+func (p *CourseServiceDeleteCourseRatingArgs) InitDefault() {
 }
 
-var CourseServiceGetCourseCommentsArgs_Req_DEFAULT *GetCourseCommentsReq
+var CourseServiceDeleteCourseRatingArgs_Req_DEFAULT *DeleteCourseRatingReq
 
-func (p *CourseServiceGetCourseCommentsArgs) GetReq() (v *GetCourseCommentsReq) {
+func (p *CourseServiceDeleteCourseRatingArgs) GetReq() (v *DeleteCourseRatingReq) {
 	if !p.IsSetReq() {
-		return CourseServiceGetCourseCommentsArgs_Req_DEFAULT
+		return CourseServiceDeleteCourseRatingArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_CourseServiceGetCourseCommentsArgs = map[int16]string{
+var fieldIDToName_CourseServiceDeleteCourseRatingArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *CourseServiceGetCourseCommentsArgs) IsSetReq() bool {
+func (p *CourseServiceDeleteCourseRatingArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *CourseServiceGetCourseCommentsArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *CourseServiceDeleteCourseRatingArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6607,7 +9241,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CourseServiceGetCourseCommentsArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CourseServiceDeleteCourseRatingArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6617,8 +9251,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *CourseServiceGetCourseCommentsArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewGetCourseCommentsReq()
+func (p *CourseServiceDeleteCourseRatingArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewDeleteCourseRatingReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -6626,9 +9260,9 @@ func (p *CourseServiceGetCourseCommentsArgs) ReadField1(iprot thrift.TProtocol)
 	return nil
 }
 
-func (p *CourseServiceGetCourseCommentsArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *CourseServiceDeleteCourseRatingArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("getCourseComments_args"); err != nil {
+	if err = oprot.WriteStructBegin("deleteCourseRating_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6654,7 +9288,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *CourseServiceGetCourseCommentsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *CourseServiceDeleteCourseRatingArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -6671,43 +9305,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *CourseServiceGetCourseCommentsArgs) String() string {
+func (p *CourseServiceDeleteCourseRatingArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CourseServiceGetCourseCommentsArgs(%+v)", *p)
+	return fmt.Sprintf("CourseServiceDeleteCourseRatingArgs(%+v)", *p)
 
 }
 
-type CourseServiceGetCourseCommentsResult struct {
-	Success *GetCourseCommentsResp `thrift:"success,0,optional"`
+type CourseServiceDeleteCourseRatingResult struct {
+	Success *DeleteCourseRatingResp `thrift:"success,0,optional"`
 }
 
-func NewCourseServiceGetCourseCommentsResult() *CourseServiceGetCourseCommentsResult {
-	return &CourseServiceGetCourseCommentsResult{}
+func NewCourseServiceDeleteCourseRatingResult() *CourseServiceDeleteCourseRatingResult {
+	return &CourseServiceDeleteCourseRatingResult{}
 }
 
-func (p *CourseServiceGetCourseCommentsResult) InitDefault() {
+func (p *CourseServiceDeleteCourseRatingResult) InitDefault() {
 }
 
-var CourseServiceGetCourseCommentsResult_Success_DEFAULT *GetCourseCommentsResp
+var CourseServiceDeleteCourseRatingResult_Success_DEFAULT *DeleteCourseRatingResp
 
-func (p *CourseServiceGetCourseCommentsResult) GetSuccess() (v *GetCourseCommentsResp) {
+func (p *CourseServiceDeleteCourseRatingResult) GetSuccess() (v *DeleteCourseRatingResp) {
 	if !p.IsSetSuccess() {
-		return CourseServiceGetCourseCommentsResult_Success_DEFAULT
+		return CourseServiceDeleteCourseRatingResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_CourseServiceGetCourseCommentsResult = map[int16]string{
+var fieldIDToName_CourseServiceDeleteCourseRatingResult = map[int16]string{
 	0: "success",
 }
 
-func (p *CourseServiceGetCourseCommentsResult) IsSetSuccess() bool {
+func (p *CourseServiceDeleteCourseRatingResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *CourseServiceGetCourseCommentsResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *CourseServiceDeleteCourseRatingResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6753,7 +9387,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CourseServiceGetCourseCommentsResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CourseServiceDeleteCourseRatingResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6763,8 +9397,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *CourseServiceGetCourseCommentsResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewGetCourseCommentsResp()
+func (p *CourseServiceDeleteCourseRatingResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewDeleteCourseRatingResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -6772,9 +9406,9 @@ func (p *CourseServiceGetCourseCommentsResult) ReadField0(iprot thrift.TProtocol
 	return nil
 }
 
-func (p *CourseServiceGetCourseCommentsResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *CourseServiceDeleteCourseRatingResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("getCourseComments_result"); err != nil {
+	if err = oprot.WriteStructBegin("deleteCourseRating_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6800,7 +9434,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *CourseServiceGetCourseCommentsResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *CourseServiceDeleteCourseRatingResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -6819,43 +9453,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *CourseServiceGetCourseCommentsResult) String() string {
+func (p *CourseServiceDeleteCourseRatingResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CourseServiceGetCourseCommentsResult(%+v)", *p)
+	return fmt.Sprintf("CourseServiceDeleteCourseRatingResult(%+v)", *p)
 
 }
 
-type CourseServiceSubmitCourseRatingArgs struct {
-	Req *SubmitCourseRatingReq `thrift:"req,1"`
+type CourseServiceReactCourseCommentArgs struct {
+	Req *SubmitCourseCommentReactionReq `thrift:"req,1"`
 }
 
-func NewCourseServiceSubmitCourseRatingArgs() *CourseServiceSubmitCourseRatingArgs {
-	return &CourseServiceSubmitCourseRatingArgs{}
+func NewCourseServiceReactCourseCommentArgs() *CourseServiceReactCourseCommentArgs {
+	return &CourseServiceReactCourseCommentArgs{}
 }
 
-func (p *CourseServiceSubmitCourseRatingArgs) InitDefault() {
+func (p *CourseServiceReactCourseCommentArgs) InitDefault() {
 }
 
-var CourseServiceSubmitCourseRatingArgs_Req_DEFAULT *SubmitCourseRatingReq
+var CourseServiceReactCourseCommentArgs_Req_DEFAULT *SubmitCourseCommentReactionReq
 
-func (p *CourseServiceSubmitCourseRatingArgs) GetReq() (v *SubmitCourseRatingReq) {
+func (p *CourseServiceReactCourseCommentArgs) GetReq() (v *SubmitCourseCommentReactionReq) {
 	if !p.IsSetReq() {
-		return CourseServiceSubmitCourseRatingArgs_Req_DEFAULT
+		return CourseServiceReactCourseCommentArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_CourseServiceSubmitCourseRatingArgs = map[int16]string{
+var fieldIDToName_CourseServiceReactCourseCommentArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *CourseServiceSubmitCourseRatingArgs) IsSetReq() bool {
+func (p *CourseServiceReactCourseCommentArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *CourseServiceSubmitCourseRatingArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *CourseServiceReactCourseCommentArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6901,7 +9535,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CourseServiceSubmitCourseRatingArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CourseServiceReactCourseCommentArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6911,8 +9545,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *CourseServiceSubmitCourseRatingArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewSubmitCourseRatingReq()
+func (p *CourseServiceReactCourseCommentArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewSubmitCourseCommentReactionReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -6920,9 +9554,9 @@ func (p *CourseServiceSubmitCourseRatingArgs) ReadField1(iprot thrift.TProtocol)
 	return nil
 }
 
-func (p *CourseServiceSubmitCourseRatingArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *CourseServiceReactCourseCommentArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("submitCourseRating_args"); err != nil {
+	if err = oprot.WriteStructBegin("reactCourseComment_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6948,7 +9582,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *CourseServiceSubmitCourseRatingArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *CourseServiceReactCourseCommentArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -6965,43 +9599,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *CourseServiceSubmitCourseRatingArgs) String() string {
+func (p *CourseServiceReactCourseCommentArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CourseServiceSubmitCourseRatingArgs(%+v)", *p)
+	return fmt.Sprintf("CourseServiceReactCourseCommentArgs(%+v)", *p)
 
 }
 
-type CourseServiceSubmitCourseRatingResult struct {
-	Success *SubmitCourseRatingResp `thrift:"success,0,optional"`
+type CourseServiceReactCourseCommentResult struct {
+	Success *SubmitCourseCommentReactionResp `thrift:"success,0,optional"`
 }
 
-func NewCourseServiceSubmitCourseRatingResult() *CourseServiceSubmitCourseRatingResult {
-	return &CourseServiceSubmitCourseRatingResult{}
+func NewCourseServiceReactCourseCommentResult() *CourseServiceReactCourseCommentResult {
+	return &CourseServiceReactCourseCommentResult{}
 }
 
-func (p *CourseServiceSubmitCourseRatingResult) InitDefault() {
+func (p *CourseServiceReactCourseCommentResult) InitDefault() {
 }
 
-var CourseServiceSubmitCourseRatingResult_Success_DEFAULT *SubmitCourseRatingResp
+var CourseServiceReactCourseCommentResult_Success_DEFAULT *SubmitCourseCommentReactionResp
 
-func (p *CourseServiceSubmitCourseRatingResult) GetSuccess() (v *SubmitCourseRatingResp) {
+func (p *CourseServiceReactCourseCommentResult) GetSuccess() (v *SubmitCourseCommentReactionResp) {
 	if !p.IsSetSuccess() {
-		return CourseServiceSubmitCourseRatingResult_Success_DEFAULT
+		return CourseServiceReactCourseCommentResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_CourseServiceSubmitCourseRatingResult = map[int16]string{
+var fieldIDToName_CourseServiceReactCourseCommentResult = map[int16]string{
 	0: "success",
 }
 
-func (p *CourseServiceSubmitCourseRatingResult) IsSetSuccess() bool {
+func (p *CourseServiceReactCourseCommentResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *CourseServiceSubmitCourseRatingResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *CourseServiceReactCourseCommentResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -7047,7 +9681,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CourseServiceSubmitCourseRatingResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CourseServiceReactCourseCommentResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7057,8 +9691,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *CourseServiceSubmitCourseRatingResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewSubmitCourseRatingResp()
+func (p *CourseServiceReactCourseCommentResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewSubmitCourseCommentReactionResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -7066,9 +9700,9 @@ func (p *CourseServiceSubmitCourseRatingResult) ReadField0(iprot thrift.TProtoco
 	return nil
 }
 
-func (p *CourseServiceSubmitCourseRatingResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *CourseServiceReactCourseCommentResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("submitCourseRating_result"); err != nil {
+	if err = oprot.WriteStructBegin("reactCourseComment_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7094,7 +9728,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *CourseServiceSubmitCourseRatingResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *CourseServiceReactCourseCommentResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -7113,43 +9747,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *CourseServiceSubmitCourseRatingResult) String() string {
+func (p *CourseServiceReactCourseCommentResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CourseServiceSubmitCourseRatingResult(%+v)", *p)
+	return fmt.Sprintf("CourseServiceReactCourseCommentResult(%+v)", *p)
 
 }
 
-type CourseServiceSubmitCourseCommentArgs struct {
-	Req *SubmitCourseCommentReq `thrift:"req,1"`
+type CourseServiceReportCourseCommentArgs struct {
+	Req *ReportCourseCommentReq `thrift:"req,1"`
 }
 
-func NewCourseServiceSubmitCourseCommentArgs() *CourseServiceSubmitCourseCommentArgs {
-	return &CourseServiceSubmitCourseCommentArgs{}
+func NewCourseServiceReportCourseCommentArgs() *CourseServiceReportCourseCommentArgs {
+	return &CourseServiceReportCourseCommentArgs{}
 }
 
-func (p *CourseServiceSubmitCourseCommentArgs) InitDefault() {
+func (p *CourseServiceReportCourseCommentArgs) InitDefault() {
 }
 
-var CourseServiceSubmitCourseCommentArgs_Req_DEFAULT *SubmitCourseCommentReq
+var CourseServiceReportCourseCommentArgs_Req_DEFAULT *ReportCourseCommentReq
 
-func (p *CourseServiceSubmitCourseCommentArgs) GetReq() (v *SubmitCourseCommentReq) {
+func (p *CourseServiceReportCourseCommentArgs) GetReq() (v *ReportCourseCommentReq) {
 	if !p.IsSetReq() {
-		return CourseServiceSubmitCourseCommentArgs_Req_DEFAULT
+		return CourseServiceReportCourseCommentArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_CourseServiceSubmitCourseCommentArgs = map[int16]string{
+var fieldIDToName_CourseServiceReportCourseCommentArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *CourseServiceSubmitCourseCommentArgs) IsSetReq() bool {
+func (p *CourseServiceReportCourseCommentArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *CourseServiceSubmitCourseCommentArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *CourseServiceReportCourseCommentArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -7195,7 +9829,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CourseServiceSubmitCourseCommentArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CourseServiceReportCourseCommentArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7205,8 +9839,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *CourseServiceSubmitCourseCommentArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewSubmitCourseCommentReq()
+func (p *CourseServiceReportCourseCommentArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewReportCourseCommentReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -7214,9 +9848,9 @@ func (p *CourseServiceSubmitCourseCommentArgs) ReadField1(iprot thrift.TProtocol
 	return nil
 }
 
-func (p *CourseServiceSubmitCourseCommentArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *CourseServiceReportCourseCommentArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("submitCourseComment_args"); err != nil {
+	if err = oprot.WriteStructBegin("reportCourseComment_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7242,7 +9876,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *CourseServiceSubmitCourseCommentArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *CourseServiceReportCourseCommentArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -7259,43 +9893,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *CourseServiceSubmitCourseCommentArgs) String() string {
+func (p *CourseServiceReportCourseCommentArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CourseServiceSubmitCourseCommentArgs(%+v)", *p)
+	return fmt.Sprintf("CourseServiceReportCourseCommentArgs(%+v)", *p)
 
 }
 
-type CourseServiceSubmitCourseCommentResult struct {
-	Success *SubmitCourseCommentResp `thrift:"success,0,optional"`
+type CourseServiceReportCourseCommentResult struct {
+	Success *ReportCourseCommentResp `thrift:"success,0,optional"`
 }
 
-func NewCourseServiceSubmitCourseCommentResult() *CourseServiceSubmitCourseCommentResult {
-	return &CourseServiceSubmitCourseCommentResult{}
+func NewCourseServiceReportCourseCommentResult() *CourseServiceReportCourseCommentResult {
+	return &CourseServiceReportCourseCommentResult{}
 }
 
-func (p *CourseServiceSubmitCourseCommentResult) InitDefault() {
+func (p *CourseServiceReportCourseCommentResult) InitDefault() {
 }
 
-var CourseServiceSubmitCourseCommentResult_Success_DEFAULT *SubmitCourseCommentResp
+var CourseServiceReportCourseCommentResult_Success_DEFAULT *ReportCourseCommentResp
 
-func (p *CourseServiceSubmitCourseCommentResult) GetSuccess() (v *SubmitCourseCommentResp) {
+func (p *CourseServiceReportCourseCommentResult) GetSuccess() (v *ReportCourseCommentResp) {
 	if !p.IsSetSuccess() {
-		return CourseServiceSubmitCourseCommentResult_Success_DEFAULT
+		return CourseServiceReportCourseCommentResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_CourseServiceSubmitCourseCommentResult = map[int16]string{
+var fieldIDToName_CourseServiceReportCourseCommentResult = map[int16]string{
 	0: "success",
 }
 
-func (p *CourseServiceSubmitCourseCommentResult) IsSetSuccess() bool {
+func (p *CourseServiceReportCourseCommentResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *CourseServiceSubmitCourseCommentResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *CourseServiceReportCourseCommentResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -7341,7 +9975,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CourseServiceSubmitCourseCommentResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CourseServiceReportCourseCommentResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7351,8 +9985,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *CourseServiceSubmitCourseCommentResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewSubmitCourseCommentResp()
+func (p *CourseServiceReportCourseCommentResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewReportCourseCommentResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -7360,9 +9994,9 @@ func (p *CourseServiceSubmitCourseCommentResult) ReadField0(iprot thrift.TProtoc
 	return nil
 }
 
-func (p *CourseServiceSubmitCourseCommentResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *CourseServiceReportCourseCommentResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("submitCourseComment_result"); err != nil {
+	if err = oprot.WriteStructBegin("reportCourseComment_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7388,7 +10022,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *CourseServiceSubmitCourseCommentResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *CourseServiceReportCourseCommentResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -7407,43 +10041,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *CourseServiceSubmitCourseCommentResult) String() string {
+func (p *CourseServiceReportCourseCommentResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CourseServiceSubmitCourseCommentResult(%+v)", *p)
+	return fmt.Sprintf("CourseServiceReportCourseCommentResult(%+v)", *p)
 
 }
 
-type CourseServiceDeleteCourseCommentArgs struct {
-	Req *DeleteCourseCommentReq `thrift:"req,1"`
+type CourseServiceReportCourseRatingArgs struct {
+	Req *ReportCourseRatingReq `thrift:"req,1"`
 }
 
-func NewCourseServiceDeleteCourseCommentArgs() *CourseServiceDeleteCourseCommentArgs {
-	return &CourseServiceDeleteCourseCommentArgs{}
+func NewCourseServiceReportCourseRatingArgs() *CourseServiceReportCourseRatingArgs {
+	return &CourseServiceReportCourseRatingArgs{}
 }
 
-func (p *CourseServiceDeleteCourseCommentArgs) InitDefault() {
+func (p *CourseServiceReportCourseRatingArgs) InitDefault() {
 }
 
-var CourseServiceDeleteCourseCommentArgs_Req_DEFAULT *DeleteCourseCommentReq
+var CourseServiceReportCourseRatingArgs_Req_DEFAULT *ReportCourseRatingReq
 
-func (p *CourseServiceDeleteCourseCommentArgs) GetReq() (v *DeleteCourseCommentReq) {
+func (p *CourseServiceReportCourseRatingArgs) GetReq() (v *ReportCourseRatingReq) {
 	if !p.IsSetReq() {
-		return CourseServiceDeleteCourseCommentArgs_Req_DEFAULT
+		return CourseServiceReportCourseRatingArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_CourseServiceDeleteCourseCommentArgs = map[int16]string{
+var fieldIDToName_CourseServiceReportCourseRatingArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *CourseServiceDeleteCourseCommentArgs) IsSetReq() bool {
+func (p *CourseServiceReportCourseRatingArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *CourseServiceDeleteCourseCommentArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *CourseServiceReportCourseRatingArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -7489,7 +10123,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CourseServiceDeleteCourseCommentArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CourseServiceReportCourseRatingArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7499,8 +10133,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *CourseServiceDeleteCourseCommentArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewDeleteCourseCommentReq()
+func (p *CourseServiceReportCourseRatingArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewReportCourseRatingReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -7508,9 +10142,9 @@ func (p *CourseServiceDeleteCourseCommentArgs) ReadField1(iprot thrift.TProtocol
 	return nil
 }
 
-func (p *CourseServiceDeleteCourseCommentArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *CourseServiceReportCourseRatingArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("deleteCourseComment_args"); err != nil {
+	if err = oprot.WriteStructBegin("reportCourseRating_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7536,7 +10170,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *CourseServiceDeleteCourseCommentArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *CourseServiceReportCourseRatingArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -7553,43 +10187,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *CourseServiceDeleteCourseCommentArgs) String() string {
+func (p *CourseServiceReportCourseRatingArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CourseServiceDeleteCourseCommentArgs(%+v)", *p)
+	return fmt.Sprintf("CourseServiceReportCourseRatingArgs(%+v)", *p)
 
 }
 
-type CourseServiceDeleteCourseCommentResult struct {
-	Success *DeleteCourseCommentResp `thrift:"success,0,optional"`
+type CourseServiceReportCourseRatingResult struct {
+	Success *ReportCourseRatingResp `thrift:"success,0,optional"`
 }
 
-func NewCourseServiceDeleteCourseCommentResult() *CourseServiceDeleteCourseCommentResult {
-	return &CourseServiceDeleteCourseCommentResult{}
+func NewCourseServiceReportCourseRatingResult() *CourseServiceReportCourseRatingResult {
+	return &CourseServiceReportCourseRatingResult{}
 }
 
-func (p *CourseServiceDeleteCourseCommentResult) InitDefault() {
+func (p *CourseServiceReportCourseRatingResult) InitDefault() {
 }
 
-var CourseServiceDeleteCourseCommentResult_Success_DEFAULT *DeleteCourseCommentResp
+var CourseServiceReportCourseRatingResult_Success_DEFAULT *ReportCourseRatingResp
 
-func (p *CourseServiceDeleteCourseCommentResult) GetSuccess() (v *DeleteCourseCommentResp) {
+func (p *CourseServiceReportCourseRatingResult) GetSuccess() (v *ReportCourseRatingResp) {
 	if !p.IsSetSuccess() {
-		return CourseServiceDeleteCourseCommentResult_Success_DEFAULT
+		return CourseServiceReportCourseRatingResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_CourseServiceDeleteCourseCommentResult = map[int16]string{
+var fieldIDToName_CourseServiceReportCourseRatingResult = map[int16]string{
 	0: "success",
 }
 
-func (p *CourseServiceDeleteCourseCommentResult) IsSetSuccess() bool {
+func (p *CourseServiceReportCourseRatingResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *CourseServiceDeleteCourseCommentResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *CourseServiceReportCourseRatingResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -7635,7 +10269,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CourseServiceDeleteCourseCommentResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CourseServiceReportCourseRatingResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7645,8 +10279,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *CourseServiceDeleteCourseCommentResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewDeleteCourseCommentResp()
+func (p *CourseServiceReportCourseRatingResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewReportCourseRatingResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -7654,9 +10288,9 @@ func (p *CourseServiceDeleteCourseCommentResult) ReadField0(iprot thrift.TProtoc
 	return nil
 }
 
-func (p *CourseServiceDeleteCourseCommentResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *CourseServiceReportCourseRatingResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("deleteCourseComment_result"); err != nil {
+	if err = oprot.WriteStructBegin("reportCourseRating_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7682,7 +10316,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *CourseServiceDeleteCourseCommentResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *CourseServiceReportCourseRatingResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -7701,43 +10335,328 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *CourseServiceDeleteCourseCommentResult) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *CourseServiceReportCourseRatingResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CourseServiceReportCourseRatingResult(%+v)", *p)
+
+}
+
+type AdminCourseServiceProcessor struct {
+	processorMap map[string]thrift.TProcessorFunction
+	handler      AdminCourseService
+}
+
+func (p *AdminCourseServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
+	p.processorMap[key] = processor
+}
+
+func (p *AdminCourseServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
+	processor, ok = p.processorMap[key]
+	return processor, ok
+}
+
+func (p *AdminCourseServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
+	return p.processorMap
+}
+
+func NewAdminCourseServiceProcessor(handler AdminCourseService) *AdminCourseServiceProcessor {
+	self := &AdminCourseServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
+	self.AddToProcessorMap("AdminDeleteCourseComment", &adminCourseServiceProcessorAdminDeleteCourseComment{handler: handler})
+	self.AddToProcessorMap("AdminDeleteCourseRating", &adminCourseServiceProcessorAdminDeleteCourseRating{handler: handler})
+	self.AddToProcessorMap("AdminDeleteCourse", &adminCourseServiceProcessorAdminDeleteCourse{handler: handler})
+	self.AddToProcessorMap("AdminRestoreCourse", &adminCourseServiceProcessorAdminRestoreCourse{handler: handler})
+	self.AddToProcessorMap("AdminRestoreCourseComment", &adminCourseServiceProcessorAdminRestoreCourseComment{handler: handler})
+	return self
+}
+func (p *AdminCourseServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	name, _, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return false, err
+	}
+	if processor, ok := p.GetProcessorFunction(name); ok {
+		return processor.Process(ctx, seqId, iprot, oprot)
+	}
+	iprot.Skip(thrift.STRUCT)
+	iprot.ReadMessageEnd()
+	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
+	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
+	x.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Flush(ctx)
+	return false, x
+}
+
+type adminCourseServiceProcessorAdminDeleteCourseComment struct {
+	handler AdminCourseService
+}
+
+func (p *adminCourseServiceProcessorAdminDeleteCourseComment) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := AdminCourseServiceAdminDeleteCourseCommentArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("AdminDeleteCourseComment", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := AdminCourseServiceAdminDeleteCourseCommentResult{}
+	var retval *AdminDeleteCourseCommentResp
+	if retval, err2 = p.handler.AdminDeleteCourseComment(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing AdminDeleteCourseComment: "+err2.Error())
+		oprot.WriteMessageBegin("AdminDeleteCourseComment", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("AdminDeleteCourseComment", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type adminCourseServiceProcessorAdminDeleteCourseRating struct {
+	handler AdminCourseService
+}
+
+func (p *adminCourseServiceProcessorAdminDeleteCourseRating) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := AdminCourseServiceAdminDeleteCourseRatingArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("AdminDeleteCourseRating", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := AdminCourseServiceAdminDeleteCourseRatingResult{}
+	var retval *AdminDeleteCourseRatingResp
+	if retval, err2 = p.handler.AdminDeleteCourseRating(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing AdminDeleteCourseRating: "+err2.Error())
+		oprot.WriteMessageBegin("AdminDeleteCourseRating", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("AdminDeleteCourseRating", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type adminCourseServiceProcessorAdminDeleteCourse struct {
+	handler AdminCourseService
+}
+
+func (p *adminCourseServiceProcessorAdminDeleteCourse) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := AdminCourseServiceAdminDeleteCourseArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("AdminDeleteCourse", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := AdminCourseServiceAdminDeleteCourseResult{}
+	var retval *AdminDeleteCourseResp
+	if retval, err2 = p.handler.AdminDeleteCourse(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing AdminDeleteCourse: "+err2.Error())
+		oprot.WriteMessageBegin("AdminDeleteCourse", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("AdminDeleteCourse", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type adminCourseServiceProcessorAdminRestoreCourse struct {
+	handler AdminCourseService
+}
+
+func (p *adminCourseServiceProcessorAdminRestoreCourse) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := AdminCourseServiceAdminRestoreCourseArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("AdminRestoreCourse", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := AdminCourseServiceAdminRestoreCourseResult{}
+	var retval *AdminRestoreCourseResp
+	if retval, err2 = p.handler.AdminRestoreCourse(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing AdminRestoreCourse: "+err2.Error())
+		oprot.WriteMessageBegin("AdminRestoreCourse", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("AdminRestoreCourse", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type adminCourseServiceProcessorAdminRestoreCourseComment struct {
+	handler AdminCourseService
+}
+
+func (p *adminCourseServiceProcessorAdminRestoreCourseComment) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := AdminCourseServiceAdminRestoreCourseCommentArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("AdminRestoreCourseComment", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
 	}
-	return fmt.Sprintf("CourseServiceDeleteCourseCommentResult(%+v)", *p)
 
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := AdminCourseServiceAdminRestoreCourseCommentResult{}
+	var retval *AdminRestoreCourseCommentResp
+	if retval, err2 = p.handler.AdminRestoreCourseComment(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing AdminRestoreCourseComment: "+err2.Error())
+		oprot.WriteMessageBegin("AdminRestoreCourseComment", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("AdminRestoreCourseComment", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
 }
 
-type CourseServiceDeleteCourseRatingArgs struct {
-	Req *DeleteCourseRatingReq `thrift:"req,1"`
+type AdminCourseServiceAdminDeleteCourseCommentArgs struct {
+	Req *AdminDeleteCourseCommentReq `thrift:"req,1"`
 }
 
-func NewCourseServiceDeleteCourseRatingArgs() *CourseServiceDeleteCourseRatingArgs {
-	return &CourseServiceDeleteCourseRatingArgs{}
+func NewAdminCourseServiceAdminDeleteCourseCommentArgs() *AdminCourseServiceAdminDeleteCourseCommentArgs {
+	return &AdminCourseServiceAdminDeleteCourseCommentArgs{}
 }
 
-func (p *CourseServiceDeleteCourseRatingArgs) InitDefault() {
+func (p *AdminCourseServiceAdminDeleteCourseCommentArgs) InitDefault() {
 }
 
-var CourseServiceDeleteCourseRatingArgs_Req_DEFAULT *DeleteCourseRatingReq
+var AdminCourseServiceAdminDeleteCourseCommentArgs_Req_DEFAULT *AdminDeleteCourseCommentReq
 
-func (p *CourseServiceDeleteCourseRatingArgs) GetReq() (v *DeleteCourseRatingReq) {
+func (p *AdminCourseServiceAdminDeleteCourseCommentArgs) GetReq() (v *AdminDeleteCourseCommentReq) {
 	if !p.IsSetReq() {
-		return CourseServiceDeleteCourseRatingArgs_Req_DEFAULT
+		return AdminCourseServiceAdminDeleteCourseCommentArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_CourseServiceDeleteCourseRatingArgs = map[int16]string{
+var fieldIDToName_AdminCourseServiceAdminDeleteCourseCommentArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *CourseServiceDeleteCourseRatingArgs) IsSetReq() bool {
+func (p *AdminCourseServiceAdminDeleteCourseCommentArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *CourseServiceDeleteCourseRatingArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *AdminCourseServiceAdminDeleteCourseCommentArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -7783,7 +10702,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CourseServiceDeleteCourseRatingArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminCourseServiceAdminDeleteCourseCommentArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7793,8 +10712,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *CourseServiceDeleteCourseRatingArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewDeleteCourseRatingReq()
+func (p *AdminCourseServiceAdminDeleteCourseCommentArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewAdminDeleteCourseCommentReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -7802,9 +10721,9 @@ func (p *CourseServiceDeleteCourseRatingArgs) ReadField1(iprot thrift.TProtocol)
 	return nil
 }
 
-func (p *CourseServiceDeleteCourseRatingArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *AdminCourseServiceAdminDeleteCourseCommentArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("deleteCourseRating_args"); err != nil {
+	if err = oprot.WriteStructBegin("AdminDeleteCourseComment_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7830,7 +10749,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *CourseServiceDeleteCourseRatingArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *AdminCourseServiceAdminDeleteCourseCommentArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -7847,43 +10766,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *CourseServiceDeleteCourseRatingArgs) String() string {
+func (p *AdminCourseServiceAdminDeleteCourseCommentArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CourseServiceDeleteCourseRatingArgs(%+v)", *p)
+	return fmt.Sprintf("AdminCourseServiceAdminDeleteCourseCommentArgs(%+v)", *p)
 
 }
 
-type CourseServiceDeleteCourseRatingResult struct {
-	Success *DeleteCourseRatingResp `thrift:"success,0,optional"`
+type AdminCourseServiceAdminDeleteCourseCommentResult struct {
+	Success *AdminDeleteCourseCommentResp `thrift:"success,0,optional"`
 }
 
-func NewCourseServiceDeleteCourseRatingResult() *CourseServiceDeleteCourseRatingResult {
-	return &CourseServiceDeleteCourseRatingResult{}
+func NewAdminCourseServiceAdminDeleteCourseCommentResult() *AdminCourseServiceAdminDeleteCourseCommentResult {
+	return &AdminCourseServiceAdminDeleteCourseCommentResult{}
 }
 
-func (p *CourseServiceDeleteCourseRatingResult) InitDefault() {
+func (p *AdminCourseServiceAdminDeleteCourseCommentResult) InitDefault() {
 }
 
-var CourseServiceDeleteCourseRatingResult_Success_DEFAULT *DeleteCourseRatingResp
+var AdminCourseServiceAdminDeleteCourseCommentResult_Success_DEFAULT *AdminDeleteCourseCommentResp
 
-func (p *CourseServiceDeleteCourseRatingResult) GetSuccess() (v *DeleteCourseRatingResp) {
+func (p *AdminCourseServiceAdminDeleteCourseCommentResult) GetSuccess() (v *AdminDeleteCourseCommentResp) {
 	if !p.IsSetSuccess() {
-		return CourseServiceDeleteCourseRatingResult_Success_DEFAULT
+		return AdminCourseServiceAdminDeleteCourseCommentResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_CourseServiceDeleteCourseRatingResult = map[int16]string{
+var fieldIDToName_AdminCourseServiceAdminDeleteCourseCommentResult = map[int16]string{
 	0: "success",
 }
 
-func (p *CourseServiceDeleteCourseRatingResult) IsSetSuccess() bool {
+func (p *AdminCourseServiceAdminDeleteCourseCommentResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *CourseServiceDeleteCourseRatingResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *AdminCourseServiceAdminDeleteCourseCommentResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -7929,7 +10848,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CourseServiceDeleteCourseRatingResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminCourseServiceAdminDeleteCourseCommentResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7939,8 +10858,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *CourseServiceDeleteCourseRatingResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewDeleteCourseRatingResp()
+func (p *AdminCourseServiceAdminDeleteCourseCommentResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewAdminDeleteCourseCommentResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -7948,9 +10867,9 @@ func (p *CourseServiceDeleteCourseRatingResult) ReadField0(iprot thrift.TProtoco
 	return nil
 }
 
-func (p *CourseServiceDeleteCourseRatingResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *AdminCourseServiceAdminDeleteCourseCommentResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("deleteCourseRating_result"); err != nil {
+	if err = oprot.WriteStructBegin("AdminDeleteCourseComment_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7976,7 +10895,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *CourseServiceDeleteCourseRatingResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *AdminCourseServiceAdminDeleteCourseCommentResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -7984,200 +10903,54 @@ func (p *CourseServiceDeleteCourseRatingResult) writeField0(oprot thrift.TProtoc
 		if err := p.Success.Write(oprot); err != nil {
 			return err
 		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
-}
-
-func (p *CourseServiceDeleteCourseRatingResult) String() string {
-	if p == nil {
-		return "<nil>"
-	}
-	return fmt.Sprintf("CourseServiceDeleteCourseRatingResult(%+v)", *p)
-
-}
-
-type CourseServiceReactCourseCommentArgs struct {
-	Req *SubmitCourseCommentReactionReq `thrift:"req,1"`
-}
-
-func NewCourseServiceReactCourseCommentArgs() *CourseServiceReactCourseCommentArgs {
-	return &CourseServiceReactCourseCommentArgs{}
-}
-
-func (p *CourseServiceReactCourseCommentArgs) InitDefault() {
-}
-
-var CourseServiceReactCourseCommentArgs_Req_DEFAULT *SubmitCourseCommentReactionReq
-
-func (p *CourseServiceReactCourseCommentArgs) GetReq() (v *SubmitCourseCommentReactionReq) {
-	if !p.IsSetReq() {
-		return CourseServiceReactCourseCommentArgs_Req_DEFAULT
-	}
-	return p.Req
-}
-
-var fieldIDToName_CourseServiceReactCourseCommentArgs = map[int16]string{
-	1: "req",
-}
-
-func (p *CourseServiceReactCourseCommentArgs) IsSetReq() bool {
-	return p.Req != nil
-}
-
-func (p *CourseServiceReactCourseCommentArgs) Read(iprot thrift.TProtocol) (err error) {
-
-	var fieldTypeId thrift.TType
-	var fieldId int16
-
-	if _, err = iprot.ReadStructBegin(); err != nil {
-		goto ReadStructBeginError
-	}
-
-	for {
-		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
-		if err != nil {
-			goto ReadFieldBeginError
-		}
-		if fieldTypeId == thrift.STOP {
-			break
-		}
-
-		switch fieldId {
-		case 1:
-			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField1(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		default:
-			if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		}
-		if err = iprot.ReadFieldEnd(); err != nil {
-			goto ReadFieldEndError
-		}
-	}
-	if err = iprot.ReadStructEnd(); err != nil {
-		goto ReadStructEndError
-	}
-
-	return nil
-ReadStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
-ReadFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
-ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CourseServiceReactCourseCommentArgs[fieldId]), err)
-SkipFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
-
-ReadFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
-ReadStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-}
-
-func (p *CourseServiceReactCourseCommentArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewSubmitCourseCommentReactionReq()
-	if err := _field.Read(iprot); err != nil {
-		return err
-	}
-	p.Req = _field
-	return nil
-}
-
-func (p *CourseServiceReactCourseCommentArgs) Write(oprot thrift.TProtocol) (err error) {
-	var fieldId int16
-	if err = oprot.WriteStructBegin("reactCourseComment_args"); err != nil {
-		goto WriteStructBeginError
-	}
-	if p != nil {
-		if err = p.writeField1(oprot); err != nil {
-			fieldId = 1
-			goto WriteFieldError
-		}
-	}
-	if err = oprot.WriteFieldStop(); err != nil {
-		goto WriteFieldStopError
-	}
-	if err = oprot.WriteStructEnd(); err != nil {
-		goto WriteStructEndError
-	}
-	return nil
-WriteStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
-WriteFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
-WriteFieldStopError:
-	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
-WriteStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
-}
-
-func (p *CourseServiceReactCourseCommentArgs) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := p.Req.Write(oprot); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *CourseServiceReactCourseCommentArgs) String() string {
+func (p *AdminCourseServiceAdminDeleteCourseCommentResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CourseServiceReactCourseCommentArgs(%+v)", *p)
+	return fmt.Sprintf("AdminCourseServiceAdminDeleteCourseCommentResult(%+v)", *p)
 
 }
 
-type CourseServiceReactCourseCommentResult struct {
-	Success *SubmitCourseCommentReactionResp `thrift:"success,0,optional"`
+type AdminCourseServiceAdminDeleteCourseRatingArgs struct {
+	Req *AdminDeleteCourseRatingReq `thrift:"req,1"`
 }
 
-func NewCourseServiceReactCourseCommentResult() *CourseServiceReactCourseCommentResult {
-	return &CourseServiceReactCourseCommentResult{}
+func NewAdminCourseServiceAdminDeleteCourseRatingArgs() *AdminCourseServiceAdminDeleteCourseRatingArgs {
+	return &AdminCourseServiceAdminDeleteCourseRatingArgs{}
 }
 
-func (p *CourseServiceReactCourseCommentResult) InitDefault() {
+func (p *AdminCourseServiceAdminDeleteCourseRatingArgs) InitDefault() {
 }
 
-var CourseServiceReactCourseCommentResult_Success_DEFAULT *SubmitCourseCommentReactionResp
+var AdminCourseServiceAdminDeleteCourseRatingArgs_Req_DEFAULT *AdminDeleteCourseRatingReq
 
-func (p *CourseServiceReactCourseCommentResult) GetSuccess() (v *SubmitCourseCommentReactionResp) {
-	if !p.IsSetSuccess() {
-		return CourseServiceReactCourseCommentResult_Success_DEFAULT
+func (p *AdminCourseServiceAdminDeleteCourseRatingArgs) GetReq() (v *AdminDeleteCourseRatingReq) {
+	if !p.IsSetReq() {
+		return AdminCourseServiceAdminDeleteCourseRatingArgs_Req_DEFAULT
 	}
-	return p.Success
+	return p.Req
 }
 
-var fieldIDToName_CourseServiceReactCourseCommentResult = map[int16]string{
-	0: "success",
+var fieldIDToName_AdminCourseServiceAdminDeleteCourseRatingArgs = map[int16]string{
+	1: "req",
 }
 
-func (p *CourseServiceReactCourseCommentResult) IsSetSuccess() bool {
-	return p.Success != nil
+func (p *AdminCourseServiceAdminDeleteCourseRatingArgs) IsSetReq() bool {
+	return p.Req != nil
 }
 
-func (p *CourseServiceReactCourseCommentResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *AdminCourseServiceAdminDeleteCourseRatingArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -8196,9 +10969,9 @@ func (p *CourseServiceReactCourseCommentResult) Read(iprot thrift.TProtocol) (er
 		}
 
 		switch fieldId {
-		case 0:
+		case 1:
 			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField0(iprot); err != nil {
+				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
@@ -8223,7 +10996,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CourseServiceReactCourseCommentResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminCourseServiceAdminDeleteCourseRatingArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -8233,23 +11006,23 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *CourseServiceReactCourseCommentResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewSubmitCourseCommentReactionResp()
+func (p *AdminCourseServiceAdminDeleteCourseRatingArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewAdminDeleteCourseRatingReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
-	p.Success = _field
+	p.Req = _field
 	return nil
 }
 
-func (p *CourseServiceReactCourseCommentResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *AdminCourseServiceAdminDeleteCourseRatingArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("reactCourseComment_result"); err != nil {
+	if err = oprot.WriteStructBegin("AdminDeleteCourseRating_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
-		if err = p.writeField0(oprot); err != nil {
-			fieldId = 0
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
 			goto WriteFieldError
 		}
 	}
@@ -8270,249 +11043,208 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *CourseServiceReactCourseCommentResult) writeField0(oprot thrift.TProtocol) (err error) {
-	if p.IsSetSuccess() {
-		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := p.Success.Write(oprot); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+func (p *AdminCourseServiceAdminDeleteCourseRatingArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *CourseServiceReactCourseCommentResult) String() string {
+func (p *AdminCourseServiceAdminDeleteCourseRatingArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CourseServiceReactCourseCommentResult(%+v)", *p)
+	return fmt.Sprintf("AdminCourseServiceAdminDeleteCourseRatingArgs(%+v)", *p)
 
 }
 
-type AdminCourseServiceProcessor struct {
-	processorMap map[string]thrift.TProcessorFunction
-	handler      AdminCourseService
+type AdminCourseServiceAdminDeleteCourseRatingResult struct {
+	Success *AdminDeleteCourseRatingResp `thrift:"success,0,optional"`
 }
 
-func (p *AdminCourseServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
-	p.processorMap[key] = processor
+func NewAdminCourseServiceAdminDeleteCourseRatingResult() *AdminCourseServiceAdminDeleteCourseRatingResult {
+	return &AdminCourseServiceAdminDeleteCourseRatingResult{}
 }
 
-func (p *AdminCourseServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
-	processor, ok = p.processorMap[key]
-	return processor, ok
+func (p *AdminCourseServiceAdminDeleteCourseRatingResult) InitDefault() {
 }
 
-func (p *AdminCourseServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
-	return p.processorMap
-}
+var AdminCourseServiceAdminDeleteCourseRatingResult_Success_DEFAULT *AdminDeleteCourseRatingResp
 
-func NewAdminCourseServiceProcessor(handler AdminCourseService) *AdminCourseServiceProcessor {
-	self := &AdminCourseServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
-	self.AddToProcessorMap("AdminDeleteCourseComment", &adminCourseServiceProcessorAdminDeleteCourseComment{handler: handler})
-	self.AddToProcessorMap("AdminDeleteCourseRating", &adminCourseServiceProcessorAdminDeleteCourseRating{handler: handler})
-	self.AddToProcessorMap("AdminDeleteCourse", &adminCourseServiceProcessorAdminDeleteCourse{handler: handler})
-	return self
-}
-func (p *AdminCourseServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	name, _, seqId, err := iprot.ReadMessageBegin()
-	if err != nil {
-		return false, err
-	}
-	if processor, ok := p.GetProcessorFunction(name); ok {
-		return processor.Process(ctx, seqId, iprot, oprot)
+func (p *AdminCourseServiceAdminDeleteCourseRatingResult) GetSuccess() (v *AdminDeleteCourseRatingResp) {
+	if !p.IsSetSuccess() {
+		return AdminCourseServiceAdminDeleteCourseRatingResult_Success_DEFAULT
 	}
-	iprot.Skip(thrift.STRUCT)
-	iprot.ReadMessageEnd()
-	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
-	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
-	x.Write(oprot)
-	oprot.WriteMessageEnd()
-	oprot.Flush(ctx)
-	return false, x
+	return p.Success
 }
 
-type adminCourseServiceProcessorAdminDeleteCourseComment struct {
-	handler AdminCourseService
+var fieldIDToName_AdminCourseServiceAdminDeleteCourseRatingResult = map[int16]string{
+	0: "success",
 }
 
-func (p *adminCourseServiceProcessorAdminDeleteCourseComment) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := AdminCourseServiceAdminDeleteCourseCommentArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("AdminDeleteCourseComment", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
-
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := AdminCourseServiceAdminDeleteCourseCommentResult{}
-	var retval *AdminDeleteCourseCommentResp
-	if retval, err2 = p.handler.AdminDeleteCourseComment(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing AdminDeleteCourseComment: "+err2.Error())
-		oprot.WriteMessageBegin("AdminDeleteCourseComment", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("AdminDeleteCourseComment", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
-	}
-	return true, err
+func (p *AdminCourseServiceAdminDeleteCourseRatingResult) IsSetSuccess() bool {
+	return p.Success != nil
 }
 
-type adminCourseServiceProcessorAdminDeleteCourseRating struct {
-	handler AdminCourseService
-}
+func (p *AdminCourseServiceAdminDeleteCourseRatingResult) Read(iprot thrift.TProtocol) (err error) {
 
-func (p *adminCourseServiceProcessorAdminDeleteCourseRating) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := AdminCourseServiceAdminDeleteCourseRatingArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("AdminDeleteCourseRating", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+	var fieldTypeId thrift.TType
+	var fieldId int16
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := AdminCourseServiceAdminDeleteCourseRatingResult{}
-	var retval *AdminDeleteCourseRatingResp
-	if retval, err2 = p.handler.AdminDeleteCourseRating(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing AdminDeleteCourseRating: "+err2.Error())
-		oprot.WriteMessageBegin("AdminDeleteCourseRating", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("AdminDeleteCourseRating", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return true, err
-}
 
-type adminCourseServiceProcessorAdminDeleteCourse struct {
-	handler AdminCourseService
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminCourseServiceAdminDeleteCourseRatingResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *adminCourseServiceProcessorAdminDeleteCourse) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := AdminCourseServiceAdminDeleteCourseArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("AdminDeleteCourse", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *AdminCourseServiceAdminDeleteCourseRatingResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewAdminDeleteCourseRatingResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.Success = _field
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := AdminCourseServiceAdminDeleteCourseResult{}
-	var retval *AdminDeleteCourseResp
-	if retval, err2 = p.handler.AdminDeleteCourse(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing AdminDeleteCourse: "+err2.Error())
-		oprot.WriteMessageBegin("AdminDeleteCourse", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+func (p *AdminCourseServiceAdminDeleteCourseRatingResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AdminDeleteCourseRating_result"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageBegin("AdminDeleteCourse", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AdminCourseServiceAdminDeleteCourseRatingResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *AdminCourseServiceAdminDeleteCourseRatingResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return true, err
+	return fmt.Sprintf("AdminCourseServiceAdminDeleteCourseRatingResult(%+v)", *p)
+
 }
 
-type AdminCourseServiceAdminDeleteCourseCommentArgs struct {
-	Req *AdminDeleteCourseCommentReq `thrift:"req,1"`
+type AdminCourseServiceAdminDeleteCourseArgs struct {
+	Req *AdminDeleteCourseReq `thrift:"req,1"`
 }
 
-func NewAdminCourseServiceAdminDeleteCourseCommentArgs() *AdminCourseServiceAdminDeleteCourseCommentArgs {
-	return &AdminCourseServiceAdminDeleteCourseCommentArgs{}
+func NewAdminCourseServiceAdminDeleteCourseArgs() *AdminCourseServiceAdminDeleteCourseArgs {
+	return &AdminCourseServiceAdminDeleteCourseArgs{}
 }
 
-func (p *AdminCourseServiceAdminDeleteCourseCommentArgs) InitDefault() {
+func (p *AdminCourseServiceAdminDeleteCourseArgs) InitDefault() {
 }
 
-var AdminCourseServiceAdminDeleteCourseCommentArgs_Req_DEFAULT *AdminDeleteCourseCommentReq
+var AdminCourseServiceAdminDeleteCourseArgs_Req_DEFAULT *AdminDeleteCourseReq
 
-func (p *AdminCourseServiceAdminDeleteCourseCommentArgs) GetReq() (v *AdminDeleteCourseCommentReq) {
+func (p *AdminCourseServiceAdminDeleteCourseArgs) GetReq() (v *AdminDeleteCourseReq) {
 	if !p.IsSetReq() {
-		return AdminCourseServiceAdminDeleteCourseCommentArgs_Req_DEFAULT
+		return AdminCourseServiceAdminDeleteCourseArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_AdminCourseServiceAdminDeleteCourseCommentArgs = map[int16]string{
+var fieldIDToName_AdminCourseServiceAdminDeleteCourseArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *AdminCourseServiceAdminDeleteCourseCommentArgs) IsSetReq() bool {
+func (p *AdminCourseServiceAdminDeleteCourseArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *AdminCourseServiceAdminDeleteCourseCommentArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *AdminCourseServiceAdminDeleteCourseArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -8558,7 +11290,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminCourseServiceAdminDeleteCourseCommentArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminCourseServiceAdminDeleteCourseArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -8568,8 +11300,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *AdminCourseServiceAdminDeleteCourseCommentArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewAdminDeleteCourseCommentReq()
+func (p *AdminCourseServiceAdminDeleteCourseArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewAdminDeleteCourseReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -8577,9 +11309,9 @@ func (p *AdminCourseServiceAdminDeleteCourseCommentArgs) ReadField1(iprot thrift
 	return nil
 }
 
-func (p *AdminCourseServiceAdminDeleteCourseCommentArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *AdminCourseServiceAdminDeleteCourseArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("AdminDeleteCourseComment_args"); err != nil {
+	if err = oprot.WriteStructBegin("AdminDeleteCourse_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -8605,7 +11337,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AdminCourseServiceAdminDeleteCourseCommentArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *AdminCourseServiceAdminDeleteCourseArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -8622,43 +11354,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *AdminCourseServiceAdminDeleteCourseCommentArgs) String() string {
+func (p *AdminCourseServiceAdminDeleteCourseArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AdminCourseServiceAdminDeleteCourseCommentArgs(%+v)", *p)
+	return fmt.Sprintf("AdminCourseServiceAdminDeleteCourseArgs(%+v)", *p)
 
 }
 
-type AdminCourseServiceAdminDeleteCourseCommentResult struct {
-	Success *AdminDeleteCourseCommentResp `thrift:"success,0,optional"`
+type AdminCourseServiceAdminDeleteCourseResult struct {
+	Success *AdminDeleteCourseResp `thrift:"success,0,optional"`
 }
 
-func NewAdminCourseServiceAdminDeleteCourseCommentResult() *AdminCourseServiceAdminDeleteCourseCommentResult {
-	return &AdminCourseServiceAdminDeleteCourseCommentResult{}
+func NewAdminCourseServiceAdminDeleteCourseResult() *AdminCourseServiceAdminDeleteCourseResult {
+	return &AdminCourseServiceAdminDeleteCourseResult{}
 }
 
-func (p *AdminCourseServiceAdminDeleteCourseCommentResult) InitDefault() {
+func (p *AdminCourseServiceAdminDeleteCourseResult) InitDefault() {
 }
 
-var AdminCourseServiceAdminDeleteCourseCommentResult_Success_DEFAULT *AdminDeleteCourseCommentResp
+var AdminCourseServiceAdminDeleteCourseResult_Success_DEFAULT *AdminDeleteCourseResp
 
-func (p *AdminCourseServiceAdminDeleteCourseCommentResult) GetSuccess() (v *AdminDeleteCourseCommentResp) {
+func (p *AdminCourseServiceAdminDeleteCourseResult) GetSuccess() (v *AdminDeleteCourseResp) {
 	if !p.IsSetSuccess() {
-		return AdminCourseServiceAdminDeleteCourseCommentResult_Success_DEFAULT
+		return AdminCourseServiceAdminDeleteCourseResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_AdminCourseServiceAdminDeleteCourseCommentResult = map[int16]string{
+var fieldIDToName_AdminCourseServiceAdminDeleteCourseResult = map[int16]string{
 	0: "success",
 }
 
-func (p *AdminCourseServiceAdminDeleteCourseCommentResult) IsSetSuccess() bool {
+func (p *AdminCourseServiceAdminDeleteCourseResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *AdminCourseServiceAdminDeleteCourseCommentResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *AdminCourseServiceAdminDeleteCourseResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -8704,7 +11436,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminCourseServiceAdminDeleteCourseCommentResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminCourseServiceAdminDeleteCourseResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -8714,8 +11446,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *AdminCourseServiceAdminDeleteCourseCommentResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewAdminDeleteCourseCommentResp()
+func (p *AdminCourseServiceAdminDeleteCourseResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewAdminDeleteCourseResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -8723,9 +11455,9 @@ func (p *AdminCourseServiceAdminDeleteCourseCommentResult) ReadField0(iprot thri
 	return nil
 }
 
-func (p *AdminCourseServiceAdminDeleteCourseCommentResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *AdminCourseServiceAdminDeleteCourseResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("AdminDeleteCourseComment_result"); err != nil {
+	if err = oprot.WriteStructBegin("AdminDeleteCourse_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -8751,7 +11483,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AdminCourseServiceAdminDeleteCourseCommentResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *AdminCourseServiceAdminDeleteCourseResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -8770,43 +11502,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *AdminCourseServiceAdminDeleteCourseCommentResult) String() string {
+func (p *AdminCourseServiceAdminDeleteCourseResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AdminCourseServiceAdminDeleteCourseCommentResult(%+v)", *p)
+	return fmt.Sprintf("AdminCourseServiceAdminDeleteCourseResult(%+v)", *p)
 
 }
 
-type AdminCourseServiceAdminDeleteCourseRatingArgs struct {
-	Req *AdminDeleteCourseRatingReq `thrift:"req,1"`
+type AdminCourseServiceAdminRestoreCourseArgs struct {
+	Req *AdminRestoreCourseReq `thrift:"req,1"`
 }
 
-func NewAdminCourseServiceAdminDeleteCourseRatingArgs() *AdminCourseServiceAdminDeleteCourseRatingArgs {
-	return &AdminCourseServiceAdminDeleteCourseRatingArgs{}
+func NewAdminCourseServiceAdminRestoreCourseArgs() *AdminCourseServiceAdminRestoreCourseArgs {
+	return &AdminCourseServiceAdminRestoreCourseArgs{}
 }
 
-func (p *AdminCourseServiceAdminDeleteCourseRatingArgs) InitDefault() {
+func (p *AdminCourseServiceAdminRestoreCourseArgs) InitDefault() {
 }
 
-var AdminCourseServiceAdminDeleteCourseRatingArgs_Req_DEFAULT *AdminDeleteCourseRatingReq
+var AdminCourseServiceAdminRestoreCourseArgs_Req_DEFAULT *AdminRestoreCourseReq
 
-func (p *AdminCourseServiceAdminDeleteCourseRatingArgs) GetReq() (v *AdminDeleteCourseRatingReq) {
+func (p *AdminCourseServiceAdminRestoreCourseArgs) GetReq() (v *AdminRestoreCourseReq) {
 	if !p.IsSetReq() {
-		return AdminCourseServiceAdminDeleteCourseRatingArgs_Req_DEFAULT
+		return AdminCourseServiceAdminRestoreCourseArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_AdminCourseServiceAdminDeleteCourseRatingArgs = map[int16]string{
+var fieldIDToName_AdminCourseServiceAdminRestoreCourseArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *AdminCourseServiceAdminDeleteCourseRatingArgs) IsSetReq() bool {
+func (p *AdminCourseServiceAdminRestoreCourseArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *AdminCourseServiceAdminDeleteCourseRatingArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *AdminCourseServiceAdminRestoreCourseArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -8852,7 +11584,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminCourseServiceAdminDeleteCourseRatingArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminCourseServiceAdminRestoreCourseArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -8862,8 +11594,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *AdminCourseServiceAdminDeleteCourseRatingArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewAdminDeleteCourseRatingReq()
+func (p *AdminCourseServiceAdminRestoreCourseArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewAdminRestoreCourseReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -8871,9 +11603,9 @@ func (p *AdminCourseServiceAdminDeleteCourseRatingArgs) ReadField1(iprot thrift.
 	return nil
 }
 
-func (p *AdminCourseServiceAdminDeleteCourseRatingArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *AdminCourseServiceAdminRestoreCourseArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("AdminDeleteCourseRating_args"); err != nil {
+	if err = oprot.WriteStructBegin("AdminRestoreCourse_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -8899,7 +11631,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AdminCourseServiceAdminDeleteCourseRatingArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *AdminCourseServiceAdminRestoreCourseArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -8916,43 +11648,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *AdminCourseServiceAdminDeleteCourseRatingArgs) String() string {
+func (p *AdminCourseServiceAdminRestoreCourseArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AdminCourseServiceAdminDeleteCourseRatingArgs(%+v)", *p)
+	return fmt.Sprintf("AdminCourseServiceAdminRestoreCourseArgs(%+v)", *p)
 
 }
 
-type AdminCourseServiceAdminDeleteCourseRatingResult struct {
-	Success *AdminDeleteCourseRatingResp `thrift:"success,0,optional"`
+type AdminCourseServiceAdminRestoreCourseResult struct {
+	Success *AdminRestoreCourseResp `thrift:"success,0,optional"`
 }
 
-func NewAdminCourseServiceAdminDeleteCourseRatingResult() *AdminCourseServiceAdminDeleteCourseRatingResult {
-	return &AdminCourseServiceAdminDeleteCourseRatingResult{}
+func NewAdminCourseServiceAdminRestoreCourseResult() *AdminCourseServiceAdminRestoreCourseResult {
+	return &AdminCourseServiceAdminRestoreCourseResult{}
 }
 
-func (p *AdminCourseServiceAdminDeleteCourseRatingResult) InitDefault() {
+func (p *AdminCourseServiceAdminRestoreCourseResult) InitDefault() {
 }
 
-var AdminCourseServiceAdminDeleteCourseRatingResult_Success_DEFAULT *AdminDeleteCourseRatingResp
+var AdminCourseServiceAdminRestoreCourseResult_Success_DEFAULT *AdminRestoreCourseResp
 
-func (p *AdminCourseServiceAdminDeleteCourseRatingResult) GetSuccess() (v *AdminDeleteCourseRatingResp) {
+func (p *AdminCourseServiceAdminRestoreCourseResult) GetSuccess() (v *AdminRestoreCourseResp) {
 	if !p.IsSetSuccess() {
-		return AdminCourseServiceAdminDeleteCourseRatingResult_Success_DEFAULT
+		return AdminCourseServiceAdminRestoreCourseResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_AdminCourseServiceAdminDeleteCourseRatingResult = map[int16]string{
+var fieldIDToName_AdminCourseServiceAdminRestoreCourseResult = map[int16]string{
 	0: "success",
 }
 
-func (p *AdminCourseServiceAdminDeleteCourseRatingResult) IsSetSuccess() bool {
+func (p *AdminCourseServiceAdminRestoreCourseResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *AdminCourseServiceAdminDeleteCourseRatingResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *AdminCourseServiceAdminRestoreCourseResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -8998,7 +11730,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminCourseServiceAdminDeleteCourseRatingResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminCourseServiceAdminRestoreCourseResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -9008,8 +11740,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *AdminCourseServiceAdminDeleteCourseRatingResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewAdminDeleteCourseRatingResp()
+func (p *AdminCourseServiceAdminRestoreCourseResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewAdminRestoreCourseResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -9017,9 +11749,9 @@ func (p *AdminCourseServiceAdminDeleteCourseRatingResult) ReadField0(iprot thrif
 	return nil
 }
 
-func (p *AdminCourseServiceAdminDeleteCourseRatingResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *AdminCourseServiceAdminRestoreCourseResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("AdminDeleteCourseRating_result"); err != nil {
+	if err = oprot.WriteStructBegin("AdminRestoreCourse_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -9045,7 +11777,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AdminCourseServiceAdminDeleteCourseRatingResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *AdminCourseServiceAdminRestoreCourseResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -9064,43 +11796,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *AdminCourseServiceAdminDeleteCourseRatingResult) String() string {
+func (p *AdminCourseServiceAdminRestoreCourseResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AdminCourseServiceAdminDeleteCourseRatingResult(%+v)", *p)
+	return fmt.Sprintf("AdminCourseServiceAdminRestoreCourseResult(%+v)", *p)
 
 }
 
-type AdminCourseServiceAdminDeleteCourseArgs struct {
-	Req *AdminDeleteCourseReq `thrift:"req,1"`
+type AdminCourseServiceAdminRestoreCourseCommentArgs struct {
+	Req *AdminRestoreCourseCommentReq `thrift:"req,1"`
 }
 
-func NewAdminCourseServiceAdminDeleteCourseArgs() *AdminCourseServiceAdminDeleteCourseArgs {
-	return &AdminCourseServiceAdminDeleteCourseArgs{}
+func NewAdminCourseServiceAdminRestoreCourseCommentArgs() *AdminCourseServiceAdminRestoreCourseCommentArgs {
+	return &AdminCourseServiceAdminRestoreCourseCommentArgs{}
 }
 
-func (p *AdminCourseServiceAdminDeleteCourseArgs) InitDefault() {
+func (p *AdminCourseServiceAdminRestoreCourseCommentArgs) InitDefault() {
 }
 
-var AdminCourseServiceAdminDeleteCourseArgs_Req_DEFAULT *AdminDeleteCourseReq
+var AdminCourseServiceAdminRestoreCourseCommentArgs_Req_DEFAULT *AdminRestoreCourseCommentReq
 
-func (p *AdminCourseServiceAdminDeleteCourseArgs) GetReq() (v *AdminDeleteCourseReq) {
+func (p *AdminCourseServiceAdminRestoreCourseCommentArgs) GetReq() (v *AdminRestoreCourseCommentReq) {
 	if !p.IsSetReq() {
-		return AdminCourseServiceAdminDeleteCourseArgs_Req_DEFAULT
+		return AdminCourseServiceAdminRestoreCourseCommentArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_AdminCourseServiceAdminDeleteCourseArgs = map[int16]string{
+var fieldIDToName_AdminCourseServiceAdminRestoreCourseCommentArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *AdminCourseServiceAdminDeleteCourseArgs) IsSetReq() bool {
+func (p *AdminCourseServiceAdminRestoreCourseCommentArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *AdminCourseServiceAdminDeleteCourseArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *AdminCourseServiceAdminRestoreCourseCommentArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -9146,7 +11878,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminCourseServiceAdminDeleteCourseArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminCourseServiceAdminRestoreCourseCommentArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -9156,8 +11888,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *AdminCourseServiceAdminDeleteCourseArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewAdminDeleteCourseReq()
+func (p *AdminCourseServiceAdminRestoreCourseCommentArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewAdminRestoreCourseCommentReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -9165,9 +11897,9 @@ func (p *AdminCourseServiceAdminDeleteCourseArgs) ReadField1(iprot thrift.TProto
 	return nil
 }
 
-func (p *AdminCourseServiceAdminDeleteCourseArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *AdminCourseServiceAdminRestoreCourseCommentArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("AdminDeleteCourse_args"); err != nil {
+	if err = oprot.WriteStructBegin("AdminRestoreCourseComment_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -9193,7 +11925,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AdminCourseServiceAdminDeleteCourseArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *AdminCourseServiceAdminRestoreCourseCommentArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -9210,43 +11942,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *AdminCourseServiceAdminDeleteCourseArgs) String() string {
+func (p *AdminCourseServiceAdminRestoreCourseCommentArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AdminCourseServiceAdminDeleteCourseArgs(%+v)", *p)
+	return fmt.Sprintf("AdminCourseServiceAdminRestoreCourseCommentArgs(%+v)", *p)
 
 }
 
-type AdminCourseServiceAdminDeleteCourseResult struct {
-	Success *AdminDeleteCourseResp `thrift:"success,0,optional"`
+type AdminCourseServiceAdminRestoreCourseCommentResult struct {
+	Success *AdminRestoreCourseCommentResp `thrift:"success,0,optional"`
 }
 
-func NewAdminCourseServiceAdminDeleteCourseResult() *AdminCourseServiceAdminDeleteCourseResult {
-	return &AdminCourseServiceAdminDeleteCourseResult{}
+func NewAdminCourseServiceAdminRestoreCourseCommentResult() *AdminCourseServiceAdminRestoreCourseCommentResult {
+	return &AdminCourseServiceAdminRestoreCourseCommentResult{}
 }
 
-func (p *AdminCourseServiceAdminDeleteCourseResult) InitDefault() {
+func (p *AdminCourseServiceAdminRestoreCourseCommentResult) InitDefault() {
 }
 
-var AdminCourseServiceAdminDeleteCourseResult_Success_DEFAULT *AdminDeleteCourseResp
+var AdminCourseServiceAdminRestoreCourseCommentResult_Success_DEFAULT *AdminRestoreCourseCommentResp
 
-func (p *AdminCourseServiceAdminDeleteCourseResult) GetSuccess() (v *AdminDeleteCourseResp) {
+func (p *AdminCourseServiceAdminRestoreCourseCommentResult) GetSuccess() (v *AdminRestoreCourseCommentResp) {
 	if !p.IsSetSuccess() {
-		return AdminCourseServiceAdminDeleteCourseResult_Success_DEFAULT
+		return AdminCourseServiceAdminRestoreCourseCommentResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_AdminCourseServiceAdminDeleteCourseResult = map[int16]string{
+var fieldIDToName_AdminCourseServiceAdminRestoreCourseCommentResult = map[int16]string{
 	0: "success",
 }
 
-func (p *AdminCourseServiceAdminDeleteCourseResult) IsSetSuccess() bool {
+func (p *AdminCourseServiceAdminRestoreCourseCommentResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *AdminCourseServiceAdminDeleteCourseResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *AdminCourseServiceAdminRestoreCourseCommentResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -9292,7 +12024,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminCourseServiceAdminDeleteCourseResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminCourseServiceAdminRestoreCourseCommentResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -9302,8 +12034,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *AdminCourseServiceAdminDeleteCourseResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewAdminDeleteCourseResp()
+func (p *AdminCourseServiceAdminRestoreCourseCommentResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewAdminRestoreCourseCommentResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -9311,9 +12043,9 @@ func (p *AdminCourseServiceAdminDeleteCourseResult) ReadField0(iprot thrift.TPro
 	return nil
 }
 
-func (p *AdminCourseServiceAdminDeleteCourseResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *AdminCourseServiceAdminRestoreCourseCommentResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("AdminDeleteCourse_result"); err != nil {
+	if err = oprot.WriteStructBegin("AdminRestoreCourseComment_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -9339,7 +12071,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AdminCourseServiceAdminDeleteCourseResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *AdminCourseServiceAdminRestoreCourseCommentResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -9358,10 +12090,10 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *AdminCourseServiceAdminDeleteCourseResult) String() string {
+func (p *AdminCourseServiceAdminRestoreCourseCommentResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AdminCourseServiceAdminDeleteCourseResult(%+v)", *p)
+	return fmt.Sprintf("AdminCourseServiceAdminRestoreCourseCommentResult(%+v)", *p)
 
 }
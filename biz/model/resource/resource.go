@@ -4708,30 +4708,38 @@ func (p *SubmitResourceCommentReactionResp) String() string {
 
 }
 
-type AdminDeleteResourceCommentReq struct {
-	CommentID int64 `thrift:"comment_id,1,required" json:"comment_id,required" path:"comment_id,required"`
+// 举报资源评分
+type ReportResourceRatingReq struct {
+	RatingID int64  `thrift:"rating_id,1,required" json:"rating_id,required" path:"rating_id,required"`
+	Reason   string `thrift:"reason,2,required" form:"reason,required" json:"reason,required"`
 }
 
-func NewAdminDeleteResourceCommentReq() *AdminDeleteResourceCommentReq {
-	return &AdminDeleteResourceCommentReq{}
+func NewReportResourceRatingReq() *ReportResourceRatingReq {
+	return &ReportResourceRatingReq{}
 }
 
-func (p *AdminDeleteResourceCommentReq) InitDefault() {
+func (p *ReportResourceRatingReq) InitDefault() {
 }
 
-func (p *AdminDeleteResourceCommentReq) GetCommentID() (v int64) {
-	return p.CommentID
+func (p *ReportResourceRatingReq) GetRatingID() (v int64) {
+	return p.RatingID
 }
 
-var fieldIDToName_AdminDeleteResourceCommentReq = map[int16]string{
-	1: "comment_id",
+func (p *ReportResourceRatingReq) GetReason() (v string) {
+	return p.Reason
 }
 
-func (p *AdminDeleteResourceCommentReq) Read(iprot thrift.TProtocol) (err error) {
+var fieldIDToName_ReportResourceRatingReq = map[int16]string{
+	1: "rating_id",
+	2: "reason",
+}
+
+func (p *ReportResourceRatingReq) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetCommentID bool = false
+	var issetRatingID bool = false
+	var issetReason bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -4752,7 +4760,16 @@ func (p *AdminDeleteResourceCommentReq) Read(iprot thrift.TProtocol) (err error)
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetCommentID = true
+				issetRatingID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetReason = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -4769,17 +4786,22 @@ func (p *AdminDeleteResourceCommentReq) Read(iprot thrift.TProtocol) (err error)
 		goto ReadStructEndError
 	}
 
-	if !issetCommentID {
+	if !issetRatingID {
 		fieldId = 1
 		goto RequiredFieldNotSetError
 	}
+
+	if !issetReason {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminDeleteResourceCommentReq[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ReportResourceRatingReq[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4788,10 +4810,10 @@ ReadFieldEndError:
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_AdminDeleteResourceCommentReq[fieldId]))
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ReportResourceRatingReq[fieldId]))
 }
 
-func (p *AdminDeleteResourceCommentReq) ReadField1(iprot thrift.TProtocol) error {
+func (p *ReportResourceRatingReq) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -4799,13 +4821,24 @@ func (p *AdminDeleteResourceCommentReq) ReadField1(iprot thrift.TProtocol) error
 	} else {
 		_field = v
 	}
-	p.CommentID = _field
+	p.RatingID = _field
 	return nil
 }
+func (p *ReportResourceRatingReq) ReadField2(iprot thrift.TProtocol) error {
 
-func (p *AdminDeleteResourceCommentReq) Write(oprot thrift.TProtocol) (err error) {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Reason = _field
+	return nil
+}
+
+func (p *ReportResourceRatingReq) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("AdminDeleteResourceCommentReq"); err != nil {
+	if err = oprot.WriteStructBegin("ReportResourceRatingReq"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4813,6 +4846,10 @@ func (p *AdminDeleteResourceCommentReq) Write(oprot thrift.TProtocol) (err error
 			fieldId = 1
 			goto WriteFieldError
 		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -4831,11 +4868,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AdminDeleteResourceCommentReq) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("comment_id", thrift.I64, 1); err != nil {
+func (p *ReportResourceRatingReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("rating_id", thrift.I64, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.CommentID); err != nil {
+	if err := oprot.WriteI64(p.RatingID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -4848,43 +4885,60 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *AdminDeleteResourceCommentReq) String() string {
+func (p *ReportResourceRatingReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("reason", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Reason); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *ReportResourceRatingReq) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AdminDeleteResourceCommentReq(%+v)", *p)
+	return fmt.Sprintf("ReportResourceRatingReq(%+v)", *p)
 
 }
 
-type AdminDeleteResourceCommentResp struct {
-	BaseResp *module.BaseResp `thrift:"base_resp,1,required" form:"base_resp,required" json:"base_resp,required" query:"base_resp,required"`
+type ReportResourceRatingResp struct {
+	BaseResp *module.BaseResp `thrift:"baseResp,1,required" form:"baseResp,required" json:"baseResp,required" query:"baseResp,required"`
 }
 
-func NewAdminDeleteResourceCommentResp() *AdminDeleteResourceCommentResp {
-	return &AdminDeleteResourceCommentResp{}
+func NewReportResourceRatingResp() *ReportResourceRatingResp {
+	return &ReportResourceRatingResp{}
 }
 
-func (p *AdminDeleteResourceCommentResp) InitDefault() {
+func (p *ReportResourceRatingResp) InitDefault() {
 }
 
-var AdminDeleteResourceCommentResp_BaseResp_DEFAULT *module.BaseResp
+var ReportResourceRatingResp_BaseResp_DEFAULT *module.BaseResp
 
-func (p *AdminDeleteResourceCommentResp) GetBaseResp() (v *module.BaseResp) {
+func (p *ReportResourceRatingResp) GetBaseResp() (v *module.BaseResp) {
 	if !p.IsSetBaseResp() {
-		return AdminDeleteResourceCommentResp_BaseResp_DEFAULT
+		return ReportResourceRatingResp_BaseResp_DEFAULT
 	}
 	return p.BaseResp
 }
 
-var fieldIDToName_AdminDeleteResourceCommentResp = map[int16]string{
-	1: "base_resp",
+var fieldIDToName_ReportResourceRatingResp = map[int16]string{
+	1: "baseResp",
 }
 
-func (p *AdminDeleteResourceCommentResp) IsSetBaseResp() bool {
+func (p *ReportResourceRatingResp) IsSetBaseResp() bool {
 	return p.BaseResp != nil
 }
 
-func (p *AdminDeleteResourceCommentResp) Read(iprot thrift.TProtocol) (err error) {
+func (p *ReportResourceRatingResp) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4936,7 +4990,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminDeleteResourceCommentResp[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ReportResourceRatingResp[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4945,10 +4999,10 @@ ReadFieldEndError:
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_AdminDeleteResourceCommentResp[fieldId]))
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ReportResourceRatingResp[fieldId]))
 }
 
-func (p *AdminDeleteResourceCommentResp) ReadField1(iprot thrift.TProtocol) error {
+func (p *ReportResourceRatingResp) ReadField1(iprot thrift.TProtocol) error {
 	_field := module.NewBaseResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -4957,9 +5011,9 @@ func (p *AdminDeleteResourceCommentResp) ReadField1(iprot thrift.TProtocol) erro
 	return nil
 }
 
-func (p *AdminDeleteResourceCommentResp) Write(oprot thrift.TProtocol) (err error) {
+func (p *ReportResourceRatingResp) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("AdminDeleteResourceCommentResp"); err != nil {
+	if err = oprot.WriteStructBegin("ReportResourceRatingResp"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4985,8 +5039,8 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AdminDeleteResourceCommentResp) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+func (p *ReportResourceRatingResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("baseResp", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
 	if err := p.BaseResp.Write(oprot); err != nil {
@@ -5002,38 +5056,46 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *AdminDeleteResourceCommentResp) String() string {
+func (p *ReportResourceRatingResp) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AdminDeleteResourceCommentResp(%+v)", *p)
+	return fmt.Sprintf("ReportResourceRatingResp(%+v)", *p)
 
 }
 
-type AdminDeleteResourceRatingReq struct {
-	RatingID int64 `thrift:"rating_id,1,required" json:"rating_id,required" path:"rating_id,required"`
+// 举报资源评论
+type ReportResourceCommentReq struct {
+	CommentID int64  `thrift:"comment_id,1,required" json:"comment_id,required" path:"comment_id,required"`
+	Reason    string `thrift:"reason,2,required" form:"reason,required" json:"reason,required"`
 }
 
-func NewAdminDeleteResourceRatingReq() *AdminDeleteResourceRatingReq {
-	return &AdminDeleteResourceRatingReq{}
+func NewReportResourceCommentReq() *ReportResourceCommentReq {
+	return &ReportResourceCommentReq{}
 }
 
-func (p *AdminDeleteResourceRatingReq) InitDefault() {
+func (p *ReportResourceCommentReq) InitDefault() {
 }
 
-func (p *AdminDeleteResourceRatingReq) GetRatingID() (v int64) {
-	return p.RatingID
+func (p *ReportResourceCommentReq) GetCommentID() (v int64) {
+	return p.CommentID
 }
 
-var fieldIDToName_AdminDeleteResourceRatingReq = map[int16]string{
-	1: "rating_id",
+func (p *ReportResourceCommentReq) GetReason() (v string) {
+	return p.Reason
 }
 
-func (p *AdminDeleteResourceRatingReq) Read(iprot thrift.TProtocol) (err error) {
+var fieldIDToName_ReportResourceCommentReq = map[int16]string{
+	1: "comment_id",
+	2: "reason",
+}
+
+func (p *ReportResourceCommentReq) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetRatingID bool = false
+	var issetCommentID bool = false
+	var issetReason bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -5054,7 +5116,16 @@ func (p *AdminDeleteResourceRatingReq) Read(iprot thrift.TProtocol) (err error)
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetRatingID = true
+				issetCommentID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetReason = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -5071,17 +5142,22 @@ func (p *AdminDeleteResourceRatingReq) Read(iprot thrift.TProtocol) (err error)
 		goto ReadStructEndError
 	}
 
-	if !issetRatingID {
+	if !issetCommentID {
 		fieldId = 1
 		goto RequiredFieldNotSetError
 	}
+
+	if !issetReason {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminDeleteResourceRatingReq[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ReportResourceCommentReq[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5090,10 +5166,10 @@ ReadFieldEndError:
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_AdminDeleteResourceRatingReq[fieldId]))
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ReportResourceCommentReq[fieldId]))
 }
 
-func (p *AdminDeleteResourceRatingReq) ReadField1(iprot thrift.TProtocol) error {
+func (p *ReportResourceCommentReq) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -5101,13 +5177,24 @@ func (p *AdminDeleteResourceRatingReq) ReadField1(iprot thrift.TProtocol) error
 	} else {
 		_field = v
 	}
-	p.RatingID = _field
+	p.CommentID = _field
 	return nil
 }
+func (p *ReportResourceCommentReq) ReadField2(iprot thrift.TProtocol) error {
 
-func (p *AdminDeleteResourceRatingReq) Write(oprot thrift.TProtocol) (err error) {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Reason = _field
+	return nil
+}
+
+func (p *ReportResourceCommentReq) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("AdminDeleteResourceRatingReq"); err != nil {
+	if err = oprot.WriteStructBegin("ReportResourceCommentReq"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5115,6 +5202,10 @@ func (p *AdminDeleteResourceRatingReq) Write(oprot thrift.TProtocol) (err error)
 			fieldId = 1
 			goto WriteFieldError
 		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -5133,11 +5224,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AdminDeleteResourceRatingReq) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("rating_id", thrift.I64, 1); err != nil {
+func (p *ReportResourceCommentReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("comment_id", thrift.I64, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.RatingID); err != nil {
+	if err := oprot.WriteI64(p.CommentID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -5150,43 +5241,60 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *AdminDeleteResourceRatingReq) String() string {
+func (p *ReportResourceCommentReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("reason", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Reason); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *ReportResourceCommentReq) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AdminDeleteResourceRatingReq(%+v)", *p)
+	return fmt.Sprintf("ReportResourceCommentReq(%+v)", *p)
 
 }
 
-type AdminDeleteResourceRatingResp struct {
-	BaseResp *module.BaseResp `thrift:"base_resp,1,required" form:"base_resp,required" json:"base_resp,required" query:"base_resp,required"`
+type ReportResourceCommentResp struct {
+	BaseResp *module.BaseResp `thrift:"baseResp,1,required" form:"baseResp,required" json:"baseResp,required" query:"baseResp,required"`
 }
 
-func NewAdminDeleteResourceRatingResp() *AdminDeleteResourceRatingResp {
-	return &AdminDeleteResourceRatingResp{}
+func NewReportResourceCommentResp() *ReportResourceCommentResp {
+	return &ReportResourceCommentResp{}
 }
 
-func (p *AdminDeleteResourceRatingResp) InitDefault() {
+func (p *ReportResourceCommentResp) InitDefault() {
 }
 
-var AdminDeleteResourceRatingResp_BaseResp_DEFAULT *module.BaseResp
+var ReportResourceCommentResp_BaseResp_DEFAULT *module.BaseResp
 
-func (p *AdminDeleteResourceRatingResp) GetBaseResp() (v *module.BaseResp) {
+func (p *ReportResourceCommentResp) GetBaseResp() (v *module.BaseResp) {
 	if !p.IsSetBaseResp() {
-		return AdminDeleteResourceRatingResp_BaseResp_DEFAULT
+		return ReportResourceCommentResp_BaseResp_DEFAULT
 	}
 	return p.BaseResp
 }
 
-var fieldIDToName_AdminDeleteResourceRatingResp = map[int16]string{
-	1: "base_resp",
+var fieldIDToName_ReportResourceCommentResp = map[int16]string{
+	1: "baseResp",
 }
 
-func (p *AdminDeleteResourceRatingResp) IsSetBaseResp() bool {
+func (p *ReportResourceCommentResp) IsSetBaseResp() bool {
 	return p.BaseResp != nil
 }
 
-func (p *AdminDeleteResourceRatingResp) Read(iprot thrift.TProtocol) (err error) {
+func (p *ReportResourceCommentResp) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5238,7 +5346,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminDeleteResourceRatingResp[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ReportResourceCommentResp[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5247,10 +5355,10 @@ ReadFieldEndError:
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_AdminDeleteResourceRatingResp[fieldId]))
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ReportResourceCommentResp[fieldId]))
 }
 
-func (p *AdminDeleteResourceRatingResp) ReadField1(iprot thrift.TProtocol) error {
+func (p *ReportResourceCommentResp) ReadField1(iprot thrift.TProtocol) error {
 	_field := module.NewBaseResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -5259,9 +5367,9 @@ func (p *AdminDeleteResourceRatingResp) ReadField1(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *AdminDeleteResourceRatingResp) Write(oprot thrift.TProtocol) (err error) {
+func (p *ReportResourceCommentResp) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("AdminDeleteResourceRatingResp"); err != nil {
+	if err = oprot.WriteStructBegin("ReportResourceCommentResp"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5287,8 +5395,8 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AdminDeleteResourceRatingResp) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+func (p *ReportResourceCommentResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("baseResp", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
 	if err := p.BaseResp.Write(oprot); err != nil {
@@ -5304,38 +5412,38 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *AdminDeleteResourceRatingResp) String() string {
+func (p *ReportResourceCommentResp) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AdminDeleteResourceRatingResp(%+v)", *p)
+	return fmt.Sprintf("ReportResourceCommentResp(%+v)", *p)
 
 }
 
-type AdminDeleteResourceReq struct {
-	ResourceID int64 `thrift:"resource_id,1,required" json:"resource_id,required" path:"resource_id,required"`
+type AdminDeleteResourceCommentReq struct {
+	CommentID int64 `thrift:"comment_id,1,required" json:"comment_id,required" path:"comment_id,required"`
 }
 
-func NewAdminDeleteResourceReq() *AdminDeleteResourceReq {
-	return &AdminDeleteResourceReq{}
+func NewAdminDeleteResourceCommentReq() *AdminDeleteResourceCommentReq {
+	return &AdminDeleteResourceCommentReq{}
 }
 
-func (p *AdminDeleteResourceReq) InitDefault() {
+func (p *AdminDeleteResourceCommentReq) InitDefault() {
 }
 
-func (p *AdminDeleteResourceReq) GetResourceID() (v int64) {
-	return p.ResourceID
+func (p *AdminDeleteResourceCommentReq) GetCommentID() (v int64) {
+	return p.CommentID
 }
 
-var fieldIDToName_AdminDeleteResourceReq = map[int16]string{
-	1: "resource_id",
+var fieldIDToName_AdminDeleteResourceCommentReq = map[int16]string{
+	1: "comment_id",
 }
 
-func (p *AdminDeleteResourceReq) Read(iprot thrift.TProtocol) (err error) {
+func (p *AdminDeleteResourceCommentReq) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetResourceID bool = false
+	var issetCommentID bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -5356,7 +5464,7 @@ func (p *AdminDeleteResourceReq) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetResourceID = true
+				issetCommentID = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -5373,7 +5481,7 @@ func (p *AdminDeleteResourceReq) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
-	if !issetResourceID {
+	if !issetCommentID {
 		fieldId = 1
 		goto RequiredFieldNotSetError
 	}
@@ -5383,7 +5491,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminDeleteResourceReq[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminDeleteResourceCommentReq[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5392,10 +5500,10 @@ ReadFieldEndError:
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_AdminDeleteResourceReq[fieldId]))
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_AdminDeleteResourceCommentReq[fieldId]))
 }
 
-func (p *AdminDeleteResourceReq) ReadField1(iprot thrift.TProtocol) error {
+func (p *AdminDeleteResourceCommentReq) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -5403,13 +5511,13 @@ func (p *AdminDeleteResourceReq) ReadField1(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.ResourceID = _field
+	p.CommentID = _field
 	return nil
 }
 
-func (p *AdminDeleteResourceReq) Write(oprot thrift.TProtocol) (err error) {
+func (p *AdminDeleteResourceCommentReq) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("AdminDeleteResourceReq"); err != nil {
+	if err = oprot.WriteStructBegin("AdminDeleteResourceCommentReq"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5435,11 +5543,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AdminDeleteResourceReq) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("resource_id", thrift.I64, 1); err != nil {
+func (p *AdminDeleteResourceCommentReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("comment_id", thrift.I64, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.ResourceID); err != nil {
+	if err := oprot.WriteI64(p.CommentID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -5452,43 +5560,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *AdminDeleteResourceReq) String() string {
+func (p *AdminDeleteResourceCommentReq) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AdminDeleteResourceReq(%+v)", *p)
+	return fmt.Sprintf("AdminDeleteResourceCommentReq(%+v)", *p)
 
 }
 
-type AdminDeleteResourceResp struct {
+type AdminDeleteResourceCommentResp struct {
 	BaseResp *module.BaseResp `thrift:"base_resp,1,required" form:"base_resp,required" json:"base_resp,required" query:"base_resp,required"`
 }
 
-func NewAdminDeleteResourceResp() *AdminDeleteResourceResp {
-	return &AdminDeleteResourceResp{}
+func NewAdminDeleteResourceCommentResp() *AdminDeleteResourceCommentResp {
+	return &AdminDeleteResourceCommentResp{}
 }
 
-func (p *AdminDeleteResourceResp) InitDefault() {
+func (p *AdminDeleteResourceCommentResp) InitDefault() {
 }
 
-var AdminDeleteResourceResp_BaseResp_DEFAULT *module.BaseResp
+var AdminDeleteResourceCommentResp_BaseResp_DEFAULT *module.BaseResp
 
-func (p *AdminDeleteResourceResp) GetBaseResp() (v *module.BaseResp) {
+func (p *AdminDeleteResourceCommentResp) GetBaseResp() (v *module.BaseResp) {
 	if !p.IsSetBaseResp() {
-		return AdminDeleteResourceResp_BaseResp_DEFAULT
+		return AdminDeleteResourceCommentResp_BaseResp_DEFAULT
 	}
 	return p.BaseResp
 }
 
-var fieldIDToName_AdminDeleteResourceResp = map[int16]string{
+var fieldIDToName_AdminDeleteResourceCommentResp = map[int16]string{
 	1: "base_resp",
 }
 
-func (p *AdminDeleteResourceResp) IsSetBaseResp() bool {
+func (p *AdminDeleteResourceCommentResp) IsSetBaseResp() bool {
 	return p.BaseResp != nil
 }
 
-func (p *AdminDeleteResourceResp) Read(iprot thrift.TProtocol) (err error) {
+func (p *AdminDeleteResourceCommentResp) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5540,7 +5648,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminDeleteResourceResp[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminDeleteResourceCommentResp[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5549,10 +5657,10 @@ ReadFieldEndError:
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_AdminDeleteResourceResp[fieldId]))
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_AdminDeleteResourceCommentResp[fieldId]))
 }
 
-func (p *AdminDeleteResourceResp) ReadField1(iprot thrift.TProtocol) error {
+func (p *AdminDeleteResourceCommentResp) ReadField1(iprot thrift.TProtocol) error {
 	_field := module.NewBaseResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -5561,9 +5669,9 @@ func (p *AdminDeleteResourceResp) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *AdminDeleteResourceResp) Write(oprot thrift.TProtocol) (err error) {
+func (p *AdminDeleteResourceCommentResp) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("AdminDeleteResourceResp"); err != nil {
+	if err = oprot.WriteStructBegin("AdminDeleteResourceCommentResp"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5589,7 +5697,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AdminDeleteResourceResp) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *AdminDeleteResourceCommentResp) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -5606,835 +5714,3361 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *AdminDeleteResourceResp) String() string {
+func (p *AdminDeleteResourceCommentResp) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AdminDeleteResourceResp(%+v)", *p)
+	return fmt.Sprintf("AdminDeleteResourceCommentResp(%+v)", *p)
 
 }
 
-// 资源服务
-type ResourceService interface {
-	SearchResources(ctx context.Context, req *SearchResourceReq) (r *SearchResourceResp, err error)
+type AdminDeleteResourceRatingReq struct {
+	RatingID int64 `thrift:"rating_id,1,required" json:"rating_id,required" path:"rating_id,required"`
+}
 
-	UploadResource(ctx context.Context, req *UploadResourceReq) (r *UploadResourceResp, err error)
+func NewAdminDeleteResourceRatingReq() *AdminDeleteResourceRatingReq {
+	return &AdminDeleteResourceRatingReq{}
+}
 
-	DownloadResource(ctx context.Context, req *DownloadResourceReq) (r *DownloadResourceResp, err error)
+func (p *AdminDeleteResourceRatingReq) InitDefault() {
+}
 
-	ReportResource(ctx context.Context, req *ReportResourceReq) (r *ReportResourceResp, err error)
+func (p *AdminDeleteResourceRatingReq) GetRatingID() (v int64) {
+	return p.RatingID
+}
 
-	GetResource(ctx context.Context, req *GetResourceReq) (r *GetResourceResp, err error)
-	// 资源评分相关API
-	SubmitResourceRating(ctx context.Context, req *SubmitResourceRatingReq) (r *SubmitResourceRatingResp, err error)
+var fieldIDToName_AdminDeleteResourceRatingReq = map[int16]string{
+	1: "rating_id",
+}
 
-	DeleteResourceRating(ctx context.Context, req *DeleteResourceRatingReq) (r *DeleteResourceRatingResp, err error)
-	// 资源评论相关API
-	SubmitResourceComment(ctx context.Context, req *SubmitResourceCommentReq) (r *SubmitResourceCommentResp, err error)
+func (p *AdminDeleteResourceRatingReq) Read(iprot thrift.TProtocol) (err error) {
 
-	DeleteResourceComment(ctx context.Context, req *DeleteResourceCommentReq) (r *DeleteResourceCommentResp, err error)
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetRatingID bool = false
 
-	GetResourceComments(ctx context.Context, req *GetResourceCommentsReq) (r *GetResourceCommentsResp, err error)
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
 
-	ReactResourceComment(ctx context.Context, req *SubmitResourceCommentReactionReq) (r *SubmitResourceCommentReactionResp, err error)
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetRatingID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetRatingID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminDeleteResourceRatingReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_AdminDeleteResourceRatingReq[fieldId]))
 }
 
-type ResourceServiceClient struct {
-	c thrift.TClient
+func (p *AdminDeleteResourceRatingReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.RatingID = _field
+	return nil
 }
 
-func NewResourceServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *ResourceServiceClient {
-	return &ResourceServiceClient{
-		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+func (p *AdminDeleteResourceRatingReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AdminDeleteResourceRatingReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
 	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func NewResourceServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *ResourceServiceClient {
-	return &ResourceServiceClient{
-		c: thrift.NewTStandardClient(iprot, oprot),
+func (p *AdminDeleteResourceRatingReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("rating_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.RatingID); err != nil {
+		return err
 	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func NewResourceServiceClient(c thrift.TClient) *ResourceServiceClient {
-	return &ResourceServiceClient{
-		c: c,
+func (p *AdminDeleteResourceRatingReq) String() string {
+	if p == nil {
+		return "<nil>"
 	}
+	return fmt.Sprintf("AdminDeleteResourceRatingReq(%+v)", *p)
+
 }
 
-func (p *ResourceServiceClient) Client_() thrift.TClient {
-	return p.c
+type AdminDeleteResourceRatingResp struct {
+	BaseResp *module.BaseResp `thrift:"base_resp,1,required" form:"base_resp,required" json:"base_resp,required" query:"base_resp,required"`
 }
 
-func (p *ResourceServiceClient) SearchResources(ctx context.Context, req *SearchResourceReq) (r *SearchResourceResp, err error) {
-	var _args ResourceServiceSearchResourcesArgs
-	_args.Req = req
-	var _result ResourceServiceSearchResourcesResult
-	if err = p.Client_().Call(ctx, "searchResources", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
+func NewAdminDeleteResourceRatingResp() *AdminDeleteResourceRatingResp {
+	return &AdminDeleteResourceRatingResp{}
 }
-func (p *ResourceServiceClient) UploadResource(ctx context.Context, req *UploadResourceReq) (r *UploadResourceResp, err error) {
-	var _args ResourceServiceUploadResourceArgs
-	_args.Req = req
-	var _result ResourceServiceUploadResourceResult
-	if err = p.Client_().Call(ctx, "uploadResource", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
+
+func (p *AdminDeleteResourceRatingResp) InitDefault() {
 }
-func (p *ResourceServiceClient) DownloadResource(ctx context.Context, req *DownloadResourceReq) (r *DownloadResourceResp, err error) {
-	var _args ResourceServiceDownloadResourceArgs
-	_args.Req = req
-	var _result ResourceServiceDownloadResourceResult
-	if err = p.Client_().Call(ctx, "downloadResource", &_args, &_result); err != nil {
-		return
+
+var AdminDeleteResourceRatingResp_BaseResp_DEFAULT *module.BaseResp
+
+func (p *AdminDeleteResourceRatingResp) GetBaseResp() (v *module.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return AdminDeleteResourceRatingResp_BaseResp_DEFAULT
 	}
-	return _result.GetSuccess(), nil
+	return p.BaseResp
 }
-func (p *ResourceServiceClient) ReportResource(ctx context.Context, req *ReportResourceReq) (r *ReportResourceResp, err error) {
-	var _args ResourceServiceReportResourceArgs
-	_args.Req = req
-	var _result ResourceServiceReportResourceResult
-	if err = p.Client_().Call(ctx, "reportResource", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
+
+var fieldIDToName_AdminDeleteResourceRatingResp = map[int16]string{
+	1: "base_resp",
 }
-func (p *ResourceServiceClient) GetResource(ctx context.Context, req *GetResourceReq) (r *GetResourceResp, err error) {
-	var _args ResourceServiceGetResourceArgs
-	_args.Req = req
-	var _result ResourceServiceGetResourceResult
-	if err = p.Client_().Call(ctx, "getResource", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
-}
-func (p *ResourceServiceClient) SubmitResourceRating(ctx context.Context, req *SubmitResourceRatingReq) (r *SubmitResourceRatingResp, err error) {
-	var _args ResourceServiceSubmitResourceRatingArgs
-	_args.Req = req
-	var _result ResourceServiceSubmitResourceRatingResult
-	if err = p.Client_().Call(ctx, "submitResourceRating", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
-}
-func (p *ResourceServiceClient) DeleteResourceRating(ctx context.Context, req *DeleteResourceRatingReq) (r *DeleteResourceRatingResp, err error) {
-	var _args ResourceServiceDeleteResourceRatingArgs
-	_args.Req = req
-	var _result ResourceServiceDeleteResourceRatingResult
-	if err = p.Client_().Call(ctx, "deleteResourceRating", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
-}
-func (p *ResourceServiceClient) SubmitResourceComment(ctx context.Context, req *SubmitResourceCommentReq) (r *SubmitResourceCommentResp, err error) {
-	var _args ResourceServiceSubmitResourceCommentArgs
-	_args.Req = req
-	var _result ResourceServiceSubmitResourceCommentResult
-	if err = p.Client_().Call(ctx, "submitResourceComment", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
-}
-func (p *ResourceServiceClient) DeleteResourceComment(ctx context.Context, req *DeleteResourceCommentReq) (r *DeleteResourceCommentResp, err error) {
-	var _args ResourceServiceDeleteResourceCommentArgs
-	_args.Req = req
-	var _result ResourceServiceDeleteResourceCommentResult
-	if err = p.Client_().Call(ctx, "deleteResourceComment", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
-}
-func (p *ResourceServiceClient) GetResourceComments(ctx context.Context, req *GetResourceCommentsReq) (r *GetResourceCommentsResp, err error) {
-	var _args ResourceServiceGetResourceCommentsArgs
-	_args.Req = req
-	var _result ResourceServiceGetResourceCommentsResult
-	if err = p.Client_().Call(ctx, "getResourceComments", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
-}
-func (p *ResourceServiceClient) ReactResourceComment(ctx context.Context, req *SubmitResourceCommentReactionReq) (r *SubmitResourceCommentReactionResp, err error) {
-	var _args ResourceServiceReactResourceCommentArgs
-	_args.Req = req
-	var _result ResourceServiceReactResourceCommentResult
-	if err = p.Client_().Call(ctx, "reactResourceComment", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
+
+func (p *AdminDeleteResourceRatingResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
 }
 
-type AdminResourceService interface {
-	AdminDeleteResourceComment(ctx context.Context, req *AdminDeleteResourceCommentReq) (r *AdminDeleteResourceCommentResp, err error)
+func (p *AdminDeleteResourceRatingResp) Read(iprot thrift.TProtocol) (err error) {
 
-	AdminDeleteResourceRating(ctx context.Context, req *AdminDeleteResourceRatingReq) (r *AdminDeleteResourceRatingResp, err error)
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetBaseResp bool = false
 
-	AdminDeleteResource(ctx context.Context, req *AdminDeleteResourceReq) (r *AdminDeleteResourceResp, err error)
-}
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
 
-type AdminResourceServiceClient struct {
-	c thrift.TClient
-}
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
 
-func NewAdminResourceServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *AdminResourceServiceClient {
-	return &AdminResourceServiceClient{
-		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetBaseResp = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-}
 
-func NewAdminResourceServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *AdminResourceServiceClient {
-	return &AdminResourceServiceClient{
-		c: thrift.NewTStandardClient(iprot, oprot),
+	if !issetBaseResp {
+		fieldId = 1
+		goto RequiredFieldNotSetError
 	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminDeleteResourceRatingResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_AdminDeleteResourceRatingResp[fieldId]))
 }
 
-func NewAdminResourceServiceClient(c thrift.TClient) *AdminResourceServiceClient {
-	return &AdminResourceServiceClient{
-		c: c,
+func (p *AdminDeleteResourceRatingResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := module.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.BaseResp = _field
+	return nil
 }
 
-func (p *AdminResourceServiceClient) Client_() thrift.TClient {
-	return p.c
+func (p *AdminDeleteResourceRatingResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AdminDeleteResourceRatingResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AdminResourceServiceClient) AdminDeleteResourceComment(ctx context.Context, req *AdminDeleteResourceCommentReq) (r *AdminDeleteResourceCommentResp, err error) {
-	var _args AdminResourceServiceAdminDeleteResourceCommentArgs
-	_args.Req = req
-	var _result AdminResourceServiceAdminDeleteResourceCommentResult
-	if err = p.Client_().Call(ctx, "AdminDeleteResourceComment", &_args, &_result); err != nil {
-		return
+func (p *AdminDeleteResourceRatingResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *AdminResourceServiceClient) AdminDeleteResourceRating(ctx context.Context, req *AdminDeleteResourceRatingReq) (r *AdminDeleteResourceRatingResp, err error) {
-	var _args AdminResourceServiceAdminDeleteResourceRatingArgs
-	_args.Req = req
-	var _result AdminResourceServiceAdminDeleteResourceRatingResult
-	if err = p.Client_().Call(ctx, "AdminDeleteResourceRating", &_args, &_result); err != nil {
-		return
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *AdminResourceServiceClient) AdminDeleteResource(ctx context.Context, req *AdminDeleteResourceReq) (r *AdminDeleteResourceResp, err error) {
-	var _args AdminResourceServiceAdminDeleteResourceArgs
-	_args.Req = req
-	var _result AdminResourceServiceAdminDeleteResourceResult
-	if err = p.Client_().Call(ctx, "AdminDeleteResource", &_args, &_result); err != nil {
-		return
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
-	return _result.GetSuccess(), nil
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-type ResourceServiceProcessor struct {
-	processorMap map[string]thrift.TProcessorFunction
-	handler      ResourceService
-}
+func (p *AdminDeleteResourceRatingResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AdminDeleteResourceRatingResp(%+v)", *p)
 
-func (p *ResourceServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
-	p.processorMap[key] = processor
 }
 
-func (p *ResourceServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
-	processor, ok = p.processorMap[key]
-	return processor, ok
+type AdminDeleteResourceReq struct {
+	ResourceID int64 `thrift:"resource_id,1,required" json:"resource_id,required" path:"resource_id,required"`
 }
 
-func (p *ResourceServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
-	return p.processorMap
+func NewAdminDeleteResourceReq() *AdminDeleteResourceReq {
+	return &AdminDeleteResourceReq{}
 }
 
-func NewResourceServiceProcessor(handler ResourceService) *ResourceServiceProcessor {
-	self := &ResourceServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
-	self.AddToProcessorMap("searchResources", &resourceServiceProcessorSearchResources{handler: handler})
-	self.AddToProcessorMap("uploadResource", &resourceServiceProcessorUploadResource{handler: handler})
-	self.AddToProcessorMap("downloadResource", &resourceServiceProcessorDownloadResource{handler: handler})
-	self.AddToProcessorMap("reportResource", &resourceServiceProcessorReportResource{handler: handler})
-	self.AddToProcessorMap("getResource", &resourceServiceProcessorGetResource{handler: handler})
-	self.AddToProcessorMap("submitResourceRating", &resourceServiceProcessorSubmitResourceRating{handler: handler})
-	self.AddToProcessorMap("deleteResourceRating", &resourceServiceProcessorDeleteResourceRating{handler: handler})
-	self.AddToProcessorMap("submitResourceComment", &resourceServiceProcessorSubmitResourceComment{handler: handler})
-	self.AddToProcessorMap("deleteResourceComment", &resourceServiceProcessorDeleteResourceComment{handler: handler})
-	self.AddToProcessorMap("getResourceComments", &resourceServiceProcessorGetResourceComments{handler: handler})
-	self.AddToProcessorMap("reactResourceComment", &resourceServiceProcessorReactResourceComment{handler: handler})
-	return self
+func (p *AdminDeleteResourceReq) InitDefault() {
 }
-func (p *ResourceServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	name, _, seqId, err := iprot.ReadMessageBegin()
-	if err != nil {
-		return false, err
-	}
-	if processor, ok := p.GetProcessorFunction(name); ok {
-		return processor.Process(ctx, seqId, iprot, oprot)
-	}
-	iprot.Skip(thrift.STRUCT)
-	iprot.ReadMessageEnd()
-	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
-	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
-	x.Write(oprot)
-	oprot.WriteMessageEnd()
-	oprot.Flush(ctx)
-	return false, x
+
+func (p *AdminDeleteResourceReq) GetResourceID() (v int64) {
+	return p.ResourceID
 }
 
-type resourceServiceProcessorSearchResources struct {
-	handler ResourceService
+var fieldIDToName_AdminDeleteResourceReq = map[int16]string{
+	1: "resource_id",
 }
 
-func (p *resourceServiceProcessorSearchResources) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ResourceServiceSearchResourcesArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("searchResources", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *AdminDeleteResourceReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetResourceID bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ResourceServiceSearchResourcesResult{}
-	var retval *SearchResourceResp
-	if retval, err2 = p.handler.SearchResources(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing searchResources: "+err2.Error())
-		oprot.WriteMessageBegin("searchResources", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetResourceID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetResourceID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminDeleteResourceReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_AdminDeleteResourceReq[fieldId]))
+}
+
+func (p *AdminDeleteResourceReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
 	} else {
-		result.Success = retval
+		_field = v
 	}
-	if err2 = oprot.WriteMessageBegin("searchResources", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	p.ResourceID = _field
+	return nil
+}
+
+func (p *AdminDeleteResourceReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AdminDeleteResourceReq"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	if err != nil {
-		return
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AdminDeleteResourceReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("resource_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
 	}
-	return true, err
+	if err := oprot.WriteI64(p.ResourceID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *AdminDeleteResourceReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AdminDeleteResourceReq(%+v)", *p)
+
+}
+
+type AdminDeleteResourceResp struct {
+	BaseResp *module.BaseResp `thrift:"base_resp,1,required" form:"base_resp,required" json:"base_resp,required" query:"base_resp,required"`
+}
+
+func NewAdminDeleteResourceResp() *AdminDeleteResourceResp {
+	return &AdminDeleteResourceResp{}
+}
+
+func (p *AdminDeleteResourceResp) InitDefault() {
+}
+
+var AdminDeleteResourceResp_BaseResp_DEFAULT *module.BaseResp
+
+func (p *AdminDeleteResourceResp) GetBaseResp() (v *module.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return AdminDeleteResourceResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+var fieldIDToName_AdminDeleteResourceResp = map[int16]string{
+	1: "base_resp",
+}
+
+func (p *AdminDeleteResourceResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *AdminDeleteResourceResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetBaseResp bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetBaseResp = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetBaseResp {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminDeleteResourceResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_AdminDeleteResourceResp[fieldId]))
+}
+
+func (p *AdminDeleteResourceResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := module.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
 }
 
-type resourceServiceProcessorUploadResource struct {
-	handler ResourceService
+func (p *AdminDeleteResourceResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AdminDeleteResourceResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AdminDeleteResourceResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *AdminDeleteResourceResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AdminDeleteResourceResp(%+v)", *p)
+
+}
+
+type AdminRestoreResourceReq struct {
+	ResourceID int64 `thrift:"resource_id,1,required" json:"resource_id,required" path:"resource_id,required"`
+}
+
+func NewAdminRestoreResourceReq() *AdminRestoreResourceReq {
+	return &AdminRestoreResourceReq{}
+}
+
+func (p *AdminRestoreResourceReq) InitDefault() {
+}
+
+func (p *AdminRestoreResourceReq) GetResourceID() (v int64) {
+	return p.ResourceID
+}
+
+var fieldIDToName_AdminRestoreResourceReq = map[int16]string{
+	1: "resource_id",
+}
+
+func (p *AdminRestoreResourceReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetResourceID bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetResourceID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetResourceID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminRestoreResourceReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_AdminRestoreResourceReq[fieldId]))
+}
+
+func (p *AdminRestoreResourceReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ResourceID = _field
+	return nil
+}
+
+func (p *AdminRestoreResourceReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AdminRestoreResourceReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AdminRestoreResourceReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("resource_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ResourceID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *AdminRestoreResourceReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AdminRestoreResourceReq(%+v)", *p)
+
+}
+
+type AdminRestoreResourceResp struct {
+	BaseResp *module.BaseResp `thrift:"base_resp,1,required" form:"base_resp,required" json:"base_resp,required" query:"base_resp,required"`
+}
+
+func NewAdminRestoreResourceResp() *AdminRestoreResourceResp {
+	return &AdminRestoreResourceResp{}
+}
+
+func (p *AdminRestoreResourceResp) InitDefault() {
+}
+
+var AdminRestoreResourceResp_BaseResp_DEFAULT *module.BaseResp
+
+func (p *AdminRestoreResourceResp) GetBaseResp() (v *module.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return AdminRestoreResourceResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+var fieldIDToName_AdminRestoreResourceResp = map[int16]string{
+	1: "base_resp",
+}
+
+func (p *AdminRestoreResourceResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *AdminRestoreResourceResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetBaseResp bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetBaseResp = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetBaseResp {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminRestoreResourceResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_AdminRestoreResourceResp[fieldId]))
+}
+
+func (p *AdminRestoreResourceResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := module.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+
+func (p *AdminRestoreResourceResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AdminRestoreResourceResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AdminRestoreResourceResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *AdminRestoreResourceResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AdminRestoreResourceResp(%+v)", *p)
+
+}
+
+type AdminRestoreResourceCommentReq struct {
+	CommentID int64 `thrift:"comment_id,1,required" json:"comment_id,required" path:"comment_id,required"`
+}
+
+func NewAdminRestoreResourceCommentReq() *AdminRestoreResourceCommentReq {
+	return &AdminRestoreResourceCommentReq{}
+}
+
+func (p *AdminRestoreResourceCommentReq) InitDefault() {
+}
+
+func (p *AdminRestoreResourceCommentReq) GetCommentID() (v int64) {
+	return p.CommentID
+}
+
+var fieldIDToName_AdminRestoreResourceCommentReq = map[int16]string{
+	1: "comment_id",
+}
+
+func (p *AdminRestoreResourceCommentReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetCommentID bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetCommentID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetCommentID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminRestoreResourceCommentReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_AdminRestoreResourceCommentReq[fieldId]))
+}
+
+func (p *AdminRestoreResourceCommentReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.CommentID = _field
+	return nil
+}
+
+func (p *AdminRestoreResourceCommentReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AdminRestoreResourceCommentReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AdminRestoreResourceCommentReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("comment_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.CommentID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *AdminRestoreResourceCommentReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AdminRestoreResourceCommentReq(%+v)", *p)
+
+}
+
+type AdminRestoreResourceCommentResp struct {
+	BaseResp *module.BaseResp `thrift:"base_resp,1,required" form:"base_resp,required" json:"base_resp,required" query:"base_resp,required"`
+}
+
+func NewAdminRestoreResourceCommentResp() *AdminRestoreResourceCommentResp {
+	return &AdminRestoreResourceCommentResp{}
+}
+
+func (p *AdminRestoreResourceCommentResp) InitDefault() {
+}
+
+var AdminRestoreResourceCommentResp_BaseResp_DEFAULT *module.BaseResp
+
+func (p *AdminRestoreResourceCommentResp) GetBaseResp() (v *module.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return AdminRestoreResourceCommentResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+var fieldIDToName_AdminRestoreResourceCommentResp = map[int16]string{
+	1: "base_resp",
+}
+
+func (p *AdminRestoreResourceCommentResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *AdminRestoreResourceCommentResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetBaseResp bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetBaseResp = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetBaseResp {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminRestoreResourceCommentResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_AdminRestoreResourceCommentResp[fieldId]))
+}
+
+func (p *AdminRestoreResourceCommentResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := module.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+
+func (p *AdminRestoreResourceCommentResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AdminRestoreResourceCommentResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AdminRestoreResourceCommentResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *AdminRestoreResourceCommentResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AdminRestoreResourceCommentResp(%+v)", *p)
+
+}
+
+// 资源服务
+type ResourceService interface {
+	SearchResources(ctx context.Context, req *SearchResourceReq) (r *SearchResourceResp, err error)
+
+	UploadResource(ctx context.Context, req *UploadResourceReq) (r *UploadResourceResp, err error)
+
+	DownloadResource(ctx context.Context, req *DownloadResourceReq) (r *DownloadResourceResp, err error)
+
+	ReportResource(ctx context.Context, req *ReportResourceReq) (r *ReportResourceResp, err error)
+
+	GetResource(ctx context.Context, req *GetResourceReq) (r *GetResourceResp, err error)
+	// 资源评分相关API
+	SubmitResourceRating(ctx context.Context, req *SubmitResourceRatingReq) (r *SubmitResourceRatingResp, err error)
+
+	DeleteResourceRating(ctx context.Context, req *DeleteResourceRatingReq) (r *DeleteResourceRatingResp, err error)
+	// 资源评论相关API
+	SubmitResourceComment(ctx context.Context, req *SubmitResourceCommentReq) (r *SubmitResourceCommentResp, err error)
+
+	DeleteResourceComment(ctx context.Context, req *DeleteResourceCommentReq) (r *DeleteResourceCommentResp, err error)
+
+	GetResourceComments(ctx context.Context, req *GetResourceCommentsReq) (r *GetResourceCommentsResp, err error)
+
+	ReactResourceComment(ctx context.Context, req *SubmitResourceCommentReactionReq) (r *SubmitResourceCommentReactionResp, err error)
+
+	ReportResourceComment(ctx context.Context, req *ReportResourceCommentReq) (r *ReportResourceCommentResp, err error)
+
+	ReportResourceRating(ctx context.Context, req *ReportResourceRatingReq) (r *ReportResourceRatingResp, err error)
+}
+
+type ResourceServiceClient struct {
+	c thrift.TClient
+}
+
+func NewResourceServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *ResourceServiceClient {
+	return &ResourceServiceClient{
+		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+	}
+}
+
+func NewResourceServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *ResourceServiceClient {
+	return &ResourceServiceClient{
+		c: thrift.NewTStandardClient(iprot, oprot),
+	}
+}
+
+func NewResourceServiceClient(c thrift.TClient) *ResourceServiceClient {
+	return &ResourceServiceClient{
+		c: c,
+	}
+}
+
+func (p *ResourceServiceClient) Client_() thrift.TClient {
+	return p.c
+}
+
+func (p *ResourceServiceClient) SearchResources(ctx context.Context, req *SearchResourceReq) (r *SearchResourceResp, err error) {
+	var _args ResourceServiceSearchResourcesArgs
+	_args.Req = req
+	var _result ResourceServiceSearchResourcesResult
+	if err = p.Client_().Call(ctx, "searchResources", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ResourceServiceClient) UploadResource(ctx context.Context, req *UploadResourceReq) (r *UploadResourceResp, err error) {
+	var _args ResourceServiceUploadResourceArgs
+	_args.Req = req
+	var _result ResourceServiceUploadResourceResult
+	if err = p.Client_().Call(ctx, "uploadResource", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ResourceServiceClient) DownloadResource(ctx context.Context, req *DownloadResourceReq) (r *DownloadResourceResp, err error) {
+	var _args ResourceServiceDownloadResourceArgs
+	_args.Req = req
+	var _result ResourceServiceDownloadResourceResult
+	if err = p.Client_().Call(ctx, "downloadResource", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ResourceServiceClient) ReportResource(ctx context.Context, req *ReportResourceReq) (r *ReportResourceResp, err error) {
+	var _args ResourceServiceReportResourceArgs
+	_args.Req = req
+	var _result ResourceServiceReportResourceResult
+	if err = p.Client_().Call(ctx, "reportResource", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ResourceServiceClient) GetResource(ctx context.Context, req *GetResourceReq) (r *GetResourceResp, err error) {
+	var _args ResourceServiceGetResourceArgs
+	_args.Req = req
+	var _result ResourceServiceGetResourceResult
+	if err = p.Client_().Call(ctx, "getResource", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ResourceServiceClient) SubmitResourceRating(ctx context.Context, req *SubmitResourceRatingReq) (r *SubmitResourceRatingResp, err error) {
+	var _args ResourceServiceSubmitResourceRatingArgs
+	_args.Req = req
+	var _result ResourceServiceSubmitResourceRatingResult
+	if err = p.Client_().Call(ctx, "submitResourceRating", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ResourceServiceClient) DeleteResourceRating(ctx context.Context, req *DeleteResourceRatingReq) (r *DeleteResourceRatingResp, err error) {
+	var _args ResourceServiceDeleteResourceRatingArgs
+	_args.Req = req
+	var _result ResourceServiceDeleteResourceRatingResult
+	if err = p.Client_().Call(ctx, "deleteResourceRating", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ResourceServiceClient) SubmitResourceComment(ctx context.Context, req *SubmitResourceCommentReq) (r *SubmitResourceCommentResp, err error) {
+	var _args ResourceServiceSubmitResourceCommentArgs
+	_args.Req = req
+	var _result ResourceServiceSubmitResourceCommentResult
+	if err = p.Client_().Call(ctx, "submitResourceComment", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ResourceServiceClient) DeleteResourceComment(ctx context.Context, req *DeleteResourceCommentReq) (r *DeleteResourceCommentResp, err error) {
+	var _args ResourceServiceDeleteResourceCommentArgs
+	_args.Req = req
+	var _result ResourceServiceDeleteResourceCommentResult
+	if err = p.Client_().Call(ctx, "deleteResourceComment", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ResourceServiceClient) GetResourceComments(ctx context.Context, req *GetResourceCommentsReq) (r *GetResourceCommentsResp, err error) {
+	var _args ResourceServiceGetResourceCommentsArgs
+	_args.Req = req
+	var _result ResourceServiceGetResourceCommentsResult
+	if err = p.Client_().Call(ctx, "getResourceComments", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ResourceServiceClient) ReactResourceComment(ctx context.Context, req *SubmitResourceCommentReactionReq) (r *SubmitResourceCommentReactionResp, err error) {
+	var _args ResourceServiceReactResourceCommentArgs
+	_args.Req = req
+	var _result ResourceServiceReactResourceCommentResult
+	if err = p.Client_().Call(ctx, "reactResourceComment", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ResourceServiceClient) ReportResourceComment(ctx context.Context, req *ReportResourceCommentReq) (r *ReportResourceCommentResp, err error) {
+	var _args ResourceServiceReportResourceCommentArgs
+	_args.Req = req
+	var _result ResourceServiceReportResourceCommentResult
+	if err = p.Client_().Call(ctx, "reportResourceComment", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ResourceServiceClient) ReportResourceRating(ctx context.Context, req *ReportResourceRatingReq) (r *ReportResourceRatingResp, err error) {
+	var _args ResourceServiceReportResourceRatingArgs
+	_args.Req = req
+	var _result ResourceServiceReportResourceRatingResult
+	if err = p.Client_().Call(ctx, "reportResourceRating", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+type AdminResourceService interface {
+	AdminDeleteResourceComment(ctx context.Context, req *AdminDeleteResourceCommentReq) (r *AdminDeleteResourceCommentResp, err error)
+
+	AdminDeleteResourceRating(ctx context.Context, req *AdminDeleteResourceRatingReq) (r *AdminDeleteResourceRatingResp, err error)
+
+	AdminDeleteResource(ctx context.Context, req *AdminDeleteResourceReq) (r *AdminDeleteResourceResp, err error)
+
+	AdminRestoreResource(ctx context.Context, req *AdminRestoreResourceReq) (r *AdminRestoreResourceResp, err error)
+
+	AdminRestoreResourceComment(ctx context.Context, req *AdminRestoreResourceCommentReq) (r *AdminRestoreResourceCommentResp, err error)
+}
+
+type AdminResourceServiceClient struct {
+	c thrift.TClient
+}
+
+func NewAdminResourceServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *AdminResourceServiceClient {
+	return &AdminResourceServiceClient{
+		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+	}
+}
+
+func NewAdminResourceServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *AdminResourceServiceClient {
+	return &AdminResourceServiceClient{
+		c: thrift.NewTStandardClient(iprot, oprot),
+	}
+}
+
+func NewAdminResourceServiceClient(c thrift.TClient) *AdminResourceServiceClient {
+	return &AdminResourceServiceClient{
+		c: c,
+	}
+}
+
+func (p *AdminResourceServiceClient) Client_() thrift.TClient {
+	return p.c
+}
+
+func (p *AdminResourceServiceClient) AdminDeleteResourceComment(ctx context.Context, req *AdminDeleteResourceCommentReq) (r *AdminDeleteResourceCommentResp, err error) {
+	var _args AdminResourceServiceAdminDeleteResourceCommentArgs
+	_args.Req = req
+	var _result AdminResourceServiceAdminDeleteResourceCommentResult
+	if err = p.Client_().Call(ctx, "AdminDeleteResourceComment", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *AdminResourceServiceClient) AdminDeleteResourceRating(ctx context.Context, req *AdminDeleteResourceRatingReq) (r *AdminDeleteResourceRatingResp, err error) {
+	var _args AdminResourceServiceAdminDeleteResourceRatingArgs
+	_args.Req = req
+	var _result AdminResourceServiceAdminDeleteResourceRatingResult
+	if err = p.Client_().Call(ctx, "AdminDeleteResourceRating", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *AdminResourceServiceClient) AdminDeleteResource(ctx context.Context, req *AdminDeleteResourceReq) (r *AdminDeleteResourceResp, err error) {
+	var _args AdminResourceServiceAdminDeleteResourceArgs
+	_args.Req = req
+	var _result AdminResourceServiceAdminDeleteResourceResult
+	if err = p.Client_().Call(ctx, "AdminDeleteResource", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *AdminResourceServiceClient) AdminRestoreResource(ctx context.Context, req *AdminRestoreResourceReq) (r *AdminRestoreResourceResp, err error) {
+	var _args AdminResourceServiceAdminRestoreResourceArgs
+	_args.Req = req
+	var _result AdminResourceServiceAdminRestoreResourceResult
+	if err = p.Client_().Call(ctx, "AdminRestoreResource", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *AdminResourceServiceClient) AdminRestoreResourceComment(ctx context.Context, req *AdminRestoreResourceCommentReq) (r *AdminRestoreResourceCommentResp, err error) {
+	var _args AdminResourceServiceAdminRestoreResourceCommentArgs
+	_args.Req = req
+	var _result AdminResourceServiceAdminRestoreResourceCommentResult
+	if err = p.Client_().Call(ctx, "AdminRestoreResourceComment", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+type ResourceServiceProcessor struct {
+	processorMap map[string]thrift.TProcessorFunction
+	handler      ResourceService
+}
+
+func (p *ResourceServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
+	p.processorMap[key] = processor
+}
+
+func (p *ResourceServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
+	processor, ok = p.processorMap[key]
+	return processor, ok
+}
+
+func (p *ResourceServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
+	return p.processorMap
+}
+
+func NewResourceServiceProcessor(handler ResourceService) *ResourceServiceProcessor {
+	self := &ResourceServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
+	self.AddToProcessorMap("searchResources", &resourceServiceProcessorSearchResources{handler: handler})
+	self.AddToProcessorMap("uploadResource", &resourceServiceProcessorUploadResource{handler: handler})
+	self.AddToProcessorMap("downloadResource", &resourceServiceProcessorDownloadResource{handler: handler})
+	self.AddToProcessorMap("reportResource", &resourceServiceProcessorReportResource{handler: handler})
+	self.AddToProcessorMap("getResource", &resourceServiceProcessorGetResource{handler: handler})
+	self.AddToProcessorMap("submitResourceRating", &resourceServiceProcessorSubmitResourceRating{handler: handler})
+	self.AddToProcessorMap("deleteResourceRating", &resourceServiceProcessorDeleteResourceRating{handler: handler})
+	self.AddToProcessorMap("submitResourceComment", &resourceServiceProcessorSubmitResourceComment{handler: handler})
+	self.AddToProcessorMap("deleteResourceComment", &resourceServiceProcessorDeleteResourceComment{handler: handler})
+	self.AddToProcessorMap("getResourceComments", &resourceServiceProcessorGetResourceComments{handler: handler})
+	self.AddToProcessorMap("reactResourceComment", &resourceServiceProcessorReactResourceComment{handler: handler})
+	self.AddToProcessorMap("reportResourceComment", &resourceServiceProcessorReportResourceComment{handler: handler})
+	self.AddToProcessorMap("reportResourceRating", &resourceServiceProcessorReportResourceRating{handler: handler})
+	return self
+}
+func (p *ResourceServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	name, _, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return false, err
+	}
+	if processor, ok := p.GetProcessorFunction(name); ok {
+		return processor.Process(ctx, seqId, iprot, oprot)
+	}
+	iprot.Skip(thrift.STRUCT)
+	iprot.ReadMessageEnd()
+	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
+	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
+	x.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Flush(ctx)
+	return false, x
+}
+
+type resourceServiceProcessorSearchResources struct {
+	handler ResourceService
+}
+
+func (p *resourceServiceProcessorSearchResources) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ResourceServiceSearchResourcesArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("searchResources", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ResourceServiceSearchResourcesResult{}
+	var retval *SearchResourceResp
+	if retval, err2 = p.handler.SearchResources(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing searchResources: "+err2.Error())
+		oprot.WriteMessageBegin("searchResources", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("searchResources", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type resourceServiceProcessorUploadResource struct {
+	handler ResourceService
+}
+
+func (p *resourceServiceProcessorUploadResource) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ResourceServiceUploadResourceArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("uploadResource", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ResourceServiceUploadResourceResult{}
+	var retval *UploadResourceResp
+	if retval, err2 = p.handler.UploadResource(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing uploadResource: "+err2.Error())
+		oprot.WriteMessageBegin("uploadResource", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("uploadResource", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type resourceServiceProcessorDownloadResource struct {
+	handler ResourceService
+}
+
+func (p *resourceServiceProcessorDownloadResource) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ResourceServiceDownloadResourceArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("downloadResource", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ResourceServiceDownloadResourceResult{}
+	var retval *DownloadResourceResp
+	if retval, err2 = p.handler.DownloadResource(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing downloadResource: "+err2.Error())
+		oprot.WriteMessageBegin("downloadResource", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("downloadResource", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type resourceServiceProcessorReportResource struct {
+	handler ResourceService
+}
+
+func (p *resourceServiceProcessorReportResource) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ResourceServiceReportResourceArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("reportResource", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ResourceServiceReportResourceResult{}
+	var retval *ReportResourceResp
+	if retval, err2 = p.handler.ReportResource(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing reportResource: "+err2.Error())
+		oprot.WriteMessageBegin("reportResource", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("reportResource", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type resourceServiceProcessorGetResource struct {
+	handler ResourceService
+}
+
+func (p *resourceServiceProcessorGetResource) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ResourceServiceGetResourceArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("getResource", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ResourceServiceGetResourceResult{}
+	var retval *GetResourceResp
+	if retval, err2 = p.handler.GetResource(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing getResource: "+err2.Error())
+		oprot.WriteMessageBegin("getResource", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("getResource", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type resourceServiceProcessorSubmitResourceRating struct {
+	handler ResourceService
+}
+
+func (p *resourceServiceProcessorSubmitResourceRating) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ResourceServiceSubmitResourceRatingArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("submitResourceRating", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ResourceServiceSubmitResourceRatingResult{}
+	var retval *SubmitResourceRatingResp
+	if retval, err2 = p.handler.SubmitResourceRating(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing submitResourceRating: "+err2.Error())
+		oprot.WriteMessageBegin("submitResourceRating", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("submitResourceRating", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type resourceServiceProcessorDeleteResourceRating struct {
+	handler ResourceService
+}
+
+func (p *resourceServiceProcessorDeleteResourceRating) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ResourceServiceDeleteResourceRatingArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("deleteResourceRating", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ResourceServiceDeleteResourceRatingResult{}
+	var retval *DeleteResourceRatingResp
+	if retval, err2 = p.handler.DeleteResourceRating(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing deleteResourceRating: "+err2.Error())
+		oprot.WriteMessageBegin("deleteResourceRating", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("deleteResourceRating", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type resourceServiceProcessorSubmitResourceComment struct {
+	handler ResourceService
+}
+
+func (p *resourceServiceProcessorSubmitResourceComment) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ResourceServiceSubmitResourceCommentArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("submitResourceComment", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ResourceServiceSubmitResourceCommentResult{}
+	var retval *SubmitResourceCommentResp
+	if retval, err2 = p.handler.SubmitResourceComment(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing submitResourceComment: "+err2.Error())
+		oprot.WriteMessageBegin("submitResourceComment", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("submitResourceComment", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type resourceServiceProcessorDeleteResourceComment struct {
+	handler ResourceService
+}
+
+func (p *resourceServiceProcessorDeleteResourceComment) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ResourceServiceDeleteResourceCommentArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("deleteResourceComment", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ResourceServiceDeleteResourceCommentResult{}
+	var retval *DeleteResourceCommentResp
+	if retval, err2 = p.handler.DeleteResourceComment(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing deleteResourceComment: "+err2.Error())
+		oprot.WriteMessageBegin("deleteResourceComment", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("deleteResourceComment", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type resourceServiceProcessorGetResourceComments struct {
+	handler ResourceService
+}
+
+func (p *resourceServiceProcessorGetResourceComments) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ResourceServiceGetResourceCommentsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("getResourceComments", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ResourceServiceGetResourceCommentsResult{}
+	var retval *GetResourceCommentsResp
+	if retval, err2 = p.handler.GetResourceComments(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing getResourceComments: "+err2.Error())
+		oprot.WriteMessageBegin("getResourceComments", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("getResourceComments", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type resourceServiceProcessorReactResourceComment struct {
+	handler ResourceService
+}
+
+func (p *resourceServiceProcessorReactResourceComment) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ResourceServiceReactResourceCommentArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("reactResourceComment", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ResourceServiceReactResourceCommentResult{}
+	var retval *SubmitResourceCommentReactionResp
+	if retval, err2 = p.handler.ReactResourceComment(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing reactResourceComment: "+err2.Error())
+		oprot.WriteMessageBegin("reactResourceComment", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("reactResourceComment", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type resourceServiceProcessorReportResourceComment struct {
+	handler ResourceService
+}
+
+func (p *resourceServiceProcessorReportResourceComment) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ResourceServiceReportResourceCommentArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("reportResourceComment", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ResourceServiceReportResourceCommentResult{}
+	var retval *ReportResourceCommentResp
+	if retval, err2 = p.handler.ReportResourceComment(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing reportResourceComment: "+err2.Error())
+		oprot.WriteMessageBegin("reportResourceComment", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("reportResourceComment", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type resourceServiceProcessorReportResourceRating struct {
+	handler ResourceService
+}
+
+func (p *resourceServiceProcessorReportResourceRating) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ResourceServiceReportResourceRatingArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("reportResourceRating", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ResourceServiceReportResourceRatingResult{}
+	var retval *ReportResourceRatingResp
+	if retval, err2 = p.handler.ReportResourceRating(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing reportResourceRating: "+err2.Error())
+		oprot.WriteMessageBegin("reportResourceRating", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("reportResourceRating", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type ResourceServiceSearchResourcesArgs struct {
+	Req *SearchResourceReq `thrift:"req,1"`
+}
+
+func NewResourceServiceSearchResourcesArgs() *ResourceServiceSearchResourcesArgs {
+	return &ResourceServiceSearchResourcesArgs{}
+}
+
+func (p *ResourceServiceSearchResourcesArgs) InitDefault() {
+}
+
+var ResourceServiceSearchResourcesArgs_Req_DEFAULT *SearchResourceReq
+
+func (p *ResourceServiceSearchResourcesArgs) GetReq() (v *SearchResourceReq) {
+	if !p.IsSetReq() {
+		return ResourceServiceSearchResourcesArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_ResourceServiceSearchResourcesArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *ResourceServiceSearchResourcesArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ResourceServiceSearchResourcesArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ResourceServiceSearchResourcesArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ResourceServiceSearchResourcesArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewSearchResourceReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *ResourceServiceSearchResourcesArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("searchResources_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ResourceServiceSearchResourcesArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ResourceServiceSearchResourcesArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ResourceServiceSearchResourcesArgs(%+v)", *p)
+
+}
+
+type ResourceServiceSearchResourcesResult struct {
+	Success *SearchResourceResp `thrift:"success,0,optional"`
+}
+
+func NewResourceServiceSearchResourcesResult() *ResourceServiceSearchResourcesResult {
+	return &ResourceServiceSearchResourcesResult{}
+}
+
+func (p *ResourceServiceSearchResourcesResult) InitDefault() {
+}
+
+var ResourceServiceSearchResourcesResult_Success_DEFAULT *SearchResourceResp
+
+func (p *ResourceServiceSearchResourcesResult) GetSuccess() (v *SearchResourceResp) {
+	if !p.IsSetSuccess() {
+		return ResourceServiceSearchResourcesResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_ResourceServiceSearchResourcesResult = map[int16]string{
+	0: "success",
+}
+
+func (p *ResourceServiceSearchResourcesResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ResourceServiceSearchResourcesResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ResourceServiceSearchResourcesResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ResourceServiceSearchResourcesResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewSearchResourceResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *ResourceServiceSearchResourcesResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("searchResources_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ResourceServiceSearchResourcesResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *ResourceServiceSearchResourcesResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ResourceServiceSearchResourcesResult(%+v)", *p)
+
+}
+
+type ResourceServiceUploadResourceArgs struct {
+	Req *UploadResourceReq `thrift:"req,1"`
+}
+
+func NewResourceServiceUploadResourceArgs() *ResourceServiceUploadResourceArgs {
+	return &ResourceServiceUploadResourceArgs{}
+}
+
+func (p *ResourceServiceUploadResourceArgs) InitDefault() {
+}
+
+var ResourceServiceUploadResourceArgs_Req_DEFAULT *UploadResourceReq
+
+func (p *ResourceServiceUploadResourceArgs) GetReq() (v *UploadResourceReq) {
+	if !p.IsSetReq() {
+		return ResourceServiceUploadResourceArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_ResourceServiceUploadResourceArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *ResourceServiceUploadResourceArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ResourceServiceUploadResourceArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ResourceServiceUploadResourceArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ResourceServiceUploadResourceArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewUploadResourceReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *ResourceServiceUploadResourceArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("uploadResource_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ResourceServiceUploadResourceArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ResourceServiceUploadResourceArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ResourceServiceUploadResourceArgs(%+v)", *p)
+
+}
+
+type ResourceServiceUploadResourceResult struct {
+	Success *UploadResourceResp `thrift:"success,0,optional"`
+}
+
+func NewResourceServiceUploadResourceResult() *ResourceServiceUploadResourceResult {
+	return &ResourceServiceUploadResourceResult{}
+}
+
+func (p *ResourceServiceUploadResourceResult) InitDefault() {
+}
+
+var ResourceServiceUploadResourceResult_Success_DEFAULT *UploadResourceResp
+
+func (p *ResourceServiceUploadResourceResult) GetSuccess() (v *UploadResourceResp) {
+	if !p.IsSetSuccess() {
+		return ResourceServiceUploadResourceResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_ResourceServiceUploadResourceResult = map[int16]string{
+	0: "success",
+}
+
+func (p *ResourceServiceUploadResourceResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ResourceServiceUploadResourceResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ResourceServiceUploadResourceResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ResourceServiceUploadResourceResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewUploadResourceResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *ResourceServiceUploadResourceResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("uploadResource_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ResourceServiceUploadResourceResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *ResourceServiceUploadResourceResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ResourceServiceUploadResourceResult(%+v)", *p)
+
+}
+
+type ResourceServiceDownloadResourceArgs struct {
+	Req *DownloadResourceReq `thrift:"req,1"`
+}
+
+func NewResourceServiceDownloadResourceArgs() *ResourceServiceDownloadResourceArgs {
+	return &ResourceServiceDownloadResourceArgs{}
+}
+
+func (p *ResourceServiceDownloadResourceArgs) InitDefault() {
+}
+
+var ResourceServiceDownloadResourceArgs_Req_DEFAULT *DownloadResourceReq
+
+func (p *ResourceServiceDownloadResourceArgs) GetReq() (v *DownloadResourceReq) {
+	if !p.IsSetReq() {
+		return ResourceServiceDownloadResourceArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_ResourceServiceDownloadResourceArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *ResourceServiceDownloadResourceArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ResourceServiceDownloadResourceArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ResourceServiceDownloadResourceArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ResourceServiceDownloadResourceArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewDownloadResourceReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *ResourceServiceDownloadResourceArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("downloadResource_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ResourceServiceDownloadResourceArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ResourceServiceDownloadResourceArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ResourceServiceDownloadResourceArgs(%+v)", *p)
+
+}
+
+type ResourceServiceDownloadResourceResult struct {
+	Success *DownloadResourceResp `thrift:"success,0,optional"`
+}
+
+func NewResourceServiceDownloadResourceResult() *ResourceServiceDownloadResourceResult {
+	return &ResourceServiceDownloadResourceResult{}
+}
+
+func (p *ResourceServiceDownloadResourceResult) InitDefault() {
+}
+
+var ResourceServiceDownloadResourceResult_Success_DEFAULT *DownloadResourceResp
+
+func (p *ResourceServiceDownloadResourceResult) GetSuccess() (v *DownloadResourceResp) {
+	if !p.IsSetSuccess() {
+		return ResourceServiceDownloadResourceResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_ResourceServiceDownloadResourceResult = map[int16]string{
+	0: "success",
+}
+
+func (p *ResourceServiceDownloadResourceResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ResourceServiceDownloadResourceResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ResourceServiceDownloadResourceResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *resourceServiceProcessorUploadResource) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ResourceServiceUploadResourceArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("uploadResource", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *ResourceServiceDownloadResourceResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewDownloadResourceResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.Success = _field
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ResourceServiceUploadResourceResult{}
-	var retval *UploadResourceResp
-	if retval, err2 = p.handler.UploadResource(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing uploadResource: "+err2.Error())
-		oprot.WriteMessageBegin("uploadResource", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("uploadResource", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+func (p *ResourceServiceDownloadResourceResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("downloadResource_result"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err != nil {
-		return
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	return true, err
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-type resourceServiceProcessorDownloadResource struct {
-	handler ResourceService
+func (p *ResourceServiceDownloadResourceResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *resourceServiceProcessorDownloadResource) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ResourceServiceDownloadResourceArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("downloadResource", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *ResourceServiceDownloadResourceResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
+	return fmt.Sprintf("ResourceServiceDownloadResourceResult(%+v)", *p)
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ResourceServiceDownloadResourceResult{}
-	var retval *DownloadResourceResp
-	if retval, err2 = p.handler.DownloadResource(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing downloadResource: "+err2.Error())
-		oprot.WriteMessageBegin("downloadResource", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("downloadResource", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
-	}
-	return true, err
 }
 
-type resourceServiceProcessorReportResource struct {
-	handler ResourceService
+type ResourceServiceReportResourceArgs struct {
+	Req *ReportResourceReq `thrift:"req,1"`
 }
 
-func (p *resourceServiceProcessorReportResource) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ResourceServiceReportResourceArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("reportResource", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func NewResourceServiceReportResourceArgs() *ResourceServiceReportResourceArgs {
+	return &ResourceServiceReportResourceArgs{}
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ResourceServiceReportResourceResult{}
-	var retval *ReportResourceResp
-	if retval, err2 = p.handler.ReportResource(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing reportResource: "+err2.Error())
-		oprot.WriteMessageBegin("reportResource", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("reportResource", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
+func (p *ResourceServiceReportResourceArgs) InitDefault() {
+}
+
+var ResourceServiceReportResourceArgs_Req_DEFAULT *ReportResourceReq
+
+func (p *ResourceServiceReportResourceArgs) GetReq() (v *ReportResourceReq) {
+	if !p.IsSetReq() {
+		return ResourceServiceReportResourceArgs_Req_DEFAULT
 	}
-	return true, err
+	return p.Req
 }
 
-type resourceServiceProcessorGetResource struct {
-	handler ResourceService
+var fieldIDToName_ResourceServiceReportResourceArgs = map[int16]string{
+	1: "req",
 }
 
-func (p *resourceServiceProcessorGetResource) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ResourceServiceGetResourceArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("getResource", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func (p *ResourceServiceReportResourceArgs) IsSetReq() bool {
+	return p.Req != nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ResourceServiceGetResourceResult{}
-	var retval *GetResourceResp
-	if retval, err2 = p.handler.GetResource(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing getResource: "+err2.Error())
-		oprot.WriteMessageBegin("getResource", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("getResource", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+func (p *ResourceServiceReportResourceArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return true, err
-}
 
-type resourceServiceProcessorSubmitResourceRating struct {
-	handler ResourceService
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ResourceServiceReportResourceArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *resourceServiceProcessorSubmitResourceRating) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ResourceServiceSubmitResourceRatingArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("submitResourceRating", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *ResourceServiceReportResourceArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewReportResourceReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.Req = _field
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ResourceServiceSubmitResourceRatingResult{}
-	var retval *SubmitResourceRatingResp
-	if retval, err2 = p.handler.SubmitResourceRating(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing submitResourceRating: "+err2.Error())
-		oprot.WriteMessageBegin("submitResourceRating", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("submitResourceRating", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+func (p *ResourceServiceReportResourceArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("reportResource_args"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err != nil {
-		return
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	return true, err
-}
-
-type resourceServiceProcessorDeleteResourceRating struct {
-	handler ResourceService
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *resourceServiceProcessorDeleteResourceRating) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ResourceServiceDeleteResourceRatingArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("deleteResourceRating", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
-
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ResourceServiceDeleteResourceRatingResult{}
-	var retval *DeleteResourceRatingResp
-	if retval, err2 = p.handler.DeleteResourceRating(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing deleteResourceRating: "+err2.Error())
-		oprot.WriteMessageBegin("deleteResourceRating", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("deleteResourceRating", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+func (p *ResourceServiceReportResourceArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if err := p.Req.Write(oprot); err != nil {
+		return err
 	}
-	if err != nil {
-		return
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
-	return true, err
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-type resourceServiceProcessorSubmitResourceComment struct {
-	handler ResourceService
+func (p *ResourceServiceReportResourceArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ResourceServiceReportResourceArgs(%+v)", *p)
+
 }
 
-func (p *resourceServiceProcessorSubmitResourceComment) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ResourceServiceSubmitResourceCommentArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("submitResourceComment", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+type ResourceServiceReportResourceResult struct {
+	Success *ReportResourceResp `thrift:"success,0,optional"`
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ResourceServiceSubmitResourceCommentResult{}
-	var retval *SubmitResourceCommentResp
-	if retval, err2 = p.handler.SubmitResourceComment(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing submitResourceComment: "+err2.Error())
-		oprot.WriteMessageBegin("submitResourceComment", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("submitResourceComment", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
-	}
-	return true, err
+func NewResourceServiceReportResourceResult() *ResourceServiceReportResourceResult {
+	return &ResourceServiceReportResourceResult{}
 }
 
-type resourceServiceProcessorDeleteResourceComment struct {
-	handler ResourceService
+func (p *ResourceServiceReportResourceResult) InitDefault() {
 }
 
-func (p *resourceServiceProcessorDeleteResourceComment) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ResourceServiceDeleteResourceCommentArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("deleteResourceComment", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+var ResourceServiceReportResourceResult_Success_DEFAULT *ReportResourceResp
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ResourceServiceDeleteResourceCommentResult{}
-	var retval *DeleteResourceCommentResp
-	if retval, err2 = p.handler.DeleteResourceComment(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing deleteResourceComment: "+err2.Error())
-		oprot.WriteMessageBegin("deleteResourceComment", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("deleteResourceComment", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
+func (p *ResourceServiceReportResourceResult) GetSuccess() (v *ReportResourceResp) {
+	if !p.IsSetSuccess() {
+		return ResourceServiceReportResourceResult_Success_DEFAULT
 	}
-	return true, err
+	return p.Success
+}
+
+var fieldIDToName_ResourceServiceReportResourceResult = map[int16]string{
+	0: "success",
 }
 
-type resourceServiceProcessorGetResourceComments struct {
-	handler ResourceService
+func (p *ResourceServiceReportResourceResult) IsSetSuccess() bool {
+	return p.Success != nil
 }
 
-func (p *resourceServiceProcessorGetResourceComments) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ResourceServiceGetResourceCommentsArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("getResourceComments", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func (p *ResourceServiceReportResourceResult) Read(iprot thrift.TProtocol) (err error) {
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ResourceServiceGetResourceCommentsResult{}
-	var retval *GetResourceCommentsResp
-	if retval, err2 = p.handler.GetResourceComments(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing getResourceComments: "+err2.Error())
-		oprot.WriteMessageBegin("getResourceComments", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("getResourceComments", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return true, err
-}
 
-type resourceServiceProcessorReactResourceComment struct {
-	handler ResourceService
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ResourceServiceReportResourceResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *resourceServiceProcessorReactResourceComment) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := ResourceServiceReactResourceCommentArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("reactResourceComment", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *ResourceServiceReportResourceResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewReportResourceResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.Success = _field
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := ResourceServiceReactResourceCommentResult{}
-	var retval *SubmitResourceCommentReactionResp
-	if retval, err2 = p.handler.ReactResourceComment(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing reactResourceComment: "+err2.Error())
-		oprot.WriteMessageBegin("reactResourceComment", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+func (p *ResourceServiceReportResourceResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("reportResource_result"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageBegin("reactResourceComment", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ResourceServiceReportResourceResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *ResourceServiceReportResourceResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return true, err
+	return fmt.Sprintf("ResourceServiceReportResourceResult(%+v)", *p)
+
 }
 
-type ResourceServiceSearchResourcesArgs struct {
-	Req *SearchResourceReq `thrift:"req,1"`
+type ResourceServiceGetResourceArgs struct {
+	Req *GetResourceReq `thrift:"req,1"`
 }
 
-func NewResourceServiceSearchResourcesArgs() *ResourceServiceSearchResourcesArgs {
-	return &ResourceServiceSearchResourcesArgs{}
+func NewResourceServiceGetResourceArgs() *ResourceServiceGetResourceArgs {
+	return &ResourceServiceGetResourceArgs{}
 }
 
-func (p *ResourceServiceSearchResourcesArgs) InitDefault() {
+func (p *ResourceServiceGetResourceArgs) InitDefault() {
 }
 
-var ResourceServiceSearchResourcesArgs_Req_DEFAULT *SearchResourceReq
+var ResourceServiceGetResourceArgs_Req_DEFAULT *GetResourceReq
 
-func (p *ResourceServiceSearchResourcesArgs) GetReq() (v *SearchResourceReq) {
+func (p *ResourceServiceGetResourceArgs) GetReq() (v *GetResourceReq) {
 	if !p.IsSetReq() {
-		return ResourceServiceSearchResourcesArgs_Req_DEFAULT
+		return ResourceServiceGetResourceArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_ResourceServiceSearchResourcesArgs = map[int16]string{
+var fieldIDToName_ResourceServiceGetResourceArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *ResourceServiceSearchResourcesArgs) IsSetReq() bool {
+func (p *ResourceServiceGetResourceArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ResourceServiceSearchResourcesArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ResourceServiceGetResourceArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6480,7 +9114,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ResourceServiceSearchResourcesArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ResourceServiceGetResourceArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6490,8 +9124,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ResourceServiceSearchResourcesArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewSearchResourceReq()
+func (p *ResourceServiceGetResourceArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetResourceReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -6499,9 +9133,9 @@ func (p *ResourceServiceSearchResourcesArgs) ReadField1(iprot thrift.TProtocol)
 	return nil
 }
 
-func (p *ResourceServiceSearchResourcesArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ResourceServiceGetResourceArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("searchResources_args"); err != nil {
+	if err = oprot.WriteStructBegin("getResource_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6527,7 +9161,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ResourceServiceSearchResourcesArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ResourceServiceGetResourceArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -6544,43 +9178,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ResourceServiceSearchResourcesArgs) String() string {
+func (p *ResourceServiceGetResourceArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ResourceServiceSearchResourcesArgs(%+v)", *p)
+	return fmt.Sprintf("ResourceServiceGetResourceArgs(%+v)", *p)
 
 }
 
-type ResourceServiceSearchResourcesResult struct {
-	Success *SearchResourceResp `thrift:"success,0,optional"`
+type ResourceServiceGetResourceResult struct {
+	Success *GetResourceResp `thrift:"success,0,optional"`
 }
 
-func NewResourceServiceSearchResourcesResult() *ResourceServiceSearchResourcesResult {
-	return &ResourceServiceSearchResourcesResult{}
+func NewResourceServiceGetResourceResult() *ResourceServiceGetResourceResult {
+	return &ResourceServiceGetResourceResult{}
 }
 
-func (p *ResourceServiceSearchResourcesResult) InitDefault() {
+func (p *ResourceServiceGetResourceResult) InitDefault() {
 }
 
-var ResourceServiceSearchResourcesResult_Success_DEFAULT *SearchResourceResp
+var ResourceServiceGetResourceResult_Success_DEFAULT *GetResourceResp
 
-func (p *ResourceServiceSearchResourcesResult) GetSuccess() (v *SearchResourceResp) {
+func (p *ResourceServiceGetResourceResult) GetSuccess() (v *GetResourceResp) {
 	if !p.IsSetSuccess() {
-		return ResourceServiceSearchResourcesResult_Success_DEFAULT
+		return ResourceServiceGetResourceResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_ResourceServiceSearchResourcesResult = map[int16]string{
+var fieldIDToName_ResourceServiceGetResourceResult = map[int16]string{
 	0: "success",
 }
 
-func (p *ResourceServiceSearchResourcesResult) IsSetSuccess() bool {
+func (p *ResourceServiceGetResourceResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ResourceServiceSearchResourcesResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ResourceServiceGetResourceResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6626,7 +9260,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ResourceServiceSearchResourcesResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ResourceServiceGetResourceResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6636,8 +9270,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ResourceServiceSearchResourcesResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewSearchResourceResp()
+func (p *ResourceServiceGetResourceResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetResourceResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -6645,9 +9279,9 @@ func (p *ResourceServiceSearchResourcesResult) ReadField0(iprot thrift.TProtocol
 	return nil
 }
 
-func (p *ResourceServiceSearchResourcesResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ResourceServiceGetResourceResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("searchResources_result"); err != nil {
+	if err = oprot.WriteStructBegin("getResource_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6673,7 +9307,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ResourceServiceSearchResourcesResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ResourceServiceGetResourceResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -6692,43 +9326,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *ResourceServiceSearchResourcesResult) String() string {
+func (p *ResourceServiceGetResourceResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ResourceServiceSearchResourcesResult(%+v)", *p)
+	return fmt.Sprintf("ResourceServiceGetResourceResult(%+v)", *p)
 
 }
 
-type ResourceServiceUploadResourceArgs struct {
-	Req *UploadResourceReq `thrift:"req,1"`
+type ResourceServiceSubmitResourceRatingArgs struct {
+	Req *SubmitResourceRatingReq `thrift:"req,1"`
 }
 
-func NewResourceServiceUploadResourceArgs() *ResourceServiceUploadResourceArgs {
-	return &ResourceServiceUploadResourceArgs{}
+func NewResourceServiceSubmitResourceRatingArgs() *ResourceServiceSubmitResourceRatingArgs {
+	return &ResourceServiceSubmitResourceRatingArgs{}
 }
 
-func (p *ResourceServiceUploadResourceArgs) InitDefault() {
+func (p *ResourceServiceSubmitResourceRatingArgs) InitDefault() {
 }
 
-var ResourceServiceUploadResourceArgs_Req_DEFAULT *UploadResourceReq
+var ResourceServiceSubmitResourceRatingArgs_Req_DEFAULT *SubmitResourceRatingReq
 
-func (p *ResourceServiceUploadResourceArgs) GetReq() (v *UploadResourceReq) {
+func (p *ResourceServiceSubmitResourceRatingArgs) GetReq() (v *SubmitResourceRatingReq) {
 	if !p.IsSetReq() {
-		return ResourceServiceUploadResourceArgs_Req_DEFAULT
+		return ResourceServiceSubmitResourceRatingArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_ResourceServiceUploadResourceArgs = map[int16]string{
+var fieldIDToName_ResourceServiceSubmitResourceRatingArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *ResourceServiceUploadResourceArgs) IsSetReq() bool {
+func (p *ResourceServiceSubmitResourceRatingArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ResourceServiceUploadResourceArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ResourceServiceSubmitResourceRatingArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6774,7 +9408,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ResourceServiceUploadResourceArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ResourceServiceSubmitResourceRatingArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6784,8 +9418,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ResourceServiceUploadResourceArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewUploadResourceReq()
+func (p *ResourceServiceSubmitResourceRatingArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewSubmitResourceRatingReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -6793,9 +9427,9 @@ func (p *ResourceServiceUploadResourceArgs) ReadField1(iprot thrift.TProtocol) e
 	return nil
 }
 
-func (p *ResourceServiceUploadResourceArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ResourceServiceSubmitResourceRatingArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("uploadResource_args"); err != nil {
+	if err = oprot.WriteStructBegin("submitResourceRating_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6821,7 +9455,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ResourceServiceUploadResourceArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ResourceServiceSubmitResourceRatingArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -6838,43 +9472,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ResourceServiceUploadResourceArgs) String() string {
+func (p *ResourceServiceSubmitResourceRatingArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ResourceServiceUploadResourceArgs(%+v)", *p)
+	return fmt.Sprintf("ResourceServiceSubmitResourceRatingArgs(%+v)", *p)
 
 }
 
-type ResourceServiceUploadResourceResult struct {
-	Success *UploadResourceResp `thrift:"success,0,optional"`
+type ResourceServiceSubmitResourceRatingResult struct {
+	Success *SubmitResourceRatingResp `thrift:"success,0,optional"`
 }
 
-func NewResourceServiceUploadResourceResult() *ResourceServiceUploadResourceResult {
-	return &ResourceServiceUploadResourceResult{}
+func NewResourceServiceSubmitResourceRatingResult() *ResourceServiceSubmitResourceRatingResult {
+	return &ResourceServiceSubmitResourceRatingResult{}
 }
 
-func (p *ResourceServiceUploadResourceResult) InitDefault() {
+func (p *ResourceServiceSubmitResourceRatingResult) InitDefault() {
 }
 
-var ResourceServiceUploadResourceResult_Success_DEFAULT *UploadResourceResp
+var ResourceServiceSubmitResourceRatingResult_Success_DEFAULT *SubmitResourceRatingResp
 
-func (p *ResourceServiceUploadResourceResult) GetSuccess() (v *UploadResourceResp) {
+func (p *ResourceServiceSubmitResourceRatingResult) GetSuccess() (v *SubmitResourceRatingResp) {
 	if !p.IsSetSuccess() {
-		return ResourceServiceUploadResourceResult_Success_DEFAULT
+		return ResourceServiceSubmitResourceRatingResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_ResourceServiceUploadResourceResult = map[int16]string{
+var fieldIDToName_ResourceServiceSubmitResourceRatingResult = map[int16]string{
 	0: "success",
 }
 
-func (p *ResourceServiceUploadResourceResult) IsSetSuccess() bool {
+func (p *ResourceServiceSubmitResourceRatingResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ResourceServiceUploadResourceResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ResourceServiceSubmitResourceRatingResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6920,7 +9554,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ResourceServiceUploadResourceResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ResourceServiceSubmitResourceRatingResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6930,8 +9564,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ResourceServiceUploadResourceResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewUploadResourceResp()
+func (p *ResourceServiceSubmitResourceRatingResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewSubmitResourceRatingResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -6939,9 +9573,9 @@ func (p *ResourceServiceUploadResourceResult) ReadField0(iprot thrift.TProtocol)
 	return nil
 }
 
-func (p *ResourceServiceUploadResourceResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ResourceServiceSubmitResourceRatingResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("uploadResource_result"); err != nil {
+	if err = oprot.WriteStructBegin("submitResourceRating_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6967,7 +9601,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ResourceServiceUploadResourceResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ResourceServiceSubmitResourceRatingResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -6986,43 +9620,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *ResourceServiceUploadResourceResult) String() string {
+func (p *ResourceServiceSubmitResourceRatingResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ResourceServiceUploadResourceResult(%+v)", *p)
+	return fmt.Sprintf("ResourceServiceSubmitResourceRatingResult(%+v)", *p)
 
 }
 
-type ResourceServiceDownloadResourceArgs struct {
-	Req *DownloadResourceReq `thrift:"req,1"`
+type ResourceServiceDeleteResourceRatingArgs struct {
+	Req *DeleteResourceRatingReq `thrift:"req,1"`
 }
 
-func NewResourceServiceDownloadResourceArgs() *ResourceServiceDownloadResourceArgs {
-	return &ResourceServiceDownloadResourceArgs{}
+func NewResourceServiceDeleteResourceRatingArgs() *ResourceServiceDeleteResourceRatingArgs {
+	return &ResourceServiceDeleteResourceRatingArgs{}
 }
 
-func (p *ResourceServiceDownloadResourceArgs) InitDefault() {
+func (p *ResourceServiceDeleteResourceRatingArgs) InitDefault() {
 }
 
-var ResourceServiceDownloadResourceArgs_Req_DEFAULT *DownloadResourceReq
+var ResourceServiceDeleteResourceRatingArgs_Req_DEFAULT *DeleteResourceRatingReq
 
-func (p *ResourceServiceDownloadResourceArgs) GetReq() (v *DownloadResourceReq) {
+func (p *ResourceServiceDeleteResourceRatingArgs) GetReq() (v *DeleteResourceRatingReq) {
 	if !p.IsSetReq() {
-		return ResourceServiceDownloadResourceArgs_Req_DEFAULT
+		return ResourceServiceDeleteResourceRatingArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_ResourceServiceDownloadResourceArgs = map[int16]string{
+var fieldIDToName_ResourceServiceDeleteResourceRatingArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *ResourceServiceDownloadResourceArgs) IsSetReq() bool {
+func (p *ResourceServiceDeleteResourceRatingArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ResourceServiceDownloadResourceArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ResourceServiceDeleteResourceRatingArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -7068,7 +9702,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ResourceServiceDownloadResourceArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ResourceServiceDeleteResourceRatingArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7078,8 +9712,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ResourceServiceDownloadResourceArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewDownloadResourceReq()
+func (p *ResourceServiceDeleteResourceRatingArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewDeleteResourceRatingReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -7087,9 +9721,9 @@ func (p *ResourceServiceDownloadResourceArgs) ReadField1(iprot thrift.TProtocol)
 	return nil
 }
 
-func (p *ResourceServiceDownloadResourceArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ResourceServiceDeleteResourceRatingArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("downloadResource_args"); err != nil {
+	if err = oprot.WriteStructBegin("deleteResourceRating_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7115,7 +9749,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ResourceServiceDownloadResourceArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ResourceServiceDeleteResourceRatingArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -7132,43 +9766,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ResourceServiceDownloadResourceArgs) String() string {
+func (p *ResourceServiceDeleteResourceRatingArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ResourceServiceDownloadResourceArgs(%+v)", *p)
+	return fmt.Sprintf("ResourceServiceDeleteResourceRatingArgs(%+v)", *p)
 
 }
 
-type ResourceServiceDownloadResourceResult struct {
-	Success *DownloadResourceResp `thrift:"success,0,optional"`
+type ResourceServiceDeleteResourceRatingResult struct {
+	Success *DeleteResourceRatingResp `thrift:"success,0,optional"`
 }
 
-func NewResourceServiceDownloadResourceResult() *ResourceServiceDownloadResourceResult {
-	return &ResourceServiceDownloadResourceResult{}
+func NewResourceServiceDeleteResourceRatingResult() *ResourceServiceDeleteResourceRatingResult {
+	return &ResourceServiceDeleteResourceRatingResult{}
 }
 
-func (p *ResourceServiceDownloadResourceResult) InitDefault() {
+func (p *ResourceServiceDeleteResourceRatingResult) InitDefault() {
 }
 
-var ResourceServiceDownloadResourceResult_Success_DEFAULT *DownloadResourceResp
+var ResourceServiceDeleteResourceRatingResult_Success_DEFAULT *DeleteResourceRatingResp
 
-func (p *ResourceServiceDownloadResourceResult) GetSuccess() (v *DownloadResourceResp) {
+func (p *ResourceServiceDeleteResourceRatingResult) GetSuccess() (v *DeleteResourceRatingResp) {
 	if !p.IsSetSuccess() {
-		return ResourceServiceDownloadResourceResult_Success_DEFAULT
+		return ResourceServiceDeleteResourceRatingResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_ResourceServiceDownloadResourceResult = map[int16]string{
+var fieldIDToName_ResourceServiceDeleteResourceRatingResult = map[int16]string{
 	0: "success",
 }
 
-func (p *ResourceServiceDownloadResourceResult) IsSetSuccess() bool {
+func (p *ResourceServiceDeleteResourceRatingResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ResourceServiceDownloadResourceResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ResourceServiceDeleteResourceRatingResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -7214,7 +9848,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ResourceServiceDownloadResourceResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ResourceServiceDeleteResourceRatingResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7224,8 +9858,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ResourceServiceDownloadResourceResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewDownloadResourceResp()
+func (p *ResourceServiceDeleteResourceRatingResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewDeleteResourceRatingResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -7233,9 +9867,9 @@ func (p *ResourceServiceDownloadResourceResult) ReadField0(iprot thrift.TProtoco
 	return nil
 }
 
-func (p *ResourceServiceDownloadResourceResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ResourceServiceDeleteResourceRatingResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("downloadResource_result"); err != nil {
+	if err = oprot.WriteStructBegin("deleteResourceRating_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7261,7 +9895,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ResourceServiceDownloadResourceResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ResourceServiceDeleteResourceRatingResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -7280,43 +9914,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *ResourceServiceDownloadResourceResult) String() string {
+func (p *ResourceServiceDeleteResourceRatingResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ResourceServiceDownloadResourceResult(%+v)", *p)
+	return fmt.Sprintf("ResourceServiceDeleteResourceRatingResult(%+v)", *p)
 
 }
 
-type ResourceServiceReportResourceArgs struct {
-	Req *ReportResourceReq `thrift:"req,1"`
+type ResourceServiceSubmitResourceCommentArgs struct {
+	Req *SubmitResourceCommentReq `thrift:"req,1"`
 }
 
-func NewResourceServiceReportResourceArgs() *ResourceServiceReportResourceArgs {
-	return &ResourceServiceReportResourceArgs{}
+func NewResourceServiceSubmitResourceCommentArgs() *ResourceServiceSubmitResourceCommentArgs {
+	return &ResourceServiceSubmitResourceCommentArgs{}
 }
 
-func (p *ResourceServiceReportResourceArgs) InitDefault() {
+func (p *ResourceServiceSubmitResourceCommentArgs) InitDefault() {
 }
 
-var ResourceServiceReportResourceArgs_Req_DEFAULT *ReportResourceReq
+var ResourceServiceSubmitResourceCommentArgs_Req_DEFAULT *SubmitResourceCommentReq
 
-func (p *ResourceServiceReportResourceArgs) GetReq() (v *ReportResourceReq) {
+func (p *ResourceServiceSubmitResourceCommentArgs) GetReq() (v *SubmitResourceCommentReq) {
 	if !p.IsSetReq() {
-		return ResourceServiceReportResourceArgs_Req_DEFAULT
+		return ResourceServiceSubmitResourceCommentArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_ResourceServiceReportResourceArgs = map[int16]string{
+var fieldIDToName_ResourceServiceSubmitResourceCommentArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *ResourceServiceReportResourceArgs) IsSetReq() bool {
+func (p *ResourceServiceSubmitResourceCommentArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ResourceServiceReportResourceArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ResourceServiceSubmitResourceCommentArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -7362,7 +9996,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ResourceServiceReportResourceArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ResourceServiceSubmitResourceCommentArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7372,8 +10006,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ResourceServiceReportResourceArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewReportResourceReq()
+func (p *ResourceServiceSubmitResourceCommentArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewSubmitResourceCommentReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -7381,9 +10015,9 @@ func (p *ResourceServiceReportResourceArgs) ReadField1(iprot thrift.TProtocol) e
 	return nil
 }
 
-func (p *ResourceServiceReportResourceArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ResourceServiceSubmitResourceCommentArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("reportResource_args"); err != nil {
+	if err = oprot.WriteStructBegin("submitResourceComment_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7409,7 +10043,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ResourceServiceReportResourceArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ResourceServiceSubmitResourceCommentArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -7426,43 +10060,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ResourceServiceReportResourceArgs) String() string {
+func (p *ResourceServiceSubmitResourceCommentArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ResourceServiceReportResourceArgs(%+v)", *p)
+	return fmt.Sprintf("ResourceServiceSubmitResourceCommentArgs(%+v)", *p)
 
 }
 
-type ResourceServiceReportResourceResult struct {
-	Success *ReportResourceResp `thrift:"success,0,optional"`
+type ResourceServiceSubmitResourceCommentResult struct {
+	Success *SubmitResourceCommentResp `thrift:"success,0,optional"`
 }
 
-func NewResourceServiceReportResourceResult() *ResourceServiceReportResourceResult {
-	return &ResourceServiceReportResourceResult{}
+func NewResourceServiceSubmitResourceCommentResult() *ResourceServiceSubmitResourceCommentResult {
+	return &ResourceServiceSubmitResourceCommentResult{}
 }
 
-func (p *ResourceServiceReportResourceResult) InitDefault() {
+func (p *ResourceServiceSubmitResourceCommentResult) InitDefault() {
 }
 
-var ResourceServiceReportResourceResult_Success_DEFAULT *ReportResourceResp
+var ResourceServiceSubmitResourceCommentResult_Success_DEFAULT *SubmitResourceCommentResp
 
-func (p *ResourceServiceReportResourceResult) GetSuccess() (v *ReportResourceResp) {
+func (p *ResourceServiceSubmitResourceCommentResult) GetSuccess() (v *SubmitResourceCommentResp) {
 	if !p.IsSetSuccess() {
-		return ResourceServiceReportResourceResult_Success_DEFAULT
+		return ResourceServiceSubmitResourceCommentResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_ResourceServiceReportResourceResult = map[int16]string{
+var fieldIDToName_ResourceServiceSubmitResourceCommentResult = map[int16]string{
 	0: "success",
 }
 
-func (p *ResourceServiceReportResourceResult) IsSetSuccess() bool {
+func (p *ResourceServiceSubmitResourceCommentResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ResourceServiceReportResourceResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ResourceServiceSubmitResourceCommentResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -7508,7 +10142,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ResourceServiceReportResourceResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ResourceServiceSubmitResourceCommentResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7518,8 +10152,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ResourceServiceReportResourceResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewReportResourceResp()
+func (p *ResourceServiceSubmitResourceCommentResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewSubmitResourceCommentResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -7527,9 +10161,9 @@ func (p *ResourceServiceReportResourceResult) ReadField0(iprot thrift.TProtocol)
 	return nil
 }
 
-func (p *ResourceServiceReportResourceResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ResourceServiceSubmitResourceCommentResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("reportResource_result"); err != nil {
+	if err = oprot.WriteStructBegin("submitResourceComment_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7555,7 +10189,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ResourceServiceReportResourceResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ResourceServiceSubmitResourceCommentResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -7574,43 +10208,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *ResourceServiceReportResourceResult) String() string {
+func (p *ResourceServiceSubmitResourceCommentResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ResourceServiceReportResourceResult(%+v)", *p)
+	return fmt.Sprintf("ResourceServiceSubmitResourceCommentResult(%+v)", *p)
 
 }
 
-type ResourceServiceGetResourceArgs struct {
-	Req *GetResourceReq `thrift:"req,1"`
+type ResourceServiceDeleteResourceCommentArgs struct {
+	Req *DeleteResourceCommentReq `thrift:"req,1"`
 }
 
-func NewResourceServiceGetResourceArgs() *ResourceServiceGetResourceArgs {
-	return &ResourceServiceGetResourceArgs{}
+func NewResourceServiceDeleteResourceCommentArgs() *ResourceServiceDeleteResourceCommentArgs {
+	return &ResourceServiceDeleteResourceCommentArgs{}
 }
 
-func (p *ResourceServiceGetResourceArgs) InitDefault() {
+func (p *ResourceServiceDeleteResourceCommentArgs) InitDefault() {
 }
 
-var ResourceServiceGetResourceArgs_Req_DEFAULT *GetResourceReq
+var ResourceServiceDeleteResourceCommentArgs_Req_DEFAULT *DeleteResourceCommentReq
 
-func (p *ResourceServiceGetResourceArgs) GetReq() (v *GetResourceReq) {
+func (p *ResourceServiceDeleteResourceCommentArgs) GetReq() (v *DeleteResourceCommentReq) {
 	if !p.IsSetReq() {
-		return ResourceServiceGetResourceArgs_Req_DEFAULT
+		return ResourceServiceDeleteResourceCommentArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_ResourceServiceGetResourceArgs = map[int16]string{
+var fieldIDToName_ResourceServiceDeleteResourceCommentArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *ResourceServiceGetResourceArgs) IsSetReq() bool {
+func (p *ResourceServiceDeleteResourceCommentArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ResourceServiceGetResourceArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ResourceServiceDeleteResourceCommentArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -7656,7 +10290,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ResourceServiceGetResourceArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ResourceServiceDeleteResourceCommentArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7666,8 +10300,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ResourceServiceGetResourceArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewGetResourceReq()
+func (p *ResourceServiceDeleteResourceCommentArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewDeleteResourceCommentReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -7675,9 +10309,9 @@ func (p *ResourceServiceGetResourceArgs) ReadField1(iprot thrift.TProtocol) erro
 	return nil
 }
 
-func (p *ResourceServiceGetResourceArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ResourceServiceDeleteResourceCommentArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("getResource_args"); err != nil {
+	if err = oprot.WriteStructBegin("deleteResourceComment_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7703,7 +10337,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ResourceServiceGetResourceArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ResourceServiceDeleteResourceCommentArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -7720,43 +10354,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ResourceServiceGetResourceArgs) String() string {
+func (p *ResourceServiceDeleteResourceCommentArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ResourceServiceGetResourceArgs(%+v)", *p)
+	return fmt.Sprintf("ResourceServiceDeleteResourceCommentArgs(%+v)", *p)
 
 }
 
-type ResourceServiceGetResourceResult struct {
-	Success *GetResourceResp `thrift:"success,0,optional"`
+type ResourceServiceDeleteResourceCommentResult struct {
+	Success *DeleteResourceCommentResp `thrift:"success,0,optional"`
 }
 
-func NewResourceServiceGetResourceResult() *ResourceServiceGetResourceResult {
-	return &ResourceServiceGetResourceResult{}
+func NewResourceServiceDeleteResourceCommentResult() *ResourceServiceDeleteResourceCommentResult {
+	return &ResourceServiceDeleteResourceCommentResult{}
 }
 
-func (p *ResourceServiceGetResourceResult) InitDefault() {
+func (p *ResourceServiceDeleteResourceCommentResult) InitDefault() {
 }
 
-var ResourceServiceGetResourceResult_Success_DEFAULT *GetResourceResp
+var ResourceServiceDeleteResourceCommentResult_Success_DEFAULT *DeleteResourceCommentResp
 
-func (p *ResourceServiceGetResourceResult) GetSuccess() (v *GetResourceResp) {
+func (p *ResourceServiceDeleteResourceCommentResult) GetSuccess() (v *DeleteResourceCommentResp) {
 	if !p.IsSetSuccess() {
-		return ResourceServiceGetResourceResult_Success_DEFAULT
+		return ResourceServiceDeleteResourceCommentResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_ResourceServiceGetResourceResult = map[int16]string{
+var fieldIDToName_ResourceServiceDeleteResourceCommentResult = map[int16]string{
 	0: "success",
 }
 
-func (p *ResourceServiceGetResourceResult) IsSetSuccess() bool {
+func (p *ResourceServiceDeleteResourceCommentResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ResourceServiceGetResourceResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ResourceServiceDeleteResourceCommentResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -7802,7 +10436,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ResourceServiceGetResourceResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ResourceServiceDeleteResourceCommentResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7812,8 +10446,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ResourceServiceGetResourceResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewGetResourceResp()
+func (p *ResourceServiceDeleteResourceCommentResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewDeleteResourceCommentResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -7821,9 +10455,9 @@ func (p *ResourceServiceGetResourceResult) ReadField0(iprot thrift.TProtocol) er
 	return nil
 }
 
-func (p *ResourceServiceGetResourceResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ResourceServiceDeleteResourceCommentResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("getResource_result"); err != nil {
+	if err = oprot.WriteStructBegin("deleteResourceComment_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7849,7 +10483,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ResourceServiceGetResourceResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ResourceServiceDeleteResourceCommentResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -7868,43 +10502,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *ResourceServiceGetResourceResult) String() string {
+func (p *ResourceServiceDeleteResourceCommentResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ResourceServiceGetResourceResult(%+v)", *p)
+	return fmt.Sprintf("ResourceServiceDeleteResourceCommentResult(%+v)", *p)
 
 }
 
-type ResourceServiceSubmitResourceRatingArgs struct {
-	Req *SubmitResourceRatingReq `thrift:"req,1"`
+type ResourceServiceGetResourceCommentsArgs struct {
+	Req *GetResourceCommentsReq `thrift:"req,1"`
 }
 
-func NewResourceServiceSubmitResourceRatingArgs() *ResourceServiceSubmitResourceRatingArgs {
-	return &ResourceServiceSubmitResourceRatingArgs{}
+func NewResourceServiceGetResourceCommentsArgs() *ResourceServiceGetResourceCommentsArgs {
+	return &ResourceServiceGetResourceCommentsArgs{}
 }
 
-func (p *ResourceServiceSubmitResourceRatingArgs) InitDefault() {
+func (p *ResourceServiceGetResourceCommentsArgs) InitDefault() {
 }
 
-var ResourceServiceSubmitResourceRatingArgs_Req_DEFAULT *SubmitResourceRatingReq
+var ResourceServiceGetResourceCommentsArgs_Req_DEFAULT *GetResourceCommentsReq
 
-func (p *ResourceServiceSubmitResourceRatingArgs) GetReq() (v *SubmitResourceRatingReq) {
+func (p *ResourceServiceGetResourceCommentsArgs) GetReq() (v *GetResourceCommentsReq) {
 	if !p.IsSetReq() {
-		return ResourceServiceSubmitResourceRatingArgs_Req_DEFAULT
+		return ResourceServiceGetResourceCommentsArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_ResourceServiceSubmitResourceRatingArgs = map[int16]string{
+var fieldIDToName_ResourceServiceGetResourceCommentsArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *ResourceServiceSubmitResourceRatingArgs) IsSetReq() bool {
+func (p *ResourceServiceGetResourceCommentsArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ResourceServiceSubmitResourceRatingArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ResourceServiceGetResourceCommentsArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -7950,7 +10584,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ResourceServiceSubmitResourceRatingArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ResourceServiceGetResourceCommentsArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7960,8 +10594,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ResourceServiceSubmitResourceRatingArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewSubmitResourceRatingReq()
+func (p *ResourceServiceGetResourceCommentsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetResourceCommentsReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -7969,9 +10603,9 @@ func (p *ResourceServiceSubmitResourceRatingArgs) ReadField1(iprot thrift.TProto
 	return nil
 }
 
-func (p *ResourceServiceSubmitResourceRatingArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ResourceServiceGetResourceCommentsArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("submitResourceRating_args"); err != nil {
+	if err = oprot.WriteStructBegin("getResourceComments_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7997,7 +10631,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ResourceServiceSubmitResourceRatingArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ResourceServiceGetResourceCommentsArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -8014,43 +10648,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ResourceServiceSubmitResourceRatingArgs) String() string {
+func (p *ResourceServiceGetResourceCommentsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ResourceServiceSubmitResourceRatingArgs(%+v)", *p)
+	return fmt.Sprintf("ResourceServiceGetResourceCommentsArgs(%+v)", *p)
 
 }
 
-type ResourceServiceSubmitResourceRatingResult struct {
-	Success *SubmitResourceRatingResp `thrift:"success,0,optional"`
+type ResourceServiceGetResourceCommentsResult struct {
+	Success *GetResourceCommentsResp `thrift:"success,0,optional"`
 }
 
-func NewResourceServiceSubmitResourceRatingResult() *ResourceServiceSubmitResourceRatingResult {
-	return &ResourceServiceSubmitResourceRatingResult{}
+func NewResourceServiceGetResourceCommentsResult() *ResourceServiceGetResourceCommentsResult {
+	return &ResourceServiceGetResourceCommentsResult{}
 }
 
-func (p *ResourceServiceSubmitResourceRatingResult) InitDefault() {
+func (p *ResourceServiceGetResourceCommentsResult) InitDefault() {
 }
 
-var ResourceServiceSubmitResourceRatingResult_Success_DEFAULT *SubmitResourceRatingResp
+var ResourceServiceGetResourceCommentsResult_Success_DEFAULT *GetResourceCommentsResp
 
-func (p *ResourceServiceSubmitResourceRatingResult) GetSuccess() (v *SubmitResourceRatingResp) {
+func (p *ResourceServiceGetResourceCommentsResult) GetSuccess() (v *GetResourceCommentsResp) {
 	if !p.IsSetSuccess() {
-		return ResourceServiceSubmitResourceRatingResult_Success_DEFAULT
+		return ResourceServiceGetResourceCommentsResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_ResourceServiceSubmitResourceRatingResult = map[int16]string{
+var fieldIDToName_ResourceServiceGetResourceCommentsResult = map[int16]string{
 	0: "success",
 }
 
-func (p *ResourceServiceSubmitResourceRatingResult) IsSetSuccess() bool {
+func (p *ResourceServiceGetResourceCommentsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ResourceServiceSubmitResourceRatingResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ResourceServiceGetResourceCommentsResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -8096,7 +10730,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ResourceServiceSubmitResourceRatingResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ResourceServiceGetResourceCommentsResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -8106,8 +10740,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ResourceServiceSubmitResourceRatingResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewSubmitResourceRatingResp()
+func (p *ResourceServiceGetResourceCommentsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetResourceCommentsResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -8115,9 +10749,9 @@ func (p *ResourceServiceSubmitResourceRatingResult) ReadField0(iprot thrift.TPro
 	return nil
 }
 
-func (p *ResourceServiceSubmitResourceRatingResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ResourceServiceGetResourceCommentsResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("submitResourceRating_result"); err != nil {
+	if err = oprot.WriteStructBegin("getResourceComments_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -8143,7 +10777,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ResourceServiceSubmitResourceRatingResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ResourceServiceGetResourceCommentsResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -8162,43 +10796,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *ResourceServiceSubmitResourceRatingResult) String() string {
+func (p *ResourceServiceGetResourceCommentsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ResourceServiceSubmitResourceRatingResult(%+v)", *p)
+	return fmt.Sprintf("ResourceServiceGetResourceCommentsResult(%+v)", *p)
 
 }
 
-type ResourceServiceDeleteResourceRatingArgs struct {
-	Req *DeleteResourceRatingReq `thrift:"req,1"`
+type ResourceServiceReactResourceCommentArgs struct {
+	Req *SubmitResourceCommentReactionReq `thrift:"req,1"`
 }
 
-func NewResourceServiceDeleteResourceRatingArgs() *ResourceServiceDeleteResourceRatingArgs {
-	return &ResourceServiceDeleteResourceRatingArgs{}
+func NewResourceServiceReactResourceCommentArgs() *ResourceServiceReactResourceCommentArgs {
+	return &ResourceServiceReactResourceCommentArgs{}
 }
 
-func (p *ResourceServiceDeleteResourceRatingArgs) InitDefault() {
+func (p *ResourceServiceReactResourceCommentArgs) InitDefault() {
 }
 
-var ResourceServiceDeleteResourceRatingArgs_Req_DEFAULT *DeleteResourceRatingReq
+var ResourceServiceReactResourceCommentArgs_Req_DEFAULT *SubmitResourceCommentReactionReq
 
-func (p *ResourceServiceDeleteResourceRatingArgs) GetReq() (v *DeleteResourceRatingReq) {
+func (p *ResourceServiceReactResourceCommentArgs) GetReq() (v *SubmitResourceCommentReactionReq) {
 	if !p.IsSetReq() {
-		return ResourceServiceDeleteResourceRatingArgs_Req_DEFAULT
+		return ResourceServiceReactResourceCommentArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_ResourceServiceDeleteResourceRatingArgs = map[int16]string{
+var fieldIDToName_ResourceServiceReactResourceCommentArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *ResourceServiceDeleteResourceRatingArgs) IsSetReq() bool {
+func (p *ResourceServiceReactResourceCommentArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ResourceServiceDeleteResourceRatingArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ResourceServiceReactResourceCommentArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -8244,7 +10878,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ResourceServiceDeleteResourceRatingArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ResourceServiceReactResourceCommentArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -8254,8 +10888,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ResourceServiceDeleteResourceRatingArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewDeleteResourceRatingReq()
+func (p *ResourceServiceReactResourceCommentArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewSubmitResourceCommentReactionReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -8263,9 +10897,9 @@ func (p *ResourceServiceDeleteResourceRatingArgs) ReadField1(iprot thrift.TProto
 	return nil
 }
 
-func (p *ResourceServiceDeleteResourceRatingArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ResourceServiceReactResourceCommentArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("deleteResourceRating_args"); err != nil {
+	if err = oprot.WriteStructBegin("reactResourceComment_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -8291,7 +10925,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ResourceServiceDeleteResourceRatingArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ResourceServiceReactResourceCommentArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -8308,43 +10942,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ResourceServiceDeleteResourceRatingArgs) String() string {
+func (p *ResourceServiceReactResourceCommentArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ResourceServiceDeleteResourceRatingArgs(%+v)", *p)
+	return fmt.Sprintf("ResourceServiceReactResourceCommentArgs(%+v)", *p)
 
 }
 
-type ResourceServiceDeleteResourceRatingResult struct {
-	Success *DeleteResourceRatingResp `thrift:"success,0,optional"`
+type ResourceServiceReactResourceCommentResult struct {
+	Success *SubmitResourceCommentReactionResp `thrift:"success,0,optional"`
 }
 
-func NewResourceServiceDeleteResourceRatingResult() *ResourceServiceDeleteResourceRatingResult {
-	return &ResourceServiceDeleteResourceRatingResult{}
+func NewResourceServiceReactResourceCommentResult() *ResourceServiceReactResourceCommentResult {
+	return &ResourceServiceReactResourceCommentResult{}
 }
 
-func (p *ResourceServiceDeleteResourceRatingResult) InitDefault() {
+func (p *ResourceServiceReactResourceCommentResult) InitDefault() {
 }
 
-var ResourceServiceDeleteResourceRatingResult_Success_DEFAULT *DeleteResourceRatingResp
+var ResourceServiceReactResourceCommentResult_Success_DEFAULT *SubmitResourceCommentReactionResp
 
-func (p *ResourceServiceDeleteResourceRatingResult) GetSuccess() (v *DeleteResourceRatingResp) {
+func (p *ResourceServiceReactResourceCommentResult) GetSuccess() (v *SubmitResourceCommentReactionResp) {
 	if !p.IsSetSuccess() {
-		return ResourceServiceDeleteResourceRatingResult_Success_DEFAULT
+		return ResourceServiceReactResourceCommentResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_ResourceServiceDeleteResourceRatingResult = map[int16]string{
+var fieldIDToName_ResourceServiceReactResourceCommentResult = map[int16]string{
 	0: "success",
 }
 
-func (p *ResourceServiceDeleteResourceRatingResult) IsSetSuccess() bool {
+func (p *ResourceServiceReactResourceCommentResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ResourceServiceDeleteResourceRatingResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ResourceServiceReactResourceCommentResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -8390,7 +11024,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ResourceServiceDeleteResourceRatingResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ResourceServiceReactResourceCommentResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -8400,8 +11034,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ResourceServiceDeleteResourceRatingResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewDeleteResourceRatingResp()
+func (p *ResourceServiceReactResourceCommentResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewSubmitResourceCommentReactionResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -8409,9 +11043,9 @@ func (p *ResourceServiceDeleteResourceRatingResult) ReadField0(iprot thrift.TPro
 	return nil
 }
 
-func (p *ResourceServiceDeleteResourceRatingResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ResourceServiceReactResourceCommentResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("deleteResourceRating_result"); err != nil {
+	if err = oprot.WriteStructBegin("reactResourceComment_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -8437,7 +11071,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ResourceServiceDeleteResourceRatingResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ResourceServiceReactResourceCommentResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -8456,43 +11090,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *ResourceServiceDeleteResourceRatingResult) String() string {
+func (p *ResourceServiceReactResourceCommentResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ResourceServiceDeleteResourceRatingResult(%+v)", *p)
+	return fmt.Sprintf("ResourceServiceReactResourceCommentResult(%+v)", *p)
 
 }
 
-type ResourceServiceSubmitResourceCommentArgs struct {
-	Req *SubmitResourceCommentReq `thrift:"req,1"`
+type ResourceServiceReportResourceCommentArgs struct {
+	Req *ReportResourceCommentReq `thrift:"req,1"`
 }
 
-func NewResourceServiceSubmitResourceCommentArgs() *ResourceServiceSubmitResourceCommentArgs {
-	return &ResourceServiceSubmitResourceCommentArgs{}
+func NewResourceServiceReportResourceCommentArgs() *ResourceServiceReportResourceCommentArgs {
+	return &ResourceServiceReportResourceCommentArgs{}
 }
 
-func (p *ResourceServiceSubmitResourceCommentArgs) InitDefault() {
+func (p *ResourceServiceReportResourceCommentArgs) InitDefault() {
 }
 
-var ResourceServiceSubmitResourceCommentArgs_Req_DEFAULT *SubmitResourceCommentReq
+var ResourceServiceReportResourceCommentArgs_Req_DEFAULT *ReportResourceCommentReq
 
-func (p *ResourceServiceSubmitResourceCommentArgs) GetReq() (v *SubmitResourceCommentReq) {
+func (p *ResourceServiceReportResourceCommentArgs) GetReq() (v *ReportResourceCommentReq) {
 	if !p.IsSetReq() {
-		return ResourceServiceSubmitResourceCommentArgs_Req_DEFAULT
+		return ResourceServiceReportResourceCommentArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_ResourceServiceSubmitResourceCommentArgs = map[int16]string{
+var fieldIDToName_ResourceServiceReportResourceCommentArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *ResourceServiceSubmitResourceCommentArgs) IsSetReq() bool {
+func (p *ResourceServiceReportResourceCommentArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ResourceServiceSubmitResourceCommentArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ResourceServiceReportResourceCommentArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -8538,7 +11172,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ResourceServiceSubmitResourceCommentArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ResourceServiceReportResourceCommentArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -8548,8 +11182,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ResourceServiceSubmitResourceCommentArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewSubmitResourceCommentReq()
+func (p *ResourceServiceReportResourceCommentArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewReportResourceCommentReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -8557,9 +11191,9 @@ func (p *ResourceServiceSubmitResourceCommentArgs) ReadField1(iprot thrift.TProt
 	return nil
 }
 
-func (p *ResourceServiceSubmitResourceCommentArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ResourceServiceReportResourceCommentArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("submitResourceComment_args"); err != nil {
+	if err = oprot.WriteStructBegin("reportResourceComment_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -8585,7 +11219,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ResourceServiceSubmitResourceCommentArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ResourceServiceReportResourceCommentArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -8602,43 +11236,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ResourceServiceSubmitResourceCommentArgs) String() string {
+func (p *ResourceServiceReportResourceCommentArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ResourceServiceSubmitResourceCommentArgs(%+v)", *p)
+	return fmt.Sprintf("ResourceServiceReportResourceCommentArgs(%+v)", *p)
 
 }
 
-type ResourceServiceSubmitResourceCommentResult struct {
-	Success *SubmitResourceCommentResp `thrift:"success,0,optional"`
+type ResourceServiceReportResourceCommentResult struct {
+	Success *ReportResourceCommentResp `thrift:"success,0,optional"`
 }
 
-func NewResourceServiceSubmitResourceCommentResult() *ResourceServiceSubmitResourceCommentResult {
-	return &ResourceServiceSubmitResourceCommentResult{}
+func NewResourceServiceReportResourceCommentResult() *ResourceServiceReportResourceCommentResult {
+	return &ResourceServiceReportResourceCommentResult{}
 }
 
-func (p *ResourceServiceSubmitResourceCommentResult) InitDefault() {
+func (p *ResourceServiceReportResourceCommentResult) InitDefault() {
 }
 
-var ResourceServiceSubmitResourceCommentResult_Success_DEFAULT *SubmitResourceCommentResp
+var ResourceServiceReportResourceCommentResult_Success_DEFAULT *ReportResourceCommentResp
 
-func (p *ResourceServiceSubmitResourceCommentResult) GetSuccess() (v *SubmitResourceCommentResp) {
+func (p *ResourceServiceReportResourceCommentResult) GetSuccess() (v *ReportResourceCommentResp) {
 	if !p.IsSetSuccess() {
-		return ResourceServiceSubmitResourceCommentResult_Success_DEFAULT
+		return ResourceServiceReportResourceCommentResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_ResourceServiceSubmitResourceCommentResult = map[int16]string{
+var fieldIDToName_ResourceServiceReportResourceCommentResult = map[int16]string{
 	0: "success",
 }
 
-func (p *ResourceServiceSubmitResourceCommentResult) IsSetSuccess() bool {
+func (p *ResourceServiceReportResourceCommentResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ResourceServiceSubmitResourceCommentResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ResourceServiceReportResourceCommentResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -8684,7 +11318,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ResourceServiceSubmitResourceCommentResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ResourceServiceReportResourceCommentResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -8694,8 +11328,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ResourceServiceSubmitResourceCommentResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewSubmitResourceCommentResp()
+func (p *ResourceServiceReportResourceCommentResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewReportResourceCommentResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -8703,9 +11337,9 @@ func (p *ResourceServiceSubmitResourceCommentResult) ReadField0(iprot thrift.TPr
 	return nil
 }
 
-func (p *ResourceServiceSubmitResourceCommentResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ResourceServiceReportResourceCommentResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("submitResourceComment_result"); err != nil {
+	if err = oprot.WriteStructBegin("reportResourceComment_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -8731,7 +11365,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ResourceServiceSubmitResourceCommentResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ResourceServiceReportResourceCommentResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -8750,43 +11384,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *ResourceServiceSubmitResourceCommentResult) String() string {
+func (p *ResourceServiceReportResourceCommentResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ResourceServiceSubmitResourceCommentResult(%+v)", *p)
+	return fmt.Sprintf("ResourceServiceReportResourceCommentResult(%+v)", *p)
 
 }
 
-type ResourceServiceDeleteResourceCommentArgs struct {
-	Req *DeleteResourceCommentReq `thrift:"req,1"`
+type ResourceServiceReportResourceRatingArgs struct {
+	Req *ReportResourceRatingReq `thrift:"req,1"`
 }
 
-func NewResourceServiceDeleteResourceCommentArgs() *ResourceServiceDeleteResourceCommentArgs {
-	return &ResourceServiceDeleteResourceCommentArgs{}
+func NewResourceServiceReportResourceRatingArgs() *ResourceServiceReportResourceRatingArgs {
+	return &ResourceServiceReportResourceRatingArgs{}
 }
 
-func (p *ResourceServiceDeleteResourceCommentArgs) InitDefault() {
+func (p *ResourceServiceReportResourceRatingArgs) InitDefault() {
 }
 
-var ResourceServiceDeleteResourceCommentArgs_Req_DEFAULT *DeleteResourceCommentReq
+var ResourceServiceReportResourceRatingArgs_Req_DEFAULT *ReportResourceRatingReq
 
-func (p *ResourceServiceDeleteResourceCommentArgs) GetReq() (v *DeleteResourceCommentReq) {
+func (p *ResourceServiceReportResourceRatingArgs) GetReq() (v *ReportResourceRatingReq) {
 	if !p.IsSetReq() {
-		return ResourceServiceDeleteResourceCommentArgs_Req_DEFAULT
+		return ResourceServiceReportResourceRatingArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_ResourceServiceDeleteResourceCommentArgs = map[int16]string{
+var fieldIDToName_ResourceServiceReportResourceRatingArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *ResourceServiceDeleteResourceCommentArgs) IsSetReq() bool {
+func (p *ResourceServiceReportResourceRatingArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ResourceServiceDeleteResourceCommentArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ResourceServiceReportResourceRatingArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -8832,7 +11466,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ResourceServiceDeleteResourceCommentArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ResourceServiceReportResourceRatingArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -8842,8 +11476,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ResourceServiceDeleteResourceCommentArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewDeleteResourceCommentReq()
+func (p *ResourceServiceReportResourceRatingArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewReportResourceRatingReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -8851,9 +11485,9 @@ func (p *ResourceServiceDeleteResourceCommentArgs) ReadField1(iprot thrift.TProt
 	return nil
 }
 
-func (p *ResourceServiceDeleteResourceCommentArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ResourceServiceReportResourceRatingArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("deleteResourceComment_args"); err != nil {
+	if err = oprot.WriteStructBegin("reportResourceRating_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -8879,7 +11513,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ResourceServiceDeleteResourceCommentArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ResourceServiceReportResourceRatingArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -8896,43 +11530,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ResourceServiceDeleteResourceCommentArgs) String() string {
+func (p *ResourceServiceReportResourceRatingArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ResourceServiceDeleteResourceCommentArgs(%+v)", *p)
+	return fmt.Sprintf("ResourceServiceReportResourceRatingArgs(%+v)", *p)
 
 }
 
-type ResourceServiceDeleteResourceCommentResult struct {
-	Success *DeleteResourceCommentResp `thrift:"success,0,optional"`
+type ResourceServiceReportResourceRatingResult struct {
+	Success *ReportResourceRatingResp `thrift:"success,0,optional"`
 }
 
-func NewResourceServiceDeleteResourceCommentResult() *ResourceServiceDeleteResourceCommentResult {
-	return &ResourceServiceDeleteResourceCommentResult{}
+func NewResourceServiceReportResourceRatingResult() *ResourceServiceReportResourceRatingResult {
+	return &ResourceServiceReportResourceRatingResult{}
 }
 
-func (p *ResourceServiceDeleteResourceCommentResult) InitDefault() {
+func (p *ResourceServiceReportResourceRatingResult) InitDefault() {
 }
 
-var ResourceServiceDeleteResourceCommentResult_Success_DEFAULT *DeleteResourceCommentResp
+var ResourceServiceReportResourceRatingResult_Success_DEFAULT *ReportResourceRatingResp
 
-func (p *ResourceServiceDeleteResourceCommentResult) GetSuccess() (v *DeleteResourceCommentResp) {
+func (p *ResourceServiceReportResourceRatingResult) GetSuccess() (v *ReportResourceRatingResp) {
 	if !p.IsSetSuccess() {
-		return ResourceServiceDeleteResourceCommentResult_Success_DEFAULT
+		return ResourceServiceReportResourceRatingResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_ResourceServiceDeleteResourceCommentResult = map[int16]string{
+var fieldIDToName_ResourceServiceReportResourceRatingResult = map[int16]string{
 	0: "success",
 }
 
-func (p *ResourceServiceDeleteResourceCommentResult) IsSetSuccess() bool {
+func (p *ResourceServiceReportResourceRatingResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ResourceServiceDeleteResourceCommentResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ResourceServiceReportResourceRatingResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -8978,7 +11612,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ResourceServiceDeleteResourceCommentResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ResourceServiceReportResourceRatingResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -8988,8 +11622,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ResourceServiceDeleteResourceCommentResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewDeleteResourceCommentResp()
+func (p *ResourceServiceReportResourceRatingResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewReportResourceRatingResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -8997,9 +11631,9 @@ func (p *ResourceServiceDeleteResourceCommentResult) ReadField0(iprot thrift.TPr
 	return nil
 }
 
-func (p *ResourceServiceDeleteResourceCommentResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ResourceServiceReportResourceRatingResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("deleteResourceComment_result"); err != nil {
+	if err = oprot.WriteStructBegin("reportResourceRating_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -9025,7 +11659,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ResourceServiceDeleteResourceCommentResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ResourceServiceReportResourceRatingResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -9044,43 +11678,328 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *ResourceServiceDeleteResourceCommentResult) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *ResourceServiceReportResourceRatingResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ResourceServiceReportResourceRatingResult(%+v)", *p)
+
+}
+
+type AdminResourceServiceProcessor struct {
+	processorMap map[string]thrift.TProcessorFunction
+	handler      AdminResourceService
+}
+
+func (p *AdminResourceServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
+	p.processorMap[key] = processor
+}
+
+func (p *AdminResourceServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
+	processor, ok = p.processorMap[key]
+	return processor, ok
+}
+
+func (p *AdminResourceServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
+	return p.processorMap
+}
+
+func NewAdminResourceServiceProcessor(handler AdminResourceService) *AdminResourceServiceProcessor {
+	self := &AdminResourceServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
+	self.AddToProcessorMap("AdminDeleteResourceComment", &adminResourceServiceProcessorAdminDeleteResourceComment{handler: handler})
+	self.AddToProcessorMap("AdminDeleteResourceRating", &adminResourceServiceProcessorAdminDeleteResourceRating{handler: handler})
+	self.AddToProcessorMap("AdminDeleteResource", &adminResourceServiceProcessorAdminDeleteResource{handler: handler})
+	self.AddToProcessorMap("AdminRestoreResource", &adminResourceServiceProcessorAdminRestoreResource{handler: handler})
+	self.AddToProcessorMap("AdminRestoreResourceComment", &adminResourceServiceProcessorAdminRestoreResourceComment{handler: handler})
+	return self
+}
+func (p *AdminResourceServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	name, _, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return false, err
+	}
+	if processor, ok := p.GetProcessorFunction(name); ok {
+		return processor.Process(ctx, seqId, iprot, oprot)
+	}
+	iprot.Skip(thrift.STRUCT)
+	iprot.ReadMessageEnd()
+	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
+	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
+	x.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Flush(ctx)
+	return false, x
+}
+
+type adminResourceServiceProcessorAdminDeleteResourceComment struct {
+	handler AdminResourceService
+}
+
+func (p *adminResourceServiceProcessorAdminDeleteResourceComment) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := AdminResourceServiceAdminDeleteResourceCommentArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("AdminDeleteResourceComment", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := AdminResourceServiceAdminDeleteResourceCommentResult{}
+	var retval *AdminDeleteResourceCommentResp
+	if retval, err2 = p.handler.AdminDeleteResourceComment(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing AdminDeleteResourceComment: "+err2.Error())
+		oprot.WriteMessageBegin("AdminDeleteResourceComment", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("AdminDeleteResourceComment", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type adminResourceServiceProcessorAdminDeleteResourceRating struct {
+	handler AdminResourceService
+}
+
+func (p *adminResourceServiceProcessorAdminDeleteResourceRating) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := AdminResourceServiceAdminDeleteResourceRatingArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("AdminDeleteResourceRating", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := AdminResourceServiceAdminDeleteResourceRatingResult{}
+	var retval *AdminDeleteResourceRatingResp
+	if retval, err2 = p.handler.AdminDeleteResourceRating(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing AdminDeleteResourceRating: "+err2.Error())
+		oprot.WriteMessageBegin("AdminDeleteResourceRating", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("AdminDeleteResourceRating", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type adminResourceServiceProcessorAdminDeleteResource struct {
+	handler AdminResourceService
+}
+
+func (p *adminResourceServiceProcessorAdminDeleteResource) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := AdminResourceServiceAdminDeleteResourceArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("AdminDeleteResource", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := AdminResourceServiceAdminDeleteResourceResult{}
+	var retval *AdminDeleteResourceResp
+	if retval, err2 = p.handler.AdminDeleteResource(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing AdminDeleteResource: "+err2.Error())
+		oprot.WriteMessageBegin("AdminDeleteResource", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("AdminDeleteResource", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type adminResourceServiceProcessorAdminRestoreResource struct {
+	handler AdminResourceService
+}
+
+func (p *adminResourceServiceProcessorAdminRestoreResource) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := AdminResourceServiceAdminRestoreResourceArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("AdminRestoreResource", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := AdminResourceServiceAdminRestoreResourceResult{}
+	var retval *AdminRestoreResourceResp
+	if retval, err2 = p.handler.AdminRestoreResource(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing AdminRestoreResource: "+err2.Error())
+		oprot.WriteMessageBegin("AdminRestoreResource", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("AdminRestoreResource", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type adminResourceServiceProcessorAdminRestoreResourceComment struct {
+	handler AdminResourceService
+}
+
+func (p *adminResourceServiceProcessorAdminRestoreResourceComment) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := AdminResourceServiceAdminRestoreResourceCommentArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("AdminRestoreResourceComment", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
 	}
-	return fmt.Sprintf("ResourceServiceDeleteResourceCommentResult(%+v)", *p)
 
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := AdminResourceServiceAdminRestoreResourceCommentResult{}
+	var retval *AdminRestoreResourceCommentResp
+	if retval, err2 = p.handler.AdminRestoreResourceComment(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing AdminRestoreResourceComment: "+err2.Error())
+		oprot.WriteMessageBegin("AdminRestoreResourceComment", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("AdminRestoreResourceComment", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
 }
 
-type ResourceServiceGetResourceCommentsArgs struct {
-	Req *GetResourceCommentsReq `thrift:"req,1"`
+type AdminResourceServiceAdminDeleteResourceCommentArgs struct {
+	Req *AdminDeleteResourceCommentReq `thrift:"req,1"`
 }
 
-func NewResourceServiceGetResourceCommentsArgs() *ResourceServiceGetResourceCommentsArgs {
-	return &ResourceServiceGetResourceCommentsArgs{}
+func NewAdminResourceServiceAdminDeleteResourceCommentArgs() *AdminResourceServiceAdminDeleteResourceCommentArgs {
+	return &AdminResourceServiceAdminDeleteResourceCommentArgs{}
 }
 
-func (p *ResourceServiceGetResourceCommentsArgs) InitDefault() {
+func (p *AdminResourceServiceAdminDeleteResourceCommentArgs) InitDefault() {
 }
 
-var ResourceServiceGetResourceCommentsArgs_Req_DEFAULT *GetResourceCommentsReq
+var AdminResourceServiceAdminDeleteResourceCommentArgs_Req_DEFAULT *AdminDeleteResourceCommentReq
 
-func (p *ResourceServiceGetResourceCommentsArgs) GetReq() (v *GetResourceCommentsReq) {
+func (p *AdminResourceServiceAdminDeleteResourceCommentArgs) GetReq() (v *AdminDeleteResourceCommentReq) {
 	if !p.IsSetReq() {
-		return ResourceServiceGetResourceCommentsArgs_Req_DEFAULT
+		return AdminResourceServiceAdminDeleteResourceCommentArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_ResourceServiceGetResourceCommentsArgs = map[int16]string{
+var fieldIDToName_AdminResourceServiceAdminDeleteResourceCommentArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *ResourceServiceGetResourceCommentsArgs) IsSetReq() bool {
+func (p *AdminResourceServiceAdminDeleteResourceCommentArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ResourceServiceGetResourceCommentsArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *AdminResourceServiceAdminDeleteResourceCommentArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -9126,7 +12045,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ResourceServiceGetResourceCommentsArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminResourceServiceAdminDeleteResourceCommentArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -9136,8 +12055,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ResourceServiceGetResourceCommentsArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewGetResourceCommentsReq()
+func (p *AdminResourceServiceAdminDeleteResourceCommentArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewAdminDeleteResourceCommentReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -9145,9 +12064,9 @@ func (p *ResourceServiceGetResourceCommentsArgs) ReadField1(iprot thrift.TProtoc
 	return nil
 }
 
-func (p *ResourceServiceGetResourceCommentsArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *AdminResourceServiceAdminDeleteResourceCommentArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("getResourceComments_args"); err != nil {
+	if err = oprot.WriteStructBegin("AdminDeleteResourceComment_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -9173,7 +12092,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ResourceServiceGetResourceCommentsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *AdminResourceServiceAdminDeleteResourceCommentArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -9190,43 +12109,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ResourceServiceGetResourceCommentsArgs) String() string {
+func (p *AdminResourceServiceAdminDeleteResourceCommentArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ResourceServiceGetResourceCommentsArgs(%+v)", *p)
+	return fmt.Sprintf("AdminResourceServiceAdminDeleteResourceCommentArgs(%+v)", *p)
 
 }
 
-type ResourceServiceGetResourceCommentsResult struct {
-	Success *GetResourceCommentsResp `thrift:"success,0,optional"`
+type AdminResourceServiceAdminDeleteResourceCommentResult struct {
+	Success *AdminDeleteResourceCommentResp `thrift:"success,0,optional"`
 }
 
-func NewResourceServiceGetResourceCommentsResult() *ResourceServiceGetResourceCommentsResult {
-	return &ResourceServiceGetResourceCommentsResult{}
+func NewAdminResourceServiceAdminDeleteResourceCommentResult() *AdminResourceServiceAdminDeleteResourceCommentResult {
+	return &AdminResourceServiceAdminDeleteResourceCommentResult{}
 }
 
-func (p *ResourceServiceGetResourceCommentsResult) InitDefault() {
+func (p *AdminResourceServiceAdminDeleteResourceCommentResult) InitDefault() {
 }
 
-var ResourceServiceGetResourceCommentsResult_Success_DEFAULT *GetResourceCommentsResp
+var AdminResourceServiceAdminDeleteResourceCommentResult_Success_DEFAULT *AdminDeleteResourceCommentResp
 
-func (p *ResourceServiceGetResourceCommentsResult) GetSuccess() (v *GetResourceCommentsResp) {
+func (p *AdminResourceServiceAdminDeleteResourceCommentResult) GetSuccess() (v *AdminDeleteResourceCommentResp) {
 	if !p.IsSetSuccess() {
-		return ResourceServiceGetResourceCommentsResult_Success_DEFAULT
+		return AdminResourceServiceAdminDeleteResourceCommentResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_ResourceServiceGetResourceCommentsResult = map[int16]string{
+var fieldIDToName_AdminResourceServiceAdminDeleteResourceCommentResult = map[int16]string{
 	0: "success",
 }
 
-func (p *ResourceServiceGetResourceCommentsResult) IsSetSuccess() bool {
+func (p *AdminResourceServiceAdminDeleteResourceCommentResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ResourceServiceGetResourceCommentsResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *AdminResourceServiceAdminDeleteResourceCommentResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -9272,7 +12191,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ResourceServiceGetResourceCommentsResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminResourceServiceAdminDeleteResourceCommentResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -9282,8 +12201,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ResourceServiceGetResourceCommentsResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewGetResourceCommentsResp()
+func (p *AdminResourceServiceAdminDeleteResourceCommentResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewAdminDeleteResourceCommentResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -9291,9 +12210,9 @@ func (p *ResourceServiceGetResourceCommentsResult) ReadField0(iprot thrift.TProt
 	return nil
 }
 
-func (p *ResourceServiceGetResourceCommentsResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *AdminResourceServiceAdminDeleteResourceCommentResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("getResourceComments_result"); err != nil {
+	if err = oprot.WriteStructBegin("AdminDeleteResourceComment_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -9319,7 +12238,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ResourceServiceGetResourceCommentsResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *AdminResourceServiceAdminDeleteResourceCommentResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -9327,200 +12246,54 @@ func (p *ResourceServiceGetResourceCommentsResult) writeField0(oprot thrift.TPro
 		if err := p.Success.Write(oprot); err != nil {
 			return err
 		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
-}
-
-func (p *ResourceServiceGetResourceCommentsResult) String() string {
-	if p == nil {
-		return "<nil>"
-	}
-	return fmt.Sprintf("ResourceServiceGetResourceCommentsResult(%+v)", *p)
-
-}
-
-type ResourceServiceReactResourceCommentArgs struct {
-	Req *SubmitResourceCommentReactionReq `thrift:"req,1"`
-}
-
-func NewResourceServiceReactResourceCommentArgs() *ResourceServiceReactResourceCommentArgs {
-	return &ResourceServiceReactResourceCommentArgs{}
-}
-
-func (p *ResourceServiceReactResourceCommentArgs) InitDefault() {
-}
-
-var ResourceServiceReactResourceCommentArgs_Req_DEFAULT *SubmitResourceCommentReactionReq
-
-func (p *ResourceServiceReactResourceCommentArgs) GetReq() (v *SubmitResourceCommentReactionReq) {
-	if !p.IsSetReq() {
-		return ResourceServiceReactResourceCommentArgs_Req_DEFAULT
-	}
-	return p.Req
-}
-
-var fieldIDToName_ResourceServiceReactResourceCommentArgs = map[int16]string{
-	1: "req",
-}
-
-func (p *ResourceServiceReactResourceCommentArgs) IsSetReq() bool {
-	return p.Req != nil
-}
-
-func (p *ResourceServiceReactResourceCommentArgs) Read(iprot thrift.TProtocol) (err error) {
-
-	var fieldTypeId thrift.TType
-	var fieldId int16
-
-	if _, err = iprot.ReadStructBegin(); err != nil {
-		goto ReadStructBeginError
-	}
-
-	for {
-		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
-		if err != nil {
-			goto ReadFieldBeginError
-		}
-		if fieldTypeId == thrift.STOP {
-			break
-		}
-
-		switch fieldId {
-		case 1:
-			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField1(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		default:
-			if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		}
-		if err = iprot.ReadFieldEnd(); err != nil {
-			goto ReadFieldEndError
-		}
-	}
-	if err = iprot.ReadStructEnd(); err != nil {
-		goto ReadStructEndError
-	}
-
-	return nil
-ReadStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
-ReadFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
-ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ResourceServiceReactResourceCommentArgs[fieldId]), err)
-SkipFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
-
-ReadFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
-ReadStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-}
-
-func (p *ResourceServiceReactResourceCommentArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewSubmitResourceCommentReactionReq()
-	if err := _field.Read(iprot); err != nil {
-		return err
-	}
-	p.Req = _field
-	return nil
-}
-
-func (p *ResourceServiceReactResourceCommentArgs) Write(oprot thrift.TProtocol) (err error) {
-	var fieldId int16
-	if err = oprot.WriteStructBegin("reactResourceComment_args"); err != nil {
-		goto WriteStructBeginError
-	}
-	if p != nil {
-		if err = p.writeField1(oprot); err != nil {
-			fieldId = 1
-			goto WriteFieldError
-		}
-	}
-	if err = oprot.WriteFieldStop(); err != nil {
-		goto WriteFieldStopError
-	}
-	if err = oprot.WriteStructEnd(); err != nil {
-		goto WriteStructEndError
-	}
-	return nil
-WriteStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
-WriteFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
-WriteFieldStopError:
-	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
-WriteStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
-}
-
-func (p *ResourceServiceReactResourceCommentArgs) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := p.Req.Write(oprot); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *ResourceServiceReactResourceCommentArgs) String() string {
+func (p *AdminResourceServiceAdminDeleteResourceCommentResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ResourceServiceReactResourceCommentArgs(%+v)", *p)
+	return fmt.Sprintf("AdminResourceServiceAdminDeleteResourceCommentResult(%+v)", *p)
 
 }
 
-type ResourceServiceReactResourceCommentResult struct {
-	Success *SubmitResourceCommentReactionResp `thrift:"success,0,optional"`
+type AdminResourceServiceAdminDeleteResourceRatingArgs struct {
+	Req *AdminDeleteResourceRatingReq `thrift:"req,1"`
 }
 
-func NewResourceServiceReactResourceCommentResult() *ResourceServiceReactResourceCommentResult {
-	return &ResourceServiceReactResourceCommentResult{}
+func NewAdminResourceServiceAdminDeleteResourceRatingArgs() *AdminResourceServiceAdminDeleteResourceRatingArgs {
+	return &AdminResourceServiceAdminDeleteResourceRatingArgs{}
 }
 
-func (p *ResourceServiceReactResourceCommentResult) InitDefault() {
+func (p *AdminResourceServiceAdminDeleteResourceRatingArgs) InitDefault() {
 }
 
-var ResourceServiceReactResourceCommentResult_Success_DEFAULT *SubmitResourceCommentReactionResp
+var AdminResourceServiceAdminDeleteResourceRatingArgs_Req_DEFAULT *AdminDeleteResourceRatingReq
 
-func (p *ResourceServiceReactResourceCommentResult) GetSuccess() (v *SubmitResourceCommentReactionResp) {
-	if !p.IsSetSuccess() {
-		return ResourceServiceReactResourceCommentResult_Success_DEFAULT
+func (p *AdminResourceServiceAdminDeleteResourceRatingArgs) GetReq() (v *AdminDeleteResourceRatingReq) {
+	if !p.IsSetReq() {
+		return AdminResourceServiceAdminDeleteResourceRatingArgs_Req_DEFAULT
 	}
-	return p.Success
+	return p.Req
 }
 
-var fieldIDToName_ResourceServiceReactResourceCommentResult = map[int16]string{
-	0: "success",
+var fieldIDToName_AdminResourceServiceAdminDeleteResourceRatingArgs = map[int16]string{
+	1: "req",
 }
 
-func (p *ResourceServiceReactResourceCommentResult) IsSetSuccess() bool {
-	return p.Success != nil
+func (p *AdminResourceServiceAdminDeleteResourceRatingArgs) IsSetReq() bool {
+	return p.Req != nil
 }
 
-func (p *ResourceServiceReactResourceCommentResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *AdminResourceServiceAdminDeleteResourceRatingArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -9539,9 +12312,9 @@ func (p *ResourceServiceReactResourceCommentResult) Read(iprot thrift.TProtocol)
 		}
 
 		switch fieldId {
-		case 0:
+		case 1:
 			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField0(iprot); err != nil {
+				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
@@ -9566,7 +12339,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ResourceServiceReactResourceCommentResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminResourceServiceAdminDeleteResourceRatingArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -9576,23 +12349,23 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ResourceServiceReactResourceCommentResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewSubmitResourceCommentReactionResp()
+func (p *AdminResourceServiceAdminDeleteResourceRatingArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewAdminDeleteResourceRatingReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
-	p.Success = _field
+	p.Req = _field
 	return nil
 }
 
-func (p *ResourceServiceReactResourceCommentResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *AdminResourceServiceAdminDeleteResourceRatingArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("reactResourceComment_result"); err != nil {
+	if err = oprot.WriteStructBegin("AdminDeleteResourceRating_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
-		if err = p.writeField0(oprot); err != nil {
-			fieldId = 0
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
 			goto WriteFieldError
 		}
 	}
@@ -9613,249 +12386,208 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ResourceServiceReactResourceCommentResult) writeField0(oprot thrift.TProtocol) (err error) {
-	if p.IsSetSuccess() {
-		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := p.Success.Write(oprot); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+func (p *AdminResourceServiceAdminDeleteResourceRatingArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ResourceServiceReactResourceCommentResult) String() string {
+func (p *AdminResourceServiceAdminDeleteResourceRatingArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ResourceServiceReactResourceCommentResult(%+v)", *p)
+	return fmt.Sprintf("AdminResourceServiceAdminDeleteResourceRatingArgs(%+v)", *p)
 
 }
 
-type AdminResourceServiceProcessor struct {
-	processorMap map[string]thrift.TProcessorFunction
-	handler      AdminResourceService
+type AdminResourceServiceAdminDeleteResourceRatingResult struct {
+	Success *AdminDeleteResourceRatingResp `thrift:"success,0,optional"`
 }
 
-func (p *AdminResourceServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
-	p.processorMap[key] = processor
+func NewAdminResourceServiceAdminDeleteResourceRatingResult() *AdminResourceServiceAdminDeleteResourceRatingResult {
+	return &AdminResourceServiceAdminDeleteResourceRatingResult{}
 }
 
-func (p *AdminResourceServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
-	processor, ok = p.processorMap[key]
-	return processor, ok
+func (p *AdminResourceServiceAdminDeleteResourceRatingResult) InitDefault() {
 }
 
-func (p *AdminResourceServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
-	return p.processorMap
-}
+var AdminResourceServiceAdminDeleteResourceRatingResult_Success_DEFAULT *AdminDeleteResourceRatingResp
 
-func NewAdminResourceServiceProcessor(handler AdminResourceService) *AdminResourceServiceProcessor {
-	self := &AdminResourceServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
-	self.AddToProcessorMap("AdminDeleteResourceComment", &adminResourceServiceProcessorAdminDeleteResourceComment{handler: handler})
-	self.AddToProcessorMap("AdminDeleteResourceRating", &adminResourceServiceProcessorAdminDeleteResourceRating{handler: handler})
-	self.AddToProcessorMap("AdminDeleteResource", &adminResourceServiceProcessorAdminDeleteResource{handler: handler})
-	return self
-}
-func (p *AdminResourceServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	name, _, seqId, err := iprot.ReadMessageBegin()
-	if err != nil {
-		return false, err
-	}
-	if processor, ok := p.GetProcessorFunction(name); ok {
-		return processor.Process(ctx, seqId, iprot, oprot)
+func (p *AdminResourceServiceAdminDeleteResourceRatingResult) GetSuccess() (v *AdminDeleteResourceRatingResp) {
+	if !p.IsSetSuccess() {
+		return AdminResourceServiceAdminDeleteResourceRatingResult_Success_DEFAULT
 	}
-	iprot.Skip(thrift.STRUCT)
-	iprot.ReadMessageEnd()
-	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
-	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
-	x.Write(oprot)
-	oprot.WriteMessageEnd()
-	oprot.Flush(ctx)
-	return false, x
+	return p.Success
 }
 
-type adminResourceServiceProcessorAdminDeleteResourceComment struct {
-	handler AdminResourceService
+var fieldIDToName_AdminResourceServiceAdminDeleteResourceRatingResult = map[int16]string{
+	0: "success",
 }
 
-func (p *adminResourceServiceProcessorAdminDeleteResourceComment) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := AdminResourceServiceAdminDeleteResourceCommentArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("AdminDeleteResourceComment", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
-
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := AdminResourceServiceAdminDeleteResourceCommentResult{}
-	var retval *AdminDeleteResourceCommentResp
-	if retval, err2 = p.handler.AdminDeleteResourceComment(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing AdminDeleteResourceComment: "+err2.Error())
-		oprot.WriteMessageBegin("AdminDeleteResourceComment", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("AdminDeleteResourceComment", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
-	}
-	return true, err
+func (p *AdminResourceServiceAdminDeleteResourceRatingResult) IsSetSuccess() bool {
+	return p.Success != nil
 }
 
-type adminResourceServiceProcessorAdminDeleteResourceRating struct {
-	handler AdminResourceService
-}
+func (p *AdminResourceServiceAdminDeleteResourceRatingResult) Read(iprot thrift.TProtocol) (err error) {
 
-func (p *adminResourceServiceProcessorAdminDeleteResourceRating) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := AdminResourceServiceAdminDeleteResourceRatingArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("AdminDeleteResourceRating", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+	var fieldTypeId thrift.TType
+	var fieldId int16
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := AdminResourceServiceAdminDeleteResourceRatingResult{}
-	var retval *AdminDeleteResourceRatingResp
-	if retval, err2 = p.handler.AdminDeleteResourceRating(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing AdminDeleteResourceRating: "+err2.Error())
-		oprot.WriteMessageBegin("AdminDeleteResourceRating", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("AdminDeleteResourceRating", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return true, err
-}
 
-type adminResourceServiceProcessorAdminDeleteResource struct {
-	handler AdminResourceService
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminResourceServiceAdminDeleteResourceRatingResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *adminResourceServiceProcessorAdminDeleteResource) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := AdminResourceServiceAdminDeleteResourceArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("AdminDeleteResource", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *AdminResourceServiceAdminDeleteResourceRatingResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewAdminDeleteResourceRatingResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.Success = _field
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := AdminResourceServiceAdminDeleteResourceResult{}
-	var retval *AdminDeleteResourceResp
-	if retval, err2 = p.handler.AdminDeleteResource(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing AdminDeleteResource: "+err2.Error())
-		oprot.WriteMessageBegin("AdminDeleteResource", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+func (p *AdminResourceServiceAdminDeleteResourceRatingResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AdminDeleteResourceRating_result"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageBegin("AdminDeleteResource", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AdminResourceServiceAdminDeleteResourceRatingResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *AdminResourceServiceAdminDeleteResourceRatingResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return true, err
+	return fmt.Sprintf("AdminResourceServiceAdminDeleteResourceRatingResult(%+v)", *p)
+
 }
 
-type AdminResourceServiceAdminDeleteResourceCommentArgs struct {
-	Req *AdminDeleteResourceCommentReq `thrift:"req,1"`
+type AdminResourceServiceAdminDeleteResourceArgs struct {
+	Req *AdminDeleteResourceReq `thrift:"req,1"`
 }
 
-func NewAdminResourceServiceAdminDeleteResourceCommentArgs() *AdminResourceServiceAdminDeleteResourceCommentArgs {
-	return &AdminResourceServiceAdminDeleteResourceCommentArgs{}
+func NewAdminResourceServiceAdminDeleteResourceArgs() *AdminResourceServiceAdminDeleteResourceArgs {
+	return &AdminResourceServiceAdminDeleteResourceArgs{}
 }
 
-func (p *AdminResourceServiceAdminDeleteResourceCommentArgs) InitDefault() {
+func (p *AdminResourceServiceAdminDeleteResourceArgs) InitDefault() {
 }
 
-var AdminResourceServiceAdminDeleteResourceCommentArgs_Req_DEFAULT *AdminDeleteResourceCommentReq
+var AdminResourceServiceAdminDeleteResourceArgs_Req_DEFAULT *AdminDeleteResourceReq
 
-func (p *AdminResourceServiceAdminDeleteResourceCommentArgs) GetReq() (v *AdminDeleteResourceCommentReq) {
+func (p *AdminResourceServiceAdminDeleteResourceArgs) GetReq() (v *AdminDeleteResourceReq) {
 	if !p.IsSetReq() {
-		return AdminResourceServiceAdminDeleteResourceCommentArgs_Req_DEFAULT
+		return AdminResourceServiceAdminDeleteResourceArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_AdminResourceServiceAdminDeleteResourceCommentArgs = map[int16]string{
+var fieldIDToName_AdminResourceServiceAdminDeleteResourceArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *AdminResourceServiceAdminDeleteResourceCommentArgs) IsSetReq() bool {
+func (p *AdminResourceServiceAdminDeleteResourceArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *AdminResourceServiceAdminDeleteResourceCommentArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *AdminResourceServiceAdminDeleteResourceArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -9901,7 +12633,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminResourceServiceAdminDeleteResourceCommentArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminResourceServiceAdminDeleteResourceArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -9911,8 +12643,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *AdminResourceServiceAdminDeleteResourceCommentArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewAdminDeleteResourceCommentReq()
+func (p *AdminResourceServiceAdminDeleteResourceArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewAdminDeleteResourceReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -9920,9 +12652,9 @@ func (p *AdminResourceServiceAdminDeleteResourceCommentArgs) ReadField1(iprot th
 	return nil
 }
 
-func (p *AdminResourceServiceAdminDeleteResourceCommentArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *AdminResourceServiceAdminDeleteResourceArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("AdminDeleteResourceComment_args"); err != nil {
+	if err = oprot.WriteStructBegin("AdminDeleteResource_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -9948,7 +12680,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AdminResourceServiceAdminDeleteResourceCommentArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *AdminResourceServiceAdminDeleteResourceArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -9965,43 +12697,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *AdminResourceServiceAdminDeleteResourceCommentArgs) String() string {
+func (p *AdminResourceServiceAdminDeleteResourceArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AdminResourceServiceAdminDeleteResourceCommentArgs(%+v)", *p)
+	return fmt.Sprintf("AdminResourceServiceAdminDeleteResourceArgs(%+v)", *p)
 
 }
 
-type AdminResourceServiceAdminDeleteResourceCommentResult struct {
-	Success *AdminDeleteResourceCommentResp `thrift:"success,0,optional"`
+type AdminResourceServiceAdminDeleteResourceResult struct {
+	Success *AdminDeleteResourceResp `thrift:"success,0,optional"`
 }
 
-func NewAdminResourceServiceAdminDeleteResourceCommentResult() *AdminResourceServiceAdminDeleteResourceCommentResult {
-	return &AdminResourceServiceAdminDeleteResourceCommentResult{}
+func NewAdminResourceServiceAdminDeleteResourceResult() *AdminResourceServiceAdminDeleteResourceResult {
+	return &AdminResourceServiceAdminDeleteResourceResult{}
 }
 
-func (p *AdminResourceServiceAdminDeleteResourceCommentResult) InitDefault() {
+func (p *AdminResourceServiceAdminDeleteResourceResult) InitDefault() {
 }
 
-var AdminResourceServiceAdminDeleteResourceCommentResult_Success_DEFAULT *AdminDeleteResourceCommentResp
+var AdminResourceServiceAdminDeleteResourceResult_Success_DEFAULT *AdminDeleteResourceResp
 
-func (p *AdminResourceServiceAdminDeleteResourceCommentResult) GetSuccess() (v *AdminDeleteResourceCommentResp) {
+func (p *AdminResourceServiceAdminDeleteResourceResult) GetSuccess() (v *AdminDeleteResourceResp) {
 	if !p.IsSetSuccess() {
-		return AdminResourceServiceAdminDeleteResourceCommentResult_Success_DEFAULT
+		return AdminResourceServiceAdminDeleteResourceResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_AdminResourceServiceAdminDeleteResourceCommentResult = map[int16]string{
+var fieldIDToName_AdminResourceServiceAdminDeleteResourceResult = map[int16]string{
 	0: "success",
 }
 
-func (p *AdminResourceServiceAdminDeleteResourceCommentResult) IsSetSuccess() bool {
+func (p *AdminResourceServiceAdminDeleteResourceResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *AdminResourceServiceAdminDeleteResourceCommentResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *AdminResourceServiceAdminDeleteResourceResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -10047,7 +12779,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminResourceServiceAdminDeleteResourceCommentResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminResourceServiceAdminDeleteResourceResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -10057,8 +12789,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *AdminResourceServiceAdminDeleteResourceCommentResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewAdminDeleteResourceCommentResp()
+func (p *AdminResourceServiceAdminDeleteResourceResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewAdminDeleteResourceResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -10066,9 +12798,9 @@ func (p *AdminResourceServiceAdminDeleteResourceCommentResult) ReadField0(iprot
 	return nil
 }
 
-func (p *AdminResourceServiceAdminDeleteResourceCommentResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *AdminResourceServiceAdminDeleteResourceResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("AdminDeleteResourceComment_result"); err != nil {
+	if err = oprot.WriteStructBegin("AdminDeleteResource_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -10094,7 +12826,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AdminResourceServiceAdminDeleteResourceCommentResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *AdminResourceServiceAdminDeleteResourceResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -10113,43 +12845,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *AdminResourceServiceAdminDeleteResourceCommentResult) String() string {
+func (p *AdminResourceServiceAdminDeleteResourceResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AdminResourceServiceAdminDeleteResourceCommentResult(%+v)", *p)
+	return fmt.Sprintf("AdminResourceServiceAdminDeleteResourceResult(%+v)", *p)
 
 }
 
-type AdminResourceServiceAdminDeleteResourceRatingArgs struct {
-	Req *AdminDeleteResourceRatingReq `thrift:"req,1"`
+type AdminResourceServiceAdminRestoreResourceArgs struct {
+	Req *AdminRestoreResourceReq `thrift:"req,1"`
 }
 
-func NewAdminResourceServiceAdminDeleteResourceRatingArgs() *AdminResourceServiceAdminDeleteResourceRatingArgs {
-	return &AdminResourceServiceAdminDeleteResourceRatingArgs{}
+func NewAdminResourceServiceAdminRestoreResourceArgs() *AdminResourceServiceAdminRestoreResourceArgs {
+	return &AdminResourceServiceAdminRestoreResourceArgs{}
 }
 
-func (p *AdminResourceServiceAdminDeleteResourceRatingArgs) InitDefault() {
+func (p *AdminResourceServiceAdminRestoreResourceArgs) InitDefault() {
 }
 
-var AdminResourceServiceAdminDeleteResourceRatingArgs_Req_DEFAULT *AdminDeleteResourceRatingReq
+var AdminResourceServiceAdminRestoreResourceArgs_Req_DEFAULT *AdminRestoreResourceReq
 
-func (p *AdminResourceServiceAdminDeleteResourceRatingArgs) GetReq() (v *AdminDeleteResourceRatingReq) {
+func (p *AdminResourceServiceAdminRestoreResourceArgs) GetReq() (v *AdminRestoreResourceReq) {
 	if !p.IsSetReq() {
-		return AdminResourceServiceAdminDeleteResourceRatingArgs_Req_DEFAULT
+		return AdminResourceServiceAdminRestoreResourceArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_AdminResourceServiceAdminDeleteResourceRatingArgs = map[int16]string{
+var fieldIDToName_AdminResourceServiceAdminRestoreResourceArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *AdminResourceServiceAdminDeleteResourceRatingArgs) IsSetReq() bool {
+func (p *AdminResourceServiceAdminRestoreResourceArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *AdminResourceServiceAdminDeleteResourceRatingArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *AdminResourceServiceAdminRestoreResourceArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -10195,7 +12927,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminResourceServiceAdminDeleteResourceRatingArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminResourceServiceAdminRestoreResourceArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -10205,8 +12937,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *AdminResourceServiceAdminDeleteResourceRatingArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewAdminDeleteResourceRatingReq()
+func (p *AdminResourceServiceAdminRestoreResourceArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewAdminRestoreResourceReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -10214,9 +12946,9 @@ func (p *AdminResourceServiceAdminDeleteResourceRatingArgs) ReadField1(iprot thr
 	return nil
 }
 
-func (p *AdminResourceServiceAdminDeleteResourceRatingArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *AdminResourceServiceAdminRestoreResourceArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("AdminDeleteResourceRating_args"); err != nil {
+	if err = oprot.WriteStructBegin("AdminRestoreResource_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -10242,7 +12974,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AdminResourceServiceAdminDeleteResourceRatingArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *AdminResourceServiceAdminRestoreResourceArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -10259,43 +12991,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *AdminResourceServiceAdminDeleteResourceRatingArgs) String() string {
+func (p *AdminResourceServiceAdminRestoreResourceArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AdminResourceServiceAdminDeleteResourceRatingArgs(%+v)", *p)
+	return fmt.Sprintf("AdminResourceServiceAdminRestoreResourceArgs(%+v)", *p)
 
 }
 
-type AdminResourceServiceAdminDeleteResourceRatingResult struct {
-	Success *AdminDeleteResourceRatingResp `thrift:"success,0,optional"`
+type AdminResourceServiceAdminRestoreResourceResult struct {
+	Success *AdminRestoreResourceResp `thrift:"success,0,optional"`
 }
 
-func NewAdminResourceServiceAdminDeleteResourceRatingResult() *AdminResourceServiceAdminDeleteResourceRatingResult {
-	return &AdminResourceServiceAdminDeleteResourceRatingResult{}
+func NewAdminResourceServiceAdminRestoreResourceResult() *AdminResourceServiceAdminRestoreResourceResult {
+	return &AdminResourceServiceAdminRestoreResourceResult{}
 }
 
-func (p *AdminResourceServiceAdminDeleteResourceRatingResult) InitDefault() {
+func (p *AdminResourceServiceAdminRestoreResourceResult) InitDefault() {
 }
 
-var AdminResourceServiceAdminDeleteResourceRatingResult_Success_DEFAULT *AdminDeleteResourceRatingResp
+var AdminResourceServiceAdminRestoreResourceResult_Success_DEFAULT *AdminRestoreResourceResp
 
-func (p *AdminResourceServiceAdminDeleteResourceRatingResult) GetSuccess() (v *AdminDeleteResourceRatingResp) {
+func (p *AdminResourceServiceAdminRestoreResourceResult) GetSuccess() (v *AdminRestoreResourceResp) {
 	if !p.IsSetSuccess() {
-		return AdminResourceServiceAdminDeleteResourceRatingResult_Success_DEFAULT
+		return AdminResourceServiceAdminRestoreResourceResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_AdminResourceServiceAdminDeleteResourceRatingResult = map[int16]string{
+var fieldIDToName_AdminResourceServiceAdminRestoreResourceResult = map[int16]string{
 	0: "success",
 }
 
-func (p *AdminResourceServiceAdminDeleteResourceRatingResult) IsSetSuccess() bool {
+func (p *AdminResourceServiceAdminRestoreResourceResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *AdminResourceServiceAdminDeleteResourceRatingResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *AdminResourceServiceAdminRestoreResourceResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -10341,7 +13073,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminResourceServiceAdminDeleteResourceRatingResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminResourceServiceAdminRestoreResourceResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -10351,8 +13083,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *AdminResourceServiceAdminDeleteResourceRatingResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewAdminDeleteResourceRatingResp()
+func (p *AdminResourceServiceAdminRestoreResourceResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewAdminRestoreResourceResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -10360,9 +13092,9 @@ func (p *AdminResourceServiceAdminDeleteResourceRatingResult) ReadField0(iprot t
 	return nil
 }
 
-func (p *AdminResourceServiceAdminDeleteResourceRatingResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *AdminResourceServiceAdminRestoreResourceResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("AdminDeleteResourceRating_result"); err != nil {
+	if err = oprot.WriteStructBegin("AdminRestoreResource_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -10388,7 +13120,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AdminResourceServiceAdminDeleteResourceRatingResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *AdminResourceServiceAdminRestoreResourceResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -10407,43 +13139,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *AdminResourceServiceAdminDeleteResourceRatingResult) String() string {
+func (p *AdminResourceServiceAdminRestoreResourceResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AdminResourceServiceAdminDeleteResourceRatingResult(%+v)", *p)
+	return fmt.Sprintf("AdminResourceServiceAdminRestoreResourceResult(%+v)", *p)
 
 }
 
-type AdminResourceServiceAdminDeleteResourceArgs struct {
-	Req *AdminDeleteResourceReq `thrift:"req,1"`
+type AdminResourceServiceAdminRestoreResourceCommentArgs struct {
+	Req *AdminRestoreResourceCommentReq `thrift:"req,1"`
 }
 
-func NewAdminResourceServiceAdminDeleteResourceArgs() *AdminResourceServiceAdminDeleteResourceArgs {
-	return &AdminResourceServiceAdminDeleteResourceArgs{}
+func NewAdminResourceServiceAdminRestoreResourceCommentArgs() *AdminResourceServiceAdminRestoreResourceCommentArgs {
+	return &AdminResourceServiceAdminRestoreResourceCommentArgs{}
 }
 
-func (p *AdminResourceServiceAdminDeleteResourceArgs) InitDefault() {
+func (p *AdminResourceServiceAdminRestoreResourceCommentArgs) InitDefault() {
 }
 
-var AdminResourceServiceAdminDeleteResourceArgs_Req_DEFAULT *AdminDeleteResourceReq
+var AdminResourceServiceAdminRestoreResourceCommentArgs_Req_DEFAULT *AdminRestoreResourceCommentReq
 
-func (p *AdminResourceServiceAdminDeleteResourceArgs) GetReq() (v *AdminDeleteResourceReq) {
+func (p *AdminResourceServiceAdminRestoreResourceCommentArgs) GetReq() (v *AdminRestoreResourceCommentReq) {
 	if !p.IsSetReq() {
-		return AdminResourceServiceAdminDeleteResourceArgs_Req_DEFAULT
+		return AdminResourceServiceAdminRestoreResourceCommentArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_AdminResourceServiceAdminDeleteResourceArgs = map[int16]string{
+var fieldIDToName_AdminResourceServiceAdminRestoreResourceCommentArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *AdminResourceServiceAdminDeleteResourceArgs) IsSetReq() bool {
+func (p *AdminResourceServiceAdminRestoreResourceCommentArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *AdminResourceServiceAdminDeleteResourceArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *AdminResourceServiceAdminRestoreResourceCommentArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -10489,7 +13221,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminResourceServiceAdminDeleteResourceArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminResourceServiceAdminRestoreResourceCommentArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -10499,8 +13231,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *AdminResourceServiceAdminDeleteResourceArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewAdminDeleteResourceReq()
+func (p *AdminResourceServiceAdminRestoreResourceCommentArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewAdminRestoreResourceCommentReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -10508,9 +13240,9 @@ func (p *AdminResourceServiceAdminDeleteResourceArgs) ReadField1(iprot thrift.TP
 	return nil
 }
 
-func (p *AdminResourceServiceAdminDeleteResourceArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *AdminResourceServiceAdminRestoreResourceCommentArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("AdminDeleteResource_args"); err != nil {
+	if err = oprot.WriteStructBegin("AdminRestoreResourceComment_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -10536,7 +13268,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AdminResourceServiceAdminDeleteResourceArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *AdminResourceServiceAdminRestoreResourceCommentArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -10553,43 +13285,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *AdminResourceServiceAdminDeleteResourceArgs) String() string {
+func (p *AdminResourceServiceAdminRestoreResourceCommentArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AdminResourceServiceAdminDeleteResourceArgs(%+v)", *p)
+	return fmt.Sprintf("AdminResourceServiceAdminRestoreResourceCommentArgs(%+v)", *p)
 
 }
 
-type AdminResourceServiceAdminDeleteResourceResult struct {
-	Success *AdminDeleteResourceResp `thrift:"success,0,optional"`
+type AdminResourceServiceAdminRestoreResourceCommentResult struct {
+	Success *AdminRestoreResourceCommentResp `thrift:"success,0,optional"`
 }
 
-func NewAdminResourceServiceAdminDeleteResourceResult() *AdminResourceServiceAdminDeleteResourceResult {
-	return &AdminResourceServiceAdminDeleteResourceResult{}
+func NewAdminResourceServiceAdminRestoreResourceCommentResult() *AdminResourceServiceAdminRestoreResourceCommentResult {
+	return &AdminResourceServiceAdminRestoreResourceCommentResult{}
 }
 
-func (p *AdminResourceServiceAdminDeleteResourceResult) InitDefault() {
+func (p *AdminResourceServiceAdminRestoreResourceCommentResult) InitDefault() {
 }
 
-var AdminResourceServiceAdminDeleteResourceResult_Success_DEFAULT *AdminDeleteResourceResp
+var AdminResourceServiceAdminRestoreResourceCommentResult_Success_DEFAULT *AdminRestoreResourceCommentResp
 
-func (p *AdminResourceServiceAdminDeleteResourceResult) GetSuccess() (v *AdminDeleteResourceResp) {
+func (p *AdminResourceServiceAdminRestoreResourceCommentResult) GetSuccess() (v *AdminRestoreResourceCommentResp) {
 	if !p.IsSetSuccess() {
-		return AdminResourceServiceAdminDeleteResourceResult_Success_DEFAULT
+		return AdminResourceServiceAdminRestoreResourceCommentResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_AdminResourceServiceAdminDeleteResourceResult = map[int16]string{
+var fieldIDToName_AdminResourceServiceAdminRestoreResourceCommentResult = map[int16]string{
 	0: "success",
 }
 
-func (p *AdminResourceServiceAdminDeleteResourceResult) IsSetSuccess() bool {
+func (p *AdminResourceServiceAdminRestoreResourceCommentResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *AdminResourceServiceAdminDeleteResourceResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *AdminResourceServiceAdminRestoreResourceCommentResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -10635,7 +13367,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminResourceServiceAdminDeleteResourceResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminResourceServiceAdminRestoreResourceCommentResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -10645,8 +13377,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *AdminResourceServiceAdminDeleteResourceResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewAdminDeleteResourceResp()
+func (p *AdminResourceServiceAdminRestoreResourceCommentResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewAdminRestoreResourceCommentResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -10654,9 +13386,9 @@ func (p *AdminResourceServiceAdminDeleteResourceResult) ReadField0(iprot thrift.
 	return nil
 }
 
-func (p *AdminResourceServiceAdminDeleteResourceResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *AdminResourceServiceAdminRestoreResourceCommentResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("AdminDeleteResource_result"); err != nil {
+	if err = oprot.WriteStructBegin("AdminRestoreResourceComment_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -10682,7 +13414,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AdminResourceServiceAdminDeleteResourceResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *AdminResourceServiceAdminRestoreResourceCommentResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -10701,10 +13433,10 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *AdminResourceServiceAdminDeleteResourceResult) String() string {
+func (p *AdminResourceServiceAdminRestoreResourceCommentResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AdminResourceServiceAdminDeleteResourceResult(%+v)", *p)
+	return fmt.Sprintf("AdminResourceServiceAdminRestoreResourceCommentResult(%+v)", *p)
 
 }
@@ -1729,6 +1729,7 @@ func (p *VerifyEmailResp) String() string {
 type UpdateEmailReq struct {
 	NewEmail string `thrift:"new_email,1,required" form:"new_email,required" json:"new_email,required" query:"new_email,required"`
 	Code     string `thrift:"code,2,required" form:"code,required" json:"code,required" query:"code,required"`
+	OldCode  string `thrift:"old_code,3,required" form:"old_code,required" json:"old_code,required" query:"old_code,required"`
 }
 
 func NewUpdateEmailReq() *UpdateEmailReq {
@@ -1746,9 +1747,14 @@ func (p *UpdateEmailReq) GetCode() (v string) {
 	return p.Code
 }
 
+func (p *UpdateEmailReq) GetOldCode() (v string) {
+	return p.OldCode
+}
+
 var fieldIDToName_UpdateEmailReq = map[int16]string{
 	1: "new_email",
 	2: "code",
+	3: "old_code",
 }
 
 func (p *UpdateEmailReq) Read(iprot thrift.TProtocol) (err error) {
@@ -1757,6 +1763,7 @@ func (p *UpdateEmailReq) Read(iprot thrift.TProtocol) (err error) {
 	var fieldId int16
 	var issetNewEmail bool = false
 	var issetCode bool = false
+	var issetOldCode bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -1790,6 +1797,15 @@ func (p *UpdateEmailReq) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetOldCode = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -1812,6 +1828,11 @@ func (p *UpdateEmailReq) Read(iprot thrift.TProtocol) (err error) {
 		fieldId = 2
 		goto RequiredFieldNotSetError
 	}
+
+	if !issetOldCode {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
@@ -1852,6 +1873,17 @@ func (p *UpdateEmailReq) ReadField2(iprot thrift.TProtocol) error {
 	p.Code = _field
 	return nil
 }
+func (p *UpdateEmailReq) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.OldCode = _field
+	return nil
+}
 
 func (p *UpdateEmailReq) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -1867,6 +1899,10 @@ func (p *UpdateEmailReq) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -1919,6 +1955,23 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
+func (p *UpdateEmailReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("old_code", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.OldCode); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
 func (p *UpdateEmailReq) String() string {
 	if p == nil {
 		return "<nil>"
@@ -3387,23 +3440,38 @@ func (p *ResetPasswordResp) String() string {
 
 }
 
-// 刷新Token
-type RefreshTokenReq struct {
+// 邮箱验证码解锁账户
+type UnlockAccountReq struct {
+	Email string `thrift:"email,1,required" form:"email,required" json:"email,required" query:"email,required"`
+	Code  string `thrift:"code,2,required" form:"code,required" json:"code,required" query:"code,required"`
 }
 
-func NewRefreshTokenReq() *RefreshTokenReq {
-	return &RefreshTokenReq{}
+func NewUnlockAccountReq() *UnlockAccountReq {
+	return &UnlockAccountReq{}
 }
 
-func (p *RefreshTokenReq) InitDefault() {
+func (p *UnlockAccountReq) InitDefault() {
 }
 
-var fieldIDToName_RefreshTokenReq = map[int16]string{}
+func (p *UnlockAccountReq) GetEmail() (v string) {
+	return p.Email
+}
 
-func (p *RefreshTokenReq) Read(iprot thrift.TProtocol) (err error) {
+func (p *UnlockAccountReq) GetCode() (v string) {
+	return p.Code
+}
+
+var fieldIDToName_UnlockAccountReq = map[int16]string{
+	1: "email",
+	2: "code",
+}
+
+func (p *UnlockAccountReq) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
+	var issetEmail bool = false
+	var issetCode bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -3417,8 +3485,30 @@ func (p *RefreshTokenReq) Read(iprot thrift.TProtocol) (err error) {
 		if fieldTypeId == thrift.STOP {
 			break
 		}
-		if err = iprot.Skip(fieldTypeId); err != nil {
-			goto SkipFieldTypeError
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetEmail = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetCode = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		}
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
@@ -3428,25 +3518,70 @@ func (p *RefreshTokenReq) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
+	if !issetEmail {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetCode {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
-SkipFieldTypeError:
-	return thrift.PrependError(fmt.Sprintf("%T skip field type %d error", p, fieldTypeId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UnlockAccountReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
 ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_UnlockAccountReq[fieldId]))
 }
 
-func (p *RefreshTokenReq) Write(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteStructBegin("RefreshTokenReq"); err != nil {
+func (p *UnlockAccountReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Email = _field
+	return nil
+}
+func (p *UnlockAccountReq) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Code = _field
+	return nil
+}
+
+func (p *UnlockAccountReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UnlockAccountReq"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -3457,49 +3592,85 @@ func (p *RefreshTokenReq) Write(oprot thrift.TProtocol) (err error) {
 	return nil
 WriteStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
 WriteFieldStopError:
 	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
 WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *RefreshTokenReq) String() string {
+func (p *UnlockAccountReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("email", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Email); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *UnlockAccountReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("code", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Code); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *UnlockAccountReq) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("RefreshTokenReq(%+v)", *p)
+	return fmt.Sprintf("UnlockAccountReq(%+v)", *p)
 
 }
 
-type RefreshTokenResp struct {
+type UnlockAccountResp struct {
 	BaseResponse *module.BaseResp `thrift:"baseResponse,1,required" form:"baseResponse,required" json:"baseResponse,required" query:"baseResponse,required"`
 }
 
-func NewRefreshTokenResp() *RefreshTokenResp {
-	return &RefreshTokenResp{}
+func NewUnlockAccountResp() *UnlockAccountResp {
+	return &UnlockAccountResp{}
 }
 
-func (p *RefreshTokenResp) InitDefault() {
+func (p *UnlockAccountResp) InitDefault() {
 }
 
-var RefreshTokenResp_BaseResponse_DEFAULT *module.BaseResp
+var UnlockAccountResp_BaseResponse_DEFAULT *module.BaseResp
 
-func (p *RefreshTokenResp) GetBaseResponse() (v *module.BaseResp) {
+func (p *UnlockAccountResp) GetBaseResponse() (v *module.BaseResp) {
 	if !p.IsSetBaseResponse() {
-		return RefreshTokenResp_BaseResponse_DEFAULT
+		return UnlockAccountResp_BaseResponse_DEFAULT
 	}
 	return p.BaseResponse
 }
 
-var fieldIDToName_RefreshTokenResp = map[int16]string{
+var fieldIDToName_UnlockAccountResp = map[int16]string{
 	1: "baseResponse",
 }
 
-func (p *RefreshTokenResp) IsSetBaseResponse() bool {
+func (p *UnlockAccountResp) IsSetBaseResponse() bool {
 	return p.BaseResponse != nil
 }
 
-func (p *RefreshTokenResp) Read(iprot thrift.TProtocol) (err error) {
+func (p *UnlockAccountResp) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -3551,7 +3722,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RefreshTokenResp[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UnlockAccountResp[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3560,10 +3731,10 @@ ReadFieldEndError:
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_RefreshTokenResp[fieldId]))
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_UnlockAccountResp[fieldId]))
 }
 
-func (p *RefreshTokenResp) ReadField1(iprot thrift.TProtocol) error {
+func (p *UnlockAccountResp) ReadField1(iprot thrift.TProtocol) error {
 	_field := module.NewBaseResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -3572,9 +3743,9 @@ func (p *RefreshTokenResp) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *RefreshTokenResp) Write(oprot thrift.TProtocol) (err error) {
+func (p *UnlockAccountResp) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("RefreshTokenResp"); err != nil {
+	if err = oprot.WriteStructBegin("UnlockAccountResp"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3600,7 +3771,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *RefreshTokenResp) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *UnlockAccountResp) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("baseResponse", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -3617,39 +3788,46 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *RefreshTokenResp) String() string {
+func (p *UnlockAccountResp) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("RefreshTokenResp(%+v)", *p)
+	return fmt.Sprintf("UnlockAccountResp(%+v)", *p)
 
 }
 
-// 获取用户信息
-type GetUserInfoReq struct {
-	UserID int64 `thrift:"user_id,1,required" json:"user_id,required" path:"user_id,required"`
+// 激活账户
+type ActivateAccountReq struct {
+	Email string `thrift:"email,1,required" form:"email,required" json:"email,required" query:"email,required"`
+	Code  string `thrift:"code,2,required" form:"code,required" json:"code,required" query:"code,required"`
 }
 
-func NewGetUserInfoReq() *GetUserInfoReq {
-	return &GetUserInfoReq{}
+func NewActivateAccountReq() *ActivateAccountReq {
+	return &ActivateAccountReq{}
 }
 
-func (p *GetUserInfoReq) InitDefault() {
+func (p *ActivateAccountReq) InitDefault() {
 }
 
-func (p *GetUserInfoReq) GetUserID() (v int64) {
-	return p.UserID
+func (p *ActivateAccountReq) GetEmail() (v string) {
+	return p.Email
 }
 
-var fieldIDToName_GetUserInfoReq = map[int16]string{
-	1: "user_id",
+func (p *ActivateAccountReq) GetCode() (v string) {
+	return p.Code
 }
 
-func (p *GetUserInfoReq) Read(iprot thrift.TProtocol) (err error) {
+var fieldIDToName_ActivateAccountReq = map[int16]string{
+	1: "email",
+	2: "code",
+}
+
+func (p *ActivateAccountReq) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetUserID bool = false
+	var issetEmail bool = false
+	var issetCode bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -3666,11 +3844,20 @@ func (p *GetUserInfoReq) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetUserID = true
+				issetEmail = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetCode = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -3687,17 +3874,22 @@ func (p *GetUserInfoReq) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
-	if !issetUserID {
+	if !issetEmail {
 		fieldId = 1
 		goto RequiredFieldNotSetError
 	}
+
+	if !issetCode {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetUserInfoReq[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ActivateAccountReq[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3706,24 +3898,35 @@ ReadFieldEndError:
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_GetUserInfoReq[fieldId]))
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ActivateAccountReq[fieldId]))
 }
 
-func (p *GetUserInfoReq) ReadField1(iprot thrift.TProtocol) error {
+func (p *ActivateAccountReq) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.UserID = _field
+	p.Email = _field
 	return nil
 }
+func (p *ActivateAccountReq) ReadField2(iprot thrift.TProtocol) error {
 
-func (p *GetUserInfoReq) Write(oprot thrift.TProtocol) (err error) {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Code = _field
+	return nil
+}
+
+func (p *ActivateAccountReq) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetUserInfoReq"); err != nil {
+	if err = oprot.WriteStructBegin("ActivateAccountReq"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3731,6 +3934,10 @@ func (p *GetUserInfoReq) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -3749,11 +3956,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *GetUserInfoReq) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("user_id", thrift.I64, 1); err != nil {
+func (p *ActivateAccountReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("email", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.UserID); err != nil {
+	if err := oprot.WriteString(p.Email); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -3766,58 +3973,60 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *GetUserInfoReq) String() string {
+func (p *ActivateAccountReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("code", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Code); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *ActivateAccountReq) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetUserInfoReq(%+v)", *p)
+	return fmt.Sprintf("ActivateAccountReq(%+v)", *p)
 
 }
 
-type GetUserInfoResp struct {
+type ActivateAccountResp struct {
 	BaseResponse *module.BaseResp `thrift:"baseResponse,1,required" form:"baseResponse,required" json:"baseResponse,required" query:"baseResponse,required"`
-	User         *module.User     `thrift:"user,2,optional" form:"user" json:"user,omitempty" query:"user"`
 }
 
-func NewGetUserInfoResp() *GetUserInfoResp {
-	return &GetUserInfoResp{}
+func NewActivateAccountResp() *ActivateAccountResp {
+	return &ActivateAccountResp{}
 }
 
-func (p *GetUserInfoResp) InitDefault() {
+func (p *ActivateAccountResp) InitDefault() {
 }
 
-var GetUserInfoResp_BaseResponse_DEFAULT *module.BaseResp
+var ActivateAccountResp_BaseResponse_DEFAULT *module.BaseResp
 
-func (p *GetUserInfoResp) GetBaseResponse() (v *module.BaseResp) {
+func (p *ActivateAccountResp) GetBaseResponse() (v *module.BaseResp) {
 	if !p.IsSetBaseResponse() {
-		return GetUserInfoResp_BaseResponse_DEFAULT
+		return ActivateAccountResp_BaseResponse_DEFAULT
 	}
 	return p.BaseResponse
 }
 
-var GetUserInfoResp_User_DEFAULT *module.User
-
-func (p *GetUserInfoResp) GetUser() (v *module.User) {
-	if !p.IsSetUser() {
-		return GetUserInfoResp_User_DEFAULT
-	}
-	return p.User
-}
-
-var fieldIDToName_GetUserInfoResp = map[int16]string{
+var fieldIDToName_ActivateAccountResp = map[int16]string{
 	1: "baseResponse",
-	2: "user",
 }
 
-func (p *GetUserInfoResp) IsSetBaseResponse() bool {
+func (p *ActivateAccountResp) IsSetBaseResponse() bool {
 	return p.BaseResponse != nil
 }
 
-func (p *GetUserInfoResp) IsSetUser() bool {
-	return p.User != nil
-}
-
-func (p *GetUserInfoResp) Read(iprot thrift.TProtocol) (err error) {
+func (p *ActivateAccountResp) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -3846,14 +4055,6 @@ func (p *GetUserInfoResp) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 2:
-			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField2(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -3877,7 +4078,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetUserInfoResp[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ActivateAccountResp[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3886,10 +4087,10 @@ ReadFieldEndError:
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_GetUserInfoResp[fieldId]))
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ActivateAccountResp[fieldId]))
 }
 
-func (p *GetUserInfoResp) ReadField1(iprot thrift.TProtocol) error {
+func (p *ActivateAccountResp) ReadField1(iprot thrift.TProtocol) error {
 	_field := module.NewBaseResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -3897,18 +4098,10 @@ func (p *GetUserInfoResp) ReadField1(iprot thrift.TProtocol) error {
 	p.BaseResponse = _field
 	return nil
 }
-func (p *GetUserInfoResp) ReadField2(iprot thrift.TProtocol) error {
-	_field := module.NewUser()
-	if err := _field.Read(iprot); err != nil {
-		return err
-	}
-	p.User = _field
-	return nil
-}
 
-func (p *GetUserInfoResp) Write(oprot thrift.TProtocol) (err error) {
+func (p *ActivateAccountResp) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetUserInfoResp"); err != nil {
+	if err = oprot.WriteStructBegin("ActivateAccountResp"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3916,10 +4109,6 @@ func (p *GetUserInfoResp) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
-		if err = p.writeField2(oprot); err != nil {
-			fieldId = 2
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -3938,7 +4127,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *GetUserInfoResp) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ActivateAccountResp) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("baseResponse", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -3955,85 +4144,39 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *GetUserInfoResp) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetUser() {
-		if err = oprot.WriteFieldBegin("user", thrift.STRUCT, 2); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := p.User.Write(oprot); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
-}
-
-func (p *GetUserInfoResp) String() string {
+func (p *ActivateAccountResp) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetUserInfoResp(%+v)", *p)
-
-}
+	return fmt.Sprintf("ActivateAccountResp(%+v)", *p)
 
-type AdminAddUserReq struct {
-	Username string `thrift:"username,1,required" form:"username,required" json:"username,required" query:"username,required"`
-	Password string `thrift:"password,2,required" form:"password,required" json:"password,required" query:"password,required"`
-	Email    string `thrift:"email,3,required" form:"email,required" json:"email,required" query:"email,required"`
-	RoleID   int64  `thrift:"role_id,4,required" form:"role_id,required" json:"role_id,required" query:"role_id,required"`
-	Status   string `thrift:"status,5,required" form:"status,required" json:"status,required" query:"status,required"`
-}
-
-func NewAdminAddUserReq() *AdminAddUserReq {
-	return &AdminAddUserReq{}
 }
 
-func (p *AdminAddUserReq) InitDefault() {
+// 重新发送激活邮件
+type ResendActivationReq struct {
+	Email string `thrift:"email,1,required" form:"email,required" json:"email,required" query:"email,required"`
 }
 
-func (p *AdminAddUserReq) GetUsername() (v string) {
-	return p.Username
+func NewResendActivationReq() *ResendActivationReq {
+	return &ResendActivationReq{}
 }
 
-func (p *AdminAddUserReq) GetPassword() (v string) {
-	return p.Password
+func (p *ResendActivationReq) InitDefault() {
 }
 
-func (p *AdminAddUserReq) GetEmail() (v string) {
+func (p *ResendActivationReq) GetEmail() (v string) {
 	return p.Email
 }
 
-func (p *AdminAddUserReq) GetRoleID() (v int64) {
-	return p.RoleID
-}
-
-func (p *AdminAddUserReq) GetStatus() (v string) {
-	return p.Status
-}
-
-var fieldIDToName_AdminAddUserReq = map[int16]string{
-	1: "username",
-	2: "password",
-	3: "email",
-	4: "role_id",
-	5: "status",
+var fieldIDToName_ResendActivationReq = map[int16]string{
+	1: "email",
 }
 
-func (p *AdminAddUserReq) Read(iprot thrift.TProtocol) (err error) {
+func (p *ResendActivationReq) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetUsername bool = false
-	var issetPassword bool = false
 	var issetEmail bool = false
-	var issetRoleID bool = false
-	var issetStatus bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -4054,46 +4197,10 @@ func (p *AdminAddUserReq) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetUsername = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 2:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField2(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetPassword = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 3:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField3(iprot); err != nil {
-					goto ReadFieldError
-				}
 				issetEmail = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 4:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField4(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetRoleID = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 5:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField5(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetStatus = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -4107,28 +4214,8 @@ func (p *AdminAddUserReq) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
-	if !issetUsername {
-		fieldId = 1
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetPassword {
-		fieldId = 2
-		goto RequiredFieldNotSetError
-	}
-
 	if !issetEmail {
-		fieldId = 3
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetRoleID {
-		fieldId = 4
-		goto RequiredFieldNotSetError
-	}
-
-	if !issetStatus {
-		fieldId = 5
+		fieldId = 1
 		goto RequiredFieldNotSetError
 	}
 	return nil
@@ -4137,7 +4224,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminAddUserReq[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ResendActivationReq[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4146,32 +4233,10 @@ ReadFieldEndError:
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_AdminAddUserReq[fieldId]))
-}
-
-func (p *AdminAddUserReq) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.Username = _field
-	return nil
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ResendActivationReq[fieldId]))
 }
-func (p *AdminAddUserReq) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.Password = _field
-	return nil
-}
-func (p *AdminAddUserReq) ReadField3(iprot thrift.TProtocol) error {
+func (p *ResendActivationReq) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -4182,32 +4247,10 @@ func (p *AdminAddUserReq) ReadField3(iprot thrift.TProtocol) error {
 	p.Email = _field
 	return nil
 }
-func (p *AdminAddUserReq) ReadField4(iprot thrift.TProtocol) error {
-
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.RoleID = _field
-	return nil
-}
-func (p *AdminAddUserReq) ReadField5(iprot thrift.TProtocol) error {
-
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.Status = _field
-	return nil
-}
 
-func (p *AdminAddUserReq) Write(oprot thrift.TProtocol) (err error) {
+func (p *ResendActivationReq) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("AdminAddUserReq"); err != nil {
+	if err = oprot.WriteStructBegin("ResendActivationReq"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4215,22 +4258,6 @@ func (p *AdminAddUserReq) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
-		if err = p.writeField2(oprot); err != nil {
-			fieldId = 2
-			goto WriteFieldError
-		}
-		if err = p.writeField3(oprot); err != nil {
-			fieldId = 3
-			goto WriteFieldError
-		}
-		if err = p.writeField4(oprot); err != nil {
-			fieldId = 4
-			goto WriteFieldError
-		}
-		if err = p.writeField5(oprot); err != nil {
-			fieldId = 5
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -4249,11 +4276,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AdminAddUserReq) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("username", thrift.STRING, 1); err != nil {
+func (p *ResendActivationReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("email", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.Username); err != nil {
+	if err := oprot.WriteString(p.Email); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -4266,122 +4293,47 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *AdminAddUserReq) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("password", thrift.STRING, 2); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.Password); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
-}
-
-func (p *AdminAddUserReq) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("email", thrift.STRING, 3); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.Email); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
-}
-
-func (p *AdminAddUserReq) writeField4(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("role_id", thrift.I64, 4); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.RoleID); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
-}
-
-func (p *AdminAddUserReq) writeField5(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("status", thrift.STRING, 5); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.Status); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
-}
-
-func (p *AdminAddUserReq) String() string {
+func (p *ResendActivationReq) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AdminAddUserReq(%+v)", *p)
+	return fmt.Sprintf("ResendActivationReq(%+v)", *p)
 
 }
 
-type AdminAddUserResp struct {
-	BaseResp *module.BaseResp `thrift:"base_resp,1,required" form:"base_resp,required" json:"base_resp,required" query:"base_resp,required"`
-	UserID   int64            `thrift:"user_id,2,required" form:"user_id,required" json:"user_id,required" query:"user_id,required"`
+type ResendActivationResp struct {
+	BaseResponse *module.BaseResp `thrift:"baseResponse,1,required" form:"baseResponse,required" json:"baseResponse,required" query:"baseResponse,required"`
 }
 
-func NewAdminAddUserResp() *AdminAddUserResp {
-	return &AdminAddUserResp{}
+func NewResendActivationResp() *ResendActivationResp {
+	return &ResendActivationResp{}
 }
 
-func (p *AdminAddUserResp) InitDefault() {
+func (p *ResendActivationResp) InitDefault() {
 }
 
-var AdminAddUserResp_BaseResp_DEFAULT *module.BaseResp
+var ResendActivationResp_BaseResponse_DEFAULT *module.BaseResp
 
-func (p *AdminAddUserResp) GetBaseResp() (v *module.BaseResp) {
-	if !p.IsSetBaseResp() {
-		return AdminAddUserResp_BaseResp_DEFAULT
+func (p *ResendActivationResp) GetBaseResponse() (v *module.BaseResp) {
+	if !p.IsSetBaseResponse() {
+		return ResendActivationResp_BaseResponse_DEFAULT
 	}
-	return p.BaseResp
-}
-
-func (p *AdminAddUserResp) GetUserID() (v int64) {
-	return p.UserID
+	return p.BaseResponse
 }
 
-var fieldIDToName_AdminAddUserResp = map[int16]string{
-	1: "base_resp",
-	2: "user_id",
+var fieldIDToName_ResendActivationResp = map[int16]string{
+	1: "baseResponse",
 }
 
-func (p *AdminAddUserResp) IsSetBaseResp() bool {
-	return p.BaseResp != nil
+func (p *ResendActivationResp) IsSetBaseResponse() bool {
+	return p.BaseResponse != nil
 }
 
-func (p *AdminAddUserResp) Read(iprot thrift.TProtocol) (err error) {
+func (p *ResendActivationResp) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetBaseResp bool = false
-	var issetUserID bool = false
+	var issetBaseResponse bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -4402,16 +4354,7 @@ func (p *AdminAddUserResp) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetBaseResp = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 2:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField2(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetUserID = true
+				issetBaseResponse = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -4428,22 +4371,17 @@ func (p *AdminAddUserResp) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
-	if !issetBaseResp {
+	if !issetBaseResponse {
 		fieldId = 1
 		goto RequiredFieldNotSetError
 	}
-
-	if !issetUserID {
-		fieldId = 2
-		goto RequiredFieldNotSetError
-	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminAddUserResp[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ResendActivationResp[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4452,32 +4390,21 @@ ReadFieldEndError:
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_AdminAddUserResp[fieldId]))
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ResendActivationResp[fieldId]))
 }
 
-func (p *AdminAddUserResp) ReadField1(iprot thrift.TProtocol) error {
+func (p *ResendActivationResp) ReadField1(iprot thrift.TProtocol) error {
 	_field := module.NewBaseResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
-	p.BaseResp = _field
-	return nil
-}
-func (p *AdminAddUserResp) ReadField2(iprot thrift.TProtocol) error {
-
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.UserID = _field
+	p.BaseResponse = _field
 	return nil
 }
 
-func (p *AdminAddUserResp) Write(oprot thrift.TProtocol) (err error) {
+func (p *ResendActivationResp) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("AdminAddUserResp"); err != nil {
+	if err = oprot.WriteStructBegin("ResendActivationResp"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4485,10 +4412,6 @@ func (p *AdminAddUserResp) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
-		if err = p.writeField2(oprot); err != nil {
-			fieldId = 2
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -4507,11 +4430,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AdminAddUserResp) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+func (p *ResendActivationResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("baseResponse", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := p.BaseResp.Write(oprot); err != nil {
+	if err := p.BaseResponse.Write(oprot); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -4524,190 +4447,131 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *AdminAddUserResp) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("user_id", thrift.I64, 2); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.UserID); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
-}
-
-func (p *AdminAddUserResp) String() string {
+func (p *ResendActivationResp) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AdminAddUserResp(%+v)", *p)
+	return fmt.Sprintf("ResendActivationResp(%+v)", *p)
 
 }
 
-type AdminUpdateUserReq struct {
-	UserID          int64   `thrift:"user_id,1,required" form:"user_id,required" json:"user_id,required" query:"user_id,required"`
-	Username        *string `thrift:"username,2,optional" form:"username" json:"username,omitempty" query:"username"`
-	Password        *string `thrift:"password,3,optional" form:"password" json:"password,omitempty" query:"password"`
-	Email           *string `thrift:"email,4,optional" form:"email" json:"email,omitempty" query:"email"`
-	CollegeID       *string `thrift:"college_id,5,optional" form:"college_id" json:"college_id,omitempty" query:"college_id"`
-	MajorID         *string `thrift:"major_id,6,optional" form:"major_id" json:"major_id,omitempty" query:"major_id"`
-	Avatar          []byte  `thrift:"avatar,7,optional" form:"avatar" json:"avatar,omitempty" query:"avatar"`
-	ReputationScore *int64  `thrift:"reputation_score,8,optional" form:"reputation_score" json:"reputation_score,omitempty" query:"reputation_score"`
-	RoleID          *int64  `thrift:"role_id,9,optional" form:"role_id" json:"role_id,omitempty" query:"role_id"`
-	Status          *string `thrift:"status,10,optional" form:"status" json:"status,omitempty" query:"status"`
-}
-
-func NewAdminUpdateUserReq() *AdminUpdateUserReq {
-	return &AdminUpdateUserReq{}
+// 刷新Token
+type RefreshTokenReq struct {
 }
 
-func (p *AdminUpdateUserReq) InitDefault() {
+func NewRefreshTokenReq() *RefreshTokenReq {
+	return &RefreshTokenReq{}
 }
 
-func (p *AdminUpdateUserReq) GetUserID() (v int64) {
-	return p.UserID
+func (p *RefreshTokenReq) InitDefault() {
 }
 
-var AdminUpdateUserReq_Username_DEFAULT string
+var fieldIDToName_RefreshTokenReq = map[int16]string{}
 
-func (p *AdminUpdateUserReq) GetUsername() (v string) {
-	if !p.IsSetUsername() {
-		return AdminUpdateUserReq_Username_DEFAULT
-	}
-	return *p.Username
-}
+func (p *RefreshTokenReq) Read(iprot thrift.TProtocol) (err error) {
 
-var AdminUpdateUserReq_Password_DEFAULT string
+	var fieldTypeId thrift.TType
+	var fieldId int16
 
-func (p *AdminUpdateUserReq) GetPassword() (v string) {
-	if !p.IsSetPassword() {
-		return AdminUpdateUserReq_Password_DEFAULT
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	return *p.Password
-}
-
-var AdminUpdateUserReq_Email_DEFAULT string
 
-func (p *AdminUpdateUserReq) GetEmail() (v string) {
-	if !p.IsSetEmail() {
-		return AdminUpdateUserReq_Email_DEFAULT
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		if err = iprot.Skip(fieldTypeId); err != nil {
+			goto SkipFieldTypeError
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return *p.Email
-}
 
-var AdminUpdateUserReq_CollegeID_DEFAULT string
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+SkipFieldTypeError:
+	return thrift.PrependError(fmt.Sprintf("%T skip field type %d error", p, fieldTypeId), err)
 
-func (p *AdminUpdateUserReq) GetCollegeID() (v string) {
-	if !p.IsSetCollegeID() {
-		return AdminUpdateUserReq_CollegeID_DEFAULT
-	}
-	return *p.CollegeID
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-var AdminUpdateUserReq_MajorID_DEFAULT string
-
-func (p *AdminUpdateUserReq) GetMajorID() (v string) {
-	if !p.IsSetMajorID() {
-		return AdminUpdateUserReq_MajorID_DEFAULT
+func (p *RefreshTokenReq) Write(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteStructBegin("RefreshTokenReq"); err != nil {
+		goto WriteStructBeginError
 	}
-	return *p.MajorID
-}
-
-var AdminUpdateUserReq_Avatar_DEFAULT []byte
-
-func (p *AdminUpdateUserReq) GetAvatar() (v []byte) {
-	if !p.IsSetAvatar() {
-		return AdminUpdateUserReq_Avatar_DEFAULT
+	if p != nil {
 	}
-	return p.Avatar
-}
-
-var AdminUpdateUserReq_ReputationScore_DEFAULT int64
-
-func (p *AdminUpdateUserReq) GetReputationScore() (v int64) {
-	if !p.IsSetReputationScore() {
-		return AdminUpdateUserReq_ReputationScore_DEFAULT
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	return *p.ReputationScore
-}
-
-var AdminUpdateUserReq_RoleID_DEFAULT int64
-
-func (p *AdminUpdateUserReq) GetRoleID() (v int64) {
-	if !p.IsSetRoleID() {
-		return AdminUpdateUserReq_RoleID_DEFAULT
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	return *p.RoleID
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-var AdminUpdateUserReq_Status_DEFAULT string
-
-func (p *AdminUpdateUserReq) GetStatus() (v string) {
-	if !p.IsSetStatus() {
-		return AdminUpdateUserReq_Status_DEFAULT
+func (p *RefreshTokenReq) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return *p.Status
-}
-
-var fieldIDToName_AdminUpdateUserReq = map[int16]string{
-	1:  "user_id",
-	2:  "username",
-	3:  "password",
-	4:  "email",
-	5:  "college_id",
-	6:  "major_id",
-	7:  "avatar",
-	8:  "reputation_score",
-	9:  "role_id",
-	10: "status",
-}
-
-func (p *AdminUpdateUserReq) IsSetUsername() bool {
-	return p.Username != nil
-}
+	return fmt.Sprintf("RefreshTokenReq(%+v)", *p)
 
-func (p *AdminUpdateUserReq) IsSetPassword() bool {
-	return p.Password != nil
 }
 
-func (p *AdminUpdateUserReq) IsSetEmail() bool {
-	return p.Email != nil
+type RefreshTokenResp struct {
+	BaseResponse *module.BaseResp `thrift:"baseResponse,1,required" form:"baseResponse,required" json:"baseResponse,required" query:"baseResponse,required"`
 }
 
-func (p *AdminUpdateUserReq) IsSetCollegeID() bool {
-	return p.CollegeID != nil
+func NewRefreshTokenResp() *RefreshTokenResp {
+	return &RefreshTokenResp{}
 }
 
-func (p *AdminUpdateUserReq) IsSetMajorID() bool {
-	return p.MajorID != nil
+func (p *RefreshTokenResp) InitDefault() {
 }
 
-func (p *AdminUpdateUserReq) IsSetAvatar() bool {
-	return p.Avatar != nil
-}
+var RefreshTokenResp_BaseResponse_DEFAULT *module.BaseResp
 
-func (p *AdminUpdateUserReq) IsSetReputationScore() bool {
-	return p.ReputationScore != nil
+func (p *RefreshTokenResp) GetBaseResponse() (v *module.BaseResp) {
+	if !p.IsSetBaseResponse() {
+		return RefreshTokenResp_BaseResponse_DEFAULT
+	}
+	return p.BaseResponse
 }
 
-func (p *AdminUpdateUserReq) IsSetRoleID() bool {
-	return p.RoleID != nil
+var fieldIDToName_RefreshTokenResp = map[int16]string{
+	1: "baseResponse",
 }
 
-func (p *AdminUpdateUserReq) IsSetStatus() bool {
-	return p.Status != nil
+func (p *RefreshTokenResp) IsSetBaseResponse() bool {
+	return p.BaseResponse != nil
 }
 
-func (p *AdminUpdateUserReq) Read(iprot thrift.TProtocol) (err error) {
+func (p *RefreshTokenResp) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetUserID bool = false
+	var issetBaseResponse bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -4724,83 +4588,11 @@ func (p *AdminUpdateUserReq) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetUserID = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 2:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField2(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 3:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField3(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 4:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField4(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 5:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField5(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 6:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField6(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 7:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField7(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 8:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField8(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 9:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField9(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 10:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField10(iprot); err != nil {
-					goto ReadFieldError
-				}
+				issetBaseResponse = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -4817,7 +4609,7 @@ func (p *AdminUpdateUserReq) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
-	if !issetUserID {
+	if !issetBaseResponse {
 		fieldId = 1
 		goto RequiredFieldNotSetError
 	}
@@ -4827,7 +4619,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminUpdateUserReq[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RefreshTokenResp[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4836,123 +4628,170 @@ ReadFieldEndError:
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_AdminUpdateUserReq[fieldId]))
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_RefreshTokenResp[fieldId]))
 }
 
-func (p *AdminUpdateUserReq) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
+func (p *RefreshTokenResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := module.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
 		return err
-	} else {
-		_field = v
 	}
-	p.UserID = _field
+	p.BaseResponse = _field
 	return nil
 }
-func (p *AdminUpdateUserReq) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = &v
+func (p *RefreshTokenResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RefreshTokenResp"); err != nil {
+		goto WriteStructBeginError
 	}
-	p.Username = _field
-	return nil
-}
-func (p *AdminUpdateUserReq) ReadField3(iprot thrift.TProtocol) error {
-
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = &v
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	p.Password = _field
 	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
-func (p *AdminUpdateUserReq) ReadField4(iprot thrift.TProtocol) error {
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+func (p *RefreshTokenResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("baseResponse", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResponse.Write(oprot); err != nil {
 		return err
-	} else {
-		_field = &v
 	}
-	p.Email = _field
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
 	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *AdminUpdateUserReq) ReadField5(iprot thrift.TProtocol) error {
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = &v
+func (p *RefreshTokenResp) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	p.CollegeID = _field
-	return nil
+	return fmt.Sprintf("RefreshTokenResp(%+v)", *p)
+
 }
-func (p *AdminUpdateUserReq) ReadField6(iprot thrift.TProtocol) error {
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.MajorID = _field
-	return nil
+// 获取用户信息
+type GetUserInfoReq struct {
+	UserID int64 `thrift:"user_id,1,required" json:"user_id,required" path:"user_id,required"`
 }
-func (p *AdminUpdateUserReq) ReadField7(iprot thrift.TProtocol) error {
 
-	var _field []byte
-	if v, err := iprot.ReadBinary(); err != nil {
-		return err
-	} else {
-		_field = []byte(v)
-	}
-	p.Avatar = _field
-	return nil
+func NewGetUserInfoReq() *GetUserInfoReq {
+	return &GetUserInfoReq{}
 }
-func (p *AdminUpdateUserReq) ReadField8(iprot thrift.TProtocol) error {
 
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.ReputationScore = _field
-	return nil
+func (p *GetUserInfoReq) InitDefault() {
 }
-func (p *AdminUpdateUserReq) ReadField9(iprot thrift.TProtocol) error {
 
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = &v
+func (p *GetUserInfoReq) GetUserID() (v int64) {
+	return p.UserID
+}
+
+var fieldIDToName_GetUserInfoReq = map[int16]string{
+	1: "user_id",
+}
+
+func (p *GetUserInfoReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetUserID bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetUserID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetUserID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
 	}
-	p.RoleID = _field
 	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetUserInfoReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_GetUserInfoReq[fieldId]))
 }
-func (p *AdminUpdateUserReq) ReadField10(iprot thrift.TProtocol) error {
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+func (p *GetUserInfoReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
-		_field = &v
+		_field = v
 	}
-	p.Status = _field
+	p.UserID = _field
 	return nil
 }
 
-func (p *AdminUpdateUserReq) Write(oprot thrift.TProtocol) (err error) {
+func (p *GetUserInfoReq) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("AdminUpdateUserReq"); err != nil {
+	if err = oprot.WriteStructBegin("GetUserInfoReq"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4960,42 +4799,6 @@ func (p *AdminUpdateUserReq) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
-		if err = p.writeField2(oprot); err != nil {
-			fieldId = 2
-			goto WriteFieldError
-		}
-		if err = p.writeField3(oprot); err != nil {
-			fieldId = 3
-			goto WriteFieldError
-		}
-		if err = p.writeField4(oprot); err != nil {
-			fieldId = 4
-			goto WriteFieldError
-		}
-		if err = p.writeField5(oprot); err != nil {
-			fieldId = 5
-			goto WriteFieldError
-		}
-		if err = p.writeField6(oprot); err != nil {
-			fieldId = 6
-			goto WriteFieldError
-		}
-		if err = p.writeField7(oprot); err != nil {
-			fieldId = 7
-			goto WriteFieldError
-		}
-		if err = p.writeField8(oprot); err != nil {
-			fieldId = 8
-			goto WriteFieldError
-		}
-		if err = p.writeField9(oprot); err != nil {
-			fieldId = 9
-			goto WriteFieldError
-		}
-		if err = p.writeField10(oprot); err != nil {
-			fieldId = 10
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -5014,7 +4817,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AdminUpdateUserReq) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *GetUserInfoReq) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("user_id", thrift.I64, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -5031,218 +4834,62 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *AdminUpdateUserReq) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetUsername() {
-		if err = oprot.WriteFieldBegin("username", thrift.STRING, 2); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.Username); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+func (p *GetUserInfoReq) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+	return fmt.Sprintf("GetUserInfoReq(%+v)", *p)
+
 }
 
-func (p *AdminUpdateUserReq) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetPassword() {
-		if err = oprot.WriteFieldBegin("password", thrift.STRING, 3); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.Password); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+type GetUserInfoResp struct {
+	BaseResponse *module.BaseResp `thrift:"baseResponse,1,required" form:"baseResponse,required" json:"baseResponse,required" query:"baseResponse,required"`
+	User         *module.User     `thrift:"user,2,optional" form:"user" json:"user,omitempty" query:"user"`
 }
 
-func (p *AdminUpdateUserReq) writeField4(oprot thrift.TProtocol) (err error) {
-	if p.IsSetEmail() {
-		if err = oprot.WriteFieldBegin("email", thrift.STRING, 4); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.Email); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+func NewGetUserInfoResp() *GetUserInfoResp {
+	return &GetUserInfoResp{}
 }
 
-func (p *AdminUpdateUserReq) writeField5(oprot thrift.TProtocol) (err error) {
-	if p.IsSetCollegeID() {
-		if err = oprot.WriteFieldBegin("college_id", thrift.STRING, 5); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.CollegeID); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+func (p *GetUserInfoResp) InitDefault() {
 }
 
-func (p *AdminUpdateUserReq) writeField6(oprot thrift.TProtocol) (err error) {
-	if p.IsSetMajorID() {
-		if err = oprot.WriteFieldBegin("major_id", thrift.STRING, 6); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.MajorID); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
-}
-
-func (p *AdminUpdateUserReq) writeField7(oprot thrift.TProtocol) (err error) {
-	if p.IsSetAvatar() {
-		if err = oprot.WriteFieldBegin("avatar", thrift.STRING, 7); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteBinary([]byte(p.Avatar)); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
-}
-
-func (p *AdminUpdateUserReq) writeField8(oprot thrift.TProtocol) (err error) {
-	if p.IsSetReputationScore() {
-		if err = oprot.WriteFieldBegin("reputation_score", thrift.I64, 8); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI64(*p.ReputationScore); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
-}
+var GetUserInfoResp_BaseResponse_DEFAULT *module.BaseResp
 
-func (p *AdminUpdateUserReq) writeField9(oprot thrift.TProtocol) (err error) {
-	if p.IsSetRoleID() {
-		if err = oprot.WriteFieldBegin("role_id", thrift.I64, 9); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI64(*p.RoleID); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+func (p *GetUserInfoResp) GetBaseResponse() (v *module.BaseResp) {
+	if !p.IsSetBaseResponse() {
+		return GetUserInfoResp_BaseResponse_DEFAULT
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+	return p.BaseResponse
 }
 
-func (p *AdminUpdateUserReq) writeField10(oprot thrift.TProtocol) (err error) {
-	if p.IsSetStatus() {
-		if err = oprot.WriteFieldBegin("status", thrift.STRING, 10); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.Status); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
-}
+var GetUserInfoResp_User_DEFAULT *module.User
 
-func (p *AdminUpdateUserReq) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *GetUserInfoResp) GetUser() (v *module.User) {
+	if !p.IsSetUser() {
+		return GetUserInfoResp_User_DEFAULT
 	}
-	return fmt.Sprintf("AdminUpdateUserReq(%+v)", *p)
-
-}
-
-type AdminUpdateUserResp struct {
-	BaseResp *module.BaseResp `thrift:"base_resp,1,required" form:"base_resp,required" json:"base_resp,required" query:"base_resp,required"`
-}
-
-func NewAdminUpdateUserResp() *AdminUpdateUserResp {
-	return &AdminUpdateUserResp{}
-}
-
-func (p *AdminUpdateUserResp) InitDefault() {
+	return p.User
 }
 
-var AdminUpdateUserResp_BaseResp_DEFAULT *module.BaseResp
-
-func (p *AdminUpdateUserResp) GetBaseResp() (v *module.BaseResp) {
-	if !p.IsSetBaseResp() {
-		return AdminUpdateUserResp_BaseResp_DEFAULT
-	}
-	return p.BaseResp
+var fieldIDToName_GetUserInfoResp = map[int16]string{
+	1: "baseResponse",
+	2: "user",
 }
 
-var fieldIDToName_AdminUpdateUserResp = map[int16]string{
-	1: "base_resp",
+func (p *GetUserInfoResp) IsSetBaseResponse() bool {
+	return p.BaseResponse != nil
 }
 
-func (p *AdminUpdateUserResp) IsSetBaseResp() bool {
-	return p.BaseResp != nil
+func (p *GetUserInfoResp) IsSetUser() bool {
+	return p.User != nil
 }
 
-func (p *AdminUpdateUserResp) Read(iprot thrift.TProtocol) (err error) {
+func (p *GetUserInfoResp) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetBaseResp bool = false
+	var issetBaseResponse bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -5263,7 +4910,15 @@ func (p *AdminUpdateUserResp) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetBaseResp = true
+				issetBaseResponse = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -5280,7 +4935,7 @@ func (p *AdminUpdateUserResp) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
-	if !issetBaseResp {
+	if !issetBaseResponse {
 		fieldId = 1
 		goto RequiredFieldNotSetError
 	}
@@ -5290,7 +4945,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminUpdateUserResp[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetUserInfoResp[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5299,21 +4954,29 @@ ReadFieldEndError:
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_AdminUpdateUserResp[fieldId]))
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_GetUserInfoResp[fieldId]))
 }
 
-func (p *AdminUpdateUserResp) ReadField1(iprot thrift.TProtocol) error {
+func (p *GetUserInfoResp) ReadField1(iprot thrift.TProtocol) error {
 	_field := module.NewBaseResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
-	p.BaseResp = _field
+	p.BaseResponse = _field
+	return nil
+}
+func (p *GetUserInfoResp) ReadField2(iprot thrift.TProtocol) error {
+	_field := module.NewUser()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.User = _field
 	return nil
 }
 
-func (p *AdminUpdateUserResp) Write(oprot thrift.TProtocol) (err error) {
+func (p *GetUserInfoResp) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("AdminUpdateUserResp"); err != nil {
+	if err = oprot.WriteStructBegin("GetUserInfoResp"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5321,6 +4984,10 @@ func (p *AdminUpdateUserResp) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -5339,11 +5006,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AdminUpdateUserResp) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+func (p *GetUserInfoResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("baseResponse", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := p.BaseResp.Write(oprot); err != nil {
+	if err := p.BaseResponse.Write(oprot); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -5356,27 +5023,47 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *AdminUpdateUserResp) String() string {
+func (p *GetUserInfoResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetUser() {
+		if err = oprot.WriteFieldBegin("user", thrift.STRUCT, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.User.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *GetUserInfoResp) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AdminUpdateUserResp(%+v)", *p)
+	return fmt.Sprintf("GetUserInfoResp(%+v)", *p)
 
 }
 
-type GetPermissionListReq struct {
+// 获取统一身份认证登录地址
+type OIDCAuthorizeReq struct {
 }
 
-func NewGetPermissionListReq() *GetPermissionListReq {
-	return &GetPermissionListReq{}
+func NewOIDCAuthorizeReq() *OIDCAuthorizeReq {
+	return &OIDCAuthorizeReq{}
 }
 
-func (p *GetPermissionListReq) InitDefault() {
+func (p *OIDCAuthorizeReq) InitDefault() {
 }
 
-var fieldIDToName_GetPermissionListReq = map[int16]string{}
+var fieldIDToName_OIDCAuthorizeReq = map[int16]string{}
 
-func (p *GetPermissionListReq) Read(iprot thrift.TProtocol) (err error) {
+func (p *OIDCAuthorizeReq) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5418,8 +5105,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *GetPermissionListReq) Write(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteStructBegin("GetPermissionListReq"); err != nil {
+func (p *OIDCAuthorizeReq) Write(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteStructBegin("OIDCAuthorizeReq"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5439,54 +5126,54 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *GetPermissionListReq) String() string {
+func (p *OIDCAuthorizeReq) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetPermissionListReq(%+v)", *p)
+	return fmt.Sprintf("OIDCAuthorizeReq(%+v)", *p)
 
 }
 
-type GetPermissionListResp struct {
-	BaseResp       *module.BaseResp     `thrift:"base_resp,1,required" form:"base_resp,required" json:"base_resp,required" query:"base_resp,required"`
-	PermissionList []*module.Permission `thrift:"permission_list,2,required,list<module.Permission>" form:"permission_list,required" json:"permission_list,required" query:"permission_list,required"`
+type OIDCAuthorizeResp struct {
+	BaseResponse *module.BaseResp `thrift:"baseResponse,1,required" form:"baseResponse,required" json:"baseResponse,required" query:"baseResponse,required"`
+	AuthorizeURL string           `thrift:"authorize_url,2,required" form:"authorize_url,required" json:"authorize_url,required" query:"authorize_url,required"`
 }
 
-func NewGetPermissionListResp() *GetPermissionListResp {
-	return &GetPermissionListResp{}
+func NewOIDCAuthorizeResp() *OIDCAuthorizeResp {
+	return &OIDCAuthorizeResp{}
 }
 
-func (p *GetPermissionListResp) InitDefault() {
+func (p *OIDCAuthorizeResp) InitDefault() {
 }
 
-var GetPermissionListResp_BaseResp_DEFAULT *module.BaseResp
+var OIDCAuthorizeResp_BaseResponse_DEFAULT *module.BaseResp
 
-func (p *GetPermissionListResp) GetBaseResp() (v *module.BaseResp) {
-	if !p.IsSetBaseResp() {
-		return GetPermissionListResp_BaseResp_DEFAULT
+func (p *OIDCAuthorizeResp) GetBaseResponse() (v *module.BaseResp) {
+	if !p.IsSetBaseResponse() {
+		return OIDCAuthorizeResp_BaseResponse_DEFAULT
 	}
-	return p.BaseResp
+	return p.BaseResponse
 }
 
-func (p *GetPermissionListResp) GetPermissionList() (v []*module.Permission) {
-	return p.PermissionList
+func (p *OIDCAuthorizeResp) GetAuthorizeURL() (v string) {
+	return p.AuthorizeURL
 }
 
-var fieldIDToName_GetPermissionListResp = map[int16]string{
-	1: "base_resp",
-	2: "permission_list",
+var fieldIDToName_OIDCAuthorizeResp = map[int16]string{
+	1: "baseResponse",
+	2: "authorize_url",
 }
 
-func (p *GetPermissionListResp) IsSetBaseResp() bool {
-	return p.BaseResp != nil
+func (p *OIDCAuthorizeResp) IsSetBaseResponse() bool {
+	return p.BaseResponse != nil
 }
 
-func (p *GetPermissionListResp) Read(iprot thrift.TProtocol) (err error) {
+func (p *OIDCAuthorizeResp) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetBaseResp bool = false
-	var issetPermissionList bool = false
+	var issetBaseResponse bool = false
+	var issetAuthorizeURL bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -5507,16 +5194,16 @@ func (p *GetPermissionListResp) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetBaseResp = true
+				issetBaseResponse = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetPermissionList = true
+				issetAuthorizeURL = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -5533,12 +5220,12 @@ func (p *GetPermissionListResp) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
-	if !issetBaseResp {
+	if !issetBaseResponse {
 		fieldId = 1
 		goto RequiredFieldNotSetError
 	}
 
-	if !issetPermissionList {
+	if !issetAuthorizeURL {
 		fieldId = 2
 		goto RequiredFieldNotSetError
 	}
@@ -5548,7 +5235,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetPermissionListResp[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OIDCAuthorizeResp[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5557,44 +5244,32 @@ ReadFieldEndError:
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_GetPermissionListResp[fieldId]))
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_OIDCAuthorizeResp[fieldId]))
 }
 
-func (p *GetPermissionListResp) ReadField1(iprot thrift.TProtocol) error {
+func (p *OIDCAuthorizeResp) ReadField1(iprot thrift.TProtocol) error {
 	_field := module.NewBaseResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
-	p.BaseResp = _field
+	p.BaseResponse = _field
 	return nil
 }
-func (p *GetPermissionListResp) ReadField2(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
+func (p *OIDCAuthorizeResp) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		_field = v
 	}
-	_field := make([]*module.Permission, 0, size)
-	values := make([]module.Permission, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-
-		if err := _elem.Read(iprot); err != nil {
-			return err
-		}
-
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
-		return err
-	}
-	p.PermissionList = _field
+	p.AuthorizeURL = _field
 	return nil
 }
 
-func (p *GetPermissionListResp) Write(oprot thrift.TProtocol) (err error) {
+func (p *OIDCAuthorizeResp) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetPermissionListResp"); err != nil {
+	if err = oprot.WriteStructBegin("OIDCAuthorizeResp"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5624,11 +5299,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *GetPermissionListResp) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+func (p *OIDCAuthorizeResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("baseResponse", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := p.BaseResp.Write(oprot); err != nil {
+	if err := p.BaseResponse.Write(oprot); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -5641,19 +5316,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *GetPermissionListResp) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("permission_list", thrift.LIST, 2); err != nil {
+func (p *OIDCAuthorizeResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("authorize_url", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.PermissionList)); err != nil {
-		return err
-	}
-	for _, v := range p.PermissionList {
-		if err := v.Write(oprot); err != nil {
-			return err
-		}
-	}
-	if err := oprot.WriteListEnd(); err != nil {
+	if err := oprot.WriteString(p.AuthorizeURL); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -5666,30 +5333,39 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *GetPermissionListResp) String() string {
+func (p *OIDCAuthorizeResp) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetPermissionListResp(%+v)", *p)
+	return fmt.Sprintf("OIDCAuthorizeResp(%+v)", *p)
 
 }
 
-type GetRoleListReq struct {
+// 通过旧邮箱中的链接撤销邮箱修改
+type RevertEmailChangeReq struct {
+	Token string `thrift:"token,1,required" form:"token,required" json:"token,required" query:"token,required"`
 }
 
-func NewGetRoleListReq() *GetRoleListReq {
-	return &GetRoleListReq{}
+func NewRevertEmailChangeReq() *RevertEmailChangeReq {
+	return &RevertEmailChangeReq{}
 }
 
-func (p *GetRoleListReq) InitDefault() {
+func (p *RevertEmailChangeReq) InitDefault() {
 }
 
-var fieldIDToName_GetRoleListReq = map[int16]string{}
+func (p *RevertEmailChangeReq) GetToken() (v string) {
+	return p.Token
+}
 
-func (p *GetRoleListReq) Read(iprot thrift.TProtocol) (err error) {
+var fieldIDToName_RevertEmailChangeReq = map[int16]string{
+	1: "token",
+}
+
+func (p *RevertEmailChangeReq) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
+	var issetToken bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -5703,8 +5379,21 @@ func (p *GetRoleListReq) Read(iprot thrift.TProtocol) (err error) {
 		if fieldTypeId == thrift.STOP {
 			break
 		}
-		if err = iprot.Skip(fieldTypeId); err != nil {
-			goto SkipFieldTypeError
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetToken = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		}
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
@@ -5714,25 +5403,50 @@ func (p *GetRoleListReq) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
+	if !issetToken {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
-SkipFieldTypeError:
-	return thrift.PrependError(fmt.Sprintf("%T skip field type %d error", p, fieldTypeId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RevertEmailChangeReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
 ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_RevertEmailChangeReq[fieldId]))
 }
 
-func (p *GetRoleListReq) Write(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteStructBegin("GetRoleListReq"); err != nil {
+func (p *RevertEmailChangeReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Token = _field
+	return nil
+}
+
+func (p *RevertEmailChangeReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RevertEmailChangeReq"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -5743,60 +5457,72 @@ func (p *GetRoleListReq) Write(oprot thrift.TProtocol) (err error) {
 	return nil
 WriteStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
 WriteFieldStopError:
 	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
 WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *GetRoleListReq) String() string {
+func (p *RevertEmailChangeReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("token", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Token); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *RevertEmailChangeReq) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetRoleListReq(%+v)", *p)
+	return fmt.Sprintf("RevertEmailChangeReq(%+v)", *p)
 
 }
 
-type GetRoleListResp struct {
-	BaseResp *module.BaseResp `thrift:"base_resp,1,required" form:"base_resp,required" json:"base_resp,required" query:"base_resp,required"`
-	RoleList []*module.Role   `thrift:"role_list,2,required,list<module.Role>" form:"role_list,required" json:"role_list,required" query:"role_list,required"`
+type RevertEmailChangeResp struct {
+	BaseResponse *module.BaseResp `thrift:"baseResponse,1,required" form:"baseResponse,required" json:"baseResponse,required" query:"baseResponse,required"`
 }
 
-func NewGetRoleListResp() *GetRoleListResp {
-	return &GetRoleListResp{}
+func NewRevertEmailChangeResp() *RevertEmailChangeResp {
+	return &RevertEmailChangeResp{}
 }
 
-func (p *GetRoleListResp) InitDefault() {
+func (p *RevertEmailChangeResp) InitDefault() {
 }
 
-var GetRoleListResp_BaseResp_DEFAULT *module.BaseResp
+var RevertEmailChangeResp_BaseResponse_DEFAULT *module.BaseResp
 
-func (p *GetRoleListResp) GetBaseResp() (v *module.BaseResp) {
-	if !p.IsSetBaseResp() {
-		return GetRoleListResp_BaseResp_DEFAULT
+func (p *RevertEmailChangeResp) GetBaseResponse() (v *module.BaseResp) {
+	if !p.IsSetBaseResponse() {
+		return RevertEmailChangeResp_BaseResponse_DEFAULT
 	}
-	return p.BaseResp
-}
-
-func (p *GetRoleListResp) GetRoleList() (v []*module.Role) {
-	return p.RoleList
+	return p.BaseResponse
 }
 
-var fieldIDToName_GetRoleListResp = map[int16]string{
-	1: "base_resp",
-	2: "role_list",
+var fieldIDToName_RevertEmailChangeResp = map[int16]string{
+	1: "baseResponse",
 }
 
-func (p *GetRoleListResp) IsSetBaseResp() bool {
-	return p.BaseResp != nil
+func (p *RevertEmailChangeResp) IsSetBaseResponse() bool {
+	return p.BaseResponse != nil
 }
 
-func (p *GetRoleListResp) Read(iprot thrift.TProtocol) (err error) {
+func (p *RevertEmailChangeResp) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetBaseResp bool = false
-	var issetRoleList bool = false
+	var issetBaseResponse bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -5817,16 +5543,7 @@ func (p *GetRoleListResp) Read(iprot thrift.TProtocol) (err error) {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetBaseResp = true
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 2:
-			if fieldTypeId == thrift.LIST {
-				if err = p.ReadField2(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetRoleList = true
+				issetBaseResponse = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -5843,22 +5560,17 @@ func (p *GetRoleListResp) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
-	if !issetBaseResp {
+	if !issetBaseResponse {
 		fieldId = 1
 		goto RequiredFieldNotSetError
 	}
-
-	if !issetRoleList {
-		fieldId = 2
-		goto RequiredFieldNotSetError
-	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetRoleListResp[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RevertEmailChangeResp[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5867,44 +5579,21 @@ ReadFieldEndError:
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_GetRoleListResp[fieldId]))
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_RevertEmailChangeResp[fieldId]))
 }
 
-func (p *GetRoleListResp) ReadField1(iprot thrift.TProtocol) error {
+func (p *RevertEmailChangeResp) ReadField1(iprot thrift.TProtocol) error {
 	_field := module.NewBaseResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
-	p.BaseResp = _field
-	return nil
-}
-func (p *GetRoleListResp) ReadField2(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return err
-	}
-	_field := make([]*module.Role, 0, size)
-	values := make([]module.Role, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-
-		if err := _elem.Read(iprot); err != nil {
-			return err
-		}
-
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
-		return err
-	}
-	p.RoleList = _field
+	p.BaseResponse = _field
 	return nil
 }
 
-func (p *GetRoleListResp) Write(oprot thrift.TProtocol) (err error) {
+func (p *RevertEmailChangeResp) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetRoleListResp"); err != nil {
+	if err = oprot.WriteStructBegin("RevertEmailChangeResp"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5912,10 +5601,6 @@ func (p *GetRoleListResp) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
-		if err = p.writeField2(oprot); err != nil {
-			fieldId = 2
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -5934,11 +5619,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *GetRoleListResp) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+func (p *RevertEmailChangeResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("baseResponse", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := p.BaseResp.Write(oprot); err != nil {
+	if err := p.BaseResponse.Write(oprot); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -5951,70 +5636,31 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *GetRoleListResp) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("role_list", thrift.LIST, 2); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.RoleList)); err != nil {
-		return err
-	}
-	for _, v := range p.RoleList {
-		if err := v.Write(oprot); err != nil {
-			return err
-		}
-	}
-	if err := oprot.WriteListEnd(); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
-}
-
-func (p *GetRoleListResp) String() string {
+func (p *RevertEmailChangeResp) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetRoleListResp(%+v)", *p)
-
-}
-
-type AddRoleReq struct {
-	RoleName      string  `thrift:"role_name,1,required" form:"role_name,required" json:"role_name,required" query:"role_name,required"`
-	PermissionIds []int64 `thrift:"permission_ids,2,required,list<i64>" form:"permission_ids,required" json:"permission_ids,required" query:"permission_ids,required"`
-}
+	return fmt.Sprintf("RevertEmailChangeResp(%+v)", *p)
 
-func NewAddRoleReq() *AddRoleReq {
-	return &AddRoleReq{}
 }
 
-func (p *AddRoleReq) InitDefault() {
+// 获取本地图形验证码
+type GetCaptchaReq struct {
 }
 
-func (p *AddRoleReq) GetRoleName() (v string) {
-	return p.RoleName
+func NewGetCaptchaReq() *GetCaptchaReq {
+	return &GetCaptchaReq{}
 }
 
-func (p *AddRoleReq) GetPermissionIds() (v []int64) {
-	return p.PermissionIds
+func (p *GetCaptchaReq) InitDefault() {
 }
 
-var fieldIDToName_AddRoleReq = map[int16]string{
-	1: "role_name",
-	2: "permission_ids",
-}
+var fieldIDToName_GetCaptchaReq = map[int16]string{}
 
-func (p *AddRoleReq) Read(iprot thrift.TProtocol) (err error) {
+func (p *GetCaptchaReq) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetRoleName bool = false
-	var issetPermissionIds bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -6028,23 +5674,162 @@ func (p *AddRoleReq) Read(iprot thrift.TProtocol) (err error) {
 		if fieldTypeId == thrift.STOP {
 			break
 		}
+		if err = iprot.Skip(fieldTypeId); err != nil {
+			goto SkipFieldTypeError
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
 
-		switch fieldId {
-		case 1:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField1(iprot); err != nil {
-					goto ReadFieldError
-				}
-				issetRoleName = true
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+SkipFieldTypeError:
+	return thrift.PrependError(fmt.Sprintf("%T skip field type %d error", p, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *GetCaptchaReq) Write(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteStructBegin("GetCaptchaReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *GetCaptchaReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetCaptchaReq(%+v)", *p)
+
+}
+
+type GetCaptchaResp struct {
+	BaseResponse *module.BaseResp `thrift:"baseResponse,1,required" form:"baseResponse,required" json:"baseResponse,required" query:"baseResponse,required"`
+	CaptchaID    string           `thrift:"captcha_id,2,required" form:"captcha_id,required" json:"captcha_id,required" query:"captcha_id,required"`
+	Image        string           `thrift:"image,3,required" form:"image,required" json:"image,required" query:"image,required"`
+	ExpireIn     int64            `thrift:"expire_in,4,required" form:"expire_in,required" json:"expire_in,required" query:"expire_in,required"`
+}
+
+func NewGetCaptchaResp() *GetCaptchaResp {
+	return &GetCaptchaResp{}
+}
+
+func (p *GetCaptchaResp) InitDefault() {
+}
+
+var GetCaptchaResp_BaseResponse_DEFAULT *module.BaseResp
+
+func (p *GetCaptchaResp) GetBaseResponse() (v *module.BaseResp) {
+	if !p.IsSetBaseResponse() {
+		return GetCaptchaResp_BaseResponse_DEFAULT
+	}
+	return p.BaseResponse
+}
+
+func (p *GetCaptchaResp) GetCaptchaID() (v string) {
+	return p.CaptchaID
+}
+
+func (p *GetCaptchaResp) GetImage() (v string) {
+	return p.Image
+}
+
+func (p *GetCaptchaResp) GetExpireIn() (v int64) {
+	return p.ExpireIn
+}
+
+var fieldIDToName_GetCaptchaResp = map[int16]string{
+	1: "baseResponse",
+	2: "captcha_id",
+	3: "image",
+	4: "expire_in",
+}
+
+func (p *GetCaptchaResp) IsSetBaseResponse() bool {
+	return p.BaseResponse != nil
+}
+
+func (p *GetCaptchaResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetBaseResponse bool = false
+	var issetCaptchaID bool = false
+	var issetImage bool = false
+	var issetExpireIn bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetBaseResponse = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetPermissionIds = true
+				issetCaptchaID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetImage = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetExpireIn = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -6061,22 +5846,32 @@ func (p *AddRoleReq) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
-	if !issetRoleName {
+	if !issetBaseResponse {
 		fieldId = 1
 		goto RequiredFieldNotSetError
 	}
 
-	if !issetPermissionIds {
+	if !issetCaptchaID {
 		fieldId = 2
 		goto RequiredFieldNotSetError
 	}
+
+	if !issetImage {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetExpireIn {
+		fieldId = 4
+		goto RequiredFieldNotSetError
+	}
 	return nil
 ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AddRoleReq[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetCaptchaResp[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6085,10 +5880,18 @@ ReadFieldEndError:
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_AddRoleReq[fieldId]))
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_GetCaptchaResp[fieldId]))
 }
 
-func (p *AddRoleReq) ReadField1(iprot thrift.TProtocol) error {
+func (p *GetCaptchaResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := module.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResponse = _field
+	return nil
+}
+func (p *GetCaptchaResp) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -6096,36 +5899,35 @@ func (p *AddRoleReq) ReadField1(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.RoleName = _field
+	p.CaptchaID = _field
 	return nil
 }
-func (p *AddRoleReq) ReadField2(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
+func (p *GetCaptchaResp) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		_field = v
 	}
-	_field := make([]int64, 0, size)
-	for i := 0; i < size; i++ {
-
-		var _elem int64
-		if v, err := iprot.ReadI64(); err != nil {
-			return err
-		} else {
-			_elem = v
-		}
+	p.Image = _field
+	return nil
+}
+func (p *GetCaptchaResp) ReadField4(iprot thrift.TProtocol) error {
 
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
+	} else {
+		_field = v
 	}
-	p.PermissionIds = _field
+	p.ExpireIn = _field
 	return nil
 }
 
-func (p *AddRoleReq) Write(oprot thrift.TProtocol) (err error) {
+func (p *GetCaptchaResp) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("AddRoleReq"); err != nil {
+	if err = oprot.WriteStructBegin("GetCaptchaResp"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6137,6 +5939,14 @@ func (p *AddRoleReq) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -6155,11 +5965,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AddRoleReq) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("role_name", thrift.STRING, 1); err != nil {
+func (p *GetCaptchaResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("baseResponse", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.RoleName); err != nil {
+	if err := p.BaseResponse.Write(oprot); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -6172,19 +5982,28 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *AddRoleReq) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("permission_ids", thrift.LIST, 2); err != nil {
+func (p *GetCaptchaResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("captcha_id", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteListBegin(thrift.I64, len(p.PermissionIds)); err != nil {
+	if err := oprot.WriteString(p.CaptchaID); err != nil {
 		return err
 	}
-	for _, v := range p.PermissionIds {
-		if err := oprot.WriteI64(v); err != nil {
-			return err
-		}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
-	if err := oprot.WriteListEnd(); err != nil {
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *GetCaptchaResp) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("image", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Image); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -6192,59 +6011,68 @@ func (p *AddRoleReq) writeField2(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *AddRoleReq) String() string {
+func (p *GetCaptchaResp) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("expire_in", thrift.I64, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ExpireIn); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *GetCaptchaResp) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AddRoleReq(%+v)", *p)
-
-}
+	return fmt.Sprintf("GetCaptchaResp(%+v)", *p)
 
-type AddRoleResp struct {
-	BaseResp *module.BaseResp `thrift:"base_resp,1,required" form:"base_resp,required" json:"base_resp,required" query:"base_resp,required"`
-	RoleID   int64            `thrift:"role_id,2,required" form:"role_id,required" json:"role_id,required" query:"role_id,required"`
 }
 
-func NewAddRoleResp() *AddRoleResp {
-	return &AddRoleResp{}
+// 统一身份认证回调登录
+type OIDCCallbackReq struct {
+	Code  string `thrift:"code,1,required" form:"code,required" json:"code,required" query:"code,required"`
+	State string `thrift:"state,2,required" form:"state,required" json:"state,required" query:"state,required"`
 }
 
-func (p *AddRoleResp) InitDefault() {
+func NewOIDCCallbackReq() *OIDCCallbackReq {
+	return &OIDCCallbackReq{}
 }
 
-var AddRoleResp_BaseResp_DEFAULT *module.BaseResp
-
-func (p *AddRoleResp) GetBaseResp() (v *module.BaseResp) {
-	if !p.IsSetBaseResp() {
-		return AddRoleResp_BaseResp_DEFAULT
-	}
-	return p.BaseResp
+func (p *OIDCCallbackReq) InitDefault() {
 }
 
-func (p *AddRoleResp) GetRoleID() (v int64) {
-	return p.RoleID
+func (p *OIDCCallbackReq) GetCode() (v string) {
+	return p.Code
 }
 
-var fieldIDToName_AddRoleResp = map[int16]string{
-	1: "base_resp",
-	2: "role_id",
+func (p *OIDCCallbackReq) GetState() (v string) {
+	return p.State
 }
 
-func (p *AddRoleResp) IsSetBaseResp() bool {
-	return p.BaseResp != nil
+var fieldIDToName_OIDCCallbackReq = map[int16]string{
+	1: "code",
+	2: "state",
 }
 
-func (p *AddRoleResp) Read(iprot thrift.TProtocol) (err error) {
+func (p *OIDCCallbackReq) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
-	var issetBaseResp bool = false
-	var issetRoleID bool = false
+	var issetCode bool = false
+	var issetState bool = false
 
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
@@ -6261,20 +6089,20 @@ func (p *AddRoleResp) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetBaseResp = true
+				issetCode = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
-				issetRoleID = true
+				issetState = true
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
@@ -6291,12 +6119,12 @@ func (p *AddRoleResp) Read(iprot thrift.TProtocol) (err error) {
 		goto ReadStructEndError
 	}
 
-	if !issetBaseResp {
+	if !issetCode {
 		fieldId = 1
 		goto RequiredFieldNotSetError
 	}
 
-	if !issetRoleID {
+	if !issetState {
 		fieldId = 2
 		goto RequiredFieldNotSetError
 	}
@@ -6306,7 +6134,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AddRoleResp[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OIDCCallbackReq[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6315,32 +6143,35 @@ ReadFieldEndError:
 ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 RequiredFieldNotSetError:
-	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_AddRoleResp[fieldId]))
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_OIDCCallbackReq[fieldId]))
 }
 
-func (p *AddRoleResp) ReadField1(iprot thrift.TProtocol) error {
-	_field := module.NewBaseResp()
-	if err := _field.Read(iprot); err != nil {
+func (p *OIDCCallbackReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		_field = v
 	}
-	p.BaseResp = _field
+	p.Code = _field
 	return nil
 }
-func (p *AddRoleResp) ReadField2(iprot thrift.TProtocol) error {
+func (p *OIDCCallbackReq) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.RoleID = _field
+	p.State = _field
 	return nil
 }
 
-func (p *AddRoleResp) Write(oprot thrift.TProtocol) (err error) {
+func (p *OIDCCallbackReq) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("AddRoleResp"); err != nil {
+	if err = oprot.WriteStructBegin("OIDCCallbackReq"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6370,11 +6201,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AddRoleResp) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+func (p *OIDCCallbackReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("code", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := p.BaseResp.Write(oprot); err != nil {
+	if err := oprot.WriteString(p.Code); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -6387,11 +6218,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *AddRoleResp) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("role_id", thrift.I64, 2); err != nil {
+func (p *OIDCCallbackReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("state", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.RoleID); err != nil {
+	if err := oprot.WriteString(p.State); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -6404,890 +6235,11786 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *AddRoleResp) String() string {
+func (p *OIDCCallbackReq) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AddRoleResp(%+v)", *p)
+	return fmt.Sprintf("OIDCCallbackReq(%+v)", *p)
 
 }
 
-type UserService interface {
-	Register(ctx context.Context, req *RegisterReq) (r *RegisterResp, err error)
-
-	LoginIn(ctx context.Context, req *LoginInReq) (r *LoginInResp, err error)
-
-	LoginOut(ctx context.Context, req *LoginOutReq) (r *LoginOutResp, err error)
-
-	SendVerifyEmail(ctx context.Context, req *SendVerifyEmailReq) (r *SendVerifyEmailResp, err error)
+type OIDCCallbackResp struct {
+	BaseResponse *module.BaseResp `thrift:"baseResponse,1,required" form:"baseResponse,required" json:"baseResponse,required" query:"baseResponse,required"`
+	User         *module.User     `thrift:"user,2,optional" form:"user" json:"user,omitempty" query:"user"`
+}
 
-	VerifyEmail(ctx context.Context, req *VerifyEmailReq) (r *VerifyEmailResp, err error)
+func NewOIDCCallbackResp() *OIDCCallbackResp {
+	return &OIDCCallbackResp{}
+}
 
-	UpdateEmail(ctx context.Context, req *UpdateEmailReq) (r *UpdateEmailResp, err error)
+func (p *OIDCCallbackResp) InitDefault() {
+}
 
-	UpdatePassword(ctx context.Context, req *UpdatePasswordReq) (r *UpdatePasswordResp, err error)
+var OIDCCallbackResp_BaseResponse_DEFAULT *module.BaseResp
 
-	UpdateMajor(ctx context.Context, req *UpdateMajorReq) (r *UpdateMajorResp, err error)
+func (p *OIDCCallbackResp) GetBaseResponse() (v *module.BaseResp) {
+	if !p.IsSetBaseResponse() {
+		return OIDCCallbackResp_BaseResponse_DEFAULT
+	}
+	return p.BaseResponse
+}
 
-	UploadAvatar(ctx context.Context, req *UploadAvatarReq) (r *UploadAvatarResp, err error)
+var OIDCCallbackResp_User_DEFAULT *module.User
 
-	ResetPassword(ctx context.Context, req *ResetPasswordReq) (r *ResetPasswordResp, err error)
+func (p *OIDCCallbackResp) GetUser() (v *module.User) {
+	if !p.IsSetUser() {
+		return OIDCCallbackResp_User_DEFAULT
+	}
+	return p.User
+}
 
-	RefreshToken(ctx context.Context, req *RefreshTokenReq) (r *RefreshTokenResp, err error)
+var fieldIDToName_OIDCCallbackResp = map[int16]string{
+	1: "baseResponse",
+	2: "user",
+}
 
-	GetUserInfo(ctx context.Context, req *GetUserInfoReq) (r *GetUserInfoResp, err error)
+func (p *OIDCCallbackResp) IsSetBaseResponse() bool {
+	return p.BaseResponse != nil
 }
 
-type UserServiceClient struct {
-	c thrift.TClient
+func (p *OIDCCallbackResp) IsSetUser() bool {
+	return p.User != nil
 }
 
-func NewUserServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *UserServiceClient {
-	return &UserServiceClient{
-		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+func (p *OIDCCallbackResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetBaseResponse bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-}
 
-func NewUserServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *UserServiceClient {
-	return &UserServiceClient{
-		c: thrift.NewTStandardClient(iprot, oprot),
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetBaseResponse = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-}
 
-func NewUserServiceClient(c thrift.TClient) *UserServiceClient {
-	return &UserServiceClient{
-		c: c,
+	if !issetBaseResponse {
+		fieldId = 1
+		goto RequiredFieldNotSetError
 	}
-}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OIDCCallbackResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
-func (p *UserServiceClient) Client_() thrift.TClient {
-	return p.c
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_OIDCCallbackResp[fieldId]))
 }
 
-func (p *UserServiceClient) Register(ctx context.Context, req *RegisterReq) (r *RegisterResp, err error) {
-	var _args UserServiceRegisterArgs
-	_args.Req = req
-	var _result UserServiceRegisterResult
-	if err = p.Client_().Call(ctx, "register", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
-}
-func (p *UserServiceClient) LoginIn(ctx context.Context, req *LoginInReq) (r *LoginInResp, err error) {
-	var _args UserServiceLoginInArgs
-	_args.Req = req
-	var _result UserServiceLoginInResult
-	if err = p.Client_().Call(ctx, "loginIn", &_args, &_result); err != nil {
-		return
+func (p *OIDCCallbackResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := module.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
-	return _result.GetSuccess(), nil
+	p.BaseResponse = _field
+	return nil
 }
-func (p *UserServiceClient) LoginOut(ctx context.Context, req *LoginOutReq) (r *LoginOutResp, err error) {
-	var _args UserServiceLoginOutArgs
-	_args.Req = req
-	var _result UserServiceLoginOutResult
-	if err = p.Client_().Call(ctx, "loginOut", &_args, &_result); err != nil {
-		return
+func (p *OIDCCallbackResp) ReadField2(iprot thrift.TProtocol) error {
+	_field := module.NewUser()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
-	return _result.GetSuccess(), nil
+	p.User = _field
+	return nil
 }
-func (p *UserServiceClient) SendVerifyEmail(ctx context.Context, req *SendVerifyEmailReq) (r *SendVerifyEmailResp, err error) {
-	var _args UserServiceSendVerifyEmailArgs
-	_args.Req = req
-	var _result UserServiceSendVerifyEmailResult
-	if err = p.Client_().Call(ctx, "sendVerifyEmail", &_args, &_result); err != nil {
-		return
+
+func (p *OIDCCallbackResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("OIDCCallbackResp"); err != nil {
+		goto WriteStructBeginError
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *UserServiceClient) VerifyEmail(ctx context.Context, req *VerifyEmailReq) (r *VerifyEmailResp, err error) {
-	var _args UserServiceVerifyEmailArgs
-	_args.Req = req
-	var _result UserServiceVerifyEmailResult
-	if err = p.Client_().Call(ctx, "verifyEmail", &_args, &_result); err != nil {
-		return
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *UserServiceClient) UpdateEmail(ctx context.Context, req *UpdateEmailReq) (r *UpdateEmailResp, err error) {
-	var _args UserServiceUpdateEmailArgs
-	_args.Req = req
-	var _result UserServiceUpdateEmailResult
-	if err = p.Client_().Call(ctx, "updateEmail", &_args, &_result); err != nil {
-		return
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *UserServiceClient) UpdatePassword(ctx context.Context, req *UpdatePasswordReq) (r *UpdatePasswordResp, err error) {
-	var _args UserServiceUpdatePasswordArgs
-	_args.Req = req
-	var _result UserServiceUpdatePasswordResult
-	if err = p.Client_().Call(ctx, "updatePassword", &_args, &_result); err != nil {
-		return
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	return _result.GetSuccess(), nil
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
-func (p *UserServiceClient) UpdateMajor(ctx context.Context, req *UpdateMajorReq) (r *UpdateMajorResp, err error) {
-	var _args UserServiceUpdateMajorArgs
-	_args.Req = req
-	var _result UserServiceUpdateMajorResult
-	if err = p.Client_().Call(ctx, "updateMajor", &_args, &_result); err != nil {
-		return
+
+func (p *OIDCCallbackResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("baseResponse", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *UserServiceClient) UploadAvatar(ctx context.Context, req *UploadAvatarReq) (r *UploadAvatarResp, err error) {
-	var _args UserServiceUploadAvatarArgs
-	_args.Req = req
-	var _result UserServiceUploadAvatarResult
-	if err = p.Client_().Call(ctx, "uploadAvatar", &_args, &_result); err != nil {
-		return
+	if err := p.BaseResponse.Write(oprot); err != nil {
+		return err
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *UserServiceClient) ResetPassword(ctx context.Context, req *ResetPasswordReq) (r *ResetPasswordResp, err error) {
-	var _args UserServiceResetPasswordArgs
-	_args.Req = req
-	var _result UserServiceResetPasswordResult
-	if err = p.Client_().Call(ctx, "resetPassword", &_args, &_result); err != nil {
-		return
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
-	return _result.GetSuccess(), nil
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *UserServiceClient) RefreshToken(ctx context.Context, req *RefreshTokenReq) (r *RefreshTokenResp, err error) {
-	var _args UserServiceRefreshTokenArgs
-	_args.Req = req
-	var _result UserServiceRefreshTokenResult
-	if err = p.Client_().Call(ctx, "refreshToken", &_args, &_result); err != nil {
-		return
+
+func (p *OIDCCallbackResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetUser() {
+		if err = oprot.WriteFieldBegin("user", thrift.STRUCT, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.User.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	return _result.GetSuccess(), nil
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *UserServiceClient) GetUserInfo(ctx context.Context, req *GetUserInfoReq) (r *GetUserInfoResp, err error) {
-	var _args UserServiceGetUserInfoArgs
-	_args.Req = req
-	var _result UserServiceGetUserInfoResult
-	if err = p.Client_().Call(ctx, "getUserInfo", &_args, &_result); err != nil {
-		return
+
+func (p *OIDCCallbackResp) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return _result.GetSuccess(), nil
+	return fmt.Sprintf("OIDCCallbackResp(%+v)", *p)
+
 }
 
-type UserAdminService interface {
-	AdminAddUser(ctx context.Context, req *AdminAddUserReq) (r *AdminAddUserResp, err error)
+// 创建个人访问令牌，明文令牌仅在创建时返回一次
+type CreatePersonalTokenReq struct {
+	Name          string   `thrift:"name,1,required" form:"name,required" json:"name,required" query:"name,required"`
+	Scopes        []string `thrift:"scopes,2,required,list<string>" form:"scopes,required" json:"scopes,required" query:"scopes,required"`
+	ExpiresInDays *int64   `thrift:"expires_in_days,3,optional" form:"expires_in_days" json:"expires_in_days,omitempty" query:"expires_in_days"`
+}
 
-	AdminUpdateUser(ctx context.Context, req *AdminUpdateUserReq) (r *AdminUpdateUserResp, err error)
+func NewCreatePersonalTokenReq() *CreatePersonalTokenReq {
+	return &CreatePersonalTokenReq{}
 }
 
-type UserAdminServiceClient struct {
-	c thrift.TClient
+func (p *CreatePersonalTokenReq) InitDefault() {
 }
 
-func NewUserAdminServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *UserAdminServiceClient {
-	return &UserAdminServiceClient{
-		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
-	}
+func (p *CreatePersonalTokenReq) GetName() (v string) {
+	return p.Name
 }
 
-func NewUserAdminServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *UserAdminServiceClient {
-	return &UserAdminServiceClient{
-		c: thrift.NewTStandardClient(iprot, oprot),
-	}
+func (p *CreatePersonalTokenReq) GetScopes() (v []string) {
+	return p.Scopes
 }
 
-func NewUserAdminServiceClient(c thrift.TClient) *UserAdminServiceClient {
-	return &UserAdminServiceClient{
-		c: c,
+var CreatePersonalTokenReq_ExpiresInDays_DEFAULT int64
+
+func (p *CreatePersonalTokenReq) GetExpiresInDays() (v int64) {
+	if !p.IsSetExpiresInDays() {
+		return CreatePersonalTokenReq_ExpiresInDays_DEFAULT
 	}
+	return *p.ExpiresInDays
 }
 
-func (p *UserAdminServiceClient) Client_() thrift.TClient {
-	return p.c
+var fieldIDToName_CreatePersonalTokenReq = map[int16]string{
+	1: "name",
+	2: "scopes",
+	3: "expires_in_days",
 }
 
-func (p *UserAdminServiceClient) AdminAddUser(ctx context.Context, req *AdminAddUserReq) (r *AdminAddUserResp, err error) {
-	var _args UserAdminServiceAdminAddUserArgs
-	_args.Req = req
-	var _result UserAdminServiceAdminAddUserResult
-	if err = p.Client_().Call(ctx, "AdminAddUser", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
-}
-func (p *UserAdminServiceClient) AdminUpdateUser(ctx context.Context, req *AdminUpdateUserReq) (r *AdminUpdateUserResp, err error) {
-	var _args UserAdminServiceAdminUpdateUserArgs
-	_args.Req = req
-	var _result UserAdminServiceAdminUpdateUserResult
-	if err = p.Client_().Call(ctx, "AdminUpdateUser", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
+func (p *CreatePersonalTokenReq) IsSetExpiresInDays() bool {
+	return p.ExpiresInDays != nil
 }
 
-type RoleAdminService interface {
-	GetPermissionList(ctx context.Context, req *GetPermissionListReq) (r *GetPermissionListResp, err error)
+func (p *CreatePersonalTokenReq) Read(iprot thrift.TProtocol) (err error) {
 
-	GetRoleList(ctx context.Context, req *GetRoleListReq) (r *GetRoleListResp, err error)
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetName bool = false
+	var issetScopes bool = false
 
-	AddRole(ctx context.Context, req *AddRoleReq) (r *AddRoleResp, err error)
-}
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
 
-type RoleAdminServiceClient struct {
-	c thrift.TClient
-}
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
 
-func NewRoleAdminServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *RoleAdminServiceClient {
-	return &RoleAdminServiceClient{
-		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetName = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetScopes = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-}
 
-func NewRoleAdminServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *RoleAdminServiceClient {
-	return &RoleAdminServiceClient{
-		c: thrift.NewTStandardClient(iprot, oprot),
+	if !issetName {
+		fieldId = 1
+		goto RequiredFieldNotSetError
 	}
-}
 
-func NewRoleAdminServiceClient(c thrift.TClient) *RoleAdminServiceClient {
-	return &RoleAdminServiceClient{
-		c: c,
+	if !issetScopes {
+		fieldId = 2
+		goto RequiredFieldNotSetError
 	}
-}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CreatePersonalTokenReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
-func (p *RoleAdminServiceClient) Client_() thrift.TClient {
-	return p.c
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_CreatePersonalTokenReq[fieldId]))
 }
 
-func (p *RoleAdminServiceClient) GetPermissionList(ctx context.Context, req *GetPermissionListReq) (r *GetPermissionListResp, err error) {
-	var _args RoleAdminServiceGetPermissionListArgs
-	_args.Req = req
-	var _result RoleAdminServiceGetPermissionListResult
-	if err = p.Client_().Call(ctx, "GetPermissionList", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
-}
-func (p *RoleAdminServiceClient) GetRoleList(ctx context.Context, req *GetRoleListReq) (r *GetRoleListResp, err error) {
-	var _args RoleAdminServiceGetRoleListArgs
-	_args.Req = req
-	var _result RoleAdminServiceGetRoleListResult
-	if err = p.Client_().Call(ctx, "GetRoleList", &_args, &_result); err != nil {
-		return
+func (p *CreatePersonalTokenReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
 	}
-	return _result.GetSuccess(), nil
+	p.Name = _field
+	return nil
 }
-func (p *RoleAdminServiceClient) AddRole(ctx context.Context, req *AddRoleReq) (r *AddRoleResp, err error) {
-	var _args RoleAdminServiceAddRoleArgs
-	_args.Req = req
-	var _result RoleAdminServiceAddRoleResult
-	if err = p.Client_().Call(ctx, "AddRole", &_args, &_result); err != nil {
-		return
+func (p *CreatePersonalTokenReq) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
 	}
-	return _result.GetSuccess(), nil
-}
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
 
-type UserServiceProcessor struct {
-	processorMap map[string]thrift.TProcessorFunction
-	handler      UserService
-}
+		var _elem string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
 
-func (p *UserServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
-	p.processorMap[key] = processor
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Scopes = _field
+	return nil
 }
+func (p *CreatePersonalTokenReq) ReadField3(iprot thrift.TProtocol) error {
 
-func (p *UserServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
-	processor, ok = p.processorMap[key]
-	return processor, ok
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ExpiresInDays = _field
+	return nil
 }
 
-func (p *UserServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
-	return p.processorMap
+func (p *CreatePersonalTokenReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CreatePersonalTokenReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func NewUserServiceProcessor(handler UserService) *UserServiceProcessor {
-	self := &UserServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
-	self.AddToProcessorMap("register", &userServiceProcessorRegister{handler: handler})
-	self.AddToProcessorMap("loginIn", &userServiceProcessorLoginIn{handler: handler})
-	self.AddToProcessorMap("loginOut", &userServiceProcessorLoginOut{handler: handler})
-	self.AddToProcessorMap("sendVerifyEmail", &userServiceProcessorSendVerifyEmail{handler: handler})
-	self.AddToProcessorMap("verifyEmail", &userServiceProcessorVerifyEmail{handler: handler})
-	self.AddToProcessorMap("updateEmail", &userServiceProcessorUpdateEmail{handler: handler})
-	self.AddToProcessorMap("updatePassword", &userServiceProcessorUpdatePassword{handler: handler})
-	self.AddToProcessorMap("updateMajor", &userServiceProcessorUpdateMajor{handler: handler})
-	self.AddToProcessorMap("uploadAvatar", &userServiceProcessorUploadAvatar{handler: handler})
-	self.AddToProcessorMap("resetPassword", &userServiceProcessorResetPassword{handler: handler})
-	self.AddToProcessorMap("refreshToken", &userServiceProcessorRefreshToken{handler: handler})
-	self.AddToProcessorMap("getUserInfo", &userServiceProcessorGetUserInfo{handler: handler})
-	return self
-}
-func (p *UserServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	name, _, seqId, err := iprot.ReadMessageBegin()
-	if err != nil {
-		return false, err
+func (p *CreatePersonalTokenReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("name", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
 	}
-	if processor, ok := p.GetProcessorFunction(name); ok {
-		return processor.Process(ctx, seqId, iprot, oprot)
+	if err := oprot.WriteString(p.Name); err != nil {
+		return err
 	}
-	iprot.Skip(thrift.STRUCT)
-	iprot.ReadMessageEnd()
-	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
-	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
-	x.Write(oprot)
-	oprot.WriteMessageEnd()
-	oprot.Flush(ctx)
-	return false, x
-}
-
-type userServiceProcessorRegister struct {
-	handler UserService
-}
-
-func (p *userServiceProcessorRegister) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := UserServiceRegisterArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("register", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := UserServiceRegisterResult{}
-	var retval *RegisterResp
-	if retval, err2 = p.handler.Register(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing register: "+err2.Error())
-		oprot.WriteMessageBegin("register", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("register", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+func (p *CreatePersonalTokenReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("scopes", thrift.LIST, 2); err != nil {
+		goto WriteFieldBeginError
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err := oprot.WriteListBegin(thrift.STRING, len(p.Scopes)); err != nil {
+		return err
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	for _, v := range p.Scopes {
+		if err := oprot.WriteString(v); err != nil {
+			return err
+		}
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
 	}
-	if err != nil {
-		return
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
-	return true, err
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-type userServiceProcessorLoginIn struct {
-	handler UserService
+func (p *CreatePersonalTokenReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetExpiresInDays() {
+		if err = oprot.WriteFieldBegin("expires_in_days", thrift.I64, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.ExpiresInDays); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *userServiceProcessorLoginIn) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := UserServiceLoginInArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("loginIn", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *CreatePersonalTokenReq) String() string {
+	if p == nil {
+		return "<nil>"
 	}
+	return fmt.Sprintf("CreatePersonalTokenReq(%+v)", *p)
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := UserServiceLoginInResult{}
-	var retval *LoginInResp
-	if retval, err2 = p.handler.LoginIn(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing loginIn: "+err2.Error())
-		oprot.WriteMessageBegin("loginIn", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("loginIn", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
-	}
-	return true, err
 }
 
-type userServiceProcessorLoginOut struct {
-	handler UserService
+type CreatePersonalTokenResp struct {
+	BaseResponse *module.BaseResp      `thrift:"baseResponse,1,required" form:"baseResponse,required" json:"baseResponse,required" query:"baseResponse,required"`
+	Token        string                `thrift:"token,2,required" form:"token,required" json:"token,required" query:"token,required"`
+	TokenInfo    *module.PersonalToken `thrift:"token_info,3,required" form:"token_info,required" json:"token_info,required" query:"token_info,required"`
 }
 
-func (p *userServiceProcessorLoginOut) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := UserServiceLoginOutArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("loginOut", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func NewCreatePersonalTokenResp() *CreatePersonalTokenResp {
+	return &CreatePersonalTokenResp{}
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := UserServiceLoginOutResult{}
-	var retval *LoginOutResp
-	if retval, err2 = p.handler.LoginOut(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing loginOut: "+err2.Error())
-		oprot.WriteMessageBegin("loginOut", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("loginOut", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
+func (p *CreatePersonalTokenResp) InitDefault() {
+}
+
+var CreatePersonalTokenResp_BaseResponse_DEFAULT *module.BaseResp
+
+func (p *CreatePersonalTokenResp) GetBaseResponse() (v *module.BaseResp) {
+	if !p.IsSetBaseResponse() {
+		return CreatePersonalTokenResp_BaseResponse_DEFAULT
 	}
-	return true, err
+	return p.BaseResponse
 }
 
-type userServiceProcessorSendVerifyEmail struct {
-	handler UserService
+func (p *CreatePersonalTokenResp) GetToken() (v string) {
+	return p.Token
 }
 
-func (p *userServiceProcessorSendVerifyEmail) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := UserServiceSendVerifyEmailArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("sendVerifyEmail", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+var CreatePersonalTokenResp_TokenInfo_DEFAULT *module.PersonalToken
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := UserServiceSendVerifyEmailResult{}
-	var retval *SendVerifyEmailResp
-	if retval, err2 = p.handler.SendVerifyEmail(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing sendVerifyEmail: "+err2.Error())
-		oprot.WriteMessageBegin("sendVerifyEmail", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("sendVerifyEmail", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+func (p *CreatePersonalTokenResp) GetTokenInfo() (v *module.PersonalToken) {
+	if !p.IsSetTokenInfo() {
+		return CreatePersonalTokenResp_TokenInfo_DEFAULT
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
-	}
-	return true, err
+	return p.TokenInfo
 }
 
-type userServiceProcessorVerifyEmail struct {
-	handler UserService
+var fieldIDToName_CreatePersonalTokenResp = map[int16]string{
+	1: "baseResponse",
+	2: "token",
+	3: "token_info",
 }
 
-func (p *userServiceProcessorVerifyEmail) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := UserServiceVerifyEmailArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("verifyEmail", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func (p *CreatePersonalTokenResp) IsSetBaseResponse() bool {
+	return p.BaseResponse != nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := UserServiceVerifyEmailResult{}
-	var retval *VerifyEmailResp
-	if retval, err2 = p.handler.VerifyEmail(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing verifyEmail: "+err2.Error())
-		oprot.WriteMessageBegin("verifyEmail", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+func (p *CreatePersonalTokenResp) IsSetTokenInfo() bool {
+	return p.TokenInfo != nil
+}
+
+func (p *CreatePersonalTokenResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetBaseResponse bool = false
+	var issetToken bool = false
+	var issetTokenInfo bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if err2 = oprot.WriteMessageBegin("verifyEmail", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetBaseResponse = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetToken = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetTokenInfo = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+
+	if !issetBaseResponse {
+		fieldId = 1
+		goto RequiredFieldNotSetError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+
+	if !issetToken {
+		fieldId = 2
+		goto RequiredFieldNotSetError
 	}
-	if err != nil {
-		return
+
+	if !issetTokenInfo {
+		fieldId = 3
+		goto RequiredFieldNotSetError
 	}
-	return true, err
-}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CreatePersonalTokenResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
-type userServiceProcessorUpdateEmail struct {
-	handler UserService
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_CreatePersonalTokenResp[fieldId]))
 }
 
-func (p *userServiceProcessorUpdateEmail) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := UserServiceUpdateEmailArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("updateEmail", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *CreatePersonalTokenResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := module.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.BaseResponse = _field
+	return nil
+}
+func (p *CreatePersonalTokenResp) ReadField2(iprot thrift.TProtocol) error {
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := UserServiceUpdateEmailResult{}
-	var retval *UpdateEmailResp
-	if retval, err2 = p.handler.UpdateEmail(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing updateEmail: "+err2.Error())
-		oprot.WriteMessageBegin("updateEmail", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
 	} else {
-		result.Success = retval
+		_field = v
 	}
-	if err2 = oprot.WriteMessageBegin("updateEmail", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	p.Token = _field
+	return nil
+}
+func (p *CreatePersonalTokenResp) ReadField3(iprot thrift.TProtocol) error {
+	_field := module.NewPersonalToken()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	p.TokenInfo = _field
+	return nil
+}
+
+func (p *CreatePersonalTokenResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CreatePersonalTokenResp"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err != nil {
-		return
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	return true, err
-}
-
-type userServiceProcessorUpdatePassword struct {
-	handler UserService
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *userServiceProcessorUpdatePassword) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := UserServiceUpdatePasswordArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("updatePassword", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *CreatePersonalTokenResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("baseResponse", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResponse.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := UserServiceUpdatePasswordResult{}
-	var retval *UpdatePasswordResp
-	if retval, err2 = p.handler.UpdatePassword(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing updatePassword: "+err2.Error())
-		oprot.WriteMessageBegin("updatePassword", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+func (p *CreatePersonalTokenResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("token", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
 	}
-	if err2 = oprot.WriteMessageBegin("updatePassword", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if err := oprot.WriteString(p.Token); err != nil {
+		return err
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *CreatePersonalTokenResp) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("token_info", thrift.STRUCT, 3); err != nil {
+		goto WriteFieldBeginError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if err := p.TokenInfo.Write(oprot); err != nil {
+		return err
 	}
-	if err != nil {
-		return
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
-	return true, err
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-type userServiceProcessorUpdateMajor struct {
-	handler UserService
+func (p *CreatePersonalTokenResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CreatePersonalTokenResp(%+v)", *p)
+
 }
 
-func (p *userServiceProcessorUpdateMajor) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := UserServiceUpdateMajorArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("updateMajor", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+// 获取个人访问令牌列表
+type ListPersonalTokensReq struct {
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := UserServiceUpdateMajorResult{}
-	var retval *UpdateMajorResp
-	if retval, err2 = p.handler.UpdateMajor(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing updateMajor: "+err2.Error())
-		oprot.WriteMessageBegin("updateMajor", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("updateMajor", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
-	}
-	return true, err
+func NewListPersonalTokensReq() *ListPersonalTokensReq {
+	return &ListPersonalTokensReq{}
 }
 
-type userServiceProcessorUploadAvatar struct {
-	handler UserService
+func (p *ListPersonalTokensReq) InitDefault() {
 }
 
-func (p *userServiceProcessorUploadAvatar) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := UserServiceUploadAvatarArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("uploadAvatar", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+var fieldIDToName_ListPersonalTokensReq = map[int16]string{}
+
+func (p *ListPersonalTokensReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := UserServiceUploadAvatarResult{}
-	var retval *UploadAvatarResp
-	if retval, err2 = p.handler.UploadAvatar(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing uploadAvatar: "+err2.Error())
-		oprot.WriteMessageBegin("uploadAvatar", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		if err = iprot.Skip(fieldTypeId); err != nil {
+			goto SkipFieldTypeError
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err2 = oprot.WriteMessageBegin("uploadAvatar", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+SkipFieldTypeError:
+	return thrift.PrependError(fmt.Sprintf("%T skip field type %d error", p, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ListPersonalTokensReq) Write(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteStructBegin("ListPersonalTokensReq"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if p != nil {
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err != nil {
-		return
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	return true, err
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-type userServiceProcessorResetPassword struct {
-	handler UserService
+func (p *ListPersonalTokensReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListPersonalTokensReq(%+v)", *p)
+
 }
 
-func (p *userServiceProcessorResetPassword) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := UserServiceResetPasswordArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("resetPassword", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+type ListPersonalTokensResp struct {
+	BaseResponse *module.BaseResp        `thrift:"baseResponse,1,required" form:"baseResponse,required" json:"baseResponse,required" query:"baseResponse,required"`
+	Tokens       []*module.PersonalToken `thrift:"tokens,2,required,list<module.PersonalToken>" form:"tokens,required" json:"tokens,required" query:"tokens,required"`
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := UserServiceResetPasswordResult{}
-	var retval *ResetPasswordResp
-	if retval, err2 = p.handler.ResetPassword(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing resetPassword: "+err2.Error())
-		oprot.WriteMessageBegin("resetPassword", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("resetPassword", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
-	}
-	return true, err
+func NewListPersonalTokensResp() *ListPersonalTokensResp {
+	return &ListPersonalTokensResp{}
 }
 
-type userServiceProcessorRefreshToken struct {
-	handler UserService
+func (p *ListPersonalTokensResp) InitDefault() {
 }
 
-func (p *userServiceProcessorRefreshToken) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := UserServiceRefreshTokenArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("refreshToken", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+var ListPersonalTokensResp_BaseResponse_DEFAULT *module.BaseResp
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := UserServiceRefreshTokenResult{}
-	var retval *RefreshTokenResp
-	if retval, err2 = p.handler.RefreshToken(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing refreshToken: "+err2.Error())
-		oprot.WriteMessageBegin("refreshToken", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("refreshToken", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
+func (p *ListPersonalTokensResp) GetBaseResponse() (v *module.BaseResp) {
+	if !p.IsSetBaseResponse() {
+		return ListPersonalTokensResp_BaseResponse_DEFAULT
 	}
-	return true, err
+	return p.BaseResponse
 }
 
-type userServiceProcessorGetUserInfo struct {
-	handler UserService
+func (p *ListPersonalTokensResp) GetTokens() (v []*module.PersonalToken) {
+	return p.Tokens
 }
 
-func (p *userServiceProcessorGetUserInfo) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := UserServiceGetUserInfoArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("getUserInfo", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+var fieldIDToName_ListPersonalTokensResp = map[int16]string{
+	1: "baseResponse",
+	2: "tokens",
+}
+
+func (p *ListPersonalTokensResp) IsSetBaseResponse() bool {
+	return p.BaseResponse != nil
+}
+
+func (p *ListPersonalTokensResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetBaseResponse bool = false
+	var issetTokens bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := UserServiceGetUserInfoResult{}
-	var retval *GetUserInfoResp
-	if retval, err2 = p.handler.GetUserInfo(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing getUserInfo: "+err2.Error())
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetBaseResponse = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetTokens = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetBaseResponse {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetTokens {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListPersonalTokensResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ListPersonalTokensResp[fieldId]))
+}
+
+func (p *ListPersonalTokensResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := module.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResponse = _field
+	return nil
+}
+func (p *ListPersonalTokensResp) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*module.PersonalToken, 0, size)
+	values := make([]module.PersonalToken, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Tokens = _field
+	return nil
+}
+
+func (p *ListPersonalTokensResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ListPersonalTokensResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ListPersonalTokensResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("baseResponse", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResponse.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ListPersonalTokensResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("tokens", thrift.LIST, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Tokens)); err != nil {
+		return err
+	}
+	for _, v := range p.Tokens {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *ListPersonalTokensResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListPersonalTokensResp(%+v)", *p)
+
+}
+
+// 吊销个人访问令牌
+type RevokePersonalTokenReq struct {
+	TokenID int64 `thrift:"token_id,1,required" json:"token_id,required" path:"token_id,required"`
+}
+
+func NewRevokePersonalTokenReq() *RevokePersonalTokenReq {
+	return &RevokePersonalTokenReq{}
+}
+
+func (p *RevokePersonalTokenReq) InitDefault() {
+}
+
+func (p *RevokePersonalTokenReq) GetTokenID() (v int64) {
+	return p.TokenID
+}
+
+var fieldIDToName_RevokePersonalTokenReq = map[int16]string{
+	1: "token_id",
+}
+
+func (p *RevokePersonalTokenReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetTokenID bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetTokenID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetTokenID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RevokePersonalTokenReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_RevokePersonalTokenReq[fieldId]))
+}
+
+func (p *RevokePersonalTokenReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.TokenID = _field
+	return nil
+}
+
+func (p *RevokePersonalTokenReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RevokePersonalTokenReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RevokePersonalTokenReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("token_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.TokenID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *RevokePersonalTokenReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RevokePersonalTokenReq(%+v)", *p)
+
+}
+
+type RevokePersonalTokenResp struct {
+	BaseResponse *module.BaseResp `thrift:"baseResponse,1,required" form:"baseResponse,required" json:"baseResponse,required" query:"baseResponse,required"`
+}
+
+func NewRevokePersonalTokenResp() *RevokePersonalTokenResp {
+	return &RevokePersonalTokenResp{}
+}
+
+func (p *RevokePersonalTokenResp) InitDefault() {
+}
+
+var RevokePersonalTokenResp_BaseResponse_DEFAULT *module.BaseResp
+
+func (p *RevokePersonalTokenResp) GetBaseResponse() (v *module.BaseResp) {
+	if !p.IsSetBaseResponse() {
+		return RevokePersonalTokenResp_BaseResponse_DEFAULT
+	}
+	return p.BaseResponse
+}
+
+var fieldIDToName_RevokePersonalTokenResp = map[int16]string{
+	1: "baseResponse",
+}
+
+func (p *RevokePersonalTokenResp) IsSetBaseResponse() bool {
+	return p.BaseResponse != nil
+}
+
+func (p *RevokePersonalTokenResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetBaseResponse bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetBaseResponse = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetBaseResponse {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RevokePersonalTokenResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_RevokePersonalTokenResp[fieldId]))
+}
+
+func (p *RevokePersonalTokenResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := module.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResponse = _field
+	return nil
+}
+
+func (p *RevokePersonalTokenResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RevokePersonalTokenResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RevokePersonalTokenResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("baseResponse", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResponse.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *RevokePersonalTokenResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RevokePersonalTokenResp(%+v)", *p)
+
+}
+
+type AdminAddUserReq struct {
+	Username string `thrift:"username,1,required" form:"username,required" json:"username,required" query:"username,required"`
+	Password string `thrift:"password,2,required" form:"password,required" json:"password,required" query:"password,required"`
+	Email    string `thrift:"email,3,required" form:"email,required" json:"email,required" query:"email,required"`
+	RoleID   int64  `thrift:"role_id,4,required" form:"role_id,required" json:"role_id,required" query:"role_id,required"`
+	Status   string `thrift:"status,5,required" form:"status,required" json:"status,required" query:"status,required"`
+}
+
+func NewAdminAddUserReq() *AdminAddUserReq {
+	return &AdminAddUserReq{}
+}
+
+func (p *AdminAddUserReq) InitDefault() {
+}
+
+func (p *AdminAddUserReq) GetUsername() (v string) {
+	return p.Username
+}
+
+func (p *AdminAddUserReq) GetPassword() (v string) {
+	return p.Password
+}
+
+func (p *AdminAddUserReq) GetEmail() (v string) {
+	return p.Email
+}
+
+func (p *AdminAddUserReq) GetRoleID() (v int64) {
+	return p.RoleID
+}
+
+func (p *AdminAddUserReq) GetStatus() (v string) {
+	return p.Status
+}
+
+var fieldIDToName_AdminAddUserReq = map[int16]string{
+	1: "username",
+	2: "password",
+	3: "email",
+	4: "role_id",
+	5: "status",
+}
+
+func (p *AdminAddUserReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetUsername bool = false
+	var issetPassword bool = false
+	var issetEmail bool = false
+	var issetRoleID bool = false
+	var issetStatus bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetUsername = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetPassword = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetEmail = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetRoleID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetStatus = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetUsername {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetPassword {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetEmail {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetRoleID {
+		fieldId = 4
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetStatus {
+		fieldId = 5
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminAddUserReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_AdminAddUserReq[fieldId]))
+}
+
+func (p *AdminAddUserReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Username = _field
+	return nil
+}
+func (p *AdminAddUserReq) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Password = _field
+	return nil
+}
+func (p *AdminAddUserReq) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Email = _field
+	return nil
+}
+func (p *AdminAddUserReq) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.RoleID = _field
+	return nil
+}
+func (p *AdminAddUserReq) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Status = _field
+	return nil
+}
+
+func (p *AdminAddUserReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AdminAddUserReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AdminAddUserReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("username", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Username); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *AdminAddUserReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("password", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Password); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *AdminAddUserReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("email", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Email); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *AdminAddUserReq) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("role_id", thrift.I64, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.RoleID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *AdminAddUserReq) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("status", thrift.STRING, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Status); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *AdminAddUserReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AdminAddUserReq(%+v)", *p)
+
+}
+
+type AdminAddUserResp struct {
+	BaseResp *module.BaseResp `thrift:"base_resp,1,required" form:"base_resp,required" json:"base_resp,required" query:"base_resp,required"`
+	UserID   int64            `thrift:"user_id,2,required" form:"user_id,required" json:"user_id,required" query:"user_id,required"`
+}
+
+func NewAdminAddUserResp() *AdminAddUserResp {
+	return &AdminAddUserResp{}
+}
+
+func (p *AdminAddUserResp) InitDefault() {
+}
+
+var AdminAddUserResp_BaseResp_DEFAULT *module.BaseResp
+
+func (p *AdminAddUserResp) GetBaseResp() (v *module.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return AdminAddUserResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *AdminAddUserResp) GetUserID() (v int64) {
+	return p.UserID
+}
+
+var fieldIDToName_AdminAddUserResp = map[int16]string{
+	1: "base_resp",
+	2: "user_id",
+}
+
+func (p *AdminAddUserResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *AdminAddUserResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetBaseResp bool = false
+	var issetUserID bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetBaseResp = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetUserID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetBaseResp {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetUserID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminAddUserResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_AdminAddUserResp[fieldId]))
+}
+
+func (p *AdminAddUserResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := module.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+func (p *AdminAddUserResp) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.UserID = _field
+	return nil
+}
+
+func (p *AdminAddUserResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AdminAddUserResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AdminAddUserResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *AdminAddUserResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.UserID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *AdminAddUserResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AdminAddUserResp(%+v)", *p)
+
+}
+
+type AdminUpdateUserReq struct {
+	UserID          int64   `thrift:"user_id,1,required" form:"user_id,required" json:"user_id,required" query:"user_id,required"`
+	Username        *string `thrift:"username,2,optional" form:"username" json:"username,omitempty" query:"username"`
+	Password        *string `thrift:"password,3,optional" form:"password" json:"password,omitempty" query:"password"`
+	Email           *string `thrift:"email,4,optional" form:"email" json:"email,omitempty" query:"email"`
+	CollegeID       *string `thrift:"college_id,5,optional" form:"college_id" json:"college_id,omitempty" query:"college_id"`
+	MajorID         *string `thrift:"major_id,6,optional" form:"major_id" json:"major_id,omitempty" query:"major_id"`
+	Avatar          []byte  `thrift:"avatar,7,optional" form:"avatar" json:"avatar,omitempty" query:"avatar"`
+	ReputationScore *int64  `thrift:"reputation_score,8,optional" form:"reputation_score" json:"reputation_score,omitempty" query:"reputation_score"`
+	RoleID          *int64  `thrift:"role_id,9,optional" form:"role_id" json:"role_id,omitempty" query:"role_id"`
+	Status          *string `thrift:"status,10,optional" form:"status" json:"status,omitempty" query:"status"`
+}
+
+func NewAdminUpdateUserReq() *AdminUpdateUserReq {
+	return &AdminUpdateUserReq{}
+}
+
+func (p *AdminUpdateUserReq) InitDefault() {
+}
+
+func (p *AdminUpdateUserReq) GetUserID() (v int64) {
+	return p.UserID
+}
+
+var AdminUpdateUserReq_Username_DEFAULT string
+
+func (p *AdminUpdateUserReq) GetUsername() (v string) {
+	if !p.IsSetUsername() {
+		return AdminUpdateUserReq_Username_DEFAULT
+	}
+	return *p.Username
+}
+
+var AdminUpdateUserReq_Password_DEFAULT string
+
+func (p *AdminUpdateUserReq) GetPassword() (v string) {
+	if !p.IsSetPassword() {
+		return AdminUpdateUserReq_Password_DEFAULT
+	}
+	return *p.Password
+}
+
+var AdminUpdateUserReq_Email_DEFAULT string
+
+func (p *AdminUpdateUserReq) GetEmail() (v string) {
+	if !p.IsSetEmail() {
+		return AdminUpdateUserReq_Email_DEFAULT
+	}
+	return *p.Email
+}
+
+var AdminUpdateUserReq_CollegeID_DEFAULT string
+
+func (p *AdminUpdateUserReq) GetCollegeID() (v string) {
+	if !p.IsSetCollegeID() {
+		return AdminUpdateUserReq_CollegeID_DEFAULT
+	}
+	return *p.CollegeID
+}
+
+var AdminUpdateUserReq_MajorID_DEFAULT string
+
+func (p *AdminUpdateUserReq) GetMajorID() (v string) {
+	if !p.IsSetMajorID() {
+		return AdminUpdateUserReq_MajorID_DEFAULT
+	}
+	return *p.MajorID
+}
+
+var AdminUpdateUserReq_Avatar_DEFAULT []byte
+
+func (p *AdminUpdateUserReq) GetAvatar() (v []byte) {
+	if !p.IsSetAvatar() {
+		return AdminUpdateUserReq_Avatar_DEFAULT
+	}
+	return p.Avatar
+}
+
+var AdminUpdateUserReq_ReputationScore_DEFAULT int64
+
+func (p *AdminUpdateUserReq) GetReputationScore() (v int64) {
+	if !p.IsSetReputationScore() {
+		return AdminUpdateUserReq_ReputationScore_DEFAULT
+	}
+	return *p.ReputationScore
+}
+
+var AdminUpdateUserReq_RoleID_DEFAULT int64
+
+func (p *AdminUpdateUserReq) GetRoleID() (v int64) {
+	if !p.IsSetRoleID() {
+		return AdminUpdateUserReq_RoleID_DEFAULT
+	}
+	return *p.RoleID
+}
+
+var AdminUpdateUserReq_Status_DEFAULT string
+
+func (p *AdminUpdateUserReq) GetStatus() (v string) {
+	if !p.IsSetStatus() {
+		return AdminUpdateUserReq_Status_DEFAULT
+	}
+	return *p.Status
+}
+
+var fieldIDToName_AdminUpdateUserReq = map[int16]string{
+	1:  "user_id",
+	2:  "username",
+	3:  "password",
+	4:  "email",
+	5:  "college_id",
+	6:  "major_id",
+	7:  "avatar",
+	8:  "reputation_score",
+	9:  "role_id",
+	10: "status",
+}
+
+func (p *AdminUpdateUserReq) IsSetUsername() bool {
+	return p.Username != nil
+}
+
+func (p *AdminUpdateUserReq) IsSetPassword() bool {
+	return p.Password != nil
+}
+
+func (p *AdminUpdateUserReq) IsSetEmail() bool {
+	return p.Email != nil
+}
+
+func (p *AdminUpdateUserReq) IsSetCollegeID() bool {
+	return p.CollegeID != nil
+}
+
+func (p *AdminUpdateUserReq) IsSetMajorID() bool {
+	return p.MajorID != nil
+}
+
+func (p *AdminUpdateUserReq) IsSetAvatar() bool {
+	return p.Avatar != nil
+}
+
+func (p *AdminUpdateUserReq) IsSetReputationScore() bool {
+	return p.ReputationScore != nil
+}
+
+func (p *AdminUpdateUserReq) IsSetRoleID() bool {
+	return p.RoleID != nil
+}
+
+func (p *AdminUpdateUserReq) IsSetStatus() bool {
+	return p.Status != nil
+}
+
+func (p *AdminUpdateUserReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetUserID bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetUserID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 9:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 10:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField10(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetUserID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminUpdateUserReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_AdminUpdateUserReq[fieldId]))
+}
+
+func (p *AdminUpdateUserReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.UserID = _field
+	return nil
+}
+func (p *AdminUpdateUserReq) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Username = _field
+	return nil
+}
+func (p *AdminUpdateUserReq) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Password = _field
+	return nil
+}
+func (p *AdminUpdateUserReq) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Email = _field
+	return nil
+}
+func (p *AdminUpdateUserReq) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.CollegeID = _field
+	return nil
+}
+func (p *AdminUpdateUserReq) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.MajorID = _field
+	return nil
+}
+func (p *AdminUpdateUserReq) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field []byte
+	if v, err := iprot.ReadBinary(); err != nil {
+		return err
+	} else {
+		_field = []byte(v)
+	}
+	p.Avatar = _field
+	return nil
+}
+func (p *AdminUpdateUserReq) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ReputationScore = _field
+	return nil
+}
+func (p *AdminUpdateUserReq) ReadField9(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.RoleID = _field
+	return nil
+}
+func (p *AdminUpdateUserReq) ReadField10(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Status = _field
+	return nil
+}
+
+func (p *AdminUpdateUserReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AdminUpdateUserReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
+		if err = p.writeField10(oprot); err != nil {
+			fieldId = 10
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AdminUpdateUserReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.UserID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *AdminUpdateUserReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetUsername() {
+		if err = oprot.WriteFieldBegin("username", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Username); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *AdminUpdateUserReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPassword() {
+		if err = oprot.WriteFieldBegin("password", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Password); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *AdminUpdateUserReq) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEmail() {
+		if err = oprot.WriteFieldBegin("email", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Email); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *AdminUpdateUserReq) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCollegeID() {
+		if err = oprot.WriteFieldBegin("college_id", thrift.STRING, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.CollegeID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *AdminUpdateUserReq) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMajorID() {
+		if err = oprot.WriteFieldBegin("major_id", thrift.STRING, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.MajorID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *AdminUpdateUserReq) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAvatar() {
+		if err = oprot.WriteFieldBegin("avatar", thrift.STRING, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBinary([]byte(p.Avatar)); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+
+func (p *AdminUpdateUserReq) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetReputationScore() {
+		if err = oprot.WriteFieldBegin("reputation_score", thrift.I64, 8); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.ReputationScore); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+
+func (p *AdminUpdateUserReq) writeField9(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRoleID() {
+		if err = oprot.WriteFieldBegin("role_id", thrift.I64, 9); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.RoleID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+}
+
+func (p *AdminUpdateUserReq) writeField10(oprot thrift.TProtocol) (err error) {
+	if p.IsSetStatus() {
+		if err = oprot.WriteFieldBegin("status", thrift.STRING, 10); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Status); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+}
+
+func (p *AdminUpdateUserReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AdminUpdateUserReq(%+v)", *p)
+
+}
+
+type AdminUpdateUserResp struct {
+	BaseResp *module.BaseResp `thrift:"base_resp,1,required" form:"base_resp,required" json:"base_resp,required" query:"base_resp,required"`
+}
+
+func NewAdminUpdateUserResp() *AdminUpdateUserResp {
+	return &AdminUpdateUserResp{}
+}
+
+func (p *AdminUpdateUserResp) InitDefault() {
+}
+
+var AdminUpdateUserResp_BaseResp_DEFAULT *module.BaseResp
+
+func (p *AdminUpdateUserResp) GetBaseResp() (v *module.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return AdminUpdateUserResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+var fieldIDToName_AdminUpdateUserResp = map[int16]string{
+	1: "base_resp",
+}
+
+func (p *AdminUpdateUserResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *AdminUpdateUserResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetBaseResp bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetBaseResp = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetBaseResp {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdminUpdateUserResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_AdminUpdateUserResp[fieldId]))
+}
+
+func (p *AdminUpdateUserResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := module.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+
+func (p *AdminUpdateUserResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AdminUpdateUserResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AdminUpdateUserResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *AdminUpdateUserResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AdminUpdateUserResp(%+v)", *p)
+
+}
+
+type GetPermissionListReq struct {
+}
+
+func NewGetPermissionListReq() *GetPermissionListReq {
+	return &GetPermissionListReq{}
+}
+
+func (p *GetPermissionListReq) InitDefault() {
+}
+
+var fieldIDToName_GetPermissionListReq = map[int16]string{}
+
+func (p *GetPermissionListReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		if err = iprot.Skip(fieldTypeId); err != nil {
+			goto SkipFieldTypeError
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+SkipFieldTypeError:
+	return thrift.PrependError(fmt.Sprintf("%T skip field type %d error", p, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *GetPermissionListReq) Write(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteStructBegin("GetPermissionListReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *GetPermissionListReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetPermissionListReq(%+v)", *p)
+
+}
+
+type GetPermissionListResp struct {
+	BaseResp       *module.BaseResp     `thrift:"base_resp,1,required" form:"base_resp,required" json:"base_resp,required" query:"base_resp,required"`
+	PermissionList []*module.Permission `thrift:"permission_list,2,required,list<module.Permission>" form:"permission_list,required" json:"permission_list,required" query:"permission_list,required"`
+}
+
+func NewGetPermissionListResp() *GetPermissionListResp {
+	return &GetPermissionListResp{}
+}
+
+func (p *GetPermissionListResp) InitDefault() {
+}
+
+var GetPermissionListResp_BaseResp_DEFAULT *module.BaseResp
+
+func (p *GetPermissionListResp) GetBaseResp() (v *module.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return GetPermissionListResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *GetPermissionListResp) GetPermissionList() (v []*module.Permission) {
+	return p.PermissionList
+}
+
+var fieldIDToName_GetPermissionListResp = map[int16]string{
+	1: "base_resp",
+	2: "permission_list",
+}
+
+func (p *GetPermissionListResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *GetPermissionListResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetBaseResp bool = false
+	var issetPermissionList bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetBaseResp = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetPermissionList = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetBaseResp {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetPermissionList {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetPermissionListResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_GetPermissionListResp[fieldId]))
+}
+
+func (p *GetPermissionListResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := module.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+func (p *GetPermissionListResp) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*module.Permission, 0, size)
+	values := make([]module.Permission, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.PermissionList = _field
+	return nil
+}
+
+func (p *GetPermissionListResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetPermissionListResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *GetPermissionListResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *GetPermissionListResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("permission_list", thrift.LIST, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.PermissionList)); err != nil {
+		return err
+	}
+	for _, v := range p.PermissionList {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *GetPermissionListResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetPermissionListResp(%+v)", *p)
+
+}
+
+type GetRoleListReq struct {
+}
+
+func NewGetRoleListReq() *GetRoleListReq {
+	return &GetRoleListReq{}
+}
+
+func (p *GetRoleListReq) InitDefault() {
+}
+
+var fieldIDToName_GetRoleListReq = map[int16]string{}
+
+func (p *GetRoleListReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		if err = iprot.Skip(fieldTypeId); err != nil {
+			goto SkipFieldTypeError
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+SkipFieldTypeError:
+	return thrift.PrependError(fmt.Sprintf("%T skip field type %d error", p, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *GetRoleListReq) Write(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteStructBegin("GetRoleListReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *GetRoleListReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetRoleListReq(%+v)", *p)
+
+}
+
+type GetRoleListResp struct {
+	BaseResp *module.BaseResp `thrift:"base_resp,1,required" form:"base_resp,required" json:"base_resp,required" query:"base_resp,required"`
+	RoleList []*module.Role   `thrift:"role_list,2,required,list<module.Role>" form:"role_list,required" json:"role_list,required" query:"role_list,required"`
+}
+
+func NewGetRoleListResp() *GetRoleListResp {
+	return &GetRoleListResp{}
+}
+
+func (p *GetRoleListResp) InitDefault() {
+}
+
+var GetRoleListResp_BaseResp_DEFAULT *module.BaseResp
+
+func (p *GetRoleListResp) GetBaseResp() (v *module.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return GetRoleListResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *GetRoleListResp) GetRoleList() (v []*module.Role) {
+	return p.RoleList
+}
+
+var fieldIDToName_GetRoleListResp = map[int16]string{
+	1: "base_resp",
+	2: "role_list",
+}
+
+func (p *GetRoleListResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *GetRoleListResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetBaseResp bool = false
+	var issetRoleList bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetBaseResp = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetRoleList = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetBaseResp {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetRoleList {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetRoleListResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_GetRoleListResp[fieldId]))
+}
+
+func (p *GetRoleListResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := module.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+func (p *GetRoleListResp) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*module.Role, 0, size)
+	values := make([]module.Role, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.RoleList = _field
+	return nil
+}
+
+func (p *GetRoleListResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetRoleListResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *GetRoleListResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *GetRoleListResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("role_list", thrift.LIST, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.RoleList)); err != nil {
+		return err
+	}
+	for _, v := range p.RoleList {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *GetRoleListResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetRoleListResp(%+v)", *p)
+
+}
+
+type AddRoleReq struct {
+	RoleName      string  `thrift:"role_name,1,required" form:"role_name,required" json:"role_name,required" query:"role_name,required"`
+	PermissionIds []int64 `thrift:"permission_ids,2,required,list<i64>" form:"permission_ids,required" json:"permission_ids,required" query:"permission_ids,required"`
+}
+
+func NewAddRoleReq() *AddRoleReq {
+	return &AddRoleReq{}
+}
+
+func (p *AddRoleReq) InitDefault() {
+}
+
+func (p *AddRoleReq) GetRoleName() (v string) {
+	return p.RoleName
+}
+
+func (p *AddRoleReq) GetPermissionIds() (v []int64) {
+	return p.PermissionIds
+}
+
+var fieldIDToName_AddRoleReq = map[int16]string{
+	1: "role_name",
+	2: "permission_ids",
+}
+
+func (p *AddRoleReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetRoleName bool = false
+	var issetPermissionIds bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetRoleName = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetPermissionIds = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetRoleName {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetPermissionIds {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AddRoleReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_AddRoleReq[fieldId]))
+}
+
+func (p *AddRoleReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.RoleName = _field
+	return nil
+}
+func (p *AddRoleReq) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]int64, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem int64
+		if v, err := iprot.ReadI64(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.PermissionIds = _field
+	return nil
+}
+
+func (p *AddRoleReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AddRoleReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AddRoleReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("role_name", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.RoleName); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *AddRoleReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("permission_ids", thrift.LIST, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.I64, len(p.PermissionIds)); err != nil {
+		return err
+	}
+	for _, v := range p.PermissionIds {
+		if err := oprot.WriteI64(v); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *AddRoleReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AddRoleReq(%+v)", *p)
+
+}
+
+type AddRoleResp struct {
+	BaseResp *module.BaseResp `thrift:"base_resp,1,required" form:"base_resp,required" json:"base_resp,required" query:"base_resp,required"`
+	RoleID   int64            `thrift:"role_id,2,required" form:"role_id,required" json:"role_id,required" query:"role_id,required"`
+}
+
+func NewAddRoleResp() *AddRoleResp {
+	return &AddRoleResp{}
+}
+
+func (p *AddRoleResp) InitDefault() {
+}
+
+var AddRoleResp_BaseResp_DEFAULT *module.BaseResp
+
+func (p *AddRoleResp) GetBaseResp() (v *module.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return AddRoleResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *AddRoleResp) GetRoleID() (v int64) {
+	return p.RoleID
+}
+
+var fieldIDToName_AddRoleResp = map[int16]string{
+	1: "base_resp",
+	2: "role_id",
+}
+
+func (p *AddRoleResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *AddRoleResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetBaseResp bool = false
+	var issetRoleID bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetBaseResp = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetRoleID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetBaseResp {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetRoleID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AddRoleResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_AddRoleResp[fieldId]))
+}
+
+func (p *AddRoleResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := module.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+func (p *AddRoleResp) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.RoleID = _field
+	return nil
+}
+
+func (p *AddRoleResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AddRoleResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AddRoleResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *AddRoleResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("role_id", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.RoleID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *AddRoleResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AddRoleResp(%+v)", *p)
+
+}
+
+type UpdateRoleReq struct {
+	RoleID      int64   `thrift:"role_id,1,required" json:"role_id,required" path:"role_id,required"`
+	RoleName    *string `thrift:"role_name,2,optional" form:"role_name" json:"role_name,omitempty" query:"role_name"`
+	Description *string `thrift:"description,3,optional" form:"description" json:"description,omitempty" query:"description"`
+}
+
+func NewUpdateRoleReq() *UpdateRoleReq {
+	return &UpdateRoleReq{}
+}
+
+func (p *UpdateRoleReq) InitDefault() {
+}
+
+func (p *UpdateRoleReq) GetRoleID() (v int64) {
+	return p.RoleID
+}
+
+var UpdateRoleReq_RoleName_DEFAULT string
+
+func (p *UpdateRoleReq) GetRoleName() (v string) {
+	if !p.IsSetRoleName() {
+		return UpdateRoleReq_RoleName_DEFAULT
+	}
+	return *p.RoleName
+}
+
+var UpdateRoleReq_Description_DEFAULT string
+
+func (p *UpdateRoleReq) GetDescription() (v string) {
+	if !p.IsSetDescription() {
+		return UpdateRoleReq_Description_DEFAULT
+	}
+	return *p.Description
+}
+
+var fieldIDToName_UpdateRoleReq = map[int16]string{
+	1: "role_id",
+	2: "role_name",
+	3: "description",
+}
+
+func (p *UpdateRoleReq) IsSetRoleName() bool {
+	return p.RoleName != nil
+}
+
+func (p *UpdateRoleReq) IsSetDescription() bool {
+	return p.Description != nil
+}
+
+func (p *UpdateRoleReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetRoleID bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetRoleID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetRoleID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateRoleReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_UpdateRoleReq[fieldId]))
+}
+
+func (p *UpdateRoleReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.RoleID = _field
+	return nil
+}
+func (p *UpdateRoleReq) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.RoleName = _field
+	return nil
+}
+func (p *UpdateRoleReq) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Description = _field
+	return nil
+}
+
+func (p *UpdateRoleReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UpdateRoleReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UpdateRoleReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("role_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.RoleID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *UpdateRoleReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRoleName() {
+		if err = oprot.WriteFieldBegin("role_name", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.RoleName); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *UpdateRoleReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDescription() {
+		if err = oprot.WriteFieldBegin("description", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Description); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *UpdateRoleReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateRoleReq(%+v)", *p)
+
+}
+
+type UpdateRoleResp struct {
+	BaseResp *module.BaseResp `thrift:"base_resp,1,required" form:"base_resp,required" json:"base_resp,required" query:"base_resp,required"`
+}
+
+func NewUpdateRoleResp() *UpdateRoleResp {
+	return &UpdateRoleResp{}
+}
+
+func (p *UpdateRoleResp) InitDefault() {
+}
+
+var UpdateRoleResp_BaseResp_DEFAULT *module.BaseResp
+
+func (p *UpdateRoleResp) GetBaseResp() (v *module.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return UpdateRoleResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+var fieldIDToName_UpdateRoleResp = map[int16]string{
+	1: "base_resp",
+}
+
+func (p *UpdateRoleResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *UpdateRoleResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetBaseResp bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetBaseResp = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetBaseResp {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateRoleResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_UpdateRoleResp[fieldId]))
+}
+
+func (p *UpdateRoleResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := module.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+
+func (p *UpdateRoleResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UpdateRoleResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UpdateRoleResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *UpdateRoleResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateRoleResp(%+v)", *p)
+
+}
+
+type GrantRolePermissionsReq struct {
+	RoleID        int64   `thrift:"role_id,1,required" json:"role_id,required" path:"role_id,required"`
+	PermissionIds []int64 `thrift:"permission_ids,2,required,list<i64>" form:"permission_ids,required" json:"permission_ids,required" query:"permission_ids,required"`
+}
+
+func NewGrantRolePermissionsReq() *GrantRolePermissionsReq {
+	return &GrantRolePermissionsReq{}
+}
+
+func (p *GrantRolePermissionsReq) InitDefault() {
+}
+
+func (p *GrantRolePermissionsReq) GetRoleID() (v int64) {
+	return p.RoleID
+}
+
+func (p *GrantRolePermissionsReq) GetPermissionIds() (v []int64) {
+	return p.PermissionIds
+}
+
+var fieldIDToName_GrantRolePermissionsReq = map[int16]string{
+	1: "role_id",
+	2: "permission_ids",
+}
+
+func (p *GrantRolePermissionsReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetRoleID bool = false
+	var issetPermissionIds bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetRoleID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetPermissionIds = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetRoleID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetPermissionIds {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GrantRolePermissionsReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_GrantRolePermissionsReq[fieldId]))
+}
+
+func (p *GrantRolePermissionsReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.RoleID = _field
+	return nil
+}
+func (p *GrantRolePermissionsReq) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]int64, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem int64
+		if v, err := iprot.ReadI64(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.PermissionIds = _field
+	return nil
+}
+
+func (p *GrantRolePermissionsReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GrantRolePermissionsReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *GrantRolePermissionsReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("role_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.RoleID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *GrantRolePermissionsReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("permission_ids", thrift.LIST, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.I64, len(p.PermissionIds)); err != nil {
+		return err
+	}
+	for _, v := range p.PermissionIds {
+		if err := oprot.WriteI64(v); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *GrantRolePermissionsReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GrantRolePermissionsReq(%+v)", *p)
+
+}
+
+type GrantRolePermissionsResp struct {
+	BaseResp *module.BaseResp `thrift:"base_resp,1,required" form:"base_resp,required" json:"base_resp,required" query:"base_resp,required"`
+}
+
+func NewGrantRolePermissionsResp() *GrantRolePermissionsResp {
+	return &GrantRolePermissionsResp{}
+}
+
+func (p *GrantRolePermissionsResp) InitDefault() {
+}
+
+var GrantRolePermissionsResp_BaseResp_DEFAULT *module.BaseResp
+
+func (p *GrantRolePermissionsResp) GetBaseResp() (v *module.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return GrantRolePermissionsResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+var fieldIDToName_GrantRolePermissionsResp = map[int16]string{
+	1: "base_resp",
+}
+
+func (p *GrantRolePermissionsResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *GrantRolePermissionsResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetBaseResp bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetBaseResp = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetBaseResp {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GrantRolePermissionsResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_GrantRolePermissionsResp[fieldId]))
+}
+
+func (p *GrantRolePermissionsResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := module.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+
+func (p *GrantRolePermissionsResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GrantRolePermissionsResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *GrantRolePermissionsResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *GrantRolePermissionsResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GrantRolePermissionsResp(%+v)", *p)
+
+}
+
+type RevokeRolePermissionsReq struct {
+	RoleID        int64   `thrift:"role_id,1,required" json:"role_id,required" path:"role_id,required"`
+	PermissionIds []int64 `thrift:"permission_ids,2,required,list<i64>" form:"permission_ids,required" json:"permission_ids,required" query:"permission_ids,required"`
+}
+
+func NewRevokeRolePermissionsReq() *RevokeRolePermissionsReq {
+	return &RevokeRolePermissionsReq{}
+}
+
+func (p *RevokeRolePermissionsReq) InitDefault() {
+}
+
+func (p *RevokeRolePermissionsReq) GetRoleID() (v int64) {
+	return p.RoleID
+}
+
+func (p *RevokeRolePermissionsReq) GetPermissionIds() (v []int64) {
+	return p.PermissionIds
+}
+
+var fieldIDToName_RevokeRolePermissionsReq = map[int16]string{
+	1: "role_id",
+	2: "permission_ids",
+}
+
+func (p *RevokeRolePermissionsReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetRoleID bool = false
+	var issetPermissionIds bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetRoleID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetPermissionIds = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetRoleID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetPermissionIds {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RevokeRolePermissionsReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_RevokeRolePermissionsReq[fieldId]))
+}
+
+func (p *RevokeRolePermissionsReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.RoleID = _field
+	return nil
+}
+func (p *RevokeRolePermissionsReq) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]int64, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem int64
+		if v, err := iprot.ReadI64(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.PermissionIds = _field
+	return nil
+}
+
+func (p *RevokeRolePermissionsReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RevokeRolePermissionsReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RevokeRolePermissionsReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("role_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.RoleID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *RevokeRolePermissionsReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("permission_ids", thrift.LIST, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.I64, len(p.PermissionIds)); err != nil {
+		return err
+	}
+	for _, v := range p.PermissionIds {
+		if err := oprot.WriteI64(v); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *RevokeRolePermissionsReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RevokeRolePermissionsReq(%+v)", *p)
+
+}
+
+type RevokeRolePermissionsResp struct {
+	BaseResp *module.BaseResp `thrift:"base_resp,1,required" form:"base_resp,required" json:"base_resp,required" query:"base_resp,required"`
+}
+
+func NewRevokeRolePermissionsResp() *RevokeRolePermissionsResp {
+	return &RevokeRolePermissionsResp{}
+}
+
+func (p *RevokeRolePermissionsResp) InitDefault() {
+}
+
+var RevokeRolePermissionsResp_BaseResp_DEFAULT *module.BaseResp
+
+func (p *RevokeRolePermissionsResp) GetBaseResp() (v *module.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return RevokeRolePermissionsResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+var fieldIDToName_RevokeRolePermissionsResp = map[int16]string{
+	1: "base_resp",
+}
+
+func (p *RevokeRolePermissionsResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *RevokeRolePermissionsResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetBaseResp bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetBaseResp = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetBaseResp {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RevokeRolePermissionsResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_RevokeRolePermissionsResp[fieldId]))
+}
+
+func (p *RevokeRolePermissionsResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := module.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+
+func (p *RevokeRolePermissionsResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RevokeRolePermissionsResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RevokeRolePermissionsResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *RevokeRolePermissionsResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RevokeRolePermissionsResp(%+v)", *p)
+
+}
+
+type DeleteRoleReq struct {
+	RoleID         int64 `thrift:"role_id,1,required" json:"role_id,required" path:"role_id,required"`
+	ReassignRoleID int64 `thrift:"reassign_role_id,2,required" form:"reassign_role_id,required" json:"reassign_role_id,required" query:"reassign_role_id,required"`
+}
+
+func NewDeleteRoleReq() *DeleteRoleReq {
+	return &DeleteRoleReq{}
+}
+
+func (p *DeleteRoleReq) InitDefault() {
+}
+
+func (p *DeleteRoleReq) GetRoleID() (v int64) {
+	return p.RoleID
+}
+
+func (p *DeleteRoleReq) GetReassignRoleID() (v int64) {
+	return p.ReassignRoleID
+}
+
+var fieldIDToName_DeleteRoleReq = map[int16]string{
+	1: "role_id",
+	2: "reassign_role_id",
+}
+
+func (p *DeleteRoleReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetRoleID bool = false
+	var issetReassignRoleID bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetRoleID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetReassignRoleID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetRoleID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetReassignRoleID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeleteRoleReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_DeleteRoleReq[fieldId]))
+}
+
+func (p *DeleteRoleReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.RoleID = _field
+	return nil
+}
+func (p *DeleteRoleReq) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ReassignRoleID = _field
+	return nil
+}
+
+func (p *DeleteRoleReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("DeleteRoleReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *DeleteRoleReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("role_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.RoleID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *DeleteRoleReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("reassign_role_id", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ReassignRoleID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *DeleteRoleReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DeleteRoleReq(%+v)", *p)
+
+}
+
+type DeleteRoleResp struct {
+	BaseResp        *module.BaseResp `thrift:"base_resp,1,required" form:"base_resp,required" json:"base_resp,required" query:"base_resp,required"`
+	ReassignedUsers int64            `thrift:"reassigned_users,2,required" form:"reassigned_users,required" json:"reassigned_users,required" query:"reassigned_users,required"`
+}
+
+func NewDeleteRoleResp() *DeleteRoleResp {
+	return &DeleteRoleResp{}
+}
+
+func (p *DeleteRoleResp) InitDefault() {
+}
+
+var DeleteRoleResp_BaseResp_DEFAULT *module.BaseResp
+
+func (p *DeleteRoleResp) GetBaseResp() (v *module.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return DeleteRoleResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *DeleteRoleResp) GetReassignedUsers() (v int64) {
+	return p.ReassignedUsers
+}
+
+var fieldIDToName_DeleteRoleResp = map[int16]string{
+	1: "base_resp",
+	2: "reassigned_users",
+}
+
+func (p *DeleteRoleResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *DeleteRoleResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetBaseResp bool = false
+	var issetReassignedUsers bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetBaseResp = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetReassignedUsers = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetBaseResp {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetReassignedUsers {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeleteRoleResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_DeleteRoleResp[fieldId]))
+}
+
+func (p *DeleteRoleResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := module.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+func (p *DeleteRoleResp) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ReassignedUsers = _field
+	return nil
+}
+
+func (p *DeleteRoleResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("DeleteRoleResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *DeleteRoleResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *DeleteRoleResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("reassigned_users", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ReassignedUsers); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *DeleteRoleResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DeleteRoleResp(%+v)", *p)
+
+}
+
+type GetRoleUsersReq struct {
+	RoleID   int64 `thrift:"role_id,1,required" json:"role_id,required" path:"role_id,required"`
+	PageNum  int32 `thrift:"page_num,2,required" form:"page_num,required" json:"page_num,required" query:"page_num,required"`
+	PageSize int32 `thrift:"page_size,3,required" form:"page_size,required" json:"page_size,required" query:"page_size,required"`
+}
+
+func NewGetRoleUsersReq() *GetRoleUsersReq {
+	return &GetRoleUsersReq{}
+}
+
+func (p *GetRoleUsersReq) InitDefault() {
+}
+
+func (p *GetRoleUsersReq) GetRoleID() (v int64) {
+	return p.RoleID
+}
+
+func (p *GetRoleUsersReq) GetPageNum() (v int32) {
+	return p.PageNum
+}
+
+func (p *GetRoleUsersReq) GetPageSize() (v int32) {
+	return p.PageSize
+}
+
+var fieldIDToName_GetRoleUsersReq = map[int16]string{
+	1: "role_id",
+	2: "page_num",
+	3: "page_size",
+}
+
+func (p *GetRoleUsersReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetRoleID bool = false
+	var issetPageNum bool = false
+	var issetPageSize bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetRoleID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetPageNum = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetPageSize = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetRoleID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetPageNum {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetPageSize {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetRoleUsersReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_GetRoleUsersReq[fieldId]))
+}
+
+func (p *GetRoleUsersReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.RoleID = _field
+	return nil
+}
+func (p *GetRoleUsersReq) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.PageNum = _field
+	return nil
+}
+func (p *GetRoleUsersReq) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.PageSize = _field
+	return nil
+}
+
+func (p *GetRoleUsersReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetRoleUsersReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *GetRoleUsersReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("role_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.RoleID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *GetRoleUsersReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("page_num", thrift.I32, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.PageNum); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *GetRoleUsersReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("page_size", thrift.I32, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.PageSize); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *GetRoleUsersReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetRoleUsersReq(%+v)", *p)
+
+}
+
+type GetRoleUsersResp struct {
+	BaseResp *module.BaseResp `thrift:"base_resp,1,required" form:"base_resp,required" json:"base_resp,required" query:"base_resp,required"`
+	UserList []*module.User   `thrift:"user_list,2,required,list<module.User>" form:"user_list,required" json:"user_list,required" query:"user_list,required"`
+	Total    int64            `thrift:"total,3,required" form:"total,required" json:"total,required" query:"total,required"`
+}
+
+func NewGetRoleUsersResp() *GetRoleUsersResp {
+	return &GetRoleUsersResp{}
+}
+
+func (p *GetRoleUsersResp) InitDefault() {
+}
+
+var GetRoleUsersResp_BaseResp_DEFAULT *module.BaseResp
+
+func (p *GetRoleUsersResp) GetBaseResp() (v *module.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return GetRoleUsersResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *GetRoleUsersResp) GetUserList() (v []*module.User) {
+	return p.UserList
+}
+
+func (p *GetRoleUsersResp) GetTotal() (v int64) {
+	return p.Total
+}
+
+var fieldIDToName_GetRoleUsersResp = map[int16]string{
+	1: "base_resp",
+	2: "user_list",
+	3: "total",
+}
+
+func (p *GetRoleUsersResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *GetRoleUsersResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetBaseResp bool = false
+	var issetUserList bool = false
+	var issetTotal bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetBaseResp = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetUserList = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetTotal = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetBaseResp {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetUserList {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetTotal {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetRoleUsersResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_GetRoleUsersResp[fieldId]))
+}
+
+func (p *GetRoleUsersResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := module.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+func (p *GetRoleUsersResp) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*module.User, 0, size)
+	values := make([]module.User, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.UserList = _field
+	return nil
+}
+func (p *GetRoleUsersResp) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Total = _field
+	return nil
+}
+
+func (p *GetRoleUsersResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetRoleUsersResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *GetRoleUsersResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *GetRoleUsersResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_list", thrift.LIST, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.UserList)); err != nil {
+		return err
+	}
+	for _, v := range p.UserList {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *GetRoleUsersResp) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("total", thrift.I64, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.Total); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *GetRoleUsersResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetRoleUsersResp(%+v)", *p)
+
+}
+
+type GrantScopedPermissionReq struct {
+	UserID         int64  `thrift:"user_id,1,required" form:"user_id,required" json:"user_id,required" query:"user_id,required"`
+	PermissionName string `thrift:"permission_name,2,required" form:"permission_name,required" json:"permission_name,required" query:"permission_name,required"`
+	ScopeType      string `thrift:"scope_type,3,required" form:"scope_type,required" json:"scope_type,required" query:"scope_type,required"`
+	ScopeID        int64  `thrift:"scope_id,4,required" form:"scope_id,required" json:"scope_id,required" query:"scope_id,required"`
+}
+
+func NewGrantScopedPermissionReq() *GrantScopedPermissionReq {
+	return &GrantScopedPermissionReq{}
+}
+
+func (p *GrantScopedPermissionReq) InitDefault() {
+}
+
+func (p *GrantScopedPermissionReq) GetUserID() (v int64) {
+	return p.UserID
+}
+
+func (p *GrantScopedPermissionReq) GetPermissionName() (v string) {
+	return p.PermissionName
+}
+
+func (p *GrantScopedPermissionReq) GetScopeType() (v string) {
+	return p.ScopeType
+}
+
+func (p *GrantScopedPermissionReq) GetScopeID() (v int64) {
+	return p.ScopeID
+}
+
+var fieldIDToName_GrantScopedPermissionReq = map[int16]string{
+	1: "user_id",
+	2: "permission_name",
+	3: "scope_type",
+	4: "scope_id",
+}
+
+func (p *GrantScopedPermissionReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetUserID bool = false
+	var issetPermissionName bool = false
+	var issetScopeType bool = false
+	var issetScopeID bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetUserID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetPermissionName = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetScopeType = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetScopeID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetUserID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetPermissionName {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetScopeType {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetScopeID {
+		fieldId = 4
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GrantScopedPermissionReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_GrantScopedPermissionReq[fieldId]))
+}
+
+func (p *GrantScopedPermissionReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.UserID = _field
+	return nil
+}
+func (p *GrantScopedPermissionReq) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.PermissionName = _field
+	return nil
+}
+func (p *GrantScopedPermissionReq) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ScopeType = _field
+	return nil
+}
+func (p *GrantScopedPermissionReq) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ScopeID = _field
+	return nil
+}
+
+func (p *GrantScopedPermissionReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GrantScopedPermissionReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *GrantScopedPermissionReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.UserID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *GrantScopedPermissionReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("permission_name", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.PermissionName); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *GrantScopedPermissionReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("scope_type", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.ScopeType); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *GrantScopedPermissionReq) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("scope_id", thrift.I64, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ScopeID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *GrantScopedPermissionReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GrantScopedPermissionReq(%+v)", *p)
+
+}
+
+type GrantScopedPermissionResp struct {
+	BaseResp *module.BaseResp `thrift:"base_resp,1,required" form:"base_resp,required" json:"base_resp,required" query:"base_resp,required"`
+	GrantID  int64            `thrift:"grant_id,2,required" form:"grant_id,required" json:"grant_id,required" query:"grant_id,required"`
+}
+
+func NewGrantScopedPermissionResp() *GrantScopedPermissionResp {
+	return &GrantScopedPermissionResp{}
+}
+
+func (p *GrantScopedPermissionResp) InitDefault() {
+}
+
+var GrantScopedPermissionResp_BaseResp_DEFAULT *module.BaseResp
+
+func (p *GrantScopedPermissionResp) GetBaseResp() (v *module.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return GrantScopedPermissionResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *GrantScopedPermissionResp) GetGrantID() (v int64) {
+	return p.GrantID
+}
+
+var fieldIDToName_GrantScopedPermissionResp = map[int16]string{
+	1: "base_resp",
+	2: "grant_id",
+}
+
+func (p *GrantScopedPermissionResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *GrantScopedPermissionResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetBaseResp bool = false
+	var issetGrantID bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetBaseResp = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetGrantID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetBaseResp {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetGrantID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GrantScopedPermissionResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_GrantScopedPermissionResp[fieldId]))
+}
+
+func (p *GrantScopedPermissionResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := module.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+func (p *GrantScopedPermissionResp) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.GrantID = _field
+	return nil
+}
+
+func (p *GrantScopedPermissionResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GrantScopedPermissionResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *GrantScopedPermissionResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *GrantScopedPermissionResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("grant_id", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.GrantID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *GrantScopedPermissionResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GrantScopedPermissionResp(%+v)", *p)
+
+}
+
+type RevokeScopedPermissionReq struct {
+	GrantID int64 `thrift:"grant_id,1,required" json:"grant_id,required" path:"grant_id,required"`
+}
+
+func NewRevokeScopedPermissionReq() *RevokeScopedPermissionReq {
+	return &RevokeScopedPermissionReq{}
+}
+
+func (p *RevokeScopedPermissionReq) InitDefault() {
+}
+
+func (p *RevokeScopedPermissionReq) GetGrantID() (v int64) {
+	return p.GrantID
+}
+
+var fieldIDToName_RevokeScopedPermissionReq = map[int16]string{
+	1: "grant_id",
+}
+
+func (p *RevokeScopedPermissionReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetGrantID bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetGrantID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetGrantID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RevokeScopedPermissionReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_RevokeScopedPermissionReq[fieldId]))
+}
+
+func (p *RevokeScopedPermissionReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.GrantID = _field
+	return nil
+}
+
+func (p *RevokeScopedPermissionReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RevokeScopedPermissionReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RevokeScopedPermissionReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("grant_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.GrantID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *RevokeScopedPermissionReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RevokeScopedPermissionReq(%+v)", *p)
+
+}
+
+type RevokeScopedPermissionResp struct {
+	BaseResp *module.BaseResp `thrift:"base_resp,1,required" form:"base_resp,required" json:"base_resp,required" query:"base_resp,required"`
+}
+
+func NewRevokeScopedPermissionResp() *RevokeScopedPermissionResp {
+	return &RevokeScopedPermissionResp{}
+}
+
+func (p *RevokeScopedPermissionResp) InitDefault() {
+}
+
+var RevokeScopedPermissionResp_BaseResp_DEFAULT *module.BaseResp
+
+func (p *RevokeScopedPermissionResp) GetBaseResp() (v *module.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return RevokeScopedPermissionResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+var fieldIDToName_RevokeScopedPermissionResp = map[int16]string{
+	1: "base_resp",
+}
+
+func (p *RevokeScopedPermissionResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *RevokeScopedPermissionResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetBaseResp bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetBaseResp = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetBaseResp {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RevokeScopedPermissionResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_RevokeScopedPermissionResp[fieldId]))
+}
+
+func (p *RevokeScopedPermissionResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := module.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+
+func (p *RevokeScopedPermissionResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RevokeScopedPermissionResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RevokeScopedPermissionResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *RevokeScopedPermissionResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RevokeScopedPermissionResp(%+v)", *p)
+
+}
+
+type ListScopedPermissionsReq struct {
+	ScopeType string `thrift:"scope_type,1,required" form:"scope_type,required" json:"scope_type,required" query:"scope_type,required"`
+	ScopeID   int64  `thrift:"scope_id,2,required" form:"scope_id,required" json:"scope_id,required" query:"scope_id,required"`
+}
+
+func NewListScopedPermissionsReq() *ListScopedPermissionsReq {
+	return &ListScopedPermissionsReq{}
+}
+
+func (p *ListScopedPermissionsReq) InitDefault() {
+}
+
+func (p *ListScopedPermissionsReq) GetScopeType() (v string) {
+	return p.ScopeType
+}
+
+func (p *ListScopedPermissionsReq) GetScopeID() (v int64) {
+	return p.ScopeID
+}
+
+var fieldIDToName_ListScopedPermissionsReq = map[int16]string{
+	1: "scope_type",
+	2: "scope_id",
+}
+
+func (p *ListScopedPermissionsReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetScopeType bool = false
+	var issetScopeID bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetScopeType = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetScopeID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetScopeType {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetScopeID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListScopedPermissionsReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ListScopedPermissionsReq[fieldId]))
+}
+
+func (p *ListScopedPermissionsReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ScopeType = _field
+	return nil
+}
+func (p *ListScopedPermissionsReq) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ScopeID = _field
+	return nil
+}
+
+func (p *ListScopedPermissionsReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ListScopedPermissionsReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ListScopedPermissionsReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("scope_type", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.ScopeType); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ListScopedPermissionsReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("scope_id", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ScopeID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *ListScopedPermissionsReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListScopedPermissionsReq(%+v)", *p)
+
+}
+
+type ListScopedPermissionsResp struct {
+	BaseResp  *module.BaseResp           `thrift:"base_resp,1,required" form:"base_resp,required" json:"base_resp,required" query:"base_resp,required"`
+	GrantList []*module.ScopedPermission `thrift:"grant_list,2,required,list<module.ScopedPermission>" form:"grant_list,required" json:"grant_list,required" query:"grant_list,required"`
+}
+
+func NewListScopedPermissionsResp() *ListScopedPermissionsResp {
+	return &ListScopedPermissionsResp{}
+}
+
+func (p *ListScopedPermissionsResp) InitDefault() {
+}
+
+var ListScopedPermissionsResp_BaseResp_DEFAULT *module.BaseResp
+
+func (p *ListScopedPermissionsResp) GetBaseResp() (v *module.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return ListScopedPermissionsResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *ListScopedPermissionsResp) GetGrantList() (v []*module.ScopedPermission) {
+	return p.GrantList
+}
+
+var fieldIDToName_ListScopedPermissionsResp = map[int16]string{
+	1: "base_resp",
+	2: "grant_list",
+}
+
+func (p *ListScopedPermissionsResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *ListScopedPermissionsResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetBaseResp bool = false
+	var issetGrantList bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetBaseResp = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetGrantList = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetBaseResp {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetGrantList {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListScopedPermissionsResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ListScopedPermissionsResp[fieldId]))
+}
+
+func (p *ListScopedPermissionsResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := module.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+func (p *ListScopedPermissionsResp) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*module.ScopedPermission, 0, size)
+	values := make([]module.ScopedPermission, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.GrantList = _field
+	return nil
+}
+
+func (p *ListScopedPermissionsResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ListScopedPermissionsResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ListScopedPermissionsResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ListScopedPermissionsResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("grant_list", thrift.LIST, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.GrantList)); err != nil {
+		return err
+	}
+	for _, v := range p.GrantList {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *ListScopedPermissionsResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListScopedPermissionsResp(%+v)", *p)
+
+}
+
+type ListMyModerationActionsReq struct {
+	PageNum  int32 `thrift:"page_num,1,required" form:"page_num,required" json:"page_num,required" query:"page_num,required"`
+	PageSize int32 `thrift:"page_size,2,required" form:"page_size,required" json:"page_size,required" query:"page_size,required"`
+}
+
+func NewListMyModerationActionsReq() *ListMyModerationActionsReq {
+	return &ListMyModerationActionsReq{}
+}
+
+func (p *ListMyModerationActionsReq) InitDefault() {
+}
+
+func (p *ListMyModerationActionsReq) GetPageNum() (v int32) {
+	return p.PageNum
+}
+
+func (p *ListMyModerationActionsReq) GetPageSize() (v int32) {
+	return p.PageSize
+}
+
+var fieldIDToName_ListMyModerationActionsReq = map[int16]string{
+	1: "page_num",
+	2: "page_size",
+}
+
+func (p *ListMyModerationActionsReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetPageNum bool = false
+	var issetPageSize bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetPageNum = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetPageSize = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetPageNum {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetPageSize {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListMyModerationActionsReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ListMyModerationActionsReq[fieldId]))
+}
+
+func (p *ListMyModerationActionsReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.PageNum = _field
+	return nil
+}
+func (p *ListMyModerationActionsReq) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.PageSize = _field
+	return nil
+}
+
+func (p *ListMyModerationActionsReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ListMyModerationActionsReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ListMyModerationActionsReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("page_num", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.PageNum); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ListMyModerationActionsReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("page_size", thrift.I32, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.PageSize); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *ListMyModerationActionsReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListMyModerationActionsReq(%+v)", *p)
+
+}
+
+type ListMyModerationActionsResp struct {
+	BaseResp   *module.BaseResp           `thrift:"base_resp,1,required" form:"base_resp,required" json:"base_resp,required" query:"base_resp,required"`
+	ActionList []*module.ModerationAction `thrift:"action_list,2,required,list<module.ModerationAction>" form:"action_list,required" json:"action_list,required" query:"action_list,required"`
+	Total      int64                      `thrift:"total,3,required" form:"total,required" json:"total,required" query:"total,required"`
+}
+
+func NewListMyModerationActionsResp() *ListMyModerationActionsResp {
+	return &ListMyModerationActionsResp{}
+}
+
+func (p *ListMyModerationActionsResp) InitDefault() {
+}
+
+var ListMyModerationActionsResp_BaseResp_DEFAULT *module.BaseResp
+
+func (p *ListMyModerationActionsResp) GetBaseResp() (v *module.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return ListMyModerationActionsResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *ListMyModerationActionsResp) GetActionList() (v []*module.ModerationAction) {
+	return p.ActionList
+}
+
+func (p *ListMyModerationActionsResp) GetTotal() (v int64) {
+	return p.Total
+}
+
+var fieldIDToName_ListMyModerationActionsResp = map[int16]string{
+	1: "base_resp",
+	2: "action_list",
+	3: "total",
+}
+
+func (p *ListMyModerationActionsResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *ListMyModerationActionsResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetBaseResp bool = false
+	var issetActionList bool = false
+	var issetTotal bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetBaseResp = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetActionList = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetTotal = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetBaseResp {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetActionList {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetTotal {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListMyModerationActionsResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ListMyModerationActionsResp[fieldId]))
+}
+
+func (p *ListMyModerationActionsResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := module.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+func (p *ListMyModerationActionsResp) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*module.ModerationAction, 0, size)
+	values := make([]module.ModerationAction, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.ActionList = _field
+	return nil
+}
+func (p *ListMyModerationActionsResp) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Total = _field
+	return nil
+}
+
+func (p *ListMyModerationActionsResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ListMyModerationActionsResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ListMyModerationActionsResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ListMyModerationActionsResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("action_list", thrift.LIST, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.ActionList)); err != nil {
+		return err
+	}
+	for _, v := range p.ActionList {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *ListMyModerationActionsResp) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("total", thrift.I64, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.Total); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *ListMyModerationActionsResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListMyModerationActionsResp(%+v)", *p)
+
+}
+
+// 对处罚提起申诉，由原审核员以外的审核员复核
+type AppealModerationActionReq struct {
+	ActionID int64  `thrift:"action_id,1,required" json:"action_id,required" path:"action_id,required"`
+	Reason   string `thrift:"reason,2,required" form:"reason,required" json:"reason,required" query:"reason,required"`
+}
+
+func NewAppealModerationActionReq() *AppealModerationActionReq {
+	return &AppealModerationActionReq{}
+}
+
+func (p *AppealModerationActionReq) InitDefault() {
+}
+
+func (p *AppealModerationActionReq) GetActionID() (v int64) {
+	return p.ActionID
+}
+
+func (p *AppealModerationActionReq) GetReason() (v string) {
+	return p.Reason
+}
+
+var fieldIDToName_AppealModerationActionReq = map[int16]string{
+	1: "action_id",
+	2: "reason",
+}
+
+func (p *AppealModerationActionReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetActionID bool = false
+	var issetReason bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetActionID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetReason = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetActionID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetReason {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AppealModerationActionReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_AppealModerationActionReq[fieldId]))
+}
+
+func (p *AppealModerationActionReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ActionID = _field
+	return nil
+}
+func (p *AppealModerationActionReq) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Reason = _field
+	return nil
+}
+
+func (p *AppealModerationActionReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AppealModerationActionReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AppealModerationActionReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("action_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ActionID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *AppealModerationActionReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("reason", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Reason); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *AppealModerationActionReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AppealModerationActionReq(%+v)", *p)
+
+}
+
+type AppealModerationActionResp struct {
+	BaseResp       *module.BaseResp `thrift:"base_resp,1,required" form:"base_resp,required" json:"base_resp,required" query:"base_resp,required"`
+	AppealReviewID int64            `thrift:"appeal_review_id,2,required" form:"appeal_review_id,required" json:"appeal_review_id,required" query:"appeal_review_id,required"`
+}
+
+func NewAppealModerationActionResp() *AppealModerationActionResp {
+	return &AppealModerationActionResp{}
+}
+
+func (p *AppealModerationActionResp) InitDefault() {
+}
+
+var AppealModerationActionResp_BaseResp_DEFAULT *module.BaseResp
+
+func (p *AppealModerationActionResp) GetBaseResp() (v *module.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return AppealModerationActionResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *AppealModerationActionResp) GetAppealReviewID() (v int64) {
+	return p.AppealReviewID
+}
+
+var fieldIDToName_AppealModerationActionResp = map[int16]string{
+	1: "base_resp",
+	2: "appeal_review_id",
+}
+
+func (p *AppealModerationActionResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *AppealModerationActionResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetBaseResp bool = false
+	var issetAppealReviewID bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetBaseResp = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetAppealReviewID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetBaseResp {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetAppealReviewID {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AppealModerationActionResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_AppealModerationActionResp[fieldId]))
+}
+
+func (p *AppealModerationActionResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := module.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+func (p *AppealModerationActionResp) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.AppealReviewID = _field
+	return nil
+}
+
+func (p *AppealModerationActionResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AppealModerationActionResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AppealModerationActionResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *AppealModerationActionResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("appeal_review_id", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.AppealReviewID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *AppealModerationActionResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AppealModerationActionResp(%+v)", *p)
+
+}
+
+type ListNotificationsReq struct {
+	PageNum    int32 `thrift:"page_num,1,required" form:"page_num,required" json:"page_num,required" query:"page_num,required"`
+	PageSize   int32 `thrift:"page_size,2,required" form:"page_size,required" json:"page_size,required" query:"page_size,required"`
+	UnreadOnly *bool `thrift:"unread_only,3,optional" form:"unread_only" json:"unread_only,omitempty" query:"unread_only"`
+}
+
+func NewListNotificationsReq() *ListNotificationsReq {
+	return &ListNotificationsReq{}
+}
+
+func (p *ListNotificationsReq) InitDefault() {
+}
+
+func (p *ListNotificationsReq) GetPageNum() (v int32) {
+	return p.PageNum
+}
+
+func (p *ListNotificationsReq) GetPageSize() (v int32) {
+	return p.PageSize
+}
+
+var ListNotificationsReq_UnreadOnly_DEFAULT bool
+
+func (p *ListNotificationsReq) GetUnreadOnly() (v bool) {
+	if !p.IsSetUnreadOnly() {
+		return ListNotificationsReq_UnreadOnly_DEFAULT
+	}
+	return *p.UnreadOnly
+}
+
+var fieldIDToName_ListNotificationsReq = map[int16]string{
+	1: "page_num",
+	2: "page_size",
+	3: "unread_only",
+}
+
+func (p *ListNotificationsReq) IsSetUnreadOnly() bool {
+	return p.UnreadOnly != nil
+}
+
+func (p *ListNotificationsReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetPageNum bool = false
+	var issetPageSize bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetPageNum = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetPageSize = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetPageNum {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetPageSize {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListNotificationsReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ListNotificationsReq[fieldId]))
+}
+
+func (p *ListNotificationsReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.PageNum = _field
+	return nil
+}
+func (p *ListNotificationsReq) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.PageSize = _field
+	return nil
+}
+func (p *ListNotificationsReq) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.UnreadOnly = _field
+	return nil
+}
+
+func (p *ListNotificationsReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ListNotificationsReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ListNotificationsReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("page_num", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.PageNum); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ListNotificationsReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("page_size", thrift.I32, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.PageSize); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *ListNotificationsReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetUnreadOnly() {
+		if err = oprot.WriteFieldBegin("unread_only", thrift.BOOL, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.UnreadOnly); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *ListNotificationsReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListNotificationsReq(%+v)", *p)
+
+}
+
+type ListNotificationsResp struct {
+	BaseResp         *module.BaseResp       `thrift:"base_resp,1,required" form:"base_resp,required" json:"base_resp,required" query:"base_resp,required"`
+	NotificationList []*module.Notification `thrift:"notification_list,2,required,list<module.Notification>" form:"notification_list,required" json:"notification_list,required" query:"notification_list,required"`
+	Total            int64                  `thrift:"total,3,required" form:"total,required" json:"total,required" query:"total,required"`
+	Unread           int64                  `thrift:"unread,4,required" form:"unread,required" json:"unread,required" query:"unread,required"`
+}
+
+func NewListNotificationsResp() *ListNotificationsResp {
+	return &ListNotificationsResp{}
+}
+
+func (p *ListNotificationsResp) InitDefault() {
+}
+
+var ListNotificationsResp_BaseResp_DEFAULT *module.BaseResp
+
+func (p *ListNotificationsResp) GetBaseResp() (v *module.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return ListNotificationsResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *ListNotificationsResp) GetNotificationList() (v []*module.Notification) {
+	return p.NotificationList
+}
+
+func (p *ListNotificationsResp) GetTotal() (v int64) {
+	return p.Total
+}
+
+func (p *ListNotificationsResp) GetUnread() (v int64) {
+	return p.Unread
+}
+
+var fieldIDToName_ListNotificationsResp = map[int16]string{
+	1: "base_resp",
+	2: "notification_list",
+	3: "total",
+	4: "unread",
+}
+
+func (p *ListNotificationsResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *ListNotificationsResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetBaseResp bool = false
+	var issetNotificationList bool = false
+	var issetTotal bool = false
+	var issetUnread bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetBaseResp = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetNotificationList = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetTotal = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetUnread = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetBaseResp {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetNotificationList {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetTotal {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetUnread {
+		fieldId = 4
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListNotificationsResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ListNotificationsResp[fieldId]))
+}
+
+func (p *ListNotificationsResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := module.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+func (p *ListNotificationsResp) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*module.Notification, 0, size)
+	values := make([]module.Notification, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.NotificationList = _field
+	return nil
+}
+func (p *ListNotificationsResp) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Total = _field
+	return nil
+}
+func (p *ListNotificationsResp) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Unread = _field
+	return nil
+}
+
+func (p *ListNotificationsResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ListNotificationsResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ListNotificationsResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ListNotificationsResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("notification_list", thrift.LIST, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.NotificationList)); err != nil {
+		return err
+	}
+	for _, v := range p.NotificationList {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *ListNotificationsResp) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("total", thrift.I64, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.Total); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *ListNotificationsResp) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("unread", thrift.I64, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.Unread); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *ListNotificationsResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListNotificationsResp(%+v)", *p)
+
+}
+
+// 标记通知为已读，notification_ids 为空时全部标记
+type MarkNotificationsReadReq struct {
+	NotificationIds []int64 `thrift:"notification_ids,1,optional,list<i64>" form:"notification_ids" json:"notification_ids,omitempty" query:"notification_ids"`
+}
+
+func NewMarkNotificationsReadReq() *MarkNotificationsReadReq {
+	return &MarkNotificationsReadReq{}
+}
+
+func (p *MarkNotificationsReadReq) InitDefault() {
+}
+
+var MarkNotificationsReadReq_NotificationIds_DEFAULT []int64
+
+func (p *MarkNotificationsReadReq) GetNotificationIds() (v []int64) {
+	if !p.IsSetNotificationIds() {
+		return MarkNotificationsReadReq_NotificationIds_DEFAULT
+	}
+	return p.NotificationIds
+}
+
+var fieldIDToName_MarkNotificationsReadReq = map[int16]string{
+	1: "notification_ids",
+}
+
+func (p *MarkNotificationsReadReq) IsSetNotificationIds() bool {
+	return p.NotificationIds != nil
+}
+
+func (p *MarkNotificationsReadReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MarkNotificationsReadReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *MarkNotificationsReadReq) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]int64, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem int64
+		if v, err := iprot.ReadI64(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.NotificationIds = _field
+	return nil
+}
+
+func (p *MarkNotificationsReadReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("MarkNotificationsReadReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *MarkNotificationsReadReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetNotificationIds() {
+		if err = oprot.WriteFieldBegin("notification_ids", thrift.LIST, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.I64, len(p.NotificationIds)); err != nil {
+			return err
+		}
+		for _, v := range p.NotificationIds {
+			if err := oprot.WriteI64(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *MarkNotificationsReadReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("MarkNotificationsReadReq(%+v)", *p)
+
+}
+
+type MarkNotificationsReadResp struct {
+	BaseResp *module.BaseResp `thrift:"base_resp,1,required" form:"base_resp,required" json:"base_resp,required" query:"base_resp,required"`
+}
+
+func NewMarkNotificationsReadResp() *MarkNotificationsReadResp {
+	return &MarkNotificationsReadResp{}
+}
+
+func (p *MarkNotificationsReadResp) InitDefault() {
+}
+
+var MarkNotificationsReadResp_BaseResp_DEFAULT *module.BaseResp
+
+func (p *MarkNotificationsReadResp) GetBaseResp() (v *module.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return MarkNotificationsReadResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+var fieldIDToName_MarkNotificationsReadResp = map[int16]string{
+	1: "base_resp",
+}
+
+func (p *MarkNotificationsReadResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *MarkNotificationsReadResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetBaseResp bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetBaseResp = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetBaseResp {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MarkNotificationsReadResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_MarkNotificationsReadResp[fieldId]))
+}
+
+func (p *MarkNotificationsReadResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := module.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+
+func (p *MarkNotificationsReadResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("MarkNotificationsReadResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *MarkNotificationsReadResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *MarkNotificationsReadResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("MarkNotificationsReadResp(%+v)", *p)
+
+}
+
+type ListMyReputationRecordsReq struct {
+	PageNum  int32 `thrift:"page_num,1,required" form:"page_num,required" json:"page_num,required" query:"page_num,required"`
+	PageSize int32 `thrift:"page_size,2,required" form:"page_size,required" json:"page_size,required" query:"page_size,required"`
+}
+
+func NewListMyReputationRecordsReq() *ListMyReputationRecordsReq {
+	return &ListMyReputationRecordsReq{}
+}
+
+func (p *ListMyReputationRecordsReq) InitDefault() {
+}
+
+func (p *ListMyReputationRecordsReq) GetPageNum() (v int32) {
+	return p.PageNum
+}
+
+func (p *ListMyReputationRecordsReq) GetPageSize() (v int32) {
+	return p.PageSize
+}
+
+var fieldIDToName_ListMyReputationRecordsReq = map[int16]string{
+	1: "page_num",
+	2: "page_size",
+}
+
+func (p *ListMyReputationRecordsReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetPageNum bool = false
+	var issetPageSize bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetPageNum = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetPageSize = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetPageNum {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetPageSize {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListMyReputationRecordsReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ListMyReputationRecordsReq[fieldId]))
+}
+
+func (p *ListMyReputationRecordsReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.PageNum = _field
+	return nil
+}
+func (p *ListMyReputationRecordsReq) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.PageSize = _field
+	return nil
+}
+
+func (p *ListMyReputationRecordsReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ListMyReputationRecordsReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ListMyReputationRecordsReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("page_num", thrift.I32, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.PageNum); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ListMyReputationRecordsReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("page_size", thrift.I32, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.PageSize); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *ListMyReputationRecordsReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListMyReputationRecordsReq(%+v)", *p)
+
+}
+
+type ListMyReputationRecordsResp struct {
+	BaseResp        *module.BaseResp           `thrift:"base_resp,1,required" form:"base_resp,required" json:"base_resp,required" query:"base_resp,required"`
+	ReputationScore int64                      `thrift:"reputation_score,2,required" form:"reputation_score,required" json:"reputation_score,required" query:"reputation_score,required"`
+	RecordList      []*module.ReputationRecord `thrift:"record_list,3,required,list<module.ReputationRecord>" form:"record_list,required" json:"record_list,required" query:"record_list,required"`
+	Total           int64                      `thrift:"total,4,required" form:"total,required" json:"total,required" query:"total,required"`
+}
+
+func NewListMyReputationRecordsResp() *ListMyReputationRecordsResp {
+	return &ListMyReputationRecordsResp{}
+}
+
+func (p *ListMyReputationRecordsResp) InitDefault() {
+}
+
+var ListMyReputationRecordsResp_BaseResp_DEFAULT *module.BaseResp
+
+func (p *ListMyReputationRecordsResp) GetBaseResp() (v *module.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return ListMyReputationRecordsResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *ListMyReputationRecordsResp) GetReputationScore() (v int64) {
+	return p.ReputationScore
+}
+
+func (p *ListMyReputationRecordsResp) GetRecordList() (v []*module.ReputationRecord) {
+	return p.RecordList
+}
+
+func (p *ListMyReputationRecordsResp) GetTotal() (v int64) {
+	return p.Total
+}
+
+var fieldIDToName_ListMyReputationRecordsResp = map[int16]string{
+	1: "base_resp",
+	2: "reputation_score",
+	3: "record_list",
+	4: "total",
+}
+
+func (p *ListMyReputationRecordsResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *ListMyReputationRecordsResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetBaseResp bool = false
+	var issetReputationScore bool = false
+	var issetRecordList bool = false
+	var issetTotal bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetBaseResp = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetReputationScore = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetRecordList = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetTotal = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetBaseResp {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetReputationScore {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetRecordList {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetTotal {
+		fieldId = 4
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListMyReputationRecordsResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ListMyReputationRecordsResp[fieldId]))
+}
+
+func (p *ListMyReputationRecordsResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := module.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+func (p *ListMyReputationRecordsResp) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ReputationScore = _field
+	return nil
+}
+func (p *ListMyReputationRecordsResp) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*module.ReputationRecord, 0, size)
+	values := make([]module.ReputationRecord, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.RecordList = _field
+	return nil
+}
+func (p *ListMyReputationRecordsResp) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Total = _field
+	return nil
+}
+
+func (p *ListMyReputationRecordsResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ListMyReputationRecordsResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ListMyReputationRecordsResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ListMyReputationRecordsResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("reputation_score", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ReputationScore); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *ListMyReputationRecordsResp) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("record_list", thrift.LIST, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.RecordList)); err != nil {
+		return err
+	}
+	for _, v := range p.RecordList {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *ListMyReputationRecordsResp) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("total", thrift.I64, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.Total); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *ListMyReputationRecordsResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListMyReputationRecordsResp(%+v)", *p)
+
+}
+
+// 管理员手动调整用户信誉分，delta 为负表示扣除
+type AdjustUserReputationReq struct {
+	UserID int64  `thrift:"user_id,1,required" json:"user_id,required" path:"user_id,required"`
+	Delta  int64  `thrift:"delta,2,required" form:"delta,required" json:"delta,required" query:"delta,required"`
+	Reason string `thrift:"reason,3,required" form:"reason,required" json:"reason,required" query:"reason,required"`
+}
+
+func NewAdjustUserReputationReq() *AdjustUserReputationReq {
+	return &AdjustUserReputationReq{}
+}
+
+func (p *AdjustUserReputationReq) InitDefault() {
+}
+
+func (p *AdjustUserReputationReq) GetUserID() (v int64) {
+	return p.UserID
+}
+
+func (p *AdjustUserReputationReq) GetDelta() (v int64) {
+	return p.Delta
+}
+
+func (p *AdjustUserReputationReq) GetReason() (v string) {
+	return p.Reason
+}
+
+var fieldIDToName_AdjustUserReputationReq = map[int16]string{
+	1: "user_id",
+	2: "delta",
+	3: "reason",
+}
+
+func (p *AdjustUserReputationReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetUserID bool = false
+	var issetDelta bool = false
+	var issetReason bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetUserID = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetDelta = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetReason = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetUserID {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetDelta {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetReason {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdjustUserReputationReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_AdjustUserReputationReq[fieldId]))
+}
+
+func (p *AdjustUserReputationReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.UserID = _field
+	return nil
+}
+func (p *AdjustUserReputationReq) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Delta = _field
+	return nil
+}
+func (p *AdjustUserReputationReq) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Reason = _field
+	return nil
+}
+
+func (p *AdjustUserReputationReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AdjustUserReputationReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AdjustUserReputationReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.UserID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *AdjustUserReputationReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("delta", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.Delta); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *AdjustUserReputationReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("reason", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Reason); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *AdjustUserReputationReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AdjustUserReputationReq(%+v)", *p)
+
+}
+
+type AdjustUserReputationResp struct {
+	BaseResp        *module.BaseResp         `thrift:"base_resp,1,required" form:"base_resp,required" json:"base_resp,required" query:"base_resp,required"`
+	ReputationScore int64                    `thrift:"reputation_score,2,required" form:"reputation_score,required" json:"reputation_score,required" query:"reputation_score,required"`
+	Record          *module.ReputationRecord `thrift:"record,3,required" form:"record,required" json:"record,required" query:"record,required"`
+}
+
+func NewAdjustUserReputationResp() *AdjustUserReputationResp {
+	return &AdjustUserReputationResp{}
+}
+
+func (p *AdjustUserReputationResp) InitDefault() {
+}
+
+var AdjustUserReputationResp_BaseResp_DEFAULT *module.BaseResp
+
+func (p *AdjustUserReputationResp) GetBaseResp() (v *module.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return AdjustUserReputationResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *AdjustUserReputationResp) GetReputationScore() (v int64) {
+	return p.ReputationScore
+}
+
+var AdjustUserReputationResp_Record_DEFAULT *module.ReputationRecord
+
+func (p *AdjustUserReputationResp) GetRecord() (v *module.ReputationRecord) {
+	if !p.IsSetRecord() {
+		return AdjustUserReputationResp_Record_DEFAULT
+	}
+	return p.Record
+}
+
+var fieldIDToName_AdjustUserReputationResp = map[int16]string{
+	1: "base_resp",
+	2: "reputation_score",
+	3: "record",
+}
+
+func (p *AdjustUserReputationResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *AdjustUserReputationResp) IsSetRecord() bool {
+	return p.Record != nil
+}
+
+func (p *AdjustUserReputationResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetBaseResp bool = false
+	var issetReputationScore bool = false
+	var issetRecord bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetBaseResp = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetReputationScore = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetRecord = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetBaseResp {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetReputationScore {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetRecord {
+		fieldId = 3
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AdjustUserReputationResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_AdjustUserReputationResp[fieldId]))
+}
+
+func (p *AdjustUserReputationResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := module.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+func (p *AdjustUserReputationResp) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ReputationScore = _field
+	return nil
+}
+func (p *AdjustUserReputationResp) ReadField3(iprot thrift.TProtocol) error {
+	_field := module.NewReputationRecord()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Record = _field
+	return nil
+}
+
+func (p *AdjustUserReputationResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AdjustUserReputationResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AdjustUserReputationResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *AdjustUserReputationResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("reputation_score", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ReputationScore); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *AdjustUserReputationResp) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("record", thrift.STRUCT, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Record.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *AdjustUserReputationResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AdjustUserReputationResp(%+v)", *p)
+
+}
+
+// 当前用户的全部成就，包含已达成与进行中的进度
+type ListMyAchievementsReq struct {
+}
+
+func NewListMyAchievementsReq() *ListMyAchievementsReq {
+	return &ListMyAchievementsReq{}
+}
+
+func (p *ListMyAchievementsReq) InitDefault() {
+}
+
+var fieldIDToName_ListMyAchievementsReq = map[int16]string{}
+
+func (p *ListMyAchievementsReq) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		if err = iprot.Skip(fieldTypeId); err != nil {
+			goto SkipFieldTypeError
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+SkipFieldTypeError:
+	return thrift.PrependError(fmt.Sprintf("%T skip field type %d error", p, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ListMyAchievementsReq) Write(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteStructBegin("ListMyAchievementsReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ListMyAchievementsReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListMyAchievementsReq(%+v)", *p)
+
+}
+
+type ListMyAchievementsResp struct {
+	BaseResp        *module.BaseResp      `thrift:"base_resp,1,required" form:"base_resp,required" json:"base_resp,required" query:"base_resp,required"`
+	AchievementList []*module.Achievement `thrift:"achievement_list,2,required,list<module.Achievement>" form:"achievement_list,required" json:"achievement_list,required" query:"achievement_list,required"`
+}
+
+func NewListMyAchievementsResp() *ListMyAchievementsResp {
+	return &ListMyAchievementsResp{}
+}
+
+func (p *ListMyAchievementsResp) InitDefault() {
+}
+
+var ListMyAchievementsResp_BaseResp_DEFAULT *module.BaseResp
+
+func (p *ListMyAchievementsResp) GetBaseResp() (v *module.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return ListMyAchievementsResp_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *ListMyAchievementsResp) GetAchievementList() (v []*module.Achievement) {
+	return p.AchievementList
+}
+
+var fieldIDToName_ListMyAchievementsResp = map[int16]string{
+	1: "base_resp",
+	2: "achievement_list",
+}
+
+func (p *ListMyAchievementsResp) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *ListMyAchievementsResp) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetBaseResp bool = false
+	var issetAchievementList bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetBaseResp = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetAchievementList = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetBaseResp {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetAchievementList {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListMyAchievementsResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ListMyAchievementsResp[fieldId]))
+}
+
+func (p *ListMyAchievementsResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := module.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+func (p *ListMyAchievementsResp) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*module.Achievement, 0, size)
+	values := make([]module.Achievement, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.AchievementList = _field
+	return nil
+}
+
+func (p *ListMyAchievementsResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ListMyAchievementsResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ListMyAchievementsResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base_resp", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.BaseResp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ListMyAchievementsResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("achievement_list", thrift.LIST, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.AchievementList)); err != nil {
+		return err
+	}
+	for _, v := range p.AchievementList {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *ListMyAchievementsResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListMyAchievementsResp(%+v)", *p)
+
+}
+
+type UserService interface {
+	Register(ctx context.Context, req *RegisterReq) (r *RegisterResp, err error)
+
+	LoginIn(ctx context.Context, req *LoginInReq) (r *LoginInResp, err error)
+
+	LoginOut(ctx context.Context, req *LoginOutReq) (r *LoginOutResp, err error)
+
+	SendVerifyEmail(ctx context.Context, req *SendVerifyEmailReq) (r *SendVerifyEmailResp, err error)
+
+	VerifyEmail(ctx context.Context, req *VerifyEmailReq) (r *VerifyEmailResp, err error)
+
+	UpdateEmail(ctx context.Context, req *UpdateEmailReq) (r *UpdateEmailResp, err error)
+
+	UpdatePassword(ctx context.Context, req *UpdatePasswordReq) (r *UpdatePasswordResp, err error)
+
+	UpdateMajor(ctx context.Context, req *UpdateMajorReq) (r *UpdateMajorResp, err error)
+
+	UploadAvatar(ctx context.Context, req *UploadAvatarReq) (r *UploadAvatarResp, err error)
+
+	ResetPassword(ctx context.Context, req *ResetPasswordReq) (r *ResetPasswordResp, err error)
+
+	UnlockAccount(ctx context.Context, req *UnlockAccountReq) (r *UnlockAccountResp, err error)
+
+	ActivateAccount(ctx context.Context, req *ActivateAccountReq) (r *ActivateAccountResp, err error)
+
+	ResendActivation(ctx context.Context, req *ResendActivationReq) (r *ResendActivationResp, err error)
+
+	RefreshToken(ctx context.Context, req *RefreshTokenReq) (r *RefreshTokenResp, err error)
+
+	GetUserInfo(ctx context.Context, req *GetUserInfoReq) (r *GetUserInfoResp, err error)
+
+	OidcAuthorize(ctx context.Context, req *OIDCAuthorizeReq) (r *OIDCAuthorizeResp, err error)
+
+	OidcCallback(ctx context.Context, req *OIDCCallbackReq) (r *OIDCCallbackResp, err error)
+
+	GetCaptcha(ctx context.Context, req *GetCaptchaReq) (r *GetCaptchaResp, err error)
+
+	RevertEmailChange(ctx context.Context, req *RevertEmailChangeReq) (r *RevertEmailChangeResp, err error)
+
+	CreatePersonalToken(ctx context.Context, req *CreatePersonalTokenReq) (r *CreatePersonalTokenResp, err error)
+
+	ListPersonalTokens(ctx context.Context, req *ListPersonalTokensReq) (r *ListPersonalTokensResp, err error)
+
+	RevokePersonalToken(ctx context.Context, req *RevokePersonalTokenReq) (r *RevokePersonalTokenResp, err error)
+}
+
+type UserServiceClient struct {
+	c thrift.TClient
+}
+
+func NewUserServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *UserServiceClient {
+	return &UserServiceClient{
+		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+	}
+}
+
+func NewUserServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *UserServiceClient {
+	return &UserServiceClient{
+		c: thrift.NewTStandardClient(iprot, oprot),
+	}
+}
+
+func NewUserServiceClient(c thrift.TClient) *UserServiceClient {
+	return &UserServiceClient{
+		c: c,
+	}
+}
+
+func (p *UserServiceClient) Client_() thrift.TClient {
+	return p.c
+}
+
+func (p *UserServiceClient) Register(ctx context.Context, req *RegisterReq) (r *RegisterResp, err error) {
+	var _args UserServiceRegisterArgs
+	_args.Req = req
+	var _result UserServiceRegisterResult
+	if err = p.Client_().Call(ctx, "register", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *UserServiceClient) LoginIn(ctx context.Context, req *LoginInReq) (r *LoginInResp, err error) {
+	var _args UserServiceLoginInArgs
+	_args.Req = req
+	var _result UserServiceLoginInResult
+	if err = p.Client_().Call(ctx, "loginIn", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *UserServiceClient) LoginOut(ctx context.Context, req *LoginOutReq) (r *LoginOutResp, err error) {
+	var _args UserServiceLoginOutArgs
+	_args.Req = req
+	var _result UserServiceLoginOutResult
+	if err = p.Client_().Call(ctx, "loginOut", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *UserServiceClient) SendVerifyEmail(ctx context.Context, req *SendVerifyEmailReq) (r *SendVerifyEmailResp, err error) {
+	var _args UserServiceSendVerifyEmailArgs
+	_args.Req = req
+	var _result UserServiceSendVerifyEmailResult
+	if err = p.Client_().Call(ctx, "sendVerifyEmail", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *UserServiceClient) VerifyEmail(ctx context.Context, req *VerifyEmailReq) (r *VerifyEmailResp, err error) {
+	var _args UserServiceVerifyEmailArgs
+	_args.Req = req
+	var _result UserServiceVerifyEmailResult
+	if err = p.Client_().Call(ctx, "verifyEmail", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *UserServiceClient) UpdateEmail(ctx context.Context, req *UpdateEmailReq) (r *UpdateEmailResp, err error) {
+	var _args UserServiceUpdateEmailArgs
+	_args.Req = req
+	var _result UserServiceUpdateEmailResult
+	if err = p.Client_().Call(ctx, "updateEmail", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *UserServiceClient) UpdatePassword(ctx context.Context, req *UpdatePasswordReq) (r *UpdatePasswordResp, err error) {
+	var _args UserServiceUpdatePasswordArgs
+	_args.Req = req
+	var _result UserServiceUpdatePasswordResult
+	if err = p.Client_().Call(ctx, "updatePassword", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *UserServiceClient) UpdateMajor(ctx context.Context, req *UpdateMajorReq) (r *UpdateMajorResp, err error) {
+	var _args UserServiceUpdateMajorArgs
+	_args.Req = req
+	var _result UserServiceUpdateMajorResult
+	if err = p.Client_().Call(ctx, "updateMajor", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *UserServiceClient) UploadAvatar(ctx context.Context, req *UploadAvatarReq) (r *UploadAvatarResp, err error) {
+	var _args UserServiceUploadAvatarArgs
+	_args.Req = req
+	var _result UserServiceUploadAvatarResult
+	if err = p.Client_().Call(ctx, "uploadAvatar", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *UserServiceClient) ResetPassword(ctx context.Context, req *ResetPasswordReq) (r *ResetPasswordResp, err error) {
+	var _args UserServiceResetPasswordArgs
+	_args.Req = req
+	var _result UserServiceResetPasswordResult
+	if err = p.Client_().Call(ctx, "resetPassword", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *UserServiceClient) UnlockAccount(ctx context.Context, req *UnlockAccountReq) (r *UnlockAccountResp, err error) {
+	var _args UserServiceUnlockAccountArgs
+	_args.Req = req
+	var _result UserServiceUnlockAccountResult
+	if err = p.Client_().Call(ctx, "unlockAccount", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *UserServiceClient) ActivateAccount(ctx context.Context, req *ActivateAccountReq) (r *ActivateAccountResp, err error) {
+	var _args UserServiceActivateAccountArgs
+	_args.Req = req
+	var _result UserServiceActivateAccountResult
+	if err = p.Client_().Call(ctx, "activateAccount", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *UserServiceClient) ResendActivation(ctx context.Context, req *ResendActivationReq) (r *ResendActivationResp, err error) {
+	var _args UserServiceResendActivationArgs
+	_args.Req = req
+	var _result UserServiceResendActivationResult
+	if err = p.Client_().Call(ctx, "resendActivation", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *UserServiceClient) RefreshToken(ctx context.Context, req *RefreshTokenReq) (r *RefreshTokenResp, err error) {
+	var _args UserServiceRefreshTokenArgs
+	_args.Req = req
+	var _result UserServiceRefreshTokenResult
+	if err = p.Client_().Call(ctx, "refreshToken", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *UserServiceClient) GetUserInfo(ctx context.Context, req *GetUserInfoReq) (r *GetUserInfoResp, err error) {
+	var _args UserServiceGetUserInfoArgs
+	_args.Req = req
+	var _result UserServiceGetUserInfoResult
+	if err = p.Client_().Call(ctx, "getUserInfo", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *UserServiceClient) OidcAuthorize(ctx context.Context, req *OIDCAuthorizeReq) (r *OIDCAuthorizeResp, err error) {
+	var _args UserServiceOidcAuthorizeArgs
+	_args.Req = req
+	var _result UserServiceOidcAuthorizeResult
+	if err = p.Client_().Call(ctx, "oidcAuthorize", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *UserServiceClient) OidcCallback(ctx context.Context, req *OIDCCallbackReq) (r *OIDCCallbackResp, err error) {
+	var _args UserServiceOidcCallbackArgs
+	_args.Req = req
+	var _result UserServiceOidcCallbackResult
+	if err = p.Client_().Call(ctx, "oidcCallback", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *UserServiceClient) GetCaptcha(ctx context.Context, req *GetCaptchaReq) (r *GetCaptchaResp, err error) {
+	var _args UserServiceGetCaptchaArgs
+	_args.Req = req
+	var _result UserServiceGetCaptchaResult
+	if err = p.Client_().Call(ctx, "getCaptcha", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *UserServiceClient) RevertEmailChange(ctx context.Context, req *RevertEmailChangeReq) (r *RevertEmailChangeResp, err error) {
+	var _args UserServiceRevertEmailChangeArgs
+	_args.Req = req
+	var _result UserServiceRevertEmailChangeResult
+	if err = p.Client_().Call(ctx, "revertEmailChange", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *UserServiceClient) CreatePersonalToken(ctx context.Context, req *CreatePersonalTokenReq) (r *CreatePersonalTokenResp, err error) {
+	var _args UserServiceCreatePersonalTokenArgs
+	_args.Req = req
+	var _result UserServiceCreatePersonalTokenResult
+	if err = p.Client_().Call(ctx, "createPersonalToken", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *UserServiceClient) ListPersonalTokens(ctx context.Context, req *ListPersonalTokensReq) (r *ListPersonalTokensResp, err error) {
+	var _args UserServiceListPersonalTokensArgs
+	_args.Req = req
+	var _result UserServiceListPersonalTokensResult
+	if err = p.Client_().Call(ctx, "listPersonalTokens", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *UserServiceClient) RevokePersonalToken(ctx context.Context, req *RevokePersonalTokenReq) (r *RevokePersonalTokenResp, err error) {
+	var _args UserServiceRevokePersonalTokenArgs
+	_args.Req = req
+	var _result UserServiceRevokePersonalTokenResult
+	if err = p.Client_().Call(ctx, "revokePersonalToken", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+type UserAdminService interface {
+	AdminAddUser(ctx context.Context, req *AdminAddUserReq) (r *AdminAddUserResp, err error)
+
+	AdminUpdateUser(ctx context.Context, req *AdminUpdateUserReq) (r *AdminUpdateUserResp, err error)
+}
+
+type UserAdminServiceClient struct {
+	c thrift.TClient
+}
+
+func NewUserAdminServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *UserAdminServiceClient {
+	return &UserAdminServiceClient{
+		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+	}
+}
+
+func NewUserAdminServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *UserAdminServiceClient {
+	return &UserAdminServiceClient{
+		c: thrift.NewTStandardClient(iprot, oprot),
+	}
+}
+
+func NewUserAdminServiceClient(c thrift.TClient) *UserAdminServiceClient {
+	return &UserAdminServiceClient{
+		c: c,
+	}
+}
+
+func (p *UserAdminServiceClient) Client_() thrift.TClient {
+	return p.c
+}
+
+func (p *UserAdminServiceClient) AdminAddUser(ctx context.Context, req *AdminAddUserReq) (r *AdminAddUserResp, err error) {
+	var _args UserAdminServiceAdminAddUserArgs
+	_args.Req = req
+	var _result UserAdminServiceAdminAddUserResult
+	if err = p.Client_().Call(ctx, "AdminAddUser", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *UserAdminServiceClient) AdminUpdateUser(ctx context.Context, req *AdminUpdateUserReq) (r *AdminUpdateUserResp, err error) {
+	var _args UserAdminServiceAdminUpdateUserArgs
+	_args.Req = req
+	var _result UserAdminServiceAdminUpdateUserResult
+	if err = p.Client_().Call(ctx, "AdminUpdateUser", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+type RoleAdminService interface {
+	GetPermissionList(ctx context.Context, req *GetPermissionListReq) (r *GetPermissionListResp, err error)
+
+	GetRoleList(ctx context.Context, req *GetRoleListReq) (r *GetRoleListResp, err error)
+
+	AddRole(ctx context.Context, req *AddRoleReq) (r *AddRoleResp, err error)
+
+	UpdateRole(ctx context.Context, req *UpdateRoleReq) (r *UpdateRoleResp, err error)
+
+	DeleteRole(ctx context.Context, req *DeleteRoleReq) (r *DeleteRoleResp, err error)
+
+	GrantRolePermissions(ctx context.Context, req *GrantRolePermissionsReq) (r *GrantRolePermissionsResp, err error)
+
+	RevokeRolePermissions(ctx context.Context, req *RevokeRolePermissionsReq) (r *RevokeRolePermissionsResp, err error)
+
+	GetRoleUsers(ctx context.Context, req *GetRoleUsersReq) (r *GetRoleUsersResp, err error)
+}
+
+type RoleAdminServiceClient struct {
+	c thrift.TClient
+}
+
+func NewRoleAdminServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *RoleAdminServiceClient {
+	return &RoleAdminServiceClient{
+		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+	}
+}
+
+func NewRoleAdminServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *RoleAdminServiceClient {
+	return &RoleAdminServiceClient{
+		c: thrift.NewTStandardClient(iprot, oprot),
+	}
+}
+
+func NewRoleAdminServiceClient(c thrift.TClient) *RoleAdminServiceClient {
+	return &RoleAdminServiceClient{
+		c: c,
+	}
+}
+
+func (p *RoleAdminServiceClient) Client_() thrift.TClient {
+	return p.c
+}
+
+func (p *RoleAdminServiceClient) GetPermissionList(ctx context.Context, req *GetPermissionListReq) (r *GetPermissionListResp, err error) {
+	var _args RoleAdminServiceGetPermissionListArgs
+	_args.Req = req
+	var _result RoleAdminServiceGetPermissionListResult
+	if err = p.Client_().Call(ctx, "GetPermissionList", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *RoleAdminServiceClient) GetRoleList(ctx context.Context, req *GetRoleListReq) (r *GetRoleListResp, err error) {
+	var _args RoleAdminServiceGetRoleListArgs
+	_args.Req = req
+	var _result RoleAdminServiceGetRoleListResult
+	if err = p.Client_().Call(ctx, "GetRoleList", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *RoleAdminServiceClient) AddRole(ctx context.Context, req *AddRoleReq) (r *AddRoleResp, err error) {
+	var _args RoleAdminServiceAddRoleArgs
+	_args.Req = req
+	var _result RoleAdminServiceAddRoleResult
+	if err = p.Client_().Call(ctx, "AddRole", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *RoleAdminServiceClient) UpdateRole(ctx context.Context, req *UpdateRoleReq) (r *UpdateRoleResp, err error) {
+	var _args RoleAdminServiceUpdateRoleArgs
+	_args.Req = req
+	var _result RoleAdminServiceUpdateRoleResult
+	if err = p.Client_().Call(ctx, "UpdateRole", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *RoleAdminServiceClient) DeleteRole(ctx context.Context, req *DeleteRoleReq) (r *DeleteRoleResp, err error) {
+	var _args RoleAdminServiceDeleteRoleArgs
+	_args.Req = req
+	var _result RoleAdminServiceDeleteRoleResult
+	if err = p.Client_().Call(ctx, "DeleteRole", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *RoleAdminServiceClient) GrantRolePermissions(ctx context.Context, req *GrantRolePermissionsReq) (r *GrantRolePermissionsResp, err error) {
+	var _args RoleAdminServiceGrantRolePermissionsArgs
+	_args.Req = req
+	var _result RoleAdminServiceGrantRolePermissionsResult
+	if err = p.Client_().Call(ctx, "GrantRolePermissions", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *RoleAdminServiceClient) RevokeRolePermissions(ctx context.Context, req *RevokeRolePermissionsReq) (r *RevokeRolePermissionsResp, err error) {
+	var _args RoleAdminServiceRevokeRolePermissionsArgs
+	_args.Req = req
+	var _result RoleAdminServiceRevokeRolePermissionsResult
+	if err = p.Client_().Call(ctx, "RevokeRolePermissions", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *RoleAdminServiceClient) GetRoleUsers(ctx context.Context, req *GetRoleUsersReq) (r *GetRoleUsersResp, err error) {
+	var _args RoleAdminServiceGetRoleUsersArgs
+	_args.Req = req
+	var _result RoleAdminServiceGetRoleUsersResult
+	if err = p.Client_().Call(ctx, "GetRoleUsers", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+type ScopedPermissionService interface {
+	GrantScopedPermission(ctx context.Context, req *GrantScopedPermissionReq) (r *GrantScopedPermissionResp, err error)
+
+	RevokeScopedPermission(ctx context.Context, req *RevokeScopedPermissionReq) (r *RevokeScopedPermissionResp, err error)
+
+	ListScopedPermissions(ctx context.Context, req *ListScopedPermissionsReq) (r *ListScopedPermissionsResp, err error)
+}
+
+type ScopedPermissionServiceClient struct {
+	c thrift.TClient
+}
+
+func NewScopedPermissionServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *ScopedPermissionServiceClient {
+	return &ScopedPermissionServiceClient{
+		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+	}
+}
+
+func NewScopedPermissionServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *ScopedPermissionServiceClient {
+	return &ScopedPermissionServiceClient{
+		c: thrift.NewTStandardClient(iprot, oprot),
+	}
+}
+
+func NewScopedPermissionServiceClient(c thrift.TClient) *ScopedPermissionServiceClient {
+	return &ScopedPermissionServiceClient{
+		c: c,
+	}
+}
+
+func (p *ScopedPermissionServiceClient) Client_() thrift.TClient {
+	return p.c
+}
+
+func (p *ScopedPermissionServiceClient) GrantScopedPermission(ctx context.Context, req *GrantScopedPermissionReq) (r *GrantScopedPermissionResp, err error) {
+	var _args ScopedPermissionServiceGrantScopedPermissionArgs
+	_args.Req = req
+	var _result ScopedPermissionServiceGrantScopedPermissionResult
+	if err = p.Client_().Call(ctx, "GrantScopedPermission", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ScopedPermissionServiceClient) RevokeScopedPermission(ctx context.Context, req *RevokeScopedPermissionReq) (r *RevokeScopedPermissionResp, err error) {
+	var _args ScopedPermissionServiceRevokeScopedPermissionArgs
+	_args.Req = req
+	var _result ScopedPermissionServiceRevokeScopedPermissionResult
+	if err = p.Client_().Call(ctx, "RevokeScopedPermission", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ScopedPermissionServiceClient) ListScopedPermissions(ctx context.Context, req *ListScopedPermissionsReq) (r *ListScopedPermissionsResp, err error) {
+	var _args ScopedPermissionServiceListScopedPermissionsArgs
+	_args.Req = req
+	var _result ScopedPermissionServiceListScopedPermissionsResult
+	if err = p.Client_().Call(ctx, "ListScopedPermissions", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+type ModerationService interface {
+	ListMyModerationActions(ctx context.Context, req *ListMyModerationActionsReq) (r *ListMyModerationActionsResp, err error)
+
+	AppealModerationAction(ctx context.Context, req *AppealModerationActionReq) (r *AppealModerationActionResp, err error)
+}
+
+type ModerationServiceClient struct {
+	c thrift.TClient
+}
+
+func NewModerationServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *ModerationServiceClient {
+	return &ModerationServiceClient{
+		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+	}
+}
+
+func NewModerationServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *ModerationServiceClient {
+	return &ModerationServiceClient{
+		c: thrift.NewTStandardClient(iprot, oprot),
+	}
+}
+
+func NewModerationServiceClient(c thrift.TClient) *ModerationServiceClient {
+	return &ModerationServiceClient{
+		c: c,
+	}
+}
+
+func (p *ModerationServiceClient) Client_() thrift.TClient {
+	return p.c
+}
+
+func (p *ModerationServiceClient) ListMyModerationActions(ctx context.Context, req *ListMyModerationActionsReq) (r *ListMyModerationActionsResp, err error) {
+	var _args ModerationServiceListMyModerationActionsArgs
+	_args.Req = req
+	var _result ModerationServiceListMyModerationActionsResult
+	if err = p.Client_().Call(ctx, "ListMyModerationActions", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ModerationServiceClient) AppealModerationAction(ctx context.Context, req *AppealModerationActionReq) (r *AppealModerationActionResp, err error) {
+	var _args ModerationServiceAppealModerationActionArgs
+	_args.Req = req
+	var _result ModerationServiceAppealModerationActionResult
+	if err = p.Client_().Call(ctx, "AppealModerationAction", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+type NotificationService interface {
+	ListNotifications(ctx context.Context, req *ListNotificationsReq) (r *ListNotificationsResp, err error)
+
+	MarkNotificationsRead(ctx context.Context, req *MarkNotificationsReadReq) (r *MarkNotificationsReadResp, err error)
+}
+
+type NotificationServiceClient struct {
+	c thrift.TClient
+}
+
+func NewNotificationServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *NotificationServiceClient {
+	return &NotificationServiceClient{
+		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+	}
+}
+
+func NewNotificationServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *NotificationServiceClient {
+	return &NotificationServiceClient{
+		c: thrift.NewTStandardClient(iprot, oprot),
+	}
+}
+
+func NewNotificationServiceClient(c thrift.TClient) *NotificationServiceClient {
+	return &NotificationServiceClient{
+		c: c,
+	}
+}
+
+func (p *NotificationServiceClient) Client_() thrift.TClient {
+	return p.c
+}
+
+func (p *NotificationServiceClient) ListNotifications(ctx context.Context, req *ListNotificationsReq) (r *ListNotificationsResp, err error) {
+	var _args NotificationServiceListNotificationsArgs
+	_args.Req = req
+	var _result NotificationServiceListNotificationsResult
+	if err = p.Client_().Call(ctx, "ListNotifications", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *NotificationServiceClient) MarkNotificationsRead(ctx context.Context, req *MarkNotificationsReadReq) (r *MarkNotificationsReadResp, err error) {
+	var _args NotificationServiceMarkNotificationsReadArgs
+	_args.Req = req
+	var _result NotificationServiceMarkNotificationsReadResult
+	if err = p.Client_().Call(ctx, "MarkNotificationsRead", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+type ReputationService interface {
+	ListMyReputationRecords(ctx context.Context, req *ListMyReputationRecordsReq) (r *ListMyReputationRecordsResp, err error)
+
+	AdjustUserReputation(ctx context.Context, req *AdjustUserReputationReq) (r *AdjustUserReputationResp, err error)
+}
+
+type ReputationServiceClient struct {
+	c thrift.TClient
+}
+
+func NewReputationServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *ReputationServiceClient {
+	return &ReputationServiceClient{
+		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+	}
+}
+
+func NewReputationServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *ReputationServiceClient {
+	return &ReputationServiceClient{
+		c: thrift.NewTStandardClient(iprot, oprot),
+	}
+}
+
+func NewReputationServiceClient(c thrift.TClient) *ReputationServiceClient {
+	return &ReputationServiceClient{
+		c: c,
+	}
+}
+
+func (p *ReputationServiceClient) Client_() thrift.TClient {
+	return p.c
+}
+
+func (p *ReputationServiceClient) ListMyReputationRecords(ctx context.Context, req *ListMyReputationRecordsReq) (r *ListMyReputationRecordsResp, err error) {
+	var _args ReputationServiceListMyReputationRecordsArgs
+	_args.Req = req
+	var _result ReputationServiceListMyReputationRecordsResult
+	if err = p.Client_().Call(ctx, "ListMyReputationRecords", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *ReputationServiceClient) AdjustUserReputation(ctx context.Context, req *AdjustUserReputationReq) (r *AdjustUserReputationResp, err error) {
+	var _args ReputationServiceAdjustUserReputationArgs
+	_args.Req = req
+	var _result ReputationServiceAdjustUserReputationResult
+	if err = p.Client_().Call(ctx, "AdjustUserReputation", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+type AchievementService interface {
+	ListMyAchievements(ctx context.Context, req *ListMyAchievementsReq) (r *ListMyAchievementsResp, err error)
+}
+
+type AchievementServiceClient struct {
+	c thrift.TClient
+}
+
+func NewAchievementServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *AchievementServiceClient {
+	return &AchievementServiceClient{
+		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+	}
+}
+
+func NewAchievementServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *AchievementServiceClient {
+	return &AchievementServiceClient{
+		c: thrift.NewTStandardClient(iprot, oprot),
+	}
+}
+
+func NewAchievementServiceClient(c thrift.TClient) *AchievementServiceClient {
+	return &AchievementServiceClient{
+		c: c,
+	}
+}
+
+func (p *AchievementServiceClient) Client_() thrift.TClient {
+	return p.c
+}
+
+func (p *AchievementServiceClient) ListMyAchievements(ctx context.Context, req *ListMyAchievementsReq) (r *ListMyAchievementsResp, err error) {
+	var _args AchievementServiceListMyAchievementsArgs
+	_args.Req = req
+	var _result AchievementServiceListMyAchievementsResult
+	if err = p.Client_().Call(ctx, "ListMyAchievements", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+type UserServiceProcessor struct {
+	processorMap map[string]thrift.TProcessorFunction
+	handler      UserService
+}
+
+func (p *UserServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
+	p.processorMap[key] = processor
+}
+
+func (p *UserServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
+	processor, ok = p.processorMap[key]
+	return processor, ok
+}
+
+func (p *UserServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
+	return p.processorMap
+}
+
+func NewUserServiceProcessor(handler UserService) *UserServiceProcessor {
+	self := &UserServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
+	self.AddToProcessorMap("register", &userServiceProcessorRegister{handler: handler})
+	self.AddToProcessorMap("loginIn", &userServiceProcessorLoginIn{handler: handler})
+	self.AddToProcessorMap("loginOut", &userServiceProcessorLoginOut{handler: handler})
+	self.AddToProcessorMap("sendVerifyEmail", &userServiceProcessorSendVerifyEmail{handler: handler})
+	self.AddToProcessorMap("verifyEmail", &userServiceProcessorVerifyEmail{handler: handler})
+	self.AddToProcessorMap("updateEmail", &userServiceProcessorUpdateEmail{handler: handler})
+	self.AddToProcessorMap("updatePassword", &userServiceProcessorUpdatePassword{handler: handler})
+	self.AddToProcessorMap("updateMajor", &userServiceProcessorUpdateMajor{handler: handler})
+	self.AddToProcessorMap("uploadAvatar", &userServiceProcessorUploadAvatar{handler: handler})
+	self.AddToProcessorMap("resetPassword", &userServiceProcessorResetPassword{handler: handler})
+	self.AddToProcessorMap("unlockAccount", &userServiceProcessorUnlockAccount{handler: handler})
+	self.AddToProcessorMap("activateAccount", &userServiceProcessorActivateAccount{handler: handler})
+	self.AddToProcessorMap("resendActivation", &userServiceProcessorResendActivation{handler: handler})
+	self.AddToProcessorMap("refreshToken", &userServiceProcessorRefreshToken{handler: handler})
+	self.AddToProcessorMap("getUserInfo", &userServiceProcessorGetUserInfo{handler: handler})
+	self.AddToProcessorMap("oidcAuthorize", &userServiceProcessorOidcAuthorize{handler: handler})
+	self.AddToProcessorMap("oidcCallback", &userServiceProcessorOidcCallback{handler: handler})
+	self.AddToProcessorMap("getCaptcha", &userServiceProcessorGetCaptcha{handler: handler})
+	self.AddToProcessorMap("revertEmailChange", &userServiceProcessorRevertEmailChange{handler: handler})
+	self.AddToProcessorMap("createPersonalToken", &userServiceProcessorCreatePersonalToken{handler: handler})
+	self.AddToProcessorMap("listPersonalTokens", &userServiceProcessorListPersonalTokens{handler: handler})
+	self.AddToProcessorMap("revokePersonalToken", &userServiceProcessorRevokePersonalToken{handler: handler})
+	return self
+}
+func (p *UserServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	name, _, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return false, err
+	}
+	if processor, ok := p.GetProcessorFunction(name); ok {
+		return processor.Process(ctx, seqId, iprot, oprot)
+	}
+	iprot.Skip(thrift.STRUCT)
+	iprot.ReadMessageEnd()
+	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
+	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
+	x.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Flush(ctx)
+	return false, x
+}
+
+type userServiceProcessorRegister struct {
+	handler UserService
+}
+
+func (p *userServiceProcessorRegister) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserServiceRegisterArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("register", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := UserServiceRegisterResult{}
+	var retval *RegisterResp
+	if retval, err2 = p.handler.Register(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing register: "+err2.Error())
+		oprot.WriteMessageBegin("register", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("register", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type userServiceProcessorLoginIn struct {
+	handler UserService
+}
+
+func (p *userServiceProcessorLoginIn) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserServiceLoginInArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("loginIn", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := UserServiceLoginInResult{}
+	var retval *LoginInResp
+	if retval, err2 = p.handler.LoginIn(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing loginIn: "+err2.Error())
+		oprot.WriteMessageBegin("loginIn", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("loginIn", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type userServiceProcessorLoginOut struct {
+	handler UserService
+}
+
+func (p *userServiceProcessorLoginOut) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserServiceLoginOutArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("loginOut", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := UserServiceLoginOutResult{}
+	var retval *LoginOutResp
+	if retval, err2 = p.handler.LoginOut(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing loginOut: "+err2.Error())
+		oprot.WriteMessageBegin("loginOut", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("loginOut", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type userServiceProcessorSendVerifyEmail struct {
+	handler UserService
+}
+
+func (p *userServiceProcessorSendVerifyEmail) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserServiceSendVerifyEmailArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("sendVerifyEmail", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := UserServiceSendVerifyEmailResult{}
+	var retval *SendVerifyEmailResp
+	if retval, err2 = p.handler.SendVerifyEmail(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing sendVerifyEmail: "+err2.Error())
+		oprot.WriteMessageBegin("sendVerifyEmail", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("sendVerifyEmail", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type userServiceProcessorVerifyEmail struct {
+	handler UserService
+}
+
+func (p *userServiceProcessorVerifyEmail) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserServiceVerifyEmailArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("verifyEmail", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := UserServiceVerifyEmailResult{}
+	var retval *VerifyEmailResp
+	if retval, err2 = p.handler.VerifyEmail(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing verifyEmail: "+err2.Error())
+		oprot.WriteMessageBegin("verifyEmail", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("verifyEmail", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type userServiceProcessorUpdateEmail struct {
+	handler UserService
+}
+
+func (p *userServiceProcessorUpdateEmail) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserServiceUpdateEmailArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("updateEmail", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := UserServiceUpdateEmailResult{}
+	var retval *UpdateEmailResp
+	if retval, err2 = p.handler.UpdateEmail(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing updateEmail: "+err2.Error())
+		oprot.WriteMessageBegin("updateEmail", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("updateEmail", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type userServiceProcessorUpdatePassword struct {
+	handler UserService
+}
+
+func (p *userServiceProcessorUpdatePassword) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserServiceUpdatePasswordArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("updatePassword", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := UserServiceUpdatePasswordResult{}
+	var retval *UpdatePasswordResp
+	if retval, err2 = p.handler.UpdatePassword(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing updatePassword: "+err2.Error())
+		oprot.WriteMessageBegin("updatePassword", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("updatePassword", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type userServiceProcessorUpdateMajor struct {
+	handler UserService
+}
+
+func (p *userServiceProcessorUpdateMajor) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserServiceUpdateMajorArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("updateMajor", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := UserServiceUpdateMajorResult{}
+	var retval *UpdateMajorResp
+	if retval, err2 = p.handler.UpdateMajor(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing updateMajor: "+err2.Error())
+		oprot.WriteMessageBegin("updateMajor", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("updateMajor", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type userServiceProcessorUploadAvatar struct {
+	handler UserService
+}
+
+func (p *userServiceProcessorUploadAvatar) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserServiceUploadAvatarArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("uploadAvatar", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := UserServiceUploadAvatarResult{}
+	var retval *UploadAvatarResp
+	if retval, err2 = p.handler.UploadAvatar(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing uploadAvatar: "+err2.Error())
+		oprot.WriteMessageBegin("uploadAvatar", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("uploadAvatar", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type userServiceProcessorResetPassword struct {
+	handler UserService
+}
+
+func (p *userServiceProcessorResetPassword) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserServiceResetPasswordArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("resetPassword", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := UserServiceResetPasswordResult{}
+	var retval *ResetPasswordResp
+	if retval, err2 = p.handler.ResetPassword(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing resetPassword: "+err2.Error())
+		oprot.WriteMessageBegin("resetPassword", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("resetPassword", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type userServiceProcessorUnlockAccount struct {
+	handler UserService
+}
+
+func (p *userServiceProcessorUnlockAccount) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserServiceUnlockAccountArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("unlockAccount", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := UserServiceUnlockAccountResult{}
+	var retval *UnlockAccountResp
+	if retval, err2 = p.handler.UnlockAccount(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing unlockAccount: "+err2.Error())
+		oprot.WriteMessageBegin("unlockAccount", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("unlockAccount", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type userServiceProcessorActivateAccount struct {
+	handler UserService
+}
+
+func (p *userServiceProcessorActivateAccount) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserServiceActivateAccountArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("activateAccount", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := UserServiceActivateAccountResult{}
+	var retval *ActivateAccountResp
+	if retval, err2 = p.handler.ActivateAccount(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing activateAccount: "+err2.Error())
+		oprot.WriteMessageBegin("activateAccount", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("activateAccount", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type userServiceProcessorResendActivation struct {
+	handler UserService
+}
+
+func (p *userServiceProcessorResendActivation) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserServiceResendActivationArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("resendActivation", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := UserServiceResendActivationResult{}
+	var retval *ResendActivationResp
+	if retval, err2 = p.handler.ResendActivation(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing resendActivation: "+err2.Error())
+		oprot.WriteMessageBegin("resendActivation", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("resendActivation", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type userServiceProcessorRefreshToken struct {
+	handler UserService
+}
+
+func (p *userServiceProcessorRefreshToken) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserServiceRefreshTokenArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("refreshToken", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := UserServiceRefreshTokenResult{}
+	var retval *RefreshTokenResp
+	if retval, err2 = p.handler.RefreshToken(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing refreshToken: "+err2.Error())
+		oprot.WriteMessageBegin("refreshToken", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("refreshToken", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type userServiceProcessorGetUserInfo struct {
+	handler UserService
+}
+
+func (p *userServiceProcessorGetUserInfo) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserServiceGetUserInfoArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("getUserInfo", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := UserServiceGetUserInfoResult{}
+	var retval *GetUserInfoResp
+	if retval, err2 = p.handler.GetUserInfo(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing getUserInfo: "+err2.Error())
 		oprot.WriteMessageBegin("getUserInfo", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
@@ -7296,53 +18023,8309 @@ func (p *userServiceProcessorGetUserInfo) Process(ctx context.Context, seqId int
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("getUserInfo", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("getUserInfo", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type userServiceProcessorOidcAuthorize struct {
+	handler UserService
+}
+
+func (p *userServiceProcessorOidcAuthorize) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserServiceOidcAuthorizeArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("oidcAuthorize", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := UserServiceOidcAuthorizeResult{}
+	var retval *OIDCAuthorizeResp
+	if retval, err2 = p.handler.OidcAuthorize(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing oidcAuthorize: "+err2.Error())
+		oprot.WriteMessageBegin("oidcAuthorize", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("oidcAuthorize", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type userServiceProcessorOidcCallback struct {
+	handler UserService
+}
+
+func (p *userServiceProcessorOidcCallback) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserServiceOidcCallbackArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("oidcCallback", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := UserServiceOidcCallbackResult{}
+	var retval *OIDCCallbackResp
+	if retval, err2 = p.handler.OidcCallback(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing oidcCallback: "+err2.Error())
+		oprot.WriteMessageBegin("oidcCallback", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("oidcCallback", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type userServiceProcessorGetCaptcha struct {
+	handler UserService
+}
+
+func (p *userServiceProcessorGetCaptcha) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserServiceGetCaptchaArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("getCaptcha", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := UserServiceGetCaptchaResult{}
+	var retval *GetCaptchaResp
+	if retval, err2 = p.handler.GetCaptcha(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing getCaptcha: "+err2.Error())
+		oprot.WriteMessageBegin("getCaptcha", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("getCaptcha", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type userServiceProcessorRevertEmailChange struct {
+	handler UserService
+}
+
+func (p *userServiceProcessorRevertEmailChange) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserServiceRevertEmailChangeArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("revertEmailChange", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := UserServiceRevertEmailChangeResult{}
+	var retval *RevertEmailChangeResp
+	if retval, err2 = p.handler.RevertEmailChange(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing revertEmailChange: "+err2.Error())
+		oprot.WriteMessageBegin("revertEmailChange", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("revertEmailChange", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type userServiceProcessorCreatePersonalToken struct {
+	handler UserService
+}
+
+func (p *userServiceProcessorCreatePersonalToken) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserServiceCreatePersonalTokenArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("createPersonalToken", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := UserServiceCreatePersonalTokenResult{}
+	var retval *CreatePersonalTokenResp
+	if retval, err2 = p.handler.CreatePersonalToken(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing createPersonalToken: "+err2.Error())
+		oprot.WriteMessageBegin("createPersonalToken", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("createPersonalToken", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type userServiceProcessorListPersonalTokens struct {
+	handler UserService
+}
+
+func (p *userServiceProcessorListPersonalTokens) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserServiceListPersonalTokensArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("listPersonalTokens", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := UserServiceListPersonalTokensResult{}
+	var retval *ListPersonalTokensResp
+	if retval, err2 = p.handler.ListPersonalTokens(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing listPersonalTokens: "+err2.Error())
+		oprot.WriteMessageBegin("listPersonalTokens", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("listPersonalTokens", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type userServiceProcessorRevokePersonalToken struct {
+	handler UserService
+}
+
+func (p *userServiceProcessorRevokePersonalToken) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserServiceRevokePersonalTokenArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("revokePersonalToken", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := UserServiceRevokePersonalTokenResult{}
+	var retval *RevokePersonalTokenResp
+	if retval, err2 = p.handler.RevokePersonalToken(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing revokePersonalToken: "+err2.Error())
+		oprot.WriteMessageBegin("revokePersonalToken", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("revokePersonalToken", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type UserServiceRegisterArgs struct {
+	Req *RegisterReq `thrift:"req,1"`
+}
+
+func NewUserServiceRegisterArgs() *UserServiceRegisterArgs {
+	return &UserServiceRegisterArgs{}
+}
+
+func (p *UserServiceRegisterArgs) InitDefault() {
+}
+
+var UserServiceRegisterArgs_Req_DEFAULT *RegisterReq
+
+func (p *UserServiceRegisterArgs) GetReq() (v *RegisterReq) {
+	if !p.IsSetReq() {
+		return UserServiceRegisterArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_UserServiceRegisterArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *UserServiceRegisterArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceRegisterArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceRegisterArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserServiceRegisterArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewRegisterReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *UserServiceRegisterArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("register_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserServiceRegisterArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *UserServiceRegisterArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceRegisterArgs(%+v)", *p)
+
+}
+
+type UserServiceRegisterResult struct {
+	Success *RegisterResp `thrift:"success,0,optional"`
+}
+
+func NewUserServiceRegisterResult() *UserServiceRegisterResult {
+	return &UserServiceRegisterResult{}
+}
+
+func (p *UserServiceRegisterResult) InitDefault() {
+}
+
+var UserServiceRegisterResult_Success_DEFAULT *RegisterResp
+
+func (p *UserServiceRegisterResult) GetSuccess() (v *RegisterResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceRegisterResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_UserServiceRegisterResult = map[int16]string{
+	0: "success",
+}
+
+func (p *UserServiceRegisterResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceRegisterResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceRegisterResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserServiceRegisterResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewRegisterResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *UserServiceRegisterResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("register_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserServiceRegisterResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *UserServiceRegisterResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceRegisterResult(%+v)", *p)
+
+}
+
+type UserServiceLoginInArgs struct {
+	Req *LoginInReq `thrift:"req,1"`
+}
+
+func NewUserServiceLoginInArgs() *UserServiceLoginInArgs {
+	return &UserServiceLoginInArgs{}
+}
+
+func (p *UserServiceLoginInArgs) InitDefault() {
+}
+
+var UserServiceLoginInArgs_Req_DEFAULT *LoginInReq
+
+func (p *UserServiceLoginInArgs) GetReq() (v *LoginInReq) {
+	if !p.IsSetReq() {
+		return UserServiceLoginInArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_UserServiceLoginInArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *UserServiceLoginInArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceLoginInArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceLoginInArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserServiceLoginInArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewLoginInReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *UserServiceLoginInArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("loginIn_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserServiceLoginInArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *UserServiceLoginInArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceLoginInArgs(%+v)", *p)
+
+}
+
+type UserServiceLoginInResult struct {
+	Success *LoginInResp `thrift:"success,0,optional"`
+}
+
+func NewUserServiceLoginInResult() *UserServiceLoginInResult {
+	return &UserServiceLoginInResult{}
+}
+
+func (p *UserServiceLoginInResult) InitDefault() {
+}
+
+var UserServiceLoginInResult_Success_DEFAULT *LoginInResp
+
+func (p *UserServiceLoginInResult) GetSuccess() (v *LoginInResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceLoginInResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_UserServiceLoginInResult = map[int16]string{
+	0: "success",
+}
+
+func (p *UserServiceLoginInResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceLoginInResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceLoginInResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserServiceLoginInResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewLoginInResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *UserServiceLoginInResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("loginIn_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserServiceLoginInResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *UserServiceLoginInResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceLoginInResult(%+v)", *p)
+
+}
+
+type UserServiceLoginOutArgs struct {
+	Req *LoginOutReq `thrift:"req,1"`
+}
+
+func NewUserServiceLoginOutArgs() *UserServiceLoginOutArgs {
+	return &UserServiceLoginOutArgs{}
+}
+
+func (p *UserServiceLoginOutArgs) InitDefault() {
+}
+
+var UserServiceLoginOutArgs_Req_DEFAULT *LoginOutReq
+
+func (p *UserServiceLoginOutArgs) GetReq() (v *LoginOutReq) {
+	if !p.IsSetReq() {
+		return UserServiceLoginOutArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_UserServiceLoginOutArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *UserServiceLoginOutArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceLoginOutArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceLoginOutArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserServiceLoginOutArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewLoginOutReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *UserServiceLoginOutArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("loginOut_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserServiceLoginOutArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *UserServiceLoginOutArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceLoginOutArgs(%+v)", *p)
+
+}
+
+type UserServiceLoginOutResult struct {
+	Success *LoginOutResp `thrift:"success,0,optional"`
+}
+
+func NewUserServiceLoginOutResult() *UserServiceLoginOutResult {
+	return &UserServiceLoginOutResult{}
+}
+
+func (p *UserServiceLoginOutResult) InitDefault() {
+}
+
+var UserServiceLoginOutResult_Success_DEFAULT *LoginOutResp
+
+func (p *UserServiceLoginOutResult) GetSuccess() (v *LoginOutResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceLoginOutResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_UserServiceLoginOutResult = map[int16]string{
+	0: "success",
+}
+
+func (p *UserServiceLoginOutResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceLoginOutResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceLoginOutResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserServiceLoginOutResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewLoginOutResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *UserServiceLoginOutResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("loginOut_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserServiceLoginOutResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *UserServiceLoginOutResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceLoginOutResult(%+v)", *p)
+
+}
+
+type UserServiceSendVerifyEmailArgs struct {
+	Req *SendVerifyEmailReq `thrift:"req,1"`
+}
+
+func NewUserServiceSendVerifyEmailArgs() *UserServiceSendVerifyEmailArgs {
+	return &UserServiceSendVerifyEmailArgs{}
+}
+
+func (p *UserServiceSendVerifyEmailArgs) InitDefault() {
+}
+
+var UserServiceSendVerifyEmailArgs_Req_DEFAULT *SendVerifyEmailReq
+
+func (p *UserServiceSendVerifyEmailArgs) GetReq() (v *SendVerifyEmailReq) {
+	if !p.IsSetReq() {
+		return UserServiceSendVerifyEmailArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_UserServiceSendVerifyEmailArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *UserServiceSendVerifyEmailArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceSendVerifyEmailArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceSendVerifyEmailArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserServiceSendVerifyEmailArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewSendVerifyEmailReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *UserServiceSendVerifyEmailArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("sendVerifyEmail_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserServiceSendVerifyEmailArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *UserServiceSendVerifyEmailArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceSendVerifyEmailArgs(%+v)", *p)
+
+}
+
+type UserServiceSendVerifyEmailResult struct {
+	Success *SendVerifyEmailResp `thrift:"success,0,optional"`
+}
+
+func NewUserServiceSendVerifyEmailResult() *UserServiceSendVerifyEmailResult {
+	return &UserServiceSendVerifyEmailResult{}
+}
+
+func (p *UserServiceSendVerifyEmailResult) InitDefault() {
+}
+
+var UserServiceSendVerifyEmailResult_Success_DEFAULT *SendVerifyEmailResp
+
+func (p *UserServiceSendVerifyEmailResult) GetSuccess() (v *SendVerifyEmailResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceSendVerifyEmailResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_UserServiceSendVerifyEmailResult = map[int16]string{
+	0: "success",
+}
+
+func (p *UserServiceSendVerifyEmailResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceSendVerifyEmailResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceSendVerifyEmailResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserServiceSendVerifyEmailResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewSendVerifyEmailResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *UserServiceSendVerifyEmailResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("sendVerifyEmail_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserServiceSendVerifyEmailResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *UserServiceSendVerifyEmailResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceSendVerifyEmailResult(%+v)", *p)
+
+}
+
+type UserServiceVerifyEmailArgs struct {
+	Req *VerifyEmailReq `thrift:"req,1"`
+}
+
+func NewUserServiceVerifyEmailArgs() *UserServiceVerifyEmailArgs {
+	return &UserServiceVerifyEmailArgs{}
+}
+
+func (p *UserServiceVerifyEmailArgs) InitDefault() {
+}
+
+var UserServiceVerifyEmailArgs_Req_DEFAULT *VerifyEmailReq
+
+func (p *UserServiceVerifyEmailArgs) GetReq() (v *VerifyEmailReq) {
+	if !p.IsSetReq() {
+		return UserServiceVerifyEmailArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_UserServiceVerifyEmailArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *UserServiceVerifyEmailArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceVerifyEmailArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceVerifyEmailArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserServiceVerifyEmailArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewVerifyEmailReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *UserServiceVerifyEmailArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("verifyEmail_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserServiceVerifyEmailArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *UserServiceVerifyEmailArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceVerifyEmailArgs(%+v)", *p)
+
+}
+
+type UserServiceVerifyEmailResult struct {
+	Success *VerifyEmailResp `thrift:"success,0,optional"`
+}
+
+func NewUserServiceVerifyEmailResult() *UserServiceVerifyEmailResult {
+	return &UserServiceVerifyEmailResult{}
+}
+
+func (p *UserServiceVerifyEmailResult) InitDefault() {
+}
+
+var UserServiceVerifyEmailResult_Success_DEFAULT *VerifyEmailResp
+
+func (p *UserServiceVerifyEmailResult) GetSuccess() (v *VerifyEmailResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceVerifyEmailResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_UserServiceVerifyEmailResult = map[int16]string{
+	0: "success",
+}
+
+func (p *UserServiceVerifyEmailResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceVerifyEmailResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceVerifyEmailResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserServiceVerifyEmailResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewVerifyEmailResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *UserServiceVerifyEmailResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("verifyEmail_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserServiceVerifyEmailResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *UserServiceVerifyEmailResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceVerifyEmailResult(%+v)", *p)
+
+}
+
+type UserServiceUpdateEmailArgs struct {
+	Req *UpdateEmailReq `thrift:"req,1"`
+}
+
+func NewUserServiceUpdateEmailArgs() *UserServiceUpdateEmailArgs {
+	return &UserServiceUpdateEmailArgs{}
+}
+
+func (p *UserServiceUpdateEmailArgs) InitDefault() {
+}
+
+var UserServiceUpdateEmailArgs_Req_DEFAULT *UpdateEmailReq
+
+func (p *UserServiceUpdateEmailArgs) GetReq() (v *UpdateEmailReq) {
+	if !p.IsSetReq() {
+		return UserServiceUpdateEmailArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_UserServiceUpdateEmailArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *UserServiceUpdateEmailArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceUpdateEmailArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceUpdateEmailArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserServiceUpdateEmailArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewUpdateEmailReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *UserServiceUpdateEmailArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("updateEmail_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserServiceUpdateEmailArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *UserServiceUpdateEmailArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceUpdateEmailArgs(%+v)", *p)
+
+}
+
+type UserServiceUpdateEmailResult struct {
+	Success *UpdateEmailResp `thrift:"success,0,optional"`
+}
+
+func NewUserServiceUpdateEmailResult() *UserServiceUpdateEmailResult {
+	return &UserServiceUpdateEmailResult{}
+}
+
+func (p *UserServiceUpdateEmailResult) InitDefault() {
+}
+
+var UserServiceUpdateEmailResult_Success_DEFAULT *UpdateEmailResp
+
+func (p *UserServiceUpdateEmailResult) GetSuccess() (v *UpdateEmailResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceUpdateEmailResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_UserServiceUpdateEmailResult = map[int16]string{
+	0: "success",
+}
+
+func (p *UserServiceUpdateEmailResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceUpdateEmailResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceUpdateEmailResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserServiceUpdateEmailResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewUpdateEmailResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *UserServiceUpdateEmailResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("updateEmail_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserServiceUpdateEmailResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *UserServiceUpdateEmailResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceUpdateEmailResult(%+v)", *p)
+
+}
+
+type UserServiceUpdatePasswordArgs struct {
+	Req *UpdatePasswordReq `thrift:"req,1"`
+}
+
+func NewUserServiceUpdatePasswordArgs() *UserServiceUpdatePasswordArgs {
+	return &UserServiceUpdatePasswordArgs{}
+}
+
+func (p *UserServiceUpdatePasswordArgs) InitDefault() {
+}
+
+var UserServiceUpdatePasswordArgs_Req_DEFAULT *UpdatePasswordReq
+
+func (p *UserServiceUpdatePasswordArgs) GetReq() (v *UpdatePasswordReq) {
+	if !p.IsSetReq() {
+		return UserServiceUpdatePasswordArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_UserServiceUpdatePasswordArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *UserServiceUpdatePasswordArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceUpdatePasswordArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceUpdatePasswordArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserServiceUpdatePasswordArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewUpdatePasswordReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *UserServiceUpdatePasswordArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("updatePassword_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserServiceUpdatePasswordArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *UserServiceUpdatePasswordArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceUpdatePasswordArgs(%+v)", *p)
+
+}
+
+type UserServiceUpdatePasswordResult struct {
+	Success *UpdatePasswordResp `thrift:"success,0,optional"`
+}
+
+func NewUserServiceUpdatePasswordResult() *UserServiceUpdatePasswordResult {
+	return &UserServiceUpdatePasswordResult{}
+}
+
+func (p *UserServiceUpdatePasswordResult) InitDefault() {
+}
+
+var UserServiceUpdatePasswordResult_Success_DEFAULT *UpdatePasswordResp
+
+func (p *UserServiceUpdatePasswordResult) GetSuccess() (v *UpdatePasswordResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceUpdatePasswordResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_UserServiceUpdatePasswordResult = map[int16]string{
+	0: "success",
+}
+
+func (p *UserServiceUpdatePasswordResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceUpdatePasswordResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceUpdatePasswordResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserServiceUpdatePasswordResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewUpdatePasswordResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *UserServiceUpdatePasswordResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("updatePassword_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserServiceUpdatePasswordResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *UserServiceUpdatePasswordResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceUpdatePasswordResult(%+v)", *p)
+
+}
+
+type UserServiceUpdateMajorArgs struct {
+	Req *UpdateMajorReq `thrift:"req,1"`
+}
+
+func NewUserServiceUpdateMajorArgs() *UserServiceUpdateMajorArgs {
+	return &UserServiceUpdateMajorArgs{}
+}
+
+func (p *UserServiceUpdateMajorArgs) InitDefault() {
+}
+
+var UserServiceUpdateMajorArgs_Req_DEFAULT *UpdateMajorReq
+
+func (p *UserServiceUpdateMajorArgs) GetReq() (v *UpdateMajorReq) {
+	if !p.IsSetReq() {
+		return UserServiceUpdateMajorArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_UserServiceUpdateMajorArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *UserServiceUpdateMajorArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceUpdateMajorArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceUpdateMajorArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserServiceUpdateMajorArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewUpdateMajorReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *UserServiceUpdateMajorArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("updateMajor_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserServiceUpdateMajorArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *UserServiceUpdateMajorArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceUpdateMajorArgs(%+v)", *p)
+
+}
+
+type UserServiceUpdateMajorResult struct {
+	Success *UpdateMajorResp `thrift:"success,0,optional"`
+}
+
+func NewUserServiceUpdateMajorResult() *UserServiceUpdateMajorResult {
+	return &UserServiceUpdateMajorResult{}
+}
+
+func (p *UserServiceUpdateMajorResult) InitDefault() {
+}
+
+var UserServiceUpdateMajorResult_Success_DEFAULT *UpdateMajorResp
+
+func (p *UserServiceUpdateMajorResult) GetSuccess() (v *UpdateMajorResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceUpdateMajorResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_UserServiceUpdateMajorResult = map[int16]string{
+	0: "success",
+}
+
+func (p *UserServiceUpdateMajorResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceUpdateMajorResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceUpdateMajorResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserServiceUpdateMajorResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewUpdateMajorResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *UserServiceUpdateMajorResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("updateMajor_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserServiceUpdateMajorResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *UserServiceUpdateMajorResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceUpdateMajorResult(%+v)", *p)
+
+}
+
+type UserServiceUploadAvatarArgs struct {
+	Req *UploadAvatarReq `thrift:"req,1"`
+}
+
+func NewUserServiceUploadAvatarArgs() *UserServiceUploadAvatarArgs {
+	return &UserServiceUploadAvatarArgs{}
+}
+
+func (p *UserServiceUploadAvatarArgs) InitDefault() {
+}
+
+var UserServiceUploadAvatarArgs_Req_DEFAULT *UploadAvatarReq
+
+func (p *UserServiceUploadAvatarArgs) GetReq() (v *UploadAvatarReq) {
+	if !p.IsSetReq() {
+		return UserServiceUploadAvatarArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_UserServiceUploadAvatarArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *UserServiceUploadAvatarArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceUploadAvatarArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceUploadAvatarArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserServiceUploadAvatarArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewUploadAvatarReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *UserServiceUploadAvatarArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("uploadAvatar_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserServiceUploadAvatarArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *UserServiceUploadAvatarArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceUploadAvatarArgs(%+v)", *p)
+
+}
+
+type UserServiceUploadAvatarResult struct {
+	Success *UploadAvatarResp `thrift:"success,0,optional"`
+}
+
+func NewUserServiceUploadAvatarResult() *UserServiceUploadAvatarResult {
+	return &UserServiceUploadAvatarResult{}
+}
+
+func (p *UserServiceUploadAvatarResult) InitDefault() {
+}
+
+var UserServiceUploadAvatarResult_Success_DEFAULT *UploadAvatarResp
+
+func (p *UserServiceUploadAvatarResult) GetSuccess() (v *UploadAvatarResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceUploadAvatarResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_UserServiceUploadAvatarResult = map[int16]string{
+	0: "success",
+}
+
+func (p *UserServiceUploadAvatarResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceUploadAvatarResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceUploadAvatarResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserServiceUploadAvatarResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewUploadAvatarResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *UserServiceUploadAvatarResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("uploadAvatar_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserServiceUploadAvatarResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *UserServiceUploadAvatarResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceUploadAvatarResult(%+v)", *p)
+
+}
+
+type UserServiceResetPasswordArgs struct {
+	Req *ResetPasswordReq `thrift:"req,1"`
+}
+
+func NewUserServiceResetPasswordArgs() *UserServiceResetPasswordArgs {
+	return &UserServiceResetPasswordArgs{}
+}
+
+func (p *UserServiceResetPasswordArgs) InitDefault() {
+}
+
+var UserServiceResetPasswordArgs_Req_DEFAULT *ResetPasswordReq
+
+func (p *UserServiceResetPasswordArgs) GetReq() (v *ResetPasswordReq) {
+	if !p.IsSetReq() {
+		return UserServiceResetPasswordArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_UserServiceResetPasswordArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *UserServiceResetPasswordArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceResetPasswordArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceResetPasswordArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserServiceResetPasswordArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewResetPasswordReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *UserServiceResetPasswordArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("resetPassword_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserServiceResetPasswordArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *UserServiceResetPasswordArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceResetPasswordArgs(%+v)", *p)
+
+}
+
+type UserServiceResetPasswordResult struct {
+	Success *ResetPasswordResp `thrift:"success,0,optional"`
+}
+
+func NewUserServiceResetPasswordResult() *UserServiceResetPasswordResult {
+	return &UserServiceResetPasswordResult{}
+}
+
+func (p *UserServiceResetPasswordResult) InitDefault() {
+}
+
+var UserServiceResetPasswordResult_Success_DEFAULT *ResetPasswordResp
+
+func (p *UserServiceResetPasswordResult) GetSuccess() (v *ResetPasswordResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceResetPasswordResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_UserServiceResetPasswordResult = map[int16]string{
+	0: "success",
+}
+
+func (p *UserServiceResetPasswordResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceResetPasswordResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceResetPasswordResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserServiceResetPasswordResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewResetPasswordResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *UserServiceResetPasswordResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("resetPassword_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserServiceResetPasswordResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *UserServiceResetPasswordResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceResetPasswordResult(%+v)", *p)
+
+}
+
+type UserServiceUnlockAccountArgs struct {
+	Req *UnlockAccountReq `thrift:"req,1"`
+}
+
+func NewUserServiceUnlockAccountArgs() *UserServiceUnlockAccountArgs {
+	return &UserServiceUnlockAccountArgs{}
+}
+
+func (p *UserServiceUnlockAccountArgs) InitDefault() {
+}
+
+var UserServiceUnlockAccountArgs_Req_DEFAULT *UnlockAccountReq
+
+func (p *UserServiceUnlockAccountArgs) GetReq() (v *UnlockAccountReq) {
+	if !p.IsSetReq() {
+		return UserServiceUnlockAccountArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_UserServiceUnlockAccountArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *UserServiceUnlockAccountArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceUnlockAccountArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceUnlockAccountArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserServiceUnlockAccountArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewUnlockAccountReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *UserServiceUnlockAccountArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("unlockAccount_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserServiceUnlockAccountArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *UserServiceUnlockAccountArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceUnlockAccountArgs(%+v)", *p)
+
+}
+
+type UserServiceUnlockAccountResult struct {
+	Success *UnlockAccountResp `thrift:"success,0,optional"`
+}
+
+func NewUserServiceUnlockAccountResult() *UserServiceUnlockAccountResult {
+	return &UserServiceUnlockAccountResult{}
+}
+
+func (p *UserServiceUnlockAccountResult) InitDefault() {
+}
+
+var UserServiceUnlockAccountResult_Success_DEFAULT *UnlockAccountResp
+
+func (p *UserServiceUnlockAccountResult) GetSuccess() (v *UnlockAccountResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceUnlockAccountResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_UserServiceUnlockAccountResult = map[int16]string{
+	0: "success",
+}
+
+func (p *UserServiceUnlockAccountResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceUnlockAccountResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceUnlockAccountResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserServiceUnlockAccountResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewUnlockAccountResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *UserServiceUnlockAccountResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("unlockAccount_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserServiceUnlockAccountResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *UserServiceUnlockAccountResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceUnlockAccountResult(%+v)", *p)
+
+}
+
+type UserServiceActivateAccountArgs struct {
+	Req *ActivateAccountReq `thrift:"req,1"`
+}
+
+func NewUserServiceActivateAccountArgs() *UserServiceActivateAccountArgs {
+	return &UserServiceActivateAccountArgs{}
+}
+
+func (p *UserServiceActivateAccountArgs) InitDefault() {
+}
+
+var UserServiceActivateAccountArgs_Req_DEFAULT *ActivateAccountReq
+
+func (p *UserServiceActivateAccountArgs) GetReq() (v *ActivateAccountReq) {
+	if !p.IsSetReq() {
+		return UserServiceActivateAccountArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_UserServiceActivateAccountArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *UserServiceActivateAccountArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceActivateAccountArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceActivateAccountArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserServiceActivateAccountArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewActivateAccountReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *UserServiceActivateAccountArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("activateAccount_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserServiceActivateAccountArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *UserServiceActivateAccountArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceActivateAccountArgs(%+v)", *p)
+
+}
+
+type UserServiceActivateAccountResult struct {
+	Success *ActivateAccountResp `thrift:"success,0,optional"`
+}
+
+func NewUserServiceActivateAccountResult() *UserServiceActivateAccountResult {
+	return &UserServiceActivateAccountResult{}
+}
+
+func (p *UserServiceActivateAccountResult) InitDefault() {
+}
+
+var UserServiceActivateAccountResult_Success_DEFAULT *ActivateAccountResp
+
+func (p *UserServiceActivateAccountResult) GetSuccess() (v *ActivateAccountResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceActivateAccountResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_UserServiceActivateAccountResult = map[int16]string{
+	0: "success",
+}
+
+func (p *UserServiceActivateAccountResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceActivateAccountResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceActivateAccountResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserServiceActivateAccountResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewActivateAccountResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *UserServiceActivateAccountResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("activateAccount_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserServiceActivateAccountResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *UserServiceActivateAccountResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceActivateAccountResult(%+v)", *p)
+
+}
+
+type UserServiceResendActivationArgs struct {
+	Req *ResendActivationReq `thrift:"req,1"`
+}
+
+func NewUserServiceResendActivationArgs() *UserServiceResendActivationArgs {
+	return &UserServiceResendActivationArgs{}
+}
+
+func (p *UserServiceResendActivationArgs) InitDefault() {
+}
+
+var UserServiceResendActivationArgs_Req_DEFAULT *ResendActivationReq
+
+func (p *UserServiceResendActivationArgs) GetReq() (v *ResendActivationReq) {
+	if !p.IsSetReq() {
+		return UserServiceResendActivationArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_UserServiceResendActivationArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *UserServiceResendActivationArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceResendActivationArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceResendActivationArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserServiceResendActivationArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewResendActivationReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *UserServiceResendActivationArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("resendActivation_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserServiceResendActivationArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *UserServiceResendActivationArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceResendActivationArgs(%+v)", *p)
+
+}
+
+type UserServiceResendActivationResult struct {
+	Success *ResendActivationResp `thrift:"success,0,optional"`
+}
+
+func NewUserServiceResendActivationResult() *UserServiceResendActivationResult {
+	return &UserServiceResendActivationResult{}
+}
+
+func (p *UserServiceResendActivationResult) InitDefault() {
+}
+
+var UserServiceResendActivationResult_Success_DEFAULT *ResendActivationResp
+
+func (p *UserServiceResendActivationResult) GetSuccess() (v *ResendActivationResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceResendActivationResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_UserServiceResendActivationResult = map[int16]string{
+	0: "success",
+}
+
+func (p *UserServiceResendActivationResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceResendActivationResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceResendActivationResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserServiceResendActivationResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewResendActivationResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *UserServiceResendActivationResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("resendActivation_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserServiceResendActivationResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *UserServiceResendActivationResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceResendActivationResult(%+v)", *p)
+
+}
+
+type UserServiceRefreshTokenArgs struct {
+	Req *RefreshTokenReq `thrift:"req,1"`
+}
+
+func NewUserServiceRefreshTokenArgs() *UserServiceRefreshTokenArgs {
+	return &UserServiceRefreshTokenArgs{}
+}
+
+func (p *UserServiceRefreshTokenArgs) InitDefault() {
+}
+
+var UserServiceRefreshTokenArgs_Req_DEFAULT *RefreshTokenReq
+
+func (p *UserServiceRefreshTokenArgs) GetReq() (v *RefreshTokenReq) {
+	if !p.IsSetReq() {
+		return UserServiceRefreshTokenArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_UserServiceRefreshTokenArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *UserServiceRefreshTokenArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceRefreshTokenArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceRefreshTokenArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserServiceRefreshTokenArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewRefreshTokenReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *UserServiceRefreshTokenArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("refreshToken_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserServiceRefreshTokenArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *UserServiceRefreshTokenArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceRefreshTokenArgs(%+v)", *p)
+
+}
+
+type UserServiceRefreshTokenResult struct {
+	Success *RefreshTokenResp `thrift:"success,0,optional"`
+}
+
+func NewUserServiceRefreshTokenResult() *UserServiceRefreshTokenResult {
+	return &UserServiceRefreshTokenResult{}
+}
+
+func (p *UserServiceRefreshTokenResult) InitDefault() {
+}
+
+var UserServiceRefreshTokenResult_Success_DEFAULT *RefreshTokenResp
+
+func (p *UserServiceRefreshTokenResult) GetSuccess() (v *RefreshTokenResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceRefreshTokenResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_UserServiceRefreshTokenResult = map[int16]string{
+	0: "success",
+}
+
+func (p *UserServiceRefreshTokenResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceRefreshTokenResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceRefreshTokenResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserServiceRefreshTokenResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewRefreshTokenResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *UserServiceRefreshTokenResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("refreshToken_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserServiceRefreshTokenResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *UserServiceRefreshTokenResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceRefreshTokenResult(%+v)", *p)
+
+}
+
+type UserServiceGetUserInfoArgs struct {
+	Req *GetUserInfoReq `thrift:"req,1"`
+}
+
+func NewUserServiceGetUserInfoArgs() *UserServiceGetUserInfoArgs {
+	return &UserServiceGetUserInfoArgs{}
+}
+
+func (p *UserServiceGetUserInfoArgs) InitDefault() {
+}
+
+var UserServiceGetUserInfoArgs_Req_DEFAULT *GetUserInfoReq
+
+func (p *UserServiceGetUserInfoArgs) GetReq() (v *GetUserInfoReq) {
+	if !p.IsSetReq() {
+		return UserServiceGetUserInfoArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_UserServiceGetUserInfoArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *UserServiceGetUserInfoArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceGetUserInfoArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceGetUserInfoArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserServiceGetUserInfoArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetUserInfoReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *UserServiceGetUserInfoArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("getUserInfo_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserServiceGetUserInfoArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *UserServiceGetUserInfoArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceGetUserInfoArgs(%+v)", *p)
+
+}
+
+type UserServiceGetUserInfoResult struct {
+	Success *GetUserInfoResp `thrift:"success,0,optional"`
+}
+
+func NewUserServiceGetUserInfoResult() *UserServiceGetUserInfoResult {
+	return &UserServiceGetUserInfoResult{}
+}
+
+func (p *UserServiceGetUserInfoResult) InitDefault() {
+}
+
+var UserServiceGetUserInfoResult_Success_DEFAULT *GetUserInfoResp
+
+func (p *UserServiceGetUserInfoResult) GetSuccess() (v *GetUserInfoResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceGetUserInfoResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_UserServiceGetUserInfoResult = map[int16]string{
+	0: "success",
+}
+
+func (p *UserServiceGetUserInfoResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceGetUserInfoResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceGetUserInfoResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserServiceGetUserInfoResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetUserInfoResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *UserServiceGetUserInfoResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("getUserInfo_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserServiceGetUserInfoResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *UserServiceGetUserInfoResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceGetUserInfoResult(%+v)", *p)
+
+}
+
+type UserServiceOidcAuthorizeArgs struct {
+	Req *OIDCAuthorizeReq `thrift:"req,1"`
+}
+
+func NewUserServiceOidcAuthorizeArgs() *UserServiceOidcAuthorizeArgs {
+	return &UserServiceOidcAuthorizeArgs{}
+}
+
+func (p *UserServiceOidcAuthorizeArgs) InitDefault() {
+}
+
+var UserServiceOidcAuthorizeArgs_Req_DEFAULT *OIDCAuthorizeReq
+
+func (p *UserServiceOidcAuthorizeArgs) GetReq() (v *OIDCAuthorizeReq) {
+	if !p.IsSetReq() {
+		return UserServiceOidcAuthorizeArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_UserServiceOidcAuthorizeArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *UserServiceOidcAuthorizeArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceOidcAuthorizeArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceOidcAuthorizeArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserServiceOidcAuthorizeArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewOIDCAuthorizeReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *UserServiceOidcAuthorizeArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("oidcAuthorize_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserServiceOidcAuthorizeArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *UserServiceOidcAuthorizeArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceOidcAuthorizeArgs(%+v)", *p)
+
+}
+
+type UserServiceOidcAuthorizeResult struct {
+	Success *OIDCAuthorizeResp `thrift:"success,0,optional"`
+}
+
+func NewUserServiceOidcAuthorizeResult() *UserServiceOidcAuthorizeResult {
+	return &UserServiceOidcAuthorizeResult{}
+}
+
+func (p *UserServiceOidcAuthorizeResult) InitDefault() {
+}
+
+var UserServiceOidcAuthorizeResult_Success_DEFAULT *OIDCAuthorizeResp
+
+func (p *UserServiceOidcAuthorizeResult) GetSuccess() (v *OIDCAuthorizeResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceOidcAuthorizeResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_UserServiceOidcAuthorizeResult = map[int16]string{
+	0: "success",
+}
+
+func (p *UserServiceOidcAuthorizeResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceOidcAuthorizeResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceOidcAuthorizeResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserServiceOidcAuthorizeResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewOIDCAuthorizeResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *UserServiceOidcAuthorizeResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("oidcAuthorize_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserServiceOidcAuthorizeResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *UserServiceOidcAuthorizeResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceOidcAuthorizeResult(%+v)", *p)
+
+}
+
+type UserServiceOidcCallbackArgs struct {
+	Req *OIDCCallbackReq `thrift:"req,1"`
+}
+
+func NewUserServiceOidcCallbackArgs() *UserServiceOidcCallbackArgs {
+	return &UserServiceOidcCallbackArgs{}
+}
+
+func (p *UserServiceOidcCallbackArgs) InitDefault() {
+}
+
+var UserServiceOidcCallbackArgs_Req_DEFAULT *OIDCCallbackReq
+
+func (p *UserServiceOidcCallbackArgs) GetReq() (v *OIDCCallbackReq) {
+	if !p.IsSetReq() {
+		return UserServiceOidcCallbackArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_UserServiceOidcCallbackArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *UserServiceOidcCallbackArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceOidcCallbackArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceOidcCallbackArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserServiceOidcCallbackArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewOIDCCallbackReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *UserServiceOidcCallbackArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("oidcCallback_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserServiceOidcCallbackArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *UserServiceOidcCallbackArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceOidcCallbackArgs(%+v)", *p)
+
+}
+
+type UserServiceOidcCallbackResult struct {
+	Success *OIDCCallbackResp `thrift:"success,0,optional"`
+}
+
+func NewUserServiceOidcCallbackResult() *UserServiceOidcCallbackResult {
+	return &UserServiceOidcCallbackResult{}
+}
+
+func (p *UserServiceOidcCallbackResult) InitDefault() {
+}
+
+var UserServiceOidcCallbackResult_Success_DEFAULT *OIDCCallbackResp
+
+func (p *UserServiceOidcCallbackResult) GetSuccess() (v *OIDCCallbackResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceOidcCallbackResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_UserServiceOidcCallbackResult = map[int16]string{
+	0: "success",
+}
+
+func (p *UserServiceOidcCallbackResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceOidcCallbackResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceOidcCallbackResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserServiceOidcCallbackResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewOIDCCallbackResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *UserServiceOidcCallbackResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("oidcCallback_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserServiceOidcCallbackResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *UserServiceOidcCallbackResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceOidcCallbackResult(%+v)", *p)
+
+}
+
+type UserServiceGetCaptchaArgs struct {
+	Req *GetCaptchaReq `thrift:"req,1"`
+}
+
+func NewUserServiceGetCaptchaArgs() *UserServiceGetCaptchaArgs {
+	return &UserServiceGetCaptchaArgs{}
+}
+
+func (p *UserServiceGetCaptchaArgs) InitDefault() {
+}
+
+var UserServiceGetCaptchaArgs_Req_DEFAULT *GetCaptchaReq
+
+func (p *UserServiceGetCaptchaArgs) GetReq() (v *GetCaptchaReq) {
+	if !p.IsSetReq() {
+		return UserServiceGetCaptchaArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_UserServiceGetCaptchaArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *UserServiceGetCaptchaArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceGetCaptchaArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceGetCaptchaArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserServiceGetCaptchaArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetCaptchaReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *UserServiceGetCaptchaArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("getCaptcha_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserServiceGetCaptchaArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *UserServiceGetCaptchaArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceGetCaptchaArgs(%+v)", *p)
+
+}
+
+type UserServiceGetCaptchaResult struct {
+	Success *GetCaptchaResp `thrift:"success,0,optional"`
+}
+
+func NewUserServiceGetCaptchaResult() *UserServiceGetCaptchaResult {
+	return &UserServiceGetCaptchaResult{}
+}
+
+func (p *UserServiceGetCaptchaResult) InitDefault() {
+}
+
+var UserServiceGetCaptchaResult_Success_DEFAULT *GetCaptchaResp
+
+func (p *UserServiceGetCaptchaResult) GetSuccess() (v *GetCaptchaResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceGetCaptchaResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_UserServiceGetCaptchaResult = map[int16]string{
+	0: "success",
+}
+
+func (p *UserServiceGetCaptchaResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceGetCaptchaResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceGetCaptchaResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserServiceGetCaptchaResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetCaptchaResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *UserServiceGetCaptchaResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("getCaptcha_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserServiceGetCaptchaResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *UserServiceGetCaptchaResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceGetCaptchaResult(%+v)", *p)
+
+}
+
+type UserServiceRevertEmailChangeArgs struct {
+	Req *RevertEmailChangeReq `thrift:"req,1"`
+}
+
+func NewUserServiceRevertEmailChangeArgs() *UserServiceRevertEmailChangeArgs {
+	return &UserServiceRevertEmailChangeArgs{}
+}
+
+func (p *UserServiceRevertEmailChangeArgs) InitDefault() {
+}
+
+var UserServiceRevertEmailChangeArgs_Req_DEFAULT *RevertEmailChangeReq
+
+func (p *UserServiceRevertEmailChangeArgs) GetReq() (v *RevertEmailChangeReq) {
+	if !p.IsSetReq() {
+		return UserServiceRevertEmailChangeArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_UserServiceRevertEmailChangeArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *UserServiceRevertEmailChangeArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceRevertEmailChangeArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceRevertEmailChangeArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserServiceRevertEmailChangeArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewRevertEmailChangeReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *UserServiceRevertEmailChangeArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("revertEmailChange_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserServiceRevertEmailChangeArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *UserServiceRevertEmailChangeArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceRevertEmailChangeArgs(%+v)", *p)
+
+}
+
+type UserServiceRevertEmailChangeResult struct {
+	Success *RevertEmailChangeResp `thrift:"success,0,optional"`
+}
+
+func NewUserServiceRevertEmailChangeResult() *UserServiceRevertEmailChangeResult {
+	return &UserServiceRevertEmailChangeResult{}
+}
+
+func (p *UserServiceRevertEmailChangeResult) InitDefault() {
+}
+
+var UserServiceRevertEmailChangeResult_Success_DEFAULT *RevertEmailChangeResp
+
+func (p *UserServiceRevertEmailChangeResult) GetSuccess() (v *RevertEmailChangeResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceRevertEmailChangeResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_UserServiceRevertEmailChangeResult = map[int16]string{
+	0: "success",
+}
+
+func (p *UserServiceRevertEmailChangeResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceRevertEmailChangeResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceRevertEmailChangeResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserServiceRevertEmailChangeResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewRevertEmailChangeResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *UserServiceRevertEmailChangeResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("revertEmailChange_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserServiceRevertEmailChangeResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *UserServiceRevertEmailChangeResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceRevertEmailChangeResult(%+v)", *p)
+
+}
+
+type UserServiceCreatePersonalTokenArgs struct {
+	Req *CreatePersonalTokenReq `thrift:"req,1"`
+}
+
+func NewUserServiceCreatePersonalTokenArgs() *UserServiceCreatePersonalTokenArgs {
+	return &UserServiceCreatePersonalTokenArgs{}
+}
+
+func (p *UserServiceCreatePersonalTokenArgs) InitDefault() {
+}
+
+var UserServiceCreatePersonalTokenArgs_Req_DEFAULT *CreatePersonalTokenReq
+
+func (p *UserServiceCreatePersonalTokenArgs) GetReq() (v *CreatePersonalTokenReq) {
+	if !p.IsSetReq() {
+		return UserServiceCreatePersonalTokenArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_UserServiceCreatePersonalTokenArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *UserServiceCreatePersonalTokenArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceCreatePersonalTokenArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceCreatePersonalTokenArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserServiceCreatePersonalTokenArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewCreatePersonalTokenReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *UserServiceCreatePersonalTokenArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("createPersonalToken_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserServiceCreatePersonalTokenArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *UserServiceCreatePersonalTokenArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceCreatePersonalTokenArgs(%+v)", *p)
+
+}
+
+type UserServiceCreatePersonalTokenResult struct {
+	Success *CreatePersonalTokenResp `thrift:"success,0,optional"`
+}
+
+func NewUserServiceCreatePersonalTokenResult() *UserServiceCreatePersonalTokenResult {
+	return &UserServiceCreatePersonalTokenResult{}
+}
+
+func (p *UserServiceCreatePersonalTokenResult) InitDefault() {
+}
+
+var UserServiceCreatePersonalTokenResult_Success_DEFAULT *CreatePersonalTokenResp
+
+func (p *UserServiceCreatePersonalTokenResult) GetSuccess() (v *CreatePersonalTokenResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceCreatePersonalTokenResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_UserServiceCreatePersonalTokenResult = map[int16]string{
+	0: "success",
+}
+
+func (p *UserServiceCreatePersonalTokenResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceCreatePersonalTokenResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceCreatePersonalTokenResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserServiceCreatePersonalTokenResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewCreatePersonalTokenResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *UserServiceCreatePersonalTokenResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("createPersonalToken_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserServiceCreatePersonalTokenResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *UserServiceCreatePersonalTokenResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceCreatePersonalTokenResult(%+v)", *p)
+
+}
+
+type UserServiceListPersonalTokensArgs struct {
+	Req *ListPersonalTokensReq `thrift:"req,1"`
+}
+
+func NewUserServiceListPersonalTokensArgs() *UserServiceListPersonalTokensArgs {
+	return &UserServiceListPersonalTokensArgs{}
+}
+
+func (p *UserServiceListPersonalTokensArgs) InitDefault() {
+}
+
+var UserServiceListPersonalTokensArgs_Req_DEFAULT *ListPersonalTokensReq
+
+func (p *UserServiceListPersonalTokensArgs) GetReq() (v *ListPersonalTokensReq) {
+	if !p.IsSetReq() {
+		return UserServiceListPersonalTokensArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_UserServiceListPersonalTokensArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *UserServiceListPersonalTokensArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceListPersonalTokensArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceListPersonalTokensArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserServiceListPersonalTokensArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewListPersonalTokensReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *UserServiceListPersonalTokensArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("listPersonalTokens_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserServiceListPersonalTokensArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *UserServiceListPersonalTokensArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceListPersonalTokensArgs(%+v)", *p)
+
+}
+
+type UserServiceListPersonalTokensResult struct {
+	Success *ListPersonalTokensResp `thrift:"success,0,optional"`
+}
+
+func NewUserServiceListPersonalTokensResult() *UserServiceListPersonalTokensResult {
+	return &UserServiceListPersonalTokensResult{}
+}
+
+func (p *UserServiceListPersonalTokensResult) InitDefault() {
+}
+
+var UserServiceListPersonalTokensResult_Success_DEFAULT *ListPersonalTokensResp
+
+func (p *UserServiceListPersonalTokensResult) GetSuccess() (v *ListPersonalTokensResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceListPersonalTokensResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_UserServiceListPersonalTokensResult = map[int16]string{
+	0: "success",
+}
+
+func (p *UserServiceListPersonalTokensResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceListPersonalTokensResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceListPersonalTokensResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserServiceListPersonalTokensResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewListPersonalTokensResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *UserServiceListPersonalTokensResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("listPersonalTokens_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserServiceListPersonalTokensResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *UserServiceListPersonalTokensResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceListPersonalTokensResult(%+v)", *p)
+
+}
+
+type UserServiceRevokePersonalTokenArgs struct {
+	Req *RevokePersonalTokenReq `thrift:"req,1"`
+}
+
+func NewUserServiceRevokePersonalTokenArgs() *UserServiceRevokePersonalTokenArgs {
+	return &UserServiceRevokePersonalTokenArgs{}
+}
+
+func (p *UserServiceRevokePersonalTokenArgs) InitDefault() {
+}
+
+var UserServiceRevokePersonalTokenArgs_Req_DEFAULT *RevokePersonalTokenReq
+
+func (p *UserServiceRevokePersonalTokenArgs) GetReq() (v *RevokePersonalTokenReq) {
+	if !p.IsSetReq() {
+		return UserServiceRevokePersonalTokenArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_UserServiceRevokePersonalTokenArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *UserServiceRevokePersonalTokenArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceRevokePersonalTokenArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceRevokePersonalTokenArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserServiceRevokePersonalTokenArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewRevokePersonalTokenReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *UserServiceRevokePersonalTokenArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("revokePersonalToken_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserServiceRevokePersonalTokenArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *UserServiceRevokePersonalTokenArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceRevokePersonalTokenArgs(%+v)", *p)
+
+}
+
+type UserServiceRevokePersonalTokenResult struct {
+	Success *RevokePersonalTokenResp `thrift:"success,0,optional"`
+}
+
+func NewUserServiceRevokePersonalTokenResult() *UserServiceRevokePersonalTokenResult {
+	return &UserServiceRevokePersonalTokenResult{}
+}
+
+func (p *UserServiceRevokePersonalTokenResult) InitDefault() {
+}
+
+var UserServiceRevokePersonalTokenResult_Success_DEFAULT *RevokePersonalTokenResp
+
+func (p *UserServiceRevokePersonalTokenResult) GetSuccess() (v *RevokePersonalTokenResp) {
+	if !p.IsSetSuccess() {
+		return UserServiceRevokePersonalTokenResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_UserServiceRevokePersonalTokenResult = map[int16]string{
+	0: "success",
+}
+
+func (p *UserServiceRevokePersonalTokenResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceRevokePersonalTokenResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceRevokePersonalTokenResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserServiceRevokePersonalTokenResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewRevokePersonalTokenResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *UserServiceRevokePersonalTokenResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("revokePersonalToken_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserServiceRevokePersonalTokenResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *UserServiceRevokePersonalTokenResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceRevokePersonalTokenResult(%+v)", *p)
+
+}
+
+type UserAdminServiceProcessor struct {
+	processorMap map[string]thrift.TProcessorFunction
+	handler      UserAdminService
+}
+
+func (p *UserAdminServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
+	p.processorMap[key] = processor
+}
+
+func (p *UserAdminServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
+	processor, ok = p.processorMap[key]
+	return processor, ok
+}
+
+func (p *UserAdminServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
+	return p.processorMap
+}
+
+func NewUserAdminServiceProcessor(handler UserAdminService) *UserAdminServiceProcessor {
+	self := &UserAdminServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
+	self.AddToProcessorMap("AdminAddUser", &userAdminServiceProcessorAdminAddUser{handler: handler})
+	self.AddToProcessorMap("AdminUpdateUser", &userAdminServiceProcessorAdminUpdateUser{handler: handler})
+	return self
+}
+func (p *UserAdminServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	name, _, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return false, err
+	}
+	if processor, ok := p.GetProcessorFunction(name); ok {
+		return processor.Process(ctx, seqId, iprot, oprot)
+	}
+	iprot.Skip(thrift.STRUCT)
+	iprot.ReadMessageEnd()
+	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
+	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
+	x.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Flush(ctx)
+	return false, x
+}
+
+type userAdminServiceProcessorAdminAddUser struct {
+	handler UserAdminService
+}
+
+func (p *userAdminServiceProcessorAdminAddUser) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserAdminServiceAdminAddUserArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("AdminAddUser", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := UserAdminServiceAdminAddUserResult{}
+	var retval *AdminAddUserResp
+	if retval, err2 = p.handler.AdminAddUser(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing AdminAddUser: "+err2.Error())
+		oprot.WriteMessageBegin("AdminAddUser", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("AdminAddUser", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type userAdminServiceProcessorAdminUpdateUser struct {
+	handler UserAdminService
+}
+
+func (p *userAdminServiceProcessorAdminUpdateUser) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserAdminServiceAdminUpdateUserArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("AdminUpdateUser", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := UserAdminServiceAdminUpdateUserResult{}
+	var retval *AdminUpdateUserResp
+	if retval, err2 = p.handler.AdminUpdateUser(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing AdminUpdateUser: "+err2.Error())
+		oprot.WriteMessageBegin("AdminUpdateUser", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("AdminUpdateUser", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type UserAdminServiceAdminAddUserArgs struct {
+	Req *AdminAddUserReq `thrift:"req,1"`
+}
+
+func NewUserAdminServiceAdminAddUserArgs() *UserAdminServiceAdminAddUserArgs {
+	return &UserAdminServiceAdminAddUserArgs{}
+}
+
+func (p *UserAdminServiceAdminAddUserArgs) InitDefault() {
+}
+
+var UserAdminServiceAdminAddUserArgs_Req_DEFAULT *AdminAddUserReq
+
+func (p *UserAdminServiceAdminAddUserArgs) GetReq() (v *AdminAddUserReq) {
+	if !p.IsSetReq() {
+		return UserAdminServiceAdminAddUserArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_UserAdminServiceAdminAddUserArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *UserAdminServiceAdminAddUserArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserAdminServiceAdminAddUserArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserAdminServiceAdminAddUserArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserAdminServiceAdminAddUserArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewAdminAddUserReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *UserAdminServiceAdminAddUserArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AdminAddUser_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserAdminServiceAdminAddUserArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *UserAdminServiceAdminAddUserArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserAdminServiceAdminAddUserArgs(%+v)", *p)
+
+}
+
+type UserAdminServiceAdminAddUserResult struct {
+	Success *AdminAddUserResp `thrift:"success,0,optional"`
+}
+
+func NewUserAdminServiceAdminAddUserResult() *UserAdminServiceAdminAddUserResult {
+	return &UserAdminServiceAdminAddUserResult{}
+}
+
+func (p *UserAdminServiceAdminAddUserResult) InitDefault() {
+}
+
+var UserAdminServiceAdminAddUserResult_Success_DEFAULT *AdminAddUserResp
+
+func (p *UserAdminServiceAdminAddUserResult) GetSuccess() (v *AdminAddUserResp) {
+	if !p.IsSetSuccess() {
+		return UserAdminServiceAdminAddUserResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_UserAdminServiceAdminAddUserResult = map[int16]string{
+	0: "success",
+}
+
+func (p *UserAdminServiceAdminAddUserResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserAdminServiceAdminAddUserResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserAdminServiceAdminAddUserResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserAdminServiceAdminAddUserResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewAdminAddUserResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *UserAdminServiceAdminAddUserResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AdminAddUser_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserAdminServiceAdminAddUserResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *UserAdminServiceAdminAddUserResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserAdminServiceAdminAddUserResult(%+v)", *p)
+
+}
+
+type UserAdminServiceAdminUpdateUserArgs struct {
+	Req *AdminUpdateUserReq `thrift:"req,1"`
+}
+
+func NewUserAdminServiceAdminUpdateUserArgs() *UserAdminServiceAdminUpdateUserArgs {
+	return &UserAdminServiceAdminUpdateUserArgs{}
+}
+
+func (p *UserAdminServiceAdminUpdateUserArgs) InitDefault() {
+}
+
+var UserAdminServiceAdminUpdateUserArgs_Req_DEFAULT *AdminUpdateUserReq
+
+func (p *UserAdminServiceAdminUpdateUserArgs) GetReq() (v *AdminUpdateUserReq) {
+	if !p.IsSetReq() {
+		return UserAdminServiceAdminUpdateUserArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_UserAdminServiceAdminUpdateUserArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *UserAdminServiceAdminUpdateUserArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserAdminServiceAdminUpdateUserArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserAdminServiceAdminUpdateUserArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserAdminServiceAdminUpdateUserArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewAdminUpdateUserReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *UserAdminServiceAdminUpdateUserArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AdminUpdateUser_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserAdminServiceAdminUpdateUserArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *UserAdminServiceAdminUpdateUserArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserAdminServiceAdminUpdateUserArgs(%+v)", *p)
+
+}
+
+type UserAdminServiceAdminUpdateUserResult struct {
+	Success *AdminUpdateUserResp `thrift:"success,0,optional"`
+}
+
+func NewUserAdminServiceAdminUpdateUserResult() *UserAdminServiceAdminUpdateUserResult {
+	return &UserAdminServiceAdminUpdateUserResult{}
+}
+
+func (p *UserAdminServiceAdminUpdateUserResult) InitDefault() {
+}
+
+var UserAdminServiceAdminUpdateUserResult_Success_DEFAULT *AdminUpdateUserResp
+
+func (p *UserAdminServiceAdminUpdateUserResult) GetSuccess() (v *AdminUpdateUserResp) {
+	if !p.IsSetSuccess() {
+		return UserAdminServiceAdminUpdateUserResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_UserAdminServiceAdminUpdateUserResult = map[int16]string{
+	0: "success",
+}
+
+func (p *UserAdminServiceAdminUpdateUserResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserAdminServiceAdminUpdateUserResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserAdminServiceAdminUpdateUserResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserAdminServiceAdminUpdateUserResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewAdminUpdateUserResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *UserAdminServiceAdminUpdateUserResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AdminUpdateUser_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserAdminServiceAdminUpdateUserResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *UserAdminServiceAdminUpdateUserResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserAdminServiceAdminUpdateUserResult(%+v)", *p)
+
+}
+
+type RoleAdminServiceProcessor struct {
+	processorMap map[string]thrift.TProcessorFunction
+	handler      RoleAdminService
+}
+
+func (p *RoleAdminServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
+	p.processorMap[key] = processor
+}
+
+func (p *RoleAdminServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
+	processor, ok = p.processorMap[key]
+	return processor, ok
+}
+
+func (p *RoleAdminServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
+	return p.processorMap
+}
+
+func NewRoleAdminServiceProcessor(handler RoleAdminService) *RoleAdminServiceProcessor {
+	self := &RoleAdminServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
+	self.AddToProcessorMap("GetPermissionList", &roleAdminServiceProcessorGetPermissionList{handler: handler})
+	self.AddToProcessorMap("GetRoleList", &roleAdminServiceProcessorGetRoleList{handler: handler})
+	self.AddToProcessorMap("AddRole", &roleAdminServiceProcessorAddRole{handler: handler})
+	self.AddToProcessorMap("UpdateRole", &roleAdminServiceProcessorUpdateRole{handler: handler})
+	self.AddToProcessorMap("DeleteRole", &roleAdminServiceProcessorDeleteRole{handler: handler})
+	self.AddToProcessorMap("GrantRolePermissions", &roleAdminServiceProcessorGrantRolePermissions{handler: handler})
+	self.AddToProcessorMap("RevokeRolePermissions", &roleAdminServiceProcessorRevokeRolePermissions{handler: handler})
+	self.AddToProcessorMap("GetRoleUsers", &roleAdminServiceProcessorGetRoleUsers{handler: handler})
+	return self
+}
+func (p *RoleAdminServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	name, _, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return false, err
+	}
+	if processor, ok := p.GetProcessorFunction(name); ok {
+		return processor.Process(ctx, seqId, iprot, oprot)
+	}
+	iprot.Skip(thrift.STRUCT)
+	iprot.ReadMessageEnd()
+	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
+	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
+	x.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Flush(ctx)
+	return false, x
+}
+
+type roleAdminServiceProcessorGetPermissionList struct {
+	handler RoleAdminService
+}
+
+func (p *roleAdminServiceProcessorGetPermissionList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := RoleAdminServiceGetPermissionListArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetPermissionList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := RoleAdminServiceGetPermissionListResult{}
+	var retval *GetPermissionListResp
+	if retval, err2 = p.handler.GetPermissionList(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetPermissionList: "+err2.Error())
+		oprot.WriteMessageBegin("GetPermissionList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetPermissionList", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type roleAdminServiceProcessorGetRoleList struct {
+	handler RoleAdminService
+}
+
+func (p *roleAdminServiceProcessorGetRoleList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := RoleAdminServiceGetRoleListArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetRoleList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := RoleAdminServiceGetRoleListResult{}
+	var retval *GetRoleListResp
+	if retval, err2 = p.handler.GetRoleList(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetRoleList: "+err2.Error())
+		oprot.WriteMessageBegin("GetRoleList", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetRoleList", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type roleAdminServiceProcessorAddRole struct {
+	handler RoleAdminService
+}
+
+func (p *roleAdminServiceProcessorAddRole) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := RoleAdminServiceAddRoleArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("AddRole", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := RoleAdminServiceAddRoleResult{}
+	var retval *AddRoleResp
+	if retval, err2 = p.handler.AddRole(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing AddRole: "+err2.Error())
+		oprot.WriteMessageBegin("AddRole", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("AddRole", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type roleAdminServiceProcessorUpdateRole struct {
+	handler RoleAdminService
+}
+
+func (p *roleAdminServiceProcessorUpdateRole) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := RoleAdminServiceUpdateRoleArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("UpdateRole", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := RoleAdminServiceUpdateRoleResult{}
+	var retval *UpdateRoleResp
+	if retval, err2 = p.handler.UpdateRole(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UpdateRole: "+err2.Error())
+		oprot.WriteMessageBegin("UpdateRole", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("UpdateRole", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type roleAdminServiceProcessorDeleteRole struct {
+	handler RoleAdminService
+}
+
+func (p *roleAdminServiceProcessorDeleteRole) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := RoleAdminServiceDeleteRoleArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("DeleteRole", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := RoleAdminServiceDeleteRoleResult{}
+	var retval *DeleteRoleResp
+	if retval, err2 = p.handler.DeleteRole(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DeleteRole: "+err2.Error())
+		oprot.WriteMessageBegin("DeleteRole", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("DeleteRole", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type roleAdminServiceProcessorGrantRolePermissions struct {
+	handler RoleAdminService
+}
+
+func (p *roleAdminServiceProcessorGrantRolePermissions) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := RoleAdminServiceGrantRolePermissionsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GrantRolePermissions", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := RoleAdminServiceGrantRolePermissionsResult{}
+	var retval *GrantRolePermissionsResp
+	if retval, err2 = p.handler.GrantRolePermissions(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GrantRolePermissions: "+err2.Error())
+		oprot.WriteMessageBegin("GrantRolePermissions", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GrantRolePermissions", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type roleAdminServiceProcessorRevokeRolePermissions struct {
+	handler RoleAdminService
+}
+
+func (p *roleAdminServiceProcessorRevokeRolePermissions) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := RoleAdminServiceRevokeRolePermissionsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("RevokeRolePermissions", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := RoleAdminServiceRevokeRolePermissionsResult{}
+	var retval *RevokeRolePermissionsResp
+	if retval, err2 = p.handler.RevokeRolePermissions(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RevokeRolePermissions: "+err2.Error())
+		oprot.WriteMessageBegin("RevokeRolePermissions", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("RevokeRolePermissions", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type roleAdminServiceProcessorGetRoleUsers struct {
+	handler RoleAdminService
+}
+
+func (p *roleAdminServiceProcessorGetRoleUsers) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := RoleAdminServiceGetRoleUsersArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetRoleUsers", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := RoleAdminServiceGetRoleUsersResult{}
+	var retval *GetRoleUsersResp
+	if retval, err2 = p.handler.GetRoleUsers(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetRoleUsers: "+err2.Error())
+		oprot.WriteMessageBegin("GetRoleUsers", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetRoleUsers", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
 		err = err2
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type RoleAdminServiceGetPermissionListArgs struct {
+	Req *GetPermissionListReq `thrift:"req,1"`
+}
+
+func NewRoleAdminServiceGetPermissionListArgs() *RoleAdminServiceGetPermissionListArgs {
+	return &RoleAdminServiceGetPermissionListArgs{}
+}
+
+func (p *RoleAdminServiceGetPermissionListArgs) InitDefault() {
+}
+
+var RoleAdminServiceGetPermissionListArgs_Req_DEFAULT *GetPermissionListReq
+
+func (p *RoleAdminServiceGetPermissionListArgs) GetReq() (v *GetPermissionListReq) {
+	if !p.IsSetReq() {
+		return RoleAdminServiceGetPermissionListArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_RoleAdminServiceGetPermissionListArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *RoleAdminServiceGetPermissionListArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *RoleAdminServiceGetPermissionListArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RoleAdminServiceGetPermissionListArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *RoleAdminServiceGetPermissionListArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetPermissionListReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *RoleAdminServiceGetPermissionListArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetPermissionList_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RoleAdminServiceGetPermissionListArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *RoleAdminServiceGetPermissionListArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RoleAdminServiceGetPermissionListArgs(%+v)", *p)
+
+}
+
+type RoleAdminServiceGetPermissionListResult struct {
+	Success *GetPermissionListResp `thrift:"success,0,optional"`
+}
+
+func NewRoleAdminServiceGetPermissionListResult() *RoleAdminServiceGetPermissionListResult {
+	return &RoleAdminServiceGetPermissionListResult{}
+}
+
+func (p *RoleAdminServiceGetPermissionListResult) InitDefault() {
+}
+
+var RoleAdminServiceGetPermissionListResult_Success_DEFAULT *GetPermissionListResp
+
+func (p *RoleAdminServiceGetPermissionListResult) GetSuccess() (v *GetPermissionListResp) {
+	if !p.IsSetSuccess() {
+		return RoleAdminServiceGetPermissionListResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_RoleAdminServiceGetPermissionListResult = map[int16]string{
+	0: "success",
+}
+
+func (p *RoleAdminServiceGetPermissionListResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *RoleAdminServiceGetPermissionListResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RoleAdminServiceGetPermissionListResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *RoleAdminServiceGetPermissionListResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetPermissionListResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *RoleAdminServiceGetPermissionListResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetPermissionList_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RoleAdminServiceGetPermissionListResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *RoleAdminServiceGetPermissionListResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return true, err
+	return fmt.Sprintf("RoleAdminServiceGetPermissionListResult(%+v)", *p)
+
 }
 
-type UserServiceRegisterArgs struct {
-	Req *RegisterReq `thrift:"req,1"`
+type RoleAdminServiceGetRoleListArgs struct {
+	Req *GetRoleListReq `thrift:"req,1"`
 }
 
-func NewUserServiceRegisterArgs() *UserServiceRegisterArgs {
-	return &UserServiceRegisterArgs{}
+func NewRoleAdminServiceGetRoleListArgs() *RoleAdminServiceGetRoleListArgs {
+	return &RoleAdminServiceGetRoleListArgs{}
 }
 
-func (p *UserServiceRegisterArgs) InitDefault() {
+func (p *RoleAdminServiceGetRoleListArgs) InitDefault() {
 }
 
-var UserServiceRegisterArgs_Req_DEFAULT *RegisterReq
+var RoleAdminServiceGetRoleListArgs_Req_DEFAULT *GetRoleListReq
 
-func (p *UserServiceRegisterArgs) GetReq() (v *RegisterReq) {
+func (p *RoleAdminServiceGetRoleListArgs) GetReq() (v *GetRoleListReq) {
 	if !p.IsSetReq() {
-		return UserServiceRegisterArgs_Req_DEFAULT
+		return RoleAdminServiceGetRoleListArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_UserServiceRegisterArgs = map[int16]string{
+var fieldIDToName_RoleAdminServiceGetRoleListArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *UserServiceRegisterArgs) IsSetReq() bool {
+func (p *RoleAdminServiceGetRoleListArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *UserServiceRegisterArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *RoleAdminServiceGetRoleListArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -7388,7 +26371,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceRegisterArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RoleAdminServiceGetRoleListArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7398,8 +26381,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceRegisterArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewRegisterReq()
+func (p *RoleAdminServiceGetRoleListArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetRoleListReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -7407,9 +26390,9 @@ func (p *UserServiceRegisterArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *UserServiceRegisterArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *RoleAdminServiceGetRoleListArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("register_args"); err != nil {
+	if err = oprot.WriteStructBegin("GetRoleList_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7435,7 +26418,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceRegisterArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *RoleAdminServiceGetRoleListArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -7452,43 +26435,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *UserServiceRegisterArgs) String() string {
+func (p *RoleAdminServiceGetRoleListArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceRegisterArgs(%+v)", *p)
+	return fmt.Sprintf("RoleAdminServiceGetRoleListArgs(%+v)", *p)
 
 }
 
-type UserServiceRegisterResult struct {
-	Success *RegisterResp `thrift:"success,0,optional"`
+type RoleAdminServiceGetRoleListResult struct {
+	Success *GetRoleListResp `thrift:"success,0,optional"`
 }
 
-func NewUserServiceRegisterResult() *UserServiceRegisterResult {
-	return &UserServiceRegisterResult{}
+func NewRoleAdminServiceGetRoleListResult() *RoleAdminServiceGetRoleListResult {
+	return &RoleAdminServiceGetRoleListResult{}
 }
 
-func (p *UserServiceRegisterResult) InitDefault() {
+func (p *RoleAdminServiceGetRoleListResult) InitDefault() {
 }
 
-var UserServiceRegisterResult_Success_DEFAULT *RegisterResp
+var RoleAdminServiceGetRoleListResult_Success_DEFAULT *GetRoleListResp
 
-func (p *UserServiceRegisterResult) GetSuccess() (v *RegisterResp) {
+func (p *RoleAdminServiceGetRoleListResult) GetSuccess() (v *GetRoleListResp) {
 	if !p.IsSetSuccess() {
-		return UserServiceRegisterResult_Success_DEFAULT
+		return RoleAdminServiceGetRoleListResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_UserServiceRegisterResult = map[int16]string{
+var fieldIDToName_RoleAdminServiceGetRoleListResult = map[int16]string{
 	0: "success",
 }
 
-func (p *UserServiceRegisterResult) IsSetSuccess() bool {
+func (p *RoleAdminServiceGetRoleListResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserServiceRegisterResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *RoleAdminServiceGetRoleListResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -7534,7 +26517,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceRegisterResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RoleAdminServiceGetRoleListResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7544,8 +26527,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceRegisterResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewRegisterResp()
+func (p *RoleAdminServiceGetRoleListResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetRoleListResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -7553,9 +26536,9 @@ func (p *UserServiceRegisterResult) ReadField0(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *UserServiceRegisterResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *RoleAdminServiceGetRoleListResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("register_result"); err != nil {
+	if err = oprot.WriteStructBegin("GetRoleList_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7581,7 +26564,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceRegisterResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *RoleAdminServiceGetRoleListResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -7600,43 +26583,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *UserServiceRegisterResult) String() string {
+func (p *RoleAdminServiceGetRoleListResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceRegisterResult(%+v)", *p)
+	return fmt.Sprintf("RoleAdminServiceGetRoleListResult(%+v)", *p)
 
 }
 
-type UserServiceLoginInArgs struct {
-	Req *LoginInReq `thrift:"req,1"`
+type RoleAdminServiceAddRoleArgs struct {
+	Req *AddRoleReq `thrift:"req,1"`
 }
 
-func NewUserServiceLoginInArgs() *UserServiceLoginInArgs {
-	return &UserServiceLoginInArgs{}
+func NewRoleAdminServiceAddRoleArgs() *RoleAdminServiceAddRoleArgs {
+	return &RoleAdminServiceAddRoleArgs{}
 }
 
-func (p *UserServiceLoginInArgs) InitDefault() {
+func (p *RoleAdminServiceAddRoleArgs) InitDefault() {
 }
 
-var UserServiceLoginInArgs_Req_DEFAULT *LoginInReq
+var RoleAdminServiceAddRoleArgs_Req_DEFAULT *AddRoleReq
 
-func (p *UserServiceLoginInArgs) GetReq() (v *LoginInReq) {
+func (p *RoleAdminServiceAddRoleArgs) GetReq() (v *AddRoleReq) {
 	if !p.IsSetReq() {
-		return UserServiceLoginInArgs_Req_DEFAULT
+		return RoleAdminServiceAddRoleArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_UserServiceLoginInArgs = map[int16]string{
+var fieldIDToName_RoleAdminServiceAddRoleArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *UserServiceLoginInArgs) IsSetReq() bool {
+func (p *RoleAdminServiceAddRoleArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *UserServiceLoginInArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *RoleAdminServiceAddRoleArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -7682,7 +26665,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceLoginInArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RoleAdminServiceAddRoleArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7692,8 +26675,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceLoginInArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewLoginInReq()
+func (p *RoleAdminServiceAddRoleArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewAddRoleReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -7701,9 +26684,9 @@ func (p *UserServiceLoginInArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *UserServiceLoginInArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *RoleAdminServiceAddRoleArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("loginIn_args"); err != nil {
+	if err = oprot.WriteStructBegin("AddRole_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7729,7 +26712,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceLoginInArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *RoleAdminServiceAddRoleArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -7746,43 +26729,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *UserServiceLoginInArgs) String() string {
+func (p *RoleAdminServiceAddRoleArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceLoginInArgs(%+v)", *p)
+	return fmt.Sprintf("RoleAdminServiceAddRoleArgs(%+v)", *p)
 
 }
 
-type UserServiceLoginInResult struct {
-	Success *LoginInResp `thrift:"success,0,optional"`
+type RoleAdminServiceAddRoleResult struct {
+	Success *AddRoleResp `thrift:"success,0,optional"`
 }
 
-func NewUserServiceLoginInResult() *UserServiceLoginInResult {
-	return &UserServiceLoginInResult{}
+func NewRoleAdminServiceAddRoleResult() *RoleAdminServiceAddRoleResult {
+	return &RoleAdminServiceAddRoleResult{}
 }
 
-func (p *UserServiceLoginInResult) InitDefault() {
+func (p *RoleAdminServiceAddRoleResult) InitDefault() {
 }
 
-var UserServiceLoginInResult_Success_DEFAULT *LoginInResp
+var RoleAdminServiceAddRoleResult_Success_DEFAULT *AddRoleResp
 
-func (p *UserServiceLoginInResult) GetSuccess() (v *LoginInResp) {
+func (p *RoleAdminServiceAddRoleResult) GetSuccess() (v *AddRoleResp) {
 	if !p.IsSetSuccess() {
-		return UserServiceLoginInResult_Success_DEFAULT
+		return RoleAdminServiceAddRoleResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_UserServiceLoginInResult = map[int16]string{
+var fieldIDToName_RoleAdminServiceAddRoleResult = map[int16]string{
 	0: "success",
 }
 
-func (p *UserServiceLoginInResult) IsSetSuccess() bool {
+func (p *RoleAdminServiceAddRoleResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserServiceLoginInResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *RoleAdminServiceAddRoleResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -7828,7 +26811,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceLoginInResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RoleAdminServiceAddRoleResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7838,8 +26821,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceLoginInResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewLoginInResp()
+func (p *RoleAdminServiceAddRoleResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewAddRoleResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -7847,9 +26830,9 @@ func (p *UserServiceLoginInResult) ReadField0(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *UserServiceLoginInResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *RoleAdminServiceAddRoleResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("loginIn_result"); err != nil {
+	if err = oprot.WriteStructBegin("AddRole_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7875,7 +26858,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceLoginInResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *RoleAdminServiceAddRoleResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -7894,43 +26877,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *UserServiceLoginInResult) String() string {
+func (p *RoleAdminServiceAddRoleResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceLoginInResult(%+v)", *p)
+	return fmt.Sprintf("RoleAdminServiceAddRoleResult(%+v)", *p)
 
 }
 
-type UserServiceLoginOutArgs struct {
-	Req *LoginOutReq `thrift:"req,1"`
+type RoleAdminServiceUpdateRoleArgs struct {
+	Req *UpdateRoleReq `thrift:"req,1"`
 }
 
-func NewUserServiceLoginOutArgs() *UserServiceLoginOutArgs {
-	return &UserServiceLoginOutArgs{}
+func NewRoleAdminServiceUpdateRoleArgs() *RoleAdminServiceUpdateRoleArgs {
+	return &RoleAdminServiceUpdateRoleArgs{}
 }
 
-func (p *UserServiceLoginOutArgs) InitDefault() {
+func (p *RoleAdminServiceUpdateRoleArgs) InitDefault() {
 }
 
-var UserServiceLoginOutArgs_Req_DEFAULT *LoginOutReq
+var RoleAdminServiceUpdateRoleArgs_Req_DEFAULT *UpdateRoleReq
 
-func (p *UserServiceLoginOutArgs) GetReq() (v *LoginOutReq) {
+func (p *RoleAdminServiceUpdateRoleArgs) GetReq() (v *UpdateRoleReq) {
 	if !p.IsSetReq() {
-		return UserServiceLoginOutArgs_Req_DEFAULT
+		return RoleAdminServiceUpdateRoleArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_UserServiceLoginOutArgs = map[int16]string{
+var fieldIDToName_RoleAdminServiceUpdateRoleArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *UserServiceLoginOutArgs) IsSetReq() bool {
+func (p *RoleAdminServiceUpdateRoleArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *UserServiceLoginOutArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *RoleAdminServiceUpdateRoleArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -7976,7 +26959,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceLoginOutArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RoleAdminServiceUpdateRoleArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7986,8 +26969,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceLoginOutArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewLoginOutReq()
+func (p *RoleAdminServiceUpdateRoleArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewUpdateRoleReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -7995,9 +26978,9 @@ func (p *UserServiceLoginOutArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *UserServiceLoginOutArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *RoleAdminServiceUpdateRoleArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("loginOut_args"); err != nil {
+	if err = oprot.WriteStructBegin("UpdateRole_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -8023,7 +27006,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceLoginOutArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *RoleAdminServiceUpdateRoleArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -8040,43 +27023,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *UserServiceLoginOutArgs) String() string {
+func (p *RoleAdminServiceUpdateRoleArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceLoginOutArgs(%+v)", *p)
+	return fmt.Sprintf("RoleAdminServiceUpdateRoleArgs(%+v)", *p)
 
 }
 
-type UserServiceLoginOutResult struct {
-	Success *LoginOutResp `thrift:"success,0,optional"`
+type RoleAdminServiceUpdateRoleResult struct {
+	Success *UpdateRoleResp `thrift:"success,0,optional"`
 }
 
-func NewUserServiceLoginOutResult() *UserServiceLoginOutResult {
-	return &UserServiceLoginOutResult{}
+func NewRoleAdminServiceUpdateRoleResult() *RoleAdminServiceUpdateRoleResult {
+	return &RoleAdminServiceUpdateRoleResult{}
 }
 
-func (p *UserServiceLoginOutResult) InitDefault() {
+func (p *RoleAdminServiceUpdateRoleResult) InitDefault() {
 }
 
-var UserServiceLoginOutResult_Success_DEFAULT *LoginOutResp
+var RoleAdminServiceUpdateRoleResult_Success_DEFAULT *UpdateRoleResp
 
-func (p *UserServiceLoginOutResult) GetSuccess() (v *LoginOutResp) {
+func (p *RoleAdminServiceUpdateRoleResult) GetSuccess() (v *UpdateRoleResp) {
 	if !p.IsSetSuccess() {
-		return UserServiceLoginOutResult_Success_DEFAULT
+		return RoleAdminServiceUpdateRoleResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_UserServiceLoginOutResult = map[int16]string{
+var fieldIDToName_RoleAdminServiceUpdateRoleResult = map[int16]string{
 	0: "success",
 }
 
-func (p *UserServiceLoginOutResult) IsSetSuccess() bool {
+func (p *RoleAdminServiceUpdateRoleResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserServiceLoginOutResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *RoleAdminServiceUpdateRoleResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -8122,7 +27105,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceLoginOutResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RoleAdminServiceUpdateRoleResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -8132,8 +27115,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceLoginOutResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewLoginOutResp()
+func (p *RoleAdminServiceUpdateRoleResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewUpdateRoleResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -8141,9 +27124,9 @@ func (p *UserServiceLoginOutResult) ReadField0(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *UserServiceLoginOutResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *RoleAdminServiceUpdateRoleResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("loginOut_result"); err != nil {
+	if err = oprot.WriteStructBegin("UpdateRole_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -8169,7 +27152,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceLoginOutResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *RoleAdminServiceUpdateRoleResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -8188,43 +27171,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *UserServiceLoginOutResult) String() string {
+func (p *RoleAdminServiceUpdateRoleResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceLoginOutResult(%+v)", *p)
+	return fmt.Sprintf("RoleAdminServiceUpdateRoleResult(%+v)", *p)
 
 }
 
-type UserServiceSendVerifyEmailArgs struct {
-	Req *SendVerifyEmailReq `thrift:"req,1"`
+type RoleAdminServiceDeleteRoleArgs struct {
+	Req *DeleteRoleReq `thrift:"req,1"`
 }
 
-func NewUserServiceSendVerifyEmailArgs() *UserServiceSendVerifyEmailArgs {
-	return &UserServiceSendVerifyEmailArgs{}
+func NewRoleAdminServiceDeleteRoleArgs() *RoleAdminServiceDeleteRoleArgs {
+	return &RoleAdminServiceDeleteRoleArgs{}
 }
 
-func (p *UserServiceSendVerifyEmailArgs) InitDefault() {
+func (p *RoleAdminServiceDeleteRoleArgs) InitDefault() {
 }
 
-var UserServiceSendVerifyEmailArgs_Req_DEFAULT *SendVerifyEmailReq
+var RoleAdminServiceDeleteRoleArgs_Req_DEFAULT *DeleteRoleReq
 
-func (p *UserServiceSendVerifyEmailArgs) GetReq() (v *SendVerifyEmailReq) {
+func (p *RoleAdminServiceDeleteRoleArgs) GetReq() (v *DeleteRoleReq) {
 	if !p.IsSetReq() {
-		return UserServiceSendVerifyEmailArgs_Req_DEFAULT
+		return RoleAdminServiceDeleteRoleArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_UserServiceSendVerifyEmailArgs = map[int16]string{
+var fieldIDToName_RoleAdminServiceDeleteRoleArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *UserServiceSendVerifyEmailArgs) IsSetReq() bool {
+func (p *RoleAdminServiceDeleteRoleArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *UserServiceSendVerifyEmailArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *RoleAdminServiceDeleteRoleArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -8270,7 +27253,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceSendVerifyEmailArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RoleAdminServiceDeleteRoleArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -8280,8 +27263,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceSendVerifyEmailArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewSendVerifyEmailReq()
+func (p *RoleAdminServiceDeleteRoleArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewDeleteRoleReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -8289,9 +27272,9 @@ func (p *UserServiceSendVerifyEmailArgs) ReadField1(iprot thrift.TProtocol) erro
 	return nil
 }
 
-func (p *UserServiceSendVerifyEmailArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *RoleAdminServiceDeleteRoleArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("sendVerifyEmail_args"); err != nil {
+	if err = oprot.WriteStructBegin("DeleteRole_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -8317,7 +27300,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceSendVerifyEmailArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *RoleAdminServiceDeleteRoleArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -8334,43 +27317,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *UserServiceSendVerifyEmailArgs) String() string {
+func (p *RoleAdminServiceDeleteRoleArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceSendVerifyEmailArgs(%+v)", *p)
+	return fmt.Sprintf("RoleAdminServiceDeleteRoleArgs(%+v)", *p)
 
 }
 
-type UserServiceSendVerifyEmailResult struct {
-	Success *SendVerifyEmailResp `thrift:"success,0,optional"`
+type RoleAdminServiceDeleteRoleResult struct {
+	Success *DeleteRoleResp `thrift:"success,0,optional"`
 }
 
-func NewUserServiceSendVerifyEmailResult() *UserServiceSendVerifyEmailResult {
-	return &UserServiceSendVerifyEmailResult{}
+func NewRoleAdminServiceDeleteRoleResult() *RoleAdminServiceDeleteRoleResult {
+	return &RoleAdminServiceDeleteRoleResult{}
 }
 
-func (p *UserServiceSendVerifyEmailResult) InitDefault() {
+func (p *RoleAdminServiceDeleteRoleResult) InitDefault() {
 }
 
-var UserServiceSendVerifyEmailResult_Success_DEFAULT *SendVerifyEmailResp
+var RoleAdminServiceDeleteRoleResult_Success_DEFAULT *DeleteRoleResp
 
-func (p *UserServiceSendVerifyEmailResult) GetSuccess() (v *SendVerifyEmailResp) {
+func (p *RoleAdminServiceDeleteRoleResult) GetSuccess() (v *DeleteRoleResp) {
 	if !p.IsSetSuccess() {
-		return UserServiceSendVerifyEmailResult_Success_DEFAULT
+		return RoleAdminServiceDeleteRoleResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_UserServiceSendVerifyEmailResult = map[int16]string{
+var fieldIDToName_RoleAdminServiceDeleteRoleResult = map[int16]string{
 	0: "success",
 }
 
-func (p *UserServiceSendVerifyEmailResult) IsSetSuccess() bool {
+func (p *RoleAdminServiceDeleteRoleResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserServiceSendVerifyEmailResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *RoleAdminServiceDeleteRoleResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -8416,7 +27399,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceSendVerifyEmailResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RoleAdminServiceDeleteRoleResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -8426,8 +27409,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceSendVerifyEmailResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewSendVerifyEmailResp()
+func (p *RoleAdminServiceDeleteRoleResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewDeleteRoleResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -8435,9 +27418,9 @@ func (p *UserServiceSendVerifyEmailResult) ReadField0(iprot thrift.TProtocol) er
 	return nil
 }
 
-func (p *UserServiceSendVerifyEmailResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *RoleAdminServiceDeleteRoleResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("sendVerifyEmail_result"); err != nil {
+	if err = oprot.WriteStructBegin("DeleteRole_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -8463,7 +27446,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceSendVerifyEmailResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *RoleAdminServiceDeleteRoleResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -8482,43 +27465,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *UserServiceSendVerifyEmailResult) String() string {
+func (p *RoleAdminServiceDeleteRoleResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceSendVerifyEmailResult(%+v)", *p)
+	return fmt.Sprintf("RoleAdminServiceDeleteRoleResult(%+v)", *p)
 
 }
 
-type UserServiceVerifyEmailArgs struct {
-	Req *VerifyEmailReq `thrift:"req,1"`
+type RoleAdminServiceGrantRolePermissionsArgs struct {
+	Req *GrantRolePermissionsReq `thrift:"req,1"`
 }
 
-func NewUserServiceVerifyEmailArgs() *UserServiceVerifyEmailArgs {
-	return &UserServiceVerifyEmailArgs{}
+func NewRoleAdminServiceGrantRolePermissionsArgs() *RoleAdminServiceGrantRolePermissionsArgs {
+	return &RoleAdminServiceGrantRolePermissionsArgs{}
 }
 
-func (p *UserServiceVerifyEmailArgs) InitDefault() {
+func (p *RoleAdminServiceGrantRolePermissionsArgs) InitDefault() {
 }
 
-var UserServiceVerifyEmailArgs_Req_DEFAULT *VerifyEmailReq
+var RoleAdminServiceGrantRolePermissionsArgs_Req_DEFAULT *GrantRolePermissionsReq
 
-func (p *UserServiceVerifyEmailArgs) GetReq() (v *VerifyEmailReq) {
+func (p *RoleAdminServiceGrantRolePermissionsArgs) GetReq() (v *GrantRolePermissionsReq) {
 	if !p.IsSetReq() {
-		return UserServiceVerifyEmailArgs_Req_DEFAULT
+		return RoleAdminServiceGrantRolePermissionsArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_UserServiceVerifyEmailArgs = map[int16]string{
+var fieldIDToName_RoleAdminServiceGrantRolePermissionsArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *UserServiceVerifyEmailArgs) IsSetReq() bool {
+func (p *RoleAdminServiceGrantRolePermissionsArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *UserServiceVerifyEmailArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *RoleAdminServiceGrantRolePermissionsArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -8564,7 +27547,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceVerifyEmailArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RoleAdminServiceGrantRolePermissionsArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -8574,8 +27557,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceVerifyEmailArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewVerifyEmailReq()
+func (p *RoleAdminServiceGrantRolePermissionsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGrantRolePermissionsReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -8583,9 +27566,9 @@ func (p *UserServiceVerifyEmailArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *UserServiceVerifyEmailArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *RoleAdminServiceGrantRolePermissionsArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("verifyEmail_args"); err != nil {
+	if err = oprot.WriteStructBegin("GrantRolePermissions_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -8611,7 +27594,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceVerifyEmailArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *RoleAdminServiceGrantRolePermissionsArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -8628,43 +27611,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *UserServiceVerifyEmailArgs) String() string {
+func (p *RoleAdminServiceGrantRolePermissionsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceVerifyEmailArgs(%+v)", *p)
+	return fmt.Sprintf("RoleAdminServiceGrantRolePermissionsArgs(%+v)", *p)
 
 }
 
-type UserServiceVerifyEmailResult struct {
-	Success *VerifyEmailResp `thrift:"success,0,optional"`
+type RoleAdminServiceGrantRolePermissionsResult struct {
+	Success *GrantRolePermissionsResp `thrift:"success,0,optional"`
 }
 
-func NewUserServiceVerifyEmailResult() *UserServiceVerifyEmailResult {
-	return &UserServiceVerifyEmailResult{}
+func NewRoleAdminServiceGrantRolePermissionsResult() *RoleAdminServiceGrantRolePermissionsResult {
+	return &RoleAdminServiceGrantRolePermissionsResult{}
 }
 
-func (p *UserServiceVerifyEmailResult) InitDefault() {
+func (p *RoleAdminServiceGrantRolePermissionsResult) InitDefault() {
 }
 
-var UserServiceVerifyEmailResult_Success_DEFAULT *VerifyEmailResp
+var RoleAdminServiceGrantRolePermissionsResult_Success_DEFAULT *GrantRolePermissionsResp
 
-func (p *UserServiceVerifyEmailResult) GetSuccess() (v *VerifyEmailResp) {
+func (p *RoleAdminServiceGrantRolePermissionsResult) GetSuccess() (v *GrantRolePermissionsResp) {
 	if !p.IsSetSuccess() {
-		return UserServiceVerifyEmailResult_Success_DEFAULT
+		return RoleAdminServiceGrantRolePermissionsResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_UserServiceVerifyEmailResult = map[int16]string{
+var fieldIDToName_RoleAdminServiceGrantRolePermissionsResult = map[int16]string{
 	0: "success",
 }
 
-func (p *UserServiceVerifyEmailResult) IsSetSuccess() bool {
+func (p *RoleAdminServiceGrantRolePermissionsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserServiceVerifyEmailResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *RoleAdminServiceGrantRolePermissionsResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -8710,7 +27693,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceVerifyEmailResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RoleAdminServiceGrantRolePermissionsResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -8720,8 +27703,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceVerifyEmailResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewVerifyEmailResp()
+func (p *RoleAdminServiceGrantRolePermissionsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGrantRolePermissionsResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -8729,9 +27712,9 @@ func (p *UserServiceVerifyEmailResult) ReadField0(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *UserServiceVerifyEmailResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *RoleAdminServiceGrantRolePermissionsResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("verifyEmail_result"); err != nil {
+	if err = oprot.WriteStructBegin("GrantRolePermissions_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -8757,7 +27740,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceVerifyEmailResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *RoleAdminServiceGrantRolePermissionsResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -8776,43 +27759,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *UserServiceVerifyEmailResult) String() string {
+func (p *RoleAdminServiceGrantRolePermissionsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceVerifyEmailResult(%+v)", *p)
+	return fmt.Sprintf("RoleAdminServiceGrantRolePermissionsResult(%+v)", *p)
 
 }
 
-type UserServiceUpdateEmailArgs struct {
-	Req *UpdateEmailReq `thrift:"req,1"`
+type RoleAdminServiceRevokeRolePermissionsArgs struct {
+	Req *RevokeRolePermissionsReq `thrift:"req,1"`
 }
 
-func NewUserServiceUpdateEmailArgs() *UserServiceUpdateEmailArgs {
-	return &UserServiceUpdateEmailArgs{}
+func NewRoleAdminServiceRevokeRolePermissionsArgs() *RoleAdminServiceRevokeRolePermissionsArgs {
+	return &RoleAdminServiceRevokeRolePermissionsArgs{}
 }
 
-func (p *UserServiceUpdateEmailArgs) InitDefault() {
+func (p *RoleAdminServiceRevokeRolePermissionsArgs) InitDefault() {
 }
 
-var UserServiceUpdateEmailArgs_Req_DEFAULT *UpdateEmailReq
+var RoleAdminServiceRevokeRolePermissionsArgs_Req_DEFAULT *RevokeRolePermissionsReq
 
-func (p *UserServiceUpdateEmailArgs) GetReq() (v *UpdateEmailReq) {
+func (p *RoleAdminServiceRevokeRolePermissionsArgs) GetReq() (v *RevokeRolePermissionsReq) {
 	if !p.IsSetReq() {
-		return UserServiceUpdateEmailArgs_Req_DEFAULT
+		return RoleAdminServiceRevokeRolePermissionsArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_UserServiceUpdateEmailArgs = map[int16]string{
+var fieldIDToName_RoleAdminServiceRevokeRolePermissionsArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *UserServiceUpdateEmailArgs) IsSetReq() bool {
+func (p *RoleAdminServiceRevokeRolePermissionsArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *UserServiceUpdateEmailArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *RoleAdminServiceRevokeRolePermissionsArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -8858,7 +27841,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceUpdateEmailArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RoleAdminServiceRevokeRolePermissionsArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -8868,8 +27851,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceUpdateEmailArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewUpdateEmailReq()
+func (p *RoleAdminServiceRevokeRolePermissionsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewRevokeRolePermissionsReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -8877,9 +27860,9 @@ func (p *UserServiceUpdateEmailArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *UserServiceUpdateEmailArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *RoleAdminServiceRevokeRolePermissionsArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("updateEmail_args"); err != nil {
+	if err = oprot.WriteStructBegin("RevokeRolePermissions_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -8905,7 +27888,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceUpdateEmailArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *RoleAdminServiceRevokeRolePermissionsArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -8922,43 +27905,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *UserServiceUpdateEmailArgs) String() string {
+func (p *RoleAdminServiceRevokeRolePermissionsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceUpdateEmailArgs(%+v)", *p)
+	return fmt.Sprintf("RoleAdminServiceRevokeRolePermissionsArgs(%+v)", *p)
 
 }
 
-type UserServiceUpdateEmailResult struct {
-	Success *UpdateEmailResp `thrift:"success,0,optional"`
+type RoleAdminServiceRevokeRolePermissionsResult struct {
+	Success *RevokeRolePermissionsResp `thrift:"success,0,optional"`
 }
 
-func NewUserServiceUpdateEmailResult() *UserServiceUpdateEmailResult {
-	return &UserServiceUpdateEmailResult{}
+func NewRoleAdminServiceRevokeRolePermissionsResult() *RoleAdminServiceRevokeRolePermissionsResult {
+	return &RoleAdminServiceRevokeRolePermissionsResult{}
 }
 
-func (p *UserServiceUpdateEmailResult) InitDefault() {
+func (p *RoleAdminServiceRevokeRolePermissionsResult) InitDefault() {
 }
 
-var UserServiceUpdateEmailResult_Success_DEFAULT *UpdateEmailResp
+var RoleAdminServiceRevokeRolePermissionsResult_Success_DEFAULT *RevokeRolePermissionsResp
 
-func (p *UserServiceUpdateEmailResult) GetSuccess() (v *UpdateEmailResp) {
+func (p *RoleAdminServiceRevokeRolePermissionsResult) GetSuccess() (v *RevokeRolePermissionsResp) {
 	if !p.IsSetSuccess() {
-		return UserServiceUpdateEmailResult_Success_DEFAULT
+		return RoleAdminServiceRevokeRolePermissionsResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_UserServiceUpdateEmailResult = map[int16]string{
+var fieldIDToName_RoleAdminServiceRevokeRolePermissionsResult = map[int16]string{
 	0: "success",
 }
 
-func (p *UserServiceUpdateEmailResult) IsSetSuccess() bool {
+func (p *RoleAdminServiceRevokeRolePermissionsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserServiceUpdateEmailResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *RoleAdminServiceRevokeRolePermissionsResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -9004,7 +27987,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceUpdateEmailResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RoleAdminServiceRevokeRolePermissionsResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -9014,8 +27997,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceUpdateEmailResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewUpdateEmailResp()
+func (p *RoleAdminServiceRevokeRolePermissionsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewRevokeRolePermissionsResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -9023,9 +28006,9 @@ func (p *UserServiceUpdateEmailResult) ReadField0(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *UserServiceUpdateEmailResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *RoleAdminServiceRevokeRolePermissionsResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("updateEmail_result"); err != nil {
+	if err = oprot.WriteStructBegin("RevokeRolePermissions_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -9051,7 +28034,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceUpdateEmailResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *RoleAdminServiceRevokeRolePermissionsResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -9070,43 +28053,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *UserServiceUpdateEmailResult) String() string {
+func (p *RoleAdminServiceRevokeRolePermissionsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceUpdateEmailResult(%+v)", *p)
+	return fmt.Sprintf("RoleAdminServiceRevokeRolePermissionsResult(%+v)", *p)
 
 }
 
-type UserServiceUpdatePasswordArgs struct {
-	Req *UpdatePasswordReq `thrift:"req,1"`
+type RoleAdminServiceGetRoleUsersArgs struct {
+	Req *GetRoleUsersReq `thrift:"req,1"`
 }
 
-func NewUserServiceUpdatePasswordArgs() *UserServiceUpdatePasswordArgs {
-	return &UserServiceUpdatePasswordArgs{}
+func NewRoleAdminServiceGetRoleUsersArgs() *RoleAdminServiceGetRoleUsersArgs {
+	return &RoleAdminServiceGetRoleUsersArgs{}
 }
 
-func (p *UserServiceUpdatePasswordArgs) InitDefault() {
+func (p *RoleAdminServiceGetRoleUsersArgs) InitDefault() {
 }
 
-var UserServiceUpdatePasswordArgs_Req_DEFAULT *UpdatePasswordReq
+var RoleAdminServiceGetRoleUsersArgs_Req_DEFAULT *GetRoleUsersReq
 
-func (p *UserServiceUpdatePasswordArgs) GetReq() (v *UpdatePasswordReq) {
+func (p *RoleAdminServiceGetRoleUsersArgs) GetReq() (v *GetRoleUsersReq) {
 	if !p.IsSetReq() {
-		return UserServiceUpdatePasswordArgs_Req_DEFAULT
+		return RoleAdminServiceGetRoleUsersArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_UserServiceUpdatePasswordArgs = map[int16]string{
+var fieldIDToName_RoleAdminServiceGetRoleUsersArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *UserServiceUpdatePasswordArgs) IsSetReq() bool {
+func (p *RoleAdminServiceGetRoleUsersArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *UserServiceUpdatePasswordArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *RoleAdminServiceGetRoleUsersArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -9152,7 +28135,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceUpdatePasswordArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RoleAdminServiceGetRoleUsersArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -9162,8 +28145,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceUpdatePasswordArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewUpdatePasswordReq()
+func (p *RoleAdminServiceGetRoleUsersArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetRoleUsersReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -9171,9 +28154,9 @@ func (p *UserServiceUpdatePasswordArgs) ReadField1(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *UserServiceUpdatePasswordArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *RoleAdminServiceGetRoleUsersArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("updatePassword_args"); err != nil {
+	if err = oprot.WriteStructBegin("GetRoleUsers_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -9199,7 +28182,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceUpdatePasswordArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *RoleAdminServiceGetRoleUsersArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -9216,43 +28199,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *UserServiceUpdatePasswordArgs) String() string {
+func (p *RoleAdminServiceGetRoleUsersArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceUpdatePasswordArgs(%+v)", *p)
+	return fmt.Sprintf("RoleAdminServiceGetRoleUsersArgs(%+v)", *p)
 
 }
 
-type UserServiceUpdatePasswordResult struct {
-	Success *UpdatePasswordResp `thrift:"success,0,optional"`
+type RoleAdminServiceGetRoleUsersResult struct {
+	Success *GetRoleUsersResp `thrift:"success,0,optional"`
 }
 
-func NewUserServiceUpdatePasswordResult() *UserServiceUpdatePasswordResult {
-	return &UserServiceUpdatePasswordResult{}
+func NewRoleAdminServiceGetRoleUsersResult() *RoleAdminServiceGetRoleUsersResult {
+	return &RoleAdminServiceGetRoleUsersResult{}
 }
 
-func (p *UserServiceUpdatePasswordResult) InitDefault() {
+func (p *RoleAdminServiceGetRoleUsersResult) InitDefault() {
 }
 
-var UserServiceUpdatePasswordResult_Success_DEFAULT *UpdatePasswordResp
+var RoleAdminServiceGetRoleUsersResult_Success_DEFAULT *GetRoleUsersResp
 
-func (p *UserServiceUpdatePasswordResult) GetSuccess() (v *UpdatePasswordResp) {
+func (p *RoleAdminServiceGetRoleUsersResult) GetSuccess() (v *GetRoleUsersResp) {
 	if !p.IsSetSuccess() {
-		return UserServiceUpdatePasswordResult_Success_DEFAULT
+		return RoleAdminServiceGetRoleUsersResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_UserServiceUpdatePasswordResult = map[int16]string{
+var fieldIDToName_RoleAdminServiceGetRoleUsersResult = map[int16]string{
 	0: "success",
 }
 
-func (p *UserServiceUpdatePasswordResult) IsSetSuccess() bool {
+func (p *RoleAdminServiceGetRoleUsersResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserServiceUpdatePasswordResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *RoleAdminServiceGetRoleUsersResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -9298,7 +28281,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceUpdatePasswordResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RoleAdminServiceGetRoleUsersResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -9308,8 +28291,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceUpdatePasswordResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewUpdatePasswordResp()
+func (p *RoleAdminServiceGetRoleUsersResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetRoleUsersResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -9317,9 +28300,9 @@ func (p *UserServiceUpdatePasswordResult) ReadField0(iprot thrift.TProtocol) err
 	return nil
 }
 
-func (p *UserServiceUpdatePasswordResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *RoleAdminServiceGetRoleUsersResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("updatePassword_result"); err != nil {
+	if err = oprot.WriteStructBegin("GetRoleUsers_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -9345,62 +28328,249 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceUpdatePasswordResult) writeField0(oprot thrift.TProtocol) (err error) {
-	if p.IsSetSuccess() {
-		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := p.Success.Write(oprot); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+func (p *RoleAdminServiceGetRoleUsersResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *RoleAdminServiceGetRoleUsersResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RoleAdminServiceGetRoleUsersResult(%+v)", *p)
+
+}
+
+type ScopedPermissionServiceProcessor struct {
+	processorMap map[string]thrift.TProcessorFunction
+	handler      ScopedPermissionService
+}
+
+func (p *ScopedPermissionServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
+	p.processorMap[key] = processor
+}
+
+func (p *ScopedPermissionServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
+	processor, ok = p.processorMap[key]
+	return processor, ok
+}
+
+func (p *ScopedPermissionServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
+	return p.processorMap
+}
+
+func NewScopedPermissionServiceProcessor(handler ScopedPermissionService) *ScopedPermissionServiceProcessor {
+	self := &ScopedPermissionServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
+	self.AddToProcessorMap("GrantScopedPermission", &scopedPermissionServiceProcessorGrantScopedPermission{handler: handler})
+	self.AddToProcessorMap("RevokeScopedPermission", &scopedPermissionServiceProcessorRevokeScopedPermission{handler: handler})
+	self.AddToProcessorMap("ListScopedPermissions", &scopedPermissionServiceProcessorListScopedPermissions{handler: handler})
+	return self
+}
+func (p *ScopedPermissionServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	name, _, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return false, err
+	}
+	if processor, ok := p.GetProcessorFunction(name); ok {
+		return processor.Process(ctx, seqId, iprot, oprot)
+	}
+	iprot.Skip(thrift.STRUCT)
+	iprot.ReadMessageEnd()
+	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
+	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
+	x.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Flush(ctx)
+	return false, x
+}
+
+type scopedPermissionServiceProcessorGrantScopedPermission struct {
+	handler ScopedPermissionService
+}
+
+func (p *scopedPermissionServiceProcessorGrantScopedPermission) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ScopedPermissionServiceGrantScopedPermissionArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GrantScopedPermission", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ScopedPermissionServiceGrantScopedPermissionResult{}
+	var retval *GrantScopedPermissionResp
+	if retval, err2 = p.handler.GrantScopedPermission(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GrantScopedPermission: "+err2.Error())
+		oprot.WriteMessageBegin("GrantScopedPermission", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GrantScopedPermission", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type scopedPermissionServiceProcessorRevokeScopedPermission struct {
+	handler ScopedPermissionService
+}
+
+func (p *scopedPermissionServiceProcessorRevokeScopedPermission) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ScopedPermissionServiceRevokeScopedPermissionArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("RevokeScopedPermission", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ScopedPermissionServiceRevokeScopedPermissionResult{}
+	var retval *RevokeScopedPermissionResp
+	if retval, err2 = p.handler.RevokeScopedPermission(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RevokeScopedPermission: "+err2.Error())
+		oprot.WriteMessageBegin("RevokeScopedPermission", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("RevokeScopedPermission", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type scopedPermissionServiceProcessorListScopedPermissions struct {
+	handler ScopedPermissionService
 }
 
-func (p *UserServiceUpdatePasswordResult) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *scopedPermissionServiceProcessorListScopedPermissions) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ScopedPermissionServiceListScopedPermissionsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ListScopedPermissions", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
 	}
-	return fmt.Sprintf("UserServiceUpdatePasswordResult(%+v)", *p)
 
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ScopedPermissionServiceListScopedPermissionsResult{}
+	var retval *ListScopedPermissionsResp
+	if retval, err2 = p.handler.ListScopedPermissions(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListScopedPermissions: "+err2.Error())
+		oprot.WriteMessageBegin("ListScopedPermissions", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ListScopedPermissions", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
 }
 
-type UserServiceUpdateMajorArgs struct {
-	Req *UpdateMajorReq `thrift:"req,1"`
+type ScopedPermissionServiceGrantScopedPermissionArgs struct {
+	Req *GrantScopedPermissionReq `thrift:"req,1"`
 }
 
-func NewUserServiceUpdateMajorArgs() *UserServiceUpdateMajorArgs {
-	return &UserServiceUpdateMajorArgs{}
+func NewScopedPermissionServiceGrantScopedPermissionArgs() *ScopedPermissionServiceGrantScopedPermissionArgs {
+	return &ScopedPermissionServiceGrantScopedPermissionArgs{}
 }
 
-func (p *UserServiceUpdateMajorArgs) InitDefault() {
+func (p *ScopedPermissionServiceGrantScopedPermissionArgs) InitDefault() {
 }
 
-var UserServiceUpdateMajorArgs_Req_DEFAULT *UpdateMajorReq
+var ScopedPermissionServiceGrantScopedPermissionArgs_Req_DEFAULT *GrantScopedPermissionReq
 
-func (p *UserServiceUpdateMajorArgs) GetReq() (v *UpdateMajorReq) {
+func (p *ScopedPermissionServiceGrantScopedPermissionArgs) GetReq() (v *GrantScopedPermissionReq) {
 	if !p.IsSetReq() {
-		return UserServiceUpdateMajorArgs_Req_DEFAULT
+		return ScopedPermissionServiceGrantScopedPermissionArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_UserServiceUpdateMajorArgs = map[int16]string{
+var fieldIDToName_ScopedPermissionServiceGrantScopedPermissionArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *UserServiceUpdateMajorArgs) IsSetReq() bool {
+func (p *ScopedPermissionServiceGrantScopedPermissionArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *UserServiceUpdateMajorArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ScopedPermissionServiceGrantScopedPermissionArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -9446,7 +28616,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceUpdateMajorArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ScopedPermissionServiceGrantScopedPermissionArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -9456,8 +28626,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceUpdateMajorArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewUpdateMajorReq()
+func (p *ScopedPermissionServiceGrantScopedPermissionArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGrantScopedPermissionReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -9465,9 +28635,9 @@ func (p *UserServiceUpdateMajorArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *UserServiceUpdateMajorArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ScopedPermissionServiceGrantScopedPermissionArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("updateMajor_args"); err != nil {
+	if err = oprot.WriteStructBegin("GrantScopedPermission_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -9493,7 +28663,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceUpdateMajorArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ScopedPermissionServiceGrantScopedPermissionArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -9510,43 +28680,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *UserServiceUpdateMajorArgs) String() string {
+func (p *ScopedPermissionServiceGrantScopedPermissionArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceUpdateMajorArgs(%+v)", *p)
+	return fmt.Sprintf("ScopedPermissionServiceGrantScopedPermissionArgs(%+v)", *p)
 
 }
 
-type UserServiceUpdateMajorResult struct {
-	Success *UpdateMajorResp `thrift:"success,0,optional"`
+type ScopedPermissionServiceGrantScopedPermissionResult struct {
+	Success *GrantScopedPermissionResp `thrift:"success,0,optional"`
 }
 
-func NewUserServiceUpdateMajorResult() *UserServiceUpdateMajorResult {
-	return &UserServiceUpdateMajorResult{}
+func NewScopedPermissionServiceGrantScopedPermissionResult() *ScopedPermissionServiceGrantScopedPermissionResult {
+	return &ScopedPermissionServiceGrantScopedPermissionResult{}
 }
 
-func (p *UserServiceUpdateMajorResult) InitDefault() {
+func (p *ScopedPermissionServiceGrantScopedPermissionResult) InitDefault() {
 }
 
-var UserServiceUpdateMajorResult_Success_DEFAULT *UpdateMajorResp
+var ScopedPermissionServiceGrantScopedPermissionResult_Success_DEFAULT *GrantScopedPermissionResp
 
-func (p *UserServiceUpdateMajorResult) GetSuccess() (v *UpdateMajorResp) {
+func (p *ScopedPermissionServiceGrantScopedPermissionResult) GetSuccess() (v *GrantScopedPermissionResp) {
 	if !p.IsSetSuccess() {
-		return UserServiceUpdateMajorResult_Success_DEFAULT
+		return ScopedPermissionServiceGrantScopedPermissionResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_UserServiceUpdateMajorResult = map[int16]string{
+var fieldIDToName_ScopedPermissionServiceGrantScopedPermissionResult = map[int16]string{
 	0: "success",
 }
 
-func (p *UserServiceUpdateMajorResult) IsSetSuccess() bool {
+func (p *ScopedPermissionServiceGrantScopedPermissionResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserServiceUpdateMajorResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ScopedPermissionServiceGrantScopedPermissionResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -9592,7 +28762,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceUpdateMajorResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ScopedPermissionServiceGrantScopedPermissionResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -9602,8 +28772,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceUpdateMajorResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewUpdateMajorResp()
+func (p *ScopedPermissionServiceGrantScopedPermissionResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGrantScopedPermissionResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -9611,9 +28781,9 @@ func (p *UserServiceUpdateMajorResult) ReadField0(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *UserServiceUpdateMajorResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ScopedPermissionServiceGrantScopedPermissionResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("updateMajor_result"); err != nil {
+	if err = oprot.WriteStructBegin("GrantScopedPermission_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -9639,7 +28809,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceUpdateMajorResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ScopedPermissionServiceGrantScopedPermissionResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -9658,43 +28828,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *UserServiceUpdateMajorResult) String() string {
+func (p *ScopedPermissionServiceGrantScopedPermissionResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceUpdateMajorResult(%+v)", *p)
+	return fmt.Sprintf("ScopedPermissionServiceGrantScopedPermissionResult(%+v)", *p)
 
 }
 
-type UserServiceUploadAvatarArgs struct {
-	Req *UploadAvatarReq `thrift:"req,1"`
+type ScopedPermissionServiceRevokeScopedPermissionArgs struct {
+	Req *RevokeScopedPermissionReq `thrift:"req,1"`
 }
 
-func NewUserServiceUploadAvatarArgs() *UserServiceUploadAvatarArgs {
-	return &UserServiceUploadAvatarArgs{}
+func NewScopedPermissionServiceRevokeScopedPermissionArgs() *ScopedPermissionServiceRevokeScopedPermissionArgs {
+	return &ScopedPermissionServiceRevokeScopedPermissionArgs{}
 }
 
-func (p *UserServiceUploadAvatarArgs) InitDefault() {
+func (p *ScopedPermissionServiceRevokeScopedPermissionArgs) InitDefault() {
 }
 
-var UserServiceUploadAvatarArgs_Req_DEFAULT *UploadAvatarReq
+var ScopedPermissionServiceRevokeScopedPermissionArgs_Req_DEFAULT *RevokeScopedPermissionReq
 
-func (p *UserServiceUploadAvatarArgs) GetReq() (v *UploadAvatarReq) {
+func (p *ScopedPermissionServiceRevokeScopedPermissionArgs) GetReq() (v *RevokeScopedPermissionReq) {
 	if !p.IsSetReq() {
-		return UserServiceUploadAvatarArgs_Req_DEFAULT
+		return ScopedPermissionServiceRevokeScopedPermissionArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_UserServiceUploadAvatarArgs = map[int16]string{
+var fieldIDToName_ScopedPermissionServiceRevokeScopedPermissionArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *UserServiceUploadAvatarArgs) IsSetReq() bool {
+func (p *ScopedPermissionServiceRevokeScopedPermissionArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *UserServiceUploadAvatarArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ScopedPermissionServiceRevokeScopedPermissionArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -9740,7 +28910,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceUploadAvatarArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ScopedPermissionServiceRevokeScopedPermissionArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -9750,8 +28920,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceUploadAvatarArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewUploadAvatarReq()
+func (p *ScopedPermissionServiceRevokeScopedPermissionArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewRevokeScopedPermissionReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -9759,9 +28929,9 @@ func (p *UserServiceUploadAvatarArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *UserServiceUploadAvatarArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ScopedPermissionServiceRevokeScopedPermissionArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("uploadAvatar_args"); err != nil {
+	if err = oprot.WriteStructBegin("RevokeScopedPermission_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -9787,7 +28957,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceUploadAvatarArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ScopedPermissionServiceRevokeScopedPermissionArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -9804,43 +28974,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *UserServiceUploadAvatarArgs) String() string {
+func (p *ScopedPermissionServiceRevokeScopedPermissionArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceUploadAvatarArgs(%+v)", *p)
+	return fmt.Sprintf("ScopedPermissionServiceRevokeScopedPermissionArgs(%+v)", *p)
 
 }
 
-type UserServiceUploadAvatarResult struct {
-	Success *UploadAvatarResp `thrift:"success,0,optional"`
+type ScopedPermissionServiceRevokeScopedPermissionResult struct {
+	Success *RevokeScopedPermissionResp `thrift:"success,0,optional"`
 }
 
-func NewUserServiceUploadAvatarResult() *UserServiceUploadAvatarResult {
-	return &UserServiceUploadAvatarResult{}
+func NewScopedPermissionServiceRevokeScopedPermissionResult() *ScopedPermissionServiceRevokeScopedPermissionResult {
+	return &ScopedPermissionServiceRevokeScopedPermissionResult{}
 }
 
-func (p *UserServiceUploadAvatarResult) InitDefault() {
+func (p *ScopedPermissionServiceRevokeScopedPermissionResult) InitDefault() {
 }
 
-var UserServiceUploadAvatarResult_Success_DEFAULT *UploadAvatarResp
+var ScopedPermissionServiceRevokeScopedPermissionResult_Success_DEFAULT *RevokeScopedPermissionResp
 
-func (p *UserServiceUploadAvatarResult) GetSuccess() (v *UploadAvatarResp) {
+func (p *ScopedPermissionServiceRevokeScopedPermissionResult) GetSuccess() (v *RevokeScopedPermissionResp) {
 	if !p.IsSetSuccess() {
-		return UserServiceUploadAvatarResult_Success_DEFAULT
+		return ScopedPermissionServiceRevokeScopedPermissionResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_UserServiceUploadAvatarResult = map[int16]string{
+var fieldIDToName_ScopedPermissionServiceRevokeScopedPermissionResult = map[int16]string{
 	0: "success",
 }
 
-func (p *UserServiceUploadAvatarResult) IsSetSuccess() bool {
+func (p *ScopedPermissionServiceRevokeScopedPermissionResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserServiceUploadAvatarResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ScopedPermissionServiceRevokeScopedPermissionResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -9886,7 +29056,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceUploadAvatarResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ScopedPermissionServiceRevokeScopedPermissionResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -9896,8 +29066,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceUploadAvatarResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewUploadAvatarResp()
+func (p *ScopedPermissionServiceRevokeScopedPermissionResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewRevokeScopedPermissionResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -9905,9 +29075,9 @@ func (p *UserServiceUploadAvatarResult) ReadField0(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *UserServiceUploadAvatarResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ScopedPermissionServiceRevokeScopedPermissionResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("uploadAvatar_result"); err != nil {
+	if err = oprot.WriteStructBegin("RevokeScopedPermission_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -9933,7 +29103,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceUploadAvatarResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ScopedPermissionServiceRevokeScopedPermissionResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -9952,43 +29122,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *UserServiceUploadAvatarResult) String() string {
+func (p *ScopedPermissionServiceRevokeScopedPermissionResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceUploadAvatarResult(%+v)", *p)
+	return fmt.Sprintf("ScopedPermissionServiceRevokeScopedPermissionResult(%+v)", *p)
 
 }
 
-type UserServiceResetPasswordArgs struct {
-	Req *ResetPasswordReq `thrift:"req,1"`
+type ScopedPermissionServiceListScopedPermissionsArgs struct {
+	Req *ListScopedPermissionsReq `thrift:"req,1"`
 }
 
-func NewUserServiceResetPasswordArgs() *UserServiceResetPasswordArgs {
-	return &UserServiceResetPasswordArgs{}
+func NewScopedPermissionServiceListScopedPermissionsArgs() *ScopedPermissionServiceListScopedPermissionsArgs {
+	return &ScopedPermissionServiceListScopedPermissionsArgs{}
 }
 
-func (p *UserServiceResetPasswordArgs) InitDefault() {
+func (p *ScopedPermissionServiceListScopedPermissionsArgs) InitDefault() {
 }
 
-var UserServiceResetPasswordArgs_Req_DEFAULT *ResetPasswordReq
+var ScopedPermissionServiceListScopedPermissionsArgs_Req_DEFAULT *ListScopedPermissionsReq
 
-func (p *UserServiceResetPasswordArgs) GetReq() (v *ResetPasswordReq) {
+func (p *ScopedPermissionServiceListScopedPermissionsArgs) GetReq() (v *ListScopedPermissionsReq) {
 	if !p.IsSetReq() {
-		return UserServiceResetPasswordArgs_Req_DEFAULT
+		return ScopedPermissionServiceListScopedPermissionsArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_UserServiceResetPasswordArgs = map[int16]string{
+var fieldIDToName_ScopedPermissionServiceListScopedPermissionsArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *UserServiceResetPasswordArgs) IsSetReq() bool {
+func (p *ScopedPermissionServiceListScopedPermissionsArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *UserServiceResetPasswordArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ScopedPermissionServiceListScopedPermissionsArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -10034,7 +29204,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceResetPasswordArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ScopedPermissionServiceListScopedPermissionsArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -10044,8 +29214,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceResetPasswordArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewResetPasswordReq()
+func (p *ScopedPermissionServiceListScopedPermissionsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewListScopedPermissionsReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -10053,9 +29223,9 @@ func (p *UserServiceResetPasswordArgs) ReadField1(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *UserServiceResetPasswordArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ScopedPermissionServiceListScopedPermissionsArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("resetPassword_args"); err != nil {
+	if err = oprot.WriteStructBegin("ListScopedPermissions_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -10081,7 +29251,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceResetPasswordArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ScopedPermissionServiceListScopedPermissionsArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -10098,43 +29268,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *UserServiceResetPasswordArgs) String() string {
+func (p *ScopedPermissionServiceListScopedPermissionsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceResetPasswordArgs(%+v)", *p)
+	return fmt.Sprintf("ScopedPermissionServiceListScopedPermissionsArgs(%+v)", *p)
 
 }
 
-type UserServiceResetPasswordResult struct {
-	Success *ResetPasswordResp `thrift:"success,0,optional"`
+type ScopedPermissionServiceListScopedPermissionsResult struct {
+	Success *ListScopedPermissionsResp `thrift:"success,0,optional"`
 }
 
-func NewUserServiceResetPasswordResult() *UserServiceResetPasswordResult {
-	return &UserServiceResetPasswordResult{}
+func NewScopedPermissionServiceListScopedPermissionsResult() *ScopedPermissionServiceListScopedPermissionsResult {
+	return &ScopedPermissionServiceListScopedPermissionsResult{}
 }
 
-func (p *UserServiceResetPasswordResult) InitDefault() {
+func (p *ScopedPermissionServiceListScopedPermissionsResult) InitDefault() {
 }
 
-var UserServiceResetPasswordResult_Success_DEFAULT *ResetPasswordResp
+var ScopedPermissionServiceListScopedPermissionsResult_Success_DEFAULT *ListScopedPermissionsResp
 
-func (p *UserServiceResetPasswordResult) GetSuccess() (v *ResetPasswordResp) {
+func (p *ScopedPermissionServiceListScopedPermissionsResult) GetSuccess() (v *ListScopedPermissionsResp) {
 	if !p.IsSetSuccess() {
-		return UserServiceResetPasswordResult_Success_DEFAULT
+		return ScopedPermissionServiceListScopedPermissionsResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_UserServiceResetPasswordResult = map[int16]string{
+var fieldIDToName_ScopedPermissionServiceListScopedPermissionsResult = map[int16]string{
 	0: "success",
 }
 
-func (p *UserServiceResetPasswordResult) IsSetSuccess() bool {
+func (p *ScopedPermissionServiceListScopedPermissionsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserServiceResetPasswordResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ScopedPermissionServiceListScopedPermissionsResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -10180,7 +29350,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceResetPasswordResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ScopedPermissionServiceListScopedPermissionsResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -10190,8 +29360,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceResetPasswordResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewResetPasswordResp()
+func (p *ScopedPermissionServiceListScopedPermissionsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewListScopedPermissionsResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -10199,9 +29369,9 @@ func (p *UserServiceResetPasswordResult) ReadField0(iprot thrift.TProtocol) erro
 	return nil
 }
 
-func (p *UserServiceResetPasswordResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ScopedPermissionServiceListScopedPermissionsResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("resetPassword_result"); err != nil {
+	if err = oprot.WriteStructBegin("ListScopedPermissions_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -10227,62 +29397,200 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceResetPasswordResult) writeField0(oprot thrift.TProtocol) (err error) {
-	if p.IsSetSuccess() {
-		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := p.Success.Write(oprot); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+func (p *ScopedPermissionServiceListScopedPermissionsResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *ScopedPermissionServiceListScopedPermissionsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ScopedPermissionServiceListScopedPermissionsResult(%+v)", *p)
+
+}
+
+type ModerationServiceProcessor struct {
+	processorMap map[string]thrift.TProcessorFunction
+	handler      ModerationService
+}
+
+func (p *ModerationServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
+	p.processorMap[key] = processor
+}
+
+func (p *ModerationServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
+	processor, ok = p.processorMap[key]
+	return processor, ok
+}
+
+func (p *ModerationServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
+	return p.processorMap
+}
+
+func NewModerationServiceProcessor(handler ModerationService) *ModerationServiceProcessor {
+	self := &ModerationServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
+	self.AddToProcessorMap("ListMyModerationActions", &moderationServiceProcessorListMyModerationActions{handler: handler})
+	self.AddToProcessorMap("AppealModerationAction", &moderationServiceProcessorAppealModerationAction{handler: handler})
+	return self
+}
+func (p *ModerationServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	name, _, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return false, err
+	}
+	if processor, ok := p.GetProcessorFunction(name); ok {
+		return processor.Process(ctx, seqId, iprot, oprot)
+	}
+	iprot.Skip(thrift.STRUCT)
+	iprot.ReadMessageEnd()
+	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
+	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
+	x.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Flush(ctx)
+	return false, x
+}
+
+type moderationServiceProcessorListMyModerationActions struct {
+	handler ModerationService
+}
+
+func (p *moderationServiceProcessorListMyModerationActions) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ModerationServiceListMyModerationActionsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ListMyModerationActions", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ModerationServiceListMyModerationActionsResult{}
+	var retval *ListMyModerationActionsResp
+	if retval, err2 = p.handler.ListMyModerationActions(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListMyModerationActions: "+err2.Error())
+		oprot.WriteMessageBegin("ListMyModerationActions", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ListMyModerationActions", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type moderationServiceProcessorAppealModerationAction struct {
+	handler ModerationService
 }
 
-func (p *UserServiceResetPasswordResult) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *moderationServiceProcessorAppealModerationAction) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ModerationServiceAppealModerationActionArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("AppealModerationAction", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
 	}
-	return fmt.Sprintf("UserServiceResetPasswordResult(%+v)", *p)
 
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := ModerationServiceAppealModerationActionResult{}
+	var retval *AppealModerationActionResp
+	if retval, err2 = p.handler.AppealModerationAction(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing AppealModerationAction: "+err2.Error())
+		oprot.WriteMessageBegin("AppealModerationAction", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("AppealModerationAction", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
 }
 
-type UserServiceRefreshTokenArgs struct {
-	Req *RefreshTokenReq `thrift:"req,1"`
+type ModerationServiceListMyModerationActionsArgs struct {
+	Req *ListMyModerationActionsReq `thrift:"req,1"`
 }
 
-func NewUserServiceRefreshTokenArgs() *UserServiceRefreshTokenArgs {
-	return &UserServiceRefreshTokenArgs{}
+func NewModerationServiceListMyModerationActionsArgs() *ModerationServiceListMyModerationActionsArgs {
+	return &ModerationServiceListMyModerationActionsArgs{}
 }
 
-func (p *UserServiceRefreshTokenArgs) InitDefault() {
+func (p *ModerationServiceListMyModerationActionsArgs) InitDefault() {
 }
 
-var UserServiceRefreshTokenArgs_Req_DEFAULT *RefreshTokenReq
+var ModerationServiceListMyModerationActionsArgs_Req_DEFAULT *ListMyModerationActionsReq
 
-func (p *UserServiceRefreshTokenArgs) GetReq() (v *RefreshTokenReq) {
+func (p *ModerationServiceListMyModerationActionsArgs) GetReq() (v *ListMyModerationActionsReq) {
 	if !p.IsSetReq() {
-		return UserServiceRefreshTokenArgs_Req_DEFAULT
+		return ModerationServiceListMyModerationActionsArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_UserServiceRefreshTokenArgs = map[int16]string{
+var fieldIDToName_ModerationServiceListMyModerationActionsArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *UserServiceRefreshTokenArgs) IsSetReq() bool {
+func (p *ModerationServiceListMyModerationActionsArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *UserServiceRefreshTokenArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ModerationServiceListMyModerationActionsArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -10328,7 +29636,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceRefreshTokenArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ModerationServiceListMyModerationActionsArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -10338,8 +29646,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceRefreshTokenArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewRefreshTokenReq()
+func (p *ModerationServiceListMyModerationActionsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewListMyModerationActionsReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -10347,9 +29655,9 @@ func (p *UserServiceRefreshTokenArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *UserServiceRefreshTokenArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ModerationServiceListMyModerationActionsArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("refreshToken_args"); err != nil {
+	if err = oprot.WriteStructBegin("ListMyModerationActions_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -10375,7 +29683,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceRefreshTokenArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ModerationServiceListMyModerationActionsArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -10392,43 +29700,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *UserServiceRefreshTokenArgs) String() string {
+func (p *ModerationServiceListMyModerationActionsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceRefreshTokenArgs(%+v)", *p)
+	return fmt.Sprintf("ModerationServiceListMyModerationActionsArgs(%+v)", *p)
 
 }
 
-type UserServiceRefreshTokenResult struct {
-	Success *RefreshTokenResp `thrift:"success,0,optional"`
+type ModerationServiceListMyModerationActionsResult struct {
+	Success *ListMyModerationActionsResp `thrift:"success,0,optional"`
 }
 
-func NewUserServiceRefreshTokenResult() *UserServiceRefreshTokenResult {
-	return &UserServiceRefreshTokenResult{}
+func NewModerationServiceListMyModerationActionsResult() *ModerationServiceListMyModerationActionsResult {
+	return &ModerationServiceListMyModerationActionsResult{}
 }
 
-func (p *UserServiceRefreshTokenResult) InitDefault() {
+func (p *ModerationServiceListMyModerationActionsResult) InitDefault() {
 }
 
-var UserServiceRefreshTokenResult_Success_DEFAULT *RefreshTokenResp
+var ModerationServiceListMyModerationActionsResult_Success_DEFAULT *ListMyModerationActionsResp
 
-func (p *UserServiceRefreshTokenResult) GetSuccess() (v *RefreshTokenResp) {
+func (p *ModerationServiceListMyModerationActionsResult) GetSuccess() (v *ListMyModerationActionsResp) {
 	if !p.IsSetSuccess() {
-		return UserServiceRefreshTokenResult_Success_DEFAULT
+		return ModerationServiceListMyModerationActionsResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_UserServiceRefreshTokenResult = map[int16]string{
+var fieldIDToName_ModerationServiceListMyModerationActionsResult = map[int16]string{
 	0: "success",
 }
 
-func (p *UserServiceRefreshTokenResult) IsSetSuccess() bool {
+func (p *ModerationServiceListMyModerationActionsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserServiceRefreshTokenResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ModerationServiceListMyModerationActionsResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -10474,7 +29782,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceRefreshTokenResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ModerationServiceListMyModerationActionsResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -10484,8 +29792,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceRefreshTokenResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewRefreshTokenResp()
+func (p *ModerationServiceListMyModerationActionsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewListMyModerationActionsResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -10493,9 +29801,9 @@ func (p *UserServiceRefreshTokenResult) ReadField0(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *UserServiceRefreshTokenResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ModerationServiceListMyModerationActionsResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("refreshToken_result"); err != nil {
+	if err = oprot.WriteStructBegin("ListMyModerationActions_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -10521,7 +29829,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceRefreshTokenResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ModerationServiceListMyModerationActionsResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -10540,43 +29848,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *UserServiceRefreshTokenResult) String() string {
+func (p *ModerationServiceListMyModerationActionsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceRefreshTokenResult(%+v)", *p)
+	return fmt.Sprintf("ModerationServiceListMyModerationActionsResult(%+v)", *p)
 
 }
 
-type UserServiceGetUserInfoArgs struct {
-	Req *GetUserInfoReq `thrift:"req,1"`
+type ModerationServiceAppealModerationActionArgs struct {
+	Req *AppealModerationActionReq `thrift:"req,1"`
 }
 
-func NewUserServiceGetUserInfoArgs() *UserServiceGetUserInfoArgs {
-	return &UserServiceGetUserInfoArgs{}
+func NewModerationServiceAppealModerationActionArgs() *ModerationServiceAppealModerationActionArgs {
+	return &ModerationServiceAppealModerationActionArgs{}
 }
 
-func (p *UserServiceGetUserInfoArgs) InitDefault() {
+func (p *ModerationServiceAppealModerationActionArgs) InitDefault() {
 }
 
-var UserServiceGetUserInfoArgs_Req_DEFAULT *GetUserInfoReq
+var ModerationServiceAppealModerationActionArgs_Req_DEFAULT *AppealModerationActionReq
 
-func (p *UserServiceGetUserInfoArgs) GetReq() (v *GetUserInfoReq) {
+func (p *ModerationServiceAppealModerationActionArgs) GetReq() (v *AppealModerationActionReq) {
 	if !p.IsSetReq() {
-		return UserServiceGetUserInfoArgs_Req_DEFAULT
+		return ModerationServiceAppealModerationActionArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_UserServiceGetUserInfoArgs = map[int16]string{
+var fieldIDToName_ModerationServiceAppealModerationActionArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *UserServiceGetUserInfoArgs) IsSetReq() bool {
+func (p *ModerationServiceAppealModerationActionArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *UserServiceGetUserInfoArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ModerationServiceAppealModerationActionArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -10622,7 +29930,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceGetUserInfoArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ModerationServiceAppealModerationActionArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -10632,8 +29940,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceGetUserInfoArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewGetUserInfoReq()
+func (p *ModerationServiceAppealModerationActionArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewAppealModerationActionReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -10641,9 +29949,9 @@ func (p *UserServiceGetUserInfoArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *UserServiceGetUserInfoArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ModerationServiceAppealModerationActionArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("getUserInfo_args"); err != nil {
+	if err = oprot.WriteStructBegin("AppealModerationAction_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -10669,7 +29977,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceGetUserInfoArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ModerationServiceAppealModerationActionArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -10686,43 +29994,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *UserServiceGetUserInfoArgs) String() string {
+func (p *ModerationServiceAppealModerationActionArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceGetUserInfoArgs(%+v)", *p)
+	return fmt.Sprintf("ModerationServiceAppealModerationActionArgs(%+v)", *p)
 
 }
 
-type UserServiceGetUserInfoResult struct {
-	Success *GetUserInfoResp `thrift:"success,0,optional"`
+type ModerationServiceAppealModerationActionResult struct {
+	Success *AppealModerationActionResp `thrift:"success,0,optional"`
 }
 
-func NewUserServiceGetUserInfoResult() *UserServiceGetUserInfoResult {
-	return &UserServiceGetUserInfoResult{}
+func NewModerationServiceAppealModerationActionResult() *ModerationServiceAppealModerationActionResult {
+	return &ModerationServiceAppealModerationActionResult{}
 }
 
-func (p *UserServiceGetUserInfoResult) InitDefault() {
+func (p *ModerationServiceAppealModerationActionResult) InitDefault() {
 }
 
-var UserServiceGetUserInfoResult_Success_DEFAULT *GetUserInfoResp
+var ModerationServiceAppealModerationActionResult_Success_DEFAULT *AppealModerationActionResp
 
-func (p *UserServiceGetUserInfoResult) GetSuccess() (v *GetUserInfoResp) {
+func (p *ModerationServiceAppealModerationActionResult) GetSuccess() (v *AppealModerationActionResp) {
 	if !p.IsSetSuccess() {
-		return UserServiceGetUserInfoResult_Success_DEFAULT
+		return ModerationServiceAppealModerationActionResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_UserServiceGetUserInfoResult = map[int16]string{
+var fieldIDToName_ModerationServiceAppealModerationActionResult = map[int16]string{
 	0: "success",
 }
 
-func (p *UserServiceGetUserInfoResult) IsSetSuccess() bool {
+func (p *ModerationServiceAppealModerationActionResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserServiceGetUserInfoResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ModerationServiceAppealModerationActionResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -10768,7 +30076,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceGetUserInfoResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ModerationServiceAppealModerationActionResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -10778,8 +30086,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceGetUserInfoResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewGetUserInfoResp()
+func (p *ModerationServiceAppealModerationActionResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewAppealModerationActionResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -10787,9 +30095,9 @@ func (p *UserServiceGetUserInfoResult) ReadField0(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *UserServiceGetUserInfoResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ModerationServiceAppealModerationActionResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("getUserInfo_result"); err != nil {
+	if err = oprot.WriteStructBegin("AppealModerationAction_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -10815,7 +30123,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceGetUserInfoResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ModerationServiceAppealModerationActionResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -10834,39 +30142,39 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *UserServiceGetUserInfoResult) String() string {
+func (p *ModerationServiceAppealModerationActionResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceGetUserInfoResult(%+v)", *p)
+	return fmt.Sprintf("ModerationServiceAppealModerationActionResult(%+v)", *p)
 
 }
 
-type UserAdminServiceProcessor struct {
+type NotificationServiceProcessor struct {
 	processorMap map[string]thrift.TProcessorFunction
-	handler      UserAdminService
+	handler      NotificationService
 }
 
-func (p *UserAdminServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
+func (p *NotificationServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
 	p.processorMap[key] = processor
 }
 
-func (p *UserAdminServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
+func (p *NotificationServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
 	processor, ok = p.processorMap[key]
 	return processor, ok
 }
 
-func (p *UserAdminServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
+func (p *NotificationServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
 	return p.processorMap
 }
 
-func NewUserAdminServiceProcessor(handler UserAdminService) *UserAdminServiceProcessor {
-	self := &UserAdminServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
-	self.AddToProcessorMap("AdminAddUser", &userAdminServiceProcessorAdminAddUser{handler: handler})
-	self.AddToProcessorMap("AdminUpdateUser", &userAdminServiceProcessorAdminUpdateUser{handler: handler})
+func NewNotificationServiceProcessor(handler NotificationService) *NotificationServiceProcessor {
+	self := &NotificationServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
+	self.AddToProcessorMap("ListNotifications", &notificationServiceProcessorListNotifications{handler: handler})
+	self.AddToProcessorMap("MarkNotificationsRead", &notificationServiceProcessorMarkNotificationsRead{handler: handler})
 	return self
 }
-func (p *UserAdminServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+func (p *NotificationServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
 	name, _, seqId, err := iprot.ReadMessageBegin()
 	if err != nil {
 		return false, err
@@ -10884,16 +30192,16 @@ func (p *UserAdminServiceProcessor) Process(ctx context.Context, iprot, oprot th
 	return false, x
 }
 
-type userAdminServiceProcessorAdminAddUser struct {
-	handler UserAdminService
+type notificationServiceProcessorListNotifications struct {
+	handler NotificationService
 }
 
-func (p *userAdminServiceProcessorAdminAddUser) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := UserAdminServiceAdminAddUserArgs{}
+func (p *notificationServiceProcessorListNotifications) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NotificationServiceListNotificationsArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("AdminAddUser", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("ListNotifications", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -10902,11 +30210,11 @@ func (p *userAdminServiceProcessorAdminAddUser) Process(ctx context.Context, seq
 
 	iprot.ReadMessageEnd()
 	var err2 error
-	result := UserAdminServiceAdminAddUserResult{}
-	var retval *AdminAddUserResp
-	if retval, err2 = p.handler.AdminAddUser(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing AdminAddUser: "+err2.Error())
-		oprot.WriteMessageBegin("AdminAddUser", thrift.EXCEPTION, seqId)
+	result := NotificationServiceListNotificationsResult{}
+	var retval *ListNotificationsResp
+	if retval, err2 = p.handler.ListNotifications(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListNotifications: "+err2.Error())
+		oprot.WriteMessageBegin("ListNotifications", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -10914,7 +30222,7 @@ func (p *userAdminServiceProcessorAdminAddUser) Process(ctx context.Context, seq
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("AdminAddUser", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("ListNotifications", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -10932,16 +30240,16 @@ func (p *userAdminServiceProcessorAdminAddUser) Process(ctx context.Context, seq
 	return true, err
 }
 
-type userAdminServiceProcessorAdminUpdateUser struct {
-	handler UserAdminService
+type notificationServiceProcessorMarkNotificationsRead struct {
+	handler NotificationService
 }
 
-func (p *userAdminServiceProcessorAdminUpdateUser) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := UserAdminServiceAdminUpdateUserArgs{}
+func (p *notificationServiceProcessorMarkNotificationsRead) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NotificationServiceMarkNotificationsReadArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("AdminUpdateUser", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("MarkNotificationsRead", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -10950,11 +30258,11 @@ func (p *userAdminServiceProcessorAdminUpdateUser) Process(ctx context.Context,
 
 	iprot.ReadMessageEnd()
 	var err2 error
-	result := UserAdminServiceAdminUpdateUserResult{}
-	var retval *AdminUpdateUserResp
-	if retval, err2 = p.handler.AdminUpdateUser(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing AdminUpdateUser: "+err2.Error())
-		oprot.WriteMessageBegin("AdminUpdateUser", thrift.EXCEPTION, seqId)
+	result := NotificationServiceMarkNotificationsReadResult{}
+	var retval *MarkNotificationsReadResp
+	if retval, err2 = p.handler.MarkNotificationsRead(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing MarkNotificationsRead: "+err2.Error())
+		oprot.WriteMessageBegin("MarkNotificationsRead", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -10962,7 +30270,7 @@ func (p *userAdminServiceProcessorAdminUpdateUser) Process(ctx context.Context,
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("AdminUpdateUser", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("MarkNotificationsRead", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -10980,35 +30288,35 @@ func (p *userAdminServiceProcessorAdminUpdateUser) Process(ctx context.Context,
 	return true, err
 }
 
-type UserAdminServiceAdminAddUserArgs struct {
-	Req *AdminAddUserReq `thrift:"req,1"`
+type NotificationServiceListNotificationsArgs struct {
+	Req *ListNotificationsReq `thrift:"req,1"`
 }
 
-func NewUserAdminServiceAdminAddUserArgs() *UserAdminServiceAdminAddUserArgs {
-	return &UserAdminServiceAdminAddUserArgs{}
+func NewNotificationServiceListNotificationsArgs() *NotificationServiceListNotificationsArgs {
+	return &NotificationServiceListNotificationsArgs{}
 }
 
-func (p *UserAdminServiceAdminAddUserArgs) InitDefault() {
+func (p *NotificationServiceListNotificationsArgs) InitDefault() {
 }
 
-var UserAdminServiceAdminAddUserArgs_Req_DEFAULT *AdminAddUserReq
+var NotificationServiceListNotificationsArgs_Req_DEFAULT *ListNotificationsReq
 
-func (p *UserAdminServiceAdminAddUserArgs) GetReq() (v *AdminAddUserReq) {
+func (p *NotificationServiceListNotificationsArgs) GetReq() (v *ListNotificationsReq) {
 	if !p.IsSetReq() {
-		return UserAdminServiceAdminAddUserArgs_Req_DEFAULT
+		return NotificationServiceListNotificationsArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_UserAdminServiceAdminAddUserArgs = map[int16]string{
+var fieldIDToName_NotificationServiceListNotificationsArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *UserAdminServiceAdminAddUserArgs) IsSetReq() bool {
+func (p *NotificationServiceListNotificationsArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *UserAdminServiceAdminAddUserArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *NotificationServiceListNotificationsArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -11054,7 +30362,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserAdminServiceAdminAddUserArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_NotificationServiceListNotificationsArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -11064,8 +30372,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserAdminServiceAdminAddUserArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewAdminAddUserReq()
+func (p *NotificationServiceListNotificationsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewListNotificationsReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -11073,9 +30381,9 @@ func (p *UserAdminServiceAdminAddUserArgs) ReadField1(iprot thrift.TProtocol) er
 	return nil
 }
 
-func (p *UserAdminServiceAdminAddUserArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *NotificationServiceListNotificationsArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("AdminAddUser_args"); err != nil {
+	if err = oprot.WriteStructBegin("ListNotifications_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -11101,7 +30409,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserAdminServiceAdminAddUserArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *NotificationServiceListNotificationsArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -11118,43 +30426,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *UserAdminServiceAdminAddUserArgs) String() string {
+func (p *NotificationServiceListNotificationsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserAdminServiceAdminAddUserArgs(%+v)", *p)
+	return fmt.Sprintf("NotificationServiceListNotificationsArgs(%+v)", *p)
 
 }
 
-type UserAdminServiceAdminAddUserResult struct {
-	Success *AdminAddUserResp `thrift:"success,0,optional"`
+type NotificationServiceListNotificationsResult struct {
+	Success *ListNotificationsResp `thrift:"success,0,optional"`
 }
 
-func NewUserAdminServiceAdminAddUserResult() *UserAdminServiceAdminAddUserResult {
-	return &UserAdminServiceAdminAddUserResult{}
+func NewNotificationServiceListNotificationsResult() *NotificationServiceListNotificationsResult {
+	return &NotificationServiceListNotificationsResult{}
 }
 
-func (p *UserAdminServiceAdminAddUserResult) InitDefault() {
+func (p *NotificationServiceListNotificationsResult) InitDefault() {
 }
 
-var UserAdminServiceAdminAddUserResult_Success_DEFAULT *AdminAddUserResp
+var NotificationServiceListNotificationsResult_Success_DEFAULT *ListNotificationsResp
 
-func (p *UserAdminServiceAdminAddUserResult) GetSuccess() (v *AdminAddUserResp) {
+func (p *NotificationServiceListNotificationsResult) GetSuccess() (v *ListNotificationsResp) {
 	if !p.IsSetSuccess() {
-		return UserAdminServiceAdminAddUserResult_Success_DEFAULT
+		return NotificationServiceListNotificationsResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_UserAdminServiceAdminAddUserResult = map[int16]string{
+var fieldIDToName_NotificationServiceListNotificationsResult = map[int16]string{
 	0: "success",
 }
 
-func (p *UserAdminServiceAdminAddUserResult) IsSetSuccess() bool {
+func (p *NotificationServiceListNotificationsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserAdminServiceAdminAddUserResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *NotificationServiceListNotificationsResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -11200,7 +30508,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserAdminServiceAdminAddUserResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_NotificationServiceListNotificationsResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -11210,8 +30518,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserAdminServiceAdminAddUserResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewAdminAddUserResp()
+func (p *NotificationServiceListNotificationsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewListNotificationsResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -11219,9 +30527,9 @@ func (p *UserAdminServiceAdminAddUserResult) ReadField0(iprot thrift.TProtocol)
 	return nil
 }
 
-func (p *UserAdminServiceAdminAddUserResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *NotificationServiceListNotificationsResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("AdminAddUser_result"); err != nil {
+	if err = oprot.WriteStructBegin("ListNotifications_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -11247,7 +30555,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserAdminServiceAdminAddUserResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *NotificationServiceListNotificationsResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -11266,43 +30574,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *UserAdminServiceAdminAddUserResult) String() string {
+func (p *NotificationServiceListNotificationsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserAdminServiceAdminAddUserResult(%+v)", *p)
+	return fmt.Sprintf("NotificationServiceListNotificationsResult(%+v)", *p)
 
 }
 
-type UserAdminServiceAdminUpdateUserArgs struct {
-	Req *AdminUpdateUserReq `thrift:"req,1"`
+type NotificationServiceMarkNotificationsReadArgs struct {
+	Req *MarkNotificationsReadReq `thrift:"req,1"`
 }
 
-func NewUserAdminServiceAdminUpdateUserArgs() *UserAdminServiceAdminUpdateUserArgs {
-	return &UserAdminServiceAdminUpdateUserArgs{}
+func NewNotificationServiceMarkNotificationsReadArgs() *NotificationServiceMarkNotificationsReadArgs {
+	return &NotificationServiceMarkNotificationsReadArgs{}
 }
 
-func (p *UserAdminServiceAdminUpdateUserArgs) InitDefault() {
+func (p *NotificationServiceMarkNotificationsReadArgs) InitDefault() {
 }
 
-var UserAdminServiceAdminUpdateUserArgs_Req_DEFAULT *AdminUpdateUserReq
+var NotificationServiceMarkNotificationsReadArgs_Req_DEFAULT *MarkNotificationsReadReq
 
-func (p *UserAdminServiceAdminUpdateUserArgs) GetReq() (v *AdminUpdateUserReq) {
+func (p *NotificationServiceMarkNotificationsReadArgs) GetReq() (v *MarkNotificationsReadReq) {
 	if !p.IsSetReq() {
-		return UserAdminServiceAdminUpdateUserArgs_Req_DEFAULT
+		return NotificationServiceMarkNotificationsReadArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_UserAdminServiceAdminUpdateUserArgs = map[int16]string{
+var fieldIDToName_NotificationServiceMarkNotificationsReadArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *UserAdminServiceAdminUpdateUserArgs) IsSetReq() bool {
+func (p *NotificationServiceMarkNotificationsReadArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *UserAdminServiceAdminUpdateUserArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *NotificationServiceMarkNotificationsReadArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -11348,7 +30656,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserAdminServiceAdminUpdateUserArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_NotificationServiceMarkNotificationsReadArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -11358,8 +30666,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserAdminServiceAdminUpdateUserArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewAdminUpdateUserReq()
+func (p *NotificationServiceMarkNotificationsReadArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewMarkNotificationsReadReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -11367,9 +30675,9 @@ func (p *UserAdminServiceAdminUpdateUserArgs) ReadField1(iprot thrift.TProtocol)
 	return nil
 }
 
-func (p *UserAdminServiceAdminUpdateUserArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *NotificationServiceMarkNotificationsReadArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("AdminUpdateUser_args"); err != nil {
+	if err = oprot.WriteStructBegin("MarkNotificationsRead_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -11395,7 +30703,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserAdminServiceAdminUpdateUserArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *NotificationServiceMarkNotificationsReadArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -11412,43 +30720,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *UserAdminServiceAdminUpdateUserArgs) String() string {
+func (p *NotificationServiceMarkNotificationsReadArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserAdminServiceAdminUpdateUserArgs(%+v)", *p)
+	return fmt.Sprintf("NotificationServiceMarkNotificationsReadArgs(%+v)", *p)
 
 }
 
-type UserAdminServiceAdminUpdateUserResult struct {
-	Success *AdminUpdateUserResp `thrift:"success,0,optional"`
+type NotificationServiceMarkNotificationsReadResult struct {
+	Success *MarkNotificationsReadResp `thrift:"success,0,optional"`
 }
 
-func NewUserAdminServiceAdminUpdateUserResult() *UserAdminServiceAdminUpdateUserResult {
-	return &UserAdminServiceAdminUpdateUserResult{}
+func NewNotificationServiceMarkNotificationsReadResult() *NotificationServiceMarkNotificationsReadResult {
+	return &NotificationServiceMarkNotificationsReadResult{}
 }
 
-func (p *UserAdminServiceAdminUpdateUserResult) InitDefault() {
+func (p *NotificationServiceMarkNotificationsReadResult) InitDefault() {
 }
 
-var UserAdminServiceAdminUpdateUserResult_Success_DEFAULT *AdminUpdateUserResp
+var NotificationServiceMarkNotificationsReadResult_Success_DEFAULT *MarkNotificationsReadResp
 
-func (p *UserAdminServiceAdminUpdateUserResult) GetSuccess() (v *AdminUpdateUserResp) {
+func (p *NotificationServiceMarkNotificationsReadResult) GetSuccess() (v *MarkNotificationsReadResp) {
 	if !p.IsSetSuccess() {
-		return UserAdminServiceAdminUpdateUserResult_Success_DEFAULT
+		return NotificationServiceMarkNotificationsReadResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_UserAdminServiceAdminUpdateUserResult = map[int16]string{
+var fieldIDToName_NotificationServiceMarkNotificationsReadResult = map[int16]string{
 	0: "success",
 }
 
-func (p *UserAdminServiceAdminUpdateUserResult) IsSetSuccess() bool {
+func (p *NotificationServiceMarkNotificationsReadResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserAdminServiceAdminUpdateUserResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *NotificationServiceMarkNotificationsReadResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -11494,7 +30802,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserAdminServiceAdminUpdateUserResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_NotificationServiceMarkNotificationsReadResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -11504,8 +30812,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserAdminServiceAdminUpdateUserResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewAdminUpdateUserResp()
+func (p *NotificationServiceMarkNotificationsReadResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewMarkNotificationsReadResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -11513,9 +30821,9 @@ func (p *UserAdminServiceAdminUpdateUserResult) ReadField0(iprot thrift.TProtoco
 	return nil
 }
 
-func (p *UserAdminServiceAdminUpdateUserResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *NotificationServiceMarkNotificationsReadResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("AdminUpdateUser_result"); err != nil {
+	if err = oprot.WriteStructBegin("MarkNotificationsRead_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -11541,7 +30849,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserAdminServiceAdminUpdateUserResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *NotificationServiceMarkNotificationsReadResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -11560,40 +30868,39 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *UserAdminServiceAdminUpdateUserResult) String() string {
+func (p *NotificationServiceMarkNotificationsReadResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserAdminServiceAdminUpdateUserResult(%+v)", *p)
+	return fmt.Sprintf("NotificationServiceMarkNotificationsReadResult(%+v)", *p)
 
 }
 
-type RoleAdminServiceProcessor struct {
+type ReputationServiceProcessor struct {
 	processorMap map[string]thrift.TProcessorFunction
-	handler      RoleAdminService
+	handler      ReputationService
 }
 
-func (p *RoleAdminServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
+func (p *ReputationServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
 	p.processorMap[key] = processor
 }
 
-func (p *RoleAdminServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
+func (p *ReputationServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
 	processor, ok = p.processorMap[key]
 	return processor, ok
 }
 
-func (p *RoleAdminServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
+func (p *ReputationServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
 	return p.processorMap
-}
-
-func NewRoleAdminServiceProcessor(handler RoleAdminService) *RoleAdminServiceProcessor {
-	self := &RoleAdminServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
-	self.AddToProcessorMap("GetPermissionList", &roleAdminServiceProcessorGetPermissionList{handler: handler})
-	self.AddToProcessorMap("GetRoleList", &roleAdminServiceProcessorGetRoleList{handler: handler})
-	self.AddToProcessorMap("AddRole", &roleAdminServiceProcessorAddRole{handler: handler})
+}
+
+func NewReputationServiceProcessor(handler ReputationService) *ReputationServiceProcessor {
+	self := &ReputationServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
+	self.AddToProcessorMap("ListMyReputationRecords", &reputationServiceProcessorListMyReputationRecords{handler: handler})
+	self.AddToProcessorMap("AdjustUserReputation", &reputationServiceProcessorAdjustUserReputation{handler: handler})
 	return self
 }
-func (p *RoleAdminServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+func (p *ReputationServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
 	name, _, seqId, err := iprot.ReadMessageBegin()
 	if err != nil {
 		return false, err
@@ -11611,64 +30918,16 @@ func (p *RoleAdminServiceProcessor) Process(ctx context.Context, iprot, oprot th
 	return false, x
 }
 
-type roleAdminServiceProcessorGetPermissionList struct {
-	handler RoleAdminService
-}
-
-func (p *roleAdminServiceProcessorGetPermissionList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := RoleAdminServiceGetPermissionListArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetPermissionList", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
-
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := RoleAdminServiceGetPermissionListResult{}
-	var retval *GetPermissionListResp
-	if retval, err2 = p.handler.GetPermissionList(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetPermissionList: "+err2.Error())
-		oprot.WriteMessageBegin("GetPermissionList", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("GetPermissionList", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
-	}
-	return true, err
-}
-
-type roleAdminServiceProcessorGetRoleList struct {
-	handler RoleAdminService
+type reputationServiceProcessorListMyReputationRecords struct {
+	handler ReputationService
 }
 
-func (p *roleAdminServiceProcessorGetRoleList) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := RoleAdminServiceGetRoleListArgs{}
+func (p *reputationServiceProcessorListMyReputationRecords) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ReputationServiceListMyReputationRecordsArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetRoleList", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("ListMyReputationRecords", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -11677,11 +30936,11 @@ func (p *roleAdminServiceProcessorGetRoleList) Process(ctx context.Context, seqI
 
 	iprot.ReadMessageEnd()
 	var err2 error
-	result := RoleAdminServiceGetRoleListResult{}
-	var retval *GetRoleListResp
-	if retval, err2 = p.handler.GetRoleList(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetRoleList: "+err2.Error())
-		oprot.WriteMessageBegin("GetRoleList", thrift.EXCEPTION, seqId)
+	result := ReputationServiceListMyReputationRecordsResult{}
+	var retval *ListMyReputationRecordsResp
+	if retval, err2 = p.handler.ListMyReputationRecords(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListMyReputationRecords: "+err2.Error())
+		oprot.WriteMessageBegin("ListMyReputationRecords", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -11689,7 +30948,7 @@ func (p *roleAdminServiceProcessorGetRoleList) Process(ctx context.Context, seqI
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("GetRoleList", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("ListMyReputationRecords", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -11707,16 +30966,16 @@ func (p *roleAdminServiceProcessorGetRoleList) Process(ctx context.Context, seqI
 	return true, err
 }
 
-type roleAdminServiceProcessorAddRole struct {
-	handler RoleAdminService
+type reputationServiceProcessorAdjustUserReputation struct {
+	handler ReputationService
 }
 
-func (p *roleAdminServiceProcessorAddRole) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := RoleAdminServiceAddRoleArgs{}
+func (p *reputationServiceProcessorAdjustUserReputation) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := ReputationServiceAdjustUserReputationArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("AddRole", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("AdjustUserReputation", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -11725,11 +30984,11 @@ func (p *roleAdminServiceProcessorAddRole) Process(ctx context.Context, seqId in
 
 	iprot.ReadMessageEnd()
 	var err2 error
-	result := RoleAdminServiceAddRoleResult{}
-	var retval *AddRoleResp
-	if retval, err2 = p.handler.AddRole(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing AddRole: "+err2.Error())
-		oprot.WriteMessageBegin("AddRole", thrift.EXCEPTION, seqId)
+	result := ReputationServiceAdjustUserReputationResult{}
+	var retval *AdjustUserReputationResp
+	if retval, err2 = p.handler.AdjustUserReputation(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing AdjustUserReputation: "+err2.Error())
+		oprot.WriteMessageBegin("AdjustUserReputation", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -11737,7 +30996,7 @@ func (p *roleAdminServiceProcessorAddRole) Process(ctx context.Context, seqId in
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("AddRole", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("AdjustUserReputation", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -11755,35 +31014,35 @@ func (p *roleAdminServiceProcessorAddRole) Process(ctx context.Context, seqId in
 	return true, err
 }
 
-type RoleAdminServiceGetPermissionListArgs struct {
-	Req *GetPermissionListReq `thrift:"req,1"`
+type ReputationServiceListMyReputationRecordsArgs struct {
+	Req *ListMyReputationRecordsReq `thrift:"req,1"`
 }
 
-func NewRoleAdminServiceGetPermissionListArgs() *RoleAdminServiceGetPermissionListArgs {
-	return &RoleAdminServiceGetPermissionListArgs{}
+func NewReputationServiceListMyReputationRecordsArgs() *ReputationServiceListMyReputationRecordsArgs {
+	return &ReputationServiceListMyReputationRecordsArgs{}
 }
 
-func (p *RoleAdminServiceGetPermissionListArgs) InitDefault() {
+func (p *ReputationServiceListMyReputationRecordsArgs) InitDefault() {
 }
 
-var RoleAdminServiceGetPermissionListArgs_Req_DEFAULT *GetPermissionListReq
+var ReputationServiceListMyReputationRecordsArgs_Req_DEFAULT *ListMyReputationRecordsReq
 
-func (p *RoleAdminServiceGetPermissionListArgs) GetReq() (v *GetPermissionListReq) {
+func (p *ReputationServiceListMyReputationRecordsArgs) GetReq() (v *ListMyReputationRecordsReq) {
 	if !p.IsSetReq() {
-		return RoleAdminServiceGetPermissionListArgs_Req_DEFAULT
+		return ReputationServiceListMyReputationRecordsArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_RoleAdminServiceGetPermissionListArgs = map[int16]string{
+var fieldIDToName_ReputationServiceListMyReputationRecordsArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *RoleAdminServiceGetPermissionListArgs) IsSetReq() bool {
+func (p *ReputationServiceListMyReputationRecordsArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *RoleAdminServiceGetPermissionListArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ReputationServiceListMyReputationRecordsArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -11829,7 +31088,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RoleAdminServiceGetPermissionListArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ReputationServiceListMyReputationRecordsArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -11839,8 +31098,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *RoleAdminServiceGetPermissionListArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewGetPermissionListReq()
+func (p *ReputationServiceListMyReputationRecordsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewListMyReputationRecordsReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -11848,9 +31107,9 @@ func (p *RoleAdminServiceGetPermissionListArgs) ReadField1(iprot thrift.TProtoco
 	return nil
 }
 
-func (p *RoleAdminServiceGetPermissionListArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ReputationServiceListMyReputationRecordsArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetPermissionList_args"); err != nil {
+	if err = oprot.WriteStructBegin("ListMyReputationRecords_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -11876,7 +31135,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *RoleAdminServiceGetPermissionListArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ReputationServiceListMyReputationRecordsArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -11893,43 +31152,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *RoleAdminServiceGetPermissionListArgs) String() string {
+func (p *ReputationServiceListMyReputationRecordsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("RoleAdminServiceGetPermissionListArgs(%+v)", *p)
+	return fmt.Sprintf("ReputationServiceListMyReputationRecordsArgs(%+v)", *p)
 
 }
 
-type RoleAdminServiceGetPermissionListResult struct {
-	Success *GetPermissionListResp `thrift:"success,0,optional"`
+type ReputationServiceListMyReputationRecordsResult struct {
+	Success *ListMyReputationRecordsResp `thrift:"success,0,optional"`
 }
 
-func NewRoleAdminServiceGetPermissionListResult() *RoleAdminServiceGetPermissionListResult {
-	return &RoleAdminServiceGetPermissionListResult{}
+func NewReputationServiceListMyReputationRecordsResult() *ReputationServiceListMyReputationRecordsResult {
+	return &ReputationServiceListMyReputationRecordsResult{}
 }
 
-func (p *RoleAdminServiceGetPermissionListResult) InitDefault() {
+func (p *ReputationServiceListMyReputationRecordsResult) InitDefault() {
 }
 
-var RoleAdminServiceGetPermissionListResult_Success_DEFAULT *GetPermissionListResp
+var ReputationServiceListMyReputationRecordsResult_Success_DEFAULT *ListMyReputationRecordsResp
 
-func (p *RoleAdminServiceGetPermissionListResult) GetSuccess() (v *GetPermissionListResp) {
+func (p *ReputationServiceListMyReputationRecordsResult) GetSuccess() (v *ListMyReputationRecordsResp) {
 	if !p.IsSetSuccess() {
-		return RoleAdminServiceGetPermissionListResult_Success_DEFAULT
+		return ReputationServiceListMyReputationRecordsResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_RoleAdminServiceGetPermissionListResult = map[int16]string{
+var fieldIDToName_ReputationServiceListMyReputationRecordsResult = map[int16]string{
 	0: "success",
 }
 
-func (p *RoleAdminServiceGetPermissionListResult) IsSetSuccess() bool {
+func (p *ReputationServiceListMyReputationRecordsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *RoleAdminServiceGetPermissionListResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ReputationServiceListMyReputationRecordsResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -11975,7 +31234,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RoleAdminServiceGetPermissionListResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ReputationServiceListMyReputationRecordsResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -11985,8 +31244,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *RoleAdminServiceGetPermissionListResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewGetPermissionListResp()
+func (p *ReputationServiceListMyReputationRecordsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewListMyReputationRecordsResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -11994,9 +31253,9 @@ func (p *RoleAdminServiceGetPermissionListResult) ReadField0(iprot thrift.TProto
 	return nil
 }
 
-func (p *RoleAdminServiceGetPermissionListResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ReputationServiceListMyReputationRecordsResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetPermissionList_result"); err != nil {
+	if err = oprot.WriteStructBegin("ListMyReputationRecords_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -12022,7 +31281,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *RoleAdminServiceGetPermissionListResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ReputationServiceListMyReputationRecordsResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -12041,43 +31300,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *RoleAdminServiceGetPermissionListResult) String() string {
+func (p *ReputationServiceListMyReputationRecordsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("RoleAdminServiceGetPermissionListResult(%+v)", *p)
+	return fmt.Sprintf("ReputationServiceListMyReputationRecordsResult(%+v)", *p)
 
 }
 
-type RoleAdminServiceGetRoleListArgs struct {
-	Req *GetRoleListReq `thrift:"req,1"`
+type ReputationServiceAdjustUserReputationArgs struct {
+	Req *AdjustUserReputationReq `thrift:"req,1"`
 }
 
-func NewRoleAdminServiceGetRoleListArgs() *RoleAdminServiceGetRoleListArgs {
-	return &RoleAdminServiceGetRoleListArgs{}
+func NewReputationServiceAdjustUserReputationArgs() *ReputationServiceAdjustUserReputationArgs {
+	return &ReputationServiceAdjustUserReputationArgs{}
 }
 
-func (p *RoleAdminServiceGetRoleListArgs) InitDefault() {
+func (p *ReputationServiceAdjustUserReputationArgs) InitDefault() {
 }
 
-var RoleAdminServiceGetRoleListArgs_Req_DEFAULT *GetRoleListReq
+var ReputationServiceAdjustUserReputationArgs_Req_DEFAULT *AdjustUserReputationReq
 
-func (p *RoleAdminServiceGetRoleListArgs) GetReq() (v *GetRoleListReq) {
+func (p *ReputationServiceAdjustUserReputationArgs) GetReq() (v *AdjustUserReputationReq) {
 	if !p.IsSetReq() {
-		return RoleAdminServiceGetRoleListArgs_Req_DEFAULT
+		return ReputationServiceAdjustUserReputationArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_RoleAdminServiceGetRoleListArgs = map[int16]string{
+var fieldIDToName_ReputationServiceAdjustUserReputationArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *RoleAdminServiceGetRoleListArgs) IsSetReq() bool {
+func (p *ReputationServiceAdjustUserReputationArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *RoleAdminServiceGetRoleListArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *ReputationServiceAdjustUserReputationArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -12123,7 +31382,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RoleAdminServiceGetRoleListArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ReputationServiceAdjustUserReputationArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -12133,8 +31392,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *RoleAdminServiceGetRoleListArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewGetRoleListReq()
+func (p *ReputationServiceAdjustUserReputationArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewAdjustUserReputationReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -12142,9 +31401,9 @@ func (p *RoleAdminServiceGetRoleListArgs) ReadField1(iprot thrift.TProtocol) err
 	return nil
 }
 
-func (p *RoleAdminServiceGetRoleListArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *ReputationServiceAdjustUserReputationArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetRoleList_args"); err != nil {
+	if err = oprot.WriteStructBegin("AdjustUserReputation_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -12170,7 +31429,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *RoleAdminServiceGetRoleListArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ReputationServiceAdjustUserReputationArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -12187,43 +31446,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *RoleAdminServiceGetRoleListArgs) String() string {
+func (p *ReputationServiceAdjustUserReputationArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("RoleAdminServiceGetRoleListArgs(%+v)", *p)
+	return fmt.Sprintf("ReputationServiceAdjustUserReputationArgs(%+v)", *p)
 
 }
 
-type RoleAdminServiceGetRoleListResult struct {
-	Success *GetRoleListResp `thrift:"success,0,optional"`
+type ReputationServiceAdjustUserReputationResult struct {
+	Success *AdjustUserReputationResp `thrift:"success,0,optional"`
 }
 
-func NewRoleAdminServiceGetRoleListResult() *RoleAdminServiceGetRoleListResult {
-	return &RoleAdminServiceGetRoleListResult{}
+func NewReputationServiceAdjustUserReputationResult() *ReputationServiceAdjustUserReputationResult {
+	return &ReputationServiceAdjustUserReputationResult{}
 }
 
-func (p *RoleAdminServiceGetRoleListResult) InitDefault() {
+func (p *ReputationServiceAdjustUserReputationResult) InitDefault() {
 }
 
-var RoleAdminServiceGetRoleListResult_Success_DEFAULT *GetRoleListResp
+var ReputationServiceAdjustUserReputationResult_Success_DEFAULT *AdjustUserReputationResp
 
-func (p *RoleAdminServiceGetRoleListResult) GetSuccess() (v *GetRoleListResp) {
+func (p *ReputationServiceAdjustUserReputationResult) GetSuccess() (v *AdjustUserReputationResp) {
 	if !p.IsSetSuccess() {
-		return RoleAdminServiceGetRoleListResult_Success_DEFAULT
+		return ReputationServiceAdjustUserReputationResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_RoleAdminServiceGetRoleListResult = map[int16]string{
+var fieldIDToName_ReputationServiceAdjustUserReputationResult = map[int16]string{
 	0: "success",
 }
 
-func (p *RoleAdminServiceGetRoleListResult) IsSetSuccess() bool {
+func (p *ReputationServiceAdjustUserReputationResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *RoleAdminServiceGetRoleListResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *ReputationServiceAdjustUserReputationResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -12269,7 +31528,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RoleAdminServiceGetRoleListResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ReputationServiceAdjustUserReputationResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -12279,8 +31538,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *RoleAdminServiceGetRoleListResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewGetRoleListResp()
+func (p *ReputationServiceAdjustUserReputationResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewAdjustUserReputationResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -12288,9 +31547,9 @@ func (p *RoleAdminServiceGetRoleListResult) ReadField0(iprot thrift.TProtocol) e
 	return nil
 }
 
-func (p *RoleAdminServiceGetRoleListResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *ReputationServiceAdjustUserReputationResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetRoleList_result"); err != nil {
+	if err = oprot.WriteStructBegin("AdjustUserReputation_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -12316,7 +31575,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *RoleAdminServiceGetRoleListResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ReputationServiceAdjustUserReputationResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -12335,43 +31594,132 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *RoleAdminServiceGetRoleListResult) String() string {
+func (p *ReputationServiceAdjustUserReputationResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("RoleAdminServiceGetRoleListResult(%+v)", *p)
+	return fmt.Sprintf("ReputationServiceAdjustUserReputationResult(%+v)", *p)
 
 }
 
-type RoleAdminServiceAddRoleArgs struct {
-	Req *AddRoleReq `thrift:"req,1"`
+type AchievementServiceProcessor struct {
+	processorMap map[string]thrift.TProcessorFunction
+	handler      AchievementService
 }
 
-func NewRoleAdminServiceAddRoleArgs() *RoleAdminServiceAddRoleArgs {
-	return &RoleAdminServiceAddRoleArgs{}
+func (p *AchievementServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
+	p.processorMap[key] = processor
 }
 
-func (p *RoleAdminServiceAddRoleArgs) InitDefault() {
+func (p *AchievementServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
+	processor, ok = p.processorMap[key]
+	return processor, ok
 }
 
-var RoleAdminServiceAddRoleArgs_Req_DEFAULT *AddRoleReq
+func (p *AchievementServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
+	return p.processorMap
+}
 
-func (p *RoleAdminServiceAddRoleArgs) GetReq() (v *AddRoleReq) {
+func NewAchievementServiceProcessor(handler AchievementService) *AchievementServiceProcessor {
+	self := &AchievementServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
+	self.AddToProcessorMap("ListMyAchievements", &achievementServiceProcessorListMyAchievements{handler: handler})
+	return self
+}
+func (p *AchievementServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	name, _, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return false, err
+	}
+	if processor, ok := p.GetProcessorFunction(name); ok {
+		return processor.Process(ctx, seqId, iprot, oprot)
+	}
+	iprot.Skip(thrift.STRUCT)
+	iprot.ReadMessageEnd()
+	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
+	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
+	x.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Flush(ctx)
+	return false, x
+}
+
+type achievementServiceProcessorListMyAchievements struct {
+	handler AchievementService
+}
+
+func (p *achievementServiceProcessorListMyAchievements) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := AchievementServiceListMyAchievementsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ListMyAchievements", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := AchievementServiceListMyAchievementsResult{}
+	var retval *ListMyAchievementsResp
+	if retval, err2 = p.handler.ListMyAchievements(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListMyAchievements: "+err2.Error())
+		oprot.WriteMessageBegin("ListMyAchievements", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ListMyAchievements", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type AchievementServiceListMyAchievementsArgs struct {
+	Req *ListMyAchievementsReq `thrift:"req,1"`
+}
+
+func NewAchievementServiceListMyAchievementsArgs() *AchievementServiceListMyAchievementsArgs {
+	return &AchievementServiceListMyAchievementsArgs{}
+}
+
+func (p *AchievementServiceListMyAchievementsArgs) InitDefault() {
+}
+
+var AchievementServiceListMyAchievementsArgs_Req_DEFAULT *ListMyAchievementsReq
+
+func (p *AchievementServiceListMyAchievementsArgs) GetReq() (v *ListMyAchievementsReq) {
 	if !p.IsSetReq() {
-		return RoleAdminServiceAddRoleArgs_Req_DEFAULT
+		return AchievementServiceListMyAchievementsArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_RoleAdminServiceAddRoleArgs = map[int16]string{
+var fieldIDToName_AchievementServiceListMyAchievementsArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *RoleAdminServiceAddRoleArgs) IsSetReq() bool {
+func (p *AchievementServiceListMyAchievementsArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *RoleAdminServiceAddRoleArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *AchievementServiceListMyAchievementsArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -12417,7 +31765,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RoleAdminServiceAddRoleArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AchievementServiceListMyAchievementsArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -12427,8 +31775,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *RoleAdminServiceAddRoleArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewAddRoleReq()
+func (p *AchievementServiceListMyAchievementsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewListMyAchievementsReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -12436,9 +31784,9 @@ func (p *RoleAdminServiceAddRoleArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *RoleAdminServiceAddRoleArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *AchievementServiceListMyAchievementsArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("AddRole_args"); err != nil {
+	if err = oprot.WriteStructBegin("ListMyAchievements_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -12464,7 +31812,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *RoleAdminServiceAddRoleArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *AchievementServiceListMyAchievementsArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -12481,43 +31829,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *RoleAdminServiceAddRoleArgs) String() string {
+func (p *AchievementServiceListMyAchievementsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("RoleAdminServiceAddRoleArgs(%+v)", *p)
+	return fmt.Sprintf("AchievementServiceListMyAchievementsArgs(%+v)", *p)
 
 }
 
-type RoleAdminServiceAddRoleResult struct {
-	Success *AddRoleResp `thrift:"success,0,optional"`
+type AchievementServiceListMyAchievementsResult struct {
+	Success *ListMyAchievementsResp `thrift:"success,0,optional"`
 }
 
-func NewRoleAdminServiceAddRoleResult() *RoleAdminServiceAddRoleResult {
-	return &RoleAdminServiceAddRoleResult{}
+func NewAchievementServiceListMyAchievementsResult() *AchievementServiceListMyAchievementsResult {
+	return &AchievementServiceListMyAchievementsResult{}
 }
 
-func (p *RoleAdminServiceAddRoleResult) InitDefault() {
+func (p *AchievementServiceListMyAchievementsResult) InitDefault() {
 }
 
-var RoleAdminServiceAddRoleResult_Success_DEFAULT *AddRoleResp
+var AchievementServiceListMyAchievementsResult_Success_DEFAULT *ListMyAchievementsResp
 
-func (p *RoleAdminServiceAddRoleResult) GetSuccess() (v *AddRoleResp) {
+func (p *AchievementServiceListMyAchievementsResult) GetSuccess() (v *ListMyAchievementsResp) {
 	if !p.IsSetSuccess() {
-		return RoleAdminServiceAddRoleResult_Success_DEFAULT
+		return AchievementServiceListMyAchievementsResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_RoleAdminServiceAddRoleResult = map[int16]string{
+var fieldIDToName_AchievementServiceListMyAchievementsResult = map[int16]string{
 	0: "success",
 }
 
-func (p *RoleAdminServiceAddRoleResult) IsSetSuccess() bool {
+func (p *AchievementServiceListMyAchievementsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *RoleAdminServiceAddRoleResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *AchievementServiceListMyAchievementsResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -12563,7 +31911,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RoleAdminServiceAddRoleResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AchievementServiceListMyAchievementsResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -12573,8 +31921,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *RoleAdminServiceAddRoleResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewAddRoleResp()
+func (p *AchievementServiceListMyAchievementsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewListMyAchievementsResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -12582,9 +31930,9 @@ func (p *RoleAdminServiceAddRoleResult) ReadField0(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *RoleAdminServiceAddRoleResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *AchievementServiceListMyAchievementsResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("AddRole_result"); err != nil {
+	if err = oprot.WriteStructBegin("ListMyAchievements_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -12610,7 +31958,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *RoleAdminServiceAddRoleResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *AchievementServiceListMyAchievementsResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -12629,10 +31977,10 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *RoleAdminServiceAddRoleResult) String() string {
+func (p *AchievementServiceListMyAchievementsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("RoleAdminServiceAddRoleResult(%+v)", *p)
+	return fmt.Sprintf("AchievementServiceListMyAchievementsResult(%+v)", *p)
 
 }
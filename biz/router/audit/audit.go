@@ -21,21 +21,68 @@ func Register(r *server.Hertz) {
 		_api := root.Group("/api", _apiMw()...)
 		{
 			_admin := _api.Group("/admin", _adminMw()...)
+			_admin.GET("/actions", append(_listadminactionsMw(), audit.ListAdminActions)...)
+			_actions := _admin.Group("/actions", _actionsMw()...)
+			_actions.GET("/export", append(_exportadminactionsMw(), audit.ExportAdminActions)...)
 			{
 				_audit := _admin.Group("/audit", _auditMw()...)
+				_audit.GET("/appeals", append(_getappeallistMw(), audit.GetAppealList)...)
+				_appeals := _audit.Group("/appeals", _appealsMw()...)
+				_appeals.POST("/:review_id", append(_resolveappealMw(), audit.ResolveAppeal)...)
 				_audit.GET("/comments", append(_getcommentauditlistMw(), audit.GetCommentAuditList)...)
 				_audit.GET("/course_comments", append(_getcoursecommentauditlistMw(), audit.GetCourseCommentAuditList)...)
 				_course_comments := _audit.Group("/course_comments", _course_commentsMw()...)
 				_course_comments.POST("/:review_id", append(_auditcoursecommentMw(), audit.AuditCourseComment)...)
+				_audit.GET("/course_ratings", append(_getcourseratingauditlistMw(), audit.GetCourseRatingAuditList)...)
+				_course_ratings := _audit.Group("/course_ratings", _course_ratingsMw()...)
+				_course_ratings.POST("/:review_id", append(_auditcourseratingMw(), audit.AuditCourseRating)...)
 				_audit.GET("/courses", append(_getcourseauditlistMw(), audit.GetCourseAuditList)...)
 				_courses := _audit.Group("/courses", _coursesMw()...)
 				_courses.POST("/:review_id", append(_auditcourseMw(), audit.AuditCourse)...)
+				_audit.GET("/inbox", append(_getreviewinboxMw(), audit.GetReviewInbox)...)
+				{
+					_queue := _audit.Group("/queue", _queueMw()...)
+					_queue.POST("/claim", append(_claimnextreviewsMw(), audit.ClaimNextReviews)...)
+					_queue.GET("/stats", append(_getreviewqueuestatsMw(), audit.GetReviewQueueStats)...)
+				}
 				_audit.GET("/resource_comments", append(_getresourcecommentauditlistMw(), audit.GetResourceCommentAuditList)...)
 				_resource_comments := _audit.Group("/resource_comments", _resource_commentsMw()...)
 				_resource_comments.POST("/:review_id", append(_auditresourcecommentMw(), audit.AuditResourceComment)...)
+				_audit.GET("/resource_ratings", append(_getresourceratingauditlistMw(), audit.GetResourceRatingAuditList)...)
+				_resource_ratings := _audit.Group("/resource_ratings", _resource_ratingsMw()...)
+				_resource_ratings.POST("/:review_id", append(_auditresourceratingMw(), audit.AuditResourceRating)...)
 				_audit.GET("/resources", append(_getresourceauditlistMw(), audit.GetResourceAuditList)...)
 				_resources := _audit.Group("/resources", _resourcesMw()...)
 				_resources.POST("/:review_id", append(_auditresourceMw(), audit.AuditResource)...)
+				_audit.POST("/restore", append(_restorecontentMw(), audit.RestoreContent)...)
+				{
+					_reviews := _audit.Group("/reviews", _reviewsMw()...)
+					_reviews.POST("/batch", append(_batchauditreviewsMw(), audit.BatchAuditReviews)...)
+					{
+						_review_id := _reviews.Group("/:review_id", _review_idMw()...)
+						_review_id.POST("/actions", append(_applymoderationactionMw(), audit.ApplyModerationAction)...)
+						_review_id.DELETE("/claim", append(_releasereviewMw(), audit.ReleaseReview)...)
+						_review_id.POST("/claim", append(_claimreviewMw(), audit.ClaimReview)...)
+						_review_id.GET("/reporters", append(_getreviewreportersMw(), audit.GetReviewReporters)...)
+					}
+				}
+				{
+					_users := _audit.Group("/users", _usersMw()...)
+					{
+						_user_id := _users.Group("/:user_id", _user_idMw()...)
+						_user_id.POST("/hide_comments", append(_hideusercommentsMw(), audit.HideUserComments)...)
+					}
+				}
+			}
+			_admin.GET("/content_filters", append(_listcontentfilterrulesMw(), audit.ListContentFilterRules)...)
+			_admin.POST("/content_filters", append(_createcontentfilterruleMw(), audit.CreateContentFilterRule)...)
+			_content_filters := _admin.Group("/content_filters", _content_filtersMw()...)
+			_content_filters.DELETE("/:rule_id", append(_deletecontentfilterruleMw(), audit.DeleteContentFilterRule)...)
+			_content_filters.PUT("/:rule_id", append(_updatecontentfilterruleMw(), audit.UpdateContentFilterRule)...)
+			_content_filters.POST("/test", append(_testcontentfilterMw(), audit.TestContentFilter)...)
+			{
+				_reports := _admin.Group("/reports", _reportsMw()...)
+				_reports.GET("/moderation", append(_getmoderationstatsMw(), audit.GetModerationStats)...)
 			}
 		}
 	}
@@ -3,8 +3,6 @@
 package audit
 
 import (
-	"LearnShare/biz/router/auth"
-
 	"github.com/cloudwego/hertz/pkg/app"
 )
 
@@ -19,9 +17,8 @@ func _apiMw() []app.HandlerFunc {
 }
 
 func _adminMw() []app.HandlerFunc {
-	return []app.HandlerFunc{
-		auth.AccessTokenAuth(),
-	}
+	// your code...
+	return nil
 }
 
 func _auditMw() []app.HandlerFunc {
@@ -65,15 +62,13 @@ func _resourcesMw() []app.HandlerFunc {
 }
 
 func _getresourceauditlistMw() []app.HandlerFunc {
-	return []app.HandlerFunc{
-		auth.RequirePermission("review.handle"),
-	}
+	// your code...
+	return nil
 }
 
 func _auditresourceMw() []app.HandlerFunc {
-	return []app.HandlerFunc{
-		auth.RequirePermission("review.handle"),
-	}
+	// your code...
+	return nil
 }
 
 func _course_commentsMw() []app.HandlerFunc {
@@ -92,9 +87,8 @@ func _resource_commentsMw() []app.HandlerFunc {
 }
 
 func _auditresourcecommentMw() []app.HandlerFunc {
-	return []app.HandlerFunc{
-		auth.RequirePermission("review.handle"),
-	}
+	// your code...
+	return nil
 }
 
 func _getcoursecommentauditlistMw() []app.HandlerFunc {
@@ -103,7 +97,181 @@ func _getcoursecommentauditlistMw() []app.HandlerFunc {
 }
 
 func _getresourcecommentauditlistMw() []app.HandlerFunc {
-	return []app.HandlerFunc{
-		auth.RequirePermission("review.handle"),
-	}
+	// your code...
+	return nil
+}
+
+func _listadminactionsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _actionsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _exportadminactionsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getreviewinboxMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _queueMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _claimnextreviewsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getreviewqueuestatsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _reviewsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _review_idMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _releasereviewMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _claimreviewMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getappeallistMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _appealsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _resolveappealMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _applymoderationactionMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getreviewreportersMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getcourseratingauditlistMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _course_ratingsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _auditcourseratingMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getresourceratingauditlistMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _resource_ratingsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _auditresourceratingMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listcontentfilterrulesMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _createcontentfilterruleMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _content_filtersMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _deletecontentfilterruleMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _updatecontentfilterruleMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _testcontentfilterMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _restorecontentMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _batchauditreviewsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _usersMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _user_idMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _hideusercommentsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _reportsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getmoderationstatsMw() []app.HandlerFunc {
+	// your code...
+	return nil
 }
@@ -12,27 +12,44 @@ import (
 
 func AccessTokenAuth() app.HandlerFunc {
 	return func(ctx context.Context, c *app.RequestContext) {
-		// 1. 验证 access-token 是否有效
-		if err := middleware.IsAccessTokenAvailable(ctx, c); err != nil {
+		if err := checkAccessToken(ctx, c); err != nil {
 			fail(c, err)
 			return
 		}
 
-		// 2. 取出 UUID
-		Uuid := service.GetUuidFormContext(c)
+		// 放行
+		c.Next(ctx)
+	}
+}
+
+// checkAccessToken 校验 access-token 并确认未被注销
+func checkAccessToken(ctx context.Context, c *app.RequestContext) error {
+	// 1. 验证 access-token 是否有效
+	if err := middleware.IsAccessTokenAvailable(ctx, c); err != nil {
+		return err
+	}
+
+	// 2. 取出 UUID
+	Uuid := service.GetUuidFormContext(c)
+
+	// 3. 判断是否已登出（黑名单）
+	ok, err := redis.IsBlacklistToken(ctx, Uuid)
+	if err != nil {
+		return err
+	}
+	if ok {
+		return errno.NewErrNo(errno.AuthInvalidCode, "令牌已被注销")
+	}
+	return nil
+}
 
-		// 3. 判断是否已登出（黑名单）
-		ok, err := redis.IsBlacklistToken(ctx, Uuid)
-		if err != nil {
+// RequireActiveAccount 要求当前用户已完成注册激活（需要先经过 AccessTokenAuth）
+func RequireActiveAccount() app.HandlerFunc {
+	return func(ctx context.Context, c *app.RequestContext) {
+		if err := service.NewUserService(ctx, c).EnsureActive(); err != nil {
 			fail(c, err)
 			return
 		}
-		if ok {
-			fail(c, errno.NewErrNo(errno.AuthInvalidCode, "令牌已被注销"))
-			return
-		}
-
-		// 4. 放行
 		c.Next(ctx)
 	}
 }
@@ -10,69 +10,46 @@ import (
 
 // RequirePermission 返回需要特定权限的中间件（需要先经过 Auth 中间件）
 func RequirePermission(permissionName string) app.HandlerFunc {
-	return func(ctx context.Context, c *app.RequestContext) {
-
-		//1. 获取用户角色ID
-		roleId := service.GetRoleIdFormContext(c)
-
-		// 2. 获取该角色的所有权限
-		permissions, err := service.NewRoleAdminService(ctx, c).GetRolePermissions(roleId)
-		if err != nil {
-			fail(c, errno.NewErrNo(errno.InternalDatabaseErrorCode, "查询角色权限失败"))
-			return
-		}
-		// 3. 检查是否拥有所需权限
-		hasPermission := false
-		for _, perm := range permissions {
-			if permissionName == perm {
-				hasPermission = true
-				break
-			}
-		}
-
-		if !hasPermission {
-			fail(c, errno.NewErrNo(errno.AuthNoOperatePermissionCode, "无权限访问"))
-			return
-		}
-
-		// 5. 放行
-		c.Next(ctx)
-	}
+	return RequirePermissions(permissionName)
 }
 
 // RequirePermissions 返回需要多个权限之一的中间件（OR 逻辑，需要先经过 Auth 中间件）
 func RequirePermissions(permissionNames ...string) app.HandlerFunc {
 	return func(ctx context.Context, c *app.RequestContext) {
-		//1. 获取用户角色ID
-		roleId := service.GetRoleIdFormContext(c)
-
-		// 2. 获取该角色的所有权限
-		permissions, err := service.NewRoleAdminService(ctx, c).GetRolePermissions(roleId)
-		if err != nil {
-			fail(c, errno.NewErrNo(errno.InternalDatabaseErrorCode, "查询角色权限失败"))
+		if err := checkPermissions(ctx, c, permissionNames...); err != nil {
+			fail(c, err)
 			return
 		}
+		c.Next(ctx)
+	}
+}
 
-		// 3. 检查是否拥有任一所需权限
-		hasPermission := false
-		for _, requiredPerm := range permissionNames {
-			for _, perm := range permissions {
-				if requiredPerm == perm {
-					hasPermission = true
-					break
-				}
-			}
-			if hasPermission {
-				break
-			}
-		}
+// checkPermissions 检查当前角色是否拥有任一所需权限，通过个人访问令牌认证时该权限还需在令牌授权范围内
+func checkPermissions(ctx context.Context, c *app.RequestContext, permissionNames ...string) error {
+	// 1. 获取该角色的所有权限
+	permissions, err := service.NewRoleAdminService(ctx, c).GetRolePermissions(service.GetRoleIdFormContext(c))
+	if err != nil {
+		return errno.NewErrNo(errno.InternalDatabaseErrorCode, "查询角色权限失败")
+	}
+	owned := make(map[string]struct{}, len(permissions))
+	for _, perm := range permissions {
+		owned[perm] = struct{}{}
+	}
 
-		if !hasPermission {
-			fail(c, errno.NewErrNo(errno.AuthNoOperatePermissionCode, "无权限访问"))
-			return
+	// 2. 检查是否拥有任一所需权限
+	scopeDenied := ""
+	for _, required := range permissionNames {
+		if _, ok := owned[required]; !ok {
+			continue
+		}
+		if service.HasTokenScope(c, required) {
+			return nil
 		}
+		scopeDenied = required
+	}
 
-		// 5. 放行
-		c.Next(ctx)
+	if scopeDenied != "" {
+		return errno.PersonalTokenScopeDeniedError.WithMessage("个人访问令牌缺少授权范围: " + scopeDenied)
 	}
+	return errno.NewErrNo(errno.AuthNoOperatePermissionCode, "无权限访问")
 }
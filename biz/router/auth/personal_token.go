@@ -0,0 +1,49 @@
+package auth
+
+import (
+	"LearnShare/biz/service"
+	"LearnShare/pkg/constants"
+	"LearnShare/pkg/errno"
+	"context"
+	"strings"
+
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// TokenAuth 同时接受 access-token 与个人访问令牌的认证中间件
+// 使用个人访问令牌时要求其授权范围包含 scope；scope 为空时由后续的 RequirePermission 校验授权范围
+func TokenAuth(scope string) app.HandlerFunc {
+	return func(ctx context.Context, c *app.RequestContext) {
+		if err := checkToken(ctx, c, scope); err != nil {
+			fail(c, err)
+			return
+		}
+		c.Next(ctx)
+	}
+}
+
+// checkToken 未携带个人访问令牌时按 access-token 校验，否则校验个人访问令牌及其授权范围
+func checkToken(ctx context.Context, c *app.RequestContext, scope string) error {
+	token, ok := personalToken(c)
+	if !ok {
+		return checkAccessToken(ctx, c)
+	}
+
+	if err := service.NewUserService(ctx, c).AuthenticatePersonalToken(token); err != nil {
+		return err
+	}
+	if scope != "" && !service.HasTokenScope(c, scope) {
+		return errno.PersonalTokenScopeDeniedError.WithMessage("个人访问令牌缺少授权范围: " + scope)
+	}
+	return nil
+}
+
+// personalToken 从 Authorization 请求头中取出个人访问令牌，兼容 Bearer 前缀
+func personalToken(c *app.RequestContext) (string, bool) {
+	header := strings.TrimSpace(string(c.GetHeader("Authorization")))
+	header = strings.TrimSpace(strings.TrimPrefix(header, "Bearer "))
+	if !strings.HasPrefix(header, constants.PersonalTokenPrefix) {
+		return "", false
+	}
+	return header, true
+}
@@ -0,0 +1,138 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"testing"
+	"time"
+
+	"LearnShare/biz/dal/db"
+	"LearnShare/pkg/constants"
+	"LearnShare/pkg/errno"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// setupTokenDB 初始化只包含用户与个人访问令牌表的内存数据库
+func setupTokenDB(t *testing.T) func() {
+	t.Helper()
+	sqliteDB, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("初始化SQLite失败: %v", err)
+	}
+	createTableSQL := `
+CREATE TABLE IF NOT EXISTS users (
+    user_id INTEGER PRIMARY KEY AUTOINCREMENT,
+    username TEXT,
+    password_hash TEXT,
+    email TEXT UNIQUE,
+    college_id INTEGER,
+    major_id INTEGER,
+    avatar_url TEXT,
+    reputation_score INTEGER DEFAULT 0,
+    role_id INTEGER,
+    status TEXT,
+    muted_until DATETIME,
+    activation_pending_at DATETIME,
+    created_at DATETIME,
+    updated_at DATETIME
+);
+CREATE TABLE IF NOT EXISTS personal_access_tokens (
+    token_id INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id INTEGER,
+    name TEXT,
+    token_prefix TEXT,
+    token_hash TEXT UNIQUE,
+    scopes TEXT,
+    expires_at DATETIME,
+    last_used_at DATETIME,
+    last_used_ip TEXT,
+    revoked_at DATETIME,
+    created_at DATETIME
+);
+`
+	if err := sqliteDB.Exec(createTableSQL).Error; err != nil {
+		t.Fatalf("创建测试数据表失败: %v", err)
+	}
+	db.DB = sqliteDB
+	return func() {
+		if sqlDB, err := db.DB.DB(); err == nil {
+			_ = sqlDB.Close()
+		}
+	}
+}
+
+// seedTokenUser 写入一个正常状态的用户及其个人访问令牌
+func seedTokenUser(t *testing.T, email, plain, scopes string, expiresAt *time.Time) {
+	t.Helper()
+	now := time.Now()
+	u := db.User{Username: email, Email: email, RoleID: 2, Status: "active", CreatedAt: now, UpdatedAt: now}
+	if err := db.DB.Table(constants.UserTableName).Create(&u).Error; err != nil {
+		t.Fatalf("插入用户失败: %v", err)
+	}
+	sum := sha256.Sum256([]byte(plain))
+	token := &db.PersonalAccessToken{
+		UserID:      u.UserID,
+		Name:        "ci",
+		TokenPrefix: plain[:len(constants.PersonalTokenPrefix)+8],
+		TokenHash:   hex.EncodeToString(sum[:]),
+		Scopes:      scopes,
+		ExpiresAt:   expiresAt,
+		CreatedAt:   now,
+	}
+	if err := db.CreatePersonalAccessToken(context.Background(), token); err != nil {
+		t.Fatalf("写入个人访问令牌失败: %v", err)
+	}
+}
+
+func tokenRequest(plain string) *app.RequestContext {
+	c := app.NewContext(0)
+	c.Request.Header.Set("Authorization", "Bearer "+plain)
+	return c
+}
+
+func assertTokenErr(t *testing.T, err error, code int64) {
+	t.Helper()
+	var errNo errno.ErrNo
+	if !errors.As(err, &errNo) || errNo.ErrorCode != code {
+		t.Fatalf("预期错误码 %d, 实际错误为 %v", code, err)
+	}
+}
+
+func TestCheckTokenScope(t *testing.T) {
+	cleanup := setupTokenDB(t)
+	defer cleanup()
+
+	plain := constants.PersonalTokenPrefix + "scope00000000000"
+	seedTokenUser(t, "scope@example.com", plain, "resource.upload", nil)
+
+	if err := checkToken(context.Background(), tokenRequest(plain), "resource.upload"); err != nil {
+		t.Fatalf("授权范围内的请求应当通过: %v", err)
+	}
+	err := checkToken(context.Background(), tokenRequest(plain), "course.rate")
+	assertTokenErr(t, err, errno.PersonalTokenScopeDeniedCode)
+
+	c := tokenRequest(plain)
+	TokenAuth("course.rate")(context.Background(), c)
+	if !c.IsAborted() {
+		t.Fatal("授权范围外的请求应当被中止")
+	}
+}
+
+func TestCheckTokenExpiredOrUnknown(t *testing.T) {
+	cleanup := setupTokenDB(t)
+	defer cleanup()
+
+	past := time.Now().Add(-time.Minute)
+	expired := constants.PersonalTokenPrefix + "expired000000000"
+	seedTokenUser(t, "expired@example.com", expired, "resource.upload", &past)
+
+	err := checkToken(context.Background(), tokenRequest(expired), "resource.upload")
+	assertTokenErr(t, err, errno.PersonalTokenExpiredCode)
+	err = checkToken(context.Background(), tokenRequest(constants.PersonalTokenPrefix+"unknown000000000"), "")
+	assertTokenErr(t, err, errno.PersonalTokenInvalidCode)
+}
@@ -0,0 +1,64 @@
+package auth
+
+import (
+	"LearnShare/biz/service"
+	"LearnShare/pkg/errno"
+	"LearnShare/pkg/policy"
+	"context"
+
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// RoutePolicy 按路由策略文件统一完成认证、激活与权限校验，需注册为全局中间件
+// 未匹配到路由的请求（404、跨域预检）直接放行；已注册但缺少策略的路由一律拒绝
+func RoutePolicy(p *policy.Policy) app.HandlerFunc {
+	return func(ctx context.Context, c *app.RequestContext) {
+		fullPath := c.FullPath()
+		if fullPath == "" {
+			c.Next(ctx)
+			return
+		}
+
+		rule := p.Match(string(c.Method()), fullPath)
+		if rule == nil {
+			fail(c, errno.NewErrNo(errno.AuthNoOperatePermissionCode, "路由未配置访问策略"))
+			return
+		}
+		if err := enforceRule(ctx, c, rule); err != nil {
+			fail(c, err)
+			return
+		}
+
+		c.Next(ctx)
+	}
+}
+
+func enforceRule(ctx context.Context, c *app.RequestContext, rule *policy.Rule) error {
+	switch rule.Auth {
+	case policy.AuthNone:
+		return nil
+	case policy.AuthAccess:
+		if err := checkAccessToken(ctx, c); err != nil {
+			return err
+		}
+	case policy.AuthToken:
+		if err := checkToken(ctx, c, rule.TokenScope); err != nil {
+			return err
+		}
+	}
+
+	if rule.Active {
+		if err := service.NewUserService(ctx, c).EnsureActive(); err != nil {
+			return err
+		}
+	}
+	if len(rule.Permissions) > 0 {
+		if err := checkPermissions(ctx, c, rule.Permissions...); err != nil {
+			return err
+		}
+	}
+	if rule.Scoped != nil {
+		return checkScopedPermission(ctx, c, rule.Scoped.Permission, rule.Scoped.Source, rule.Scoped.Param)
+	}
+	return nil
+}
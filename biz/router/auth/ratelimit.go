@@ -0,0 +1,70 @@
+package auth
+
+import (
+	"LearnShare/biz/dal/redis"
+	"LearnShare/config"
+	"LearnShare/pkg/constants"
+	"LearnShare/pkg/errno"
+	"LearnShare/pkg/logger"
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+	"go.uber.org/zap"
+)
+
+// RateLimit 按配置中的同名策略对接口限流，返回 RateLimit-* 标准响应头
+// 策略不存在或限流未启用时直接放行；Redis 异常时放行，避免限流故障影响正常业务
+func RateLimit(policy string) app.HandlerFunc {
+	return func(ctx context.Context, c *app.RequestContext) {
+		cfg := config.RateLimit
+		if cfg == nil || !cfg.Enabled {
+			c.Next(ctx)
+			return
+		}
+		p, ok := cfg.Policies[policy]
+		if !ok || p.Limit <= 0 || p.WindowSeconds <= 0 {
+			c.Next(ctx)
+			return
+		}
+
+		window := time.Duration(p.WindowSeconds) * time.Second
+		res, err := redis.SlidingWindowAllow(ctx, policy, rateLimitSubject(c, p.Key), int64(p.Limit), window)
+		if err != nil {
+			logger.WithFields(zap.String("policy", policy), zap.Error(err)).Error("限流检查失败，已放行")
+			c.Next(ctx)
+			return
+		}
+
+		resetSeconds := strconv.Itoa(int(math.Ceil(res.Reset.Seconds())))
+		c.Header("RateLimit-Limit", strconv.Itoa(p.Limit))
+		c.Header("RateLimit-Remaining", strconv.FormatInt(res.Remaining, 10))
+		c.Header("RateLimit-Reset", resetSeconds)
+
+		if !res.Allowed {
+			c.Header("Retry-After", resetSeconds)
+			fail(c, errno.TooManyRequestsError)
+			c.SetStatusCode(consts.StatusTooManyRequests)
+			return
+		}
+
+		c.Next(ctx)
+	}
+}
+
+// rateLimitSubject 根据策略的限流维度确定计数主体，按用户限流但未登录时回退为按IP
+func rateLimitSubject(c *app.RequestContext, key string) string {
+	switch key {
+	case "route":
+		return "route:" + c.FullPath()
+	case "user":
+		if uid, ok := c.Get(constants.ContextUid); ok {
+			return fmt.Sprintf("user:%v", uid)
+		}
+	}
+	return "ip:" + c.ClientIP()
+}
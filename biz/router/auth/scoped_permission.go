@@ -0,0 +1,38 @@
+package auth
+
+import (
+	"LearnShare/biz/service"
+	"LearnShare/pkg/errno"
+	"context"
+	"strconv"
+
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// RequireScopedPermission 要求用户拥有全局权限，或在路径参数 param 所指对象所属的课程/资源作用域内被授予该权限
+// source 为 constants.ScopeSource* 常量，说明 param 指向的对象类型（需要先经过 Auth 中间件）
+func RequireScopedPermission(permissionName, source, param string) app.HandlerFunc {
+	return func(ctx context.Context, c *app.RequestContext) {
+		if err := checkScopedPermission(ctx, c, permissionName, source, param); err != nil {
+			fail(c, err)
+			return
+		}
+		c.Next(ctx)
+	}
+}
+
+func checkScopedPermission(ctx context.Context, c *app.RequestContext, permissionName, source, param string) error {
+	id, err := strconv.ParseInt(c.Param(param), 10, 64)
+	if err != nil || id <= 0 {
+		return errno.ParamVerifyError.WithMessage("无效的路径参数: " + param)
+	}
+
+	ok, err := service.NewScopedPermissionService(ctx, c).HasPermission(permissionName, source, id)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errno.NewErrNo(errno.AuthNoOperatePermissionCode, "无权限访问")
+	}
+	return nil
+}
@@ -0,0 +1,197 @@
+package auth
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"LearnShare/biz/dal/db"
+	redisDal "LearnShare/biz/dal/redis"
+	"LearnShare/biz/model/user"
+	"LearnShare/biz/service"
+	"LearnShare/pkg/constants"
+	"LearnShare/pkg/errno"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/route/param"
+	goRedis "github.com/redis/go-redis/v9"
+)
+
+// setupScopedDB 在令牌测试数据库上补充权限、作用域授权与课程/资源表
+// 课程 1 下有资源 10（评论 100），课程 2 下有资源 20；普通用户角色没有任何全局权限
+func setupScopedDB(t *testing.T) func() {
+	t.Helper()
+	cleanupDB := setupTokenDB(t)
+	createTableSQL := `
+CREATE TABLE IF NOT EXISTS permissions (
+    permission_id INTEGER PRIMARY KEY AUTOINCREMENT,
+    permission_name TEXT UNIQUE,
+    description TEXT
+);
+CREATE TABLE IF NOT EXISTS role_permissions (
+    role_id INTEGER,
+    permission_id INTEGER,
+    PRIMARY KEY (role_id, permission_id)
+);
+CREATE TABLE IF NOT EXISTS scoped_permission_grants (
+    grant_id INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id INTEGER,
+    permission_id INTEGER,
+    scope_type TEXT,
+    scope_id INTEGER,
+    granted_by INTEGER,
+    created_at DATETIME,
+    UNIQUE (user_id, permission_id, scope_type, scope_id)
+);
+CREATE TABLE IF NOT EXISTS courses (
+    course_id INTEGER PRIMARY KEY,
+    deleted_at DATETIME
+);
+CREATE TABLE IF NOT EXISTS resources (
+    resource_id INTEGER PRIMARY KEY,
+    course_id INTEGER,
+    deleted_at DATETIME
+);
+CREATE TABLE IF NOT EXISTS resource_comments (
+    comment_id INTEGER PRIMARY KEY,
+    resource_id INTEGER,
+    deleted_at DATETIME
+);
+INSERT INTO permissions (permission_id, permission_name) VALUES
+    (1, 'course.owner'), (2, 'course.comment.moderate'), (3, 'resource.comment.moderate');
+INSERT INTO courses (course_id) VALUES (1), (2);
+INSERT INTO resources (resource_id, course_id) VALUES (10, 1), (20, 2);
+INSERT INTO resource_comments (comment_id, resource_id) VALUES (100, 10);
+`
+	if err := db.DB.Exec(createTableSQL).Error; err != nil {
+		t.Fatalf("创建测试数据表失败: %v", err)
+	}
+
+	server, err := miniredis.Run()
+	if err != nil {
+		t.Fatalf("启动 MiniRedis 失败: %v", err)
+	}
+	redisDal.RDB = goRedis.NewClient(&goRedis.Options{Addr: server.Addr()})
+	return func() {
+		_ = redisDal.RDB.Close()
+		server.Close()
+		cleanupDB()
+	}
+}
+
+// seedScopedUser 写入一个普通用户并为其写入作用域授权
+func seedScopedUser(t *testing.T, name string, grants ...db.ScopedPermissionGrant) int64 {
+	t.Helper()
+	now := time.Now()
+	u := db.User{Username: name, Email: name + "@example.com", RoleID: constants.RoleUserID, Status: "active", CreatedAt: now, UpdatedAt: now}
+	if err := db.DB.Table(constants.UserTableName).Create(&u).Error; err != nil {
+		t.Fatalf("插入用户失败: %v", err)
+	}
+	for _, grant := range grants {
+		grant.UserID, grant.CreatedAt = u.UserID, now
+		if err := db.CreateScopedPermissionGrant(context.Background(), &grant); err != nil {
+			t.Fatalf("写入作用域授权失败: %v", err)
+		}
+	}
+	return u.UserID
+}
+
+func scopedRequest(userID int64, key string, id int64, tokenScopes ...string) *app.RequestContext {
+	c := app.NewContext(0)
+	c.Set(constants.ContextUid, userID)
+	c.Set(constants.RoleID, int64(constants.RoleUserID))
+	c.Params = param.Params{{Key: key, Value: strconv.FormatInt(id, 10)}}
+	if tokenScopes != nil {
+		c.Set(constants.ContextTokenScopes, tokenScopes)
+	}
+	return c
+}
+
+func TestRequireScopedPermissionCourseGrant(t *testing.T) {
+	cleanup := setupScopedDB(t)
+	defer cleanup()
+
+	ta := seedScopedUser(t, "ta", db.ScopedPermissionGrant{PermissionID: 3, ScopeType: constants.ScopeTypeCourse, ScopeID: 1})
+	check := RequireScopedPermission("resource.comment.moderate", constants.ScopeSourceResourceComment, "comment_id")
+
+	// 课程 1 的授权覆盖该课程下资源的评论
+	c := scopedRequest(ta, "comment_id", 100)
+	check(context.Background(), c)
+	if c.IsAborted() {
+		t.Fatal("课程作用域内的评论应当允许管理")
+	}
+
+	// 课程 2 下的资源不在授权范围内
+	err := checkScopedPermission(context.Background(), scopedRequest(ta, "resource_id", 20),
+		"resource.comment.moderate", constants.ScopeSourceResource, "resource_id")
+	assertTokenErr(t, err, errno.AuthNoOperatePermissionCode)
+	// 授权只针对指定权限
+	err = checkScopedPermission(context.Background(), scopedRequest(ta, "course_id", 1),
+		"course.comment.moderate", constants.ScopeSourceCourse, "course_id")
+	assertTokenErr(t, err, errno.AuthNoOperatePermissionCode)
+	// 不存在的对象没有作用域
+	err = checkScopedPermission(context.Background(), scopedRequest(ta, "comment_id", 999),
+		"resource.comment.moderate", constants.ScopeSourceResourceComment, "comment_id")
+	assertTokenErr(t, err, errno.AuthNoOperatePermissionCode)
+}
+
+func TestRequireScopedPermissionCourseOwner(t *testing.T) {
+	cleanup := setupScopedDB(t)
+	defer cleanup()
+
+	owner := seedScopedUser(t, "owner", db.ScopedPermissionGrant{
+		PermissionID: 1, ScopeType: constants.ScopeTypeCourse, ScopeID: 1,
+	})
+	ta := seedScopedUser(t, "helper")
+
+	if err := checkScopedPermission(context.Background(), scopedRequest(owner, "course_id", 1),
+		constants.PermissionCourseOwner, constants.ScopeSourceCourse, "course_id"); err != nil {
+		t.Fatalf("课程负责人应当通过本课程的校验: %v", err)
+	}
+	err := checkScopedPermission(context.Background(), scopedRequest(owner, "course_id", 2),
+		constants.PermissionCourseOwner, constants.ScopeSourceCourse, "course_id")
+	assertTokenErr(t, err, errno.AuthNoOperatePermissionCode)
+
+	// 课程负责人可在本课程内授予管理权限，被授权人随即通过作用域校验
+	svc := service.NewScopedPermissionService(context.Background(), scopedRequest(owner, "course_id", 1))
+	if _, err := svc.GrantScopedPermission(&user.GrantScopedPermissionReq{
+		UserID: ta, PermissionName: "course.comment.moderate", ScopeType: constants.ScopeTypeCourse, ScopeID: 1,
+	}); err != nil {
+		t.Fatalf("课程负责人授予本课程权限失败: %v", err)
+	}
+	if err := checkScopedPermission(context.Background(), scopedRequest(ta, "course_id", 1),
+		"course.comment.moderate", constants.ScopeSourceCourse, "course_id"); err != nil {
+		t.Fatalf("被授权用户应当通过校验: %v", err)
+	}
+
+	// 不能授予其他课程的权限，也不能授予课程负责人
+	_, err = svc.GrantScopedPermission(&user.GrantScopedPermissionReq{
+		UserID: ta, PermissionName: "course.comment.moderate", ScopeType: constants.ScopeTypeCourse, ScopeID: 2,
+	})
+	assertTokenErr(t, err, errno.AuthNoOperatePermissionCode)
+	_, err = svc.GrantScopedPermission(&user.GrantScopedPermissionReq{
+		UserID: ta, PermissionName: constants.PermissionCourseOwner, ScopeType: constants.ScopeTypeCourse, ScopeID: 1,
+	})
+	assertTokenErr(t, err, errno.AuthNoOperatePermissionCode)
+}
+
+func TestRequireScopedPermissionTokenScope(t *testing.T) {
+	cleanup := setupScopedDB(t)
+	defer cleanup()
+
+	ta := seedScopedUser(t, "tokenta", db.ScopedPermissionGrant{PermissionID: 2, ScopeType: constants.ScopeTypeCourse, ScopeID: 1})
+
+	if err := checkScopedPermission(context.Background(), scopedRequest(ta, "course_id", 1, "course.comment.moderate"),
+		"course.comment.moderate", constants.ScopeSourceCourse, "course_id"); err != nil {
+		t.Fatalf("令牌范围包含该权限时应当通过: %v", err)
+	}
+
+	// 令牌未包含该权限时，即使有作用域授权也拒绝
+	c := scopedRequest(ta, "course_id", 1, "resource.upload")
+	RequireScopedPermission("course.comment.moderate", constants.ScopeSourceCourse, "course_id")(context.Background(), c)
+	if !c.IsAborted() {
+		t.Fatal("令牌授权范围外的作用域权限应当被拒绝")
+	}
+}
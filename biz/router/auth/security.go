@@ -2,13 +2,14 @@ package auth
 
 import (
 	"LearnShare/biz/dal/redis"
-	"LearnShare/biz/middleware"
+	"LearnShare/biz/service"
 	"LearnShare/config"
 	"LearnShare/pkg/errno"
 	"LearnShare/pkg/logger"
 	"context"
 
 	"github.com/cloudwego/hertz/pkg/app"
+	"go.uber.org/zap"
 )
 
 // EmailRateLimitMiddleware 邮件发送频率限制中间件（同一IP 1分钟间隔）
@@ -33,43 +34,63 @@ func EmailRateLimitMiddleware() app.HandlerFunc {
 	}
 }
 
-// TurnstileMiddleware Turnstile 验证中间件
+// TurnstileMiddleware 人机验证中间件，按配置选择 Turnstile / hCaptcha / reCAPTCHA / 本地图形验证码
 func TurnstileMiddleware() app.HandlerFunc {
 	return func(ctx context.Context, c *app.RequestContext) {
-		// 检查是否启用 Turnstile 验证
-		if !config.Turnstile.Enabled {
+		// 检查是否启用人机验证
+		if config.Captcha == nil || !config.Captcha.Enabled {
 			c.Next(ctx)
 			return
 		}
 
-		// 从请求头中获取 Turnstile token
-		token := c.GetHeader("CF-Turnstile-Token")
-		if len(token) == 0 {
-			// 尝试从表单或 JSON 中获取
-			tokenStr := c.PostForm("cf_turnstile_token")
-			if tokenStr == "" {
-				// 尝试从 JSON body 中获取
-				type TurnstileReq struct {
-					CfTurnstileToken string `json:"cf_turnstile_token"`
-				}
-				var req TurnstileReq
-				_ = c.BindJSON(&req)
-				tokenStr = req.CfTurnstileToken
-			}
-			token = []byte(tokenStr)
+		token := captchaToken(c)
+		if token == "" {
+			fail(c, errno.NewErrNo(errno.TurnstileMissingTokenCode, "缺少人机验证 token"))
+			return
 		}
 
-		if len(token) == 0 {
-			fail(c, errno.NewErrNo(errno.TurnstileMissingTokenCode, "缺少 Turnstile 验证 token"))
+		verifier, err := service.CaptchaVerifier()
+		if err != nil {
+			fail(c, err)
 			return
 		}
 
-		// 验证 Turnstile token
-		if !middleware.VerifyTurnstile(string(token), c.ClientIP()) {
-			fail(c, errno.NewErrNo(errno.TurnstileInvalidTokenCode, "Turnstile 验证失败"))
+		ok, err := verifier.Verify(ctx, token, c.ClientIP())
+		if err != nil {
+			logger.WithFields(zap.String("provider", config.Captcha.Provider), zap.Error(err)).Error("人机验证服务调用失败")
+			fail(c, errno.CaptchaUnavailableError)
+			return
+		}
+		if !ok {
+			fail(c, errno.NewErrNo(errno.TurnstileInvalidTokenCode, "人机验证失败"))
 			return
 		}
 
 		c.Next(ctx)
 	}
 }
+
+// captchaToken 依次从请求头、表单、JSON body 中获取人机验证 token
+func captchaToken(c *app.RequestContext) string {
+	for _, header := range []string{"X-Captcha-Token", "CF-Turnstile-Token"} {
+		if token := c.GetHeader(header); len(token) > 0 {
+			return string(token)
+		}
+	}
+	for _, field := range []string{"captcha_token", "cf_turnstile_token"} {
+		if token := c.PostForm(field); token != "" {
+			return token
+		}
+	}
+
+	type captchaReq struct {
+		CaptchaToken     string `json:"captcha_token"`
+		CfTurnstileToken string `json:"cf_turnstile_token"`
+	}
+	var req captchaReq
+	_ = c.BindJSON(&req)
+	if req.CaptchaToken != "" {
+		return req.CaptchaToken
+	}
+	return req.CfTurnstileToken
+}
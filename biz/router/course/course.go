@@ -24,6 +24,8 @@ func Register(r *server.Hertz) {
 			{
 				_course_comments := _admin.Group("/course_comments", _course_commentsMw()...)
 				_course_comments.DELETE("/:comment_id", append(_admindeletecoursecommentMw(), course.AdminDeleteCourseComment)...)
+				_comment_id0 := _course_comments.Group("/:comment_id", _comment_id0Mw()...)
+				_comment_id0.POST("/restore", append(_adminrestorecoursecommentMw(), course.AdminRestoreCourseComment)...)
 			}
 			{
 				_course_ratings := _admin.Group("/course_ratings", _course_ratingsMw()...)
@@ -32,6 +34,8 @@ func Register(r *server.Hertz) {
 			{
 				_courses := _admin.Group("/courses", _coursesMw()...)
 				_courses.DELETE("/:course_id", append(_admindeletecourseMw(), course.AdminDeleteCourse)...)
+				_course_id0 := _courses.Group("/:course_id", _course_id0Mw()...)
+				_course_id0.POST("/restore", append(_adminrestorecourseMw(), course.AdminRestoreCourse)...)
 			}
 		}
 		{
@@ -40,12 +44,15 @@ func Register(r *server.Hertz) {
 			{
 				_comment_id := _course_comments0.Group("/:comment_id", _comment_idMw()...)
 				_comment_id.POST("/likes", append(_reactcoursecommentMw(), course.ReactCourseComment)...)
+				_comment_id.POST("/report", append(_reportcoursecommentMw(), course.ReportCourseComment)...)
 			}
 		}
 		{
 			_course_ratings0 := _api.Group("/course_ratings", _course_ratings0Mw()...)
 			_course_ratings0.POST("/:course_id", append(_submitcourseratingMw(), course.SubmitCourseRating)...)
 			_course_ratings0.DELETE("/:rating_id", append(_deletecourseratingMw(), course.DeleteCourseRating)...)
+			_rating_id := _course_ratings0.Group("/:rating_id", _rating_idMw()...)
+			_rating_id.POST("/report", append(_reportcourseratingMw(), course.ReportCourseRating)...)
 		}
 		{
 			_courses0 := _api.Group("/courses", _courses0Mw()...)
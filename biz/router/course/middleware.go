@@ -24,14 +24,13 @@ func _course_ratingsMw() []app.HandlerFunc {
 }
 
 func _deletecourseratingMw() []app.HandlerFunc {
-	return []app.HandlerFunc{
-		auth.AccessTokenAuth(),
-	}
+	// your code...
+	return nil
 }
 
 func _submitcourseratingMw() []app.HandlerFunc {
 	return []app.HandlerFunc{
-		auth.AccessTokenAuth(),
+		auth.RateLimit("rating"),
 	}
 }
 
@@ -52,7 +51,7 @@ func _getcoursecommentsMw() []app.HandlerFunc {
 
 func _submitcoursecommentMw() []app.HandlerFunc {
 	return []app.HandlerFunc{
-		auth.AccessTokenAuth(),
+		auth.RateLimit("comment"),
 	}
 }
 
@@ -62,8 +61,10 @@ func _getcourseresourcelistMw() []app.HandlerFunc {
 }
 
 func _searchMw() []app.HandlerFunc {
-	// 搜索功能公开访问，不需要认证
-	return nil
+	// 搜索功能公开访问，不需要认证，仅按IP限流
+	return []app.HandlerFunc{
+		auth.RateLimit("search"),
+	}
 }
 
 func _courses_commentsMw() []app.HandlerFunc {
@@ -72,9 +73,8 @@ func _courses_commentsMw() []app.HandlerFunc {
 }
 
 func _deletecoursecommentMw() []app.HandlerFunc {
-	return []app.HandlerFunc{
-		auth.AccessTokenAuth(),
-	}
+	// your code...
+	return nil
 }
 
 func _course_commentsMw() []app.HandlerFunc {
@@ -128,6 +128,44 @@ func _comment_idMw() []app.HandlerFunc {
 }
 
 func _reactcoursecommentMw() []app.HandlerFunc {
+	return []app.HandlerFunc{
+		auth.RateLimit("reaction"),
+	}
+}
+
+func _reportcoursecommentMw() []app.HandlerFunc {
+	return []app.HandlerFunc{
+		auth.RateLimit("report"),
+	}
+}
+
+func _rating_idMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _reportcourseratingMw() []app.HandlerFunc {
+	return []app.HandlerFunc{
+		auth.RateLimit("report"),
+	}
+}
+
+func _comment_id0Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _adminrestorecoursecommentMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _course_id0Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _adminrestorecourseMw() []app.HandlerFunc {
 	// your code...
 	return nil
 }
@@ -0,0 +1,27 @@
+// Code generated by hertz generator.
+
+package leaderboard
+
+import (
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+func rootMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _apiMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _leaderboardsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getleaderboardMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
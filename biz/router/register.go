@@ -6,9 +6,11 @@ import (
 	audit "LearnShare/biz/router/audit"
 	course "LearnShare/biz/router/course"
 	favorite "LearnShare/biz/router/favorite"
+	leaderboard "LearnShare/biz/router/leaderboard"
 	module "LearnShare/biz/router/module"
 	resource "LearnShare/biz/router/resource"
 	school_struct "LearnShare/biz/router/school_struct"
+	shop "LearnShare/biz/router/shop"
 	user "LearnShare/biz/router/user"
 	"github.com/cloudwego/hertz/pkg/app/server"
 )
@@ -16,6 +18,10 @@ import (
 // GeneratedRegister registers routers generated by IDL.
 func GeneratedRegister(r *server.Hertz) {
 	//INSERT_POINT: DO NOT DELETE THIS LINE!
+	leaderboard.Register(r)
+
+	shop.Register(r)
+
 	favorite.Register(r)
 
 	audit.Register(r)
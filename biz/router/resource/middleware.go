@@ -24,9 +24,8 @@ func _resourcesMw() []app.HandlerFunc {
 }
 
 func _uploadresourceMw() []app.HandlerFunc {
-	return []app.HandlerFunc{
-		auth.AccessTokenAuth(),
-	}
+	// your code...
+	return nil
 }
 
 func _getresourceMw() []app.HandlerFunc {
@@ -35,15 +34,13 @@ func _getresourceMw() []app.HandlerFunc {
 }
 
 func _downloadresourceMw() []app.HandlerFunc {
-	return []app.HandlerFunc{
-		auth.AccessTokenAuth(),
-	}
+	// your code...
+	return nil
 }
 
 func _reportresourceMw() []app.HandlerFunc {
-	// your code...
 	return []app.HandlerFunc{
-		auth.AccessTokenAuth(),
+		auth.RateLimit("report"),
 	}
 }
 
@@ -53,8 +50,10 @@ func _resources0Mw() []app.HandlerFunc {
 }
 
 func _searchresourcesMw() []app.HandlerFunc {
-	// your code...
-	return nil
+	// 搜索公开访问，仅按IP限流
+	return []app.HandlerFunc{
+		auth.RateLimit("search"),
+	}
 }
 
 func _resourceMw() []app.HandlerFunc {
@@ -73,9 +72,8 @@ func _resource_commentsMw() []app.HandlerFunc {
 }
 
 func _submitresourcecommentMw() []app.HandlerFunc {
-	// your code...
 	return []app.HandlerFunc{
-		auth.AccessTokenAuth(),
+		auth.RateLimit("comment"),
 	}
 }
 
@@ -86,15 +84,12 @@ func _resource_ratingsMw() []app.HandlerFunc {
 
 func _deleteresourceratingMw() []app.HandlerFunc {
 	// your code...
-	return []app.HandlerFunc{
-		auth.AccessTokenAuth(),
-	}
+	return nil
 }
 
 func _submitresourceratingMw() []app.HandlerFunc {
-	// your code...
 	return []app.HandlerFunc{
-		auth.AccessTokenAuth(),
+		auth.RateLimit("rating"),
 	}
 }
 
@@ -105,9 +100,7 @@ func _resources_commentsMw() []app.HandlerFunc {
 
 func _deleteresourcecommentMw() []app.HandlerFunc {
 	// your code...
-	return []app.HandlerFunc{
-		auth.AccessTokenAuth(),
-	}
+	return nil
 }
 
 func _reportMw() []app.HandlerFunc {
@@ -131,27 +124,23 @@ func _resource_id0Mw() []app.HandlerFunc {
 }
 
 func _adminMw() []app.HandlerFunc {
-	return []app.HandlerFunc{
-		auth.AccessTokenAuth(),
-	}
+	// your code...
+	return nil
 }
 
 func _admindeleteresourcecommentMw() []app.HandlerFunc {
-	return []app.HandlerFunc{
-		auth.RequirePermission("resource.comment.moderate"),
-	}
+	// your code...
+	return nil
 }
 
 func _admindeleteresourceratingMw() []app.HandlerFunc {
-	return []app.HandlerFunc{
-		auth.RequirePermission("resource.rating.moderate"),
-	}
+	// your code...
+	return nil
 }
 
 func _admindeleteresourceMw() []app.HandlerFunc {
-	return []app.HandlerFunc{
-		auth.RequirePermission("resource.manage_all"),
-	}
+	// your code...
+	return nil
 }
 
 func _resource_comments0Mw() []app.HandlerFunc {
@@ -171,6 +160,43 @@ func _comment_idMw() []app.HandlerFunc {
 
 func _reactresourcecommentMw() []app.HandlerFunc {
 	return []app.HandlerFunc{
-		auth.AccessTokenAuth(),
+		auth.RateLimit("reaction"),
+	}
+}
+
+func _reportresourcecommentMw() []app.HandlerFunc {
+	return []app.HandlerFunc{
+		auth.RateLimit("report"),
+	}
+}
+
+func _rating_idMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _reportresourceratingMw() []app.HandlerFunc {
+	return []app.HandlerFunc{
+		auth.RateLimit("report"),
 	}
 }
+
+func _comment_id0Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _adminrestoreresourcecommentMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _resource_id1Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _adminrestoreresourceMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
@@ -32,6 +32,8 @@ func Register(r *server.Hertz) {
 			{
 				_resource_comments := _admin.Group("/resource_comments", _resource_commentsMw()...)
 				_resource_comments.DELETE("/:comment_id", append(_admindeleteresourcecommentMw(), resource.AdminDeleteResourceComment)...)
+				_comment_id0 := _resource_comments.Group("/:comment_id", _comment_id0Mw()...)
+				_comment_id0.POST("/restore", append(_adminrestoreresourcecommentMw(), resource.AdminRestoreResourceComment)...)
 			}
 			{
 				_resource_ratings := _admin.Group("/resource_ratings", _resource_ratingsMw()...)
@@ -40,6 +42,8 @@ func Register(r *server.Hertz) {
 			{
 				_resources0 := _admin.Group("/resources", _resources0Mw()...)
 				_resources0.DELETE("/:resource_id", append(_admindeleteresourceMw(), resource.AdminDeleteResource)...)
+				_resource_id1 := _resources0.Group("/:resource_id", _resource_id1Mw()...)
+				_resource_id1.POST("/restore", append(_adminrestoreresourceMw(), resource.AdminRestoreResource)...)
 			}
 		}
 		{
@@ -54,11 +58,14 @@ func Register(r *server.Hertz) {
 			_resource_comments0.DELETE("/:comment_id", append(_deleteresourcecommentMw(), resource.DeleteResourceComment)...)
 			_comment_id := _resource_comments0.Group("/:comment_id", _comment_idMw()...)
 			_comment_id.POST("/likes", append(_reactresourcecommentMw(), resource.ReactResourceComment)...)
+			_comment_id.POST("/report", append(_reportresourcecommentMw(), resource.ReportResourceComment)...)
 			_resource_comments0.POST("/:resource_id", append(_submitresourcecommentMw(), resource.SubmitResourceComment)...)
 		}
 		{
 			_resource_ratings0 := _api.Group("/resource_ratings", _resource_ratings0Mw()...)
 			_resource_ratings0.DELETE("/:rating_id", append(_deleteresourceratingMw(), resource.DeleteResourceRating)...)
+			_rating_id := _resource_ratings0.Group("/:rating_id", _rating_idMw()...)
+			_rating_id.POST("/report", append(_reportresourceratingMw(), resource.ReportResourceRating)...)
 			_resource_ratings0.POST("/:resource_id", append(_submitresourceratingMw(), resource.SubmitResourceRating)...)
 		}
 		{
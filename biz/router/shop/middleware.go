@@ -0,0 +1,102 @@
+// Code generated by hertz generator.
+
+package shop
+
+import (
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+func rootMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _apiMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _adminMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _admincreateitemMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _itemsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _admindeleteitemMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _adminupdateitemMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _shopMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listitemsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _items0Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _item_idMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _purchaseitemMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _usersMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _meMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listmyitemsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _items1Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _item_id0Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _equipitemMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _unequipitemMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
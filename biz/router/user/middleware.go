@@ -24,7 +24,7 @@ func _authMw() []app.HandlerFunc {
 }
 
 func _logininMw() []app.HandlerFunc {
-	// 登录接口添加 Turnstile 验证
+	// 登录接口添加人机验证
 	return []app.HandlerFunc{
 		auth.TurnstileMiddleware(),
 	}
@@ -32,9 +32,7 @@ func _logininMw() []app.HandlerFunc {
 
 func _loginoutMw() []app.HandlerFunc {
 	// your code...
-	return []app.HandlerFunc{
-		auth.AccessTokenAuth(),
-	}
+	return nil
 }
 
 func _refreshtokenMw() []app.HandlerFunc {
@@ -54,9 +52,7 @@ func _usersMw() []app.HandlerFunc {
 
 func _uploadavatarMw() []app.HandlerFunc {
 	// your code...
-	return []app.HandlerFunc{
-		auth.AccessTokenAuth(),
-	}
+	return nil
 }
 
 func _getuserinfoMw() []app.HandlerFunc {
@@ -70,16 +66,13 @@ func _meMw() []app.HandlerFunc {
 }
 
 func _updateemailMw() []app.HandlerFunc {
-	return []app.HandlerFunc{
-		auth.AccessTokenAuth(),
-	}
+	// your code...
+	return nil
 }
 
 func _updatemajorMw() []app.HandlerFunc {
 	// your code...
-	return []app.HandlerFunc{
-		auth.AccessTokenAuth(),
-	}
+	return nil
 }
 
 func _passwordMw() []app.HandlerFunc {
@@ -89,9 +82,7 @@ func _passwordMw() []app.HandlerFunc {
 
 func _updatepasswordMw() []app.HandlerFunc {
 	// your code...
-	return []app.HandlerFunc{
-		auth.AccessTokenAuth(),
-	}
+	return nil
 }
 
 func _resetpasswordMw() []app.HandlerFunc {
@@ -105,7 +96,7 @@ func _emailMw() []app.HandlerFunc {
 }
 
 func _sendverifyemailMw() []app.HandlerFunc {
-	// 发送验证邮件接口添加 Turnstile 验证和频率限制
+	// 发送验证邮件接口添加人机验证和频率限制
 	return []app.HandlerFunc{
 		auth.TurnstileMiddleware(),
 		auth.EmailRateLimitMiddleware(),
@@ -114,39 +105,229 @@ func _sendverifyemailMw() []app.HandlerFunc {
 
 func _verifyemailMw() []app.HandlerFunc {
 	// your code...
-	return []app.HandlerFunc{
-		auth.AccessTokenAuth(),
-	}
+	return nil
 }
 
 func _adminMw() []app.HandlerFunc {
-	// 管理员路由组，需要认证
-	return []app.HandlerFunc{
-		auth.AccessTokenAuth(),
-	}
+	// your code...
+	return nil
 }
 
 func _getpermissionlistMw() []app.HandlerFunc {
-	// 获取权限列表，需要 role.manage 权限
-	return []app.HandlerFunc{auth.RequirePermission("role.manage")}
+	// your code...
+	return nil
 }
 
 func _getrolelistMw() []app.HandlerFunc {
-	// 获取角色列表，需要 role.manage 权限
-	return []app.HandlerFunc{auth.RequirePermission("role.manage")}
+	// your code...
+	return nil
 }
 
 func _addroleMw() []app.HandlerFunc {
-	// 添加角色，需要 role.manage 权限
-	return []app.HandlerFunc{auth.RequirePermission("role.manage")}
+	// your code...
+	return nil
 }
 
 func _adminadduserMw() []app.HandlerFunc {
-	// 管理员添加用户，需要 user.account.manage 权限
-	return []app.HandlerFunc{auth.RequirePermission("user.account.manage")}
+	// your code...
+	return nil
 }
 
 func _adminupdateuserMw() []app.HandlerFunc {
-	// 管理员更新用户，需要 user.account.manage 权限
-	return []app.HandlerFunc{auth.RequirePermission("user.account.manage")}
+	// your code...
+	return nil
+}
+
+func _oidcMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _oidcauthorizeMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _oidccallbackMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _unlockaccountMw() []app.HandlerFunc {
+	// 解锁接口添加人机验证
+	return []app.HandlerFunc{
+		auth.TurnstileMiddleware(),
+	}
+}
+
+func _activateaccountMw() []app.HandlerFunc {
+	// 激活接口添加人机验证
+	return []app.HandlerFunc{
+		auth.TurnstileMiddleware(),
+	}
+}
+
+func _activateMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _resendactivationMw() []app.HandlerFunc {
+	// 重发激活邮件接口添加人机验证和频率限制
+	return []app.HandlerFunc{
+		auth.TurnstileMiddleware(),
+		auth.EmailRateLimitMiddleware(),
+	}
+}
+
+func _getcaptchaMw() []app.HandlerFunc {
+	// 获取图形验证码需要频率限制
+	return []app.HandlerFunc{
+		auth.RateLimit("captcha"),
+	}
+}
+
+func _email0Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _revertemailchangeMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listpersonaltokensMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _createpersonaltokenMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _tokensMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _revokepersonaltokenMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _rolesMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _deleteroleMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _updateroleMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _role_idMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _revokerolepermissionsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _grantrolepermissionsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getroleusersMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listscopedpermissionsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _grantscopedpermissionMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _scoped_permissionsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _revokescopedpermissionMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listmymoderationactionsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listnotificationsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _notificationsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _marknotificationsreadMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _moderation_actionsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _action_idMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _appealmoderationactionMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _users0Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _user_idMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _adjustuserreputationMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listmyreputationrecordsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listmyachievementsMw() []app.HandlerFunc {
+	// your code...
+	return nil
 }
@@ -1,11 +1,37 @@
 package user
 
 import (
+	"strings"
 	"testing"
 
+	"LearnShare/pkg/policy"
+
 	"github.com/cloudwego/hertz/pkg/app"
 )
 
+const routePolicyPath = "../../../config/route_policy.yaml"
+
+func loadRoutePolicy(t *testing.T) *policy.Policy {
+	t.Helper()
+	p, err := policy.Load(routePolicyPath)
+	if err != nil {
+		t.Fatalf("加载路由策略失败: %v", err)
+	}
+	return p
+}
+
+// requireAuthPolicy 校验路由策略要求登录
+func requireAuthPolicy(t *testing.T, method, path string) {
+	t.Helper()
+	rule := loadRoutePolicy(t).Match(method, path)
+	if rule == nil {
+		t.Fatalf("%s %s: 缺少路由策略", method, path)
+	}
+	if rule.Auth == policy.AuthNone {
+		t.Fatalf("%s %s: 预期需要认证，实际策略为 %s", method, path, rule.Auth)
+	}
+}
+
 // TestRootMw 测试根路径中间件
 func TestRootMw(t *testing.T) {
 	mws := rootMw()
@@ -38,21 +64,12 @@ func TestLogininMw(t *testing.T) {
 	}
 }
 
-// TestLoginoutMw 测试登出中间件（需要认证）
+// TestLoginoutMw 测试登出中间件（认证由路由策略统一校验）
 func TestLoginoutMw(t *testing.T) {
-	mws := _loginoutMw()
-	if mws == nil {
-		t.Fatalf("预期_loginoutMw返回认证中间件，实际返回nil")
-	}
-	if len(mws) == 0 {
-		t.Fatalf("预期_loginoutMw返回至少一个中间件，实际返回0个")
-	}
-	// 验证返回的是HandlerFunc类型
-	for i, mw := range mws {
-		if mw == nil {
-			t.Fatalf("预期第%d个中间件不为nil，实际为nil", i)
-		}
+	if mws := _loginoutMw(); mws != nil {
+		t.Fatalf("预期_loginoutMw返回nil，实际返回 %v", mws)
 	}
+	requireAuthPolicy(t, "POST", "/api/auth/logout")
 }
 
 // TestRefreshtokenMw 测试刷新令牌中间件
@@ -79,15 +96,12 @@ func TestUsersMw(t *testing.T) {
 	}
 }
 
-// TestUploadavatarMw 测试上传头像中间件（需要认证）
+// TestUploadavatarMw 测试上传头像中间件（认证由路由策略统一校验）
 func TestUploadavatarMw(t *testing.T) {
-	mws := _uploadavatarMw()
-	if mws == nil {
-		t.Fatalf("预期_uploadavatarMw返回认证中间件，实际返回nil")
-	}
-	if len(mws) == 0 {
-		t.Fatalf("预期_uploadavatarMw返回至少一个中间件，实际返回0个")
+	if mws := _uploadavatarMw(); mws != nil {
+		t.Fatalf("预期_uploadavatarMw返回nil，实际返回 %v", mws)
 	}
+	requireAuthPolicy(t, "PUT", "/api/users/avatar")
 }
 
 // TestGetuserinfoMw 测试获取用户信息中间件
@@ -106,26 +120,20 @@ func TestMeMw(t *testing.T) {
 	}
 }
 
-// TestUpdateemailMw 测试更新邮箱中间件（需要认证）
+// TestUpdateemailMw 测试更新邮箱中间件（认证由路由策略统一校验）
 func TestUpdateemailMw(t *testing.T) {
-	mws := _updateemailMw()
-	if mws == nil {
-		t.Fatalf("预期_updateemailMw返回认证中间件，实际返回nil")
-	}
-	if len(mws) == 0 {
-		t.Fatalf("预期_updateemailMw返回至少一个中间件，实际返回0个")
+	if mws := _updateemailMw(); mws != nil {
+		t.Fatalf("预期_updateemailMw返回nil，实际返回 %v", mws)
 	}
+	requireAuthPolicy(t, "PUT", "/api/users/me/email")
 }
 
-// TestUpdatemajorMw 测试更新专业中间件（需要认证）
+// TestUpdatemajorMw 测试更新专业中间件（认证由路由策略统一校验）
 func TestUpdatemajorMw(t *testing.T) {
-	mws := _updatemajorMw()
-	if mws == nil {
-		t.Fatalf("预期_updatemajorMw返回认证中间件，实际返回nil")
-	}
-	if len(mws) == 0 {
-		t.Fatalf("预期_updatemajorMw返回至少一个中间件，实际返回0个")
+	if mws := _updatemajorMw(); mws != nil {
+		t.Fatalf("预期_updatemajorMw返回nil，实际返回 %v", mws)
 	}
+	requireAuthPolicy(t, "PUT", "/api/users/me/major")
 }
 
 // TestPasswordMw 测试password路径中间件
@@ -136,15 +144,12 @@ func TestPasswordMw(t *testing.T) {
 	}
 }
 
-// TestUpdatepasswordMw 测试更新密码中间件（需要认证）
+// TestUpdatepasswordMw 测试更新密码中间件（认证由路由策略统一校验）
 func TestUpdatepasswordMw(t *testing.T) {
-	mws := _updatepasswordMw()
-	if mws == nil {
-		t.Fatalf("预期_updatepasswordMw返回认证中间件，实际返回nil")
-	}
-	if len(mws) == 0 {
-		t.Fatalf("预期_updatepasswordMw返回至少一个中间件，实际返回0个")
+	if mws := _updatepasswordMw(); mws != nil {
+		t.Fatalf("预期_updatepasswordMw返回nil，实际返回 %v", mws)
 	}
+	requireAuthPolicy(t, "PUT", "/api/users/me/password")
 }
 
 // TestResetpasswordMw 测试重置密码中间件
@@ -171,55 +176,31 @@ func TestSendverifyemailMw(t *testing.T) {
 	}
 }
 
-// TestVerifyemailMw 测试验证邮箱中间件（需要认证）
+// TestVerifyemailMw 测试验证邮箱中间件（认证由路由策略统一校验）
 func TestVerifyemailMw(t *testing.T) {
-	mws := _verifyemailMw()
-	if mws == nil {
-		t.Fatalf("预期_verifyemailMw返回认证中间件，实际返回nil")
-	}
-	if len(mws) == 0 {
-		t.Fatalf("预期_verifyemailMw返回至少一个中间件，实际返回0个")
+	if mws := _verifyemailMw(); mws != nil {
+		t.Fatalf("预期_verifyemailMw返回nil，实际返回 %v", mws)
 	}
+	requireAuthPolicy(t, "POST", "/api/users/me/email/verify")
 }
 
 // TestMiddlewareExecution 测试中间件执行
 func TestMiddlewareExecution(t *testing.T) {
-	t.Run("测试需要认证的中间件可以执行", func(t *testing.T) {
-		// 获取需要认证的中间件
-		authMiddlewares := []struct {
-			name string
-			mws  []app.HandlerFunc
+	t.Run("测试需要认证的路由在策略中声明了认证", func(t *testing.T) {
+		authRoutes := []struct {
+			method string
+			path   string
 		}{
-			{"_loginoutMw", _loginoutMw()},
-			{"_uploadavatarMw", _uploadavatarMw()},
-			{"_updateemailMw", _updateemailMw()},
-			{"_updatemajorMw", _updatemajorMw()},
-			{"_updatepasswordMw", _updatepasswordMw()},
-			{"_verifyemailMw", _verifyemailMw()},
+			{"POST", "/api/auth/logout"},
+			{"PUT", "/api/users/avatar"},
+			{"PUT", "/api/users/me/email"},
+			{"PUT", "/api/users/me/major"},
+			{"PUT", "/api/users/me/password"},
+			{"POST", "/api/users/me/email/verify"},
 		}
 
-		for _, am := range authMiddlewares {
-			if am.mws == nil || len(am.mws) == 0 {
-				t.Fatalf("%s: 预期返回认证中间件，实际返回空", am.name)
-			}
-
-			// 验证每个中间件都可以被调用
-			for i, mw := range am.mws {
-				if mw == nil {
-					t.Fatalf("%s: 第%d个中间件为nil", am.name, i)
-				}
-				// 中间件函数应该可以正常调用（不测试具体行为，只测试不会panic）
-				func() {
-					defer func() {
-						if r := recover(); r != nil {
-							// 预期可能会有panic（因为没有完整的请求上下文），这是正常的
-							// 我们只是验证中间件函数本身存在且可以被调用
-						}
-					}()
-					// 注意：这里不实际执行中间件，因为需要完整的Hertz上下文
-					// 只验证中间件函数不为nil即可
-				}()
-			}
+		for _, route := range authRoutes {
+			requireAuthPolicy(t, route.method, route.path)
 		}
 	})
 
@@ -251,21 +232,22 @@ func TestMiddlewareExecution(t *testing.T) {
 	})
 }
 
-// TestMiddlewareCount 测试中间件数量
+// TestMiddlewareCount 测试管理员路由在策略中声明了所需权限
 func TestMiddlewareCount(t *testing.T) {
-	// 测试需要认证的中间件至少有一个handler
-	authMiddlewares := map[string][]app.HandlerFunc{
-		"_loginoutMw":       _loginoutMw(),
-		"_uploadavatarMw":   _uploadavatarMw(),
-		"_updateemailMw":    _updateemailMw(),
-		"_updatemajorMw":    _updatemajorMw(),
-		"_updatepasswordMw": _updatepasswordMw(),
-		"_verifyemailMw":    _verifyemailMw(),
+	p := loadRoutePolicy(t)
+	adminRoutes := map[string]string{
+		"GET /api/admin/roles":       "role.manage",
+		"POST /api/admin/roles":      "role.manage",
+		"POST /api/admin/users":      "user.account.manage",
+		"PUT /api/admin/users":       "user.account.manage",
+		"GET /api/admin/permissions": "role.manage",
 	}
 
-	for name, mws := range authMiddlewares {
-		if len(mws) < 1 {
-			t.Errorf("%s: 预期至少有1个中间件，实际有 %d 个", name, len(mws))
+	for key, permission := range adminRoutes {
+		method, path, _ := strings.Cut(key, " ")
+		rule := p.Match(method, path)
+		if rule == nil || len(rule.Permissions) == 0 || rule.Permissions[0] != permission {
+			t.Errorf("%s: 预期需要 %s 权限，实际策略为 %+v", key, permission, rule)
 		}
 	}
 }
@@ -24,32 +24,81 @@ func Register(r *server.Hertz) {
 			_admin.GET("/permissions", append(_getpermissionlistMw(), user.GetPermissionList)...)
 			_admin.GET("/roles", append(_getrolelistMw(), user.GetRoleList)...)
 			_admin.POST("/roles", append(_addroleMw(), user.AddRole)...)
+			_roles := _admin.Group("/roles", _rolesMw()...)
+			_roles.DELETE("/:role_id", append(_deleteroleMw(), user.DeleteRole)...)
+			_roles.PUT("/:role_id", append(_updateroleMw(), user.UpdateRole)...)
+			{
+				_role_id := _roles.Group("/:role_id", _role_idMw()...)
+				_role_id.DELETE("/permissions", append(_revokerolepermissionsMw(), user.RevokeRolePermissions)...)
+				_role_id.POST("/permissions", append(_grantrolepermissionsMw(), user.GrantRolePermissions)...)
+				_role_id.GET("/users", append(_getroleusersMw(), user.GetRoleUsers)...)
+			}
 			_admin.POST("/users", append(_adminadduserMw(), user.AdminAddUser)...)
 			_admin.PUT("/users", append(_adminupdateuserMw(), user.AdminUpdateUser)...)
+			_users0 := _admin.Group("/users", _users0Mw()...)
+			{
+				_user_id := _users0.Group("/:user_id", _user_idMw()...)
+				_user_id.POST("/reputation", append(_adjustuserreputationMw(), user.AdjustUserReputation)...)
+			}
 		}
 		{
 			_auth := _api.Group("/auth", _authMw()...)
+			_auth.POST("/activate", append(_activateaccountMw(), user.ActivateAccount)...)
+			_activate := _auth.Group("/activate", _activateMw()...)
+			_activate.POST("/resend", append(_resendactivationMw(), user.ResendActivation)...)
+			_auth.GET("/captcha", append(_getcaptchaMw(), user.GetCaptcha)...)
+			{
+				_email0 := _auth.Group("/email", _email0Mw()...)
+				_email0.POST("/revert", append(_revertemailchangeMw(), user.RevertEmailChange)...)
+			}
 			_auth.POST("/login", append(_logininMw(), user.LoginIn)...)
 			_auth.POST("/logout", append(_loginoutMw(), user.LoginOut)...)
 			_auth.POST("/refresh", append(_refreshtokenMw(), user.RefreshToken)...)
 			_auth.POST("/register", append(_registerMw(), user.Register)...)
+			_auth.POST("/unlock", append(_unlockaccountMw(), user.UnlockAccount)...)
+			{
+				_oidc := _auth.Group("/oidc", _oidcMw()...)
+				_oidc.GET("/authorize", append(_oidcauthorizeMw(), user.OidcAuthorize)...)
+				_oidc.POST("/callback", append(_oidccallbackMw(), user.OidcCallback)...)
+			}
 		}
+		_api.GET("/scoped_permissions", append(_listscopedpermissionsMw(), user.ListScopedPermissions)...)
+		_api.POST("/scoped_permissions", append(_grantscopedpermissionMw(), user.GrantScopedPermission)...)
+		_scoped_permissions := _api.Group("/scoped_permissions", _scoped_permissionsMw()...)
+		_scoped_permissions.DELETE("/:grant_id", append(_revokescopedpermissionMw(), user.RevokeScopedPermission)...)
 		{
 			_users := _api.Group("/users", _usersMw()...)
 			_users.PUT("/avatar", append(_uploadavatarMw(), user.UploadAvatar)...)
 			_users.GET("/:user_id", append(_getuserinfoMw(), user.GetUserInfo)...)
 			{
 				_me := _users.Group("/me", _meMw()...)
+				_me.GET("/achievements", append(_listmyachievementsMw(), user.ListMyAchievements)...)
 				_me.PUT("/email", append(_updateemailMw(), user.UpdateEmail)...)
 				_me.PUT("/major", append(_updatemajorMw(), user.UpdateMajor)...)
+				_me.GET("/moderation_actions", append(_listmymoderationactionsMw(), user.ListMyModerationActions)...)
+				_me.GET("/notifications", append(_listnotificationsMw(), user.ListNotifications)...)
+				_notifications := _me.Group("/notifications", _notificationsMw()...)
+				_notifications.POST("/read", append(_marknotificationsreadMw(), user.MarkNotificationsRead)...)
 				_me.PUT("/password", append(_updatepasswordMw(), user.UpdatePassword)...)
 				_password := _me.Group("/password", _passwordMw()...)
 				_password.POST("/reset", append(_resetpasswordMw(), user.ResetPassword)...)
+				_me.GET("/reputation", append(_listmyreputationrecordsMw(), user.ListMyReputationRecords)...)
+				_me.GET("/tokens", append(_listpersonaltokensMw(), user.ListPersonalTokens)...)
+				_me.POST("/tokens", append(_createpersonaltokenMw(), user.CreatePersonalToken)...)
+				_tokens := _me.Group("/tokens", _tokensMw()...)
+				_tokens.DELETE("/:token_id", append(_revokepersonaltokenMw(), user.RevokePersonalToken)...)
 				{
 					_email := _me.Group("/email", _emailMw()...)
 					_email.POST("/get", append(_sendverifyemailMw(), user.SendVerifyEmail)...)
 					_email.POST("/verify", append(_verifyemailMw(), user.VerifyEmail)...)
 				}
+				{
+					_moderation_actions := _me.Group("/moderation_actions", _moderation_actionsMw()...)
+					{
+						_action_id := _moderation_actions.Group("/:action_id", _action_idMw()...)
+						_action_id.POST("/appeal", append(_appealmoderationactionMw(), user.AppealModerationAction)...)
+					}
+				}
 			}
 		}
 	}
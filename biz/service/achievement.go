@@ -0,0 +1,64 @@
+package service
+
+import (
+	"LearnShare/biz/dal/db"
+	model "LearnShare/biz/model/module"
+	"LearnShare/biz/model/user"
+	"context"
+
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// AchievementService 用户成就
+type AchievementService struct {
+	ctx context.Context
+	c   *app.RequestContext
+}
+
+func NewAchievementService(ctx context.Context, c *app.RequestContext) *AchievementService {
+	return &AchievementService{ctx: ctx, c: c}
+}
+
+// ListMyAchievements 查询当前用户的全部成就及进度，查询前先即时检查并发放新达成的成就
+func (s *AchievementService) ListMyAchievements(_ *user.ListMyAchievementsReq) ([]*model.Achievement, error) {
+	uid := GetUidFormContext(s.c)
+	achievements, err := db.ListAchievements(s.ctx)
+	if err != nil {
+		return nil, err
+	}
+	values, _, err := db.EvaluateAchievements(s.ctx, achievements, []int64{uid})
+	if err != nil {
+		return nil, err
+	}
+	earned, err := db.GetUserAchievements(s.ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	achievedAt := make(map[string]int64, len(earned))
+	for _, e := range earned {
+		achievedAt[e.AchievementCode] = e.AchievedAt.Unix()
+	}
+
+	list := make([]*model.Achievement, 0, len(achievements))
+	for _, a := range achievements {
+		item := &model.Achievement{
+			Code:        a.Code,
+			Name:        a.Name,
+			Description: a.Description,
+			Metric:      a.Metric,
+			Threshold:   a.Threshold,
+			Progress:    min(values[a.Metric][uid], a.Threshold),
+		}
+		if at, ok := achievedAt[a.Code]; ok {
+			// 达成后指标回落（如资源被删除）不影响已获得的成就
+			item.Achieved = true
+			item.AchievedAt = &at
+			item.Progress = a.Threshold
+		}
+		if a.Medal != nil {
+			item.Medal = a.Medal.ToItemModule()
+		}
+		list = append(list, item)
+	}
+	return list, nil
+}
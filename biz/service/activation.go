@@ -0,0 +1,97 @@
+package service
+
+import (
+	"LearnShare/biz/dal/db"
+	"LearnShare/biz/dal/redis"
+	"LearnShare/biz/model/user"
+	"LearnShare/pkg/errno"
+	"LearnShare/pkg/logger"
+	"LearnShare/pkg/utils"
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// deliverActivationCode 生成激活验证码、写入缓存并发送到注册邮箱，依赖验证码与 SMTP 配置，测试中可替换
+var deliverActivationCode = func(ctx context.Context, email string) error {
+	code, err := utils.GenerateCode()
+	if err != nil {
+		return err
+	}
+	if err := redis.PutCodeToCache(ctx, email, code); err != nil {
+		return err
+	}
+	return utils.MailSendCode(email, code)
+}
+
+// sendActivationCode 生成激活验证码并发送到注册邮箱
+func (s *UserService) sendActivationCode(email string) error {
+	return deliverActivationCode(s.ctx, email)
+}
+
+// ActivateAccount 通过注册邮箱收到的验证码激活账户
+func (s *UserService) ActivateAccount(req *user.ActivateAccountReq) error {
+	if !redis.IsKeyExist(s.ctx, req.Email) {
+		return errno.UserVerificationCodeExpiredError
+	}
+
+	storeCode, err := redis.GetCodeCache(s.ctx, req.Email)
+	if err != nil {
+		return err
+	}
+	if storeCode != req.Code {
+		return errno.UserVerificationCodeInvalidError
+	}
+
+	userInfo, err := db.GetUserByEmail(s.ctx, req.Email)
+	if err != nil {
+		return err
+	}
+	if userInfo.Status != "inactive" {
+		return nil
+	}
+
+	if err := db.ActivateUser(s.ctx, userInfo.UserID); err != nil {
+		return err
+	}
+	if err := redis.DeleteCodeCache(s.ctx, req.Email); err != nil {
+		return err
+	}
+
+	logger.WithFields(
+		zap.Int64("user_id", userInfo.UserID),
+		zap.String("email", logger.MaskEmail(req.Email)),
+	).Info("账户已激活")
+	return nil
+}
+
+// ResendActivation 重新发送激活邮件，邮箱未注册或已激活时静默返回，避免泄露账户是否存在
+func (s *UserService) ResendActivation(req *user.ResendActivationReq) error {
+	userInfo, err := db.FindUserByEmail(s.ctx, req.Email)
+	if err != nil {
+		return err
+	}
+	if userInfo == nil || userInfo.Status != "inactive" {
+		return nil
+	}
+	return s.sendActivationCode(req.Email)
+}
+
+// EnsureActive 校验当前登录用户已激活且未被封禁或禁言，否则拒绝发布内容
+func (s *UserService) EnsureActive() error {
+	userInfo, err := db.GetUserByID(s.ctx, GetUidFormContext(s.c))
+	if err != nil {
+		return err
+	}
+	switch userInfo.Status {
+	case "inactive":
+		return errno.UserAccountInactiveError.WithMessage("账户未激活，请先通过注册邮箱完成激活")
+	case "banned":
+		return errno.UserAccountSuspendedError.WithMessage("账户已被封禁")
+	}
+	if userInfo.MutedUntil != nil && userInfo.MutedUntil.After(time.Now()) {
+		return errno.UserAccountMutedError.WithMessage("账户处于禁言期，解除时间: " + userInfo.MutedUntil.Format("2006-01-02 15:04"))
+	}
+	return nil
+}
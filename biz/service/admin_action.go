@@ -0,0 +1,249 @@
+package service
+
+import (
+	"LearnShare/biz/dal/db"
+	"LearnShare/biz/model/audit"
+	"LearnShare/biz/model/module"
+	"LearnShare/pkg/constants"
+	"LearnShare/pkg/errno"
+	"LearnShare/pkg/logger"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"go.uber.org/zap"
+)
+
+// maxAdminActionExportRows 单次导出的最大行数，测试中可调小
+var maxAdminActionExportRows = 50000
+
+// adminActionDetail 管理操作的目标与变更快照，由服务层写入请求上下文
+type adminActionDetail struct {
+	targetType string
+	targetID   int64
+	before     any
+	after      any
+}
+
+// RecordAdminChange 记录本次管理操作的目标及变更前后的快照，由操作日志中间件在请求结束后落库
+// before/after 为 nil 表示没有对应快照（如新增没有变更前、删除没有变更后）
+func RecordAdminChange(c *app.RequestContext, targetType string, targetID int64, before, after any) {
+	if c == nil {
+		return
+	}
+	c.Set(constants.ContextAdminAction, &adminActionDetail{
+		targetType: targetType,
+		targetID:   targetID,
+		before:     before,
+		after:      after,
+	})
+}
+
+// RecordAdminBatchChange 记录批量管理操作，批量操作没有单一目标，只记录目标类型；快照通常以目标ID为键
+func RecordAdminBatchChange(c *app.RequestContext, targetType string, before, after any) {
+	RecordAdminChange(c, targetType, 0, before, after)
+}
+
+type AdminActionService struct {
+	ctx context.Context
+	c   *app.RequestContext
+}
+
+func NewAdminActionService(ctx context.Context, c *app.RequestContext) *AdminActionService {
+	return &AdminActionService{ctx: ctx, c: c}
+}
+
+// RecordRequest 将当前管理请求写入操作日志，目标默认取第一个路径参数，服务层通过 RecordAdminChange 补充快照
+func (s *AdminActionService) RecordRequest() {
+	action := &db.AdminAction{
+		ActorID:    GetUidFormContext(s.c),
+		Action:     handlerAction(s.c.HandlerName()),
+		Method:     string(s.c.Method()),
+		Path:       string(s.c.Path()),
+		StatusCode: int32(s.c.Response.StatusCode()),
+		CreatedAt:  time.Now(),
+	}
+	if ip := s.c.ClientIP(); ip != "" {
+		action.IP = &ip
+	}
+	if value, ok := s.c.Get("request_id"); ok {
+		if requestID, ok := value.(string); ok && requestID != "" {
+			action.RequestID = &requestID
+		}
+	}
+
+	if len(s.c.Params) > 0 {
+		param := s.c.Params[0]
+		if id, err := strconv.ParseInt(param.Value, 10, 64); err == nil {
+			targetType := strings.TrimSuffix(param.Key, "_id")
+			action.TargetType, action.TargetID = &targetType, &id
+		}
+	}
+	if value, ok := s.c.Get(constants.ContextAdminAction); ok {
+		if detail, ok := value.(*adminActionDetail); ok {
+			action.TargetType, action.TargetID = &detail.targetType, &detail.targetID
+			if detail.targetID == 0 {
+				action.TargetID = nil
+			}
+			action.BeforeSnapshot = snapshotJSON(detail.before)
+			action.AfterSnapshot = snapshotJSON(detail.after)
+		}
+	}
+
+	if err := db.CreateAdminAction(s.ctx, action); err != nil {
+		logger.WithFields(
+			zap.String("audit", "admin_action"),
+			zap.Int64("actor_id", action.ActorID),
+			zap.String("action", action.Action),
+			zap.String("path", action.Path),
+			zap.Error(err),
+		).Error("管理操作日志写入失败")
+	}
+}
+
+// ListAdminActions 分页查询管理操作日志
+func (s *AdminActionService) ListAdminActions(req *audit.ListAdminActionsReq) ([]*module.AdminAction, int64, error) {
+	if req.PageNum <= 0 || req.PageSize <= 0 || req.PageSize > 100 {
+		return nil, 0, errno.ParamVerifyError.WithMessage("分页参数无效")
+	}
+	filter, err := adminActionFilter(req.ActorID, req.Action, req.TargetType, req.TargetID, req.StartTime, req.EndTime)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	actions, total, err := db.ListAdminActions(s.ctx, filter, int(req.PageNum), int(req.PageSize))
+	if err != nil {
+		return nil, 0, err
+	}
+	list := make([]*module.AdminAction, 0, len(actions))
+	for _, action := range actions {
+		list = append(list, action.ToAdminActionModule())
+	}
+	return list, total, nil
+}
+
+// ExportAdminActions 按条件将管理操作日志以 CSV 格式写入 w
+func (s *AdminActionService) ExportAdminActions(req *audit.ExportAdminActionsReq, w io.Writer) error {
+	filter, err := adminActionFilter(req.ActorID, req.Action, req.TargetType, req.TargetID, req.StartTime, req.EndTime)
+	if err != nil {
+		return err
+	}
+
+	writer := csv.NewWriter(w)
+	header := []string{"action_id", "created_at", "actor_id", "action", "method", "path",
+		"target_type", "target_id", "status_code", "ip", "request_id", "before_snapshot", "after_snapshot"}
+	if err := writer.Write(header); err != nil {
+		return errno.NewErrNo(errno.InternalServiceErrorCode, "导出管理操作日志失败: "+err.Error())
+	}
+
+	err = db.ExportAdminActions(s.ctx, filter, maxAdminActionExportRows, func(actions []*db.AdminAction) error {
+		for _, a := range actions {
+			record := []string{
+				strconv.FormatInt(a.ActionID, 10),
+				a.CreatedAt.Format(time.RFC3339),
+				strconv.FormatInt(a.ActorID, 10),
+				a.Action,
+				a.Method,
+				a.Path,
+				derefString(a.TargetType),
+				derefInt64(a.TargetID),
+				strconv.Itoa(int(a.StatusCode)),
+				derefString(a.IP),
+				derefString(a.RequestID),
+				derefString(a.BeforeSnapshot),
+				derefString(a.AfterSnapshot),
+			}
+			if err := writer.Write(record); err != nil {
+				return errno.NewErrNo(errno.InternalServiceErrorCode, "导出管理操作日志失败: "+err.Error())
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return errno.NewErrNo(errno.InternalServiceErrorCode, "导出管理操作日志失败: "+err.Error())
+	}
+
+	logger.WithFields(
+		zap.String("audit", "admin_action_export"),
+		zap.Int64("operator_id", GetUidFormContext(s.c)),
+	).Info("导出管理操作日志")
+	return nil
+}
+
+func adminActionFilter(actorID *int64, action, targetType *string, targetID, startTime, endTime *int64) (db.AdminActionFilter, error) {
+	filter := db.AdminActionFilter{
+		ActorID:    actorID,
+		Action:     action,
+		TargetType: targetType,
+		TargetID:   targetID,
+	}
+	if startTime != nil {
+		t := time.Unix(*startTime, 0)
+		filter.StartTime = &t
+	}
+	if endTime != nil {
+		t := time.Unix(*endTime, 0)
+		filter.EndTime = &t
+	}
+	if filter.StartTime != nil && filter.EndTime != nil && !filter.StartTime.Before(*filter.EndTime) {
+		return filter, errno.ParamVerifyError.WithMessage("开始时间必须早于结束时间")
+	}
+	return filter, nil
+}
+
+// handlerAction 从处理函数全名（如 LearnShare/biz/handler/course.AdminDeleteCourse）中取出函数名作为操作名称
+func handlerAction(handlerName string) string {
+	if i := strings.LastIndex(handlerName, "."); i >= 0 {
+		return handlerName[i+1:]
+	}
+	return handlerName
+}
+
+func snapshotJSON(value any) *string {
+	if value == nil {
+		return nil
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil
+	}
+	snapshot := string(data)
+	return &snapshot
+}
+
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func derefInt64(v *int64) string {
+	if v == nil {
+		return ""
+	}
+	return strconv.FormatInt(*v, 10)
+}
+
+// adminSnapshot 读取记录快照用于操作日志，读取失败只记录日志，不影响管理操作本身
+func adminSnapshot(ctx context.Context, table, keyColumn string, id int64) any {
+	record, err := db.GetRecordSnapshot(ctx, table, keyColumn, id)
+	if err != nil {
+		logger.Warnf("读取操作快照失败: table=%s id=%d err=%v", table, id, err)
+		return nil
+	}
+	if record == nil {
+		return nil
+	}
+	return record
+}
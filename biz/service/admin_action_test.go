@@ -0,0 +1,157 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"strings"
+	"testing"
+	"time"
+
+	"LearnShare/biz/dal/db"
+	"LearnShare/biz/model/audit"
+	"LearnShare/pkg/constants"
+	"LearnShare/pkg/errno"
+)
+
+// setupAdminActionTestDB 在用户测试数据库上补充管理操作日志表，触发器与 init.sql 一致保证只能追加
+func setupAdminActionTestDB(t *testing.T) func() {
+	t.Helper()
+	cleanup := setupTestDB(t)
+	createTableSQL := `
+CREATE TABLE IF NOT EXISTS admin_actions (
+    action_id INTEGER PRIMARY KEY AUTOINCREMENT,
+    actor_id INTEGER NOT NULL,
+    action TEXT NOT NULL,
+    method TEXT NOT NULL,
+    path TEXT NOT NULL,
+    target_type TEXT,
+    target_id INTEGER,
+    before_snapshot TEXT,
+    after_snapshot TEXT,
+    status_code INTEGER NOT NULL,
+    ip TEXT,
+    request_id TEXT,
+    created_at DATETIME
+);
+CREATE TRIGGER IF NOT EXISTS trg_admin_actions_no_update BEFORE UPDATE ON admin_actions
+BEGIN SELECT RAISE(ABORT, 'admin_actions is append-only'); END;
+CREATE TRIGGER IF NOT EXISTS trg_admin_actions_no_delete BEFORE DELETE ON admin_actions
+BEGIN SELECT RAISE(ABORT, 'admin_actions is append-only'); END;
+`
+	if err := db.DB.Exec(createTableSQL).Error; err != nil {
+		t.Fatalf("创建管理操作日志表失败: %v", err)
+	}
+	return cleanup
+}
+
+// seedAdminAction 写入一条指定操作人、操作名称与时间的日志
+func seedAdminAction(t *testing.T, actorID int64, action string, at time.Time) {
+	t.Helper()
+	target := "course"
+	if err := db.CreateAdminAction(context.Background(), &db.AdminAction{
+		ActorID: actorID, Action: action, Method: "POST", Path: "/api/admin/courses/1",
+		TargetType: &target, StatusCode: 200, CreatedAt: at,
+	}); err != nil {
+		t.Fatalf("写入管理操作日志失败: %v", err)
+	}
+}
+
+func exportAdminActionRows(t *testing.T, req *audit.ExportAdminActionsReq) [][]string {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := NewAdminActionService(context.Background(), buildRequestContextWithUser(1)).ExportAdminActions(req, &buf); err != nil {
+		t.Fatalf("导出管理操作日志失败: %v", err)
+	}
+	records, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatalf("解析导出结果失败: %v", err)
+	}
+	return records[1:]
+}
+
+func TestAdminActionSnapshotStripsSensitiveColumns(t *testing.T) {
+	cleanup := setupAdminActionTestDB(t)
+	defer cleanup()
+
+	u := seedUser(t, "snapshot", "snapshot@example.com", "Pass1234")
+	seedPersonalToken(t, u.UserID, constants.PersonalTokenPrefix+"snapshot00000000", "resource.upload", nil, nil)
+
+	for _, c := range []struct {
+		table, key, column string
+	}{
+		{constants.UserTableName, "user_id", "password_hash"},
+		{constants.PersonalAccessTokenTableName, "user_id", "token_hash"},
+	} {
+		snapshot := adminSnapshot(context.Background(), c.table, c.key, u.UserID)
+		record, ok := snapshot.(map[string]any)
+		if !ok || record[c.key] == nil {
+			t.Fatalf("应当读取到 %s 的快照: %v", c.table, snapshot)
+		}
+		if _, ok := record[c.column]; ok {
+			t.Fatalf("%s 的快照不应包含 %s", c.table, c.column)
+		}
+		if data := snapshotJSON(snapshot); data == nil || strings.Contains(*data, c.column) {
+			t.Fatalf("写入日志的快照不应包含 %s: %v", c.column, data)
+		}
+	}
+	if snapshot := adminSnapshot(context.Background(), constants.UserTableName, "user_id", 999); snapshot != nil {
+		t.Fatalf("记录不存在时快照应为空: %v", snapshot)
+	}
+}
+
+func TestAdminActionAppendOnly(t *testing.T) {
+	cleanup := setupAdminActionTestDB(t)
+	defer cleanup()
+
+	seedAdminAction(t, 1, "AdminDeleteCourse", time.Now())
+	if err := db.DB.Table(constants.AdminActionTableName).Where("action_id = ?", 1).Update("actor_id", 2).Error; err == nil {
+		t.Fatal("管理操作日志不允许修改")
+	}
+	if err := db.DB.Exec("DELETE FROM admin_actions WHERE action_id = ?", 1).Error; err == nil {
+		t.Fatal("管理操作日志不允许删除")
+	}
+	seedAdminAction(t, 1, "AdminDeleteCourse", time.Now())
+	_, total, err := db.ListAdminActions(context.Background(), db.AdminActionFilter{}, 1, 10)
+	if err != nil || total != 2 {
+		t.Fatalf("同一操作再次执行应追加新日志: total=%d err=%v", total, err)
+	}
+}
+
+func TestExportAdminActionsFiltersAndCap(t *testing.T) {
+	cleanup := setupAdminActionTestDB(t)
+	defer cleanup()
+
+	base := time.Date(2026, 3, 1, 12, 0, 0, 0, time.Local)
+	for i := 0; i < 6; i++ {
+		seedAdminAction(t, 1, "AdminDeleteCourse", base.Add(time.Duration(i)*time.Hour))
+	}
+	seedAdminAction(t, 2, "AdminBanUser", base.Add(time.Hour))
+
+	// 按操作人与时间范围过滤，结果按时间倒序
+	actor := int64(1)
+	start, end := base.Add(time.Hour).Unix(), base.Add(4*time.Hour).Unix()
+	rows := exportAdminActionRows(t, &audit.ExportAdminActionsReq{ActorID: &actor, StartTime: &start, EndTime: &end})
+	if len(rows) != 3 || rows[0][0] != "4" || rows[2][0] != "2" {
+		t.Fatalf("过滤结果不正确: %v", rows)
+	}
+	action := "AdminBanUser"
+	rows = exportAdminActionRows(t, &audit.ExportAdminActionsReq{Action: &action})
+	if len(rows) != 1 || rows[0][2] != "2" || rows[0][3] != action {
+		t.Fatalf("按操作名称过滤结果不正确: %v", rows)
+	}
+
+	// 超过导出上限时只导出最新的记录
+	origin := maxAdminActionExportRows
+	maxAdminActionExportRows = 4
+	defer func() { maxAdminActionExportRows = origin }()
+	rows = exportAdminActionRows(t, &audit.ExportAdminActionsReq{})
+	if len(rows) != 4 || rows[0][0] != "7" || rows[3][0] != "4" {
+		t.Fatalf("导出数量应受上限约束: %v", rows)
+	}
+
+	var buf bytes.Buffer
+	err := NewAdminActionService(context.Background(), buildRequestContextWithUser(1)).
+		ExportAdminActions(&audit.ExportAdminActionsReq{StartTime: &end, EndTime: &start}, &buf)
+	assertErrCode(t, err, errno.ParamVerifyErrorCode)
+}
@@ -4,6 +4,7 @@ import (
 	"LearnShare/biz/dal/db"
 	"LearnShare/biz/model/audit"
 	model "LearnShare/biz/model/module"
+	"LearnShare/pkg/constants"
 	"LearnShare/pkg/errno"
 
 	"context"
@@ -70,11 +71,14 @@ func (s *AuditService) AuditResource(req *audit.AuditResourceReq) error {
 	}
 
 	reviewerID := GetUidFormContext(s.c)
+	before := adminSnapshot(s.ctx, constants.ReviewTableName, "review_id", req.ReviewID)
 	// 调用数据库层执行审核
 	err := db.AuditResourceReview(s.ctx, req.ReviewID, reviewerID, req.Action)
 	if err != nil {
 		return err
 	}
+	refreshReviewedLeaderboards(s.ctx, req.ReviewID)
+	RecordAdminChange(s.c, "review", req.ReviewID, before, adminSnapshot(s.ctx, constants.ReviewTableName, "review_id", req.ReviewID))
 	return nil
 }
 
@@ -98,26 +102,80 @@ func (s *AuditService) GetResourceCommentAuditList(req *audit.GetResourceComment
 	return reviewModules, nil
 }
 
+// GetCourseCommentAuditList 获取待审核的课程评论举报列表
+func (s *AuditService) GetCourseCommentAuditList(req *audit.GetCourseCommentAuditListReq) ([]*model.Review, error) {
+	return s.pendingReviews("course_comment", req.PageNum, req.PageSize)
+}
+
+// AuditCourseComment 审核课程评论举报，approve 时删除该评论
 func (s *AuditService) AuditCourseComment(req *audit.AuditCourseCommentReq) error {
+	return s.auditContent("course_comment", req.ReviewID, req.Action)
+}
+
+func (s *AuditService) AuditResourceComment(req *audit.AuditResourceCommentReq) error {
 	if req.ReviewID <= 0 {
 		return errno.NewErrNo(errno.ServiceInvalidParameter, "审核记录ID无效")
 	}
 	if req.Action != "approve" && req.Action != "reject" {
 		return errno.NewErrNo(errno.ServiceInvalidParameter, "操作类型无效")
 	}
+	reviewerID := GetUidFormContext(s.c)
+	before := adminSnapshot(s.ctx, constants.ReviewTableName, "review_id", req.ReviewID)
+	if err := db.AuditResourceCommentReview(s.ctx, req.ReviewID, reviewerID, req.Action); err != nil {
+		return err
+	}
+	RecordAdminChange(s.c, "review", req.ReviewID, before, adminSnapshot(s.ctx, constants.ReviewTableName, "review_id", req.ReviewID))
 	return nil
 }
 
-func (s *AuditService) AuditResourceComment(req *audit.AuditResourceCommentReq) error {
-	if req.ReviewID <= 0 {
+// GetCourseRatingAuditList 获取待审核的课程评分举报列表
+func (s *AuditService) GetCourseRatingAuditList(req *audit.GetCourseRatingAuditListReq) ([]*model.Review, error) {
+	return s.pendingReviews("course_rating", req.PageNum, req.PageSize)
+}
+
+// AuditCourseRating 审核课程评分举报，approve 时隐藏该评分
+func (s *AuditService) AuditCourseRating(req *audit.AuditCourseRatingReq) error {
+	return s.auditContent("course_rating", req.ReviewID, req.Action)
+}
+
+// GetResourceRatingAuditList 获取待审核的资源评分举报列表
+func (s *AuditService) GetResourceRatingAuditList(req *audit.GetResourceRatingAuditListReq) ([]*model.Review, error) {
+	return s.pendingReviews("resource_rating", req.PageNum, req.PageSize)
+}
+
+// AuditResourceRating 审核资源评分举报，approve 时隐藏该评分并重新计算资源平均分
+func (s *AuditService) AuditResourceRating(req *audit.AuditResourceRatingReq) error {
+	return s.auditContent("resource_rating", req.ReviewID, req.Action)
+}
+
+// pendingReviews 按对象类型分页查询待审核举报
+func (s *AuditService) pendingReviews(targetType string, pageNum, pageSize int32) ([]*model.Review, error) {
+	if pageNum <= 0 {
+		pageNum = 1
+	}
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 20
+	}
+	reviews, err := db.GetPendingReviewsByType(s.ctx, targetType, int(pageNum), int(pageSize))
+	if err != nil {
+		return nil, err
+	}
+	return toReviewModules(reviews), nil
+}
+
+// auditContent 审核评分或评论举报并记录操作日志
+func (s *AuditService) auditContent(targetType string, reviewID int64, action string) error {
+	if reviewID <= 0 {
 		return errno.NewErrNo(errno.ServiceInvalidParameter, "审核记录ID无效")
 	}
-	if req.Action != "approve" && req.Action != "reject" {
+	if action != "approve" && action != "reject" {
 		return errno.NewErrNo(errno.ServiceInvalidParameter, "操作类型无效")
 	}
 	reviewerID := GetUidFormContext(s.c)
-	if err := db.AuditResourceCommentReview(s.ctx, req.ReviewID, reviewerID, req.Action); err != nil {
+	before := adminSnapshot(s.ctx, constants.ReviewTableName, "review_id", reviewID)
+	if err := db.AuditContentReview(s.ctx, reviewID, reviewerID, targetType, action); err != nil {
 		return err
 	}
+	RecordAdminChange(s.c, "review", reviewID, before, adminSnapshot(s.ctx, constants.ReviewTableName, "review_id", reviewID))
 	return nil
 }
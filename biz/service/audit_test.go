@@ -0,0 +1,94 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"LearnShare/biz/dal/db"
+	"LearnShare/biz/model/audit"
+	"LearnShare/pkg/constants"
+)
+
+// seedResourceRatings 为资源写入一组可见评分，并同步资源的平均分与评分人数
+func seedResourceRatings(t *testing.T, resourceID int64, recommendations ...float64) []int64 {
+	t.Helper()
+	var ids []int64
+	var sum float64
+	for i, r := range recommendations {
+		rating := db.ResourceRating{UserID: int64(i + 10), ResourceID: resourceID, Recommendation: r, IsVisible: true, CreatedAt: time.Now()}
+		if err := db.DB.Table(constants.ResourceRatingTableName).Create(&rating).Error; err != nil {
+			t.Fatalf("插入资源评分失败: %v", err)
+		}
+		ids = append(ids, rating.RatingID)
+		sum += r
+	}
+	if err := db.DB.Table(constants.ResourceTableName).Where("resource_id = ?", resourceID).Updates(map[string]interface{}{
+		"average_rating": sum / float64(len(recommendations)),
+		"rating_count":   len(recommendations),
+	}).Error; err != nil {
+		t.Fatalf("更新资源评分信息失败: %v", err)
+	}
+	return ids
+}
+
+func assertRatingAggregate(t *testing.T, resourceID int64, average float64, count int64) {
+	t.Helper()
+	var r db.Resource
+	if err := db.DB.Table(constants.ResourceTableName).Where("resource_id = ?", resourceID).First(&r).Error; err != nil {
+		t.Fatalf("查询资源失败: %v", err)
+	}
+	if r.AverageRating != average || r.RatingCount != count {
+		t.Fatalf("资源评分信息不正确: average=%v count=%d, 期望 average=%v count=%d", r.AverageRating, r.RatingCount, average, count)
+	}
+}
+
+func ratingVisible(t *testing.T, ratingID int64) bool {
+	t.Helper()
+	var visible []bool
+	db.DB.Table(constants.ResourceRatingTableName).Where("rating_id = ?", ratingID).Pluck("is_visible", &visible)
+	return len(visible) == 1 && visible[0]
+}
+
+func TestAuditResourceRatingRecomputesAggregate(t *testing.T) {
+	cleanup := setupResourceServiceTestDB(t)
+	defer cleanup()
+
+	reviewer := seedUserForService(t, "reviewer", "reviewer@example.com")
+	res := seedResourceForService(t, "评分资源", "描述", 1)
+	ratings := seedResourceRatings(t, res.ResourceID, 40, 20, 30)
+	assertRatingAggregate(t, res.ResourceID, 30, 3)
+
+	svc := NewAuditService(context.Background(), buildRequestContextWithUser(reviewer.UserID))
+
+	// 确认举报：隐藏该评分并按剩余可见评分重新计算
+	report := db.Review{TargetID: ratings[1], TargetType: "resource_rating", Reason: "恶意评分", Status: "pending", Level: 1}
+	if err := db.DB.Table(constants.ReviewTableName).Create(&report).Error; err != nil {
+		t.Fatalf("插入举报记录失败: %v", err)
+	}
+	if err := svc.AuditResourceRating(&audit.AuditResourceRatingReq{ReviewID: report.ReviewID, Action: "approve"}); err != nil {
+		t.Fatalf("审核评分举报失败: %v", err)
+	}
+	if ratingVisible(t, ratings[1]) {
+		t.Fatal("举报确认后评分应当隐藏")
+	}
+	assertRatingAggregate(t, res.ResourceID, 35, 2)
+
+	// 举报数达到阈值而自动隐藏的评分，举报被驳回后恢复并重新计入
+	now := time.Now()
+	hidden := db.Review{TargetID: ratings[2], TargetType: "resource_rating", Reason: "刷分", Status: "pending", Level: 1, AutoHiddenAt: &now}
+	if err := db.DB.Table(constants.ReviewTableName).Create(&hidden).Error; err != nil {
+		t.Fatalf("插入举报记录失败: %v", err)
+	}
+	db.DB.Table(constants.ResourceRatingTableName).Where("rating_id = ?", ratings[2]).Update("is_visible", false)
+	db.DB.Table(constants.ResourceTableName).Where("resource_id = ?", res.ResourceID).
+		Updates(map[string]interface{}{"average_rating": 40, "rating_count": 1})
+
+	if err := svc.AuditResourceRating(&audit.AuditResourceRatingReq{ReviewID: hidden.ReviewID, Action: "reject"}); err != nil {
+		t.Fatalf("驳回评分举报失败: %v", err)
+	}
+	if !ratingVisible(t, ratings[2]) {
+		t.Fatal("举报驳回后自动隐藏的评分应当恢复")
+	}
+	assertRatingAggregate(t, res.ResourceID, 35, 2)
+}
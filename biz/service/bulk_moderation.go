@@ -0,0 +1,159 @@
+package service
+
+import (
+	"LearnShare/biz/dal/db"
+	"LearnShare/biz/model/audit"
+	model "LearnShare/biz/model/module"
+	"LearnShare/pkg/constants"
+	"LearnShare/pkg/errno"
+	"LearnShare/pkg/logger"
+	"context"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"go.uber.org/zap"
+)
+
+// maxBulkModerationItems 单次批量操作的最大条目数
+const maxBulkModerationItems = 100
+
+// BulkModerationService 管理端：批量审核举报、批量隐藏与恢复内容
+// 每个条目单独提交事务并复用单条操作的处理逻辑，部分失败不影响其他条目，结果逐条返回
+type BulkModerationService struct {
+	ctx context.Context
+	c   *app.RequestContext
+}
+
+func NewBulkModerationService(ctx context.Context, c *app.RequestContext) *BulkModerationService {
+	return &BulkModerationService{ctx: ctx, c: c}
+}
+
+func batchItemResult(targetType string, targetID int64, err error) *model.BatchItemResult {
+	e := errno.ConvertErr(err)
+	return &model.BatchItemResult{
+		TargetType: targetType,
+		TargetID:   targetID,
+		Success:    err == nil,
+		Code:       e.ErrorCode,
+		Message:    e.ErrorMsg,
+	}
+}
+
+// BatchAuditReviews 批量审核举报（approve/reject），返回逐条结果与成功数量
+func (s *BulkModerationService) BatchAuditReviews(req *audit.BatchAuditReviewsReq) ([]*model.BatchItemResult, int64, error) {
+	if req.Action != "approve" && req.Action != "reject" {
+		return nil, 0, errno.NewErrNo(errno.ServiceInvalidParameter, "操作类型无效")
+	}
+	if len(req.ReviewIds) == 0 || len(req.ReviewIds) > maxBulkModerationItems {
+		return nil, 0, errno.ParamVerifyError.WithMessage("每次须审核1-100条举报")
+	}
+
+	reviewerID := GetUidFormContext(s.c)
+	results := make([]*model.BatchItemResult, 0, len(req.ReviewIds))
+	before, after := make(map[int64]any), make(map[int64]any)
+	seen := make(map[int64]bool, len(req.ReviewIds))
+	var succeeded int64
+	for _, id := range req.ReviewIds {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		if id <= 0 {
+			results = append(results, batchItemResult("review", id, errno.NewErrNo(errno.ServiceInvalidParameter, "审核记录ID无效")))
+			continue
+		}
+
+		snapshot := adminSnapshot(s.ctx, constants.ReviewTableName, "review_id", id)
+		err := db.AuditContentReview(s.ctx, id, reviewerID, "", req.Action)
+		results = append(results, batchItemResult("review", id, err))
+		if err != nil {
+			continue
+		}
+		succeeded++
+		before[id], after[id] = snapshot, adminSnapshot(s.ctx, constants.ReviewTableName, "review_id", id)
+		refreshReviewedLeaderboards(s.ctx, id)
+	}
+	RecordAdminBatchChange(s.c, "review", before, after)
+
+	logger.WithFields(
+		zap.Int64("reviewer_id", reviewerID),
+		zap.String("action", req.Action),
+		zap.Int("total", len(results)),
+		zap.Int64("succeeded", succeeded),
+	).Info("批量审核举报")
+	return results, succeeded, nil
+}
+
+// HideUserComments 隐藏用户全部可见的资源评论与课程评论，处理方式与举报审核确认违规时相同
+func (s *BulkModerationService) HideUserComments(req *audit.HideUserCommentsReq) ([]*model.BatchItemResult, int64, error) {
+	if req.UserID <= 0 {
+		return nil, 0, errno.NewErrNo(errno.ServiceInvalidParameter, "用户ID无效")
+	}
+	refs, err := db.ListUserVisibleComments(s.ctx, req.UserID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	results := make([]*model.BatchItemResult, 0, len(refs))
+	hidden := make([]*model.BatchItemResult, 0, len(refs))
+	var succeeded int64
+	for _, ref := range refs {
+		result := batchItemResult(ref.TargetType, ref.TargetID, db.HideContent(s.ctx, ref.TargetType, ref.TargetID))
+		results = append(results, result)
+		if result.Success {
+			succeeded++
+			hidden = append(hidden, result)
+		}
+	}
+	RecordAdminChange(s.c, "user", req.UserID, nil, hidden)
+
+	logger.WithFields(
+		zap.Int64("operator_id", GetUidFormContext(s.c)),
+		zap.Int64("user_id", req.UserID),
+		zap.Int("total", len(results)),
+		zap.Int64("succeeded", succeeded),
+	).Info("批量隐藏用户评论")
+	return results, succeeded, nil
+}
+
+// RestoreContent 批量恢复被管理员隐藏的内容，用户自行删除的评论不会被恢复
+func (s *BulkModerationService) RestoreContent(req *audit.RestoreContentReq) ([]*model.BatchItemResult, int64, error) {
+	if len(req.Items) == 0 || len(req.Items) > maxBulkModerationItems {
+		return nil, 0, errno.ParamVerifyError.WithMessage("每次须恢复1-100条内容")
+	}
+
+	results := make([]*model.BatchItemResult, 0, len(req.Items))
+	restored := make([]*model.BatchItemResult, 0, len(req.Items))
+	seen := make(map[db.ContentRef]bool, len(req.Items))
+	var succeeded int64
+	for _, item := range req.Items {
+		if item == nil {
+			continue
+		}
+		ref := db.ContentRef{TargetType: item.TargetType, TargetID: item.TargetID}
+		if seen[ref] {
+			continue
+		}
+		seen[ref] = true
+
+		var err error
+		if ref.TargetID <= 0 {
+			err = errno.NewErrNo(errno.ServiceInvalidParameter, "内容ID无效")
+		} else {
+			err = db.RestoreContent(s.ctx, ref.TargetType, ref.TargetID)
+		}
+		result := batchItemResult(ref.TargetType, ref.TargetID, err)
+		results = append(results, result)
+		if result.Success {
+			succeeded++
+			restored = append(restored, result)
+		}
+	}
+	RecordAdminBatchChange(s.c, "content", nil, restored)
+
+	logger.WithFields(
+		zap.Int64("operator_id", GetUidFormContext(s.c)),
+		zap.Int("total", len(results)),
+		zap.Int64("succeeded", succeeded),
+	).Info("批量恢复内容")
+	return results, succeeded, nil
+}
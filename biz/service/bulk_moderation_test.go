@@ -0,0 +1,183 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"LearnShare/biz/dal/db"
+	"LearnShare/biz/model/audit"
+	model "LearnShare/biz/model/module"
+	"LearnShare/pkg/constants"
+	"LearnShare/pkg/errno"
+)
+
+// setupBulkModerationTestDB 在资源测试数据库上补充课程评论表，批量隐藏会同时处理两类评论
+func setupBulkModerationTestDB(t *testing.T) func() {
+	t.Helper()
+	cleanup := setupResourceServiceTestDB(t)
+	createTableSQL := `
+CREATE TABLE IF NOT EXISTS course_comments (
+    comment_id INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id INTEGER NOT NULL,
+    course_id INTEGER NOT NULL,
+    content TEXT NOT NULL,
+    is_visible INTEGER DEFAULT 1,
+    status TEXT DEFAULT 'normal',
+    created_at DATETIME,
+    deleted_at DATETIME
+);
+`
+	if err := db.DB.Exec(createTableSQL).Error; err != nil {
+		t.Fatalf("创建课程评论表失败: %v", err)
+	}
+	return cleanup
+}
+
+// seedCommentWithStatus 写入一条指定状态的资源评论
+func seedCommentWithStatus(t *testing.T, userID, resourceID int64, status string) int64 {
+	t.Helper()
+	comment := map[string]interface{}{
+		"user_id": userID, "resource_id": resourceID, "content": "评论", "status": status,
+		"is_visible": status == "normal", "created_at": time.Now(),
+	}
+	if status != "normal" {
+		comment["deleted_at"] = time.Now()
+	}
+	if err := db.DB.Table(constants.ResourceCommentTableName).Create(comment).Error; err != nil {
+		t.Fatalf("插入评论失败: %v", err)
+	}
+	var id int64
+	db.DB.Table(constants.ResourceCommentTableName).Select("MAX(comment_id)").Scan(&id)
+	return id
+}
+
+func assertBatchItem(t *testing.T, result *model.BatchItemResult, targetID int64, code int64) {
+	t.Helper()
+	if result.TargetID != targetID || result.Success != (code == errno.SuccessCode) || result.Code != code {
+		t.Fatalf("条目 %d 的结果不正确: %+v, 期望 code=%d", targetID, result, code)
+	}
+}
+
+func commentState(t *testing.T, table string, commentID int64) (string, bool) {
+	t.Helper()
+	var c struct {
+		Status    string
+		IsVisible bool
+	}
+	db.DB.Table(table).Select("status, is_visible").Where("comment_id = ?", commentID).Scan(&c)
+	return c.Status, c.IsVisible
+}
+
+func TestBatchAuditReviewsReportsPartialFailure(t *testing.T) {
+	cleanup := setupBulkModerationTestDB(t)
+	defer cleanup()
+
+	reviewer := seedUserForService(t, "batchreviewer", "batchreviewer@example.com")
+	author := seedUserForService(t, "author", "author@example.com")
+	res := seedResourceForService(t, "批量资源", "描述", 1)
+	comment := seedCommentWithStatus(t, author.UserID, res.ResourceID, "normal")
+
+	pending := db.Review{TargetID: comment, TargetType: "comment", Reason: "广告", Status: "pending", Level: 1}
+	handled := db.Review{TargetID: comment, TargetType: "comment", Reason: "广告", Status: "approved", Level: 1}
+	for _, r := range []*db.Review{&pending, &handled} {
+		if err := db.DB.Table(constants.ReviewTableName).Create(r).Error; err != nil {
+			t.Fatalf("插入举报记录失败: %v", err)
+		}
+	}
+
+	svc := NewBulkModerationService(context.Background(), buildRequestContextWithUser(reviewer.UserID))
+	results, succeeded, err := svc.BatchAuditReviews(&audit.BatchAuditReviewsReq{
+		ReviewIds: []int64{pending.ReviewID, handled.ReviewID, 999, 0, pending.ReviewID},
+		Action:    "approve",
+	})
+	if err != nil {
+		t.Fatalf("批量审核失败: %v", err)
+	}
+	// 重复的条目只处理一次，失败条目不影响其他条目
+	if succeeded != 1 || len(results) != 4 {
+		t.Fatalf("批量审核结果数量不正确: succeeded=%d results=%d", succeeded, len(results))
+	}
+	assertBatchItem(t, results[0], pending.ReviewID, errno.SuccessCode)
+	assertBatchItem(t, results[1], handled.ReviewID, errno.ReviewAlreadyHandled)
+	assertBatchItem(t, results[2], 999, errno.ReviewNotFound)
+	assertBatchItem(t, results[3], 0, errno.ServiceInvalidParameter)
+
+	if status, visible := commentState(t, constants.ResourceCommentTableName, comment); status != "deleted_by_admin" || visible {
+		t.Fatalf("举报确认后评论应当隐藏: status=%s visible=%v", status, visible)
+	}
+
+	_, _, err = svc.BatchAuditReviews(&audit.BatchAuditReviewsReq{ReviewIds: []int64{pending.ReviewID}, Action: "ignore"})
+	assertErrCode(t, err, errno.ServiceInvalidParameter)
+	_, _, err = svc.BatchAuditReviews(&audit.BatchAuditReviewsReq{ReviewIds: make([]int64, maxBulkModerationItems+1), Action: "reject"})
+	assertErrCode(t, err, errno.ParamVerifyErrorCode)
+}
+
+func TestRestoreContentAfterHideUserComments(t *testing.T) {
+	cleanup := setupBulkModerationTestDB(t)
+	defer cleanup()
+
+	admin := seedUserForService(t, "admin", "admin@example.com")
+	author := seedUserForService(t, "spammer", "spammer@example.com")
+	other := seedUserForService(t, "other", "other@example.com")
+	res := seedResourceForService(t, "批量资源", "描述", 1)
+
+	first := seedCommentWithStatus(t, author.UserID, res.ResourceID, "normal")
+	second := seedCommentWithStatus(t, author.UserID, res.ResourceID, "normal")
+	selfDeleted := seedCommentWithStatus(t, author.UserID, res.ResourceID, "deleted_by_user")
+	untouched := seedCommentWithStatus(t, other.UserID, res.ResourceID, "normal")
+	courseComment := map[string]interface{}{"user_id": author.UserID, "course_id": 1, "content": "课程评论", "status": "normal", "is_visible": true}
+	if err := db.DB.Table(constants.CourseCommentTableName).Create(courseComment).Error; err != nil {
+		t.Fatalf("插入课程评论失败: %v", err)
+	}
+
+	svc := NewBulkModerationService(context.Background(), buildRequestContextWithUser(admin.UserID))
+	results, succeeded, err := svc.HideUserComments(&audit.HideUserCommentsReq{UserID: author.UserID})
+	if err != nil || succeeded != 3 || len(results) != 3 {
+		t.Fatalf("批量隐藏用户评论失败: succeeded=%d results=%d err=%v", succeeded, len(results), err)
+	}
+	if results[2].TargetType != "course_comment" {
+		t.Fatalf("课程评论应当一并隐藏: %+v", results[2])
+	}
+	for _, id := range []int64{first, second} {
+		if status, visible := commentState(t, constants.ResourceCommentTableName, id); status != "deleted_by_admin" || visible {
+			t.Fatalf("评论 %d 应当被隐藏: status=%s visible=%v", id, status, visible)
+		}
+	}
+	if status, _ := commentState(t, constants.ResourceCommentTableName, untouched); status != "normal" {
+		t.Fatalf("其他用户的评论不应受影响: status=%s", status)
+	}
+
+	// 只恢复管理员隐藏的内容，用户自行删除的评论与无效条目单独报告失败
+	results, succeeded, err = svc.RestoreContent(&audit.RestoreContentReq{Items: []*model.ContentRef{
+		{TargetType: "comment", TargetID: first},
+		{TargetType: "comment", TargetID: second},
+		{TargetType: "comment", TargetID: selfDeleted},
+		{TargetType: "comment", TargetID: untouched},
+		{TargetType: "comment", TargetID: first},
+		{TargetType: "unknown", TargetID: first},
+	}})
+	if err != nil {
+		t.Fatalf("批量恢复内容失败: %v", err)
+	}
+	if succeeded != 2 || len(results) != 5 {
+		t.Fatalf("批量恢复结果数量不正确: succeeded=%d results=%d", succeeded, len(results))
+	}
+	assertBatchItem(t, results[0], first, errno.SuccessCode)
+	assertBatchItem(t, results[1], second, errno.SuccessCode)
+	assertBatchItem(t, results[2], selfDeleted, errno.ServiceInvalidParameter)
+	assertBatchItem(t, results[3], untouched, errno.ServiceInvalidParameter)
+	assertBatchItem(t, results[4], first, errno.ServiceInvalidParameter)
+
+	for _, id := range []int64{first, second} {
+		if status, visible := commentState(t, constants.ResourceCommentTableName, id); status != "normal" || !visible {
+			t.Fatalf("评论 %d 应当恢复: status=%s visible=%v", id, status, visible)
+		}
+	}
+	if status, visible := commentState(t, constants.ResourceCommentTableName, selfDeleted); status != "deleted_by_user" || visible {
+		t.Fatalf("用户自行删除的评论不应恢复: status=%s visible=%v", status, visible)
+	}
+	if status, _ := commentState(t, constants.CourseCommentTableName, 1); status != "deleted_by_admin" {
+		t.Fatalf("未列入恢复的课程评论应保持隐藏: status=%s", status)
+	}
+}
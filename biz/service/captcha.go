@@ -0,0 +1,79 @@
+package service
+
+import (
+	"LearnShare/biz/dal/redis"
+	"LearnShare/config"
+	"LearnShare/pkg/captcha"
+	"LearnShare/pkg/errno"
+	"context"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+const defaultCaptchaExpire = 5 * time.Minute
+
+type CaptchaService struct {
+	ctx context.Context
+	c   *app.RequestContext
+}
+
+func NewCaptchaService(ctx context.Context, c *app.RequestContext) *CaptchaService {
+	return &CaptchaService{ctx: ctx, c: c}
+}
+
+// Generate 生成本地图形验证码，仅在服务商配置为 local 时可用
+func (s *CaptchaService) Generate() (*captcha.Challenge, time.Duration, error) {
+	if config.Captcha == nil || config.Captcha.Provider != captcha.ProviderLocal {
+		return nil, 0, errno.CaptchaNotSupportedError
+	}
+	local := newLocalCaptcha()
+	challenge, err := local.Generate(s.ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	return challenge, local.TTL(), nil
+}
+
+// CaptchaVerifier 根据配置选择人机验证服务商
+func CaptchaVerifier() (captcha.Verifier, error) {
+	cfg := config.Captcha
+	remote := captcha.Config{
+		SecretKey: cfg.SecretKey,
+		VerifyURL: cfg.VerifyURL,
+		Timeout:   time.Duration(cfg.TimeoutSeconds) * time.Second,
+		MinScore:  cfg.MinScore,
+	}
+
+	switch cfg.Provider {
+	case "", captcha.ProviderTurnstile:
+		return captcha.NewTurnstile(remote), nil
+	case captcha.ProviderHCaptcha:
+		return captcha.NewHCaptcha(remote), nil
+	case captcha.ProviderReCaptcha:
+		return captcha.NewReCaptcha(remote), nil
+	case captcha.ProviderLocal:
+		return newLocalCaptcha(), nil
+	default:
+		return nil, errno.NewErrNo(errno.InternalServiceErrorCode, "未知的人机验证服务商: "+cfg.Provider)
+	}
+}
+
+func newLocalCaptcha() *captcha.Local {
+	expire := time.Duration(config.Captcha.ExpireSeconds) * time.Second
+	if expire <= 0 {
+		expire = defaultCaptchaExpire
+	}
+	return captcha.NewLocal(captchaStore{}, expire)
+}
+
+// captchaStore 基于 Redis 的本地验证码答案存储
+type captchaStore struct{}
+
+func (captchaStore) Set(ctx context.Context, id, answer string, ttl time.Duration) error {
+	return redis.SetCaptchaAnswer(ctx, id, answer, ttl)
+}
+
+func (captchaStore) Take(ctx context.Context, id string) (string, error) {
+	return redis.TakeCaptchaAnswer(ctx, id)
+}
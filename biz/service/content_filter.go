@@ -0,0 +1,344 @@
+package service
+
+import (
+	"LearnShare/biz/dal/db"
+	"LearnShare/biz/model/audit"
+	model "LearnShare/biz/model/module"
+	"LearnShare/config"
+	"LearnShare/pkg/constants"
+	"LearnShare/pkg/contentfilter"
+	"LearnShare/pkg/errno"
+	"LearnShare/pkg/logger"
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultContentFilterReloadSeconds = 60
+	maxFilterRuleDescriptionLength    = 255
+	maxFilterReviewReasonLength       = 500
+
+	// 命中 review 规则的内容先隐藏，优先审核；只命中 mask 规则的内容已打码发布，审核仅作复查
+	filterReviewPriority = 2
+	filterMaskPriority   = 4
+)
+
+// contentFilterCache 进程内缓存的已编译规则，本实例修改规则后立即失效，其他实例按有效期重新加载
+var contentFilterCache struct {
+	mu       sync.Mutex
+	filter   *contentfilter.Filter
+	loadedAt time.Time
+}
+
+func contentFilterTTL() time.Duration {
+	if config.ContentFilter != nil && config.ContentFilter.ReloadSeconds > 0 {
+		return time.Duration(config.ContentFilter.ReloadSeconds) * time.Second
+	}
+	return defaultContentFilterReloadSeconds * time.Second
+}
+
+// loadContentFilter 返回当前启用规则编译出的过滤器
+// 加载失败时沿用上一次的规则，不阻塞内容发布
+func loadContentFilter(ctx context.Context) *contentfilter.Filter {
+	contentFilterCache.mu.Lock()
+	defer contentFilterCache.mu.Unlock()
+	if !contentFilterCache.loadedAt.IsZero() && time.Since(contentFilterCache.loadedAt) < contentFilterTTL() {
+		return contentFilterCache.filter
+	}
+
+	rules, err := db.GetEnabledContentFilterRules(ctx)
+	if err != nil {
+		logger.Errorf("加载内容过滤规则失败: %v", err)
+		return contentFilterCache.filter
+	}
+	compiled := make([]contentfilter.Rule, 0, len(rules))
+	for _, r := range rules {
+		rule := toFilterRule(r)
+		// 数据库中的规则已在写入时校验，这里跳过个别无效规则以免整个过滤器失效
+		if _, err := contentfilter.New([]contentfilter.Rule{rule}); err != nil {
+			logger.Warnf("跳过无效的内容过滤规则: %v", err)
+			continue
+		}
+		compiled = append(compiled, rule)
+	}
+	filter, err := contentfilter.New(compiled)
+	if err != nil {
+		logger.Errorf("编译内容过滤规则失败: %v", err)
+		return contentFilterCache.filter
+	}
+	contentFilterCache.filter, contentFilterCache.loadedAt = filter, time.Now()
+	return filter
+}
+
+// invalidateContentFilter 规则变更后清除本实例缓存，下次检查时重新加载
+func invalidateContentFilter() {
+	contentFilterCache.mu.Lock()
+	contentFilterCache.loadedAt = time.Time{}
+	contentFilterCache.mu.Unlock()
+}
+
+func toFilterRule(r *db.ContentFilterRule) contentfilter.Rule {
+	return contentfilter.Rule{
+		ID:      r.RuleID,
+		Pattern: r.Pattern,
+		Regex:   r.MatchType == "regex",
+		Action:  contentfilter.Action(r.Action),
+	}
+}
+
+// contentCheck 一次发布涉及的全部文本（评论内容、资源标题描述与标签）的过滤结果
+type contentCheck struct {
+	filter  *contentfilter.Filter
+	action  contentfilter.Action
+	matches []contentfilter.Match
+	seen    map[int64]bool
+}
+
+func newContentCheck(ctx context.Context) *contentCheck {
+	return &contentCheck{filter: loadContentFilter(ctx), seen: make(map[int64]bool)}
+}
+
+// apply 检查一段文本，命中打码规则的部分直接在原文中替换
+func (c *contentCheck) apply(text *string) {
+	res := c.filter.Check(*text)
+	if !res.Hit() {
+		return
+	}
+	*text = res.Masked
+	c.action = contentfilter.Stricter(c.action, res.Action)
+	for _, m := range res.Matches {
+		if !c.seen[m.RuleID] {
+			c.seen[m.RuleID] = true
+			c.matches = append(c.matches, m)
+		}
+	}
+}
+
+// rejected 命中拒绝规则时记录日志并返回错误，内容不会被保存
+func (c *contentCheck) rejected(userID int64, targetType string) error {
+	if c.action != contentfilter.ActionReject {
+		return nil
+	}
+	logger.WithFields(
+		zap.Int64("user_id", userID),
+		zap.String("target_type", targetType),
+		zap.String("rules", c.describe()),
+	).Info("内容命中过滤规则，已拒绝发布")
+	return errno.ContentFilterRejectedError
+}
+
+// describe 命中规则的说明，写入审核原因
+func (c *contentCheck) describe() string {
+	parts := make([]string, 0, len(c.matches))
+	for _, m := range c.matches {
+		parts = append(parts, fmt.Sprintf("#%d「%s」(%s)", m.RuleID, m.Pattern, m.Action))
+	}
+	reason := "内容过滤命中规则：" + strings.Join(parts, "、")
+	if utf8.RuneCountInString(reason) > maxFilterReviewReasonLength {
+		reason = string([]rune(reason)[:maxFilterReviewReasonLength-1]) + "…"
+	}
+	return reason
+}
+
+// submitReview 内容保存后为命中规则的内容提交审核，命中 review 规则时先隐藏内容
+// 内容已经发布成功，这里的失败只记录日志；返回内容是否已被隐藏
+func (c *contentCheck) submitReview(ctx context.Context, targetType string, targetID int64) bool {
+	if len(c.matches) == 0 {
+		return false
+	}
+	hide := c.action == contentfilter.ActionReview
+	priority := filterMaskPriority
+	if hide {
+		priority = filterReviewPriority
+	}
+	review, err := db.CreateFilterReview(ctx, targetType, targetID, c.describe(), priority, reviewDueAt(priority, time.Now()), hide)
+	if err != nil {
+		logger.Errorf("提交内容过滤审核失败: target_type=%s target_id=%d err=%v", targetType, targetID, err)
+		return false
+	}
+	logger.WithFields(
+		zap.Int64("review_id", review.ReviewID),
+		zap.String("target_type", targetType),
+		zap.Int64("target_id", targetID),
+		zap.String("action", string(c.action)),
+	).Info("内容命中过滤规则，已提交审核")
+	return hide
+}
+
+// ContentFilterAdminService 管理端：维护内容过滤规则
+type ContentFilterAdminService struct {
+	ctx context.Context
+	c   *app.RequestContext
+}
+
+func NewContentFilterAdminService(ctx context.Context, c *app.RequestContext) *ContentFilterAdminService {
+	return &ContentFilterAdminService{ctx: ctx, c: c}
+}
+
+// validateFilterRule 校验匹配方式、处理方式与规则内容
+func validateFilterRule(pattern, matchType, action string) error {
+	if matchType != "keyword" && matchType != "regex" {
+		return errno.ContentFilterRuleInvalidError.WithMessage("匹配方式须为 keyword 或 regex")
+	}
+	if !contentfilter.ValidAction(contentfilter.Action(action)) {
+		return errno.ContentFilterRuleInvalidError.WithMessage("处理方式须为 reject、mask 或 review")
+	}
+	if err := contentfilter.ValidatePattern(pattern, matchType == "regex"); err != nil {
+		return errno.ContentFilterRuleInvalidError.WithMessage(err.Error())
+	}
+	return nil
+}
+
+func validFilterRuleDescription(description *string) bool {
+	return description == nil || utf8.RuneCountInString(*description) <= maxFilterRuleDescriptionLength
+}
+
+// ListContentFilterRules 分页查询过滤规则
+func (s *ContentFilterAdminService) ListContentFilterRules(req *audit.ListContentFilterRulesReq) ([]*model.ContentFilterRule, int64, error) {
+	if req.PageNum <= 0 {
+		req.PageNum = 1
+	}
+	if req.PageSize <= 0 || req.PageSize > 100 {
+		req.PageSize = 20
+	}
+	keyword := ""
+	if req.Keyword != nil {
+		keyword = strings.TrimSpace(*req.Keyword)
+	}
+	rules, total, err := db.ListContentFilterRules(s.ctx, req.Enabled, keyword, int(req.PageNum), int(req.PageSize))
+	if err != nil {
+		return nil, 0, err
+	}
+	list := make([]*model.ContentFilterRule, 0, len(rules))
+	for _, r := range rules {
+		list = append(list, r.ToContentFilterRuleModule())
+	}
+	return list, total, nil
+}
+
+// CreateContentFilterRule 创建过滤规则，创建后立即启用
+func (s *ContentFilterAdminService) CreateContentFilterRule(req *audit.CreateContentFilterRuleReq) (*model.ContentFilterRule, error) {
+	if err := validateFilterRule(req.Pattern, req.MatchType, req.Action); err != nil {
+		return nil, err
+	}
+	if !validFilterRuleDescription(req.Description) {
+		return nil, errno.ParamVerifyError.WithMessage("规则说明不能超过255字")
+	}
+
+	creatorID := GetUidFormContext(s.c)
+	rule := &db.ContentFilterRule{
+		Pattern:     req.Pattern,
+		MatchType:   req.MatchType,
+		Action:      req.Action,
+		Description: req.Description,
+		Enabled:     true,
+		CreatedBy:   &creatorID,
+	}
+	if err := db.CreateContentFilterRule(s.ctx, rule); err != nil {
+		return nil, err
+	}
+	invalidateContentFilter()
+	RecordAdminChange(s.c, "content_filter_rule", rule.RuleID, nil,
+		adminSnapshot(s.ctx, constants.ContentFilterRuleTableName, "rule_id", rule.RuleID))
+
+	created, err := db.GetContentFilterRule(s.ctx, rule.RuleID)
+	if err != nil {
+		return nil, err
+	}
+	return created.ToContentFilterRuleModule(), nil
+}
+
+// UpdateContentFilterRule 更新过滤规则，只修改传入的字段
+func (s *ContentFilterAdminService) UpdateContentFilterRule(req *audit.UpdateContentFilterRuleReq) (*model.ContentFilterRule, error) {
+	if req.RuleID <= 0 {
+		return nil, errno.NewErrNo(errno.ServiceInvalidParameter, "规则ID无效")
+	}
+	rule, err := db.GetContentFilterRule(s.ctx, req.RuleID)
+	if err != nil {
+		return nil, err
+	}
+
+	updates := map[string]interface{}{}
+	if req.Pattern != nil {
+		rule.Pattern = *req.Pattern
+		updates["pattern"] = rule.Pattern
+	}
+	if req.MatchType != nil {
+		rule.MatchType = *req.MatchType
+		updates["match_type"] = rule.MatchType
+	}
+	if req.Action != nil {
+		rule.Action = *req.Action
+		updates["action"] = rule.Action
+	}
+	if req.Description != nil {
+		if !validFilterRuleDescription(req.Description) {
+			return nil, errno.ParamVerifyError.WithMessage("规则说明不能超过255字")
+		}
+		updates["description"] = *req.Description
+	}
+	if req.Enabled != nil {
+		updates["enabled"] = *req.Enabled
+	}
+	if len(updates) == 0 {
+		return rule.ToContentFilterRuleModule(), nil
+	}
+	if err := validateFilterRule(rule.Pattern, rule.MatchType, rule.Action); err != nil {
+		return nil, err
+	}
+
+	before := adminSnapshot(s.ctx, constants.ContentFilterRuleTableName, "rule_id", req.RuleID)
+	if err := db.UpdateContentFilterRule(s.ctx, req.RuleID, updates); err != nil {
+		return nil, err
+	}
+	invalidateContentFilter()
+	RecordAdminChange(s.c, "content_filter_rule", req.RuleID, before,
+		adminSnapshot(s.ctx, constants.ContentFilterRuleTableName, "rule_id", req.RuleID))
+
+	updated, err := db.GetContentFilterRule(s.ctx, req.RuleID)
+	if err != nil {
+		return nil, err
+	}
+	return updated.ToContentFilterRuleModule(), nil
+}
+
+// DeleteContentFilterRule 删除过滤规则，已产生的审核记录不受影响
+func (s *ContentFilterAdminService) DeleteContentFilterRule(req *audit.DeleteContentFilterRuleReq) error {
+	if req.RuleID <= 0 {
+		return errno.NewErrNo(errno.ServiceInvalidParameter, "规则ID无效")
+	}
+	before := adminSnapshot(s.ctx, constants.ContentFilterRuleTableName, "rule_id", req.RuleID)
+	if err := db.DeleteContentFilterRule(s.ctx, req.RuleID); err != nil {
+		return err
+	}
+	invalidateContentFilter()
+	RecordAdminChange(s.c, "content_filter_rule", req.RuleID, before, nil)
+	return nil
+}
+
+// TestContentFilter 用当前启用的规则检查文本，返回最严格的处理方式（未命中时为空）、命中记录与打码后的文本
+func (s *ContentFilterAdminService) TestContentFilter(req *audit.TestContentFilterReq) (string, []*model.ContentFilterMatch, string, error) {
+	if strings.TrimSpace(req.Text) == "" {
+		return "", nil, "", errno.ParamVerifyError.WithMessage("待检查的文本不能为空")
+	}
+	// 总是重新加载，便于修改规则后立即验证效果
+	invalidateContentFilter()
+	res := loadContentFilter(s.ctx).Check(req.Text)
+	matches := make([]*model.ContentFilterMatch, 0, len(res.Matches))
+	for _, m := range res.Matches {
+		matches = append(matches, &model.ContentFilterMatch{
+			RuleID:  m.RuleID,
+			Pattern: m.Pattern,
+			Action:  string(m.Action),
+			Text:    m.Text,
+		})
+	}
+	return string(res.Action), matches, res.Masked, nil
+}
@@ -4,6 +4,7 @@ import (
 	"LearnShare/biz/dal/db"
 	"LearnShare/biz/model/course"
 	"LearnShare/biz/model/module"
+	"LearnShare/pkg/constants"
 	"LearnShare/pkg/errno"
 	"context"
 	"strings"
@@ -158,6 +159,13 @@ func (s *CourseService) SubmitCourseComment(req *course.SubmitCourseCommentReq)
 		parentIDPtr = &req.ParentID
 	}
 
+	// 内容过滤：命中拒绝规则时不保存，打码规则直接替换原文
+	check := newContentCheck(s.ctx)
+	check.apply(&req.Contents)
+	if err := check.rejected(userID, "course_comment"); err != nil {
+		return err
+	}
+
 	comment := &db.CourseComment{
 		CourseID:  req.CourseID,
 		UserID:    userID,
@@ -171,6 +179,7 @@ func (s *CourseService) SubmitCourseComment(req *course.SubmitCourseCommentReq)
 	if err := <-errChan; err != nil {
 		return errno.NewErrNo(errno.InternalDatabaseErrorCode, "提交评论失败: "+err.Error())
 	}
+	check.submitReview(s.ctx, "course_comment", comment.CommentID)
 
 	return nil
 }
@@ -214,29 +223,60 @@ func (s *CourseService) ReactCourseComment(commentID int64, action string) error
 	return nil
 }
 
-// AdminDeleteCourse 管理员硬删除课程（包括关联资源、评论、评分等）
+// AdminDeleteCourse 管理员删除课程（课程下的资源一并删除），保留期内可恢复
 func (s *CourseService) AdminDeleteCourse(req *course.AdminDeleteCourseReq) error {
 	if req.CourseID <= 0 {
 		return errno.NewErrNo(errno.ServiceInvalidParameter, "课程ID无效")
 	}
 
-	// 调用数据库层执行硬删除（需确保 db.AdminDeleteCourse 已实现级联删除或事务清理）
+	before := adminSnapshot(s.ctx, constants.CourseTableName, "course_id", req.CourseID)
 	if err := db.AdminDeleteCourse(s.ctx, req.CourseID); err != nil {
 		return err
 	}
+	RecordAdminChange(s.c, "course", req.CourseID, before, nil)
+
+	return nil
+}
+
+// AdminRestoreCourse 管理员恢复保留期内已删除的课程，以及随课程一起删除的资源
+func (s *CourseService) AdminRestoreCourse(req *course.AdminRestoreCourseReq) error {
+	if req.CourseID <= 0 {
+		return errno.NewErrNo(errno.ServiceInvalidParameter, "课程ID无效")
+	}
 
+	before := adminSnapshot(s.ctx, constants.CourseTableName, "course_id", req.CourseID)
+	if err := db.RestoreCourse(s.ctx, req.CourseID); err != nil {
+		return err
+	}
+	RecordAdminChange(s.c, "course", req.CourseID, before, adminSnapshot(s.ctx, constants.CourseTableName, "course_id", req.CourseID))
 	return nil
 }
 
-// AdminDeleteCourseComment 管理员硬删除课程评论
+// AdminDeleteCourseComment 管理员删除课程评论，保留期内可恢复
 func (s *CourseService) AdminDeleteCourseComment(req *course.AdminDeleteCourseCommentReq) error {
 	if req.CommentID <= 0 {
 		return errno.NewErrNo(errno.ServiceInvalidParameter, "评论ID无效")
 	}
 
+	before := adminSnapshot(s.ctx, constants.CourseCommentTableName, "comment_id", req.CommentID)
 	if err := db.AdminDeleteCourseComment(s.ctx, req.CommentID); err != nil {
 		return err
 	}
+	RecordAdminChange(s.c, "course_comment", req.CommentID, before, nil)
+	return nil
+}
+
+// AdminRestoreCourseComment 管理员恢复被用户或管理员删除的课程评论
+func (s *CourseService) AdminRestoreCourseComment(req *course.AdminRestoreCourseCommentReq) error {
+	if req.CommentID <= 0 {
+		return errno.NewErrNo(errno.ServiceInvalidParameter, "评论ID无效")
+	}
+
+	before := adminSnapshot(s.ctx, constants.CourseCommentTableName, "comment_id", req.CommentID)
+	if err := db.RestoreDeletedComment(s.ctx, "course_comment", req.CommentID); err != nil {
+		return err
+	}
+	RecordAdminChange(s.c, "course_comment", req.CommentID, before, adminSnapshot(s.ctx, constants.CourseCommentTableName, "comment_id", req.CommentID))
 	return nil
 }
 
@@ -246,8 +286,32 @@ func (s *CourseService) AdminDeleteCourseRating(req *course.AdminDeleteCourseRat
 		return errno.NewErrNo(errno.ServiceInvalidParameter, "评分ID无效")
 	}
 
+	before := adminSnapshot(s.ctx, constants.CourseRatingTableName, "rating_id", req.RatingID)
 	if err := db.AdminDeleteCourseRating(s.ctx, req.RatingID); err != nil {
 		return err
 	}
+	RecordAdminChange(s.c, "course_rating", req.RatingID, before, nil)
 	return nil
 }
+
+// ReportCourseRating 举报一条课程评分
+func (s *CourseService) ReportCourseRating(req *course.ReportCourseRatingReq) error {
+	if req.RatingID <= 0 {
+		return errno.NewErrNo(errno.ServiceInvalidParameter, "评分ID无效")
+	}
+	if err := validateReportReason(req.Reason); err != nil {
+		return err
+	}
+	return reportContent(s.ctx, GetUidFormContext(s.c), "course_rating", req.RatingID, req.Reason)
+}
+
+// ReportCourseComment 举报一条课程评论
+func (s *CourseService) ReportCourseComment(req *course.ReportCourseCommentReq) error {
+	if req.CommentID <= 0 {
+		return errno.NewErrNo(errno.ServiceInvalidParameter, "评论ID无效")
+	}
+	if err := validateReportReason(req.Reason); err != nil {
+		return err
+	}
+	return reportContent(s.ctx, GetUidFormContext(s.c), "course_comment", req.CommentID, req.Reason)
+}
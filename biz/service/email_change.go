@@ -0,0 +1,108 @@
+package service
+
+import (
+	"LearnShare/biz/dal/db"
+	"LearnShare/biz/dal/redis"
+	"LearnShare/biz/model/user"
+	"LearnShare/config"
+	"LearnShare/pkg/errno"
+	"LearnShare/pkg/logger"
+	"LearnShare/pkg/utils"
+	"crypto/rand"
+	"encoding/hex"
+	"net/url"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	defaultEmailRevertTTL = 72 * time.Hour
+	// sessionRevokeTTL 会话吊销标记的保留时长，与刷新令牌的有效期一致
+	sessionRevokeTTL = 72 * time.Hour
+)
+
+// checkEmailCode 校验发送到指定邮箱的验证码
+func (s *UserService) checkEmailCode(email, code string) error {
+	if !redis.IsKeyExist(s.ctx, email) {
+		return errno.UserVerificationCodeExpiredError.WithMessage("邮箱 " + logger.MaskEmail(email) + " 的验证码已过期")
+	}
+	storeCode, err := redis.GetCodeCache(s.ctx, email)
+	if err != nil {
+		return err
+	}
+	if storeCode != code {
+		return errno.UserVerificationCodeInvalidError.WithMessage("邮箱 " + logger.MaskEmail(email) + " 的验证码不正确")
+	}
+	return nil
+}
+
+// sendEmailChangeNotice 向旧邮箱发送带撤销链接的变更通知，依赖 SMTP 配置，测试中可替换
+var sendEmailChangeNotice = utils.MailSendEmailChangeNotice
+
+// sendEmailRevertLink 生成撤销令牌并将撤销链接发送到旧邮箱
+func (s *UserService) sendEmailRevertLink(userID int64, oldEmail, newEmail string) error {
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return errno.NewErrNo(errno.InternalServiceErrorCode, "生成撤销令牌失败: "+err.Error())
+	}
+	token := hex.EncodeToString(raw)
+
+	ttl := defaultEmailRevertTTL
+	revertURL := ""
+	if cfg := config.EmailChange; cfg != nil {
+		if cfg.RevertTTLHours > 0 {
+			ttl = time.Duration(cfg.RevertTTLHours) * time.Hour
+		}
+		revertURL = cfg.RevertURL
+	}
+
+	err := redis.SetEmailRevert(s.ctx, token, &redis.EmailRevert{
+		UserID:   userID,
+		OldEmail: oldEmail,
+		NewEmail: newEmail,
+	}, ttl)
+	if err != nil {
+		return err
+	}
+
+	link := revertURL + "?token=" + url.QueryEscape(token)
+	return sendEmailChangeNotice(oldEmail, newEmail, link, ttl)
+}
+
+// RevertEmailChange 通过旧邮箱收到的链接撤销邮箱修改，恢复旧邮箱并强制所有会话下线
+func (s *UserService) RevertEmailChange(req *user.RevertEmailChangeReq) error {
+	revert, err := redis.TakeEmailRevert(s.ctx, req.Token)
+	if err != nil {
+		return err
+	}
+	if revert == nil {
+		return errno.UserEmailRevertInvalidError
+	}
+
+	userInfo, err := db.GetUserByID(s.ctx, revert.UserID)
+	if err != nil {
+		return err
+	}
+	if userInfo.Email != revert.OldEmail {
+		ip := s.clientIP()
+		if err := db.ChangeUserEmail(s.ctx, revert.UserID, userInfo.Email, revert.OldEmail, "revert", &ip); err != nil {
+			return err
+		}
+	}
+
+	if err := revokeUserCredentials(s.ctx, revert.UserID, time.Now()); err != nil {
+		return err
+	}
+	_ = redis.DeleteUserInfoCache(s.ctx, strconv.FormatInt(revert.UserID, 10))
+
+	logger.WithFields(
+		zap.String("audit", "email_revert"),
+		zap.Int64("user_id", revert.UserID),
+		zap.String("restored_email", logger.MaskEmail(revert.OldEmail)),
+		zap.String("reverted_email", logger.MaskEmail(userInfo.Email)),
+		zap.String("ip", s.clientIP()),
+	).Warn("用户通过旧邮箱撤销了邮箱修改，已强制所有会话下线")
+	return nil
+}
@@ -0,0 +1,279 @@
+package service
+
+import (
+	"LearnShare/biz/dal/db"
+	"LearnShare/biz/dal/redis"
+	"LearnShare/biz/model/leaderboard"
+	model "LearnShare/biz/model/module"
+	"LearnShare/pkg/errno"
+	"LearnShare/pkg/logger"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// leaderboardBoards 排行榜类型：uploads 上传资源数，reputation 信誉分
+var leaderboardBoards = []string{"uploads", "reputation"}
+
+func validLeaderboardBoard(board string) bool {
+	for _, b := range leaderboardBoards {
+		if b == board {
+			return true
+		}
+	}
+	return false
+}
+
+// leaderboardPeriod 排行榜统计周期，id 写入排行榜键，周期切换后自然使用新的排行榜
+type leaderboardPeriod struct {
+	name string
+	id   string
+	ttl  time.Duration
+}
+
+// score 取用户在该周期的分数
+func (p leaderboardPeriod) score(s *db.LeaderboardUserScore) int64 {
+	switch p.name {
+	case "week":
+		return s.Week
+	case "month":
+		return s.Month
+	default:
+		return s.Total
+	}
+}
+
+// currentLeaderboardPeriods 返回 now 所在的周（周一开始）与月的起始时间及全部统计周期
+// 周榜与月榜在周期结束后保留一段时间再过期，总榜不过期
+func currentLeaderboardPeriods(now time.Time) (time.Time, time.Time, []leaderboardPeriod) {
+	day := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	weekStart := day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+	monthStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+	return weekStart, monthStart, []leaderboardPeriod{
+		{name: "week", id: "w" + weekStart.Format("20060102"), ttl: 14 * 24 * time.Hour},
+		{name: "month", id: "m" + monthStart.Format("200601"), ttl: 62 * 24 * time.Hour},
+		{name: "all", id: "all"},
+	}
+}
+
+func collegeLeaderboardScope(collegeID int64) string {
+	return fmt.Sprintf("college:%d", collegeID)
+}
+
+func majorLeaderboardScope(majorID int64) string {
+	return fmt.Sprintf("major:%d", majorID)
+}
+
+// leaderboardScopes 用户所在的排行榜范围：全站、所属学院、所属专业
+func leaderboardScopes(collegeID, majorID *int64) []string {
+	scopes := []string{"all"}
+	if collegeID != nil && *collegeID > 0 {
+		scopes = append(scopes, collegeLeaderboardScope(*collegeID))
+	}
+	if majorID != nil && *majorID > 0 {
+		scopes = append(scopes, majorLeaderboardScope(*majorID))
+	}
+	return scopes
+}
+
+// RefreshUserLeaderboards 从业务表重新统计用户在全部排行榜上的分数并写入 Redis，可重复执行
+// 已封禁、未激活或分数不大于 0 的用户移出排行榜；staleScopes 为用户已离开的范围（如修改前的专业），一并移出
+func RefreshUserLeaderboards(ctx context.Context, userID int64, staleScopes ...string) error {
+	u, err := db.GetUserByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	weekStart, monthStart, periods := currentLeaderboardPeriods(time.Now())
+	scopes := leaderboardScopes(u.CollegeID, u.MajorID)
+
+	var scores []redis.LeaderboardScore
+	for _, board := range leaderboardBoards {
+		rows, err := db.ListLeaderboardScores(ctx, board, weekStart, monthStart, userID)
+		if err != nil {
+			return err
+		}
+		current := &db.LeaderboardUserScore{UserID: userID}
+		if len(rows) > 0 {
+			current = rows[0]
+		}
+		for _, p := range periods {
+			for _, scope := range scopes {
+				scores = append(scores, redis.LeaderboardScore{
+					Key:   redis.LeaderboardKey(board, p.id, scope),
+					Score: p.score(current),
+					TTL:   p.ttl,
+				})
+			}
+			for _, scope := range staleScopes {
+				scores = append(scores, redis.LeaderboardScore{Key: redis.LeaderboardKey(board, p.id, scope)})
+			}
+		}
+	}
+	return redis.SetLeaderboardScores(ctx, userID, scores)
+}
+
+// RebuildLeaderboards 从业务表重新统计全部用户并整体替换当前周期的排行榜，返回写入的排行榜数量
+// 定时任务与 `leaderboards rebuild` 命令共用，用于首次上线、Redis 数据丢失或修正增量更新遗漏的变化
+func RebuildLeaderboards(ctx context.Context) (int, error) {
+	weekStart, monthStart, periods := currentLeaderboardPeriods(time.Now())
+	snapshots := make(map[string]*redis.LeaderboardSnapshot)
+	for _, board := range leaderboardBoards {
+		rows, err := db.ListLeaderboardScores(ctx, board, weekStart, monthStart)
+		if err != nil {
+			return 0, err
+		}
+		for _, row := range rows {
+			for _, p := range periods {
+				score := p.score(row)
+				if score <= 0 {
+					continue
+				}
+				for _, scope := range leaderboardScopes(row.CollegeID, row.MajorID) {
+					key := redis.LeaderboardKey(board, p.id, scope)
+					snapshot, ok := snapshots[key]
+					if !ok {
+						snapshot = &redis.LeaderboardSnapshot{Key: key, TTL: p.ttl, Scores: make(map[int64]int64)}
+						snapshots[key] = snapshot
+					}
+					snapshot.Scores[row.UserID] = score
+				}
+			}
+		}
+	}
+
+	list := make([]*redis.LeaderboardSnapshot, 0, len(snapshots))
+	for _, snapshot := range snapshots {
+		list = append(list, snapshot)
+	}
+	if err := redis.ReplaceLeaderboards(ctx, list); err != nil {
+		return 0, err
+	}
+	return len(list), nil
+}
+
+// refreshLeaderboards 信誉分或已发布资源变化后异步刷新用户的排行榜分数
+// 排行榜只用于展示，失败只记录日志，由定时重建修正
+func refreshLeaderboards(userID int64, staleScopes ...string) {
+	go func() {
+		if err := RefreshUserLeaderboards(context.Background(), userID, staleScopes...); err != nil {
+			logger.Warnf("刷新排行榜失败: user_id=%d err=%v", userID, err)
+		}
+	}()
+}
+
+// refreshResourceUploaderLeaderboards 资源状态变化（审核、删除、恢复）后异步刷新上传者的排行榜分数
+func refreshResourceUploaderLeaderboards(resourceID int64) {
+	go func() {
+		ctx := context.Background()
+		uploaderID, err := db.GetReviewContentOwner(ctx, "resource", resourceID)
+		if err == nil {
+			err = RefreshUserLeaderboards(ctx, uploaderID)
+		}
+		if err != nil {
+			logger.Warnf("刷新资源上传者排行榜失败: resource_id=%d err=%v", resourceID, err)
+		}
+	}()
+}
+
+// refreshReviewedLeaderboards 举报审核完成后，被举报对象为资源时刷新上传者的排行榜分数
+func refreshReviewedLeaderboards(ctx context.Context, reviewID int64) {
+	review, err := db.GetReviewByID(ctx, reviewID)
+	if err != nil {
+		logger.Warnf("查询举报记录失败，跳过排行榜刷新: review_id=%d err=%v", reviewID, err)
+		return
+	}
+	if review.TargetType == "resource" {
+		refreshResourceUploaderLeaderboards(review.TargetID)
+	}
+}
+
+// LeaderboardService 上传与信誉分排行榜
+type LeaderboardService struct {
+	ctx context.Context
+	c   *app.RequestContext
+}
+
+func NewLeaderboardService(ctx context.Context, c *app.RequestContext) *LeaderboardService {
+	return &LeaderboardService{ctx: ctx, c: c}
+}
+
+// GetLeaderboard 分页查询排行榜，同时返回当前用户在该榜上的名次（未上榜时为 nil）
+// 同时指定学院与专业时按专业筛选
+func (s *LeaderboardService) GetLeaderboard(req *leaderboard.GetLeaderboardReq) ([]*model.LeaderboardEntry, int64, *model.LeaderboardEntry, error) {
+	if !validLeaderboardBoard(req.Board) {
+		return nil, 0, nil, errno.ParamVerifyError.WithMessage("排行榜类型须为 uploads 或 reputation")
+	}
+	periodName := req.GetPeriod()
+	if periodName == "" {
+		periodName = "all"
+	}
+	_, _, periods := currentLeaderboardPeriods(time.Now())
+	var period *leaderboardPeriod
+	for i := range periods {
+		if periods[i].name == periodName {
+			period = &periods[i]
+		}
+	}
+	if period == nil {
+		return nil, 0, nil, errno.ParamVerifyError.WithMessage("统计周期须为 week、month 或 all")
+	}
+	scope := "all"
+	switch {
+	case req.GetMajorID() > 0:
+		scope = majorLeaderboardScope(req.GetMajorID())
+	case req.GetCollegeID() > 0:
+		scope = collegeLeaderboardScope(req.GetCollegeID())
+	}
+	if req.PageNum <= 0 {
+		req.PageNum = 1
+	}
+	if req.PageSize <= 0 || req.PageSize > 100 {
+		req.PageSize = 20
+	}
+
+	key := redis.LeaderboardKey(req.Board, period.id, scope)
+	entries, total, err := redis.GetLeaderboardPage(s.ctx, key, int64(req.PageSize)*int64(req.PageNum-1), int64(req.PageSize))
+	if err != nil {
+		return nil, 0, nil, err
+	}
+	mine, err := redis.GetLeaderboardRank(s.ctx, key, GetUidFormContext(s.c))
+	if err != nil {
+		return nil, 0, nil, err
+	}
+
+	userIDs := make([]int64, 0, len(entries)+1)
+	for _, e := range entries {
+		userIDs = append(userIDs, e.UserID)
+	}
+	if mine != nil {
+		userIDs = append(userIDs, mine.UserID)
+	}
+	users, err := db.GetUsersByIDs(s.ctx, userIDs)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+
+	list := make([]*model.LeaderboardEntry, 0, len(entries))
+	for _, e := range entries {
+		list = append(list, toLeaderboardEntryModule(e, users[e.UserID]))
+	}
+	var myEntry *model.LeaderboardEntry
+	if mine != nil {
+		myEntry = toLeaderboardEntryModule(mine, users[mine.UserID])
+	}
+	return list, total, myEntry, nil
+}
+
+// toLeaderboardEntryModule 组装排行榜条目，用户已被删除时只返回ID与分数
+func toLeaderboardEntryModule(e *redis.LeaderboardEntry, u *db.User) *model.LeaderboardEntry {
+	entry := &model.LeaderboardEntry{Rank: e.Rank, UserID: e.UserID, Score: e.Score}
+	if u != nil {
+		entry.Username = u.Username
+		if u.AvatarURL != nil {
+			entry.AvatarURL = *u.AvatarURL
+		}
+	}
+	return entry
+}
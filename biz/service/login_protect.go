@@ -0,0 +1,222 @@
+package service
+
+import (
+	"LearnShare/biz/dal/db"
+	"LearnShare/biz/dal/redis"
+	"LearnShare/config"
+	"LearnShare/pkg/errno"
+	"LearnShare/pkg/logger"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// lockLevelRetention 锁定级别的保留时长，期间再次被锁定时锁定时长翻倍
+const lockLevelRetention = 24 * time.Hour
+
+// clientIP 获取请求方IP，无请求上下文时返回空串
+func (s *UserService) clientIP() string {
+	if s.c == nil {
+		return ""
+	}
+	return s.c.ClientIP()
+}
+
+// checkLoginIP IP因失败次数过多被临时锁定且未到期时拒绝登录
+func (s *UserService) checkLoginIP(ip string) error {
+	cfg := config.LoginProtect
+	if cfg == nil || cfg.IPMaxAttempts <= 0 || ip == "" {
+		return nil
+	}
+
+	lock, err := redis.GetLoginIPLock(s.ctx, ip)
+	if err != nil {
+		return err
+	}
+	if lock == nil {
+		return nil
+	}
+	remaining := time.Until(time.Unix(lock.Until, 0))
+	if remaining <= 0 {
+		return nil
+	}
+	return errno.UserLoginTooFrequentError.WithMessage(fmt.Sprintf(
+		"登录失败次数过多，请 %d 分钟后重试", int(remaining.Minutes())+1,
+	))
+}
+
+// checkAccountLock 锁定未到期时拒绝登录，到期后自动恢复锁定前的状态
+func (s *UserService) checkAccountLock(userInfo *db.User) error {
+	if userInfo.Status != "locked" {
+		return nil
+	}
+
+	lock, err := redis.GetLoginLock(s.ctx, userInfo.UserID)
+	if err != nil {
+		return err
+	}
+	// 没有临时锁定记录说明是管理员手动锁定
+	if lock == nil {
+		return errno.UserAccountLockedError.WithMessage("账户已被锁定，请联系管理员")
+	}
+
+	remaining := time.Until(time.Unix(lock.Until, 0))
+	if remaining > 0 {
+		return errno.UserAccountLockedError.WithMessage(fmt.Sprintf(
+			"登录失败次数过多，账户已被临时锁定，请 %d 分钟后重试或通过邮箱验证码解锁",
+			int(remaining.Minutes())+1,
+		))
+	}
+
+	if err := db.UpdateUserStatues(s.ctx, userInfo.UserID, lock.PrevStatus); err != nil {
+		return err
+	}
+	userInfo.Status = lock.PrevStatus
+	return nil
+}
+
+// onLoginFailure 记录失败次数，账户或IP在窗口内失败达到阈值时临时锁定
+func (s *UserService) onLoginFailure(email, ip string, userInfo *db.User, reason string) error {
+	var userID int64
+	if userInfo != nil {
+		userID = userInfo.UserID
+	}
+	recordLoginAttempt(email, ip, userID, false, reason)
+
+	cfg := config.LoginProtect
+	if cfg == nil || cfg.WindowSeconds <= 0 {
+		return nil
+	}
+
+	accountCount, ipCount, err := redis.IncrLoginFail(s.ctx, email, ip, time.Duration(cfg.WindowSeconds)*time.Second)
+	if err != nil {
+		return err
+	}
+
+	var ipErr error
+	if ip != "" && cfg.IPMaxAttempts > 0 && ipCount >= int64(cfg.IPMaxAttempts) {
+		duration, err := s.lockIP(ip)
+		if err != nil {
+			return err
+		}
+		ipErr = errno.UserLoginTooFrequentError.WithMessage(fmt.Sprintf(
+			"登录失败次数过多，请 %d 分钟后重试", int(duration.Minutes()),
+		))
+	}
+
+	if userInfo == nil || cfg.MaxAttempts <= 0 || accountCount < int64(cfg.MaxAttempts) {
+		return ipErr
+	}
+
+	duration, err := s.lockAccount(email, userInfo)
+	if err != nil {
+		return err
+	}
+	return errno.UserAccountLockedError.WithMessage(fmt.Sprintf(
+		"登录失败次数过多，账户已被临时锁定 %d 分钟，可通过邮箱验证码解锁",
+		int(duration.Minutes()),
+	))
+}
+
+// lockDuration 第 level 次连续锁定的时长：首次为 lock_seconds，之后每次翻倍，不超过 max_lock_seconds
+func lockDuration(level int) time.Duration {
+	cfg := config.LoginProtect
+	duration := time.Duration(cfg.LockSeconds) * time.Second
+	maxDuration := time.Duration(cfg.MaxLockSeconds) * time.Second
+	for i := 1; i < level && (maxDuration <= 0 || duration < maxDuration); i++ {
+		duration *= 2
+	}
+	if maxDuration > 0 && duration > maxDuration {
+		duration = maxDuration
+	}
+	return duration
+}
+
+// lockAccount 临时锁定账户，锁定时长随连续锁定次数指数增长
+func (s *UserService) lockAccount(email string, userInfo *db.User) (time.Duration, error) {
+	prev, err := redis.GetLoginLock(s.ctx, userInfo.UserID)
+	if err != nil {
+		return 0, err
+	}
+	lock := &redis.LoginLock{PrevStatus: userInfo.Status, Level: 1}
+	if prev != nil {
+		lock.Level = prev.Level + 1
+		if userInfo.Status == "locked" {
+			lock.PrevStatus = prev.PrevStatus
+		}
+	}
+
+	duration := lockDuration(lock.Level)
+	lock.Until = time.Now().Add(duration).Unix()
+
+	if err := redis.SetLoginLock(s.ctx, userInfo.UserID, lock, duration+lockLevelRetention); err != nil {
+		return 0, err
+	}
+	if err := db.UpdateUserStatues(s.ctx, userInfo.UserID, "locked"); err != nil {
+		return 0, err
+	}
+	if err := redis.ClearLoginFailAccount(s.ctx, email); err != nil {
+		return 0, err
+	}
+
+	logger.WithFields(
+		zap.String("audit", "account_lock"),
+		zap.Int64("user_id", userInfo.UserID),
+		zap.String("email", logger.MaskEmail(email)),
+		zap.Int("level", lock.Level),
+		zap.Duration("duration", duration),
+	).Warn("登录失败次数过多，账户已临时锁定")
+	return duration, nil
+}
+
+// lockIP 临时禁止IP登录，与账户锁定相同，锁定时长随连续锁定次数指数增长
+func (s *UserService) lockIP(ip string) (time.Duration, error) {
+	prev, err := redis.GetLoginIPLock(s.ctx, ip)
+	if err != nil {
+		return 0, err
+	}
+	lock := &redis.LoginLock{Level: 1}
+	if prev != nil {
+		lock.Level = prev.Level + 1
+	}
+
+	duration := lockDuration(lock.Level)
+	lock.Until = time.Now().Add(duration).Unix()
+
+	if err := redis.SetLoginIPLock(s.ctx, ip, lock, duration+lockLevelRetention); err != nil {
+		return 0, err
+	}
+	if err := redis.ClearLoginFailIP(s.ctx, ip); err != nil {
+		return 0, err
+	}
+
+	logger.WithFields(
+		zap.String("audit", "ip_lock"),
+		zap.String("ip", ip),
+		zap.Int("level", lock.Level),
+		zap.Duration("duration", duration),
+	).Warn("IP登录失败次数过多，已临时禁止登录")
+	return duration, nil
+}
+
+// onLoginSuccess 登录成功后清除账户失败计数，未启用登录保护时没有计数需要清除
+func (s *UserService) onLoginSuccess(email, ip string, userID int64) error {
+	recordLoginAttempt(email, ip, userID, true, "")
+	if cfg := config.LoginProtect; cfg == nil || cfg.WindowSeconds <= 0 {
+		return nil
+	}
+	return redis.ClearLoginFailAccount(s.ctx, email)
+}
+
+// recordLoginAttempt 记录登录尝试，供安全审计
+func recordLoginAttempt(email, ip string, userID int64, success bool, reason string) {
+	logger.WithFields(
+		zap.String("audit", "login_attempt"),
+		zap.String("email", logger.MaskEmail(email)),
+		zap.String("ip", ip),
+		zap.Int64("user_id", userID),
+		zap.Bool("success", success),
+		zap.String("reason", reason),
+	).Info("登录尝试")
+}
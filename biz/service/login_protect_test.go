@@ -0,0 +1,102 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	redisDal "LearnShare/biz/dal/redis"
+	"LearnShare/config"
+	"LearnShare/pkg/errno"
+)
+
+func withLoginProtect(t *testing.T) {
+	t.Helper()
+	withTestConfig(t, &config.LoginProtect, map[string]interface{}{
+		"MaxAttempts":    100,
+		"IPMaxAttempts":  2,
+		"WindowSeconds":  900,
+		"LockSeconds":    60,
+		"MaxLockSeconds": 3600,
+	})
+}
+
+func assertLoginTooFrequent(t *testing.T, err error) {
+	t.Helper()
+	var errNo errno.ErrNo
+	if !errors.As(err, &errNo) || errNo.ErrorCode != errno.UserLoginTooFrequent {
+		t.Fatalf("预期返回登录过于频繁错误, 实际为 %v", err)
+	}
+}
+
+// TestLoginIPProgressiveLock 同一IP失败达到阈值后临时锁定，再次被锁定时锁定时长翻倍
+func TestLoginIPProgressiveLock(t *testing.T) {
+	_, cleanupRedis := setupTestRedis(t)
+	defer cleanupRedis()
+	withLoginProtect(t)
+
+	ctx := context.Background()
+	svc := NewUserService(ctx, nil)
+	const ip = "10.0.0.1"
+
+	if err := svc.onLoginFailure("a@example.com", ip, nil, "用户不存在"); err != nil {
+		t.Fatalf("未达到阈值时不应锁定IP: %v", err)
+	}
+	assertLoginTooFrequent(t, svc.onLoginFailure("b@example.com", ip, nil, "用户不存在"))
+	assertLoginTooFrequent(t, svc.checkLoginIP(ip))
+	if err := svc.checkLoginIP("10.0.0.2"); err != nil {
+		t.Fatalf("其他IP不应受影响: %v", err)
+	}
+
+	lock, err := redisDal.GetLoginIPLock(ctx, ip)
+	if err != nil || lock == nil || lock.Level != 1 {
+		t.Fatalf("首次锁定记录不正确: lock=%+v err=%v", lock, err)
+	}
+	if d := time.Until(time.Unix(lock.Until, 0)); d <= 0 || d > time.Minute {
+		t.Fatalf("首次锁定时长应为 lock_seconds, 实际剩余 %v", d)
+	}
+
+	// 锁定到期后允许登录，再次失败达到阈值时锁定时长翻倍
+	lock.Until = time.Now().Add(-time.Second).Unix()
+	if err := redisDal.SetLoginIPLock(ctx, ip, lock, time.Hour); err != nil {
+		t.Fatalf("写入锁定记录失败: %v", err)
+	}
+	if err := svc.checkLoginIP(ip); err != nil {
+		t.Fatalf("锁定到期后应允许登录: %v", err)
+	}
+	if err := svc.onLoginFailure("a@example.com", ip, nil, "用户不存在"); err != nil {
+		t.Fatalf("锁定后失败计数应当重新开始: %v", err)
+	}
+	assertLoginTooFrequent(t, svc.onLoginFailure("a@example.com", ip, nil, "用户不存在"))
+
+	lock, err = redisDal.GetLoginIPLock(ctx, ip)
+	if err != nil || lock == nil || lock.Level != 2 {
+		t.Fatalf("再次锁定记录不正确: lock=%+v err=%v", lock, err)
+	}
+	if d := time.Until(time.Unix(lock.Until, 0)); d <= time.Minute || d > 2*time.Minute {
+		t.Fatalf("再次锁定时长应当翻倍, 实际剩余 %v", d)
+	}
+}
+
+// TestLoginFailureWithoutIP 无法获取请求方IP时不累计IP失败次数，避免此类请求被一并锁定
+func TestLoginFailureWithoutIP(t *testing.T) {
+	server, cleanupRedis := setupTestRedis(t)
+	defer cleanupRedis()
+	withLoginProtect(t)
+
+	svc := NewUserService(context.Background(), nil)
+	for i := 0; i < 5; i++ {
+		if err := svc.onLoginFailure("a@example.com", "", nil, "用户不存在"); err != nil {
+			t.Fatalf("没有IP时不应触发IP锁定: %v", err)
+		}
+	}
+	if err := svc.checkLoginIP(""); err != nil {
+		t.Fatalf("没有IP时不应拒绝登录: %v", err)
+	}
+	for _, key := range server.Keys() {
+		if key == "login_fail:ip:" || key == "login_lock:ip:" {
+			t.Fatalf("没有IP时不应写入IP计数或锁定: %s", key)
+		}
+	}
+}
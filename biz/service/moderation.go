@@ -0,0 +1,246 @@
+package service
+
+import (
+	"LearnShare/biz/dal/db"
+	"LearnShare/biz/dal/redis"
+	"LearnShare/biz/model/audit"
+	model "LearnShare/biz/model/module"
+	"LearnShare/biz/model/user"
+	"LearnShare/pkg/constants"
+	"LearnShare/pkg/errno"
+	"LearnShare/pkg/logger"
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"go.uber.org/zap"
+)
+
+const (
+	maxModerationReasonLength = 500
+	maxReputationDeduction    = 100
+	maxMuteHours              = 720
+	appealWindowDays          = 30
+	appealPriority            = 2
+)
+
+// moderationActionNames 处罚类型及其在通知中的名称
+var moderationActionNames = map[string]string{
+	"warn":              "警告",
+	"hide_content":      "隐藏违规内容",
+	"deduct_reputation": "扣除信誉分",
+	"mute":              "禁言",
+	"ban":               "封禁账户",
+}
+
+func validModerationReason(reason string) bool {
+	reason = strings.TrimSpace(reason)
+	return reason != "" && utf8.RuneCountInString(reason) <= maxModerationReasonLength
+}
+
+// ModerationAdminService 管理端：对违规举报执行处罚并处理处罚申诉
+type ModerationAdminService struct {
+	ctx context.Context
+	c   *app.RequestContext
+}
+
+func NewModerationAdminService(ctx context.Context, c *app.RequestContext) *ModerationAdminService {
+	return &ModerationAdminService{ctx: ctx, c: c}
+}
+
+// ApplyModerationAction 对已核实违规（approved）的举报执行处罚，并通知内容发布者
+func (s *ModerationAdminService) ApplyModerationAction(req *audit.ApplyModerationActionReq) (*model.ModerationAction, error) {
+	if req.ReviewID <= 0 {
+		return nil, errno.NewErrNo(errno.ServiceInvalidParameter, "审核记录ID无效")
+	}
+	actionName, ok := moderationActionNames[req.Action]
+	if !ok {
+		return nil, errno.NewErrNo(errno.ServiceInvalidParameter, "处罚类型无效")
+	}
+	if !validModerationReason(req.Reason) {
+		return nil, errno.ParamVerifyError.WithMessage("处罚原因不能为空且不能超过500字")
+	}
+
+	review, err := db.GetReviewByID(s.ctx, req.ReviewID)
+	if err != nil {
+		return nil, err
+	}
+	if review.Level != 1 || review.Status != "approved" {
+		return nil, errno.ReviewNotApprovedError
+	}
+	ownerID, err := db.GetReviewContentOwner(s.ctx, review.TargetType, review.TargetID)
+	if err != nil {
+		return nil, err
+	}
+
+	moderatorID := GetUidFormContext(s.c)
+	action := &db.ModerationAction{
+		ReviewID:    req.ReviewID,
+		UserID:      ownerID,
+		ModeratorID: &moderatorID,
+		Action:      req.Action,
+		Reason:      strings.TrimSpace(req.Reason),
+	}
+	detail := ""
+	switch req.Action {
+	case "deduct_reputation":
+		if req.ReputationDelta == nil || *req.ReputationDelta <= 0 || *req.ReputationDelta > maxReputationDeduction {
+			return nil, errno.ParamVerifyError.WithMessage("扣除的信誉分须在1-100之间")
+		}
+		action.ReputationDelta = -int64(*req.ReputationDelta)
+		detail = fmt.Sprintf("（扣除 %d 分）", *req.ReputationDelta)
+	case "mute":
+		if req.MuteHours == nil || *req.MuteHours <= 0 || *req.MuteHours > maxMuteHours {
+			return nil, errno.ParamVerifyError.WithMessage("禁言时长须在1-720小时之间")
+		}
+		until := time.Now().Add(time.Duration(*req.MuteHours) * time.Hour)
+		action.MutedUntil = &until
+		detail = fmt.Sprintf("（至 %s）", until.Format("2006-01-02 15:04"))
+	}
+
+	notification := &db.Notification{
+		Type:  "moderation",
+		Title: "违规处理通知",
+		Content: fmt.Sprintf("你发布的内容经举报核实违规，处理结果：%s%s。原因：%s。如有异议，可在 %d 天内提起申诉。",
+			actionName, detail, action.Reason, appealWindowDays),
+	}
+	if err := db.ApplyModerationAction(s.ctx, action, notification); err != nil {
+		return nil, err
+	}
+	refreshPenalizedUser(s.ctx, action, action.Action == "ban")
+	refreshLeaderboards(action.UserID)
+	RecordAdminChange(s.c, "moderation_action", action.ActionID, nil,
+		adminSnapshot(s.ctx, constants.ModerationActionTableName, "action_id", action.ActionID))
+
+	logger.WithFields(
+		zap.Int64("action_id", action.ActionID),
+		zap.Int64("review_id", action.ReviewID),
+		zap.Int64("user_id", action.UserID),
+		zap.Int64("moderator_id", moderatorID),
+		zap.String("action", action.Action),
+	).Info("已执行违规处罚")
+	return action.ToModerationActionModule(), nil
+}
+
+// GetAppealList 分页查询待处理的处罚申诉
+func (s *ModerationAdminService) GetAppealList(req *audit.GetAppealListReq) ([]*model.Review, int64, error) {
+	if req.PageNum <= 0 {
+		req.PageNum = 1
+	}
+	if req.PageSize <= 0 || req.PageSize > 100 {
+		req.PageSize = 20
+	}
+	reviews, total, err := db.GetPendingAppeals(s.ctx, int(req.PageNum), int(req.PageSize))
+	if err != nil {
+		return nil, 0, err
+	}
+	return toReviewModules(reviews), total, nil
+}
+
+// ResolveAppeal 处理处罚申诉：approve 撤销处罚并恢复其效果，reject 维持原处罚
+// 复核人不能是原处罚人或原举报的审核员
+func (s *ModerationAdminService) ResolveAppeal(req *audit.ResolveAppealReq) error {
+	if req.ReviewID <= 0 {
+		return errno.NewErrNo(errno.ServiceInvalidParameter, "审核记录ID无效")
+	}
+	if req.Action != "approve" && req.Action != "reject" {
+		return errno.NewErrNo(errno.ServiceInvalidParameter, "操作类型无效")
+	}
+
+	overturn := req.Action == "approve"
+	notification := &db.Notification{Type: "appeal", Title: "申诉结果通知"}
+	if overturn {
+		notification.Content = "你的申诉已通过复核，原处罚已撤销。"
+	} else {
+		notification.Content = "你的申诉经复核后被驳回，维持原处罚。"
+	}
+
+	reviewerID := GetUidFormContext(s.c)
+	before := adminSnapshot(s.ctx, constants.ReviewTableName, "review_id", req.ReviewID)
+	action, err := db.ResolveAppeal(s.ctx, req.ReviewID, reviewerID, overturn, notification)
+	if err != nil {
+		return err
+	}
+	if overturn {
+		refreshPenalizedUser(s.ctx, action, false)
+		refreshLeaderboards(action.UserID)
+	}
+	RecordAdminChange(s.c, "review", req.ReviewID, before, adminSnapshot(s.ctx, constants.ReviewTableName, "review_id", req.ReviewID))
+	return nil
+}
+
+// refreshPenalizedUser 处罚或撤销影响账户状态、禁言或信誉分时清除用户信息缓存，revokeSessions 时同时吊销已签发的会话与个人访问令牌
+// 数据库已提交，这里的失败只记录日志，缓存会在过期后自然刷新
+func refreshPenalizedUser(ctx context.Context, action *db.ModerationAction, revokeSessions bool) {
+	switch action.Action {
+	case "deduct_reputation", "mute", "ban":
+	default:
+		return
+	}
+	if err := redis.DeleteUserInfoCache(ctx, strconv.FormatInt(action.UserID, 10)); err != nil {
+		logger.Warnf("清除被处罚用户缓存失败: user_id=%d err=%v", action.UserID, err)
+	}
+	if revokeSessions {
+		if err := revokeUserCredentials(ctx, action.UserID, time.Now()); err != nil {
+			logger.Warnf("吊销被封禁用户会话与个人访问令牌失败: user_id=%d err=%v", action.UserID, err)
+		}
+	}
+}
+
+// ModerationService 用户端：查看本人收到的处罚并提起申诉
+type ModerationService struct {
+	ctx context.Context
+	c   *app.RequestContext
+}
+
+func NewModerationService(ctx context.Context, c *app.RequestContext) *ModerationService {
+	return &ModerationService{ctx: ctx, c: c}
+}
+
+// ListMyModerationActions 分页查询当前用户收到的处罚
+func (s *ModerationService) ListMyModerationActions(req *user.ListMyModerationActionsReq) ([]*model.ModerationAction, int64, error) {
+	if req.PageNum <= 0 {
+		req.PageNum = 1
+	}
+	if req.PageSize <= 0 || req.PageSize > 100 {
+		req.PageSize = 20
+	}
+	actions, total, err := db.ListUserModerationActions(s.ctx, GetUidFormContext(s.c), int(req.PageNum), int(req.PageSize))
+	if err != nil {
+		return nil, 0, err
+	}
+	list := make([]*model.ModerationAction, 0, len(actions))
+	for _, a := range actions {
+		list = append(list, a.ToModerationActionModule())
+	}
+	return list, total, nil
+}
+
+// AppealModerationAction 对本人收到的处罚提起申诉，须在处罚后 30 天内，每条处罚只能申诉一次
+func (s *ModerationService) AppealModerationAction(req *user.AppealModerationActionReq) (int64, error) {
+	if req.ActionID <= 0 {
+		return 0, errno.NewErrNo(errno.ServiceInvalidParameter, "处罚记录ID无效")
+	}
+	if !validModerationReason(req.Reason) {
+		return 0, errno.ParamVerifyError.WithMessage("申诉理由不能为空且不能超过500字")
+	}
+
+	userID := GetUidFormContext(s.c)
+	action, err := db.GetModerationAction(s.ctx, req.ActionID)
+	if err != nil {
+		return 0, err
+	}
+	if action.UserID != userID {
+		return 0, errno.ModerationActionNotFoundError
+	}
+	now := time.Now()
+	if now.Sub(action.CreatedAt) > appealWindowDays*24*time.Hour {
+		return 0, errno.AppealNotAllowedError.WithMessage("已超过申诉期限")
+	}
+
+	return db.CreateAppeal(s.ctx, req.ActionID, userID, strings.TrimSpace(req.Reason), appealPriority, reviewDueAt(appealPriority, now))
+}
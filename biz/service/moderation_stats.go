@@ -0,0 +1,188 @@
+package service
+
+import (
+	"LearnShare/biz/dal/db"
+	"LearnShare/biz/model/audit"
+	model "LearnShare/biz/model/module"
+	"LearnShare/pkg/errno"
+	"context"
+	"sort"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+const (
+	defaultModerationStatsDays = 30
+	maxModerationStatsDays     = 366
+	maxModerationStatsBuckets  = 92
+	defaultModerationStatsTopN = 10
+	maxModerationStatsTopN     = 50
+)
+
+// moderationStatsIntervals 积压曲线支持的统计粒度，时间桶从开始时间起按固定长度划分
+var moderationStatsIntervals = map[string]time.Duration{
+	"day":  24 * time.Hour,
+	"week": 7 * 24 * time.Hour,
+}
+
+// ModerationStatsService 管理端：审核数据统计
+type ModerationStatsService struct {
+	ctx context.Context
+	c   *app.RequestContext
+}
+
+func NewModerationStatsService(ctx context.Context, c *app.RequestContext) *ModerationStatsService {
+	return &ModerationStatsService{ctx: ctx, c: c}
+}
+
+// GetModerationStats 统计时间范围内各类审核的积压变化、处理用时中位数、审核员处理情况以及被举报最多的用户和资源
+// 默认统计最近 30 天；处理用时与审核员统计只包含举报审核，不含申诉复核
+func (s *ModerationStatsService) GetModerationStats(req *audit.GetModerationStatsReq) (*model.ModerationStats, error) {
+	end := time.Now()
+	if req.EndTime != nil {
+		end = time.Unix(*req.EndTime, 0)
+	}
+	start := end.AddDate(0, 0, -defaultModerationStatsDays)
+	if req.StartTime != nil {
+		start = time.Unix(*req.StartTime, 0)
+	}
+	if !start.Before(end) {
+		return nil, errno.ParamVerifyError.WithMessage("开始时间必须早于结束时间")
+	}
+	if end.Sub(start) > maxModerationStatsDays*24*time.Hour {
+		return nil, errno.ParamVerifyError.WithMessage("统计范围不能超过366天")
+	}
+
+	interval := "day"
+	if req.Interval != nil && *req.Interval != "" {
+		interval = *req.Interval
+	}
+	step, ok := moderationStatsIntervals[interval]
+	if !ok {
+		return nil, errno.ParamVerifyError.WithMessage("统计粒度只能为 day 或 week")
+	}
+	buckets := int64((end.Sub(start) + step - 1) / step)
+	if buckets > maxModerationStatsBuckets {
+		return nil, errno.ParamVerifyError.WithMessage("时间桶过多，请缩小范围或改用 week 粒度")
+	}
+
+	topN := defaultModerationStatsTopN
+	if req.TopN != nil {
+		if *req.TopN <= 0 || *req.TopN > maxModerationStatsTopN {
+			return nil, errno.ParamVerifyError.WithMessage("top_n 须在1-50之间")
+		}
+		topN = int(*req.TopN)
+	}
+
+	created, decided, err := db.CountReviewBacklogDeltas(s.ctx, start, end, step)
+	if err != nil {
+		return nil, err
+	}
+	decidedCount, median, err := db.MedianDecisionSeconds(s.ctx, start, end)
+	if err != nil {
+		return nil, err
+	}
+	reviewers, err := db.GetReviewerDecisionStats(s.ctx, start, end)
+	if err != nil {
+		return nil, err
+	}
+	users, err := db.GetTopReportedUsers(s.ctx, start, end, topN)
+	if err != nil {
+		return nil, err
+	}
+	resources, err := db.GetTopReportedResources(s.ctx, start, end, topN)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &model.ModerationStats{
+		StartTime:             start.Unix(),
+		EndTime:               end.Unix(),
+		Interval:              interval,
+		Backlog:               backlogSeries(created, decided, start, end, step, buckets),
+		DecidedCount:          decidedCount,
+		MedianDecisionSeconds: median,
+		Reviewers:             make([]*model.ReviewerPerformance, 0, len(reviewers)),
+		TopReportedUsers:      make([]*model.ReportedUser, 0, len(users)),
+		TopReportedResources:  make([]*model.ReportedResource, 0, len(resources)),
+	}
+	for _, r := range reviewers {
+		var rate float64
+		if r.Decided > 0 {
+			rate = float64(r.Approved) / float64(r.Decided)
+		}
+		stats.Reviewers = append(stats.Reviewers, &model.ReviewerPerformance{
+			ReviewerID:         r.ReviewerID,
+			Username:           r.Username,
+			Decided:            r.Decided,
+			Approved:           r.Approved,
+			Rejected:           r.Rejected,
+			ApprovalRate:       rate,
+			AvgDecisionSeconds: int64(r.AvgDecisionSeconds),
+		})
+	}
+	for _, u := range users {
+		stats.TopReportedUsers = append(stats.TopReportedUsers, &model.ReportedUser{
+			UserID:      u.UserID,
+			Username:    u.Username,
+			ReportCount: u.ReportCount,
+			ReviewCount: u.ReviewCount,
+		})
+	}
+	for _, r := range resources {
+		stats.TopReportedResources = append(stats.TopReportedResources, &model.ReportedResource{
+			ResourceID:   r.ResourceID,
+			ResourceName: r.ResourceName,
+			ReportCount:  r.ReportCount,
+			ReviewCount:  r.ReviewCount,
+		})
+	}
+	return stats, nil
+}
+
+// backlogSeries 将各时间桶的提交与处理数量累加为每个桶结束时刻的积压数量
+func backlogSeries(created, decided []*db.BacklogDelta, start, end time.Time, step time.Duration, buckets int64) []*model.ModerationBacklogPoint {
+	// delta[类型][桶+1]，下标 0 为开始时间之前的累计值
+	delta := make(map[string][]int64)
+	add := func(rows []*db.BacklogDelta, sign int64) {
+		for _, row := range rows {
+			if row.Bucket < -1 || row.Bucket >= buckets {
+				continue
+			}
+			if delta[row.TargetType] == nil {
+				delta[row.TargetType] = make([]int64, buckets+1)
+			}
+			delta[row.TargetType][row.Bucket+1] += sign * row.Count
+		}
+	}
+	add(created, 1)
+	add(decided, -1)
+
+	types := make([]string, 0, len(delta))
+	for t := range delta {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+
+	points := make([]*model.ModerationBacklogPoint, 0, int64(len(types))*buckets)
+	pending := make([]int64, len(types))
+	for k := int64(0); k < buckets; k++ {
+		at := start.Add(time.Duration(k+1) * step)
+		if at.After(end) {
+			at = end
+		}
+		for i, t := range types {
+			if k == 0 {
+				pending[i] = delta[t][0]
+			}
+			pending[i] += delta[t][k+1]
+			points = append(points, &model.ModerationBacklogPoint{
+				Time:       at.Unix(),
+				TargetType: t,
+				Pending:    pending[i],
+			})
+		}
+	}
+	return points
+}
@@ -0,0 +1,45 @@
+package service
+
+import (
+	"LearnShare/biz/dal/db"
+	model "LearnShare/biz/model/module"
+	"LearnShare/biz/model/user"
+	"context"
+
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// NotificationService 站内通知
+type NotificationService struct {
+	ctx context.Context
+	c   *app.RequestContext
+}
+
+func NewNotificationService(ctx context.Context, c *app.RequestContext) *NotificationService {
+	return &NotificationService{ctx: ctx, c: c}
+}
+
+// ListNotifications 分页查询当前用户的通知，同时返回未读数量
+func (s *NotificationService) ListNotifications(req *user.ListNotificationsReq) ([]*model.Notification, int64, int64, error) {
+	if req.PageNum <= 0 {
+		req.PageNum = 1
+	}
+	if req.PageSize <= 0 || req.PageSize > 100 {
+		req.PageSize = 20
+	}
+	unreadOnly := req.UnreadOnly != nil && *req.UnreadOnly
+	notifications, total, unread, err := db.ListNotifications(s.ctx, GetUidFormContext(s.c), unreadOnly, int(req.PageNum), int(req.PageSize))
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	list := make([]*model.Notification, 0, len(notifications))
+	for _, n := range notifications {
+		list = append(list, n.ToNotificationModule())
+	}
+	return list, total, unread, nil
+}
+
+// MarkNotificationsRead 将指定通知标记为已读，未指定时全部标记为已读
+func (s *NotificationService) MarkNotificationsRead(req *user.MarkNotificationsReadReq) error {
+	return db.MarkNotificationsRead(s.ctx, GetUidFormContext(s.c), req.NotificationIds)
+}
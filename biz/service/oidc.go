@@ -0,0 +1,263 @@
+package service
+
+import (
+	"LearnShare/biz/dal/db"
+	"LearnShare/biz/dal/redis"
+	"LearnShare/biz/model/module"
+	"LearnShare/biz/model/user"
+	"LearnShare/config"
+	"LearnShare/pkg/errno"
+	"LearnShare/pkg/logger"
+	"LearnShare/pkg/oidc"
+	"LearnShare/pkg/utils"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math/rand"
+	"regexp"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"go.uber.org/zap"
+)
+
+var usernameIllegalChars = regexp.MustCompile(`[^A-Za-z0-9_]`)
+
+type OIDCService struct {
+	ctx context.Context
+	c   *app.RequestContext
+}
+
+func NewOIDCService(ctx context.Context, c *app.RequestContext) *OIDCService {
+	return &OIDCService{ctx: ctx, c: c}
+}
+
+// Authorize 生成统一身份认证授权地址，并保存 state / nonce / code_verifier
+func (s *OIDCService) Authorize() (string, error) {
+	provider, err := s.provider()
+	if err != nil {
+		return "", err
+	}
+
+	state, err := oidc.RandomString()
+	if err != nil {
+		return "", err
+	}
+	nonce, err := oidc.RandomString()
+	if err != nil {
+		return "", err
+	}
+	codeVerifier, err := oidc.RandomString()
+	if err != nil {
+		return "", err
+	}
+
+	err = redis.SetOIDCState(s.ctx, state, &redis.OIDCState{
+		CodeVerifier: codeVerifier,
+		Nonce:        nonce,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return provider.AuthCodeURL(state, nonce, codeVerifier), nil
+}
+
+// Callback 处理授权回调：换取并校验 ID Token，再映射到平台用户
+func (s *OIDCService) Callback(req *user.OIDCCallbackReq) (*module.User, error) {
+	provider, err := s.provider()
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := redis.TakeOIDCState(s.ctx, req.State)
+	if err != nil {
+		return nil, err
+	}
+	if state == nil {
+		return nil, errno.OIDCStateInvalidError
+	}
+
+	token, err := provider.Exchange(s.ctx, req.Code, state.CodeVerifier)
+	if err != nil {
+		logger.WithFields(zap.Error(err)).Warn("OIDC 授权码换取令牌失败")
+		return nil, err
+	}
+
+	claims, err := provider.VerifyIDToken(s.ctx, token.IDToken, state.Nonce)
+	if err != nil {
+		logger.WithFields(zap.Error(err)).Warn("OIDC ID Token 校验失败")
+		return nil, err
+	}
+
+	userInfo, err := s.resolveUser(provider.Issuer(), claims)
+	if err != nil {
+		return nil, err
+	}
+
+	switch userInfo.Status {
+	case "locked", "banned":
+		return nil, errno.UserAccountSuspendedError
+	}
+
+	userInfo.PasswordHash = ""
+	return userInfo.ToUserModule(), nil
+}
+
+func (s *OIDCService) provider() (*oidc.Provider, error) {
+	if config.OIDC == nil || !config.OIDC.Enabled {
+		return nil, errno.OIDCDisabledError
+	}
+	return oidc.GetProvider(s.ctx, oidc.Config{
+		Issuer:       config.OIDC.Issuer,
+		ClientID:     config.OIDC.ClientID,
+		ClientSecret: config.OIDC.ClientSecret,
+		RedirectURL:  config.OIDC.RedirectURL,
+		Scopes:       config.OIDC.Scopes,
+	})
+}
+
+// resolveUser 按 已绑定身份 -> 已验证邮箱关联 -> 自动创建 的顺序确定平台用户
+func (s *OIDCService) resolveUser(issuer string, claims oidc.Claims) (*db.User, error) {
+	subject := claims.String("sub")
+	mapping := config.OIDC.Claims
+
+	identity, err := db.GetUserIdentity(s.ctx, issuer, subject)
+	if err != nil {
+		return nil, err
+	}
+	if identity != nil {
+		return db.GetUserByID(s.ctx, identity.UserID)
+	}
+
+	email := claims.String(mapping.Email)
+	emailVerified := claims.Bool(mapping.EmailVerified)
+
+	if email != "" {
+		existing, err := db.FindUserByEmail(s.ctx, email)
+		if err != nil {
+			return nil, err
+		}
+		if existing != nil {
+			// 仅在身份提供方确认邮箱归属时才关联已有账户，防止借邮箱接管账户
+			if !emailVerified {
+				return nil, errno.OIDCAccountNotLinkedError
+			}
+			if err := db.CreateUserIdentity(s.ctx, existing.UserID, issuer, subject, &email); err != nil {
+				return nil, err
+			}
+			logger.WithFields(
+				zap.Int64("user_id", existing.UserID),
+				zap.String("email", logger.MaskEmail(email)),
+			).Info("OIDC 身份已关联到已有账户")
+			return existing, nil
+		}
+	}
+
+	if !config.OIDC.AutoProvision {
+		return nil, errno.OIDCAccountNotLinkedError
+	}
+	if email == "" {
+		return nil, errno.OIDCTokenInvalidError.WithMessage("ID Token 缺少邮箱声明，无法自动创建账户")
+	}
+
+	return s.provisionUser(issuer, subject, email, claims)
+}
+
+// provisionUser 根据 ID Token 声明自动创建账户
+func (s *OIDCService) provisionUser(issuer, subject, email string, claims oidc.Claims) (*db.User, error) {
+	mapping := config.OIDC.Claims
+
+	username, err := s.availableUsername(claims.String(mapping.Username), subject)
+	if err != nil {
+		return nil, err
+	}
+
+	// 统一身份认证账户不使用本地密码登录，写入不可猜测的随机密码
+	randomPassword, err := oidc.RandomString()
+	if err != nil {
+		return nil, err
+	}
+	passwordHash, err := utils.EncryptPassword(randomPassword)
+	if err != nil {
+		return nil, err
+	}
+
+	newUser := &db.User{
+		Username:     username,
+		PasswordHash: passwordHash,
+		Email:        email,
+		RoleID:       2, // 默认普通用户角色ID
+		Status:       "active",
+	}
+
+	if collegeName := claims.String(mapping.College); collegeName != "" {
+		college, err := db.GetCollegeByName(s.ctx, collegeName)
+		if err != nil {
+			return nil, err
+		}
+		if college != nil {
+			newUser.CollegeID = &college.CollegeID
+		}
+	}
+	if majorName := claims.String(mapping.Major); majorName != "" {
+		var collegeID int64
+		if newUser.CollegeID != nil {
+			collegeID = *newUser.CollegeID
+		}
+		major, err := db.GetMajorByName(s.ctx, majorName, collegeID)
+		if err != nil {
+			return nil, err
+		}
+		if major != nil {
+			newUser.MajorID = &major.MajorID
+			if newUser.CollegeID == nil {
+				newUser.CollegeID = &major.CollegeID
+			}
+		}
+	}
+
+	if _, err := db.CreateUserWithIdentity(s.ctx, newUser, issuer, subject); err != nil {
+		logger.WithFields(
+			zap.String("username", username),
+			zap.String("email", logger.MaskEmail(email)),
+			zap.Error(err),
+		).Error("OIDC 自动创建账户失败")
+		return nil, err
+	}
+
+	logger.WithFields(
+		zap.Int64("user_id", newUser.UserID),
+		zap.String("username", username),
+	).Info("OIDC 自动创建账户成功")
+	return newUser, nil
+}
+
+// availableUsername 将声明中的用户名规整为平台格式，并在冲突时追加随机后缀
+func (s *OIDCService) availableUsername(preferred, subject string) (string, error) {
+	base := usernameIllegalChars.ReplaceAllString(preferred, "")
+	if len(base) < 4 {
+		sum := sha256.Sum256([]byte(subject))
+		base = "sso_" + hex.EncodeToString(sum[:])[:8]
+	}
+	if len(base) > 16 {
+		base = base[:16]
+	}
+
+	candidate := base
+	for i := 0; i < 5; i++ {
+		exist, err := db.IsUsernameExist(s.ctx, candidate)
+		if err != nil {
+			return "", err
+		}
+		if !exist {
+			return candidate, nil
+		}
+		prefix := base
+		if len(prefix) > 11 {
+			prefix = prefix[:11]
+		}
+		candidate = fmt.Sprintf("%s_%04d", prefix, rand.Intn(10000))
+	}
+	return "", errno.NewErrNo(errno.ServiceUserExist, "无法生成可用的用户名")
+}
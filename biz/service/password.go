@@ -0,0 +1,102 @@
+package service
+
+import (
+	"LearnShare/biz/dal/db"
+	"LearnShare/config"
+	"LearnShare/pkg/errno"
+	"LearnShare/pkg/logger"
+	"LearnShare/pkg/password"
+	"LearnShare/pkg/utils"
+	"context"
+
+	"go.uber.org/zap"
+)
+
+// checkPasswordPolicy 按配置的密码策略校验新密码，并检查是否出现在泄露密码库中
+func checkPasswordPolicy(pwd, username, email string) error {
+	cfg := config.PasswordPolicy
+	if cfg == nil || cfg.MinLength <= 0 {
+		// 未配置 password_policy（或未设置最小长度）时沿用默认规则：8-20位，至少包含字母和数字
+		if _, err := utils.VerifyPassword(pwd); err != nil {
+			return err
+		}
+	} else {
+		policy := password.Policy{
+			MinLength:      cfg.MinLength,
+			MaxLength:      cfg.MaxLength,
+			RequireUpper:   cfg.RequireUpper,
+			RequireLower:   cfg.RequireLower,
+			RequireDigit:   cfg.RequireDigit,
+			RequireSymbol:  cfg.RequireSymbol,
+			MinClasses:     cfg.MinClasses,
+			ForbidUserInfo: cfg.ForbidUserInfo,
+		}
+		if err := policy.Validate(pwd, username, email); err != nil {
+			return err
+		}
+	}
+
+	if cfg != nil && cfg.BreachCheck {
+		breached, err := password.NewBreachChecker(cfg.BreachDir).IsBreached(pwd)
+		if err != nil {
+			// 泄露库读取失败不阻断用户操作
+			logger.WithFields(zap.String("breach_dir", cfg.BreachDir), zap.Error(err)).Error("读取泄露密码库失败")
+			return nil
+		}
+		if breached {
+			return errno.UserPasswordBreachedError
+		}
+	}
+	return nil
+}
+
+// checkPasswordReuse 新密码不能与当前密码及最近使用过的密码相同
+func checkPasswordReuse(ctx context.Context, userInfo *db.User, pwd string) error {
+	cfg := config.PasswordPolicy
+	if cfg == nil || cfg.HistorySize <= 0 {
+		return nil
+	}
+
+	if utils.ComparePassword(userInfo.PasswordHash, pwd) == nil {
+		return errno.UserPasswordReusedError
+	}
+	if cfg.HistorySize == 1 {
+		return nil
+	}
+
+	hashes, err := db.GetPasswordHistory(ctx, userInfo.UserID, cfg.HistorySize-1)
+	if err != nil {
+		return err
+	}
+	for _, hash := range hashes {
+		if utils.ComparePassword(hash, pwd) == nil {
+			return errno.UserPasswordReusedError
+		}
+	}
+	return nil
+}
+
+// changePassword 校验新密码后更新，并记录被替换的旧密码
+func changePassword(ctx context.Context, userInfo *db.User, newPassword string) error {
+	if err := checkPasswordPolicy(newPassword, userInfo.Username, userInfo.Email); err != nil {
+		return err
+	}
+	if err := checkPasswordReuse(ctx, userInfo, newPassword); err != nil {
+		return err
+	}
+
+	newPasswordHash, err := utils.EncryptPassword(newPassword)
+	if err != nil {
+		logger.WithFields(
+			zap.Int64("user_id", userInfo.UserID),
+			zap.Error(err),
+		).Error("新密码加密失败")
+		return err
+	}
+
+	keep := 0
+	if config.PasswordPolicy != nil && config.PasswordPolicy.HistorySize > 1 {
+		keep = config.PasswordPolicy.HistorySize - 1
+	}
+	return db.UpdateUserPasswordWithHistory(ctx, userInfo.UserID, userInfo.PasswordHash, newPasswordHash, keep)
+}
@@ -0,0 +1,32 @@
+package service
+
+import (
+	"reflect"
+	"testing"
+
+	"LearnShare/config"
+)
+
+// TestCheckPasswordPolicyWithoutConfig 配置文件没有 password_policy 段时仍按默认规则校验
+func TestCheckPasswordPolicyWithoutConfig(t *testing.T) {
+	origin := config.PasswordPolicy
+	defer func() { config.PasswordPolicy = origin }()
+
+	// 缺少配置段时映射结果为全零值的策略
+	policy := reflect.ValueOf(&config.PasswordPolicy).Elem()
+	policy.Set(reflect.New(policy.Type().Elem()))
+
+	for _, pwd := range []string{"", "abc123", "abcdefgh", "12345678", "abcd1234abcd1234abcd1"} {
+		if err := checkPasswordPolicy(pwd, "alice", "alice@example.com"); err == nil {
+			t.Fatalf("未配置密码策略时应当拒绝不符合默认规则的密码: %q", pwd)
+		}
+	}
+	if err := checkPasswordPolicy("abcd1234", "alice", "alice@example.com"); err != nil {
+		t.Fatalf("符合默认规则的密码应当通过: %v", err)
+	}
+
+	config.PasswordPolicy = nil
+	if err := checkPasswordPolicy("", "alice", "alice@example.com"); err == nil {
+		t.Fatal("配置未加载时应当拒绝空密码")
+	}
+}
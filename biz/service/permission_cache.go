@@ -0,0 +1,143 @@
+package service
+
+import (
+	"LearnShare/biz/dal/db"
+	"LearnShare/biz/dal/redis"
+	"LearnShare/config"
+	"LearnShare/pkg/logger"
+	"LearnShare/pkg/lru"
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	defaultPermissionLocalTTL  = 5 * time.Second
+	defaultPermissionLocalSize = 256
+	defaultPermissionRedisTTL  = time.Hour
+	// permissionSubscribeRetry 订阅断开后的重试间隔
+	permissionSubscribeRetry = 5 * time.Second
+)
+
+// rolePermissionSnapshot 进程内缓存的角色权限快照
+type rolePermissionSnapshot struct {
+	permissions []string
+	version     int64
+	loadedAt    time.Time
+}
+
+var (
+	rolePermissionLocal     *lru.Cache[int64, rolePermissionSnapshot]
+	rolePermissionLocalOnce sync.Once
+	// permissionVersion 本实例已知的最新权限版本号，低于该版本的进程内快照视为失效
+	permissionVersion atomic.Int64
+)
+
+// InitPermissionCache 初始化进程内权限缓存，并订阅其他实例广播的权限失效消息
+func InitPermissionCache() {
+	localPermissionCache()
+	go func() {
+		for {
+			err := redis.SubscribePermissionInvalidation(context.Background(), func(msg *redis.PermissionInvalidation) {
+				observePermissionVersion(msg.Version)
+				logger.WithFields(
+					zap.Int64("version", msg.Version),
+					zap.Int64("role_id", msg.RoleID),
+				).Debug("收到权限失效广播")
+			})
+			if err != nil {
+				logger.WithFields(zap.Error(err)).Error("订阅权限失效广播失败")
+			}
+			time.Sleep(permissionSubscribeRetry)
+		}
+	}()
+}
+
+func localPermissionCache() *lru.Cache[int64, rolePermissionSnapshot] {
+	rolePermissionLocalOnce.Do(func() {
+		size := defaultPermissionLocalSize
+		if config.PermissionCache != nil && config.PermissionCache.LocalSize > 0 {
+			size = config.PermissionCache.LocalSize
+		}
+		rolePermissionLocal = lru.New[int64, rolePermissionSnapshot](size)
+	})
+	return rolePermissionLocal
+}
+
+func permissionLocalTTL() time.Duration {
+	if config.PermissionCache != nil && config.PermissionCache.LocalTTLSeconds > 0 {
+		return time.Duration(config.PermissionCache.LocalTTLSeconds) * time.Second
+	}
+	return defaultPermissionLocalTTL
+}
+
+func permissionRedisTTL() time.Duration {
+	if config.PermissionCache != nil && config.PermissionCache.RedisTTLSeconds > 0 {
+		return time.Duration(config.PermissionCache.RedisTTLSeconds) * time.Second
+	}
+	return defaultPermissionRedisTTL
+}
+
+// observePermissionVersion 记录新的权限版本号，版本前进时清空进程内缓存
+func observePermissionVersion(version int64) {
+	for {
+		current := permissionVersion.Load()
+		if version <= current {
+			return
+		}
+		if permissionVersion.CompareAndSwap(current, version) {
+			localPermissionCache().Purge()
+			return
+		}
+	}
+}
+
+// loadRolePermissions 按 进程内缓存 -> Redis 版本快照 -> 数据库 的顺序获取角色权限
+func loadRolePermissions(ctx context.Context, roleID int64) ([]string, error) {
+	cache := localPermissionCache()
+	if snap, ok := cache.Get(roleID); ok &&
+		snap.version == permissionVersion.Load() &&
+		time.Since(snap.loadedAt) < permissionLocalTTL() {
+		return snap.permissions, nil
+	}
+
+	version, err := redis.GetPermissionVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+	observePermissionVersion(version)
+
+	data, ok, err := redis.GetRolePermissionSnapshot(ctx, roleID, version)
+	if err != nil {
+		return nil, err
+	}
+	var permissions []string
+	if ok {
+		permissions = deserializePermissions(data)
+	} else {
+		permissions, err = db.GetRolePermissions(ctx, roleID)
+		if err != nil {
+			return nil, err
+		}
+		// 写入期间版本号若已前进，该快照挂在旧版本下不会再被读取
+		if err := redis.SetRolePermissionSnapshot(ctx, roleID, version, serializePermissions(permissions), permissionRedisTTL()); err != nil {
+			return nil, err
+		}
+	}
+
+	cache.Add(roleID, rolePermissionSnapshot{permissions: permissions, version: version, loadedAt: time.Now()})
+	return permissions, nil
+}
+
+// invalidatePermissions 角色或权限写入后使所有实例的权限快照失效
+func invalidatePermissions(ctx context.Context, roleID int64) error {
+	version, err := redis.InvalidatePermissions(ctx, roleID)
+	if err != nil {
+		return err
+	}
+	observePermissionVersion(version)
+	return nil
+}
@@ -0,0 +1,235 @@
+package service
+
+import (
+	"LearnShare/biz/dal/db"
+	"LearnShare/biz/dal/redis"
+	"LearnShare/biz/model/module"
+	"LearnShare/biz/model/user"
+	"LearnShare/pkg/constants"
+	"LearnShare/pkg/errno"
+	"LearnShare/pkg/logger"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"go.uber.org/zap"
+)
+
+const (
+	maxPersonalTokensPerUser = 20
+	maxPersonalTokenDays     = 365
+	// personalTokenTouchInterval 最近使用时间的最小更新间隔，避免每次请求都写库
+	personalTokenTouchInterval = time.Minute
+)
+
+// hashPersonalToken 计算令牌的 SHA-256 哈希，数据库只保存哈希
+func hashPersonalToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// CreatePersonalToken 创建个人访问令牌，返回仅展示一次的明文令牌
+func (s *UserService) CreatePersonalToken(req *user.CreatePersonalTokenReq) (string, *module.PersonalToken, error) {
+	userID := GetUidFormContext(s.c)
+
+	name := strings.TrimSpace(req.Name)
+	if name == "" || len([]rune(name)) > 64 {
+		return "", nil, errno.ParamVerifyError.WithMessage("令牌名称长度应为1-64个字符")
+	}
+
+	scopes, err := s.normalizeTokenScopes(req.Scopes)
+	if err != nil {
+		return "", nil, err
+	}
+
+	count, err := db.CountPersonalAccessTokens(s.ctx, userID)
+	if err != nil {
+		return "", nil, err
+	}
+	if count >= maxPersonalTokensPerUser {
+		return "", nil, errno.ParamVerifyError.WithMessage(fmt.Sprintf("每个用户最多持有 %d 个个人访问令牌", maxPersonalTokensPerUser))
+	}
+
+	var expiresAt *time.Time
+	if req.ExpiresInDays != nil {
+		days := *req.ExpiresInDays
+		if days <= 0 || days > maxPersonalTokenDays {
+			return "", nil, errno.ParamVerifyError.WithMessage(fmt.Sprintf("有效期应为1-%d天", maxPersonalTokenDays))
+		}
+		t := time.Now().Add(time.Duration(days) * 24 * time.Hour)
+		expiresAt = &t
+	}
+
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return "", nil, errno.NewErrNo(errno.InternalServiceErrorCode, "生成个人访问令牌失败: "+err.Error())
+	}
+	plain := constants.PersonalTokenPrefix + hex.EncodeToString(raw)
+
+	token := &db.PersonalAccessToken{
+		UserID:      userID,
+		Name:        name,
+		TokenPrefix: plain[:len(constants.PersonalTokenPrefix)+8],
+		TokenHash:   hashPersonalToken(plain),
+		Scopes:      strings.Join(scopes, ","),
+		ExpiresAt:   expiresAt,
+		CreatedAt:   time.Now(),
+	}
+	if err := db.CreatePersonalAccessToken(s.ctx, token); err != nil {
+		return "", nil, err
+	}
+
+	logger.WithFields(
+		zap.String("audit", "personal_token_create"),
+		zap.Int64("user_id", userID),
+		zap.Int64("token_id", token.TokenID),
+		zap.Strings("scopes", scopes),
+	).Info("创建个人访问令牌")
+	return plain, token.ToPersonalTokenModule(), nil
+}
+
+// ListPersonalTokens 获取当前用户未吊销的个人访问令牌
+func (s *UserService) ListPersonalTokens() ([]*module.PersonalToken, error) {
+	tokens, err := db.ListPersonalAccessTokens(s.ctx, GetUidFormContext(s.c))
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]*module.PersonalToken, 0, len(tokens))
+	for _, token := range tokens {
+		list = append(list, token.ToPersonalTokenModule())
+	}
+	return list, nil
+}
+
+// RevokePersonalToken 吊销当前用户的个人访问令牌
+func (s *UserService) RevokePersonalToken(req *user.RevokePersonalTokenReq) error {
+	userID := GetUidFormContext(s.c)
+	ok, err := db.RevokePersonalAccessToken(s.ctx, userID, req.TokenID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errno.NewErrNo(errno.ServiceUserNotExist, "令牌不存在或已吊销")
+	}
+
+	logger.WithFields(
+		zap.String("audit", "personal_token_revoke"),
+		zap.Int64("user_id", userID),
+		zap.Int64("token_id", req.TokenID),
+	).Info("吊销个人访问令牌")
+	return nil
+}
+
+// revokeUserCredentials 吊销用户在 at 之前签发的会话，并吊销其全部个人访问令牌
+// 会话吊销标记只保留到 access-token 的最长有效期，个人访问令牌有效期更长且不检查该标记，须在数据库中一并吊销
+func revokeUserCredentials(ctx context.Context, userID int64, at time.Time) error {
+	if err := redis.RevokeUserSessions(ctx, userID, at, sessionRevokeTTL); err != nil {
+		return err
+	}
+	revoked, err := db.RevokeUserPersonalAccessTokens(ctx, userID, at)
+	if err != nil {
+		return err
+	}
+	if revoked > 0 {
+		logger.WithFields(
+			zap.String("audit", "personal_token_revoke_all"),
+			zap.Int64("user_id", userID),
+			zap.Int64("revoked", revoked),
+		).Info("吊销用户全部个人访问令牌")
+	}
+	return nil
+}
+
+// normalizeTokenScopes 去重并校验授权范围，范围必须是已存在的权限标识
+func (s *UserService) normalizeTokenScopes(scopes []string) ([]string, error) {
+	if len(scopes) == 0 {
+		return nil, errno.ParamVerifyError.WithMessage("至少需要指定一个授权范围")
+	}
+
+	permissions, err := db.GetAllPermissions(s.ctx)
+	if err != nil {
+		return nil, err
+	}
+	known := make(map[string]struct{}, len(permissions))
+	for _, perm := range permissions {
+		known[perm.PermissionName] = struct{}{}
+	}
+
+	seen := make(map[string]struct{}, len(scopes))
+	result := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		scope = strings.TrimSpace(scope)
+		if _, ok := known[scope]; !ok {
+			return nil, errno.ParamVerifyError.WithMessage("未知的授权范围: " + scope)
+		}
+		if _, ok := seen[scope]; ok {
+			continue
+		}
+		seen[scope] = struct{}{}
+		result = append(result, scope)
+	}
+	return result, nil
+}
+
+// AuthenticatePersonalToken 校验个人访问令牌，通过后将用户身份与授权范围写入请求上下文
+func (s *UserService) AuthenticatePersonalToken(plain string) error {
+	token, err := db.GetPersonalAccessTokenByHash(s.ctx, hashPersonalToken(plain))
+	if err != nil {
+		return err
+	}
+	if token == nil || token.RevokedAt != nil {
+		return errno.PersonalTokenInvalidError
+	}
+	if token.ExpiresAt != nil && time.Now().After(*token.ExpiresAt) {
+		return errno.PersonalTokenExpiredError
+	}
+
+	userInfo, err := db.GetUserByID(s.ctx, token.UserID)
+	if err != nil {
+		return err
+	}
+	switch userInfo.Status {
+	case "locked", "banned":
+		return errno.UserAccountSuspendedError
+	}
+
+	s.c.Set(constants.ContextUid, userInfo.UserID)
+	s.c.Set(constants.RoleID, userInfo.RoleID)
+	s.c.Set(constants.UUID, fmt.Sprintf("pat-%d", token.TokenID))
+	s.c.Set(constants.ContextTokenScopes, token.ScopeList())
+
+	if token.LastUsedAt == nil || time.Since(*token.LastUsedAt) >= personalTokenTouchInterval {
+		db.TouchPersonalAccessTokenAsync(token.TokenID, s.clientIP())
+	}
+	return nil
+}
+
+// GetTokenScopesFormContext 从请求上下文中获取个人访问令牌的授权范围，非令牌认证时返回 false
+func GetTokenScopesFormContext(c *app.RequestContext) ([]string, bool) {
+	value, ok := c.Get(constants.ContextTokenScopes)
+	if !ok {
+		return nil, false
+	}
+	scopes, ok := value.([]string)
+	return scopes, ok
+}
+
+// HasTokenScope 判断当前请求的授权范围是否包含 scope，非令牌认证的请求不受授权范围限制
+func HasTokenScope(c *app.RequestContext, scope string) bool {
+	scopes, ok := GetTokenScopesFormContext(c)
+	if !ok {
+		return true
+	}
+	for _, s := range scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
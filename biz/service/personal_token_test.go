@@ -0,0 +1,131 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"LearnShare/biz/dal/db"
+	redisDal "LearnShare/biz/dal/redis"
+	"LearnShare/pkg/constants"
+	"LearnShare/pkg/errno"
+
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// seedPersonalToken 按明文令牌直接写入一条个人访问令牌记录
+func seedPersonalToken(t *testing.T, userID int64, plain, scopes string, expiresAt, revokedAt *time.Time) *db.PersonalAccessToken {
+	t.Helper()
+	token := &db.PersonalAccessToken{
+		UserID:      userID,
+		Name:        "ci",
+		TokenPrefix: plain[:len(constants.PersonalTokenPrefix)+8],
+		TokenHash:   hashPersonalToken(plain),
+		Scopes:      scopes,
+		ExpiresAt:   expiresAt,
+		RevokedAt:   revokedAt,
+		CreatedAt:   time.Now(),
+	}
+	if err := db.CreatePersonalAccessToken(context.Background(), token); err != nil {
+		t.Fatalf("写入个人访问令牌失败: %v", err)
+	}
+	return token
+}
+
+func activateUser(t *testing.T, userID int64) {
+	t.Helper()
+	if err := db.DB.Table(constants.UserTableName).Where("user_id = ?", userID).Update("status", "active").Error; err != nil {
+		t.Fatalf("激活用户失败: %v", err)
+	}
+}
+
+func assertErrCode(t *testing.T, err error, code int64) {
+	t.Helper()
+	var errNo errno.ErrNo
+	if !errors.As(err, &errNo) || errNo.ErrorCode != code {
+		t.Fatalf("预期错误码 %d, 实际错误为 %v", code, err)
+	}
+}
+
+func TestAuthenticatePersonalToken(t *testing.T) {
+	cleanupDB := setupTestDB(t)
+	defer cleanupDB()
+
+	u := seedUser(t, "patuser", "pat@example.com", "Pass1234")
+	activateUser(t, u.UserID)
+	plain := constants.PersonalTokenPrefix + "0123456789abcdef"
+	token := seedPersonalToken(t, u.UserID, plain, "resource.upload,course.rate", nil, nil)
+
+	stored, err := db.GetPersonalAccessTokenByHash(context.Background(), hashPersonalToken(plain))
+	if err != nil || stored == nil || stored.TokenID != token.TokenID {
+		t.Fatalf("应当能按哈希查到令牌: token=%+v err=%v", stored, err)
+	}
+	if stored.TokenHash == plain {
+		t.Fatal("数据库不应保存明文令牌")
+	}
+
+	c := app.NewContext(0)
+	if err := NewUserService(context.Background(), c).AuthenticatePersonalToken(plain); err != nil {
+		t.Fatalf("有效令牌认证失败: %v", err)
+	}
+	if GetUidFormContext(c) != u.UserID {
+		t.Fatalf("认证后上下文中的用户ID不正确: %d", GetUidFormContext(c))
+	}
+	if !HasTokenScope(c, "resource.upload") || HasTokenScope(c, "user.reputation.adjust") {
+		t.Fatal("令牌授权范围判断不正确")
+	}
+	if !HasTokenScope(app.NewContext(0), "user.reputation.adjust") {
+		t.Fatal("非令牌认证的请求不应受授权范围限制")
+	}
+
+	err = NewUserService(context.Background(), app.NewContext(0)).AuthenticatePersonalToken(plain + "x")
+	assertErrCode(t, err, errno.PersonalTokenInvalidCode)
+}
+
+func TestAuthenticatePersonalTokenExpiredOrRevoked(t *testing.T) {
+	cleanupDB := setupTestDB(t)
+	defer cleanupDB()
+
+	u := seedUser(t, "patexpire", "patexpire@example.com", "Pass1234")
+	activateUser(t, u.UserID)
+	past := time.Now().Add(-time.Hour)
+	expired := constants.PersonalTokenPrefix + "expired000000000"
+	revoked := constants.PersonalTokenPrefix + "revoked000000000"
+	seedPersonalToken(t, u.UserID, expired, "resource.upload", &past, nil)
+	seedPersonalToken(t, u.UserID, revoked, "resource.upload", nil, &past)
+
+	err := NewUserService(context.Background(), app.NewContext(0)).AuthenticatePersonalToken(expired)
+	assertErrCode(t, err, errno.PersonalTokenExpiredCode)
+	err = NewUserService(context.Background(), app.NewContext(0)).AuthenticatePersonalToken(revoked)
+	assertErrCode(t, err, errno.PersonalTokenInvalidCode)
+}
+
+// TestRevokeUserCredentials 撤销邮箱修改与封禁时，用户已创建的个人访问令牌一并失效
+func TestRevokeUserCredentials(t *testing.T) {
+	cleanupDB := setupTestDB(t)
+	defer cleanupDB()
+	_, cleanupRedis := setupTestRedis(t)
+	defer cleanupRedis()
+
+	u := seedUser(t, "patrevoke", "patrevoke@example.com", "Pass1234")
+	activateUser(t, u.UserID)
+	plain := constants.PersonalTokenPrefix + "attacker00000000"
+	seedPersonalToken(t, u.UserID, plain, "resource.upload", nil, nil)
+
+	now := time.Now()
+	if err := revokeUserCredentials(context.Background(), u.UserID, now); err != nil {
+		t.Fatalf("吊销用户凭证失败: %v", err)
+	}
+	err := NewUserService(context.Background(), app.NewContext(0)).AuthenticatePersonalToken(plain)
+	assertErrCode(t, err, errno.PersonalTokenInvalidCode)
+
+	at, err := redisDal.GetUserSessionsRevokedAt(context.Background(), u.UserID)
+	if err != nil || at != now.Unix() {
+		t.Fatalf("会话吊销时间不正确: at=%d err=%v", at, err)
+	}
+	count, err := db.CountPersonalAccessTokens(context.Background(), u.UserID)
+	if err != nil || count != 0 {
+		t.Fatalf("用户不应再有未吊销的令牌: count=%d err=%v", count, err)
+	}
+}
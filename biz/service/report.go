@@ -0,0 +1,89 @@
+package service
+
+import (
+	"LearnShare/biz/dal/db"
+	"LearnShare/config"
+	"LearnShare/pkg/constants"
+	"LearnShare/pkg/errno"
+	"LearnShare/pkg/logger"
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	defaultReportAutoHideThreshold = 5
+
+	// 举报量达到这些次数时优先级依次提升一档
+	reportCountRaiseOne = 3
+	reportCountRaiseTwo = 10
+	// 举报人平均信誉分高于 trusted 时提升一档，低于 untrusted 时降低一档
+	trustedReporterReputation   = 90
+	untrustedReporterReputation = 60
+	// 对象热度（资源下载与评分次数、评论点赞数）达到该值时提升一档
+	popularReportTarget = 100
+)
+
+func reportAutoHideThreshold() int64 {
+	if config.ReviewQueue != nil && config.ReviewQueue.AutoHideThreshold > 0 {
+		return int64(config.ReviewQueue.AutoHideThreshold)
+	}
+	return defaultReportAutoHideThreshold
+}
+
+// reportPriority 从默认优先级出发，按举报量、举报人信誉与对象热度调整，结果限制在 1-5（1 最高）
+func reportPriority(stats *db.ReportStats) int {
+	priority := constants.DefaultReviewPriority
+	switch {
+	case stats.ReportCount >= reportCountRaiseTwo:
+		priority -= 2
+	case stats.ReportCount >= reportCountRaiseOne:
+		priority--
+	}
+	switch {
+	case stats.ReporterReputation >= trustedReporterReputation:
+		priority--
+	case stats.ReporterReputation < untrustedReporterReputation:
+		priority++
+	}
+	if stats.Popularity >= popularReportTarget {
+		priority--
+	}
+	return min(max(priority, 1), 5)
+}
+
+// validateReportReason 举报原因不能为空且不超过 500 字符
+func validateReportReason(reason string) error {
+	if reason == "" {
+		return errno.ResourceReportInvalidReasonError
+	}
+	if len(reason) > 500 {
+		return errno.ValidationReportReasonTooLongError
+	}
+	return nil
+}
+
+// reportContent 提交举报，同一对象待处理的举报合并到一条审核记录
+func reportContent(ctx context.Context, reporterID int64, targetType string, targetID int64, reason string) error {
+	review, err := db.ReportContent(ctx, reporterID, targetType, targetID, reason, db.ReportOptions{
+		Prioritize: func(stats *db.ReportStats) (int, time.Time) {
+			priority := reportPriority(stats)
+			return priority, reviewDueAt(priority, stats.CreatedAt)
+		},
+		AutoHideThreshold: reportAutoHideThreshold(),
+	})
+	if err != nil {
+		return err
+	}
+
+	if review.AutoHiddenAt != nil && review.ReportCount == reportAutoHideThreshold() {
+		logger.WithFields(
+			zap.Int64("review_id", review.ReviewID),
+			zap.String("target_type", targetType),
+			zap.Int64("target_id", targetID),
+			zap.Int64("report_count", review.ReportCount),
+		).Info("举报次数达到阈值，内容已自动隐藏等待审核")
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package service
+
+import (
+	"LearnShare/biz/dal/db"
+	model "LearnShare/biz/model/module"
+	"LearnShare/biz/model/user"
+	"LearnShare/pkg/constants"
+	"LearnShare/pkg/errno"
+	"context"
+
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// maxReputationAdjust 单次手动调整信誉分的最大幅度
+const maxReputationAdjust = 100
+
+// ReputationService 信誉分记录与手动调整
+type ReputationService struct {
+	ctx context.Context
+	c   *app.RequestContext
+}
+
+func NewReputationService(ctx context.Context, c *app.RequestContext) *ReputationService {
+	return &ReputationService{ctx: ctx, c: c}
+}
+
+// ListMyReputationRecords 分页查询当前用户的信誉分变动记录，同时返回当前信誉分
+func (s *ReputationService) ListMyReputationRecords(req *user.ListMyReputationRecordsReq) (int64, []*model.ReputationRecord, int64, error) {
+	if req.PageNum <= 0 {
+		req.PageNum = 1
+	}
+	if req.PageSize <= 0 || req.PageSize > 100 {
+		req.PageSize = 20
+	}
+	uid := GetUidFormContext(s.c)
+	u, err := db.GetUserByID(s.ctx, uid)
+	if err != nil {
+		return 0, nil, 0, err
+	}
+	records, total, err := db.ListReputationRecords(s.ctx, uid, int(req.PageNum), int(req.PageSize))
+	if err != nil {
+		return 0, nil, 0, err
+	}
+	list := make([]*model.ReputationRecord, 0, len(records))
+	for _, r := range records {
+		list = append(list, r.ToReputationRecordModule())
+	}
+	return u.ReputationScore, list, total, nil
+}
+
+// AdjustUserReputation 管理员手动调整用户信誉分，调整原因写入信誉分记录
+func (s *ReputationService) AdjustUserReputation(req *user.AdjustUserReputationReq) (int64, *model.ReputationRecord, error) {
+	if req.UserID <= 0 {
+		return 0, nil, errno.NewErrNo(errno.ServiceInvalidParameter, "用户ID无效")
+	}
+	if req.Delta == 0 || req.Delta > maxReputationAdjust || req.Delta < -maxReputationAdjust {
+		return 0, nil, errno.ParamVerifyError.WithMessage("调整的信誉分须在-100到100之间且不能为0")
+	}
+	if !validModerationReason(req.Reason) {
+		return 0, nil, errno.ParamVerifyError.WithMessage("调整原因不能为空且不能超过500字")
+	}
+
+	operatorID := GetUidFormContext(s.c)
+	before := adminSnapshot(s.ctx, constants.UserTableName, "user_id", req.UserID)
+	record, score, err := db.AdjustUserReputation(s.ctx, db.ReputationChange{
+		UserID:     req.UserID,
+		Delta:      req.Delta,
+		EventType:  db.ReputationManualAdjust,
+		Reason:     req.Reason,
+		OperatorID: &operatorID,
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+	refreshLeaderboards(req.UserID)
+	RecordAdminChange(s.c, "user", req.UserID, before, adminSnapshot(s.ctx, constants.UserTableName, "user_id", req.UserID))
+	return score, record.ToReputationRecordModule(), nil
+}
@@ -4,6 +4,7 @@ import (
 	"LearnShare/biz/dal/db"
 	model "LearnShare/biz/model/module"
 	"LearnShare/biz/model/resource"
+	"LearnShare/pkg/constants"
 	"LearnShare/pkg/errno"
 	"LearnShare/pkg/logger"
 	"LearnShare/pkg/oss"
@@ -91,9 +92,12 @@ func (s *ResourceService) DownloadResource(req *resource.DownloadResourceReq) (s
 		return "", err
 	}
 
-	repErrChan := db.IncrementUserReputationAsync(s.ctx, r.UploaderID, 1)
-	if e := <-repErrChan; e != nil {
-		return "", e
+	// 上传者获得信誉分，下载自己的资源不计
+	if r.UploaderID != userID {
+		if _, err = db.AwardReputation(s.ctx, r.UploaderID, db.ReputationResourceDownloaded, "resource", r.ResourceID); err != nil {
+			return "", err
+		}
+		refreshLeaderboards(r.UploaderID)
 	}
 
 	logger.Infof("user %d downloaded resource %d", userID, req.ResourceID)
@@ -144,6 +148,8 @@ func (s *ResourceService) SubmitResourceRating(req *resource.SubmitResourceRatin
 	if err != nil {
 		return nil, err
 	}
+	// 上传者因收到评分获得信誉分
+	refreshResourceUploaderLeaderboards(req.ResourceID)
 
 	return rating.ToResourceRatingModule(), nil
 }
@@ -167,6 +173,13 @@ func (s *ResourceService) SubmitResourceComment(req *resource.SubmitResourceComm
 		parentID = req.ParentId
 	}
 
+	// 内容过滤：命中拒绝规则时不保存，打码规则直接替换原文
+	check := newContentCheck(s.ctx)
+	check.apply(&req.Content)
+	if err := check.rejected(userID, "comment"); err != nil {
+		return nil, err
+	}
+
 	// 调用数据库层提交评论
 	resultChan := db.SubmitResourceCommentAsync(s.ctx, userID, req.ResourceID, req.Content, parentID)
 	result := <-resultChan
@@ -177,6 +190,9 @@ func (s *ResourceService) SubmitResourceComment(req *resource.SubmitResourceComm
 	if result.Comment == nil {
 		return nil, errno.NewErrNo(errno.InternalDatabaseErrorCode, "提交资源评论失败: 未返回评论数据")
 	}
+	if check.submitReview(s.ctx, "comment", result.Comment.CommentID) {
+		result.Comment.IsVisible = false
+	}
 
 	return result.Comment.ToResourceCommentModule(), nil
 }
@@ -225,34 +241,80 @@ func (s *ResourceService) ReportResource(req *resource.ReportResourceReq) error
 	}
 
 	// 验证举报原因
-	if req.Reason == "" {
-		return errno.ResourceReportInvalidReasonError
-	}
-	if len(req.Reason) > 500 {
-		return errno.ValidationReportReasonTooLongError
+	if err := validateReportReason(req.Reason); err != nil {
+		return err
 	}
 
 	// 从上下文获取当前用户ID
 	userID := GetUidFormContext(s.c)
 
-	// 使用异步创建举报记录
-	errChan := db.CreateReviewAsync(s.ctx, userID, req.ResourceID, "resource", req.Reason)
-	if err := <-errChan; err != nil {
+	// 同一资源待处理的举报会合并到一条审核记录
+	return reportContent(s.ctx, userID, "resource", req.ResourceID, req.Reason)
+}
+
+// ReportResourceRating 举报一条资源评分
+func (s *ResourceService) ReportResourceRating(req *resource.ReportResourceRatingReq) error {
+	if req.RatingID <= 0 {
+		return errno.NewErrNo(errno.ServiceInvalidParameter, "评分ID无效")
+	}
+	if err := validateReportReason(req.Reason); err != nil {
 		return err
 	}
+	return reportContent(s.ctx, GetUidFormContext(s.c), "resource_rating", req.RatingID, req.Reason)
+}
 
-	return nil
+// ReportResourceComment 举报一条资源评论
+func (s *ResourceService) ReportResourceComment(req *resource.ReportResourceCommentReq) error {
+	if req.CommentID <= 0 {
+		return errno.NewErrNo(errno.ServiceInvalidParameter, "评论ID无效")
+	}
+	if err := validateReportReason(req.Reason); err != nil {
+		return err
+	}
+	return reportContent(s.ctx, GetUidFormContext(s.c), "comment", req.CommentID, req.Reason)
 }
 
-// AdminDeleteResource 管理员硬删除资源
+// AdminDeleteResource 管理员删除资源，保留期内可恢复
 func (s *ResourceService) AdminDeleteResource(req *resource.AdminDeleteResourceReq) error {
 	if req.ResourceID <= 0 {
 		return errno.NewErrNo(errno.ServiceInvalidParameter, "资源ID无效")
 	}
 
+	before := adminSnapshot(s.ctx, constants.ResourceTableName, "resource_id", req.ResourceID)
 	if err := db.AdminDeleteResource(s.ctx, req.ResourceID); err != nil {
 		return err
 	}
+	refreshResourceUploaderLeaderboards(req.ResourceID)
+	RecordAdminChange(s.c, "resource", req.ResourceID, before, nil)
+	return nil
+}
+
+// AdminRestoreResource 管理员恢复保留期内已删除的资源
+func (s *ResourceService) AdminRestoreResource(req *resource.AdminRestoreResourceReq) error {
+	if req.ResourceID <= 0 {
+		return errno.NewErrNo(errno.ServiceInvalidParameter, "资源ID无效")
+	}
+
+	before := adminSnapshot(s.ctx, constants.ResourceTableName, "resource_id", req.ResourceID)
+	if err := db.RestoreResource(s.ctx, req.ResourceID); err != nil {
+		return err
+	}
+	refreshResourceUploaderLeaderboards(req.ResourceID)
+	RecordAdminChange(s.c, "resource", req.ResourceID, before, adminSnapshot(s.ctx, constants.ResourceTableName, "resource_id", req.ResourceID))
+	return nil
+}
+
+// AdminRestoreResourceComment 管理员恢复被用户或管理员删除的资源评论
+func (s *ResourceService) AdminRestoreResourceComment(req *resource.AdminRestoreResourceCommentReq) error {
+	if req.CommentID <= 0 {
+		return errno.NewErrNo(errno.ServiceInvalidParameter, "评论ID无效")
+	}
+
+	before := adminSnapshot(s.ctx, constants.ResourceCommentTableName, "comment_id", req.CommentID)
+	if err := db.RestoreDeletedComment(s.ctx, "comment", req.CommentID); err != nil {
+		return err
+	}
+	RecordAdminChange(s.c, "resource_comment", req.CommentID, before, adminSnapshot(s.ctx, constants.ResourceCommentTableName, "comment_id", req.CommentID))
 	return nil
 }
 
@@ -260,9 +322,11 @@ func (s *ResourceService) AdminDeleteResourceComment(req *resource.AdminDeleteRe
 	if req.CommentID <= 0 {
 		return errno.NewErrNo(errno.ServiceInvalidParameter, "评论ID无效")
 	}
+	before := adminSnapshot(s.ctx, constants.ResourceCommentTableName, "comment_id", req.CommentID)
 	if err := db.AdminDeleteResourceComment(s.ctx, req.CommentID); err != nil {
 		return err
 	}
+	RecordAdminChange(s.c, "resource_comment", req.CommentID, before, nil)
 	return nil
 }
 
@@ -270,9 +334,11 @@ func (s *ResourceService) AdminDeleteResourceRating(req *resource.AdminDeleteRes
 	if req.RatingID <= 0 {
 		return errno.NewErrNo(errno.ServiceInvalidParameter, "评分ID无效")
 	}
+	before := adminSnapshot(s.ctx, constants.ResourceRatingTableName, "rating_id", req.RatingID)
 	if err := db.AdminDeleteResourceRating(s.ctx, req.RatingID); err != nil {
 		return err
 	}
+	RecordAdminChange(s.c, "resource_rating", req.RatingID, before, nil)
 	return nil
 }
 
@@ -302,6 +368,19 @@ func (s *ResourceService) UploadResource(file *multipart.FileHeader, title strin
 
 	userID := GetUidFormContext(s.c)
 
+	// 内容过滤：标题、描述与标签一并检查，命中拒绝规则时不上传文件
+	check := newContentCheck(s.ctx)
+	check.apply(&title)
+	if description != nil {
+		check.apply(description)
+	}
+	for i := range tags {
+		check.apply(&tags[i])
+	}
+	if err := check.rejected(userID, "resource"); err != nil {
+		return nil, err
+	}
+
 	link, err := oss.UploadFile(file, "resource", courseID)
 	if err != nil {
 		return nil, err
@@ -354,6 +433,16 @@ func (s *ResourceService) UploadResource(file *multipart.FileHeader, title strin
 		}
 	}
 
+	if check.submitReview(s.ctx, "resource", res.ResourceID) {
+		res.Status = "pending_review"
+	} else {
+		if _, e := db.AwardReputation(s.ctx, userID, db.ReputationUploadApproved, "resource", res.ResourceID); e != nil {
+			// 资源已发布，信誉分发放失败不影响上传结果
+			logger.Errorf("发放上传信誉分失败: resource_id=%d, err=%v", res.ResourceID, e)
+		}
+		refreshLeaderboards(userID)
+	}
+
 	// 直接构建返回结果，避免重复查询
 	var tagsResp []*model.ResourceTag
 	if res.Tags != nil {
@@ -38,7 +38,8 @@ CREATE TABLE IF NOT EXISTS resources (
     average_rating REAL DEFAULT 0.0,
     rating_count INTEGER DEFAULT 0,
     status TEXT DEFAULT 'pending_review',
-    created_at DATETIME
+    created_at DATETIME,
+    deleted_at DATETIME
 );
 `
 
@@ -72,7 +73,8 @@ CREATE TABLE IF NOT EXISTS resource_comments (
     likes INTEGER DEFAULT 0,
     is_visible INTEGER DEFAULT 1,
     status TEXT DEFAULT 'normal',
-    created_at DATETIME
+    created_at DATETIME,
+    deleted_at DATETIME
 );
 `
 
@@ -101,6 +103,8 @@ CREATE TABLE IF NOT EXISTS users (
     reputation_score INTEGER DEFAULT 0,
     role_id INTEGER,
     status TEXT,
+    muted_until DATETIME,
+    activation_pending_at DATETIME,
     created_at DATETIME,
     updated_at DATETIME
 );
@@ -115,10 +119,25 @@ CREATE TABLE IF NOT EXISTS reviews (
     reason TEXT NOT NULL,
     status TEXT DEFAULT 'pending',
     priority INTEGER DEFAULT 3,
+    level INTEGER DEFAULT 1,
+    reporter_id INTEGER,
     reviewer_id INTEGER,
     reviewed_at DATETIME,
+    claimed_by INTEGER,
+    claim_expires_at DATETIME,
+    due_at DATETIME,
+    escalated_at DATETIME,
+    report_count INTEGER DEFAULT 1,
+    auto_hidden_at DATETIME,
     created_at DATETIME
 );
+CREATE TABLE IF NOT EXISTS review_reporters (
+    review_id INTEGER NOT NULL,
+    reporter_id INTEGER NOT NULL,
+    reason TEXT NOT NULL,
+    created_at DATETIME,
+    PRIMARY KEY (review_id, reporter_id)
+);
 `
 
 	tables := []string{
@@ -0,0 +1,188 @@
+package service
+
+import (
+	"LearnShare/biz/dal/db"
+	"LearnShare/biz/model/audit"
+	model "LearnShare/biz/model/module"
+	"LearnShare/config"
+	"LearnShare/pkg/errno"
+	"context"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+const (
+	defaultReviewLease     = 30 * time.Minute
+	defaultReviewMaxClaims = 20
+	maxReviewClaimBatch    = 50
+)
+
+// defaultReviewSLAHours 优先级 1-5 依次对应的默认处理时限（小时）
+var defaultReviewSLAHours = []int{4, 8, 24, 48, 72}
+
+// reviewTargetTypes 审核记录的对象类型
+var reviewTargetTypes = map[string]bool{
+	"resource":          true,
+	"course_rating":     true,
+	"resource_rating":   true,
+	"comment":           true,
+	"course_comment":    true,
+	"moderation_action": true,
+}
+
+func reviewLease() time.Duration {
+	if config.ReviewQueue != nil && config.ReviewQueue.LeaseMinutes > 0 {
+		return time.Duration(config.ReviewQueue.LeaseMinutes) * time.Minute
+	}
+	return defaultReviewLease
+}
+
+func reviewMaxClaims() int64 {
+	if config.ReviewQueue != nil && config.ReviewQueue.MaxClaims > 0 {
+		return int64(config.ReviewQueue.MaxClaims)
+	}
+	return defaultReviewMaxClaims
+}
+
+// reviewDueAt 按优先级计算审核记录的 SLA 截止时间，超出 1-5 的优先级按最近的一档处理
+func reviewDueAt(priority int, from time.Time) time.Time {
+	hours := defaultReviewSLAHours
+	if config.ReviewQueue != nil && len(config.ReviewQueue.SLAHours) > 0 {
+		hours = config.ReviewQueue.SLAHours
+	}
+	i := min(max(priority, 1), len(hours)) - 1
+	return from.Add(time.Duration(hours[i]) * time.Hour)
+}
+
+// ReviewQueueService 审核队列：认领、释放、收件箱与队列概况
+type ReviewQueueService struct {
+	ctx context.Context
+	c   *app.RequestContext
+}
+
+func NewReviewQueueService(ctx context.Context, c *app.RequestContext) *ReviewQueueService {
+	return &ReviewQueueService{ctx: ctx, c: c}
+}
+
+// ClaimNextReviews 按队列顺序认领若干条待审核记录，受单人认领上限约束
+func (s *ReviewQueueService) ClaimNextReviews(req *audit.ClaimNextReviewsReq) ([]*model.Review, error) {
+	if req.Count <= 0 || req.Count > maxReviewClaimBatch {
+		return nil, errno.ParamVerifyError.WithMessage("认领数量无效")
+	}
+	targetType := ""
+	if req.TargetType != nil && *req.TargetType != "" {
+		if !reviewTargetTypes[*req.TargetType] {
+			return nil, errno.ParamVerifyError.WithMessage("审核对象类型无效")
+		}
+		targetType = *req.TargetType
+	}
+
+	now := time.Now()
+	reviews, err := db.ClaimNextReviews(s.ctx, GetUidFormContext(s.c), targetType, int(req.Count), reviewMaxClaims(),
+		now.Add(reviewLease()), now)
+	if err != nil {
+		return nil, err
+	}
+	return toReviewModules(reviews), nil
+}
+
+// ClaimReview 认领指定审核记录，已持有认领时续期
+func (s *ReviewQueueService) ClaimReview(req *audit.ClaimReviewReq) (*model.Review, error) {
+	if req.ReviewID <= 0 {
+		return nil, errno.NewErrNo(errno.ServiceInvalidParameter, "审核记录ID无效")
+	}
+
+	reviewerID := GetUidFormContext(s.c)
+	now := time.Now()
+	held, err := db.CountActiveReviewClaims(s.ctx, reviewerID, now)
+	if err != nil {
+		return nil, err
+	}
+	if held >= reviewMaxClaims() {
+		// 已达上限时仍允许为自己持有的认领续期
+		review, err := db.GetReviewByID(s.ctx, req.ReviewID)
+		if err != nil {
+			return nil, err
+		}
+		if review.ClaimedBy == nil || *review.ClaimedBy != reviewerID ||
+			review.ClaimExpiresAt == nil || !review.ClaimExpiresAt.After(now) {
+			return nil, errno.ReviewClaimLimitExceededError
+		}
+	}
+
+	review, err := db.ClaimReview(s.ctx, req.ReviewID, reviewerID, now.Add(reviewLease()), now)
+	if err != nil {
+		return nil, err
+	}
+	return review.ToReviewModule(), nil
+}
+
+// ReleaseReview 释放本人持有的认领，使记录回到队列
+func (s *ReviewQueueService) ReleaseReview(req *audit.ReleaseReviewReq) error {
+	if req.ReviewID <= 0 {
+		return errno.NewErrNo(errno.ServiceInvalidParameter, "审核记录ID无效")
+	}
+	return db.ReleaseReviewClaim(s.ctx, req.ReviewID, GetUidFormContext(s.c))
+}
+
+// GetReviewInbox 当前审核员已认领且未过期的审核记录
+func (s *ReviewQueueService) GetReviewInbox() ([]*model.Review, error) {
+	reviews, err := db.ListReviewerInbox(s.ctx, GetUidFormContext(s.c), time.Now())
+	if err != nil {
+		return nil, err
+	}
+	return toReviewModules(reviews), nil
+}
+
+// GetReviewQueueStats 审核队列概况：待处理、认领、超时与升级数量及最早待处理记录的时间
+func (s *ReviewQueueService) GetReviewQueueStats(req *audit.GetReviewQueueStatsReq) (*model.ReviewQueueStats, error) {
+	targetType := ""
+	if req.TargetType != nil && *req.TargetType != "" {
+		if !reviewTargetTypes[*req.TargetType] {
+			return nil, errno.ParamVerifyError.WithMessage("审核对象类型无效")
+		}
+		targetType = *req.TargetType
+	}
+
+	stats, err := db.GetReviewQueueStats(s.ctx, targetType, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	result := &model.ReviewQueueStats{
+		Pending:   stats.Pending,
+		Unclaimed: stats.Unclaimed,
+		Claimed:   stats.Claimed,
+		Overdue:   stats.Overdue,
+		Escalated: stats.Escalated,
+	}
+	if stats.OldestPendingAt != nil {
+		oldest := stats.OldestPendingAt.Unix()
+		result.OldestPendingAt = &oldest
+	}
+	return result, nil
+}
+
+// GetReviewReporters 合并到审核记录的全部举报人及其理由
+func (s *ReviewQueueService) GetReviewReporters(req *audit.GetReviewReportersReq) ([]*model.ReviewReporter, error) {
+	if req.ReviewID <= 0 {
+		return nil, errno.NewErrNo(errno.ServiceInvalidParameter, "审核记录ID无效")
+	}
+	reporters, err := db.GetReviewReporters(s.ctx, req.ReviewID)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*model.ReviewReporter, 0, len(reporters))
+	for _, r := range reporters {
+		list = append(list, r.ToReviewReporterModule())
+	}
+	return list, nil
+}
+
+func toReviewModules(reviews []*db.Review) []*model.Review {
+	list := make([]*model.Review, 0, len(reviews))
+	for _, r := range reviews {
+		list = append(list, r.ToReviewModule())
+	}
+	return list
+}
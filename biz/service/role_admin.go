@@ -5,11 +5,16 @@ import (
 	"LearnShare/biz/dal/redis"
 	"LearnShare/biz/model/module"
 	"LearnShare/biz/model/user"
+	"LearnShare/pkg/constants"
+	"LearnShare/pkg/errno"
+	"LearnShare/pkg/logger"
 	"context"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/cloudwego/hertz/pkg/app"
+	"go.uber.org/zap"
 )
 
 type RoleAdminService struct {
@@ -55,35 +60,206 @@ func (s *RoleAdminService) AddRole(req *user.AddRoleReq) (int64, error) {
 	if err != nil {
 		return 0, err
 	}
+	if err := s.invalidateRolePermissions(roleID); err != nil {
+		return 0, err
+	}
 
+	RecordAdminChange(s.c, "role", roleID, nil, s.roleSnapshot(roleID))
 	return roleID, nil
 }
 
 func (s *RoleAdminService) GetRolePermissions(roleID int64) ([]string, error) {
-	var (
-		permissions []string
-		err         error
-	)
-	// 优先从缓存中获取
-	if redis.IsKeyExist(s.ctx, "role_permissions_"+strconv.FormatInt(roleID, 10)) {
-		data, err := redis.GetPermissionCache(s.ctx, "role_permissions_"+strconv.FormatInt(roleID, 10))
-		if err != nil {
-			return nil, err
+	return loadRolePermissions(s.ctx, roleID)
+}
+
+// UpdateRole 修改角色名称与描述
+func (s *RoleAdminService) UpdateRole(req *user.UpdateRoleReq) error {
+	if _, err := s.getRole(req.RoleID); err != nil {
+		return err
+	}
+	if req.RoleName != nil {
+		name := strings.TrimSpace(*req.RoleName)
+		if name == "" || len([]rune(name)) > 50 {
+			return errno.ParamVerifyError.WithMessage("角色名称长度应为1-50个字符")
+		}
+		req.RoleName = &name
+	}
+	if req.Description != nil && len([]rune(*req.Description)) > 500 {
+		return errno.ParamVerifyError.WithMessage("角色描述不能超过500个字符")
+	}
+
+	before := s.roleSnapshot(req.RoleID)
+	if err := db.UpdateRole(s.ctx, req.RoleID, req.RoleName, req.Description); err != nil {
+		return err
+	}
+	RecordAdminChange(s.c, "role", req.RoleID, before, s.roleSnapshot(req.RoleID))
+	s.logRoleChange("role_update", req.RoleID, zap.Stringp("role_name", req.RoleName))
+	return nil
+}
+
+// GrantRolePermissions 为角色授予权限
+func (s *RoleAdminService) GrantRolePermissions(req *user.GrantRolePermissionsReq) error {
+	if err := s.checkRolePermissionChange(req.RoleID, req.PermissionIds); err != nil {
+		return err
+	}
+	before := s.roleSnapshot(req.RoleID)
+	if err := db.GrantRolePermissions(s.ctx, req.RoleID, req.PermissionIds); err != nil {
+		return err
+	}
+	RecordAdminChange(s.c, "role", req.RoleID, before, s.roleSnapshot(req.RoleID))
+	s.logRoleChange("role_grant", req.RoleID, zap.Int64s("permission_ids", req.PermissionIds))
+	return s.invalidateRolePermissions(req.RoleID)
+}
+
+// RevokeRolePermissions 移除角色的权限
+func (s *RoleAdminService) RevokeRolePermissions(req *user.RevokeRolePermissionsReq) error {
+	if err := s.checkRolePermissionChange(req.RoleID, req.PermissionIds); err != nil {
+		return err
+	}
+	before := s.roleSnapshot(req.RoleID)
+	if err := db.RevokeRolePermissions(s.ctx, req.RoleID, req.PermissionIds); err != nil {
+		return err
+	}
+	RecordAdminChange(s.c, "role", req.RoleID, before, s.roleSnapshot(req.RoleID))
+	s.logRoleChange("role_revoke", req.RoleID, zap.Int64s("permission_ids", req.PermissionIds))
+	return s.invalidateRolePermissions(req.RoleID)
+}
+
+// DeleteRole 删除角色，角色下的用户转移到指定角色并需重新登录
+func (s *RoleAdminService) DeleteRole(req *user.DeleteRoleReq) (int64, error) {
+	for _, id := range constants.BuiltinRoleIDs {
+		if req.RoleID == id {
+			return 0, errno.RoleProtectedError
 		}
-		permissions = deserializePermissions(data)
-		return permissions, nil
+	}
+	if req.ReassignRoleID == req.RoleID {
+		return 0, errno.RoleReassignInvalidError
+	}
+	if _, err := s.getRole(req.RoleID); err != nil {
+		return 0, err
+	}
+	target, err := db.GetRoleByID(s.ctx, req.ReassignRoleID)
+	if err != nil {
+		return 0, err
+	}
+	if target == nil {
+		return 0, errno.RoleReassignInvalidError
+	}
+
+	before := s.roleSnapshot(req.RoleID)
+	userIDs, err := db.DeleteRole(s.ctx, req.RoleID, req.ReassignRoleID)
+	if err != nil {
+		return 0, err
+	}
+	RecordAdminChange(s.c, "role", req.RoleID, before, map[string]any{
+		"reassign_role_id": req.ReassignRoleID,
+		"reassigned_users": userIDs,
+	})
+	if err := s.invalidateRolePermissions(req.RoleID); err != nil {
+		return 0, err
+	}
 
-	} else {
-		permissions, err = db.GetRolePermissions(s.ctx, roleID)
-		if err != nil {
-			return nil, err
+	// 登录令牌中携带角色ID，吊销被转移用户的会话使其以新角色重新登录
+	// 个人访问令牌按用户当前角色鉴权，角色变更后无需吊销
+	now := time.Now()
+	for _, userID := range userIDs {
+		if err := redis.DeleteUserInfoCache(s.ctx, strconv.FormatInt(userID, 10)); err != nil {
+			return 0, err
 		}
-		err = redis.SetPermissionCache(s.ctx, "role_permissions_"+strconv.FormatInt(roleID, 10), serializePermissions(permissions))
-		if err != nil {
-			return nil, err
+		if err := redis.RevokeUserSessions(s.ctx, userID, now, sessionRevokeTTL); err != nil {
+			return 0, err
 		}
 	}
-	return permissions, nil
+
+	s.logRoleChange("role_delete", req.RoleID,
+		zap.Int64("reassign_role_id", req.ReassignRoleID),
+		zap.Int("reassigned_users", len(userIDs)),
+	)
+	return int64(len(userIDs)), nil
+}
+
+// GetRoleUsers 分页查询角色下的用户
+func (s *RoleAdminService) GetRoleUsers(req *user.GetRoleUsersReq) ([]*module.User, int64, error) {
+	if req.PageNum <= 0 || req.PageSize <= 0 || req.PageSize > 100 {
+		return nil, 0, errno.ParamVerifyError.WithMessage("分页参数无效")
+	}
+	if _, err := s.getRole(req.RoleID); err != nil {
+		return nil, 0, err
+	}
+
+	users, total, err := db.GetUsersByRole(s.ctx, req.RoleID, int(req.PageNum), int(req.PageSize))
+	if err != nil {
+		return nil, 0, err
+	}
+	userList := make([]*module.User, 0, len(users))
+	for _, u := range users {
+		u.PasswordHash = ""
+		userList = append(userList, u.ToUserModule())
+	}
+	return userList, total, nil
+}
+
+// getRole 查询角色，不存在时返回 RoleNotFoundError
+func (s *RoleAdminService) getRole(roleID int64) (*db.Role, error) {
+	role, err := db.GetRoleByID(s.ctx, roleID)
+	if err != nil {
+		return nil, err
+	}
+	if role == nil {
+		return nil, errno.RoleNotFoundError
+	}
+	return role, nil
+}
+
+// checkRolePermissionChange 校验角色与待变更的权限均存在
+func (s *RoleAdminService) checkRolePermissionChange(roleID int64, permissionIDs []int64) error {
+	if len(permissionIDs) == 0 {
+		return errno.ParamVerifyError.WithMessage("权限列表不能为空")
+	}
+	if _, err := s.getRole(roleID); err != nil {
+		return err
+	}
+
+	unique := make(map[int64]struct{}, len(permissionIDs))
+	for _, id := range permissionIDs {
+		unique[id] = struct{}{}
+	}
+	count, err := db.CountExistingPermissions(s.ctx, permissionIDs)
+	if err != nil {
+		return err
+	}
+	if count != int64(len(unique)) {
+		return errno.ParamVerifyError.WithMessage("包含不存在的权限")
+	}
+	return nil
+}
+
+// invalidateRolePermissions 角色权限变更后使权限缓存失效
+func (s *RoleAdminService) invalidateRolePermissions(roleID int64) error {
+	return invalidatePermissions(s.ctx, roleID)
+}
+
+// roleSnapshot 角色及其权限列表的快照，用于管理操作日志
+func (s *RoleAdminService) roleSnapshot(roleID int64) any {
+	role := adminSnapshot(s.ctx, constants.RoleTableName, "role_id", roleID)
+	if role == nil {
+		return nil
+	}
+	snapshot := role.(map[string]any)
+	if permissions, err := db.GetRolePermissions(s.ctx, roleID); err == nil {
+		snapshot["permissions"] = permissions
+	}
+	return snapshot
+}
+
+// logRoleChange 记录角色变更审计日志
+func (s *RoleAdminService) logRoleChange(action string, roleID int64, fields ...zap.Field) {
+	fields = append([]zap.Field{
+		zap.String("audit", action),
+		zap.Int64("operator_id", GetUidFormContext(s.c)),
+		zap.Int64("role_id", roleID),
+	}, fields...)
+	logger.WithFields(fields...).Info("角色变更")
 }
 
 func serializePermissions(permissions []string) string {
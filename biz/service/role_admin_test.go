@@ -0,0 +1,169 @@
+package service
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"testing"
+
+	"LearnShare/biz/dal/db"
+	redisDal "LearnShare/biz/dal/redis"
+	"LearnShare/biz/model/user"
+	"LearnShare/pkg/constants"
+	"LearnShare/pkg/errno"
+
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// setupRoleTestDB 在用户测试数据库上补充角色与权限表，并写入内置角色
+func setupRoleTestDB(t *testing.T) func() {
+	t.Helper()
+	cleanup := setupTestDB(t)
+	createTableSQL := `
+CREATE TABLE IF NOT EXISTS permissions (
+    permission_id INTEGER PRIMARY KEY AUTOINCREMENT,
+    permission_name TEXT UNIQUE,
+    description TEXT
+);
+CREATE TABLE IF NOT EXISTS roles (
+    role_id INTEGER PRIMARY KEY AUTOINCREMENT,
+    role_name TEXT UNIQUE,
+    description TEXT
+);
+CREATE TABLE IF NOT EXISTS role_permissions (
+    role_id INTEGER,
+    permission_id INTEGER,
+    PRIMARY KEY (role_id, permission_id)
+);
+INSERT INTO roles (role_id, role_name) VALUES (1, 'super_admin'), (2, 'user'), (3, 'auditor');
+INSERT INTO permissions (permission_id, permission_name) VALUES (1, 'resource.upload'), (2, 'course.rate');
+`
+	if err := db.DB.Exec(createTableSQL).Error; err != nil {
+		t.Fatalf("创建角色测试数据表失败: %v", err)
+	}
+	return cleanup
+}
+
+func assertRolePermissions(t *testing.T, roleID int64, expected ...string) {
+	t.Helper()
+	permissions, err := loadRolePermissions(context.Background(), roleID)
+	if err != nil {
+		t.Fatalf("读取角色权限失败: %v", err)
+	}
+	sort.Strings(permissions)
+	if len(expected) == 0 && len(permissions) == 0 {
+		return
+	}
+	if !reflect.DeepEqual(permissions, expected) {
+		t.Fatalf("角色权限不正确: 期望 %v, 实际 %v", expected, permissions)
+	}
+}
+
+func TestRoleAdminGrantRevokeInvalidatesPermissions(t *testing.T) {
+	cleanupDB := setupRoleTestDB(t)
+	defer cleanupDB()
+	_, cleanupRedis := setupTestRedis(t)
+	defer cleanupRedis()
+
+	svc := NewRoleAdminService(context.Background(), buildRequestContextWithUser(1))
+	roleID, err := svc.AddRole(&user.AddRoleReq{RoleName: "uploader", PermissionIds: []int64{1}})
+	if err != nil {
+		t.Fatalf("创建角色失败: %v", err)
+	}
+	// 读取一次使快照写入缓存
+	assertRolePermissions(t, roleID, "resource.upload")
+
+	if err := svc.GrantRolePermissions(&user.GrantRolePermissionsReq{RoleID: roleID, PermissionIds: []int64{2}}); err != nil {
+		t.Fatalf("授予权限失败: %v", err)
+	}
+	assertRolePermissions(t, roleID, "course.rate", "resource.upload")
+
+	if err := svc.RevokeRolePermissions(&user.RevokeRolePermissionsReq{RoleID: roleID, PermissionIds: []int64{1}}); err != nil {
+		t.Fatalf("移除权限失败: %v", err)
+	}
+	assertRolePermissions(t, roleID, "course.rate")
+
+	version, err := redisDal.GetPermissionVersion(context.Background())
+	if err != nil || version != 3 {
+		t.Fatalf("新增、授予与移除都应使权限版本前进: version=%d err=%v", version, err)
+	}
+
+	err = svc.GrantRolePermissions(&user.GrantRolePermissionsReq{RoleID: roleID, PermissionIds: []int64{99}})
+	assertErrCode(t, err, errno.ParamVerifyErrorCode)
+	err = svc.GrantRolePermissions(&user.GrantRolePermissionsReq{RoleID: 99, PermissionIds: []int64{1}})
+	assertErrCode(t, err, errno.RoleNotFound)
+}
+
+func TestRoleAdminDeleteRoleReassignsUsers(t *testing.T) {
+	cleanupDB := setupRoleTestDB(t)
+	defer cleanupDB()
+	_, cleanupRedis := setupTestRedis(t)
+	defer cleanupRedis()
+
+	ctx := context.Background()
+	svc := NewRoleAdminService(ctx, buildRequestContextWithUser(1))
+	roleID, err := svc.AddRole(&user.AddRoleReq{RoleName: "helper", PermissionIds: []int64{1}})
+	if err != nil {
+		t.Fatalf("创建角色失败: %v", err)
+	}
+
+	var members []db.User
+	for _, name := range []string{"helper1", "helper2"} {
+		u := seedUser(t, name, name+"@example.com", "Pass1234")
+		activateUser(t, u.UserID)
+		if err := db.DB.Table(constants.UserTableName).Where("user_id = ?", u.UserID).Update("role_id", roleID).Error; err != nil {
+			t.Fatalf("设置用户角色失败: %v", err)
+		}
+		members = append(members, u)
+	}
+	plain := constants.PersonalTokenPrefix + "helper0000000000"
+	seedPersonalToken(t, members[0].UserID, plain, "resource.upload", nil, nil)
+
+	moved, err := svc.DeleteRole(&user.DeleteRoleReq{RoleID: roleID, ReassignRoleID: constants.RoleUserID})
+	if err != nil || moved != 2 {
+		t.Fatalf("删除角色失败: moved=%d err=%v", moved, err)
+	}
+	if role, err := db.GetRoleByID(ctx, roleID); err != nil || role != nil {
+		t.Fatalf("角色应当已删除: role=%+v err=%v", role, err)
+	}
+	for _, u := range members {
+		stored, err := db.GetUserByID(ctx, u.UserID)
+		if err != nil || stored.RoleID != constants.RoleUserID {
+			t.Fatalf("用户应转移到指定角色: user=%+v err=%v", stored, err)
+		}
+		if at, err := redisDal.GetUserSessionsRevokedAt(ctx, u.UserID); err != nil || at == 0 {
+			t.Fatalf("被转移用户的会话应当吊销: at=%d err=%v", at, err)
+		}
+	}
+	// 个人访问令牌按当前角色鉴权，删除角色不吊销
+	if err := NewUserService(ctx, app.NewContext(0)).AuthenticatePersonalToken(plain); err != nil {
+		t.Fatalf("删除角色后个人访问令牌应当仍然有效: %v", err)
+	}
+}
+
+func TestRoleAdminDeleteRoleRejectsProtectedRoles(t *testing.T) {
+	cleanupDB := setupRoleTestDB(t)
+	defer cleanupDB()
+	_, cleanupRedis := setupTestRedis(t)
+	defer cleanupRedis()
+
+	svc := NewRoleAdminService(context.Background(), buildRequestContextWithUser(1))
+	for _, id := range constants.BuiltinRoleIDs {
+		_, err := svc.DeleteRole(&user.DeleteRoleReq{RoleID: id, ReassignRoleID: constants.RoleUserID})
+		assertErrCode(t, err, errno.RoleProtected)
+		if role, err := db.GetRoleByID(context.Background(), id); err != nil || role == nil {
+			t.Fatalf("内置角色不应被删除: role_id=%d err=%v", id, err)
+		}
+	}
+
+	roleID, err := svc.AddRole(&user.AddRoleReq{RoleName: "temp", PermissionIds: []int64{1}})
+	if err != nil {
+		t.Fatalf("创建角色失败: %v", err)
+	}
+	_, err = svc.DeleteRole(&user.DeleteRoleReq{RoleID: roleID, ReassignRoleID: roleID})
+	assertErrCode(t, err, errno.RoleReassignInvalid)
+	_, err = svc.DeleteRole(&user.DeleteRoleReq{RoleID: roleID, ReassignRoleID: 99})
+	assertErrCode(t, err, errno.RoleReassignInvalid)
+	_, err = svc.DeleteRole(&user.DeleteRoleReq{RoleID: 99, ReassignRoleID: constants.RoleUserID})
+	assertErrCode(t, err, errno.RoleNotFound)
+}
@@ -4,6 +4,7 @@ import (
 	"LearnShare/biz/dal/db"
 	"LearnShare/biz/model/module"
 	"LearnShare/biz/model/school_struct"
+	"LearnShare/pkg/constants"
 	"LearnShare/pkg/errno"
 	"context"
 	"strconv"
@@ -96,6 +97,7 @@ func (s *SchoolStructService) AdminAddCollege(req *school_struct.AdminAddCollege
 			return 0, errno.NewErrNo(errno.InternalDatabaseErrorCode, "添加学院失败: "+err.Error())
 		}
 	case id := <-idChan:
+		RecordAdminChange(s.c, "college", id, nil, adminSnapshot(s.ctx, constants.CollegeTableName, "college_id", id))
 		return id, nil
 	}
 
@@ -112,6 +114,7 @@ func (s *SchoolStructService) AdminAddMajor(req *school_struct.AdminAddMajorReq)
 			return 0, errno.NewErrNo(errno.InternalDatabaseErrorCode, "添加专业失败: "+err.Error())
 		}
 	case id := <-idChan:
+		RecordAdminChange(s.c, "major", id, nil, adminSnapshot(s.ctx, constants.MajorTableName, "major_id", id))
 		return id, nil
 	}
 
@@ -134,6 +137,7 @@ func (s *SchoolStructService) AdminAddTeacher(req *school_struct.AdminAddTeacher
 			return 0, errno.NewErrNo(errno.InternalDatabaseErrorCode, "添加教师失败: "+err.Error())
 		}
 	case id := <-idChan:
+		RecordAdminChange(s.c, "teacher", id, nil, adminSnapshot(s.ctx, constants.TeacherTableName, "teacher_id", id))
 		return id, nil
 	}
 
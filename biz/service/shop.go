@@ -0,0 +1,253 @@
+package service
+
+import (
+	"LearnShare/biz/dal/db"
+	model "LearnShare/biz/model/module"
+	"LearnShare/biz/model/shop"
+	"LearnShare/pkg/constants"
+	"LearnShare/pkg/errno"
+	"LearnShare/pkg/logger"
+	"LearnShare/pkg/oss"
+	"context"
+	"mime/multipart"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+const (
+	maxItemNameLength        = 100
+	maxItemDescriptionLength = 500
+	maxItemPrice             = 100000
+)
+
+// itemTypes 物品类型，每种类型同一时间只能使用一件
+var itemTypes = map[string]bool{
+	"avatar_frame":   true,
+	"background":     true,
+	"medal":          true,
+	"nickname_color": true,
+}
+
+func validItemType(itemType *string) bool {
+	return itemType == nil || *itemType == "" || itemTypes[*itemType]
+}
+
+// ShopService 积分商城：用信誉分兑换并使用装扮物品
+type ShopService struct {
+	ctx context.Context
+	c   *app.RequestContext
+}
+
+func NewShopService(ctx context.Context, c *app.RequestContext) *ShopService {
+	return &ShopService{ctx: ctx, c: c}
+}
+
+// ListItems 分页查询商城物品，可按类型筛选
+func (s *ShopService) ListItems(req *shop.ListItemsReq) ([]*model.Item, int64, error) {
+	if !validItemType(req.Type) {
+		return nil, 0, errno.ParamVerifyError.WithMessage("物品类型无效")
+	}
+	if req.PageNum <= 0 {
+		req.PageNum = 1
+	}
+	if req.PageSize <= 0 || req.PageSize > 100 {
+		req.PageSize = 20
+	}
+	items, total, err := db.ListItems(s.ctx, req.GetType(), int(req.PageNum), int(req.PageSize))
+	if err != nil {
+		return nil, 0, err
+	}
+	list := make([]*model.Item, 0, len(items))
+	for _, item := range items {
+		list = append(list, item.ToItemModule())
+	}
+	return list, total, nil
+}
+
+// PurchaseItem 用信誉分兑换物品，返回获得的物品与兑换后的信誉分
+func (s *ShopService) PurchaseItem(req *shop.PurchaseItemReq) (*model.UserItem, int64, error) {
+	if req.ItemID <= 0 {
+		return nil, 0, errno.NewErrNo(errno.ServiceInvalidParameter, "物品ID无效")
+	}
+	uid := GetUidFormContext(s.c)
+	userItem, score, err := db.PurchaseItem(s.ctx, uid, req.ItemID)
+	if err != nil {
+		return nil, 0, err
+	}
+	refreshLeaderboards(uid)
+	return userItem.ToUserItemModule(), score, nil
+}
+
+// ListMyItems 查询当前用户拥有的物品，可按类型筛选
+func (s *ShopService) ListMyItems(req *shop.ListMyItemsReq) ([]*model.UserItem, error) {
+	if !validItemType(req.Type) {
+		return nil, errno.ParamVerifyError.WithMessage("物品类型无效")
+	}
+	userItems, err := db.ListUserItems(s.ctx, GetUidFormContext(s.c), req.GetType())
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*model.UserItem, 0, len(userItems))
+	for _, ui := range userItems {
+		list = append(list, ui.ToUserItemModule())
+	}
+	return list, nil
+}
+
+// EquipItem 使用已拥有的物品，同类型的其他物品自动取消使用
+func (s *ShopService) EquipItem(req *shop.EquipItemReq) error {
+	if req.ItemID <= 0 {
+		return errno.NewErrNo(errno.ServiceInvalidParameter, "物品ID无效")
+	}
+	return db.EquipUserItem(s.ctx, GetUidFormContext(s.c), req.ItemID)
+}
+
+// UnequipItem 取消使用已拥有的物品
+func (s *ShopService) UnequipItem(req *shop.UnequipItemReq) error {
+	if req.ItemID <= 0 {
+		return errno.NewErrNo(errno.ServiceInvalidParameter, "物品ID无效")
+	}
+	return db.UnequipUserItem(s.ctx, GetUidFormContext(s.c), req.ItemID)
+}
+
+// ShopAdminService 管理端：维护商城物品
+type ShopAdminService struct {
+	ctx context.Context
+	c   *app.RequestContext
+}
+
+func NewShopAdminService(ctx context.Context, c *app.RequestContext) *ShopAdminService {
+	return &ShopAdminService{ctx: ctx, c: c}
+}
+
+// validateItem 校验物品名称、类型、价格与描述，传入 nil 表示不修改该字段
+func validateItem(name, itemType *string, price *int64, description *string) error {
+	if name != nil && (strings.TrimSpace(*name) == "" || utf8.RuneCountInString(*name) > maxItemNameLength) {
+		return errno.ParamVerifyError.WithMessage("物品名称不能为空且不能超过100字")
+	}
+	if itemType != nil && !itemTypes[*itemType] {
+		return errno.ParamVerifyError.WithMessage("物品类型须为 avatar_frame、background、medal 或 nickname_color")
+	}
+	if price != nil && (*price < 0 || *price > maxItemPrice) {
+		return errno.ParamVerifyError.WithMessage("物品价格须在0-100000之间")
+	}
+	if description != nil && utf8.RuneCountInString(*description) > maxItemDescriptionLength {
+		return errno.ParamVerifyError.WithMessage("物品描述不能超过500字")
+	}
+	return nil
+}
+
+// AdminCreateItem 创建物品并上传预览图
+func (s *ShopAdminService) AdminCreateItem(req *shop.AdminCreateItemReq, image *multipart.FileHeader) (*model.Item, error) {
+	req.Name = strings.TrimSpace(req.Name)
+	if err := validateItem(&req.Name, &req.Type, &req.Price, req.Description); err != nil {
+		return nil, err
+	}
+
+	url, err := oss.UploadFile(image, "item", 0)
+	if err != nil {
+		return nil, err
+	}
+	item := &db.Item{
+		Name:        req.Name,
+		Type:        req.Type,
+		Price:       req.Price,
+		Description: req.Description,
+		ImageURL:    &url,
+	}
+	if err := db.CreateItem(s.ctx, item); err != nil {
+		deleteItemImage(url)
+		return nil, err
+	}
+	RecordAdminChange(s.c, "item", item.ItemID, nil, adminSnapshot(s.ctx, constants.ItemTableName, "item_id", item.ItemID))
+	return item.ToItemModule(), nil
+}
+
+// AdminUpdateItem 更新物品，只修改传入的字段；上传了新预览图时替换原图
+func (s *ShopAdminService) AdminUpdateItem(req *shop.AdminUpdateItemReq, image *multipart.FileHeader) (*model.Item, error) {
+	if req.ItemID <= 0 {
+		return nil, errno.NewErrNo(errno.ServiceInvalidParameter, "物品ID无效")
+	}
+	if req.Name != nil {
+		name := strings.TrimSpace(*req.Name)
+		req.Name = &name
+	}
+	if err := validateItem(req.Name, req.Type, req.Price, req.Description); err != nil {
+		return nil, err
+	}
+	item, err := db.GetItemByID(s.ctx, req.ItemID)
+	if err != nil {
+		return nil, err
+	}
+
+	updates := map[string]interface{}{}
+	if req.Name != nil {
+		updates["name"] = *req.Name
+	}
+	if req.Type != nil && *req.Type != item.Type {
+		updates["type"] = *req.Type
+	}
+	if req.Price != nil {
+		updates["price"] = *req.Price
+	}
+	if req.Description != nil {
+		updates["description"] = *req.Description
+	}
+	var url string
+	if image != nil {
+		if url, err = oss.UploadFile(image, "item", req.ItemID); err != nil {
+			return nil, err
+		}
+		updates["image_url"] = url
+	}
+	if len(updates) == 0 {
+		return item.ToItemModule(), nil
+	}
+
+	before := adminSnapshot(s.ctx, constants.ItemTableName, "item_id", req.ItemID)
+	if err := db.UpdateItem(s.ctx, req.ItemID, updates); err != nil {
+		if url != "" {
+			deleteItemImage(url)
+		}
+		return nil, err
+	}
+	if url != "" && item.ImageURL != nil {
+		deleteItemImage(*item.ImageURL)
+	}
+	RecordAdminChange(s.c, "item", req.ItemID, before, adminSnapshot(s.ctx, constants.ItemTableName, "item_id", req.ItemID))
+
+	updated, err := db.GetItemByID(s.ctx, req.ItemID)
+	if err != nil {
+		return nil, err
+	}
+	return updated.ToItemModule(), nil
+}
+
+// AdminDeleteItem 删除没有用户拥有的物品及其预览图
+func (s *ShopAdminService) AdminDeleteItem(req *shop.AdminDeleteItemReq) error {
+	if req.ItemID <= 0 {
+		return errno.NewErrNo(errno.ServiceInvalidParameter, "物品ID无效")
+	}
+	item, err := db.GetItemByID(s.ctx, req.ItemID)
+	if err != nil {
+		return err
+	}
+	before := adminSnapshot(s.ctx, constants.ItemTableName, "item_id", req.ItemID)
+	if err := db.DeleteItem(s.ctx, req.ItemID); err != nil {
+		return err
+	}
+	if item.ImageURL != nil {
+		deleteItemImage(*item.ImageURL)
+	}
+	RecordAdminChange(s.c, "item", req.ItemID, before, nil)
+	return nil
+}
+
+// deleteItemImage 删除对象存储中的物品预览图，失败只记录日志
+func deleteItemImage(url string) {
+	if err := oss.DeleteByURL(url); err != nil {
+		logger.Warnf("删除物品预览图失败: url=%s err=%v", url, err)
+	}
+}
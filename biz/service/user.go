@@ -12,6 +12,7 @@ import (
 	"context"
 	"mime/multipart"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/cloudwego/hertz/pkg/app"
@@ -37,7 +38,7 @@ func (s *UserService) Register(req *user.RegisterReq) error {
 		return err
 	}
 
-	if valid, err := utils.VerifyPassword(req.Password); !valid {
+	if err := checkPasswordPolicy(req.Password, req.Username, req.Email); err != nil {
 		return err
 	}
 
@@ -70,12 +71,32 @@ func (s *UserService) Register(req *user.RegisterReq) error {
 		return err
 	}
 
+	// 激活邮件发送失败不影响注册，用户可稍后重新发送
+	if err := s.sendActivationCode(req.Email); err != nil {
+		logger.WithFields(
+			zap.String("email", logger.MaskEmail(req.Email)),
+			zap.Error(err),
+		).Error("发送激活邮件失败")
+	}
+
 	return nil
 }
 
 func (s *UserService) LoginIn(req *user.LoginInReq) (*module.User, error) {
+	ip := s.clientIP()
+	if err := s.checkLoginIP(ip); err != nil {
+		return nil, err
+	}
+
 	userInfo, err := db.GetUserByEmail(s.ctx, req.Email)
 	if err != nil {
+		if lockErr := s.onLoginFailure(req.Email, ip, nil, "用户不存在"); lockErr != nil {
+			return nil, lockErr
+		}
+		return nil, err
+	}
+
+	if err := s.checkAccountLock(userInfo); err != nil {
 		return nil, err
 	}
 
@@ -84,6 +105,13 @@ func (s *UserService) LoginIn(req *user.LoginInReq) (*module.User, error) {
 			zap.String("email", logger.MaskEmail(req.Email)),
 			zap.Int64("user_id", userInfo.UserID),
 		).Warn("登录失败：密码错误")
+		if lockErr := s.onLoginFailure(req.Email, ip, userInfo, "密码错误"); lockErr != nil {
+			return nil, lockErr
+		}
+		return nil, err
+	}
+
+	if err := s.onLoginSuccess(req.Email, ip, userInfo.UserID); err != nil {
 		return nil, err
 	}
 
@@ -157,24 +185,60 @@ func (s *UserService) VerifyEmail(req *user.VerifyEmailReq) error {
 	return nil
 }
 
+// UpdateEmail 修改邮箱，需要旧邮箱与新邮箱的验证码同时确认，并向旧邮箱发送撤销链接
 func (s *UserService) UpdateEmail(req *user.UpdateEmailReq) error {
-
 	userId := GetUidFormContext(s.c)
 
-	storeCode, err := redis.GetCodeCache(s.ctx, req.NewEmail)
+	userInfo, err := db.GetUserByID(s.ctx, userId)
 	if err != nil {
 		return err
 	}
+	oldEmail := userInfo.Email
 
-	if storeCode != req.Code {
-		return errno.UserVerificationCodeInvalidError
+	if valid, err := utils.VerifyEmail(req.NewEmail); !valid {
+		return err
+	}
+	if strings.EqualFold(oldEmail, req.NewEmail) {
+		return errno.NewErrNo(errno.ServiceInvalidEmail, "新邮箱不能与当前邮箱相同")
+	}
+	existing, err := db.FindUserByEmail(s.ctx, req.NewEmail)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		return errno.UserEmailInUseError
 	}
 
-	// 使用异步更新用户邮箱
-	errChan := db.UpdateUserEmailAsync(s.ctx, int(userId), req.NewEmail)
-	if err := <-errChan; err != nil {
+	if err := s.checkEmailCode(oldEmail, req.OldCode); err != nil {
+		return err
+	}
+	if err := s.checkEmailCode(req.NewEmail, req.Code); err != nil {
+		return err
+	}
+
+	ip := s.clientIP()
+	if err := db.ChangeUserEmail(s.ctx, userId, oldEmail, req.NewEmail, "change", &ip); err != nil {
 		return err
 	}
+	_ = redis.DeleteCodeCache(s.ctx, oldEmail)
+	_ = redis.DeleteCodeCache(s.ctx, req.NewEmail)
+	_ = redis.DeleteUserInfoCache(s.ctx, strconv.FormatInt(userId, 10))
+
+	logger.WithFields(
+		zap.String("audit", "email_change"),
+		zap.Int64("user_id", userId),
+		zap.String("old_email", logger.MaskEmail(oldEmail)),
+		zap.String("new_email", logger.MaskEmail(req.NewEmail)),
+		zap.String("ip", ip),
+	).Info("用户修改邮箱")
+
+	// 撤销通知发送失败不回滚修改，只记录日志
+	if err := s.sendEmailRevertLink(userId, oldEmail, req.NewEmail); err != nil {
+		logger.WithFields(
+			zap.Int64("user_id", userId),
+			zap.Error(err),
+		).Error("发送邮箱修改撤销链接失败")
+	}
 	return nil
 }
 
@@ -195,22 +259,12 @@ func (s *UserService) UpdatePassword(req *user.UpdatePasswordReq) error {
 		).Warn("修改密码失败：旧密码错误")
 		return errno.UserPasswordIncorrectError
 	}
-	newPasswordHash, err := utils.EncryptPassword(req.NewPassword)
-	if err != nil {
-		logger.WithFields(
-			zap.Int64("user_id", userId),
-			zap.Error(err),
-		).Error("新密码加密失败")
-		return err
-	}
 
-	// 使用异步更新用户密码
-	errChan := db.UpdateUserPasswordAsync(s.ctx, userInfo.UserID, newPasswordHash)
-	if err := <-errChan; err != nil {
+	if err := changePassword(s.ctx, userInfo, req.NewPassword); err != nil {
 		logger.WithFields(
 			zap.Int64("user_id", userId),
 			zap.Error(err),
-		).Error("更新用户密码失败")
+		).Warn("修改密码失败")
 		return err
 	}
 
@@ -245,6 +299,12 @@ func (s *UserService) UpdateMajor(req *user.UpdateMajorReq) error {
 	if err := <-errChan; err != nil {
 		return err
 	}
+	// 移出原专业的排行榜
+	if userInfo.MajorID != nil && *userInfo.MajorID != req.NewMajorId {
+		refreshLeaderboards(userInfo.UserID, majorLeaderboardScope(*userInfo.MajorID))
+	} else {
+		refreshLeaderboards(userInfo.UserID)
+	}
 	return nil
 }
 
@@ -277,15 +337,6 @@ func (s *UserService) ResetPassword(req *user.ResetPasswordReq) error {
 		return errno.UserVerificationCodeInvalidError
 	}
 
-	newPasswordHash, err := utils.EncryptPassword(req.NewPassword)
-	if err != nil {
-		logger.WithFields(
-			zap.String("email", logger.MaskEmail(req.Email)),
-			zap.Error(err),
-		).Error("新密码加密失败")
-		return err
-	}
-
 	userInfo, err := db.GetUserByEmail(s.ctx, req.Email)
 	if err != nil {
 		logger.WithFields(
@@ -295,17 +346,66 @@ func (s *UserService) ResetPassword(req *user.ResetPasswordReq) error {
 		return err
 	}
 
-	// 使用异步更新用户密码
-	errChan := db.UpdateUserPasswordAsync(s.ctx, userInfo.UserID, newPasswordHash)
-	if err := <-errChan; err != nil {
+	if err := changePassword(s.ctx, userInfo, req.NewPassword); err != nil {
 		logger.WithFields(
 			zap.String("email", logger.MaskEmail(req.Email)),
 			zap.Int64("user_id", userInfo.UserID),
 			zap.Error(err),
-		).Error("重置密码失败")
+		).Warn("重置密码失败")
+		return err
+	}
+
+	return nil
+}
+
+// UnlockAccount 通过邮箱验证码解除登录失败导致的临时锁定
+func (s *UserService) UnlockAccount(req *user.UnlockAccountReq) error {
+	if !redis.IsKeyExist(s.ctx, req.Email) {
+		return errno.UserVerificationCodeExpiredError
+	}
+
+	storeCode, err := redis.GetCodeCache(s.ctx, req.Email)
+	if err != nil {
+		return err
+	}
+	if storeCode != req.Code {
+		return errno.UserVerificationCodeInvalidError
+	}
+
+	userInfo, err := db.GetUserByEmail(s.ctx, req.Email)
+	if err != nil {
+		return err
+	}
+	if userInfo.Status != "locked" {
+		return nil
+	}
+
+	lock, err := redis.GetLoginLock(s.ctx, userInfo.UserID)
+	if err != nil {
+		return err
+	}
+	if lock == nil {
+		return errno.UserAccountLockedError.WithMessage("账户已被锁定，请联系管理员")
+	}
+
+	if err := db.UpdateUserStatues(s.ctx, userInfo.UserID, lock.PrevStatus); err != nil {
+		return err
+	}
+	if err := redis.DeleteLoginLock(s.ctx, userInfo.UserID); err != nil {
+		return err
+	}
+	if err := redis.ClearLoginFailAccount(s.ctx, req.Email); err != nil {
+		return err
+	}
+	if err := redis.DeleteCodeCache(s.ctx, req.Email); err != nil {
 		return err
 	}
 
+	logger.WithFields(
+		zap.String("audit", "account_unlock"),
+		zap.Int64("user_id", userInfo.UserID),
+		zap.String("email", logger.MaskEmail(req.Email)),
+	).Info("账户已通过邮箱验证码解锁")
 	return nil
 }
 
@@ -330,5 +430,16 @@ func (s *UserService) GetUserInfo(req *user.GetUserInfoReq) (*module.User, error
 			return nil, err
 		}
 	}
-	return userInfo.ToUserModule(), nil
+
+	// 使用中的装扮不进入用户信息缓存，每次实时查询
+	equipped, err := db.GetEquippedItems(s.ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	u := userInfo.ToUserModule()
+	u.EquippedItems = make([]*module.Item, 0, len(equipped))
+	for _, item := range equipped {
+		u.EquippedItems = append(u.EquippedItems, item.ToItemModule())
+	}
+	return u, nil
 }
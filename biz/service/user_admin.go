@@ -3,6 +3,7 @@ package service
 import (
 	"LearnShare/biz/dal/db"
 	"LearnShare/biz/model/user"
+	"LearnShare/pkg/constants"
 	"LearnShare/pkg/errno"
 	"LearnShare/pkg/utils"
 	"context"
@@ -26,7 +27,7 @@ func (s *UserAdminService) AdminAddUser(req *user.AdminAddUserReq) (int64, error
 	}
 
 	// 验证密码
-	if valid, err := utils.VerifyPassword(req.Password); !valid {
+	if err := checkPasswordPolicy(req.Password, req.Username, req.Email); err != nil {
 		return 0, err
 	}
 
@@ -46,13 +47,14 @@ func (s *UserAdminService) AdminAddUser(req *user.AdminAddUserReq) (int64, error
 	if err != nil {
 		return 0, err
 	}
+	RecordAdminChange(s.c, "user", userID, nil, adminSnapshot(s.ctx, constants.UserTableName, "user_id", userID))
 
 	return userID, nil
 }
 
 func (s *UserAdminService) AdminUpdateUser(req *user.AdminUpdateUserReq) error {
 	// 检查用户是否存在
-	_, err := db.GetUserByID(s.ctx, req.UserID)
+	userInfo, err := db.GetUserByID(s.ctx, req.UserID)
 	if err != nil {
 		return errno.NewErrNo(errno.ServiceUserNotExist, "用户不存在")
 	}
@@ -60,7 +62,14 @@ func (s *UserAdminService) AdminUpdateUser(req *user.AdminUpdateUserReq) error {
 	// 如果需要更新密码，先加密
 	var passwordHash *string
 	if req.Password != nil {
-		if valid, err := utils.VerifyPassword(*req.Password); !valid {
+		username, email := userInfo.Username, userInfo.Email
+		if req.Username != nil {
+			username = *req.Username
+		}
+		if req.Email != nil {
+			email = *req.Email
+		}
+		if err := checkPasswordPolicy(*req.Password, username, email); err != nil {
 			return err
 		}
 		hash, err := utils.EncryptPassword(*req.Password)
@@ -71,11 +80,13 @@ func (s *UserAdminService) AdminUpdateUser(req *user.AdminUpdateUserReq) error {
 	}
 
 	// 更新用户信息
+	before := adminSnapshot(s.ctx, constants.UserTableName, "user_id", req.UserID)
 	err = db.AdminUpdateUser(s.ctx, req.UserID, req.Username, passwordHash, req.Email,
 		req.CollegeID, req.MajorID, req.RoleID, req.Status)
 	if err != nil {
 		return err
 	}
+	RecordAdminChange(s.c, "user", req.UserID, before, adminSnapshot(s.ctx, constants.UserTableName, "user_id", req.UserID))
 
 	return nil
 }
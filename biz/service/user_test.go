@@ -3,6 +3,8 @@ package service
 import (
 	"context"
 	"errors"
+	"reflect"
+	"strings"
 	"testing"
 	"time"
 
@@ -40,9 +42,48 @@ CREATE TABLE IF NOT EXISTS users (
     reputation_score INTEGER DEFAULT 0,
     role_id INTEGER,
     status TEXT,
+    muted_until DATETIME,
+    activation_pending_at DATETIME,
     created_at DATETIME,
     updated_at DATETIME
 );
+CREATE TABLE IF NOT EXISTS items (
+    item_id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name TEXT UNIQUE,
+    type TEXT,
+    price INTEGER,
+    description TEXT,
+    image_url TEXT
+);
+CREATE TABLE IF NOT EXISTS user_items (
+    user_item_id INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id INTEGER,
+    item_id INTEGER,
+    is_used BOOLEAN DEFAULT FALSE,
+    obtained_at DATETIME
+);
+CREATE TABLE IF NOT EXISTS personal_access_tokens (
+    token_id INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id INTEGER,
+    name TEXT,
+    token_prefix TEXT,
+    token_hash TEXT UNIQUE,
+    scopes TEXT,
+    expires_at DATETIME,
+    last_used_at DATETIME,
+    last_used_ip TEXT,
+    revoked_at DATETIME,
+    created_at DATETIME
+);
+CREATE TABLE IF NOT EXISTS email_change_histories (
+    history_id INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id INTEGER,
+    old_email TEXT,
+    new_email TEXT,
+    action TEXT,
+    ip TEXT,
+    created_at DATETIME
+);
 `
 	if err := sqliteDB.Exec(createTableSQL).Error; err != nil {
 		t.Fatalf("创建测试数据表失败: %v", err)
@@ -74,6 +115,20 @@ func setupTestRedis(t *testing.T) (*miniredis.Miniredis, func()) {
 	return server, cleanup
 }
 
+// withTestConfig 将配置全局变量（如 &config.LoginProtect）替换为只设置了 fields 的配置，测试结束后恢复
+// 配置结构体未导出，通过反射按字段名赋值
+func withTestConfig(t *testing.T, global interface{}, fields map[string]interface{}) {
+	t.Helper()
+	target := reflect.ValueOf(global).Elem()
+	origin := reflect.ValueOf(target.Interface())
+	value := reflect.New(target.Type().Elem())
+	for name, v := range fields {
+		value.Elem().FieldByName(name).Set(reflect.ValueOf(v))
+	}
+	target.Set(value)
+	t.Cleanup(func() { target.Set(origin) })
+}
+
 func buildRequestContextWithUser(uid int64) *app.RequestContext {
 	ctx := app.NewContext(0)
 	ctx.Set(constants.ContextUid, uid)
@@ -115,12 +170,23 @@ func TestUserServiceRegister(t *testing.T) {
 	cleanupDB := setupTestDB(t)
 	defer cleanupDB()
 
+	var sentTo string
+	origDeliver := deliverActivationCode
+	deliverActivationCode = func(_ context.Context, email string) error {
+		sentTo = email
+		return nil
+	}
+	defer func() { deliverActivationCode = origDeliver }()
+
 	svc := NewUserService(context.Background(), nil)
 	req := &user.RegisterReq{Username: "user001", Password: "Pass1234", Email: "user001@example.com"}
 
 	if err := svc.Register(req); err != nil {
 		t.Fatalf("注册用户失败: %v", err)
 	}
+	if sentTo != req.Email {
+		t.Fatalf("注册后应当向注册邮箱发送激活验证码，实际发送到 %q", sentTo)
+	}
 
 	stored, err := db.GetUserByEmail(context.Background(), "user001@example.com")
 	if err != nil {
@@ -129,6 +195,9 @@ func TestUserServiceRegister(t *testing.T) {
 	if utils.ComparePassword(stored.PasswordHash, "Pass1234") != nil {
 		t.Fatalf("密码未按预期加密保存")
 	}
+	if stored.Status != "inactive" || stored.ActivationPendingAt == nil {
+		t.Fatalf("注册的账户应当未激活并带待激活标记: status=%s pending=%v", stored.Status, stored.ActivationPendingAt)
+	}
 }
 
 func TestUserServiceLoginIn(t *testing.T) {
@@ -224,11 +293,29 @@ func TestUserServiceUpdateEmail(t *testing.T) {
 	ctx := buildRequestContextWithUser(userRecord.UserID)
 	svc := NewUserService(context.Background(), ctx)
 
+	var noticeTo, revertLink string
+	origin := sendEmailChangeNotice
+	sendEmailChangeNotice = func(oldEmail, newEmail, link string, ttl time.Duration) error {
+		noticeTo, revertLink = oldEmail, link
+		return nil
+	}
+	defer func() { sendEmailChangeNotice = origin }()
+
 	if err := redisDal.PutCodeToCache(context.Background(), "new@example.com", "111222"); err != nil {
 		t.Fatalf("写入验证码失败: %v", err)
 	}
 
-	if err := svc.UpdateEmail(&user.UpdateEmailReq{NewEmail: "new@example.com", Code: "111222"}); err != nil {
+	// 只有新邮箱的验证码时不允许修改
+	err := svc.UpdateEmail(&user.UpdateEmailReq{NewEmail: "new@example.com", Code: "111222", OldCode: "333444"})
+	var e errno.ErrNo
+	if !errors.As(err, &e) || e.ErrorCode != errno.UserVerificationCodeExpired {
+		t.Fatalf("缺少旧邮箱验证码时应当拒绝修改, 实际: %v", err)
+	}
+
+	if err := redisDal.PutCodeToCache(context.Background(), "old@example.com", "333444"); err != nil {
+		t.Fatalf("写入验证码失败: %v", err)
+	}
+	if err := svc.UpdateEmail(&user.UpdateEmailReq{NewEmail: "new@example.com", Code: "111222", OldCode: "333444"}); err != nil {
 		t.Fatalf("更新邮箱失败: %v", err)
 	}
 
@@ -239,6 +326,9 @@ func TestUserServiceUpdateEmail(t *testing.T) {
 	if stored.Email != "new@example.com" {
 		t.Fatalf("邮箱未被更新, 当前为 %s", stored.Email)
 	}
+	if noticeTo != "old@example.com" || !strings.Contains(revertLink, "token=") {
+		t.Fatalf("应当向旧邮箱发送撤销链接: to=%s link=%s", noticeTo, revertLink)
+	}
 }
 
 func TestUserServiceUpdatePassword(t *testing.T) {
@@ -308,6 +398,8 @@ func TestUserServiceResetPassword(t *testing.T) {
 func TestUserServiceGetUserInfo(t *testing.T) {
 	cleanupDB := setupTestDB(t)
 	defer cleanupDB()
+	_, cleanupRedis := setupTestRedis(t)
+	defer cleanupRedis()
 
 	now := time.Now()
 	college := int64(5)
@@ -342,4 +434,27 @@ func TestUserServiceGetUserInfo(t *testing.T) {
 	if result.AvatarURL != avatar {
 		t.Fatalf("头像地址未正确映射")
 	}
+	if len(result.EquippedItems) != 0 {
+		t.Fatalf("未使用物品时不应返回装扮")
+	}
+
+	item := db.Item{Name: "金色头像框", Type: "avatar_frame", Price: 10}
+	if err := db.DB.Table(constants.ItemTableName).Create(&item).Error; err != nil {
+		t.Fatalf("插入测试物品失败: %v", err)
+	}
+	if err := db.DB.Table(constants.UserItemTableName).Omit("Item").
+		Create(&db.UserItem{UserID: userRecord.UserID, ItemID: item.ItemID, IsUsed: true}).Error; err != nil {
+		t.Fatalf("插入用户物品失败: %v", err)
+	}
+	result, err = svc.GetUserInfo(&user.GetUserInfoReq{UserID: userRecord.UserID})
+	if err != nil {
+		t.Fatalf("获取用户信息失败: %v", err)
+	}
+	if len(result.EquippedItems) != 1 || result.EquippedItems[0].ItemID != item.ItemID {
+		t.Fatalf("使用中的装扮未正确返回")
+	}
+	// 第二次读取命中用户信息缓存，装扮仍然实时查询
+	if result.Username != userRecord.Username || result.AvatarURL != avatar {
+		t.Fatalf("缓存中的用户信息不正确: %+v", result)
+	}
 }
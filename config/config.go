@@ -8,16 +8,28 @@ import (
 )
 
 var (
-	Mysql        *mySQL
-	Redis        *redis
-	Oss          *oss
-	Smtp         *smtp
-	Verify       *verify
-	Server       *server
-	Turnstile    *turnstile
-	Logger       *logger
-	Cors         *cors
-	runtimeViper = viper.New()
+	Mysql           *mySQL
+	Redis           *redis
+	Oss             *oss
+	Smtp            *smtp
+	Verify          *verify
+	Server          *server
+	Captcha         *captcha
+	OIDC            *oidc
+	LoginProtect    *loginProtect
+	RateLimit       *rateLimit
+	Activation      *activation
+	PasswordPolicy  *passwordPolicy
+	EmailChange     *emailChange
+	PermissionCache *permissionCache
+	ReviewQueue     *reviewQueue
+	ContentFilter   *contentFilter
+	SoftDelete      *softDelete
+	Achievement     *achievement
+	Leaderboard     *leaderboard
+	Logger          *logger
+	Cors            *cors
+	runtimeViper    = viper.New()
 )
 
 // Init 目的是初始化配置管理器
@@ -55,7 +67,34 @@ func configMapping() {
 	Smtp = &c.Smtp
 	Verify = &c.Verify
 	Server = &c.Server
-	Turnstile = &c.Turnstile
+	migrateLegacyCaptcha(c, runtimeViper)
+	Captcha = &c.Captcha
+	OIDC = &c.OIDC
+	LoginProtect = &c.LoginProtect
+	RateLimit = &c.RateLimit
+	Activation = &c.Activation
+	PasswordPolicy = &c.PasswordPolicy
+	EmailChange = &c.EmailChange
+	PermissionCache = &c.PermissionCache
+	ReviewQueue = &c.ReviewQueue
+	ContentFilter = &c.ContentFilter
+	SoftDelete = &c.SoftDelete
+	Achievement = &c.Achievement
+	Leaderboard = &c.Leaderboard
 	Logger = &c.Logger
 	Cors = &c.Cors
 }
+
+// migrateLegacyCaptcha 兼容旧版配置：只有 turnstile 段而没有 captcha 段时，按 turnstile 服务商映射到 captcha，
+// 避免升级后人机验证被静默关闭
+func migrateLegacyCaptcha(c *config, v *viper.Viper) {
+	if v.IsSet("captcha") || !v.IsSet("turnstile") {
+		return
+	}
+	c.Captcha = captcha{
+		Enabled:   c.Turnstile.Enabled,
+		Provider:  "turnstile",
+		SecretKey: c.Turnstile.SecretKey,
+	}
+	log.Warn("config: turnstile 配置段已废弃，已按 captcha.provider=turnstile 加载，请迁移到 captcha 配置段")
+}
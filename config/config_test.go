@@ -0,0 +1,35 @@
+package config
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/spf13/viper"
+)
+
+func loadTestConfig(t *testing.T, yaml string) *config {
+	t.Helper()
+	v := viper.New()
+	v.SetConfigType("yaml")
+	if err := v.ReadConfig(strings.NewReader(yaml)); err != nil {
+		t.Fatalf("读取配置失败: %v", err)
+	}
+	c := new(config)
+	if err := v.Unmarshal(c); err != nil {
+		t.Fatalf("反序列化配置失败: %v", err)
+	}
+	migrateLegacyCaptcha(c, v)
+	return c
+}
+
+func TestMigrateLegacyCaptcha(t *testing.T) {
+	c := loadTestConfig(t, "turnstile:\n  enabled: true\n  secret_key: legacy\n")
+	if !c.Captcha.Enabled || c.Captcha.Provider != "turnstile" || c.Captcha.SecretKey != "legacy" {
+		t.Fatalf("旧版 turnstile 配置应当映射到 captcha: %+v", c.Captcha)
+	}
+
+	c = loadTestConfig(t, "turnstile:\n  enabled: true\n  secret_key: legacy\ncaptcha:\n  enabled: false\n  provider: local\n")
+	if c.Captcha.Enabled || c.Captcha.Provider != "local" {
+		t.Fatalf("已配置 captcha 时不应使用旧版配置: %+v", c.Captcha)
+	}
+}
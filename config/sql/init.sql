@@ -106,6 +106,8 @@ CREATE TABLE `users` (
                          `reputation_score` INT NOT NULL DEFAULT 80 COMMENT '信誉分',
                          `role_id` SMALLINT UNSIGNED NOT NULL COMMENT '角色ID', -- SMALLINT
                          `status` ENUM('active','inactive','locked','banned') NOT NULL DEFAULT 'inactive' COMMENT '账户状态 (新增banned)',
+                         `muted_until` TIMESTAMP NULL DEFAULT NULL COMMENT '禁言截止时间，禁言期间不能发布内容',
+                         `activation_pending_at` TIMESTAMP NULL DEFAULT NULL COMMENT '注册待激活标记，激活后清空；只有带此标记的未激活账户会被清理',
                          `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
                          `updated_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
                          PRIMARY KEY (`user_id`),
@@ -117,6 +119,128 @@ CREATE TABLE `users` (
                          CONSTRAINT `fk_user_major` FOREIGN KEY (`major_id`) REFERENCES `majors` (`major_id`) ON DELETE SET NULL
 ) ENGINE=INNODB DEFAULT CHARSET=utf8mb4 ROW_FORMAT=DYNAMIC COMMENT='用户表';
 
+-- ----------------------------
+-- 第三方身份绑定表 (user_identities) - OIDC 统一身份认证
+-- ----------------------------
+DROP TABLE IF EXISTS `user_identities`;
+CREATE TABLE `user_identities` (
+                                   `identity_id` INT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '绑定记录ID',
+                                   `user_id` INT UNSIGNED NOT NULL COMMENT '用户ID',
+                                   `issuer` VARCHAR(255) NOT NULL COMMENT '身份提供方 Issuer',
+                                   `subject` VARCHAR(255) NOT NULL COMMENT '身份提供方用户标识 (sub)',
+                                   `email` VARCHAR(100) DEFAULT NULL COMMENT '绑定时的邮箱',
+                                   `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '绑定时间',
+                                   `updated_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+                                   PRIMARY KEY (`identity_id`),
+                                   UNIQUE KEY `uk_identity_issuer_subject` (`issuer`,`subject`),
+                                   KEY `idx_identity_user` (`user_id`),
+                                   CONSTRAINT `fk_identity_user` FOREIGN KEY (`user_id`) REFERENCES `users` (`user_id`) ON DELETE CASCADE
+) ENGINE=INNODB DEFAULT CHARSET=utf8mb4 ROW_FORMAT=DYNAMIC COMMENT='第三方身份绑定表';
+
+-- ----------------------------
+-- 历史密码表 (password_histories) - 防止重复使用近期密码
+-- ----------------------------
+DROP TABLE IF EXISTS `password_histories`;
+CREATE TABLE `password_histories` (
+                                      `history_id` INT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '记录ID',
+                                      `user_id` INT UNSIGNED NOT NULL COMMENT '用户ID',
+                                      `password_hash` VARCHAR(255) NOT NULL COMMENT '曾使用的密码哈希',
+                                      `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '被替换的时间',
+                                      PRIMARY KEY (`history_id`),
+                                      KEY `idx_ph_user_time` (`user_id`,`created_at`),
+                                      CONSTRAINT `fk_ph_user` FOREIGN KEY (`user_id`) REFERENCES `users` (`user_id`) ON DELETE CASCADE
+) ENGINE=INNODB DEFAULT CHARSET=utf8mb4 ROW_FORMAT=DYNAMIC COMMENT='历史密码表';
+
+-- ----------------------------
+-- 邮箱变更记录表 (email_change_histories)
+-- ----------------------------
+DROP TABLE IF EXISTS `email_change_histories`;
+CREATE TABLE `email_change_histories` (
+                                          `history_id` INT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '记录ID',
+                                          `user_id` INT UNSIGNED NOT NULL COMMENT '用户ID',
+                                          `old_email` VARCHAR(100) NOT NULL COMMENT '变更前邮箱',
+                                          `new_email` VARCHAR(100) NOT NULL COMMENT '变更后邮箱',
+                                          `action` ENUM('change','revert') NOT NULL COMMENT '操作类型: change=修改, revert=通过旧邮箱撤销',
+                                          `ip` VARCHAR(45) DEFAULT NULL COMMENT '操作IP',
+                                          `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '操作时间',
+                                          PRIMARY KEY (`history_id`),
+                                          KEY `idx_ech_user_time` (`user_id`,`created_at`),
+                                          CONSTRAINT `fk_ech_user` FOREIGN KEY (`user_id`) REFERENCES `users` (`user_id`) ON DELETE CASCADE
+) ENGINE=INNODB DEFAULT CHARSET=utf8mb4 ROW_FORMAT=DYNAMIC COMMENT='邮箱变更记录表';
+
+-- ----------------------------
+-- 个人访问令牌表 (personal_access_tokens) - 供脚本与第三方集成调用 API
+-- ----------------------------
+DROP TABLE IF EXISTS `personal_access_tokens`;
+CREATE TABLE `personal_access_tokens` (
+                                          `token_id` INT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '令牌ID',
+                                          `user_id` INT UNSIGNED NOT NULL COMMENT '所属用户ID',
+                                          `name` VARCHAR(64) NOT NULL COMMENT '令牌名称',
+                                          `token_prefix` VARCHAR(16) NOT NULL COMMENT '令牌前缀，用于识别',
+                                          `token_hash` CHAR(64) NOT NULL COMMENT '令牌 SHA-256 哈希',
+                                          `scopes` VARCHAR(1024) NOT NULL COMMENT '授权范围，逗号分隔的权限标识',
+                                          `expires_at` TIMESTAMP NULL DEFAULT NULL COMMENT '过期时间，NULL 表示永不过期',
+                                          `last_used_at` TIMESTAMP NULL DEFAULT NULL COMMENT '最近使用时间',
+                                          `last_used_ip` VARCHAR(45) DEFAULT NULL COMMENT '最近使用IP',
+                                          `revoked_at` TIMESTAMP NULL DEFAULT NULL COMMENT '吊销时间',
+                                          `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+                                          PRIMARY KEY (`token_id`),
+                                          UNIQUE KEY `uk_pat_hash` (`token_hash`),
+                                          KEY `idx_pat_user` (`user_id`),
+                                          CONSTRAINT `fk_pat_user` FOREIGN KEY (`user_id`) REFERENCES `users` (`user_id`) ON DELETE CASCADE
+) ENGINE=INNODB DEFAULT CHARSET=utf8mb4 ROW_FORMAT=DYNAMIC COMMENT='个人访问令牌表';
+
+-- ----------------------------
+-- 作用域授权表 (scoped_permission_grants) - 仅在指定课程或资源范围内生效的权限
+-- ----------------------------
+DROP TABLE IF EXISTS `scoped_permission_grants`;
+CREATE TABLE `scoped_permission_grants` (
+                                            `grant_id` INT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '授权ID',
+                                            `user_id` INT UNSIGNED NOT NULL COMMENT '被授权用户ID',
+                                            `permission_id` INT UNSIGNED NOT NULL COMMENT '权限ID',
+                                            `scope_type` ENUM('course','resource') NOT NULL COMMENT '作用域类型',
+                                            `scope_id` INT UNSIGNED NOT NULL COMMENT '作用域对象ID（课程ID或资源ID）',
+                                            `granted_by` INT UNSIGNED DEFAULT NULL COMMENT '授权人ID',
+                                            `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '授权时间',
+                                            PRIMARY KEY (`grant_id`),
+                                            UNIQUE KEY `uk_spg_user_perm_scope` (`user_id`,`permission_id`,`scope_type`,`scope_id`),
+                                            KEY `idx_spg_scope` (`scope_type`,`scope_id`),
+                                            CONSTRAINT `fk_spg_user` FOREIGN KEY (`user_id`) REFERENCES `users` (`user_id`) ON DELETE CASCADE,
+                                            CONSTRAINT `fk_spg_permission` FOREIGN KEY (`permission_id`) REFERENCES `permissions` (`permission_id`) ON DELETE CASCADE,
+                                            CONSTRAINT `fk_spg_granted_by` FOREIGN KEY (`granted_by`) REFERENCES `users` (`user_id`) ON DELETE SET NULL
+) ENGINE=INNODB DEFAULT CHARSET=utf8mb4 ROW_FORMAT=DYNAMIC COMMENT='作用域授权表';
+
+-- ----------------------------
+-- 管理操作日志表 (admin_actions) - 仅追加，记录管理后台的写操作；不设外键，操作人被删除后日志仍保留
+-- ----------------------------
+DROP TABLE IF EXISTS `admin_actions`;
+CREATE TABLE `admin_actions` (
+                                 `action_id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '日志ID',
+                                 `actor_id` INT UNSIGNED NOT NULL COMMENT '操作人ID',
+                                 `action` VARCHAR(64) NOT NULL COMMENT '操作名称，如 AdminDeleteCourse',
+                                 `method` VARCHAR(10) NOT NULL COMMENT '请求方法',
+                                 `path` VARCHAR(255) NOT NULL COMMENT '请求路径',
+                                 `target_type` VARCHAR(32) DEFAULT NULL COMMENT '操作对象类型',
+                                 `target_id` BIGINT UNSIGNED DEFAULT NULL COMMENT '操作对象ID',
+                                 `before_snapshot` JSON DEFAULT NULL COMMENT '变更前快照',
+                                 `after_snapshot` JSON DEFAULT NULL COMMENT '变更后快照',
+                                 `status_code` SMALLINT UNSIGNED NOT NULL COMMENT '响应状态码',
+                                 `ip` VARCHAR(45) DEFAULT NULL COMMENT '操作IP',
+                                 `request_id` VARCHAR(64) DEFAULT NULL COMMENT '请求ID',
+                                 `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '操作时间',
+                                 PRIMARY KEY (`action_id`),
+                                 KEY `idx_aa_actor` (`actor_id`,`created_at`),
+                                 KEY `idx_aa_action` (`action`,`created_at`),
+                                 KEY `idx_aa_target` (`target_type`,`target_id`),
+                                 KEY `idx_aa_created` (`created_at`)
+) ENGINE=INNODB DEFAULT CHARSET=utf8mb4 ROW_FORMAT=DYNAMIC COMMENT='管理操作日志表';
+
+-- 操作日志只允许追加
+CREATE TRIGGER `trg_admin_actions_no_update` BEFORE UPDATE ON `admin_actions`
+    FOR EACH ROW SIGNAL SQLSTATE '45000' SET MESSAGE_TEXT = 'admin_actions is append-only';
+CREATE TRIGGER `trg_admin_actions_no_delete` BEFORE DELETE ON `admin_actions`
+    FOR EACH ROW SIGNAL SQLSTATE '45000' SET MESSAGE_TEXT = 'admin_actions is append-only';
+
 -- ----------------------------
 -- 课程表 (courses) - 调整学分为 DECIMAL(2,1) 更精确
 -- ----------------------------
@@ -131,8 +255,10 @@ CREATE TABLE `courses` (
                            `description` TEXT COMMENT '课程简介',
                            `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
                            `updated_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+                           `deleted_at` TIMESTAMP NULL DEFAULT NULL COMMENT '删除时间，超过保留期后由清理任务彻底删除',
                            PRIMARY KEY (`course_id`),
                            KEY `idx_course_name` (`course_name`),
+                           KEY `idx_course_deleted` (`deleted_at`),
                            KEY `idx_course_teacher_major` (`teacher_id`,`major_id`),
                            CONSTRAINT `fk_course_teacher` FOREIGN KEY (`teacher_id`) REFERENCES `teachers` (`teacher_id`) ON DELETE SET NULL,
                            CONSTRAINT `fk_course_major` FOREIGN KEY (`major_id`) REFERENCES `majors` (`major_id`) ON DELETE RESTRICT
@@ -168,8 +294,10 @@ CREATE TABLE `resources` (
                              `status` ENUM('normal','low_quality','pending_review', 'banned') DEFAULT 'pending_review' COMMENT '状态 (新增banned)',
                              `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
                              `updated_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+                             `deleted_at` TIMESTAMP NULL DEFAULT NULL COMMENT '删除时间，超过保留期后由清理任务彻底删除文件与记录',
                              PRIMARY KEY (`resource_id`),
                              KEY `idx_resource_status` (`status`),
+                             KEY `idx_resource_deleted` (`deleted_at`),
                              KEY `idx_res_uploader_type` (`uploader_id`,`type`),
                              KEY `idx_res_course_status` (`course_id`,`status`),
                              CONSTRAINT `fk_resource_uploader` FOREIGN KEY (`uploader_id`) REFERENCES `users` (`user_id`) ON DELETE CASCADE,
@@ -226,9 +354,11 @@ CREATE TABLE `course_comments` (
                                    `status` ENUM('normal','deleted_by_user','deleted_by_admin') DEFAULT 'normal' COMMENT '评论状态',
                                    `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
                                    `updated_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+                                   `deleted_at` TIMESTAMP NULL DEFAULT NULL COMMENT '删除时间，举报审核隐藏的评论为空，不会被清理',
                                    PRIMARY KEY (`comment_id`),
                                    KEY `fk_cc_parent` (`parent_id`),
                                    KEY `idx_cc_course_status` (`course_id`,`status`),
+                                   KEY `idx_cc_deleted` (`deleted_at`),
                                    CONSTRAINT `fk_cc_user` FOREIGN KEY (`user_id`) REFERENCES `users` (`user_id`) ON DELETE CASCADE,
                                    CONSTRAINT `fk_cc_course` FOREIGN KEY (`course_id`) REFERENCES `courses` (`course_id`) ON DELETE CASCADE,
                                    CONSTRAINT `fk_cc_parent` FOREIGN KEY (`parent_id`) REFERENCES `course_comments` (`comment_id`) ON DELETE SET NULL
@@ -286,9 +416,11 @@ CREATE TABLE `resource_comments` (
                                      `status` ENUM('normal','deleted_by_user','deleted_by_admin') DEFAULT 'normal' COMMENT '评论状态',
                                      `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
                                      `updated_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+                                     `deleted_at` TIMESTAMP NULL DEFAULT NULL COMMENT '删除时间，举报审核隐藏的评论为空，不会被清理',
                                      PRIMARY KEY (`comment_id`),
                                      KEY `fk_rc_parent` (`parent_id`),
                                      KEY `idx_rc_resource_status` (`resource_id`,`status`),
+                                     KEY `idx_rc_deleted` (`deleted_at`),
                                      CONSTRAINT `fk_rc_user` FOREIGN KEY (`user_id`) REFERENCES `users` (`user_id`) ON DELETE CASCADE,
                                      CONSTRAINT `fk_rc_resource` FOREIGN KEY (`resource_id`) REFERENCES `resources` (`resource_id`) ON DELETE CASCADE,
                                      CONSTRAINT `fk_rc_parent` FOREIGN KEY (`parent_id`) REFERENCES `resource_comments` (`comment_id`) ON DELETE SET NULL
@@ -319,15 +451,33 @@ CREATE TABLE `reputation_records` (
                                       `record_id` INT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '记录ID',
                                       `user_id` INT UNSIGNED NOT NULL COMMENT '用户ID',
                                       `change_score` SMALLINT NOT NULL COMMENT '分数变化',
+                                      `event_type` VARCHAR(32) NOT NULL DEFAULT 'manual_adjust' COMMENT '事件类型，规则事件的分值见 reputation_rules',
                                       `reason` VARCHAR(500) NOT NULL COMMENT '变化原因',
                                       `related_id` INT UNSIGNED DEFAULT NULL COMMENT '关联对象ID',
-                                      `related_type` ENUM('resource','comment','rating') DEFAULT NULL COMMENT '关联对象类型',
+                                      `related_type` ENUM('resource','comment','rating','moderation','item') DEFAULT NULL COMMENT '关联对象类型 (moderation 为处罚记录，item 为兑换的物品)',
+                                      `operator_id` INT UNSIGNED DEFAULT NULL COMMENT '手动调整的管理员ID',
                                       `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '记录时间',
                                       PRIMARY KEY (`record_id`),
                                       KEY `idx_reputation_time` (`created_at`),
+                                      KEY `idx_reputation_user_event` (`user_id`, `event_type`, `created_at`), -- 历史查询与每日上限统计
                                       CONSTRAINT `fk_reprec_user` FOREIGN KEY (`user_id`) REFERENCES `users` (`user_id`) ON DELETE CASCADE
 ) ENGINE=INNODB DEFAULT CHARSET=utf8mb4 ROW_FORMAT=DYNAMIC COMMENT='信誉分记录表';
 
+-- ----------------------------
+-- 信誉分规则表 (reputation_rules) - 自动发放的事件及分值，处罚与手动调整的分值由操作人指定
+-- ----------------------------
+DROP TABLE IF EXISTS `reputation_rules`;
+CREATE TABLE `reputation_rules` (
+                                    `event_type` VARCHAR(32) NOT NULL COMMENT '事件类型',
+                                    `points` SMALLINT NOT NULL COMMENT '每次事件的分值',
+                                    `daily_limit` INT UNSIGNED NOT NULL DEFAULT 0 COMMENT '每位用户每天通过该事件获得分数的次数上限，0 不限',
+                                    `once_per_target` BOOLEAN NOT NULL DEFAULT FALSE COMMENT '同一关联对象是否只计一次',
+                                    `description` VARCHAR(255) NOT NULL DEFAULT '' COMMENT '说明，写入信誉分记录的变化原因',
+                                    `enabled` BOOLEAN NOT NULL DEFAULT TRUE COMMENT '是否启用',
+                                    `updated_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+                                    PRIMARY KEY (`event_type`)
+) ENGINE=INNODB DEFAULT CHARSET=utf8mb4 ROW_FORMAT=DYNAMIC COMMENT='信誉分规则表';
+
 -- ----------------------------
 -- 收藏表 (favorites)
 -- ----------------------------
@@ -345,27 +495,125 @@ CREATE TABLE `favorites` (
 ) ENGINE=INNODB DEFAULT CHARSET=utf8mb4 ROW_FORMAT=DYNAMIC COMMENT='收藏表';
 
 -- ----------------------------
--- 审核表 (reviews) - 优化状态和优先级索引，新增举报人外键
+-- 审核表 (reviews) - 优化状态和优先级索引，新增举报人外键；内容过滤自动提交的审核没有举报人
 -- ----------------------------
 DROP TABLE IF EXISTS `reviews`;
 CREATE TABLE `reviews` (
                            `review_id` INT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '审核ID',
                            `target_id` INT UNSIGNED NOT NULL COMMENT '对象ID',
-                           `reporter_id` INT UNSIGNED NOT NULL COMMENT '举报人ID',
-                           `target_type` ENUM('resource','course_rating','resource_rating','comment') NOT NULL COMMENT '对象类型',
+                           `reporter_id` INT UNSIGNED DEFAULT NULL COMMENT '举报人ID，内容过滤自动提交时为空',
+                           `target_type` ENUM('resource','course_rating','resource_rating','comment','course_comment','moderation_action') NOT NULL COMMENT '对象类型 (comment 为资源评论，moderation_action 为处罚申诉)',
                            `reason` VARCHAR(500) NOT NULL COMMENT '审核原因',
                            `status` ENUM('pending','approved','rejected') DEFAULT 'pending' COMMENT '状态',
                            `priority` TINYINT UNSIGNED DEFAULT 3 COMMENT '优先级(1-5)',
+                           `level` TINYINT UNSIGNED NOT NULL DEFAULT 1 COMMENT '审核级别：1 举报审核，2 申诉复核',
                            `reviewer_id` INT UNSIGNED DEFAULT NULL COMMENT '审核员ID',
                            `reviewed_at` TIMESTAMP NULL DEFAULT NULL COMMENT '审核时间',
+                           `claimed_by` INT UNSIGNED DEFAULT NULL COMMENT '认领审核员ID',
+                           `claim_expires_at` TIMESTAMP NULL DEFAULT NULL COMMENT '认领租约到期时间，到期后自动释放',
+                           `due_at` TIMESTAMP NULL DEFAULT NULL COMMENT 'SLA 截止时间，由优先级计算',
+                           `escalated_at` TIMESTAMP NULL DEFAULT NULL COMMENT '超时升级时间',
+                           `report_count` INT UNSIGNED NOT NULL DEFAULT 1 COMMENT '合并到该审核的举报次数',
+                           `auto_hidden_at` TIMESTAMP NULL DEFAULT NULL COMMENT '举报数达到阈值后自动隐藏内容的时间',
+                           `open_target` VARCHAR(64) GENERATED ALWAYS AS (IF(`status` = 'pending' AND `level` = 1, CONCAT(`target_type`, ':', `target_id`), NULL)) STORED COMMENT '待处理举报的去重键',
                            `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
                            PRIMARY KEY (`review_id`),
                            KEY `idx_review_status_priority` (`status`, `priority`), -- 优化索引
                            KEY `idx_review_target` (`target_type`,`target_id`),
+                           KEY `idx_review_claim` (`claimed_by`, `status`),
+                           KEY `idx_review_status_due` (`status`, `due_at`),
+                           KEY `idx_review_created` (`created_at`, `target_type`), -- 审核统计按时间范围查询
+                           KEY `idx_review_reviewed` (`reviewed_at`, `target_type`),
+                           UNIQUE KEY `uk_review_open_target` (`open_target`),
                            CONSTRAINT `fk_review_reporter` FOREIGN KEY (`reporter_id`) REFERENCES `users` (`user_id`) ON DELETE RESTRICT,
-                           CONSTRAINT `fk_review_reviewer` FOREIGN KEY (`reviewer_id`) REFERENCES `users` (`user_id`) ON DELETE SET NULL
+                           CONSTRAINT `fk_review_reviewer` FOREIGN KEY (`reviewer_id`) REFERENCES `users` (`user_id`) ON DELETE SET NULL,
+                           CONSTRAINT `fk_review_claimer` FOREIGN KEY (`claimed_by`) REFERENCES `users` (`user_id`) ON DELETE SET NULL
 ) ENGINE=INNODB DEFAULT CHARSET=utf8mb4 ROW_FORMAT=DYNAMIC COMMENT='审核表';
 
+-- ----------------------------
+-- 举报人表 (review_reporters) - 同一对象的多次举报合并到一条审核，这里记录每位举报人
+-- ----------------------------
+DROP TABLE IF EXISTS `review_reporters`;
+CREATE TABLE `review_reporters` (
+                                    `review_id` INT UNSIGNED NOT NULL COMMENT '审核ID',
+                                    `reporter_id` INT UNSIGNED NOT NULL COMMENT '举报人ID',
+                                    `reason` VARCHAR(500) NOT NULL COMMENT '举报原因',
+                                    `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '举报时间',
+                                    PRIMARY KEY (`review_id`, `reporter_id`),
+                                    KEY `idx_reporter` (`reporter_id`),
+                                    KEY `idx_rr_created` (`created_at`),
+                                    CONSTRAINT `fk_rr_review` FOREIGN KEY (`review_id`) REFERENCES `reviews` (`review_id`) ON DELETE CASCADE,
+                                    CONSTRAINT `fk_rr_reporter` FOREIGN KEY (`reporter_id`) REFERENCES `users` (`user_id`) ON DELETE CASCADE
+) ENGINE=INNODB DEFAULT CHARSET=utf8mb4 ROW_FORMAT=DYNAMIC COMMENT='举报人表';
+
+-- ----------------------------
+-- 处罚记录表 (moderation_actions) - 与举报审核关联，申诉通过后撤销
+-- ----------------------------
+DROP TABLE IF EXISTS `moderation_actions`;
+CREATE TABLE `moderation_actions` (
+                                      `action_id` INT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '处罚ID',
+                                      `review_id` INT UNSIGNED NOT NULL COMMENT '关联的举报审核ID',
+                                      `user_id` INT UNSIGNED NOT NULL COMMENT '被处罚用户ID',
+                                      `moderator_id` INT UNSIGNED DEFAULT NULL COMMENT '执行处罚的审核员ID',
+                                      `action` ENUM('warn','hide_content','deduct_reputation','mute','ban') NOT NULL COMMENT '处罚类型',
+                                      `reason` VARCHAR(500) NOT NULL COMMENT '处罚原因',
+                                      `target_type` ENUM('resource','course_rating','resource_rating','comment','course_comment') NOT NULL COMMENT '违规内容类型',
+                                      `target_id` INT UNSIGNED NOT NULL COMMENT '违规内容ID',
+                                      `reputation_delta` SMALLINT NOT NULL DEFAULT 0 COMMENT '扣除的信誉分（负数）',
+                                      `muted_until` TIMESTAMP NULL DEFAULT NULL COMMENT '禁言截止时间',
+                                      `status` ENUM('active','revoked') NOT NULL DEFAULT 'active' COMMENT '状态，申诉成功后撤销',
+                                      `appeal_review_id` INT UNSIGNED DEFAULT NULL COMMENT '申诉复核ID，每条处罚只能申诉一次',
+                                      `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '处罚时间',
+                                      `revoked_at` TIMESTAMP NULL DEFAULT NULL COMMENT '撤销时间',
+                                      PRIMARY KEY (`action_id`),
+                                      UNIQUE KEY `uk_moderation_appeal` (`appeal_review_id`),
+                                      KEY `idx_moderation_user` (`user_id`, `created_at`),
+                                      KEY `idx_moderation_review` (`review_id`),
+                                      CONSTRAINT `fk_moderation_review` FOREIGN KEY (`review_id`) REFERENCES `reviews` (`review_id`) ON DELETE CASCADE,
+                                      CONSTRAINT `fk_moderation_user` FOREIGN KEY (`user_id`) REFERENCES `users` (`user_id`) ON DELETE CASCADE,
+                                      CONSTRAINT `fk_moderation_moderator` FOREIGN KEY (`moderator_id`) REFERENCES `users` (`user_id`) ON DELETE SET NULL,
+                                      CONSTRAINT `fk_moderation_appeal` FOREIGN KEY (`appeal_review_id`) REFERENCES `reviews` (`review_id`) ON DELETE SET NULL
+) ENGINE=INNODB DEFAULT CHARSET=utf8mb4 ROW_FORMAT=DYNAMIC COMMENT='处罚记录表';
+
+-- ----------------------------
+-- 站内通知表 (notifications)
+-- ----------------------------
+DROP TABLE IF EXISTS `notifications`;
+CREATE TABLE `notifications` (
+                                 `notification_id` INT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '通知ID',
+                                 `user_id` INT UNSIGNED NOT NULL COMMENT '接收用户ID',
+                                 `type` VARCHAR(32) NOT NULL COMMENT '通知类型，如 moderation、appeal',
+                                 `title` VARCHAR(100) NOT NULL COMMENT '标题',
+                                 `content` VARCHAR(1000) NOT NULL COMMENT '内容',
+                                 `related_type` VARCHAR(32) DEFAULT NULL COMMENT '关联对象类型',
+                                 `related_id` INT UNSIGNED DEFAULT NULL COMMENT '关联对象ID',
+                                 `is_read` BOOLEAN NOT NULL DEFAULT FALSE COMMENT '是否已读',
+                                 `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+                                 PRIMARY KEY (`notification_id`),
+                                 KEY `idx_notification_user` (`user_id`, `is_read`, `created_at`),
+                                 CONSTRAINT `fk_notification_user` FOREIGN KEY (`user_id`) REFERENCES `users` (`user_id`) ON DELETE CASCADE
+) ENGINE=INNODB DEFAULT CHARSET=utf8mb4 ROW_FORMAT=DYNAMIC COMMENT='站内通知表';
+
+-- ----------------------------
+-- 内容过滤规则表 (content_filter_rules) - 评论、资源标题描述与标签发布前按规则检查
+-- ----------------------------
+DROP TABLE IF EXISTS `content_filter_rules`;
+CREATE TABLE `content_filter_rules` (
+                                        `rule_id` INT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '规则ID',
+                                        `pattern` VARCHAR(200) NOT NULL COMMENT '关键词或正则表达式',
+                                        `match_type` ENUM('keyword','regex') NOT NULL DEFAULT 'keyword' COMMENT '匹配方式，关键词忽略大小写',
+                                        `action` ENUM('reject','mask','review') NOT NULL COMMENT '命中后的处理：拒绝发布、打码、提交人工审核',
+                                        `description` VARCHAR(255) DEFAULT NULL COMMENT '规则说明',
+                                        `enabled` BOOLEAN NOT NULL DEFAULT TRUE COMMENT '是否启用',
+                                        `created_by` INT UNSIGNED DEFAULT NULL COMMENT '创建人ID',
+                                        `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+                                        `updated_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+                                        PRIMARY KEY (`rule_id`),
+                                        UNIQUE KEY `uk_filter_pattern` (`match_type`, `pattern`),
+                                        KEY `idx_filter_enabled` (`enabled`),
+                                        CONSTRAINT `fk_filter_creator` FOREIGN KEY (`created_by`) REFERENCES `users` (`user_id`) ON DELETE SET NULL
+) ENGINE=INNODB DEFAULT CHARSET=utf8mb4 ROW_FORMAT=DYNAMIC COMMENT='内容过滤规则表';
+
 -- ----------------------------
 -- 物品表 (items)
 -- ----------------------------
@@ -398,6 +646,38 @@ CREATE TABLE `user_items` (
                               CONSTRAINT `fk_ui_item` FOREIGN KEY (`item_id`) REFERENCES `items` (`item_id`) ON DELETE CASCADE
 ) ENGINE=INNODB DEFAULT CHARSET=utf8mb4 ROW_FORMAT=DYNAMIC COMMENT='用户物品表';
 
+-- ----------------------------
+-- 成就表 (achievements) - 按用户活动统计指标达到阈值后发放，可附带勋章物品
+-- ----------------------------
+DROP TABLE IF EXISTS `achievements`;
+CREATE TABLE `achievements` (
+                                `code` VARCHAR(32) NOT NULL COMMENT '成就标识',
+                                `name` VARCHAR(100) NOT NULL COMMENT '成就名称',
+                                `description` VARCHAR(255) NOT NULL DEFAULT '' COMMENT '达成条件说明',
+                                `metric` VARCHAR(32) NOT NULL COMMENT '统计指标: uploads 发布资源数, downloads_received 资源被下载次数, helpful_comments 获赞评论数, course_ratings 评价课程数',
+                                `threshold` INT UNSIGNED NOT NULL COMMENT '达成所需的指标值',
+                                `medal_item_id` INT UNSIGNED DEFAULT NULL COMMENT '达成后发放的勋章物品，该物品不能在商城兑换',
+                                `sort_order` INT NOT NULL DEFAULT 0 COMMENT '展示顺序',
+                                `enabled` BOOLEAN NOT NULL DEFAULT TRUE COMMENT '是否启用',
+                                PRIMARY KEY (`code`),
+                                KEY `idx_achievement_medal` (`medal_item_id`),
+                                CONSTRAINT `fk_achievement_medal` FOREIGN KEY (`medal_item_id`) REFERENCES `items` (`item_id`) ON DELETE SET NULL
+) ENGINE=INNODB DEFAULT CHARSET=utf8mb4 ROW_FORMAT=DYNAMIC COMMENT='成就表';
+
+-- ----------------------------
+-- 用户成就表 (user_achievements)
+-- ----------------------------
+DROP TABLE IF EXISTS `user_achievements`;
+CREATE TABLE `user_achievements` (
+                                     `user_id` INT UNSIGNED NOT NULL COMMENT '用户ID',
+                                     `achievement_code` VARCHAR(32) NOT NULL COMMENT '成就标识',
+                                     `achieved_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '达成时间',
+                                     PRIMARY KEY (`user_id`, `achievement_code`),
+                                     KEY `idx_ua_achievement` (`achievement_code`),
+                                     CONSTRAINT `fk_ua_user` FOREIGN KEY (`user_id`) REFERENCES `users` (`user_id`) ON DELETE CASCADE,
+                                     CONSTRAINT `fk_ua_achievement` FOREIGN KEY (`achievement_code`) REFERENCES `achievements` (`code`) ON DELETE CASCADE ON UPDATE CASCADE
+) ENGINE=INNODB DEFAULT CHARSET=utf8mb4 ROW_FORMAT=DYNAMIC COMMENT='用户成就表';
+
 
 -- ----------------------------
 -- 数据初始化: 角色 (Roles)
@@ -430,7 +710,10 @@ VALUES
     (15,'teacher.profile.manage','维护教师信息与关联课程'),
     (16,'system.config.manage','修改系统配置与全局参数'),
     (17,'audit.log.view','查看系统操作日志与审核记录'),
-    (18,'data.report.view','访问平台数据报表与统计信息');
+    (18,'data.report.view','访问平台数据报表与统计信息'),
+    (19,'course.owner','课程负责人，可管理本课程范围内的授权'),
+    (20,'content.filter.manage','维护内容过滤词库与规则'),
+    (21,'shop.item.manage','维护积分商城物品与预览图');
 
 -- ----------------------------
 -- 数据初始化: 角色权限映射 (Role Permissions)
@@ -439,12 +722,31 @@ VALUES
 -- 审核员 (Role ID: 3)
 INSERT INTO `role_permissions` (`role_id`,`permission_id`)
 VALUES
-    (3,4),(3,5),(3,6),(3,7),(3,8),(3,9),(3,11),(3,12),(3,13),(3,14),(3,15),(3,17),(3,18);
+    (3,4),(3,5),(3,6),(3,7),(3,8),(3,9),(3,11),(3,12),(3,13),(3,14),(3,15),(3,17),(3,18),(3,20);
 
 -- 超级管理员 (Role ID: 1)
 INSERT INTO `role_permissions` (`role_id`,`permission_id`)
 SELECT 1, `permission_id` FROM `permissions`;
 
+-- ----------------------------
+-- 数据初始化: 信誉分规则 (Reputation Rules)
+-- ----------------------------
+INSERT INTO `reputation_rules` (`event_type`,`points`,`daily_limit`,`once_per_target`,`description`)
+VALUES
+    ('upload_approved',2,10,TRUE,'上传的资源审核通过'),
+    ('resource_downloaded',1,20,FALSE,'上传的资源被下载'),
+    ('rating_received',1,20,TRUE,'上传的资源收到评分');
+
+-- ----------------------------
+-- 数据初始化: 成就 (Achievements)，勋章物品可在创建物品后通过 medal_item_id 关联
+-- ----------------------------
+INSERT INTO `achievements` (`code`,`name`,`description`,`metric`,`threshold`,`sort_order`)
+VALUES
+    ('first_upload','初次分享','发布第一份学习资源','uploads',1,10),
+    ('downloads_100','广受欢迎','发布的资源累计被下载 100 次','downloads_received',100,20),
+    ('helpful_comments_50','热心评论','发表 50 条获得点赞的评论','helpful_comments',50,30),
+    ('rated_courses_10','课程评鉴','评价 10 门课程','course_ratings',10,40);
+
 -- 提交事务并恢复环境参数
 COMMIT;
 SET FOREIGN_KEY_CHECKS = @OLD_FOREIGN_KEY_CHECKS;
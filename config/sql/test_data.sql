@@ -11,8 +11,13 @@ SET FOREIGN_KEY_CHECKS = 0;
 -- ------------------------------------------------------------
 -- 清空已有业务数据（可选：如果需要重新插入）
 -- ------------------------------------------------------------
+TRUNCATE TABLE `user_achievements`;
 TRUNCATE TABLE `user_items`;
 TRUNCATE TABLE `items`;
+TRUNCATE TABLE `notifications`;
+TRUNCATE TABLE `content_filter_rules`;
+TRUNCATE TABLE `moderation_actions`;
+TRUNCATE TABLE `review_reporters`;
 TRUNCATE TABLE `reviews`;
 TRUNCATE TABLE `favorites`;
 TRUNCATE TABLE `reputation_records`;
@@ -252,6 +257,30 @@ INSERT INTO `reviews` (`target_id`, `reporter_id`, `target_type`, `reason`, `pri
                                                                                                                      (16, 4, 'comment', '资源评论可能包含不当言辞，待审核', 4, NULL, 'pending'),
                                                                                                                      (20, 5, 'comment', '资源评论内容需人工复核', 3, NULL, 'pending');
 
+-- 已处理的审核补齐处理时间，便于审核统计
+UPDATE `reviews` SET `reviewed_at` = `created_at` WHERE `status` <> 'pending' AND `reviewed_at` IS NULL;
+
+-- 每条审核的举报人（同一对象的后续举报会合并进来）
+INSERT INTO `review_reporters` (`review_id`, `reporter_id`, `reason`) VALUES
+                                                                          (1, 3, '深度学习面试题库需确认版权和内容准确性'),
+                                                                          (2, 4, '期权定价模型Excel文件可能有格式问题'),
+                                                                          (3, 5, '用户反馈课程讨论组信息过时，已更新'),
+                                                                          (4, 6, '历年试卷资源确认无版权问题'),
+                                                                          (5, 7, '课程评论中包含无关链接'),
+                                                                          (6, 8, '资源质量问题已确认，要求用户重新上传'),
+                                                                          (7, 9, '用户评分可能存在恶意刷分行为'),
+                                                                          (8, 10, '评分与资源质量明显不符'),
+                                                                          (9, 3, '资源评论涉嫌广告，待审核'),
+                                                                          (10, 4, '资源评论可能包含不当言辞，待审核'),
+                                                                          (11, 5, '资源评论内容需人工复核');
+
+-- 内容过滤规则
+INSERT INTO `content_filter_rules` (`pattern`, `match_type`, `action`, `description`, `created_by`) VALUES
+                                                                                                     ('代考', 'keyword', 'reject', '考试代考广告', 1),
+                                                                                                     ('作业代写', 'keyword', 'reject', '代写作业广告', 1),
+                                                                                                     ('傻逼', 'keyword', 'mask', '辱骂用语', 1),
+                                                                                                     ('(微信|vx|QQ)[:：]?\\s*[A-Za-z0-9_-]{5,}', 'regex', 'review', '留联系方式引流', 1);
+
 -- ----------------------------
 -- 16. 物品 items
 -- ----------------------------
@@ -296,6 +325,14 @@ INSERT INTO `user_items` (`user_id`, `item_id`, `is_used`) VALUES
                                                                (8, 8, 1),   -- frank: 分享达人勋章（使用中）
                                                                (8, 12, 0);  -- frank: 海洋蓝昵称 (item_id:12)
 
+-- ----------------------------
+-- 18. 成就勋章：测试物品中的勋章改为通过成就发放
+-- ----------------------------
+UPDATE `achievements` SET `medal_item_id` = (SELECT `item_id` FROM `items` WHERE `name` = '分享达人勋章') WHERE `code` = 'first_upload';
+UPDATE `achievements` SET `medal_item_id` = (SELECT `item_id` FROM `items` WHERE `name` = '人气王者勋章') WHERE `code` = 'downloads_100';
+UPDATE `achievements` SET `medal_item_id` = (SELECT `item_id` FROM `items` WHERE `name` = '评论大师勋章') WHERE `code` = 'helpful_comments_50';
+UPDATE `achievements` SET `medal_item_id` = (SELECT `item_id` FROM `items` WHERE `name` = '学霸勋章') WHERE `code` = 'rated_courses_10';
+
 -- 恢复外键检查
 SET FOREIGN_KEY_CHECKS = 1;
 
@@ -44,11 +44,128 @@ type server struct {
 	Port int
 }
 
+// captcha 人机验证配置
+type captcha struct {
+	Enabled        bool
+	Provider       string  // turnstile、hcaptcha、recaptcha、local
+	SecretKey      string  `mapstructure:"secret_key"`
+	VerifyURL      string  `mapstructure:"verify_url"`      // 为空时使用服务商默认地址
+	TimeoutSeconds int     `mapstructure:"timeout_seconds"` // 请求校验服务的超时时间
+	MinScore       float64 `mapstructure:"min_score"`       // reCAPTCHA v3 最低分
+	ExpireSeconds  int     `mapstructure:"expire_seconds"`  // 本地图形验证码有效期
+}
+
+// turnstile 旧版人机验证配置，已由 captcha 取代，仅在未配置 captcha 时兼容读取
 type turnstile struct {
 	SecretKey string `mapstructure:"secret_key"`
 	Enabled   bool
 }
 
+// loginProtect 登录防爆破配置
+type loginProtect struct {
+	MaxAttempts    int `mapstructure:"max_attempts"`     // 同一账户在窗口内允许的连续失败次数
+	IPMaxAttempts  int `mapstructure:"ip_max_attempts"`  // 同一IP在窗口内允许的失败次数，达到后临时禁止该IP登录
+	WindowSeconds  int `mapstructure:"window_seconds"`   // 失败计数窗口
+	LockSeconds    int `mapstructure:"lock_seconds"`     // 账户与IP首次锁定时长，之后每次翻倍
+	MaxLockSeconds int `mapstructure:"max_lock_seconds"` // 锁定时长上限
+}
+
+// passwordPolicy 密码策略配置
+type passwordPolicy struct {
+	MinLength      int    `mapstructure:"min_length"`
+	MaxLength      int    `mapstructure:"max_length"`
+	RequireUpper   bool   `mapstructure:"require_upper"`
+	RequireLower   bool   `mapstructure:"require_lower"`
+	RequireDigit   bool   `mapstructure:"require_digit"`
+	RequireSymbol  bool   `mapstructure:"require_symbol"`
+	MinClasses     int    `mapstructure:"min_classes"`      // 大写、小写、数字、符号中至少包含的类别数
+	ForbidUserInfo bool   `mapstructure:"forbid_user_info"` // 禁止包含用户名或邮箱前缀
+	HistorySize    int    `mapstructure:"history_size"`     // 不允许与最近 N 次使用过的密码相同（含当前密码）
+	BreachCheck    bool   `mapstructure:"breach_check"`     // 是否检查泄露密码库
+	BreachDir      string `mapstructure:"breach_dir"`       // 泄露密码前缀文件目录，为空时使用内置列表
+}
+
+// emailChange 修改邮箱配置
+type emailChange struct {
+	RevertURL      string `mapstructure:"revert_url"`       // 撤销链接地址，token 以查询参数拼接在其后
+	RevertTTLHours int    `mapstructure:"revert_ttl_hours"` // 撤销链接有效期
+}
+
+// activation 注册激活配置
+type activation struct {
+	PurgeAfterHours      int `mapstructure:"purge_after_hours"`      // 注册后超过该时长仍未激活的账户将被清理，<=0 不清理
+	PurgeIntervalMinutes int `mapstructure:"purge_interval_minutes"` // 清理任务执行间隔
+}
+
+// permissionCache 角色权限缓存配置
+type permissionCache struct {
+	LocalTTLSeconds int `mapstructure:"local_ttl_seconds"` // 进程内缓存有效期，也是未收到失效广播时各实例收敛的最长时间
+	LocalSize       int `mapstructure:"local_size"`        // 进程内缓存的角色数量上限
+	RedisTTLSeconds int `mapstructure:"redis_ttl_seconds"` // Redis 权限快照有效期
+}
+
+// reviewQueue 审核队列配置
+type reviewQueue struct {
+	LeaseMinutes            int   `mapstructure:"lease_minutes"`             // 认领租约时长，超时未处理自动释放
+	MaxClaims               int   `mapstructure:"max_claims"`                // 单个审核员同时持有的认领上限
+	SLAHours                []int `mapstructure:"sla_hours"`                 // 按优先级 1-5 依次对应的处理时限
+	EscalateIntervalMinutes int   `mapstructure:"escalate_interval_minutes"` // 超时升级与过期认领释放任务的执行间隔
+	AutoHideThreshold       int   `mapstructure:"auto_hide_threshold"`       // 同一对象累计举报达到该次数时先隐藏内容等待审核
+}
+
+// contentFilter 内容过滤配置
+type contentFilter struct {
+	ReloadSeconds int `mapstructure:"reload_seconds"` // 规则缓存有效期，其他实例修改的规则最迟在该时间后生效
+}
+
+// softDelete 已删除内容的保留与清理配置
+type softDelete struct {
+	RetentionDays        int `mapstructure:"retention_days"`         // 删除后保留的天数，期间管理员可恢复，<=0 不清理
+	PurgeIntervalMinutes int `mapstructure:"purge_interval_minutes"` // 清理任务执行间隔
+}
+
+type achievement struct {
+	EvaluateIntervalMinutes int `mapstructure:"evaluate_interval_minutes"` // 全量检查成就的间隔，<=0 不执行，用户查看成就时仍会即时检查
+}
+
+type leaderboard struct {
+	RebuildIntervalMinutes int `mapstructure:"rebuild_interval_minutes"` // 从数据库全量重建排行榜的间隔，<=0 不执行，排行榜仍随信誉分与资源变化增量更新
+}
+
+// rateLimit 接口限流配置，Policies 的键为策略名（如 comment、report、search）
+type rateLimit struct {
+	Enabled  bool
+	Policies map[string]rateLimitPolicy
+}
+
+// rateLimitPolicy 单个限流策略：Window 窗口内最多 Limit 次请求
+type rateLimitPolicy struct {
+	Limit         int
+	WindowSeconds int    `mapstructure:"window_seconds"`
+	Key           string // 限流维度: ip、user、route
+}
+
+// OIDC 统一身份认证配置
+type oidc struct {
+	Enabled       bool
+	Issuer        string
+	ClientID      string   `mapstructure:"client_id"`
+	ClientSecret  string   `mapstructure:"client_secret"`
+	RedirectURL   string   `mapstructure:"redirect_url"`
+	Scopes        []string `mapstructure:"scopes"`
+	AutoProvision bool     `mapstructure:"auto_provision"`
+	Claims        oidcClaims
+}
+
+// oidcClaims ID Token 声明与用户字段的映射
+type oidcClaims struct {
+	Username      string
+	Email         string
+	EmailVerified string `mapstructure:"email_verified"`
+	College       string
+	Major         string
+}
+
 type logger struct {
 	Level string
 	Dir   string
@@ -65,13 +182,26 @@ type cors struct {
 }
 
 type config struct {
-	MySQL     mySQL
-	Redis     redis
-	OSS       oss
-	Smtp      smtp   `mapstructure:"smtp"`
-	Verify    verify `mapstructure:"verify"`
-	Server    server
-	Turnstile turnstile `mapstructure:"turnstile"`
-	Logger    logger    `mapstructure:"logger"`
-	Cors      cors      `mapstructure:"cors"`
+	MySQL           mySQL
+	Redis           redis
+	OSS             oss
+	Smtp            smtp   `mapstructure:"smtp"`
+	Verify          verify `mapstructure:"verify"`
+	Server          server
+	Captcha         captcha         `mapstructure:"captcha"`
+	Turnstile       turnstile       `mapstructure:"turnstile"`
+	OIDC            oidc            `mapstructure:"oidc"`
+	LoginProtect    loginProtect    `mapstructure:"login_protect"`
+	RateLimit       rateLimit       `mapstructure:"rate_limit"`
+	Activation      activation      `mapstructure:"activation"`
+	PasswordPolicy  passwordPolicy  `mapstructure:"password_policy"`
+	EmailChange     emailChange     `mapstructure:"email_change"`
+	PermissionCache permissionCache `mapstructure:"permission_cache"`
+	ReviewQueue     reviewQueue     `mapstructure:"review_queue"`
+	ContentFilter   contentFilter   `mapstructure:"content_filter"`
+	SoftDelete      softDelete      `mapstructure:"soft_delete"`
+	Achievement     achievement     `mapstructure:"achievement"`
+	Leaderboard     leaderboard     `mapstructure:"leaderboard"`
+	Logger          logger          `mapstructure:"logger"`
+	Cors            cors            `mapstructure:"cors"`
 }
@@ -53,7 +53,7 @@ struct GetCourseCommentAuditListReq{
 }
 struct GetCourseCommentAuditListResp{
     required model.BaseResp base_resp,
-    required list<model.CourseComment> comment_audit_list,
+    required list<model.review> comment_audit_list,
 }
 
 // 获取待审核资源评论列表
@@ -82,6 +82,44 @@ struct AuditResourceCommentResp{
     required model.BaseResp base_resp,
 }
 
+// 获取待审核课程评分举报列表
+struct GetCourseRatingAuditListReq{
+    required i32 page_num,
+    required i32 page_size,
+}
+struct GetCourseRatingAuditListResp{
+    required model.BaseResp base_resp,
+    required list<model.review> rating_audit_list,
+}
+
+// 审核课程评分举报，approve 时隐藏该评分
+struct AuditCourseRatingReq{
+    required i64 review_id(api.path="review_id"),
+    required string action,
+}
+struct AuditCourseRatingResp{
+    required model.BaseResp base_resp,
+}
+
+// 获取待审核资源评分举报列表
+struct GetResourceRatingAuditListReq{
+    required i32 page_num,
+    required i32 page_size,
+}
+struct GetResourceRatingAuditListResp{
+    required model.BaseResp base_resp,
+    required list<model.review> rating_audit_list,
+}
+
+// 审核资源评分举报，approve 时隐藏该评分并重新计算资源平均分
+struct AuditResourceRatingReq{
+    required i64 review_id(api.path="review_id"),
+    required string action,
+}
+struct AuditResourceRatingResp{
+    required model.BaseResp base_resp,
+}
+
 service AdminAuditService {
     GetResourceAuditListResp GetResourceAuditList(1:GetResourceAuditListReq req)(api.get="/api/admin/audit/resources"),
     AuditResourceResp AuditResource(1:AuditResourceReq req)(api.post="/api/admin/audit/resources/:review_id"),
@@ -92,7 +130,259 @@ service AdminAuditService {
     GetResourceCommentAuditListResp GetResourceCommentAuditList(1:GetResourceCommentAuditListReq req)(api.get="/api/admin/audit/resource_comments"),
     AuditCourseCommentResp AuditCourseComment(1:AuditCourseCommentReq req)(api.post="/api/admin/audit/course_comments/:review_id"),
     AuditResourceCommentResp AuditResourceComment(1:AuditResourceCommentReq req)(api.post="/api/admin/audit/resource_comments/:review_id"),
+    GetCourseRatingAuditListResp GetCourseRatingAuditList(1:GetCourseRatingAuditListReq req)(api.get="/api/admin/audit/course_ratings"),
+    AuditCourseRatingResp AuditCourseRating(1:AuditCourseRatingReq req)(api.post="/api/admin/audit/course_ratings/:review_id"),
+    GetResourceRatingAuditListResp GetResourceRatingAuditList(1:GetResourceRatingAuditListReq req)(api.get="/api/admin/audit/resource_ratings"),
+    AuditResourceRatingResp AuditResourceRating(1:AuditResourceRatingReq req)(api.post="/api/admin/audit/resource_ratings/:review_id"),
+}
+
+// 查询管理操作日志，时间为秒级时间戳
+struct ListAdminActionsReq{
+    optional i64 actor_id(api.query="actor_id"),
+    optional string action(api.query="action"),
+    optional string target_type(api.query="target_type"),
+    optional i64 target_id(api.query="target_id"),
+    optional i64 start_time(api.query="start_time"),
+    optional i64 end_time(api.query="end_time"),
+    required i32 page_num(api.query="page_num"),
+    required i32 page_size(api.query="page_size"),
+}
+struct ListAdminActionsResp{
+    required model.BaseResp base_resp,
+    required list<model.AdminAction> action_list,
+    required i64 total,
+}
+
+// 导出管理操作日志为 CSV，响应体为文件内容
+struct ExportAdminActionsReq{
+    optional i64 actor_id(api.query="actor_id"),
+    optional string action(api.query="action"),
+    optional string target_type(api.query="target_type"),
+    optional i64 target_id(api.query="target_id"),
+    optional i64 start_time(api.query="start_time"),
+    optional i64 end_time(api.query="end_time"),
+}
+struct ExportAdminActionsResp{
+    required model.BaseResp base_resp,
+}
+
+service AdminActionService {
+    ListAdminActionsResp ListAdminActions(1:ListAdminActionsReq req)(api.get="/api/admin/actions"),
+    ExportAdminActionsResp ExportAdminActions(1:ExportAdminActionsReq req)(api.get="/api/admin/actions/export"),
+}
+
+// 按队列顺序认领若干条待审核记录，target_type 为空时不限类型
+struct ClaimNextReviewsReq{
+    optional string target_type,
+    required i32 count,
+}
+struct ClaimNextReviewsResp{
+    required model.BaseResp base_resp,
+    required list<model.review> review_list,
+}
+
+// 认领指定审核记录，已持有认领时续期
+struct ClaimReviewReq{
+    required i64 review_id(api.path="review_id"),
+}
+struct ClaimReviewResp{
+    required model.BaseResp base_resp,
+    required model.review review,
+}
+
+struct ReleaseReviewReq{
+    required i64 review_id(api.path="review_id"),
+}
+struct ReleaseReviewResp{
+    required model.BaseResp base_resp,
+}
+
+// 当前审核员已认领且未过期的审核记录
+struct GetReviewInboxReq{
+}
+struct GetReviewInboxResp{
+    required model.BaseResp base_resp,
+    required list<model.review> review_list,
+}
+
+struct GetReviewQueueStatsReq{
+    optional string target_type(api.query="target_type"),
+}
+struct GetReviewQueueStatsResp{
+    required model.BaseResp base_resp,
+    required model.ReviewQueueStats stats,
+}
+
+// 同一对象的多次举报合并为一条审核记录，这里列出全部举报人及其理由
+struct GetReviewReportersReq{
+    required i64 review_id(api.path="review_id"),
+}
+struct GetReviewReportersResp{
+    required model.BaseResp base_resp,
+    required list<model.ReviewReporter> reporter_list,
 }
 
+service ReviewQueueService {
+    ClaimNextReviewsResp ClaimNextReviews(1:ClaimNextReviewsReq req)(api.post="/api/admin/audit/queue/claim"),
+    GetReviewQueueStatsResp GetReviewQueueStats(1:GetReviewQueueStatsReq req)(api.get="/api/admin/audit/queue/stats"),
+    GetReviewInboxResp GetReviewInbox(1:GetReviewInboxReq req)(api.get="/api/admin/audit/inbox"),
+    ClaimReviewResp ClaimReview(1:ClaimReviewReq req)(api.post="/api/admin/audit/reviews/:review_id/claim"),
+    ReleaseReviewResp ReleaseReview(1:ReleaseReviewReq req)(api.delete="/api/admin/audit/reviews/:review_id/claim"),
+    GetReviewReportersResp GetReviewReporters(1:GetReviewReportersReq req)(api.get="/api/admin/audit/reviews/:review_id/reporters"),
+}
+
+// 对已确认违规的举报执行处罚，扣分时 reputation_delta 为扣除的分数，禁言时 mute_hours 为禁言时长
+struct ApplyModerationActionReq{
+    required i64 review_id(api.path="review_id"),
+    required string action,
+    required string reason,
+    optional i32 reputation_delta,
+    optional i32 mute_hours,
+}
+struct ApplyModerationActionResp{
+    required model.BaseResp base_resp,
+    required model.ModerationAction moderation_action,
+}
+
+// 获取待处理的处罚申诉（二级复核）列表
+struct GetAppealListReq{
+    required i32 page_num,
+    required i32 page_size,
+}
+struct GetAppealListResp{
+    required model.BaseResp base_resp,
+    required list<model.review> appeal_list,
+    required i64 total,
+}
+
+// 处理处罚申诉：approve 撤销处罚，reject 维持处罚
+struct ResolveAppealReq{
+    required i64 review_id(api.path="review_id"),
+    required string action,
+}
+struct ResolveAppealResp{
+    required model.BaseResp base_resp,
+}
+
+service ModerationAdminService {
+    ApplyModerationActionResp ApplyModerationAction(1:ApplyModerationActionReq req)(api.post="/api/admin/audit/reviews/:review_id/actions"),
+    GetAppealListResp GetAppealList(1:GetAppealListReq req)(api.get="/api/admin/audit/appeals"),
+    ResolveAppealResp ResolveAppeal(1:ResolveAppealReq req)(api.post="/api/admin/audit/appeals/:review_id"),
+}
+
+// 内容过滤规则：match_type 为 keyword（忽略大小写）或 regex，action 为 reject、mask 或 review
+struct ListContentFilterRulesReq{
+    optional bool enabled(api.query="enabled"),
+    optional string keyword(api.query="keyword"),
+    required i32 page_num(api.query="page_num"),
+    required i32 page_size(api.query="page_size"),
+}
+struct ListContentFilterRulesResp{
+    required model.BaseResp base_resp,
+    required list<model.ContentFilterRule> rule_list,
+    required i64 total,
+}
 
+struct CreateContentFilterRuleReq{
+    required string pattern,
+    required string match_type,
+    required string action,
+    optional string description,
+}
+struct CreateContentFilterRuleResp{
+    required model.BaseResp base_resp,
+    required model.ContentFilterRule rule,
+}
 
+// 只更新传入的字段
+struct UpdateContentFilterRuleReq{
+    required i64 rule_id(api.path="rule_id"),
+    optional string pattern,
+    optional string match_type,
+    optional string action,
+    optional string description,
+    optional bool enabled,
+}
+struct UpdateContentFilterRuleResp{
+    required model.BaseResp base_resp,
+    required model.ContentFilterRule rule,
+}
+
+struct DeleteContentFilterRuleReq{
+    required i64 rule_id(api.path="rule_id"),
+}
+struct DeleteContentFilterRuleResp{
+    required model.BaseResp base_resp,
+}
+
+// 用当前启用的规则检查一段文本，不会产生审核记录
+struct TestContentFilterReq{
+    required string text,
+}
+struct TestContentFilterResp{
+    required model.BaseResp base_resp,
+    optional string action,
+    required list<model.ContentFilterMatch> match_list,
+    required string masked_text,
+}
+
+service ContentFilterAdminService {
+    ListContentFilterRulesResp ListContentFilterRules(1:ListContentFilterRulesReq req)(api.get="/api/admin/content_filters"),
+    CreateContentFilterRuleResp CreateContentFilterRule(1:CreateContentFilterRuleReq req)(api.post="/api/admin/content_filters"),
+    UpdateContentFilterRuleResp UpdateContentFilterRule(1:UpdateContentFilterRuleReq req)(api.put="/api/admin/content_filters/:rule_id"),
+    DeleteContentFilterRuleResp DeleteContentFilterRule(1:DeleteContentFilterRuleReq req)(api.delete="/api/admin/content_filters/:rule_id"),
+    TestContentFilterResp TestContentFilter(1:TestContentFilterReq req)(api.post="/api/admin/content_filters/test"),
+}
+
+// 批量审核举报，每条举报单独提交事务，与单条审核的处理相同
+struct BatchAuditReviewsReq{
+    required list<i64> review_ids,
+    required string action,
+}
+struct BatchAuditReviewsResp{
+    required model.BaseResp base_resp,
+    required list<model.BatchItemResult> result_list,
+    required i64 success_count,
+}
+
+// 隐藏用户全部可见的资源评论与课程评论
+struct HideUserCommentsReq{
+    required i64 user_id(api.path="user_id"),
+}
+struct HideUserCommentsResp{
+    required model.BaseResp base_resp,
+    required list<model.BatchItemResult> result_list,
+    required i64 success_count,
+}
+
+// 批量恢复被管理员隐藏的内容
+struct RestoreContentReq{
+    required list<model.ContentRef> items,
+}
+struct RestoreContentResp{
+    required model.BaseResp base_resp,
+    required list<model.BatchItemResult> result_list,
+    required i64 success_count,
+}
+
+service BulkModerationService {
+    BatchAuditReviewsResp BatchAuditReviews(1:BatchAuditReviewsReq req)(api.post="/api/admin/audit/reviews/batch"),
+    HideUserCommentsResp HideUserComments(1:HideUserCommentsReq req)(api.post="/api/admin/audit/users/:user_id/hide_comments"),
+    RestoreContentResp RestoreContent(1:RestoreContentReq req)(api.post="/api/admin/audit/restore"),
+}
+
+// 审核统计：时间为秒级时间戳，默认最近 30 天；interval 为 day 或 week，决定积压曲线的采样间隔
+struct GetModerationStatsReq{
+    optional i64 start_time(api.query="start_time"),
+    optional i64 end_time(api.query="end_time"),
+    optional string interval(api.query="interval"),
+    optional i32 top_n(api.query="top_n"),
+}
+struct GetModerationStatsResp{
+    required model.BaseResp base_resp,
+    required model.ModerationStats stats,
+}
+
+service ModerationStatsService {
+    GetModerationStatsResp GetModerationStats(1:GetModerationStatsReq req)(api.get="/api/admin/reports/moderation"),
+}
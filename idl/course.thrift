@@ -108,6 +108,26 @@ struct SubmitCourseCommentReactionResp {
     1: required model.BaseResp baseResp,
 }
 
+// 举报课程评分
+struct ReportCourseRatingReq {
+    1: required i64 rating_id (api.path="rating_id"),
+    2: required string reason (api.body="reason"),
+}
+
+struct ReportCourseRatingResp {
+    1: required model.BaseResp baseResp,
+}
+
+// 举报课程评论
+struct ReportCourseCommentReq {
+    1: required i64 comment_id (api.path="comment_id"),
+    2: required string reason (api.body="reason"),
+}
+
+struct ReportCourseCommentResp {
+    1: required model.BaseResp baseResp,
+}
+
 service CourseService {
   SearchResp search(1: SearchReq req)(api.get="/api/courses/search"),
   GetCourseDetailResp getCourseDetail(1: GetCourseDetailReq req)(api.get="/api/courses/:course_id"),
@@ -119,6 +139,8 @@ service CourseService {
   DeleteCourseRatingResp deleteCourseRating(1: DeleteCourseRatingReq req)(api.delete="/api/course_ratings/:rating_id"),
 
   SubmitCourseCommentReactionResp reactCourseComment(  1: SubmitCourseCommentReactionReq req)(api.post="/api/course_comments/:comment_id/likes"),
+  ReportCourseCommentResp reportCourseComment(1: ReportCourseCommentReq req)(api.post="/api/course_comments/:comment_id/report"),
+  ReportCourseRatingResp reportCourseRating(1: ReportCourseRatingReq req)(api.post="/api/course_ratings/:rating_id/report"),
 }
 
 struct AdminDeleteCourseCommentReq{
@@ -142,10 +164,26 @@ struct AdminDeleteCourseResp{
     required model.BaseResp base_resp,
 }
 
+struct AdminRestoreCourseReq{
+    required i64 course_id(api.path="course_id"),
+}
+struct AdminRestoreCourseResp{
+    required model.BaseResp base_resp,
+}
+
+struct AdminRestoreCourseCommentReq{
+    required i64 comment_id(api.path="comment_id"),
+}
+struct AdminRestoreCourseCommentResp{
+    required model.BaseResp base_resp,
+}
+
 service AdminCourseService{
     AdminDeleteCourseCommentResp AdminDeleteCourseComment(1:AdminDeleteCourseCommentReq req)(api.delete="/api/admin/course_comments/:comment_id"),
     AdminDeleteCourseRatingResp AdminDeleteCourseRating(1:AdminDeleteCourseRatingReq req)(api.delete="/api/admin/course_ratings/:rating_id"),
     AdminDeleteCourseResp AdminDeleteCourse(1:AdminDeleteCourseReq req)(api.delete="/api/admin/courses/:course_id"),
+    AdminRestoreCourseResp AdminRestoreCourse(1:AdminRestoreCourseReq req)(api.post="/api/admin/courses/:course_id/restore"),
+    AdminRestoreCourseCommentResp AdminRestoreCourseComment(1:AdminRestoreCourseCommentReq req)(api.post="/api/admin/course_comments/:comment_id/restore"),
 
 }
 
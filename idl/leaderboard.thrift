@@ -0,0 +1,23 @@
+namespace go leaderboard
+include "model.thrift"
+
+// board: uploads 上传资源数 / reputation 信誉分；period: week / month / all，默认 all
+// 同时传入 college_id 与 major_id 时按专业筛选
+struct GetLeaderboardReq{
+    required string board(api.path="board"),
+    optional string period,
+    optional i64 college_id,
+    optional i64 major_id,
+    required i32 page_num,
+    required i32 page_size,
+}
+struct GetLeaderboardResp{
+    required model.BaseResp base_resp,
+    required list<model.LeaderboardEntry> entry_list,
+    required i64 total,
+    optional model.LeaderboardEntry my_entry,
+}
+
+service LeaderboardService {
+    GetLeaderboardResp GetLeaderboard(1:GetLeaderboardReq req)(api.get="/api/leaderboards/:board"),
+}
@@ -18,6 +18,7 @@ struct User{
     required string status,
     required i64 created_at,
     required i64 updated_at,
+    optional list<Item> equipped_items,
 }
 
 struct Course{
@@ -186,4 +187,222 @@ struct review{
     required string status,
     required i64 priority,
     required i64 createdAt,
+    optional i64 claimedBy,
+    optional i64 claimExpiresAt,
+    optional i64 dueAt,
+    optional i64 escalatedAt,
+    optional i64 level,
+    optional i64 reportCount,
+    optional i64 autoHiddenAt,
+}
+
+// 合并到同一审核记录的举报人
+struct ReviewReporter{
+    required i64 reporterId,
+    required string reason,
+    required i64 createdAt,
+}
+
+struct PersonalToken{
+    required i64 token_id,
+    required string name,
+    required string token_prefix,
+    required list<string> scopes,
+    optional i64 expires_at,
+    optional i64 last_used_at,
+    optional string last_used_ip,
+    required i64 created_at,
+}
+
+struct ScopedPermission{
+    required i64 grant_id,
+    required i64 user_id,
+    required string permission_name,
+    required string scope_type,
+    required i64 scope_id,
+    optional i64 granted_by,
+    required i64 created_at,
+}
+
+struct AdminAction{
+    required i64 action_id,
+    required i64 actor_id,
+    required string action,
+    required string method,
+    required string path,
+    optional string target_type,
+    optional i64 target_id,
+    optional string before_snapshot,
+    optional string after_snapshot,
+    required i32 status_code,
+    optional string ip,
+    optional string request_id,
+    required i64 created_at,
+}
+
+// 审核队列概况，oldest_pending_at 为最早一条待审核记录的创建时间
+struct ReviewQueueStats{
+    required i64 pending,
+    required i64 unclaimed,
+    required i64 claimed,
+    required i64 overdue,
+    required i64 escalated,
+    optional i64 oldest_pending_at,
+}
+
+// 处罚记录，action 为 warn、hide_content、deduct_reputation、mute、ban
+struct ModerationAction{
+    required i64 action_id,
+    required i64 review_id,
+    required i64 user_id,
+    optional i64 moderator_id,
+    required string action,
+    required string reason,
+    required string target_type,
+    required i64 target_id,
+    required i64 reputation_delta,
+    optional i64 muted_until,
+    required string status,
+    optional i64 appeal_review_id,
+    required i64 created_at,
+    optional i64 revoked_at,
+}
+
+struct ContentFilterRule{
+    required i64 rule_id,
+    required string pattern,
+    required string match_type,
+    required string action,
+    optional string description,
+    required bool enabled,
+    optional i64 created_by,
+    required i64 created_at,
+    required i64 updated_at,
+}
+
+// 内容过滤命中记录，text 为命中的原文
+struct ContentFilterMatch{
+    required i64 rule_id,
+    required string pattern,
+    required string action,
+    required string text,
+}
+
+struct Notification{
+    required i64 notification_id,
+    required string type,
+    required string title,
+    required string content,
+    optional string related_type,
+    optional i64 related_id,
+    required bool is_read,
+    required i64 created_at,
+}
+
+// 内容引用，target_type 取值与举报对象类型相同：resource、comment（资源评论）、course_comment、course_rating、resource_rating
+struct ContentRef{
+    required string target_type,
+    required i64 target_id,
+}
+
+// 批量操作中单个条目的执行结果，失败时 code 与 message 为对应的错误
+struct BatchItemResult{
+    required string target_type,
+    required i64 target_id,
+    required bool success,
+    required i64 code,
+    required string message,
+}
+
+// 审核积压：time 时刻各类型尚未处理的审核数量
+struct ModerationBacklogPoint{
+    required i64 time,
+    required string target_type,
+    required i64 pending,
+}
+
+// 审核员在统计区间内的处理情况，approval_rate 为确认违规的比例
+struct ReviewerPerformance{
+    required i64 reviewer_id,
+    required string username,
+    required i64 decided,
+    required i64 approved,
+    required i64 rejected,
+    required double approval_rate,
+    required i64 avg_decision_seconds,
+}
+
+struct ReportedUser{
+    required i64 user_id,
+    required string username,
+    required i64 report_count,
+    required i64 review_count,
+}
+
+struct ReportedResource{
+    required i64 resource_id,
+    required string resource_name,
+    required i64 report_count,
+    required i64 review_count,
+}
+
+struct ModerationStats{
+    required i64 start_time,
+    required i64 end_time,
+    required string interval,
+    required list<ModerationBacklogPoint> backlog,
+    required i64 decided_count,
+    required i64 median_decision_seconds,
+    required list<ReviewerPerformance> reviewers,
+    required list<ReportedUser> top_reported_users,
+    required list<ReportedResource> top_reported_resources,
+}
+
+// 信誉分变动记录，event_type 为 upload_approved、resource_downloaded、rating_received、penalty、appeal_restored 或 manual_adjust
+struct ReputationRecord{
+    required i64 record_id,
+    required i64 user_id,
+    required i64 change_score,
+    required string event_type,
+    required string reason,
+    optional string related_type,
+    optional i64 related_id,
+    optional i64 operator_id,
+    required i64 created_at,
+}
+
+struct Item{
+    required i64 item_id,
+    required string name,
+    required string type,
+    required i64 price,
+    required string description,
+    required string image_url,
+}
+
+struct UserItem{
+    required i64 user_item_id,
+    required Item item,
+    required bool is_used,
+    required i64 obtained_at,
+}
+
+struct Achievement{
+    required string code,
+    required string name,
+    required string description,
+    required string metric,
+    required i64 threshold,
+    required i64 progress,
+    required bool achieved,
+    optional i64 achieved_at,
+    optional Item medal,
+}
+
+struct LeaderboardEntry{
+    required i64 rank,
+    required i64 user_id,
+    required string username,
+    required string avatar_url,
+    required i64 score,
 }
@@ -123,6 +123,26 @@ struct SubmitResourceCommentReactionResp {
     1: required model.BaseResp baseResp,
 }
 
+// 举报资源评分
+struct ReportResourceRatingReq {
+    1: required i64 rating_id (api.path="rating_id"),
+    2: required string reason (api.body="reason"),
+}
+
+struct ReportResourceRatingResp {
+    1: required model.BaseResp baseResp,
+}
+
+// 举报资源评论
+struct ReportResourceCommentReq {
+    1: required i64 comment_id (api.path="comment_id"),
+    2: required string reason (api.body="reason"),
+}
+
+struct ReportResourceCommentResp {
+    1: required model.BaseResp baseResp,
+}
+
 // 资源服务
 service ResourceService {
     SearchResourceResp searchResources(1: SearchResourceReq req)(api.get="/api/resources/search"),
@@ -141,6 +161,8 @@ service ResourceService {
     GetResourceCommentsResp getResourceComments(1: GetResourceCommentsReq req)(api.get="/api/resource/:resource_id/comment"),
 
     SubmitResourceCommentReactionResp reactResourceComment(1: SubmitResourceCommentReactionReq req)(api.post="/api/resource_comments/:comment_id/likes"),
+    ReportResourceCommentResp reportResourceComment(1: ReportResourceCommentReq req)(api.post="/api/resource_comments/:comment_id/report"),
+    ReportResourceRatingResp reportResourceRating(1: ReportResourceRatingReq req)(api.post="/api/resource_ratings/:rating_id/report"),
 }
 
 struct AdminDeleteResourceCommentReq{
@@ -165,9 +187,25 @@ struct AdminDeleteResourceResp{
     required model.BaseResp base_resp,
 }
 
+struct AdminRestoreResourceReq{
+    required i64 resource_id(api.path="resource_id"),
+}
+struct AdminRestoreResourceResp{
+    required model.BaseResp base_resp,
+}
+
+struct AdminRestoreResourceCommentReq{
+    required i64 comment_id(api.path="comment_id"),
+}
+struct AdminRestoreResourceCommentResp{
+    required model.BaseResp base_resp,
+}
+
 service AdminResourceService {
     AdminDeleteResourceCommentResp AdminDeleteResourceComment(1:AdminDeleteResourceCommentReq req)(api.delete="/api/admin/resource_comments/:comment_id"),
     AdminDeleteResourceRatingResp AdminDeleteResourceRating(1:AdminDeleteResourceRatingReq req)(api.delete="/api/admin/resource_ratings/:rating_id"),
     AdminDeleteResourceResp AdminDeleteResource(1:AdminDeleteResourceReq req)(api.delete="/api/admin/resources/:resource_id"),
+    AdminRestoreResourceResp AdminRestoreResource(1:AdminRestoreResourceReq req)(api.post="/api/admin/resources/:resource_id/restore"),
+    AdminRestoreResourceCommentResp AdminRestoreResourceComment(1:AdminRestoreResourceCommentReq req)(api.post="/api/admin/resource_comments/:comment_id/restore"),
 
 }
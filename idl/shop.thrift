@@ -0,0 +1,89 @@
+namespace go shop
+include "model.thrift"
+
+struct ListItemsReq{
+    optional string type,
+    required i32 page_num,
+    required i32 page_size,
+}
+struct ListItemsResp{
+    required model.BaseResp base_resp,
+    required list<model.Item> item_list,
+    required i64 total,
+}
+
+struct PurchaseItemReq{
+    required i64 item_id(api.path="item_id"),
+}
+struct PurchaseItemResp{
+    required model.BaseResp base_resp,
+    required model.UserItem user_item,
+    required i64 reputation_score,
+}
+
+struct ListMyItemsReq{
+    optional string type,
+}
+struct ListMyItemsResp{
+    required model.BaseResp base_resp,
+    required list<model.UserItem> item_list,
+}
+
+struct EquipItemReq{
+    required i64 item_id(api.path="item_id"),
+}
+struct EquipItemResp{
+    required model.BaseResp base_resp,
+}
+
+struct UnequipItemReq{
+    required i64 item_id(api.path="item_id"),
+}
+struct UnequipItemResp{
+    required model.BaseResp base_resp,
+}
+
+service ShopService {
+    ListItemsResp ListItems(1:ListItemsReq req)(api.get="/api/shop/items"),
+    PurchaseItemResp PurchaseItem(1:PurchaseItemReq req)(api.post="/api/shop/items/:item_id/purchase"),
+    ListMyItemsResp ListMyItems(1:ListMyItemsReq req)(api.get="/api/users/me/items"),
+    EquipItemResp EquipItem(1:EquipItemReq req)(api.post="/api/users/me/items/:item_id/equip"),
+    UnequipItemResp UnequipItem(1:UnequipItemReq req)(api.post="/api/users/me/items/:item_id/unequip"),
+}
+
+// 管理端：物品维护，预览图以 multipart 表单字段 image 上传
+struct AdminCreateItemReq{
+    required string name,
+    required string type,
+    required i64 price,
+    optional string description,
+}
+struct AdminCreateItemResp{
+    required model.BaseResp base_resp,
+    required model.Item item,
+}
+
+struct AdminUpdateItemReq{
+    required i64 item_id(api.path="item_id"),
+    optional string name,
+    optional string type,
+    optional i64 price,
+    optional string description,
+}
+struct AdminUpdateItemResp{
+    required model.BaseResp base_resp,
+    required model.Item item,
+}
+
+struct AdminDeleteItemReq{
+    required i64 item_id(api.path="item_id"),
+}
+struct AdminDeleteItemResp{
+    required model.BaseResp base_resp,
+}
+
+service ShopAdminService {
+    AdminCreateItemResp AdminCreateItem(1:AdminCreateItemReq req)(api.post="/api/admin/items"),
+    AdminUpdateItemResp AdminUpdateItem(1:AdminUpdateItemReq req)(api.put="/api/admin/items/:item_id"),
+    AdminDeleteItemResp AdminDeleteItem(1:AdminDeleteItemReq req)(api.delete="/api/admin/items/:item_id"),
+}
@@ -49,6 +49,7 @@ struct VerifyEmailResp {
 struct updateEmailReq {
   required string new_email;
   required string code;
+  required string old_code;
 }
 struct updateEmailResp {
   required model.BaseResp baseResponse;
@@ -92,6 +93,33 @@ struct ResetPasswordResp {
 }
 
 
+//邮箱验证码解锁账户
+struct UnlockAccountReq {
+  required string email;
+  required string code;
+}
+struct UnlockAccountResp {
+  required model.BaseResp baseResponse;
+}
+
+//激活账户
+struct ActivateAccountReq {
+  required string email;
+  required string code;
+}
+struct ActivateAccountResp {
+  required model.BaseResp baseResponse;
+}
+
+//重新发送激活邮件
+struct ResendActivationReq {
+  required string email;
+}
+struct ResendActivationResp {
+  required model.BaseResp baseResponse;
+}
+
+
 //刷新Token
 struct RefreshTokenReq {
 }
@@ -108,6 +136,69 @@ struct GetUserInfoResp {
   optional model.User user;
 }
 
+//获取统一身份认证登录地址
+struct OIDCAuthorizeReq {
+}
+struct OIDCAuthorizeResp {
+  required model.BaseResp baseResponse;
+  required string authorize_url;
+}
+
+//通过旧邮箱中的链接撤销邮箱修改
+struct RevertEmailChangeReq {
+  required string token;
+}
+struct RevertEmailChangeResp {
+  required model.BaseResp baseResponse;
+}
+
+//获取本地图形验证码
+struct GetCaptchaReq {
+}
+struct GetCaptchaResp {
+  required model.BaseResp baseResponse;
+  required string captcha_id;
+  required string image;
+  required i64 expire_in;
+}
+
+//统一身份认证回调登录
+struct OIDCCallbackReq {
+  required string code;
+  required string state;
+}
+struct OIDCCallbackResp {
+  required model.BaseResp baseResponse;
+  optional model.User user;
+}
+
+//创建个人访问令牌，明文令牌仅在创建时返回一次
+struct CreatePersonalTokenReq {
+  required string name;
+  required list<string> scopes;
+  optional i64 expires_in_days;
+}
+struct CreatePersonalTokenResp {
+  required model.BaseResp baseResponse;
+  required string token;
+  required model.PersonalToken token_info;
+}
+
+//获取个人访问令牌列表
+struct ListPersonalTokensReq {
+}
+struct ListPersonalTokensResp {
+  required model.BaseResp baseResponse;
+  required list<model.PersonalToken> tokens;
+}
+
+//吊销个人访问令牌
+struct RevokePersonalTokenReq {
+  required i64 token_id (api.path="token_id");
+}
+struct RevokePersonalTokenResp {
+  required model.BaseResp baseResponse;
+}
 
 service UserService {
   RegisterResp register(1: RegisterReq req)(api.post="/api/auth/register"),
@@ -120,8 +211,18 @@ service UserService {
   updateMajorResp updateMajor(1: updateMajorReq req)(api.put="/api/users/me/major"),
   uploadAvatarResp uploadAvatar(1: uploadAvatarReq req)(api.put="/api/users/avatar"),
   ResetPasswordResp resetPassword(1: ResetPasswordReq req)(api.post="/api/users/me/password/reset"),
+  UnlockAccountResp unlockAccount(1: UnlockAccountReq req)(api.post="/api/auth/unlock"),
+  ActivateAccountResp activateAccount(1: ActivateAccountReq req)(api.post="/api/auth/activate"),
+  ResendActivationResp resendActivation(1: ResendActivationReq req)(api.post="/api/auth/activate/resend"),
   RefreshTokenResp refreshToken(1: RefreshTokenReq req)(api.post="/api/auth/refresh"),
   GetUserInfoResp getUserInfo(1: GetUserInfoReq req)(api.get="/api/users/:user_id"),
+  OIDCAuthorizeResp oidcAuthorize(1: OIDCAuthorizeReq req)(api.get="/api/auth/oidc/authorize"),
+  OIDCCallbackResp oidcCallback(1: OIDCCallbackReq req)(api.post="/api/auth/oidc/callback"),
+  GetCaptchaResp getCaptcha(1: GetCaptchaReq req)(api.get="/api/auth/captcha"),
+  RevertEmailChangeResp revertEmailChange(1: RevertEmailChangeReq req)(api.post="/api/auth/email/revert"),
+  CreatePersonalTokenResp createPersonalToken(1: CreatePersonalTokenReq req)(api.post="/api/users/me/tokens"),
+  ListPersonalTokensResp listPersonalTokens(1: ListPersonalTokensReq req)(api.get="/api/users/me/tokens"),
+  RevokePersonalTokenResp revokePersonalToken(1: RevokePersonalTokenReq req)(api.delete="/api/users/me/tokens/:token_id"),
 }
 
 struct AdminAddUserReq{
@@ -180,8 +281,181 @@ struct AddRoleResp{
     required i64 role_id,
 }
 
+struct UpdateRoleReq{
+    required i64 role_id(api.path="role_id"),
+    optional string role_name,
+    optional string description,
+}
+struct UpdateRoleResp{
+    required model.BaseResp base_resp,
+}
+
+struct GrantRolePermissionsReq{
+    required i64 role_id(api.path="role_id"),
+    required list<i64> permission_ids,
+}
+struct GrantRolePermissionsResp{
+    required model.BaseResp base_resp,
+}
+
+struct RevokeRolePermissionsReq{
+    required i64 role_id(api.path="role_id"),
+    required list<i64> permission_ids,
+}
+struct RevokeRolePermissionsResp{
+    required model.BaseResp base_resp,
+}
+
+struct DeleteRoleReq{
+    required i64 role_id(api.path="role_id"),
+    required i64 reassign_role_id,
+}
+struct DeleteRoleResp{
+    required model.BaseResp base_resp,
+    required i64 reassigned_users,
+}
+
+struct GetRoleUsersReq{
+    required i64 role_id(api.path="role_id"),
+    required i32 page_num,
+    required i32 page_size,
+}
+struct GetRoleUsersResp{
+    required model.BaseResp base_resp,
+    required list<model.User> user_list,
+    required i64 total,
+}
+
 service RoleAdminService {
     GetPermissionListResp GetPermissionList(1:GetPermissionListReq req)(api.get="/api/admin/permissions"),
     GetRoleListResp GetRoleList(1:GetRoleListReq req)(api.get="/api/admin/roles"),
     AddRoleResp AddRole(1:AddRoleReq req)(api.post="/api/admin/roles"),
+    UpdateRoleResp UpdateRole(1:UpdateRoleReq req)(api.put="/api/admin/roles/:role_id"),
+    DeleteRoleResp DeleteRole(1:DeleteRoleReq req)(api.delete="/api/admin/roles/:role_id"),
+    GrantRolePermissionsResp GrantRolePermissions(1:GrantRolePermissionsReq req)(api.post="/api/admin/roles/:role_id/permissions"),
+    RevokeRolePermissionsResp RevokeRolePermissions(1:RevokeRolePermissionsReq req)(api.delete="/api/admin/roles/:role_id/permissions"),
+    GetRoleUsersResp GetRoleUsers(1:GetRoleUsersReq req)(api.get="/api/admin/roles/:role_id/users"),
+}
+
+struct GrantScopedPermissionReq{
+    required i64 user_id,
+    required string permission_name,
+    required string scope_type,
+    required i64 scope_id,
+}
+struct GrantScopedPermissionResp{
+    required model.BaseResp base_resp,
+    required i64 grant_id,
+}
+
+struct RevokeScopedPermissionReq{
+    required i64 grant_id(api.path="grant_id"),
+}
+struct RevokeScopedPermissionResp{
+    required model.BaseResp base_resp,
+}
+
+struct ListScopedPermissionsReq{
+    required string scope_type,
+    required i64 scope_id,
+}
+struct ListScopedPermissionsResp{
+    required model.BaseResp base_resp,
+    required list<model.ScopedPermission> grant_list,
+}
+
+service ScopedPermissionService {
+    GrantScopedPermissionResp GrantScopedPermission(1:GrantScopedPermissionReq req)(api.post="/api/scoped_permissions"),
+    RevokeScopedPermissionResp RevokeScopedPermission(1:RevokeScopedPermissionReq req)(api.delete="/api/scoped_permissions/:grant_id"),
+    ListScopedPermissionsResp ListScopedPermissions(1:ListScopedPermissionsReq req)(api.get="/api/scoped_permissions"),
+}
+
+struct ListMyModerationActionsReq{
+    required i32 page_num,
+    required i32 page_size,
+}
+struct ListMyModerationActionsResp{
+    required model.BaseResp base_resp,
+    required list<model.ModerationAction> action_list,
+    required i64 total,
+}
+
+// 对处罚提起申诉，由原审核员以外的审核员复核
+struct AppealModerationActionReq{
+    required i64 action_id(api.path="action_id"),
+    required string reason,
+}
+struct AppealModerationActionResp{
+    required model.BaseResp base_resp,
+    required i64 appeal_review_id,
+}
+
+service ModerationService {
+    ListMyModerationActionsResp ListMyModerationActions(1:ListMyModerationActionsReq req)(api.get="/api/users/me/moderation_actions"),
+    AppealModerationActionResp AppealModerationAction(1:AppealModerationActionReq req)(api.post="/api/users/me/moderation_actions/:action_id/appeal"),
+}
+
+struct ListNotificationsReq{
+    required i32 page_num,
+    required i32 page_size,
+    optional bool unread_only,
+}
+struct ListNotificationsResp{
+    required model.BaseResp base_resp,
+    required list<model.Notification> notification_list,
+    required i64 total,
+    required i64 unread,
+}
+
+// 标记通知为已读，notification_ids 为空时全部标记
+struct MarkNotificationsReadReq{
+    optional list<i64> notification_ids,
+}
+struct MarkNotificationsReadResp{
+    required model.BaseResp base_resp,
+}
+
+service NotificationService {
+    ListNotificationsResp ListNotifications(1:ListNotificationsReq req)(api.get="/api/users/me/notifications"),
+    MarkNotificationsReadResp MarkNotificationsRead(1:MarkNotificationsReadReq req)(api.post="/api/users/me/notifications/read"),
+}
+
+struct ListMyReputationRecordsReq{
+    required i32 page_num,
+    required i32 page_size,
+}
+struct ListMyReputationRecordsResp{
+    required model.BaseResp base_resp,
+    required i64 reputation_score,
+    required list<model.ReputationRecord> record_list,
+    required i64 total,
+}
+
+// 管理员手动调整用户信誉分，delta 为负表示扣除
+struct AdjustUserReputationReq{
+    required i64 user_id(api.path="user_id"),
+    required i64 delta,
+    required string reason,
+}
+struct AdjustUserReputationResp{
+    required model.BaseResp base_resp,
+    required i64 reputation_score,
+    required model.ReputationRecord record,
+}
+
+service ReputationService {
+    ListMyReputationRecordsResp ListMyReputationRecords(1:ListMyReputationRecordsReq req)(api.get="/api/users/me/reputation"),
+    AdjustUserReputationResp AdjustUserReputation(1:AdjustUserReputationReq req)(api.post="/api/admin/users/:user_id/reputation"),
+}
+
+// 当前用户的全部成就，包含已达成与进行中的进度
+struct ListMyAchievementsReq{
+}
+struct ListMyAchievementsResp{
+    required model.BaseResp base_resp,
+    required list<model.Achievement> achievement_list,
+}
+
+service AchievementService {
+    ListMyAchievementsResp ListMyAchievements(1:ListMyAchievementsReq req)(api.get="/api/users/me/achievements"),
 }
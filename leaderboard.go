@@ -0,0 +1,19 @@
+package main
+
+import (
+	"LearnShare/biz/service"
+	"context"
+	"fmt"
+	"os"
+)
+
+// rebuildLeaderboards 从数据库统计全部用户并重建当前周期的排行榜
+func rebuildLeaderboards() {
+	initStorage()
+	boards, err := service.RebuildLeaderboards(context.Background())
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	fmt.Printf("排行榜重建完成，共 %d 个排行榜\n", boards)
+}
@@ -3,10 +3,13 @@
 package main
 
 import (
+	"os"
 	"time"
 
 	"LearnShare/biz/dal"
+	"LearnShare/biz/job"
 	"LearnShare/biz/middleware"
+	"LearnShare/biz/service"
 	"LearnShare/config"
 	"LearnShare/pkg/logger"
 	"LearnShare/pkg/utils"
@@ -17,6 +20,14 @@ import (
 )
 
 func Init() {
+	initStorage()
+	middleware.InitJWT()
+	service.InitPermissionCache()
+	job.Init()
+}
+
+// initStorage 加载配置并初始化日志与数据库，服务启动与命令行子命令共用
+func initStorage() {
 	config.Init()
 
 	// 初始化日志系统（传递环境参数）
@@ -29,10 +40,25 @@ func Init() {
 	if err != nil {
 		logger.Fatalf("数据库初始化失败: %v", err)
 	}
-	middleware.InitJWT()
 }
 
 func main() {
+	// routes 子命令：输出路由权限矩阵后退出
+	if len(os.Args) > 1 && os.Args[1] == "routes" {
+		printRoutePolicy()
+		return
+	}
+	// achievements backfill 子命令：为现有用户补发成就后退出
+	if len(os.Args) > 2 && os.Args[1] == "achievements" && os.Args[2] == "backfill" {
+		backfillAchievements()
+		return
+	}
+	// leaderboards rebuild 子命令：从数据库重建排行榜后退出
+	if len(os.Args) > 2 && os.Args[1] == "leaderboards" && os.Args[2] == "rebuild" {
+		rebuildLeaderboards()
+		return
+	}
+
 	Init()
 	h := server.Default(server.WithHostPorts(utils.GetServerAddress()))
 
@@ -55,10 +81,17 @@ func main() {
 		}))
 	}
 
+	// 路由访问策略（认证、激活与权限校验）
+	routePolicy := loadRoutePolicy(h)
+
+	// 管理操作日志
+	h.Use(middleware.AdminActionLogger())
+
 	// 注册 pprof 性能分析路由
 	pprof.Register(h)
 
 	register(h)
+	checkRoutePolicy(h, routePolicy)
 	logger.Infof("服务器启动成功 | 监听地址=%s", utils.GetServerAddress())
 	h.Spin()
 }
@@ -0,0 +1,133 @@
+package captcha
+
+import (
+	"context"
+	"encoding/base64"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func newSiteVerifyServer(t *testing.T, handler func(secret, response, remoteIP string) string) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+		_, _ = w.Write([]byte(handler(r.PostForm.Get("secret"), r.PostForm.Get("response"), r.PostForm.Get("remoteip"))))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestSiteVerifier(t *testing.T) {
+	server := newSiteVerifyServer(t, func(secret, response, remoteIP string) string {
+		if secret == "secret" && response == "good" && remoteIP == "1.2.3.4" {
+			return `{"success":true}`
+		}
+		return `{"success":false,"error-codes":["invalid-input-response"]}`
+	})
+
+	for name, v := range map[string]Verifier{
+		ProviderTurnstile: NewTurnstile(Config{SecretKey: "secret", VerifyURL: server.URL}),
+		ProviderHCaptcha:  NewHCaptcha(Config{SecretKey: "secret", VerifyURL: server.URL}),
+		ProviderReCaptcha: NewReCaptcha(Config{SecretKey: "secret", VerifyURL: server.URL}),
+	} {
+		ok, err := v.Verify(context.Background(), "good", "1.2.3.4")
+		if err != nil || !ok {
+			t.Fatalf("%s: 有效 token 应当通过: ok=%v err=%v", name, ok, err)
+		}
+		ok, err = v.Verify(context.Background(), "bad", "1.2.3.4")
+		if err != nil || ok {
+			t.Fatalf("%s: 无效 token 不应通过: ok=%v err=%v", name, ok, err)
+		}
+	}
+}
+
+func TestReCaptchaMinScore(t *testing.T) {
+	server := newSiteVerifyServer(t, func(_, response, _ string) string {
+		if response == "human" {
+			return `{"success":true,"score":0.9}`
+		}
+		return `{"success":true,"score":0.1}`
+	})
+	v := NewReCaptcha(Config{SecretKey: "secret", VerifyURL: server.URL, MinScore: 0.5})
+
+	if ok, _ := v.Verify(context.Background(), "human", ""); !ok {
+		t.Fatalf("高分请求应当通过")
+	}
+	if ok, _ := v.Verify(context.Background(), "bot", ""); ok {
+		t.Fatalf("低于最低分的请求不应通过")
+	}
+}
+
+func TestSiteVerifierTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+		_, _ = w.Write([]byte(`{"success":true}`))
+	}))
+	defer server.Close()
+
+	v := NewTurnstile(Config{SecretKey: "secret", VerifyURL: server.URL, Timeout: 50 * time.Millisecond})
+	if ok, err := v.Verify(context.Background(), "good", ""); err == nil || ok {
+		t.Fatalf("校验服务超时应当返回错误: ok=%v err=%v", ok, err)
+	}
+}
+
+// memoryStore 测试用的内存答案存储
+type memoryStore struct {
+	mu      sync.Mutex
+	answers map[string]string
+}
+
+func (m *memoryStore) Set(_ context.Context, id, answer string, _ time.Duration) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.answers[id] = answer
+	return nil
+}
+
+func (m *memoryStore) Take(_ context.Context, id string) (string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	answer := m.answers[id]
+	delete(m.answers, id)
+	return answer, nil
+}
+
+func TestLocalCaptcha(t *testing.T) {
+	store := &memoryStore{answers: map[string]string{}}
+	l := NewLocal(store, time.Minute)
+	ctx := context.Background()
+
+	challenge, err := l.Generate(ctx)
+	if err != nil {
+		t.Fatalf("生成验证码失败: %v", err)
+	}
+	data, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(challenge.Image, "data:image/png;base64,"))
+	if err != nil {
+		t.Fatalf("验证码图片编码不正确: %v", err)
+	}
+	if _, err := png.Decode(strings.NewReader(string(data))); err != nil {
+		t.Fatalf("验证码图片不是有效的 PNG: %v", err)
+	}
+
+	if ok, _ := l.Verify(ctx, challenge.ID+":wrong", ""); ok {
+		t.Fatalf("错误答案不应通过")
+	}
+
+	// 答案只能使用一次，错误尝试后需要重新获取
+	challenge, _ = l.Generate(ctx)
+	answer := store.answers[challenge.ID]
+	if ok, err := l.Verify(ctx, challenge.ID+":"+answer, ""); err != nil || !ok {
+		t.Fatalf("正确答案应当通过: ok=%v err=%v", ok, err)
+	}
+	if ok, _ := l.Verify(ctx, challenge.ID+":"+answer, ""); ok {
+		t.Fatalf("同一验证码不能重复使用")
+	}
+	if ok, _ := l.Verify(ctx, "malformed", ""); ok {
+		t.Fatalf("格式错误的 token 不应通过")
+	}
+}
@@ -0,0 +1,129 @@
+package captcha
+
+import (
+	"bytes"
+	"encoding/base64"
+	"image"
+	"image/color"
+	"image/png"
+)
+
+const (
+	glyphWidth  = 5
+	glyphHeight = 7
+	glyphScale  = 4
+	glyphGap    = 4
+	imagePad    = 8
+)
+
+// glyphs 5x7 点阵字体，每行 5 位，高位在左
+var glyphs = map[rune][glyphHeight]uint8{
+	'0': {0x0E, 0x11, 0x13, 0x15, 0x19, 0x11, 0x0E},
+	'1': {0x04, 0x0C, 0x04, 0x04, 0x04, 0x04, 0x0E},
+	'2': {0x0E, 0x11, 0x01, 0x02, 0x04, 0x08, 0x1F},
+	'3': {0x1F, 0x02, 0x04, 0x02, 0x01, 0x11, 0x0E},
+	'4': {0x02, 0x06, 0x0A, 0x12, 0x1F, 0x02, 0x02},
+	'5': {0x1F, 0x10, 0x1E, 0x01, 0x01, 0x11, 0x0E},
+	'6': {0x06, 0x08, 0x10, 0x1E, 0x11, 0x11, 0x0E},
+	'7': {0x1F, 0x01, 0x02, 0x04, 0x08, 0x08, 0x08},
+	'8': {0x0E, 0x11, 0x11, 0x0E, 0x11, 0x11, 0x0E},
+	'9': {0x0E, 0x11, 0x11, 0x0F, 0x01, 0x02, 0x0C},
+	'+': {0x00, 0x04, 0x04, 0x1F, 0x04, 0x04, 0x00},
+	'-': {0x00, 0x00, 0x00, 0x1F, 0x00, 0x00, 0x00},
+	'=': {0x00, 0x00, 0x1F, 0x00, 0x1F, 0x00, 0x00},
+	'?': {0x0E, 0x11, 0x01, 0x02, 0x04, 0x00, 0x04},
+}
+
+// renderPNG 将文本绘制为带干扰的 PNG 图片，返回 data URI
+func renderPNG(text string) (string, error) {
+	runes := []rune(text)
+	cell := glyphWidth*glyphScale + glyphGap
+	width := imagePad*2 + len(runes)*cell
+	height := imagePad*2 + glyphHeight*glyphScale + glyphScale*2
+
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	background := color.RGBA{R: 245, G: 245, B: 245, A: 255}
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			img.Set(x, y, background)
+		}
+	}
+
+	// 干扰点
+	for i := 0; i < width*height/12; i++ {
+		img.Set(randInt(0, width-1), randInt(0, height-1), randomColor(120, 220))
+	}
+
+	// 字符逐个随机上下偏移、随机颜色
+	for i, r := range runes {
+		glyph, ok := glyphs[r]
+		if !ok {
+			continue
+		}
+		originX := imagePad + i*cell
+		originY := imagePad + randInt(0, glyphScale*2)
+		c := randomColor(20, 110)
+		for row := 0; row < glyphHeight; row++ {
+			for col := 0; col < glyphWidth; col++ {
+				if glyph[row]&(1<<(glyphWidth-1-col)) == 0 {
+					continue
+				}
+				for dy := 0; dy < glyphScale; dy++ {
+					for dx := 0; dx < glyphScale; dx++ {
+						img.Set(originX+col*glyphScale+dx, originY+row*glyphScale+dy, c)
+					}
+				}
+			}
+		}
+	}
+
+	// 干扰线
+	for i := 0; i < 3; i++ {
+		drawLine(img, randInt(0, width/3), randInt(0, height-1), randInt(width*2/3, width-1), randInt(0, height-1), randomColor(60, 160))
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return "", err
+	}
+	return "data:image/png;base64," + base64.StdEncoding.EncodeToString(buf.Bytes()), nil
+}
+
+func randomColor(min, max int) color.RGBA {
+	return color.RGBA{R: uint8(randInt(min, max)), G: uint8(randInt(min, max)), B: uint8(randInt(min, max)), A: 255}
+}
+
+// drawLine Bresenham 直线
+func drawLine(img *image.RGBA, x0, y0, x1, y1 int, c color.RGBA) {
+	dx, dy := abs(x1-x0), -abs(y1-y0)
+	sx, sy := 1, 1
+	if x0 > x1 {
+		sx = -1
+	}
+	if y0 > y1 {
+		sy = -1
+	}
+	e := dx + dy
+	for {
+		img.Set(x0, y0, c)
+		if x0 == x1 && y0 == y1 {
+			return
+		}
+		e2 := 2 * e
+		if e2 >= dy {
+			e += dy
+			x0 += sx
+		}
+		if e2 <= dx {
+			e += dx
+			y0 += sy
+		}
+	}
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
@@ -0,0 +1,90 @@
+package captcha
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+)
+
+// Store 本地验证码答案存储，答案只能被取出一次
+type Store interface {
+	Set(ctx context.Context, id, answer string, ttl time.Duration) error
+	// Take 取出并删除答案，不存在或已过期时返回空串
+	Take(ctx context.Context, id string) (string, error)
+}
+
+// Challenge 本地生成的图形验证码
+type Challenge struct {
+	ID    string
+	Image string // data:image/png;base64 格式的图片
+}
+
+// Local 本地算术图形验证码，无需依赖外部服务
+// 客户端提交的 token 格式为 "<验证码ID>:<计算结果>"
+type Local struct {
+	store Store
+	ttl   time.Duration
+}
+
+func NewLocal(store Store, ttl time.Duration) *Local {
+	return &Local{store: store, ttl: ttl}
+}
+
+// TTL 验证码有效期
+func (l *Local) TTL() time.Duration {
+	return l.ttl
+}
+
+// Generate 生成一道算术题并保存答案
+func (l *Local) Generate(ctx context.Context) (*Challenge, error) {
+	idBytes := make([]byte, 16)
+	if _, err := rand.Read(idBytes); err != nil {
+		return nil, err
+	}
+	id := hex.EncodeToString(idBytes)
+
+	a, b := randInt(1, 20), randInt(1, 20)
+	op := "+"
+	answer := a + b
+	if randInt(0, 1) == 1 {
+		op = "-"
+		if a < b {
+			a, b = b, a
+		}
+		answer = a - b
+	}
+
+	image, err := renderPNG(fmt.Sprintf("%d%s%d=?", a, op, b))
+	if err != nil {
+		return nil, err
+	}
+	if err := l.store.Set(ctx, id, fmt.Sprint(answer), l.ttl); err != nil {
+		return nil, err
+	}
+	return &Challenge{ID: id, Image: image}, nil
+}
+
+func (l *Local) Verify(ctx context.Context, token, _ string) (bool, error) {
+	id, answer, ok := strings.Cut(token, ":")
+	if !ok || id == "" || answer == "" {
+		return false, nil
+	}
+	expected, err := l.store.Take(ctx, id)
+	if err != nil {
+		return false, err
+	}
+	return expected != "" && expected == strings.TrimSpace(answer), nil
+}
+
+// randInt 返回 [min, max] 区间内的随机整数
+func randInt(min, max int) int {
+	n, err := rand.Int(rand.Reader, big.NewInt(int64(max-min+1)))
+	if err != nil {
+		return min
+	}
+	return min + int(n.Int64())
+}
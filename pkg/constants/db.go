@@ -28,4 +28,20 @@ const (
 	MajorTableName                   = "majors"
 	TeacherTableName                 = "teachers"
 	FavoriteTableName                = "favorites"
+	UserIdentityTableName            = "user_identities"
+	PasswordHistoryTableName         = "password_histories"
+	EmailChangeHistoryTableName      = "email_change_histories"
+	PersonalAccessTokenTableName     = "personal_access_tokens"
+	ScopedPermissionGrantTableName   = "scoped_permission_grants"
+	AdminActionTableName             = "admin_actions"
+	ModerationActionTableName        = "moderation_actions"
+	NotificationTableName            = "notifications"
+	ReputationRecordTableName        = "reputation_records"
+	ReputationRuleTableName          = "reputation_rules"
+	ReviewReporterTableName          = "review_reporters"
+	ContentFilterRuleTableName       = "content_filter_rules"
+	ItemTableName                    = "items"
+	UserItemTableName                = "user_items"
+	AchievementTableName             = "achievements"
+	UserAchievementTableName         = "user_achievements"
 )
@@ -2,5 +2,43 @@ package constants
 
 const (
 	RoleSuperAdminID = 1
+	RoleUserID       = 2
 	RoleAuditorID    = 3
 )
+
+// BuiltinRoleIDs 内置角色，不允许删除
+var BuiltinRoleIDs = []int64{RoleSuperAdminID, RoleUserID, RoleAuditorID}
+
+// 作用域授权的作用域类型
+const (
+	ScopeTypeCourse   = "course"
+	ScopeTypeResource = "resource"
+)
+
+// 作用域来源：说明路径参数中的ID指向哪类对象，用于推导其所属的课程、资源作用域
+const (
+	ScopeSourceCourse          = "course"
+	ScopeSourceCourseComment   = "course_comment"
+	ScopeSourceCourseRating    = "course_rating"
+	ScopeSourceResource        = "resource"
+	ScopeSourceResourceComment = "resource_comment"
+	ScopeSourceResourceRating  = "resource_rating"
+)
+
+// PermissionCourseOwner 课程负责人权限，仅以作用域授权的形式授予，持有者可管理该课程范围内的授权
+const PermissionCourseOwner = "course.owner"
+
+// ScopedGrantablePermissions 各作用域类型下允许授予的权限
+var ScopedGrantablePermissions = map[string][]string{
+	ScopeTypeCourse: {
+		PermissionCourseOwner,
+		"course.comment.moderate",
+		"course.rating.moderate",
+		"resource.comment.moderate",
+		"resource.rating.moderate",
+	},
+	ScopeTypeResource: {
+		"resource.comment.moderate",
+		"resource.rating.moderate",
+	},
+}
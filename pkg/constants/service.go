@@ -4,4 +4,12 @@ package constants
 const (
 	UserDefaultEncryptPasswordCost = 10
 	ContextUid                     = "userid"
+	ContextTokenScopes             = "token_scopes" // 个人访问令牌的授权范围，仅通过个人访问令牌认证时存在
+	ContextAdminAction             = "admin_action" // 管理操作的目标与变更快照，由服务层写入、操作日志中间件落库
 )
+
+// PersonalTokenPrefix 个人访问令牌前缀
+const PersonalTokenPrefix = "lsp_"
+
+// DefaultReviewPriority 新建审核记录的默认优先级（1 最高，5 最低）
+const DefaultReviewPriority = 3
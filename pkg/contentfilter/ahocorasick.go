@@ -0,0 +1,90 @@
+package contentfilter
+
+import "unicode"
+
+// matcher Aho-Corasick 多模式匹配自动机，按小写字符匹配，一次扫描找出全部关键词出现位置
+type matcher struct {
+	nodes []acNode
+}
+
+type acNode struct {
+	next map[rune]int
+	fail int
+	// out 以该节点结尾的关键词下标，包含沿失败链可达的关键词
+	out []int
+}
+
+// hit 关键词在文本中的一次出现，start/end 为字符（rune）下标，end 不包含
+type hit struct {
+	pattern    int
+	start, end int
+}
+
+// newMatcher 构建自动机，空关键词被忽略
+func newMatcher(patterns []string) *matcher {
+	m := &matcher{nodes: []acNode{{next: map[rune]int{}}}}
+	for i, p := range patterns {
+		if p == "" {
+			continue
+		}
+		cur := 0
+		for _, r := range p {
+			r = unicode.ToLower(r)
+			nxt, ok := m.nodes[cur].next[r]
+			if !ok {
+				nxt = len(m.nodes)
+				m.nodes = append(m.nodes, acNode{next: map[rune]int{}})
+				m.nodes[cur].next[r] = nxt
+			}
+			cur = nxt
+		}
+		m.nodes[cur].out = append(m.nodes[cur].out, i)
+	}
+
+	// 按层次遍历设置失败指针
+	queue := make([]int, 0, len(m.nodes))
+	for _, child := range m.nodes[0].next {
+		queue = append(queue, child)
+	}
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		for r, child := range m.nodes[cur].next {
+			f := m.nodes[cur].fail
+			for f != 0 {
+				if _, ok := m.nodes[f].next[r]; ok {
+					break
+				}
+				f = m.nodes[f].fail
+			}
+			if target, ok := m.nodes[f].next[r]; ok && target != child {
+				m.nodes[child].fail = target
+			}
+			m.nodes[child].out = append(m.nodes[child].out, m.nodes[m.nodes[child].fail].out...)
+			queue = append(queue, child)
+		}
+	}
+	return m
+}
+
+// find 返回 text（已按字符切分）中全部关键词出现位置，lengths 为各关键词的字符数
+func (m *matcher) find(text []rune, lengths []int) []hit {
+	var hits []hit
+	cur := 0
+	for i, r := range text {
+		r = unicode.ToLower(r)
+		for cur != 0 {
+			if _, ok := m.nodes[cur].next[r]; ok {
+				break
+			}
+			cur = m.nodes[cur].fail
+		}
+		if nxt, ok := m.nodes[cur].next[r]; ok {
+			cur = nxt
+		}
+		for _, p := range m.nodes[cur].out {
+			hits = append(hits, hit{pattern: p, start: i + 1 - lengths[p], end: i + 1})
+		}
+	}
+	return hits
+}
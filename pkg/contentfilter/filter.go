@@ -0,0 +1,188 @@
+package contentfilter
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// Action 规则命中后的处理方式
+type Action string
+
+const (
+	// ActionMask 将命中的内容替换为 *
+	ActionMask Action = "mask"
+	// ActionReview 允许发布，但提交人工审核
+	ActionReview Action = "review"
+	// ActionReject 拒绝发布
+	ActionReject Action = "reject"
+)
+
+// MaxPatternLength 单条规则的最大字符数
+const MaxPatternLength = 100
+
+// severity 多条规则同时命中时取最严格的处理方式
+var severity = map[Action]int{ActionMask: 1, ActionReview: 2, ActionReject: 3}
+
+// Rule 过滤规则，Regex 为 false 时按关键词（忽略大小写）匹配
+type Rule struct {
+	ID      int64
+	Pattern string
+	Regex   bool
+	Action  Action
+}
+
+// Match 规则命中记录，Text 为该规则第一次命中的原文
+type Match struct {
+	RuleID  int64
+	Pattern string
+	Action  Action
+	Text    string
+}
+
+// Result 检查结果，Action 为空表示未命中任何规则，Masked 为打码后的文本
+type Result struct {
+	Action  Action
+	Matches []Match
+	Masked  string
+}
+
+// Hit 是否命中了任意规则
+func (r Result) Hit() bool {
+	return len(r.Matches) > 0
+}
+
+// Filter 编译后的规则集合，创建后只读，可并发使用
+type Filter struct {
+	keywords []Rule
+	lengths  []int
+	ac       *matcher
+	regexps  []compiledRegexp
+}
+
+type compiledRegexp struct {
+	rule Rule
+	re   *regexp.Regexp
+}
+
+// ValidatePattern 校验规则内容：不能为空或过长，正则须能编译且不能匹配空串
+func ValidatePattern(pattern string, regex bool) error {
+	if strings.TrimSpace(pattern) == "" {
+		return errors.New("规则内容不能为空")
+	}
+	if utf8.RuneCountInString(pattern) > MaxPatternLength {
+		return fmt.Errorf("规则内容不能超过%d个字符", MaxPatternLength)
+	}
+	if !regex {
+		return nil
+	}
+	re, err := regexp.Compile("(?i)" + pattern)
+	if err != nil {
+		return fmt.Errorf("正则表达式无效: %v", err)
+	}
+	if re.MatchString("") {
+		return errors.New("正则表达式不能匹配空字符串")
+	}
+	return nil
+}
+
+// Stricter 返回两种处理方式中更严格的一种，空值表示未命中
+func Stricter(a, b Action) Action {
+	if severity[b] > severity[a] {
+		return b
+	}
+	return a
+}
+
+// ValidAction 是否为支持的处理方式
+func ValidAction(action Action) bool {
+	_, ok := severity[action]
+	return ok
+}
+
+// New 编译规则，任一规则无效时返回错误
+func New(rules []Rule) (*Filter, error) {
+	f := &Filter{}
+	var patterns []string
+	for _, rule := range rules {
+		if !ValidAction(rule.Action) {
+			return nil, fmt.Errorf("规则 %d 的处理方式无效: %q", rule.ID, rule.Action)
+		}
+		if err := ValidatePattern(rule.Pattern, rule.Regex); err != nil {
+			return nil, fmt.Errorf("规则 %d: %w", rule.ID, err)
+		}
+		if rule.Regex {
+			f.regexps = append(f.regexps, compiledRegexp{rule: rule, re: regexp.MustCompile("(?i)" + rule.Pattern)})
+			continue
+		}
+		f.keywords = append(f.keywords, rule)
+		f.lengths = append(f.lengths, utf8.RuneCountInString(rule.Pattern))
+		patterns = append(patterns, rule.Pattern)
+	}
+	f.ac = newMatcher(patterns)
+	return f, nil
+}
+
+// Check 检查文本，返回命中的规则、最严格的处理方式以及按打码规则处理后的文本
+func (f *Filter) Check(text string) Result {
+	res := Result{Masked: text}
+	if f == nil || text == "" {
+		return res
+	}
+
+	runes := []rune(text)
+	mask := make([]bool, len(runes))
+	masked := false
+	seen := make(map[int64]bool)
+	record := func(rule Rule, start, end int) {
+		if rule.Action == ActionMask {
+			for i := start; i < end; i++ {
+				mask[i] = true
+			}
+			masked = true
+		}
+		if seen[rule.ID] {
+			return
+		}
+		seen[rule.ID] = true
+		res.Matches = append(res.Matches, Match{
+			RuleID:  rule.ID,
+			Pattern: rule.Pattern,
+			Action:  rule.Action,
+			Text:    string(runes[start:end]),
+		})
+		res.Action = Stricter(res.Action, rule.Action)
+	}
+
+	for _, h := range f.ac.find(runes, f.lengths) {
+		record(f.keywords[h.pattern], h.start, h.end)
+	}
+
+	if len(f.regexps) > 0 {
+		// 正则返回字节偏移，换算为字符下标
+		runeIndex := make([]int, len(text)+1)
+		n := 0
+		for i := range text {
+			runeIndex[i] = n
+			n++
+		}
+		runeIndex[len(text)] = n
+		for _, cr := range f.regexps {
+			for _, loc := range cr.re.FindAllStringIndex(text, -1) {
+				record(cr.rule, runeIndex[loc[0]], runeIndex[loc[1]])
+			}
+		}
+	}
+
+	if masked {
+		for i := range runes {
+			if mask[i] {
+				runes[i] = '*'
+			}
+		}
+		res.Masked = string(runes)
+	}
+	return res
+}
@@ -0,0 +1,89 @@
+package contentfilter
+
+import "testing"
+
+func TestCheckKeywordsOverlapAndCase(t *testing.T) {
+	f, err := New([]Rule{
+		{ID: 1, Pattern: "he", Action: ActionMask},
+		{ID: 2, Pattern: "she", Action: ActionMask},
+		{ID: 3, Pattern: "hers", Action: ActionMask},
+		{ID: 4, Pattern: "作弊", Action: ActionMask},
+	})
+	if err != nil {
+		t.Fatalf("编译规则失败: %v", err)
+	}
+
+	res := f.Check("uSHErs 考试作弊")
+	if res.Action != ActionMask {
+		t.Fatalf("期望处理方式 mask, 实际 %q", res.Action)
+	}
+	if len(res.Matches) != 4 {
+		t.Fatalf("期望命中 4 条规则, 实际 %d: %+v", len(res.Matches), res.Matches)
+	}
+	if res.Masked != "u***** 考试**" {
+		t.Fatalf("打码结果错误: %q", res.Masked)
+	}
+	for _, m := range res.Matches {
+		if m.RuleID == 3 && m.Text != "HErs" {
+			t.Fatalf("命中原文应保留大小写, 实际 %q", m.Text)
+		}
+	}
+}
+
+func TestCheckTakesStrictestAction(t *testing.T) {
+	f, err := New([]Rule{
+		{ID: 1, Pattern: "广告", Action: ActionMask},
+		{ID: 2, Pattern: `\d{11}`, Regex: true, Action: ActionReview},
+		{ID: 3, Pattern: "代考", Action: ActionReject},
+	})
+	if err != nil {
+		t.Fatalf("编译规则失败: %v", err)
+	}
+
+	res := f.Check("广告：联系13800000000")
+	if res.Action != ActionReview {
+		t.Fatalf("期望处理方式 review, 实际 %q", res.Action)
+	}
+	if res.Masked != "**：联系13800000000" {
+		t.Fatalf("仅 mask 规则应打码, 实际 %q", res.Masked)
+	}
+
+	if res := f.Check("广告 代考 13800000000"); res.Action != ActionReject {
+		t.Fatalf("期望处理方式 reject, 实际 %q", res.Action)
+	}
+	if res := f.Check("正常的课程评论"); res.Hit() || res.Masked != "正常的课程评论" {
+		t.Fatalf("未命中时不应修改文本: %+v", res)
+	}
+}
+
+func TestCheckRegexMasksByRune(t *testing.T) {
+	f, err := New([]Rule{{ID: 1, Pattern: `微信[:：]?\w+`, Regex: true, Action: ActionMask}})
+	if err != nil {
+		t.Fatalf("编译规则失败: %v", err)
+	}
+	res := f.Check("加我微信：abc123 哦")
+	if res.Masked != "加我********* 哦" {
+		t.Fatalf("打码结果错误: %q", res.Masked)
+	}
+	if len(res.Matches) != 1 || res.Matches[0].Text != "微信：abc123" {
+		t.Fatalf("命中记录错误: %+v", res.Matches)
+	}
+}
+
+func TestNewRejectsInvalidRules(t *testing.T) {
+	cases := []Rule{
+		{ID: 1, Pattern: " ", Action: ActionMask},
+		{ID: 2, Pattern: "(", Regex: true, Action: ActionMask},
+		{ID: 3, Pattern: "a*", Regex: true, Action: ActionMask},
+		{ID: 4, Pattern: "abc", Action: "delete"},
+	}
+	for _, rule := range cases {
+		if _, err := New([]Rule{rule}); err == nil {
+			t.Fatalf("规则 %d 应被拒绝", rule.ID)
+		}
+	}
+	var f *Filter
+	if res := f.Check("任意文本"); res.Hit() {
+		t.Fatalf("空过滤器不应命中")
+	}
+}
@@ -15,15 +15,25 @@ const (
 
 // 300xx: 鉴权错误，Auth 打头
 const (
-	AuthInvalidCode             = 30000 + iota // 鉴权失败
-	AuthAccessExpiredCode                      // 访问令牌过期
-	AuthRefreshExpiredCode                     // 刷新令牌过期
-	AuthNoTokenCode                            // 没有 token
-	AuthNoOperatePermissionCode                // 没有操作权限
-	AuthMissingTokenCode                       // 缺少 token
-	IllegalOperatorCode                        // 不合格的操作(比如传入 payment status时传入了一个不存在的 status)
-	TurnstileMissingTokenCode                  // Turnstile 缺少 token
-	TurnstileInvalidTokenCode                  // Turnstile 无效 token
+	AuthInvalidCode              = 30000 + iota // 鉴权失败
+	AuthAccessExpiredCode                       // 访问令牌过期
+	AuthRefreshExpiredCode                      // 刷新令牌过期
+	AuthNoTokenCode                             // 没有 token
+	AuthNoOperatePermissionCode                 // 没有操作权限
+	AuthMissingTokenCode                        // 缺少 token
+	IllegalOperatorCode                         // 不合格的操作(比如传入 payment status时传入了一个不存在的 status)
+	TurnstileMissingTokenCode                   // Turnstile 缺少 token
+	TurnstileInvalidTokenCode                   // Turnstile 无效 token
+	OIDCDisabledCode                            // 未启用统一身份认证
+	OIDCStateInvalidCode                        // OIDC state 无效或已过期
+	OIDCTokenInvalidCode                        // OIDC 令牌校验失败
+	OIDCProviderErrorCode                       // 身份提供方请求失败
+	OIDCAccountNotLinkedCode                    // 外部身份未绑定账户
+	CaptchaUnavailableCode                      // 人机验证服务不可用
+	CaptchaNotSupportedCode                     // 当前人机验证服务商不支持该操作
+	PersonalTokenInvalidCode                    // 个人访问令牌无效或已吊销
+	PersonalTokenExpiredCode                    // 个人访问令牌已过期
+	PersonalTokenScopeDeniedCode                // 个人访问令牌未授权该操作
 
 )
 
@@ -46,6 +56,7 @@ const (
 	QiNiuYunFileErrorCode = 60000 + iota
 	RedisKeyNotExist
 	RepeatedOperation
+	TooManyRequestsCode // 请求过于频繁
 )
 
 // User Module (1000-1099)
@@ -74,6 +85,21 @@ const (
 	UserVerificationCodeExpired
 	UserAccountInactive
 	UserAccountSuspended
+	UserAccountLocked
+	UserLoginTooFrequent
+	UserPasswordTooShort
+	UserPasswordTooLong
+	UserPasswordMissingUpper
+	UserPasswordMissingLower
+	UserPasswordMissingDigit
+	UserPasswordMissingSymbol
+	UserPasswordTooFewClasses
+	UserPasswordContainsUserInfo
+	UserPasswordReused
+	UserPasswordBreached
+	UserEmailInUse
+	UserEmailRevertInvalid
+	UserAccountMuted
 )
 
 // Resource Module (2000-2099)
@@ -108,3 +134,49 @@ const (
 	ValidationReportReasonTooLong
 	ValidationRatingRangeInvalid
 )
+
+// Role Module (5000-5099)
+const (
+	RoleNotFound = 5000 + iota
+	RoleNameExist
+	RoleProtected
+	RoleReassignInvalid
+	ScopedGrantInvalid
+	ScopedGrantExist
+	ScopedGrantNotFound
+)
+
+// Review Module (6000-6099)
+const (
+	ReviewNotFound = 6000 + iota
+	ReviewAlreadyHandled
+	ReviewClaimedByOther
+	ReviewClaimNotHeld
+	ReviewClaimLimitExceeded
+	ReviewNotApproved
+	ModerationActionNotFound
+	AppealNotAllowed
+	AppealExist
+	AppealReviewerConflict
+	ReviewAlreadyReported
+	ModerationActionExist
+)
+
+// Content Filter Module (6100-6199)
+const (
+	ContentFilterRejected = 6100 + iota
+	ContentFilterRuleNotFound
+	ContentFilterRuleExist
+	ContentFilterRuleInvalid
+)
+
+// Shop Module (6200-6299)
+const (
+	ItemNotFound = 6200 + iota
+	ItemNameExist
+	ItemAlreadyOwned
+	ItemNotOwned
+	ItemInUse
+	ReputationInsufficient
+	ItemNotForSale
+)
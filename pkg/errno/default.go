@@ -12,21 +12,49 @@ var (
 	AuthNoToken             = NewErrNo(AuthNoTokenCode, "缺少令牌")
 	AuthNoOperatePermission = NewErrNo(AuthNoOperatePermissionCode, "没有操作权限")
 
+	OIDCDisabledError         = NewErrNo(OIDCDisabledCode, "未启用统一身份认证登录")
+	OIDCStateInvalidError     = NewErrNo(OIDCStateInvalidCode, "登录请求已失效，请重新发起登录")
+	OIDCTokenInvalidError     = NewErrNo(OIDCTokenInvalidCode, "统一身份认证令牌校验失败")
+	OIDCAccountNotLinkedError = NewErrNo(OIDCAccountNotLinkedCode, "该统一身份认证账户尚未绑定平台账户")
+
+	CaptchaUnavailableError  = NewErrNo(CaptchaUnavailableCode, "人机验证服务暂不可用，请稍后重试")
+	CaptchaNotSupportedError = NewErrNo(CaptchaNotSupportedCode, "当前人机验证方式不支持获取图形验证码")
+
+	PersonalTokenInvalidError     = NewErrNo(PersonalTokenInvalidCode, "个人访问令牌无效或已吊销")
+	PersonalTokenExpiredError     = NewErrNo(PersonalTokenExpiredCode, "个人访问令牌已过期")
+	PersonalTokenScopeDeniedError = NewErrNo(PersonalTokenScopeDeniedCode, "个人访问令牌未授权该操作")
+
 	InternalServiceError = NewErrNo(InternalServiceErrorCode, "内部服务错误")
 	OSOperationError     = NewErrNo(OSOperateErrorCode, "操作系统调用失败")
 	IOOperationError     = NewErrNo(IOOperateErrorCode, "输入输出操作失败")
 
-	QiNiuYunFileError = NewErrNo(QiNiuYunFileErrorCode, "七牛云操作失败")
+	QiNiuYunFileError    = NewErrNo(QiNiuYunFileErrorCode, "七牛云操作失败")
+	TooManyRequestsError = NewErrNo(TooManyRequestsCode, "请求过于频繁，请稍后再试")
 
 	//  User Module Errors
-	UserPasswordIncorrectError       = NewErrNo(UserPasswordIncorrect, "密码不正确")
-	UserPasswordFormatInvalidError   = NewErrNo(UserPasswordFormatInvalid, "密码格式不正确，应为8-20位字母和数字组成")
-	UserUsernameFormatInvalidError   = NewErrNo(UserUsernameFormatInvalid, "用户名格式不正确，应为4-16位字母、数字或下划线组成")
-	UserEmailFormatInvalidError      = NewErrNo(UserEmailFormatInvalid, "邮箱格式不正确")
-	UserVerificationCodeInvalidError = NewErrNo(UserVerificationCodeInvalid, "验证码不正确")
-	UserVerificationCodeExpiredError = NewErrNo(UserVerificationCodeExpired, "验证码已过期")
-	UserAccountInactiveError         = NewErrNo(UserAccountInactive, "账户未激活")
-	UserAccountSuspendedError        = NewErrNo(UserAccountSuspended, "账户已被暂停")
+	UserPasswordIncorrectError        = NewErrNo(UserPasswordIncorrect, "密码不正确")
+	UserPasswordFormatInvalidError    = NewErrNo(UserPasswordFormatInvalid, "密码格式不正确，应为8-20位字母和数字组成")
+	UserUsernameFormatInvalidError    = NewErrNo(UserUsernameFormatInvalid, "用户名格式不正确，应为4-16位字母、数字或下划线组成")
+	UserEmailFormatInvalidError       = NewErrNo(UserEmailFormatInvalid, "邮箱格式不正确")
+	UserVerificationCodeInvalidError  = NewErrNo(UserVerificationCodeInvalid, "验证码不正确")
+	UserVerificationCodeExpiredError  = NewErrNo(UserVerificationCodeExpired, "验证码已过期")
+	UserAccountInactiveError          = NewErrNo(UserAccountInactive, "账户未激活")
+	UserAccountSuspendedError         = NewErrNo(UserAccountSuspended, "账户已被暂停")
+	UserAccountLockedError            = NewErrNo(UserAccountLocked, "登录失败次数过多，账户已被临时锁定")
+	UserLoginTooFrequentError         = NewErrNo(UserLoginTooFrequent, "登录失败次数过多，请稍后再试")
+	UserPasswordTooShortError         = NewErrNo(UserPasswordTooShort, "密码长度过短")
+	UserPasswordTooLongError          = NewErrNo(UserPasswordTooLong, "密码长度过长")
+	UserPasswordMissingUpperError     = NewErrNo(UserPasswordMissingUpper, "密码必须包含大写字母")
+	UserPasswordMissingLowerError     = NewErrNo(UserPasswordMissingLower, "密码必须包含小写字母")
+	UserPasswordMissingDigitError     = NewErrNo(UserPasswordMissingDigit, "密码必须包含数字")
+	UserPasswordMissingSymbolError    = NewErrNo(UserPasswordMissingSymbol, "密码必须包含特殊符号")
+	UserPasswordTooFewClassesError    = NewErrNo(UserPasswordTooFewClasses, "密码包含的字符类别过少")
+	UserPasswordContainsUserInfoError = NewErrNo(UserPasswordContainsUserInfo, "密码不能包含用户名或邮箱")
+	UserPasswordReusedError           = NewErrNo(UserPasswordReused, "不能使用最近使用过的密码")
+	UserPasswordBreachedError         = NewErrNo(UserPasswordBreached, "该密码已出现在公开泄露的密码库中，请更换")
+	UserEmailInUseError               = NewErrNo(UserEmailInUse, "该邮箱已被其他账户使用")
+	UserEmailRevertInvalidError       = NewErrNo(UserEmailRevertInvalid, "撤销链接无效或已过期")
+	UserAccountMutedError             = NewErrNo(UserAccountMuted, "账户处于禁言期，暂时不能发布内容")
 
 	// Resource Module Errors
 	ResourceNotFoundError            = NewErrNo(ResourceNotFound, "资源不存在")
@@ -54,4 +82,42 @@ var (
 	ValidationCommentTooLongError      = NewErrNo(ValidationCommentTooLong, "评论内容不能超过1000字符")
 	ValidationReportReasonTooLongError = NewErrNo(ValidationReportReasonTooLong, "举报原因不能超过500字符")
 	ValidationRatingRangeInvalidError  = NewErrNo(ValidationRatingRangeInvalid, "评分必须在0-5之间")
+
+	// Role Module Errors
+	RoleNotFoundError        = NewErrNo(RoleNotFound, "角色不存在")
+	RoleNameExistError       = NewErrNo(RoleNameExist, "角色名称已存在")
+	RoleProtectedError       = NewErrNo(RoleProtected, "内置角色不允许删除")
+	RoleReassignInvalidError = NewErrNo(RoleReassignInvalid, "用户转移的目标角色无效")
+	ScopedGrantInvalidError  = NewErrNo(ScopedGrantInvalid, "该权限不能在此作用域内授予")
+	ScopedGrantExistError    = NewErrNo(ScopedGrantExist, "该用户已拥有此作用域授权")
+	ScopedGrantNotFoundError = NewErrNo(ScopedGrantNotFound, "作用域授权不存在")
+
+	// Review Module Errors
+	ReviewNotFoundError           = NewErrNo(ReviewNotFound, "审核记录不存在")
+	ReviewAlreadyHandledError     = NewErrNo(ReviewAlreadyHandled, "该审核记录已处理")
+	ReviewClaimedByOtherError     = NewErrNo(ReviewClaimedByOther, "该审核记录已被其他审核员认领")
+	ReviewClaimNotHeldError       = NewErrNo(ReviewClaimNotHeld, "未认领该审核记录或认领已过期")
+	ReviewClaimLimitExceededError = NewErrNo(ReviewClaimLimitExceeded, "同时认领的审核记录已达上限")
+	ReviewNotApprovedError        = NewErrNo(ReviewNotApproved, "只能对已确认违规的举报执行处罚")
+	ModerationActionNotFoundError = NewErrNo(ModerationActionNotFound, "处罚记录不存在")
+	AppealNotAllowedError         = NewErrNo(AppealNotAllowed, "该处罚不能申诉")
+	AppealExistError              = NewErrNo(AppealExist, "该处罚已提交过申诉")
+	AppealReviewerConflictError   = NewErrNo(AppealReviewerConflict, "申诉需由原审核员以外的审核员处理")
+	ReviewAlreadyReportedError    = NewErrNo(ReviewAlreadyReported, "你已举报过该内容，请等待审核处理")
+	ModerationActionExistError    = NewErrNo(ModerationActionExist, "该举报已执行过相同的处罚")
+
+	// Content Filter Module Errors
+	ContentFilterRejectedError     = NewErrNo(ContentFilterRejected, "内容包含违规信息，请修改后重新提交")
+	ContentFilterRuleNotFoundError = NewErrNo(ContentFilterRuleNotFound, "过滤规则不存在")
+	ContentFilterRuleExistError    = NewErrNo(ContentFilterRuleExist, "相同的过滤规则已存在")
+	ContentFilterRuleInvalidError  = NewErrNo(ContentFilterRuleInvalid, "过滤规则无效")
+
+	// Shop Module Errors
+	ItemNotFoundError           = NewErrNo(ItemNotFound, "物品不存在")
+	ItemNameExistError          = NewErrNo(ItemNameExist, "物品名称已存在")
+	ItemAlreadyOwnedError       = NewErrNo(ItemAlreadyOwned, "你已拥有该物品")
+	ItemNotOwnedError           = NewErrNo(ItemNotOwned, "你尚未拥有该物品")
+	ItemInUseError              = NewErrNo(ItemInUse, "已有用户拥有该物品，不能删除")
+	ReputationInsufficientError = NewErrNo(ReputationInsufficient, "信誉分不足")
+	ItemNotForSaleError         = NewErrNo(ItemNotForSale, "该勋章需通过达成成就获得")
 )
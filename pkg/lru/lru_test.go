@@ -0,0 +1,70 @@
+package lru
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	c := New[int, string](2)
+	c.Add(1, "a")
+	c.Add(2, "b")
+	// 访问 1 后，2 成为最久未使用的元素
+	if v, ok := c.Get(1); !ok || v != "a" {
+		t.Fatalf("期望命中 1=a, 实际 %q %v", v, ok)
+	}
+	if evicted := c.Add(3, "c"); !evicted {
+		t.Fatalf("容量已满时写入新元素应淘汰旧元素")
+	}
+	if _, ok := c.Get(2); ok {
+		t.Fatalf("最久未使用的 2 应被淘汰")
+	}
+	if _, ok := c.Get(1); !ok {
+		t.Fatalf("最近使用的 1 不应被淘汰")
+	}
+	if c.Len() != 2 {
+		t.Fatalf("期望长度 2, 实际 %d", c.Len())
+	}
+}
+
+func TestCacheUpdateRemovePurge(t *testing.T) {
+	c := New[string, int](4)
+	c.Add("x", 1)
+	if evicted := c.Add("x", 2); evicted {
+		t.Fatalf("更新已有元素不应淘汰")
+	}
+	if v, _ := c.Get("x"); v != 2 {
+		t.Fatalf("期望更新后的值 2, 实际 %d", v)
+	}
+
+	c.Remove("x")
+	if _, ok := c.Get("x"); ok {
+		t.Fatalf("删除后不应命中")
+	}
+
+	c.Add("y", 1)
+	c.Add("z", 2)
+	c.Purge()
+	if c.Len() != 0 {
+		t.Fatalf("清空后长度应为 0, 实际 %d", c.Len())
+	}
+}
+
+func TestCacheConcurrentAccess(t *testing.T) {
+	c := New[int, int](16)
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(n int) {
+			defer wg.Done()
+			for j := 0; j < 1000; j++ {
+				c.Add(j%32, n)
+				c.Get(j % 32)
+			}
+		}(i)
+	}
+	wg.Wait()
+	if c.Len() > 16 {
+		t.Fatalf("元素数量不应超过容量, 实际 %d", c.Len())
+	}
+}
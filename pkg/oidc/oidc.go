@@ -0,0 +1,388 @@
+package oidc
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"LearnShare/pkg/errno"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+// Config 身份提供方与客户端配置
+type Config struct {
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	Scopes       []string
+}
+
+// providerMetadata OIDC Discovery 文档中需要的字段
+type providerMetadata struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// Token 授权码换取的令牌
+type Token struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	IDToken     string `json:"id_token"`
+	ExpiresIn   int64  `json:"expires_in"`
+}
+
+// Claims ID Token 中的声明
+type Claims map[string]interface{}
+
+// Provider 已完成 Discovery 的身份提供方
+type Provider struct {
+	config   Config
+	metadata providerMetadata
+	client   *http.Client
+
+	mu   sync.RWMutex
+	keys map[string]interface{}
+}
+
+var (
+	providerMu sync.Mutex
+	provider   *Provider
+)
+
+// GetProvider 返回与配置匹配的身份提供方，配置变化（如热更新）时重新执行 Discovery
+func GetProvider(ctx context.Context, cfg Config) (*Provider, error) {
+	providerMu.Lock()
+	defer providerMu.Unlock()
+
+	if provider != nil && sameConfig(provider.config, cfg) {
+		return provider, nil
+	}
+	p, err := NewProvider(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	provider = p
+	return provider, nil
+}
+
+func sameConfig(a, b Config) bool {
+	return a.Issuer == b.Issuer && a.ClientID == b.ClientID && a.ClientSecret == b.ClientSecret &&
+		a.RedirectURL == b.RedirectURL && strings.Join(a.Scopes, " ") == strings.Join(b.Scopes, " ")
+}
+
+// NewProvider 通过 Discovery 文档初始化身份提供方
+func NewProvider(ctx context.Context, cfg Config) (*Provider, error) {
+	p := &Provider{
+		config: cfg,
+		client: &http.Client{Timeout: 10 * time.Second},
+	}
+
+	wellKnown := strings.TrimSuffix(cfg.Issuer, "/") + "/.well-known/openid-configuration"
+	if err := p.getJSON(ctx, wellKnown, &p.metadata); err != nil {
+		return nil, err
+	}
+	if strings.TrimSuffix(p.metadata.Issuer, "/") != strings.TrimSuffix(cfg.Issuer, "/") {
+		return nil, errno.NewErrNo(errno.OIDCProviderErrorCode, "Discovery 文档中的 issuer 与配置不一致: "+p.metadata.Issuer)
+	}
+	if p.metadata.AuthorizationEndpoint == "" || p.metadata.TokenEndpoint == "" || p.metadata.JWKSURI == "" {
+		return nil, errno.NewErrNo(errno.OIDCProviderErrorCode, "Discovery 文档缺少必要的端点")
+	}
+	return p, nil
+}
+
+// AuthCodeURL 构造授权码 + PKCE (S256) 的授权地址
+func (p *Provider) AuthCodeURL(state, nonce, codeVerifier string) string {
+	scopes := p.config.Scopes
+	if len(scopes) == 0 {
+		scopes = []string{"openid"}
+	}
+
+	v := url.Values{}
+	v.Set("response_type", "code")
+	v.Set("client_id", p.config.ClientID)
+	v.Set("redirect_uri", p.config.RedirectURL)
+	v.Set("scope", strings.Join(scopes, " "))
+	v.Set("state", state)
+	v.Set("nonce", nonce)
+	v.Set("code_challenge", CodeChallenge(codeVerifier))
+	v.Set("code_challenge_method", "S256")
+
+	sep := "?"
+	if strings.Contains(p.metadata.AuthorizationEndpoint, "?") {
+		sep = "&"
+	}
+	return p.metadata.AuthorizationEndpoint + sep + v.Encode()
+}
+
+// Exchange 使用授权码和 code_verifier 换取令牌
+func (p *Provider) Exchange(ctx context.Context, code, codeVerifier string) (*Token, error) {
+	v := url.Values{}
+	v.Set("grant_type", "authorization_code")
+	v.Set("code", code)
+	v.Set("redirect_uri", p.config.RedirectURL)
+	v.Set("client_id", p.config.ClientID)
+	v.Set("code_verifier", codeVerifier)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.metadata.TokenEndpoint, strings.NewReader(v.Encode()))
+	if err != nil {
+		return nil, errno.NewErrNo(errno.OIDCProviderErrorCode, "构造令牌请求失败: "+err.Error())
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if p.config.ClientSecret != "" {
+		req.SetBasicAuth(url.QueryEscape(p.config.ClientID), url.QueryEscape(p.config.ClientSecret))
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, errno.NewErrNo(errno.OIDCProviderErrorCode, "请求令牌端点失败: "+err.Error())
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, errno.NewErrNo(errno.OIDCProviderErrorCode, "读取令牌响应失败: "+err.Error())
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, errno.NewErrNo(errno.OIDCProviderErrorCode, fmt.Sprintf("令牌端点返回错误: status=%d body=%s", resp.StatusCode, body))
+	}
+
+	var token Token
+	if err := json.Unmarshal(body, &token); err != nil {
+		return nil, errno.NewErrNo(errno.OIDCProviderErrorCode, "解析令牌响应失败: "+err.Error())
+	}
+	if token.IDToken == "" {
+		return nil, errno.NewErrNo(errno.OIDCTokenInvalidCode, "令牌响应中缺少 id_token")
+	}
+	return &token, nil
+}
+
+// VerifyIDToken 校验 ID Token 的签名、issuer、audience、有效期与 nonce
+func (p *Provider) VerifyIDToken(ctx context.Context, rawIDToken, nonce string) (Claims, error) {
+	parser := jwt.NewParser(jwt.WithValidMethods([]string{
+		"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512",
+	}))
+
+	claims := jwt.MapClaims{}
+	_, err := parser.ParseWithClaims(rawIDToken, claims, func(t *jwt.Token) (interface{}, error) {
+		kid, _ := t.Header["kid"].(string)
+		return p.key(ctx, kid)
+	})
+	if err != nil {
+		return nil, errno.OIDCTokenInvalidError.WithError(err)
+	}
+
+	if !claims.VerifyIssuer(p.metadata.Issuer, true) {
+		return nil, errno.OIDCTokenInvalidError.WithMessage("ID Token issuer 不匹配")
+	}
+	if !claims.VerifyAudience(p.config.ClientID, true) {
+		return nil, errno.OIDCTokenInvalidError.WithMessage("ID Token audience 不匹配")
+	}
+	if !claims.VerifyExpiresAt(time.Now().Unix(), true) {
+		return nil, errno.OIDCTokenInvalidError.WithMessage("ID Token 已过期")
+	}
+	if tokenNonce, _ := claims["nonce"].(string); tokenNonce != nonce {
+		return nil, errno.OIDCTokenInvalidError.WithMessage("ID Token nonce 不匹配")
+	}
+	if sub, _ := claims["sub"].(string); sub == "" {
+		return nil, errno.OIDCTokenInvalidError.WithMessage("ID Token 缺少 sub")
+	}
+
+	return Claims(claims), nil
+}
+
+// Issuer 返回身份提供方的 issuer
+func (p *Provider) Issuer() string {
+	return p.metadata.Issuer
+}
+
+// key 按 kid 查找签名公钥，未命中时刷新一次 JWKS（应对密钥轮换）
+func (p *Provider) key(ctx context.Context, kid string) (interface{}, error) {
+	if k := p.lookupKey(kid); k != nil {
+		return k, nil
+	}
+	if err := p.refreshKeys(ctx); err != nil {
+		return nil, err
+	}
+	if k := p.lookupKey(kid); k != nil {
+		return k, nil
+	}
+	return nil, fmt.Errorf("未找到签名公钥: kid=%s", kid)
+}
+
+func (p *Provider) lookupKey(kid string) interface{} {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	if kid != "" {
+		return p.keys[kid]
+	}
+	// 未指定 kid 时仅在只有一个公钥的情况下使用
+	if len(p.keys) == 1 {
+		for _, k := range p.keys {
+			return k
+		}
+	}
+	return nil
+}
+
+// jsonWebKey JWKS 中的单个公钥
+type jsonWebKey struct {
+	Kid string `json:"kid"`
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+func (p *Provider) refreshKeys(ctx context.Context) error {
+	var set struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := p.getJSON(ctx, p.metadata.JWKSURI, &set); err != nil {
+		return err
+	}
+
+	keys := make(map[string]interface{}, len(set.Keys))
+	for _, jwk := range set.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		pub, err := jwk.publicKey()
+		if err != nil {
+			continue
+		}
+		keys[jwk.Kid] = pub
+	}
+
+	p.mu.Lock()
+	p.keys = keys
+	p.mu.Unlock()
+	return nil
+}
+
+func (k jsonWebKey) publicKey() (interface{}, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("不支持的曲线: %s", k.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := base64.RawURLEncoding.DecodeString(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}, nil
+	default:
+		return nil, fmt.Errorf("不支持的密钥类型: %s", k.Kty)
+	}
+}
+
+func (p *Provider) getJSON(ctx context.Context, rawURL string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return errno.NewErrNo(errno.OIDCProviderErrorCode, "构造请求失败: "+err.Error())
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return errno.NewErrNo(errno.OIDCProviderErrorCode, "请求身份提供方失败: "+err.Error())
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return errno.NewErrNo(errno.OIDCProviderErrorCode, fmt.Sprintf("身份提供方返回错误: url=%s status=%d", rawURL, resp.StatusCode))
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(v); err != nil {
+		return errno.NewErrNo(errno.OIDCProviderErrorCode, "解析身份提供方响应失败: "+err.Error())
+	}
+	return nil
+}
+
+// RandomString 生成 URL 安全的随机字符串，用于 state、nonce 与 code_verifier
+func RandomString() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", errno.NewErrNo(errno.InternalServiceErrorCode, "生成随机数失败: "+err.Error())
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// CodeChallenge 计算 PKCE S256 code_challenge
+func CodeChallenge(codeVerifier string) string {
+	sum := sha256.Sum256([]byte(codeVerifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// String 读取字符串声明，不存在时返回空串
+func (c Claims) String(name string) string {
+	if name == "" {
+		return ""
+	}
+	switch v := c[name].(type) {
+	case string:
+		return v
+	case float64:
+		return fmt.Sprintf("%.0f", v)
+	}
+	return ""
+}
+
+// Bool 读取布尔声明，兼容部分身份提供方以字符串形式下发
+func (c Claims) Bool(name string) bool {
+	if name == "" {
+		return false
+	}
+	switch v := c[name].(type) {
+	case bool:
+		return v
+	case string:
+		return v == "true"
+	}
+	return false
+}
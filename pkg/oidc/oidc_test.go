@@ -0,0 +1,194 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+// mockIdP 本地模拟的身份提供方
+type mockIdP struct {
+	server        *httptest.Server
+	key           *rsa.PrivateKey
+	kid           string
+	codeChallenge string
+	claims        jwt.MapClaims
+}
+
+func newMockIdP(t *testing.T) *mockIdP {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("生成 RSA 密钥失败: %v", err)
+	}
+	m := &mockIdP{key: key, kid: "test-key"}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 m.server.URL,
+			"authorization_endpoint": m.server.URL + "/authorize",
+			"token_endpoint":         m.server.URL + "/token",
+			"jwks_uri":               m.server.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"keys": []map[string]string{{
+				"kid": m.kid,
+				"kty": "RSA",
+				"use": "sig",
+				"n":   base64.RawURLEncoding.EncodeToString(m.key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(m.key.E)).Bytes()),
+			}},
+		})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+		if r.PostForm.Get("code") != "valid-code" || CodeChallenge(r.PostForm.Get("code_verifier")) != m.codeChallenge {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":"invalid_grant"}`))
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"access_token": "access",
+			"token_type":   "Bearer",
+			"id_token":     m.sign(t, m.claims),
+			"expires_in":   3600,
+		})
+	})
+	m.server = httptest.NewServer(mux)
+	t.Cleanup(m.server.Close)
+	return m
+}
+
+func (m *mockIdP) sign(t *testing.T, claims jwt.MapClaims) string {
+	t.Helper()
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = m.kid
+	raw, err := token.SignedString(m.key)
+	if err != nil {
+		t.Fatalf("签名 ID Token 失败: %v", err)
+	}
+	return raw
+}
+
+func (m *mockIdP) defaultClaims(nonce string) jwt.MapClaims {
+	return jwt.MapClaims{
+		"iss":            m.server.URL,
+		"aud":            "learnshare",
+		"sub":            "20230001",
+		"exp":            time.Now().Add(time.Hour).Unix(),
+		"iat":            time.Now().Unix(),
+		"nonce":          nonce,
+		"email":          "student@example.edu.cn",
+		"email_verified": true,
+		"college":        "计算机学院",
+	}
+}
+
+func newTestProvider(t *testing.T, m *mockIdP) *Provider {
+	t.Helper()
+	p, err := NewProvider(context.Background(), Config{
+		Issuer:      m.server.URL,
+		ClientID:    "learnshare",
+		RedirectURL: "http://localhost:8081/sso/callback",
+		Scopes:      []string{"openid", "email"},
+	})
+	if err != nil {
+		t.Fatalf("初始化身份提供方失败: %v", err)
+	}
+	return p
+}
+
+func TestAuthorizationCodeFlow(t *testing.T) {
+	m := newMockIdP(t)
+	p := newTestProvider(t, m)
+
+	verifier, _ := RandomString()
+	authURL, err := url.Parse(p.AuthCodeURL("state-1", "nonce-1", verifier))
+	if err != nil {
+		t.Fatalf("解析授权地址失败: %v", err)
+	}
+	q := authURL.Query()
+	if q.Get("code_challenge_method") != "S256" || q.Get("state") != "state-1" || q.Get("nonce") != "nonce-1" {
+		t.Fatalf("授权地址参数不正确: %s", authURL)
+	}
+	m.codeChallenge = q.Get("code_challenge")
+	m.claims = m.defaultClaims("nonce-1")
+
+	token, err := p.Exchange(context.Background(), "valid-code", verifier)
+	if err != nil {
+		t.Fatalf("换取令牌失败: %v", err)
+	}
+
+	claims, err := p.VerifyIDToken(context.Background(), token.IDToken, "nonce-1")
+	if err != nil {
+		t.Fatalf("校验 ID Token 失败: %v", err)
+	}
+	if claims.String("sub") != "20230001" || !claims.Bool("email_verified") || claims.String("college") != "计算机学院" {
+		t.Fatalf("声明解析不正确: %v", claims)
+	}
+}
+
+func TestExchangeRejectsWrongVerifier(t *testing.T) {
+	m := newMockIdP(t)
+	p := newTestProvider(t, m)
+
+	verifier, _ := RandomString()
+	m.codeChallenge = CodeChallenge(verifier)
+	m.claims = m.defaultClaims("nonce-1")
+
+	if _, err := p.Exchange(context.Background(), "valid-code", "wrong-verifier"); err == nil {
+		t.Fatalf("错误的 code_verifier 应当换取失败")
+	}
+}
+
+func TestVerifyIDTokenRejectsInvalidClaims(t *testing.T) {
+	m := newMockIdP(t)
+	p := newTestProvider(t, m)
+
+	cases := map[string]func(jwt.MapClaims){
+		"nonce 不匹配":    func(c jwt.MapClaims) { c["nonce"] = "other" },
+		"audience 不匹配": func(c jwt.MapClaims) { c["aud"] = "other-client" },
+		"issuer 不匹配":   func(c jwt.MapClaims) { c["iss"] = "https://evil.example.com" },
+		"已过期":          func(c jwt.MapClaims) { c["exp"] = time.Now().Add(-time.Minute).Unix() },
+		"缺少 sub":       func(c jwt.MapClaims) { delete(c, "sub") },
+	}
+	for name, mutate := range cases {
+		t.Run(name, func(t *testing.T) {
+			claims := m.defaultClaims("nonce-1")
+			mutate(claims)
+			if _, err := p.VerifyIDToken(context.Background(), m.sign(t, claims), "nonce-1"); err == nil {
+				t.Fatalf("期望校验失败")
+			}
+		})
+	}
+}
+
+func TestVerifyIDTokenRejectsUnknownKey(t *testing.T) {
+	m := newMockIdP(t)
+	p := newTestProvider(t, m)
+
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("生成 RSA 密钥失败: %v", err)
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, m.defaultClaims("nonce-1"))
+	token.Header["kid"] = m.kid
+	raw, _ := token.SignedString(otherKey)
+
+	if _, err := p.VerifyIDToken(context.Background(), raw, "nonce-1"); err == nil {
+		t.Fatalf("非身份提供方签名的令牌应当校验失败")
+	}
+}
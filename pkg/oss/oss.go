@@ -198,7 +198,7 @@ func getQiniuZone(region string) *storage.Region {
 // UploadFile 接收 multipart 文件并完成校验、存储和上传
 func UploadFile(data *multipart.FileHeader, class string, targetId int64) (string, error) {
 	var allowedTypes []string
-	if class == "avatar" {
+	if class == "avatar" || class == "item" {
 		allowedTypes = []string{"image/jpeg", "image/png", "image/gif", "image/webp"}
 	} else if class == "resource" {
 		allowedTypes = []string{
@@ -269,6 +269,11 @@ func DeleteByURL(fileURL string) error {
 
 	bm := storage.NewBucketManager(mac, &cfg)
 	if err := bm.Delete(config.Oss.BucketName, key); err != nil {
+		// 612 表示文件已不存在，视为删除成功，便于清理任务重试
+		var info *storage.ErrorInfo
+		if errors.As(err, &info) && info.HttpCode() == 612 {
+			return nil
+		}
 		return errno.NewErrNo(errno.QiNiuYunFileErrorCode, fmt.Sprintf("删除失败: %v", err))
 	}
 	return nil
@@ -0,0 +1,59 @@
+package password
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"embed"
+	"encoding/hex"
+	"errors"
+	"io/fs"
+	"os"
+	"strings"
+)
+
+// bundled 随代码发布的常见泄露密码列表
+//
+//go:embed breached/*.txt
+var bundled embed.FS
+
+// BreachChecker 基于 k-匿名前缀文件的泄露密码检查
+// 文件格式与 Have I Been Pwned 的 range 接口一致：对密码做 SHA-1 后取前 5 位十六进制作为文件名（<PREFIX>.txt），
+// 文件每行为 "<剩余 35 位后缀>:<出现次数>"，因此可直接使用 HIBP 官方下载工具导出的目录替换内置列表
+type BreachChecker struct {
+	fsys fs.FS
+}
+
+// NewBreachChecker dir 为空时使用内置列表，否则读取 dir 下的前缀文件
+func NewBreachChecker(dir string) *BreachChecker {
+	if dir == "" {
+		sub, _ := fs.Sub(bundled, "breached")
+		return &BreachChecker{fsys: sub}
+	}
+	return &BreachChecker{fsys: os.DirFS(dir)}
+}
+
+// IsBreached 判断密码是否出现在泄露列表中
+func (b *BreachChecker) IsBreached(pwd string) (bool, error) {
+	sum := sha1.Sum([]byte(pwd))
+	hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+	prefix, suffix := hash[:5], hash[5:]
+
+	f, err := b.fsys.Open(prefix + ".txt")
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return false, nil
+		}
+		return false, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		candidate, _, _ := strings.Cut(line, ":")
+		if strings.EqualFold(candidate, suffix) {
+			return true, nil
+		}
+	}
+	return false, scanner.Err()
+}
@@ -0,0 +1,100 @@
+package password
+
+import (
+	"LearnShare/pkg/errno"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestPolicyValidate(t *testing.T) {
+	policy := Policy{
+		MinLength:      8,
+		MaxLength:      20,
+		RequireDigit:   true,
+		MinClasses:     2,
+		ForbidUserInfo: true,
+	}
+
+	cases := []struct {
+		name     string
+		password string
+		code     int64
+	}{
+		{"过短", "a1b2c3", errno.UserPasswordTooShort},
+		{"过长", strings.Repeat("a1", 11), errno.UserPasswordTooLong},
+		{"缺少数字", "abcdefghij", errno.UserPasswordMissingDigit},
+		{"类别过少", "1234567890", errno.UserPasswordTooFewClasses},
+		{"包含用户名", "xAliceX2024", errno.UserPasswordContainsUserInfo},
+		{"包含邮箱前缀", "bob.s99zz", errno.UserPasswordContainsUserInfo},
+		{"包含空白", "abc 12345", errno.UserPasswordFormatInvalid},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := policy.Validate(c.password, "alice", "bob.s@example.com")
+			var e errno.ErrNo
+			if !errors.As(err, &e) || e.ErrorCode != c.code {
+				t.Fatalf("期望错误码 %d, 实际为 %v", c.code, err)
+			}
+		})
+	}
+
+	if err := policy.Validate("Learn2Share!", "alice", "bob.s@example.com"); err != nil {
+		t.Fatalf("合规密码不应报错: %v", err)
+	}
+}
+
+func TestPolicyCharacterClasses(t *testing.T) {
+	policy := Policy{RequireUpper: true, RequireLower: true, RequireSymbol: true}
+
+	cases := map[string]int64{
+		"lower123!": errno.UserPasswordMissingUpper,
+		"UPPER123!": errno.UserPasswordMissingLower,
+		"Mixed1234": errno.UserPasswordMissingSymbol,
+	}
+	for pwd, code := range cases {
+		var e errno.ErrNo
+		if err := policy.Validate(pwd); !errors.As(err, &e) || e.ErrorCode != code {
+			t.Fatalf("%s: 期望错误码 %d, 实际为 %v", pwd, code, err)
+		}
+	}
+}
+
+func TestBundledBreachList(t *testing.T) {
+	checker := NewBreachChecker("")
+
+	for _, pwd := range []string{"password1", "qwerty123", "woaini1314"} {
+		breached, err := checker.IsBreached(pwd)
+		if err != nil || !breached {
+			t.Fatalf("%s 应当命中内置泄露列表: breached=%v err=%v", pwd, breached, err)
+		}
+	}
+
+	breached, err := checker.IsBreached("Learn2Share!fzu")
+	if err != nil || breached {
+		t.Fatalf("非常见密码不应命中: breached=%v err=%v", breached, err)
+	}
+}
+
+func TestBreachCheckerDir(t *testing.T) {
+	dir := t.TempDir()
+	sum := sha1.Sum([]byte("custom-leaked"))
+	hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+	// HIBP 导出的后缀可能为小写，检查时不区分大小写
+	content := "0000000000000000000000000000000000A:3\r\n" + strings.ToLower(hash[5:]) + ":42\r\n"
+	if err := os.WriteFile(filepath.Join(dir, hash[:5]+".txt"), []byte(content), 0o644); err != nil {
+		t.Fatalf("写入前缀文件失败: %v", err)
+	}
+
+	checker := NewBreachChecker(dir)
+	if breached, err := checker.IsBreached("custom-leaked"); err != nil || !breached {
+		t.Fatalf("应当命中自定义泄露列表: breached=%v err=%v", breached, err)
+	}
+	if breached, err := checker.IsBreached("password1"); err != nil || breached {
+		t.Fatalf("自定义目录不应包含内置列表: breached=%v err=%v", breached, err)
+	}
+}
@@ -0,0 +1,91 @@
+package password
+
+import (
+	"LearnShare/pkg/errno"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Policy 密码策略
+type Policy struct {
+	MinLength      int
+	MaxLength      int
+	RequireUpper   bool
+	RequireLower   bool
+	RequireDigit   bool
+	RequireSymbol  bool
+	MinClasses     int  // 大写、小写、数字、符号四类中至少包含的类别数
+	ForbidUserInfo bool // 禁止包含用户名或邮箱前缀
+}
+
+// minUserInfoLength 用户信息短于该长度时不做包含检查，避免误伤
+const minUserInfoLength = 3
+
+// Validate 按策略逐条校验密码，返回第一条不满足规则对应的错误
+// userInfo 为用户名、邮箱等不允许出现在密码中的信息，邮箱只取 @ 之前的部分
+func (p Policy) Validate(pwd string, userInfo ...string) error {
+	length := utf8.RuneCountInString(pwd)
+	if p.MinLength > 0 && length < p.MinLength {
+		return errno.UserPasswordTooShortError.WithMessage(fmt.Sprintf("密码长度不能少于 %d 位", p.MinLength))
+	}
+	if p.MaxLength > 0 && length > p.MaxLength {
+		return errno.UserPasswordTooLongError.WithMessage(fmt.Sprintf("密码长度不能超过 %d 位", p.MaxLength))
+	}
+
+	var hasUpper, hasLower, hasDigit, hasSymbol bool
+	for _, r := range pwd {
+		switch {
+		case unicode.IsUpper(r):
+			hasUpper = true
+		case unicode.IsLower(r):
+			hasLower = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		case unicode.IsSpace(r):
+			return errno.UserPasswordFormatInvalidError.WithMessage("密码不能包含空白字符")
+		default:
+			hasSymbol = true
+		}
+	}
+	if p.RequireUpper && !hasUpper {
+		return errno.UserPasswordMissingUpperError
+	}
+	if p.RequireLower && !hasLower {
+		return errno.UserPasswordMissingLowerError
+	}
+	if p.RequireDigit && !hasDigit {
+		return errno.UserPasswordMissingDigitError
+	}
+	if p.RequireSymbol && !hasSymbol {
+		return errno.UserPasswordMissingSymbolError
+	}
+	if p.MinClasses > 0 && countTrue(hasUpper, hasLower, hasDigit, hasSymbol) < p.MinClasses {
+		return errno.UserPasswordTooFewClassesError.WithMessage(fmt.Sprintf("密码需包含大写字母、小写字母、数字、符号中的至少 %d 类", p.MinClasses))
+	}
+
+	if p.ForbidUserInfo {
+		lower := strings.ToLower(pwd)
+		for _, info := range userInfo {
+			if at := strings.Index(info, "@"); at >= 0 {
+				info = info[:at]
+			}
+			info = strings.ToLower(info)
+			if utf8.RuneCountInString(info) >= minUserInfoLength && strings.Contains(lower, info) {
+				return errno.UserPasswordContainsUserInfoError
+			}
+		}
+	}
+	return nil
+}
+
+func countTrue(values ...bool) int {
+	n := 0
+	for _, v := range values {
+		if v {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,264 @@
+package policy
+
+import (
+	"LearnShare/pkg/constants"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/viper"
+)
+
+// DefaultPath 路由策略文件的默认位置
+const DefaultPath = "./config/route_policy.yaml"
+
+// 认证方式
+const (
+	AuthNone   = "none"   // 无需登录
+	AuthAccess = "access" // 仅接受登录态的 access-token
+	AuthToken  = "token"  // 接受 access-token 或个人访问令牌
+)
+
+// AnyMethod 匹配任意请求方法
+const AnyMethod = "*"
+
+// Rule 单条路由策略
+type Rule struct {
+	Method string `mapstructure:"method"`
+	// Path 与路由注册时的路径模式一致，如 /api/resources/:resource_id；以 /* 结尾时匹配该前缀下的全部路由
+	Path string `mapstructure:"path"`
+	Auth string `mapstructure:"auth"`
+	// TokenScope 使用个人访问令牌时要求其授权范围包含该项，仅 auth 为 token 时有效
+	TokenScope string `mapstructure:"token_scope"`
+	// Active 要求账户已完成注册激活
+	Active bool `mapstructure:"active"`
+	// Permissions 需要拥有其中任一全局权限
+	Permissions []string `mapstructure:"permissions"`
+	// Scoped 需要拥有全局权限，或在路径参数所指对象所属的作用域内被授予该权限
+	Scoped *ScopedRule `mapstructure:"scoped"`
+}
+
+// ScopedRule 作用域权限要求
+type ScopedRule struct {
+	Permission string `mapstructure:"permission"`
+	// Source 为 constants.ScopeSource* 常量，说明 Param 指向的对象类型
+	Source string `mapstructure:"source"`
+	Param  string `mapstructure:"param"`
+}
+
+// Route 已注册的路由
+type Route struct {
+	Method string
+	Path   string
+}
+
+// Policy 路由策略表
+type Policy struct {
+	exact  map[string]*Rule
+	prefix []*Rule
+	rules  []*Rule
+}
+
+type file struct {
+	Routes []*Rule `mapstructure:"routes"`
+}
+
+var (
+	validMethods = map[string]bool{
+		"GET": true, "POST": true, "PUT": true, "DELETE": true, "PATCH": true, "HEAD": true, "OPTIONS": true, AnyMethod: true,
+	}
+	validSources = map[string]bool{
+		constants.ScopeSourceCourse:          true,
+		constants.ScopeSourceCourseComment:   true,
+		constants.ScopeSourceCourseRating:    true,
+		constants.ScopeSourceResource:        true,
+		constants.ScopeSourceResourceComment: true,
+		constants.ScopeSourceResourceRating:  true,
+	}
+)
+
+// Load 读取并校验路由策略文件
+func Load(path string) (*Policy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("读取路由策略文件失败: %w", err)
+	}
+	return Parse(data)
+}
+
+// Parse 解析 YAML 格式的路由策略
+func Parse(data []byte) (*Policy, error) {
+	v := viper.New()
+	v.SetConfigType("yaml")
+	if err := v.ReadConfig(bytes.NewReader(data)); err != nil {
+		return nil, fmt.Errorf("解析路由策略失败: %w", err)
+	}
+
+	var f file
+	if err := v.Unmarshal(&f); err != nil {
+		return nil, fmt.Errorf("解析路由策略失败: %w", err)
+	}
+
+	p := &Policy{exact: make(map[string]*Rule, len(f.Routes))}
+	for i, rule := range f.Routes {
+		if rule == nil {
+			return nil, fmt.Errorf("第 %d 条路由策略为空", i+1)
+		}
+		rule.Method = strings.ToUpper(strings.TrimSpace(rule.Method))
+		rule.Auth = strings.TrimSpace(rule.Auth)
+		if err := rule.validate(); err != nil {
+			return nil, fmt.Errorf("第 %d 条路由策略 %s %s 无效: %w", i+1, rule.Method, rule.Path, err)
+		}
+
+		key := rule.key()
+		if _, ok := p.exact[key]; ok {
+			return nil, fmt.Errorf("路由策略重复: %s", key)
+		}
+		p.exact[key] = rule
+		if rule.isPrefix() {
+			p.prefix = append(p.prefix, rule)
+		}
+		p.rules = append(p.rules, rule)
+	}
+
+	// 前缀规则按前缀长度倒序，保证最长前缀优先匹配
+	sort.SliceStable(p.prefix, func(i, j int) bool {
+		return len(p.prefix[i].Path) > len(p.prefix[j].Path)
+	})
+	return p, nil
+}
+
+func (r *Rule) validate() error {
+	if !validMethods[r.Method] {
+		return fmt.Errorf("未知的请求方法 %q", r.Method)
+	}
+	if !strings.HasPrefix(r.Path, "/") {
+		return fmt.Errorf("路径必须以 / 开头")
+	}
+
+	switch r.Auth {
+	case AuthNone:
+		if r.Active || len(r.Permissions) > 0 || r.Scoped != nil {
+			return fmt.Errorf("无需登录的路由不能要求激活或权限")
+		}
+	case AuthAccess, AuthToken:
+	default:
+		return fmt.Errorf("未知的认证方式 %q", r.Auth)
+	}
+
+	if r.TokenScope != "" && r.Auth != AuthToken {
+		return fmt.Errorf("token_scope 仅在 auth 为 token 时有效")
+	}
+	if r.Scoped != nil {
+		if r.Scoped.Permission == "" || r.Scoped.Param == "" {
+			return fmt.Errorf("作用域权限需要指定 permission 与 param")
+		}
+		if !validSources[r.Scoped.Source] {
+			return fmt.Errorf("未知的作用域来源 %q", r.Scoped.Source)
+		}
+		if !strings.Contains(r.Path, ":"+r.Scoped.Param) {
+			return fmt.Errorf("路径中不存在参数 %s", r.Scoped.Param)
+		}
+	}
+	return nil
+}
+
+func (r *Rule) key() string {
+	return r.Method + " " + r.Path
+}
+
+func (r *Rule) isPrefix() bool {
+	return strings.HasSuffix(r.Path, "/*")
+}
+
+func (r *Rule) matchPrefix(method, path string) bool {
+	if r.Method != AnyMethod && r.Method != method {
+		return false
+	}
+	prefix := strings.TrimSuffix(r.Path, "*")
+	return strings.HasPrefix(path, prefix) || path == strings.TrimSuffix(prefix, "/")
+}
+
+// Match 查找路由对应的策略：先精确匹配方法与路径，再匹配任意方法，最后按最长前缀匹配；未找到时返回 nil
+func (p *Policy) Match(method, path string) *Rule {
+	if rule, ok := p.exact[method+" "+path]; ok {
+		return rule
+	}
+	if rule, ok := p.exact[AnyMethod+" "+path]; ok {
+		return rule
+	}
+	for _, rule := range p.prefix {
+		if rule.matchPrefix(method, path) {
+			return rule
+		}
+	}
+	return nil
+}
+
+// Verify 核对已注册路由与策略：missing 为没有策略的路由，unused 为未匹配任何路由的策略
+func (p *Policy) Verify(routes []Route) (missing []Route, unused []string) {
+	used := make(map[*Rule]bool, len(p.rules))
+	for _, route := range routes {
+		rule := p.Match(route.Method, route.Path)
+		if rule == nil {
+			missing = append(missing, route)
+			continue
+		}
+		used[rule] = true
+	}
+	for _, rule := range p.rules {
+		if !used[rule] {
+			unused = append(unused, rule.key())
+		}
+	}
+	return missing, unused
+}
+
+// WriteMatrix 输出路由与认证、权限要求的对照表
+func (p *Policy) WriteMatrix(w io.Writer, routes []Route) error {
+	sorted := make([]Route, len(routes))
+	copy(sorted, routes)
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Path != sorted[j].Path {
+			return sorted[i].Path < sorted[j].Path
+		}
+		return sorted[i].Method < sorted[j].Method
+	})
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "METHOD\tPATH\tAUTH\tTOKEN_SCOPE\tACTIVE\tPERMISSIONS\tSCOPED")
+	for _, route := range sorted {
+		rule := p.Match(route.Method, route.Path)
+		if rule == nil {
+			fmt.Fprintf(tw, "%s\t%s\tMISSING\t-\t-\t-\t-\n", route.Method, route.Path)
+			continue
+		}
+
+		active := "-"
+		if rule.Active {
+			active = "yes"
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			route.Method, route.Path, rule.Auth, orDash(rule.TokenScope), active,
+			orDash(strings.Join(rule.Permissions, "|")), orDash(rule.Scoped.String()))
+	}
+	return tw.Flush()
+}
+
+func (s *ScopedRule) String() string {
+	if s == nil {
+		return ""
+	}
+	return fmt.Sprintf("%s(%s:%s)", s.Permission, s.Source, s.Param)
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
@@ -0,0 +1,128 @@
+package policy
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+const testPolicy = `
+routes:
+  - { method: GET, path: /ping, auth: none }
+  - { method: "*", path: /debug/pprof/*, auth: none }
+  - { method: POST, path: /api/resources, auth: token, token_scope: resource.upload, active: true }
+  - { method: GET, path: "/api/admin/roles", auth: token, permissions: [role.manage] }
+  - method: DELETE
+    path: "/api/admin/resource_comments/:comment_id"
+    auth: token
+    scoped: { permission: resource.comment.moderate, source: resource_comment, param: comment_id }
+  - { method: get, path: /stale, auth: access }
+`
+
+func TestParseAndMatch(t *testing.T) {
+	p, err := Parse([]byte(testPolicy))
+	if err != nil {
+		t.Fatalf("解析失败: %v", err)
+	}
+
+	rule := p.Match("POST", "/api/resources")
+	if rule == nil || rule.Auth != AuthToken || rule.TokenScope != "resource.upload" || !rule.Active {
+		t.Fatalf("上传接口策略不符合预期: %+v", rule)
+	}
+
+	rule = p.Match("GET", "/api/admin/roles")
+	if rule == nil || len(rule.Permissions) != 1 || rule.Permissions[0] != "role.manage" {
+		t.Fatalf("角色列表策略不符合预期: %+v", rule)
+	}
+
+	rule = p.Match("DELETE", "/api/admin/resource_comments/:comment_id")
+	if rule == nil || rule.Scoped == nil || rule.Scoped.Param != "comment_id" {
+		t.Fatalf("作用域策略不符合预期: %+v", rule)
+	}
+
+	for _, path := range []string{"/debug/pprof/", "/debug/pprof/heap", "/debug/pprof"} {
+		if rule := p.Match("GET", path); rule == nil || rule.Auth != AuthNone {
+			t.Fatalf("%s: 前缀策略未生效: %+v", path, rule)
+		}
+	}
+
+	if rule := p.Match("POST", "/ping"); rule != nil {
+		t.Fatalf("方法不同不应匹配: %+v", rule)
+	}
+	if rule := p.Match("GET", "/debug/pprofx"); rule != nil {
+		t.Fatalf("前缀不应跨越路径段匹配: %+v", rule)
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	cases := map[string]string{
+		"未知认证方式":  `routes: [{ method: GET, path: /a, auth: jwt }]`,
+		"未知请求方法":  `routes: [{ method: FETCH, path: /a, auth: none }]`,
+		"路径格式错误":  `routes: [{ method: GET, path: a, auth: none }]`,
+		"匿名要求权限":  `routes: [{ method: GET, path: /a, auth: none, permissions: [role.manage] }]`,
+		"授权范围非令牌": `routes: [{ method: GET, path: /a, auth: access, token_scope: resource.upload }]`,
+		"作用域来源未知": `routes: [{ method: DELETE, path: "/a/:id", auth: token, scoped: { permission: p, source: x, param: id } }]`,
+		"作用域参数缺失": `routes: [{ method: DELETE, path: /a, auth: token, scoped: { permission: p, source: course, param: id } }]`,
+		"策略重复": `
+routes:
+  - { method: GET, path: /a, auth: none }
+  - { method: get, path: /a, auth: access }
+`,
+	}
+
+	for name, data := range cases {
+		if _, err := Parse([]byte(data)); err == nil {
+			t.Errorf("%s: 预期解析失败", name)
+		}
+	}
+}
+
+func TestVerify(t *testing.T) {
+	p, err := Parse([]byte(testPolicy))
+	if err != nil {
+		t.Fatalf("解析失败: %v", err)
+	}
+
+	routes := []Route{
+		{Method: "GET", Path: "/ping"},
+		{Method: "GET", Path: "/debug/pprof/heap"},
+		{Method: "POST", Path: "/api/resources"},
+		{Method: "GET", Path: "/api/admin/roles"},
+		{Method: "DELETE", Path: "/api/admin/resource_comments/:comment_id"},
+		{Method: "PUT", Path: "/api/users/avatar"},
+	}
+	missing, unused := p.Verify(routes)
+	if len(missing) != 1 || missing[0].Path != "/api/users/avatar" {
+		t.Fatalf("缺少策略的路由不符合预期: %v", missing)
+	}
+	if len(unused) != 1 || unused[0] != "GET /stale" {
+		t.Fatalf("未使用的策略不符合预期: %v", unused)
+	}
+}
+
+func TestWriteMatrix(t *testing.T) {
+	p, err := Parse([]byte(testPolicy))
+	if err != nil {
+		t.Fatalf("解析失败: %v", err)
+	}
+
+	var buf bytes.Buffer
+	err = p.WriteMatrix(&buf, []Route{
+		{Method: "POST", Path: "/api/resources"},
+		{Method: "PUT", Path: "/api/users/avatar"},
+	})
+	if err != nil {
+		t.Fatalf("输出失败: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("预期 3 行，实际 %d 行:\n%s", len(lines), buf.String())
+	}
+	if !strings.Contains(lines[1], "resource.upload") || !strings.Contains(lines[1], "yes") {
+		t.Errorf("上传接口行不符合预期: %s", lines[1])
+	}
+	if !strings.Contains(lines[2], "MISSING") {
+		t.Errorf("缺少策略的路由应标记为 MISSING: %s", lines[2])
+	}
+}
@@ -5,9 +5,11 @@ import (
 	"crypto/rand"
 	"crypto/tls"
 	"fmt"
+	"html"
 	"math/big"
 	"net/smtp"
 	"strconv"
+	"time"
 
 	"LearnShare/config"
 
@@ -16,6 +18,21 @@ import (
 
 // MailSendCode 发送验证码邮件到指定地址。优先使用 config.Smtp 配置，若未初始化则回退到环境变量。
 func MailSendCode(to string, code string) error {
+	return MailSend(to, "验证码", fmt.Sprintf("你的验证码为：<h1>%s</h1><p>有效期请以系统设置为准。</p>", code))
+}
+
+// MailSendEmailChangeNotice 通知旧邮箱账户邮箱已被修改，并附带撤销链接
+func MailSendEmailChangeNotice(to, newEmail, revertURL string, ttl time.Duration) error {
+	return MailSend(to, "账户邮箱已修改", fmt.Sprintf(
+		"<p>你的账户绑定邮箱已修改为 <b>%s</b>。</p>"+
+			"<p>如果这不是你本人的操作，请在 %d 小时内点击以下链接撤销修改，撤销后所有已登录的设备将被强制下线：</p>"+
+			"<p><a href=\"%s\">%s</a></p>",
+		html.EscapeString(newEmail), int(ttl.Hours()), html.EscapeString(revertURL), html.EscapeString(revertURL),
+	))
+}
+
+// MailSend 发送 HTML 邮件
+func MailSend(to, subject, body string) error {
 	if to == "" {
 		return errno.NewErrNo(errno.InternalServiceErrorCode, "收件人邮箱为空")
 	}
@@ -40,8 +57,8 @@ func MailSendCode(to string, code string) error {
 		e.From = from
 	}
 	e.To = []string{to}
-	e.Subject = "验证码"
-	e.HTML = []byte(fmt.Sprintf("你的验证码为：<h1>%s</h1><p>有效期请以系统设置为准。</p>", code))
+	e.Subject = subject
+	e.HTML = []byte(body)
 
 	auth := smtp.PlainAuth("", user, pass, host)
 
@@ -51,7 +68,7 @@ func MailSendCode(to string, code string) error {
 	}
 
 	if err := e.Send(addr, auth); err != nil {
-		return errno.NewErrNo(errno.InternalServiceErrorCode, "MailSendCode: 无法发送邮件，请检查 SMTP 配置"+err.Error())
+		return errno.NewErrNo(errno.InternalServiceErrorCode, "MailSend: 无法发送邮件，请检查 SMTP 配置"+err.Error())
 	}
 	return nil
 }
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"LearnShare/biz/router/auth"
+	"LearnShare/pkg/logger"
+	"LearnShare/pkg/policy"
+
+	"github.com/cloudwego/hertz/pkg/app/server"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/hertz-contrib/pprof"
+)
+
+// loadRoutePolicy 加载路由策略并注册为全局中间件，需在注册路由之前调用
+func loadRoutePolicy(h *server.Hertz) *policy.Policy {
+	p, err := policy.Load(policy.DefaultPath)
+	if err != nil {
+		logger.Fatalf("路由策略加载失败: %v", err)
+	}
+	h.Use(auth.RoutePolicy(p))
+	return p
+}
+
+// checkRoutePolicy 启动检查：每个已注册的路由都必须有显式策略
+func checkRoutePolicy(h *server.Hertz, p *policy.Policy) {
+	missing, unused := p.Verify(registeredRoutes(h))
+	for _, key := range unused {
+		logger.Warnf("路由策略未匹配任何路由: %s", key)
+	}
+	if len(missing) > 0 {
+		names := make([]string, 0, len(missing))
+		for _, route := range missing {
+			names = append(names, route.Method+" "+route.Path)
+		}
+		logger.Fatalf("以下路由缺少访问策略: %s", strings.Join(names, ", "))
+	}
+}
+
+// printRoutePolicy 输出生效的路由权限矩阵，存在缺少策略的路由时以非零状态退出
+func printRoutePolicy() {
+	p, err := policy.Load(policy.DefaultPath)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	// 屏蔽路由注册的调试日志，只输出矩阵
+	hlog.SetLevel(hlog.LevelWarn)
+	h := server.New()
+	pprof.Register(h)
+	register(h)
+
+	routes := registeredRoutes(h)
+	if err := p.WriteMatrix(os.Stdout, routes); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	if missing, _ := p.Verify(routes); len(missing) > 0 {
+		os.Exit(1)
+	}
+}
+
+func registeredRoutes(h *server.Hertz) []policy.Route {
+	infos := h.Routes()
+	routes := make([]policy.Route, 0, len(infos))
+	for _, info := range infos {
+		routes = append(routes, policy.Route{Method: info.Method, Path: info.Path})
+	}
+	return routes
+}
@@ -0,0 +1,30 @@
+package main
+
+import (
+	"testing"
+
+	"LearnShare/pkg/policy"
+
+	"github.com/cloudwego/hertz/pkg/app/server"
+	"github.com/hertz-contrib/pprof"
+)
+
+// TestRoutePolicyCoverage 校验路由策略文件覆盖全部已注册路由，且没有多余的策略
+func TestRoutePolicyCoverage(t *testing.T) {
+	p, err := policy.Load(policy.DefaultPath)
+	if err != nil {
+		t.Fatalf("加载路由策略失败: %v", err)
+	}
+
+	h := server.New()
+	pprof.Register(h)
+	register(h)
+
+	missing, unused := p.Verify(registeredRoutes(h))
+	for _, route := range missing {
+		t.Errorf("路由缺少访问策略: %s %s", route.Method, route.Path)
+	}
+	for _, key := range unused {
+		t.Errorf("路由策略未匹配任何路由: %s", key)
+	}
+}